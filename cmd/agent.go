@@ -27,6 +27,7 @@ const (
 var (
 	agentEndpoint       string
 	agentContext        string
+	agentNamespace      string
 	agentTimeout        time.Duration
 	agentVerbose        bool
 	agentNoColor        bool
@@ -86,6 +87,7 @@ func init() {
 	// Add flags
 	agentCmd.Flags().StringVar(&agentEndpoint, "endpoint", "", "Aggregator MCP endpoint URL (default: from config)")
 	agentCmd.Flags().StringVar(&agentContext, "context", "", "Use a specific context (env: MUSTER_CONTEXT)")
+	agentCmd.Flags().StringVarP(&agentNamespace, "namespace", "n", "", "Kubernetes namespace of the resources (default: from the context)")
 	agentCmd.Flags().DurationVar(&agentTimeout, "timeout", 5*time.Minute, "Timeout for waiting for notifications")
 	agentCmd.Flags().BoolVar(&agentVerbose, "verbose", false, "Enable verbose logging (show keepalive messages)")
 	agentCmd.Flags().BoolVar(&agentNoColor, "no-color", false, "Disable colored output")
@@ -136,6 +138,15 @@ func runAgent(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	// Each context authenticates with its own token store
+	authProfile, err := cli.ResolveAuthProfile(agentEndpoint, agentContext)
+	if err != nil {
+		return err
+	}
+	namespace, err := cli.ResolveNamespace(agentNamespace, agentEndpoint, agentContext)
+	if err != nil {
+		return err
+	}
 	if endpoint == "" {
 		// Fall back to config-based resolution
 		cfg, err := config.LoadConfig(agentConfigPath)
@@ -161,10 +172,11 @@ func runAgent(cmd *cobra.Command, args []string) error {
 
 	// Create agent client
 	client := agent.NewClient(endpoint, logger, transport)
+	client.SetDefaultNamespace(namespace)
 
 	// For MCP Server mode, check if authentication is required first
 	if agentMCPServer {
-		return runMCPServerWithOAuth(ctx, client, logger, endpoint, transport)
+		return runMCPServerWithOAuth(ctx, client, logger, endpoint, transport, authProfile)
 	}
 
 	// Parse auth mode (uses environment variable as default if not specified)
@@ -174,7 +186,7 @@ func runAgent(cmd *cobra.Command, args []string) error {
 	}
 
 	// For REPL and normal modes, use the AuthHandler for authentication
-	if err := setupAgentAuthentication(ctx, client, logger, endpoint, authMode); err != nil {
+	if err := setupAgentAuthentication(ctx, client, logger, endpoint, authMode, authProfile); err != nil {
 		return err
 	}
 
@@ -189,6 +201,8 @@ func runAgent(cmd *cobra.Command, args []string) error {
 	if agentREPL {
 		// REPL mode - let REPL handle its own connection and logging
 		repl := agent.NewREPL(client, logger)
+		repl.SetAuthProfile(authProfile)
+		repl.SetNamespace(agentNamespace)
 		if err := repl.Run(ctx); err != nil {
 			return fmt.Errorf("REPL error: %w", err)
 		}
@@ -202,16 +216,14 @@ func runAgent(cmd *cobra.Command, args []string) error {
 
 // setupAgentAuthentication sets up the mcp-go OAuth transport for the agent client.
 // If no valid token exists, it triggers authentication via the AuthHandler.
-func setupAgentAuthentication(ctx context.Context, client *agent.Client, logger *agent.Logger, endpoint string, authMode cli.AuthMode) error {
+func setupAgentAuthentication(ctx context.Context, client *agent.Client, logger *agent.Logger, endpoint string, authMode cli.AuthMode, profile oauth.AuthProfile) error {
 	if !cli.IsRemoteEndpoint(endpoint) {
 		return nil
 	}
 
 	handler := api.GetAuthHandler()
 	if handler == nil {
-		adapter, err := cli.NewAuthAdapterWithConfig(cli.AuthAdapterConfig{
-			NoSilentRefresh: !agentSilentAuth,
-		})
+		adapter, err := cli.NewAuthAdapterWithConfig(cli.AuthAdapterConfigForProfile(profile, !agentSilentAuth))
 		if err != nil {
 			logger.Info("Warning: Could not initialize auth adapter: %v", err)
 			return nil
@@ -225,7 +237,7 @@ func setupAgentAuthentication(ctx context.Context, client *agent.Client, logger
 	}
 
 	// Set up mcp-go OAuth transport
-	oauthCfg, agentStore, err := oauth.SetupOAuthConfig(endpoint)
+	oauthCfg, agentStore, err := oauth.SetupOAuthConfigWithProfile(endpoint, profile)
 	if err != nil {
 		logger.Info("Warning: Could not set up OAuth transport: %v", err)
 		return nil
@@ -277,11 +289,9 @@ func setupAgentAuthentication(ctx context.Context, client *agent.Client, logger
 // If the server requires authentication, it starts with a pending auth server
 // exposing only the authenticate_muster tool, then upgrades to the full server
 // after authentication completes.
-func runMCPServerWithOAuth(ctx context.Context, client *agent.Client, logger *agent.Logger, endpoint string, transport agent.TransportType) error {
+func runMCPServerWithOAuth(ctx context.Context, client *agent.Client, logger *agent.Logger, endpoint string, transport agent.TransportType, profile oauth.AuthProfile) error {
 	// Create an AuthAdapter for OAuth support.
-	adapter, err := cli.NewAuthAdapterWithConfig(cli.AuthAdapterConfig{
-		NoSilentRefresh: !agentSilentAuth,
-	})
+	adapter, err := cli.NewAuthAdapterWithConfig(cli.AuthAdapterConfigForProfile(profile, !agentSilentAuth))
 	if err != nil {
 		logger.Debug("Could not initialize auth adapter: %v", err)
 	} else {
@@ -291,8 +301,11 @@ func runMCPServerWithOAuth(ctx context.Context, client *agent.Client, logger *ag
 
 	// First, check if the server requires authentication
 	authManager, err := oauth.NewAuthManager(oauth.AuthManagerConfig{
-		CallbackPort: DefaultOAuthCallbackPort,
-		FileMode:     true, // Persist tokens to filesystem
+		CallbackPort:    DefaultOAuthCallbackPort,
+		FileMode:        true, // Persist tokens to filesystem
+		TokenStorageDir: profile.TokenStorageDir,
+		ClientID:        profile.ClientID,
+		IdP:             profile.IdP,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create auth manager: %w", err)
@@ -309,7 +322,7 @@ func runMCPServerWithOAuth(ctx context.Context, client *agent.Client, logger *ag
 	}
 
 	// Set up OAuth transport for the MCP server client
-	oauthCfg, agentStore, oauthErr := oauth.SetupOAuthConfig(endpoint)
+	oauthCfg, agentStore, oauthErr := oauth.SetupOAuthConfigWithProfile(endpoint, profile)
 	if oauthErr == nil {
 		client.SetOAuthConfig(*oauthCfg, agentStore)
 	}
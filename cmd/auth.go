@@ -35,6 +35,7 @@ Examples:
   muster auth login                    # Login to configured aggregator
   muster auth login --endpoint <url>   # Login to specific remote endpoint
   muster auth status                   # Show authentication status
+  muster auth doctor                   # Explain auth failures and next steps
   muster auth logout                   # Logout from configured aggregator
   muster auth logout --all             # Clear all stored tokens
   muster auth whoami                   # Show current identity`,
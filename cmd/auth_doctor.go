@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pkgoauth "github.com/giantswarm/muster/pkg/oauth"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/cli"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+)
+
+// Doctor-specific flags
+var (
+	doctorServer string
+)
+
+// authDoctorCmd represents the auth doctor command
+var authDoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Explain authentication problems and how to fix them",
+	Long: `Diagnose why MCP servers are not connected for your session.
+
+For every server that is pending authentication or failing, this command
+names the failure category, explains what it means, and prints the exact
+command to run next. Healthy servers are summarized in a single line.
+
+Failure categories, as reported in the failure_category of auth://status:
+  aggregator_auth          Not logged in to the aggregator itself
+  aggregator_unreachable   The aggregator endpoint cannot be reached
+  not_authenticated        The server needs an OAuth login
+  login_failed             The last login to the server failed
+  token_expired            The token expired or was rejected and cannot be refreshed
+  scope_mismatch           The server rejected the token's scopes
+  sso_failed               The server rejected muster's forwarded or exchanged token
+  unreachable              The server endpoint cannot be reached
+  misconfigured            The server failed or its configuration must be fixed
+
+Servers still establishing SSO are shown as sso_pending.
+
+Examples:
+  muster auth doctor                   # Diagnose all servers
+  muster auth doctor --server github   # Diagnose a single server`,
+	Args: cobra.NoArgs,
+	RunE: runAuthDoctor,
+}
+
+func init() {
+	authCmd.AddCommand(authDoctorCmd)
+	authDoctorCmd.Flags().StringVar(&doctorServer, "server", "", "MCP server name to diagnose")
+}
+
+// authDiagnosis is the doctor's verdict for one server or the aggregator.
+type authDiagnosis struct {
+	Server   string
+	Category string
+	Detail   string
+	Next     string
+}
+
+// categoryDetails explains the failure categories of auth://status.
+var categoryDetails = map[pkgoauth.AuthFailureCategory]string{
+	pkgoauth.AuthFailureNotAuthenticated: "The server returned 401 Unauthorized and needs an OAuth login",
+	pkgoauth.AuthFailureLoginFailed:      "The last authentication to the server failed",
+	pkgoauth.AuthFailureTokenExpired:     "Your token expired or was rejected and could not be refreshed",
+	pkgoauth.AuthFailureScopeMismatch:    "The server rejected the token's scopes",
+	pkgoauth.AuthFailureSSOFailed:        "The server rejected muster's forwarded or exchanged token",
+	pkgoauth.AuthFailureUnreachable:      "The server endpoint could not be reached; this is not an authentication problem",
+	pkgoauth.AuthFailureMisconfigured:    "The server failed or is misconfigured; logging in does not help",
+}
+
+func runAuthDoctor(cmd *cobra.Command, _ []string) error {
+	handler, err := ensureAuthHandler()
+	if err != nil {
+		return err
+	}
+
+	aggregatorEndpoint := authEndpoint
+	if aggregatorEndpoint == "" {
+		aggregatorEndpoint, err = getEndpointFromConfig()
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), DefaultStatusCheckTimeout)
+	defer cancel()
+
+	authStatus, err := getAuthStatusFromAggregator(ctx, handler, aggregatorEndpoint)
+	if err != nil {
+		printDiagnoses([]authDiagnosis{diagnoseAggregator(err, aggregatorEndpoint, handler)})
+		return nil
+	}
+
+	servers := authStatus.Servers
+	if doctorServer != "" {
+		servers = nil
+		for _, srv := range authStatus.Servers {
+			if srv.Name == doctorServer {
+				servers = append(servers, srv)
+			}
+		}
+		if len(servers) == 0 {
+			return cli.NewValidationError("server '%s' not found. Use 'muster auth status' to see available servers", doctorServer)
+		}
+	}
+
+	var diagnoses []authDiagnosis
+	var healthy []string
+	for _, srv := range servers {
+		if d, ok := diagnoseServer(srv); ok {
+			diagnoses = append(diagnoses, d)
+		} else {
+			healthy = append(healthy, srv.Name)
+		}
+	}
+
+	if len(diagnoses) == 0 {
+		fmt.Printf("%s No authentication problems found (%d server(s) connected).\n", text.FgGreen.Sprint("✓"), len(healthy))
+		return nil
+	}
+	printDiagnoses(diagnoses)
+	if len(healthy) > 0 {
+		fmt.Printf("\n%d server(s) connected: %s\n", len(healthy), strings.Join(healthy, ", "))
+	}
+	return nil
+}
+
+// diagnoseAggregator explains a failure to reach or authenticate to the
+// aggregator itself; no per-server diagnosis is possible in that case.
+func diagnoseAggregator(err error, endpoint string, handler api.AuthHandler) authDiagnosis {
+	if pkgoauth.IsOAuthUnauthorizedError(err) {
+		d := authDiagnosis{
+			Server:   "aggregator",
+			Category: string(pkgoauth.AuthFailureAggregatorAuth),
+			Detail:   fmt.Sprintf("%s returned 401 Unauthorized.", endpoint),
+			Next:     "muster auth login",
+		}
+		if local := handler.GetStatusForEndpoint(endpoint); local != nil && local.Authenticated {
+			d.Detail += " The stored token was rejected and could not be refreshed."
+		}
+		return d
+	}
+
+	next := "muster auth status --endpoint " + endpoint
+	if !cli.IsRemoteEndpoint(endpoint) {
+		next = "muster serve"
+	}
+	return authDiagnosis{
+		Server:   "aggregator",
+		Category: string(pkgoauth.AuthFailureAggregatorUnreachable),
+		Detail:   fmt.Sprintf("cannot reach %s: %s", endpoint, formatConnectionErrorReason(err)),
+		Next:     next,
+	}
+}
+
+// diagnoseServer explains a server's auth status from the failure category
+// and remediation the aggregator reports. It returns false for servers that
+// need no action.
+func diagnoseServer(srv pkgoauth.ServerAuthStatus) (authDiagnosis, bool) {
+	d := authDiagnosis{
+		Server:   srv.Name,
+		Category: string(srv.FailureCategory),
+		Next:     strings.TrimPrefix(srv.RemediationHint, "Run: "),
+	}
+
+	switch {
+	case srv.FailureCategory != "":
+		d.Detail = categoryDetails[srv.FailureCategory]
+		if d.Detail == "" {
+			d.Detail = fmt.Sprintf("Status %q", srv.Status)
+		}
+		switch srv.FailureCategory {
+		case pkgoauth.AuthFailureNotAuthenticated, pkgoauth.AuthFailureSSOFailed:
+			if srv.Issuer != "" {
+				d.Detail += fmt.Sprintf(" (issuer %s)", srv.Issuer)
+			}
+		case pkgoauth.AuthFailureScopeMismatch:
+			if srv.Scope != "" {
+				d.Detail += fmt.Sprintf(". Required scope: %s", srv.Scope)
+			}
+		}
+		d.Detail += "."
+
+	case srv.Status == pkgoauth.SessionServerStatusSSOPending:
+		d.Category = string(srv.Status)
+		d.Detail = "muster is still establishing the connection with your SSO token. Do not log in manually."
+		d.Next = fmt.Sprintf("muster auth status --server %s", srv.Name)
+
+	case srv.Status == pkgoauth.SessionServerStatusConnected:
+		return d, false
+
+	default:
+		// Aggregators that predate failure categories
+		d.Category = string(srv.Status)
+		d.Detail = fmt.Sprintf("Status %q.", srv.Status)
+		d.Next = fmt.Sprintf("muster auth status --server %s", srv.Name)
+	}
+
+	if d.Next == "" {
+		d.Next = fmt.Sprintf("muster events --resource-name %s --type Warning", srv.Name)
+	}
+	if srv.Error != "" {
+		d.Detail += " Error: " + srv.Error
+	}
+	return d, true
+}
+
+// printDiagnoses prints one block per diagnosis.
+func printDiagnoses(diagnoses []authDiagnosis) {
+	for i, d := range diagnoses {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s  %s\n", text.Bold.Sprint(d.Server), text.FgYellow.Sprint(d.Category))
+		fmt.Printf("  %s\n", d.Detail)
+		fmt.Printf("  Next: %s\n", text.FgCyan.Sprint(d.Next))
+	}
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pkgoauth "github.com/giantswarm/muster/pkg/oauth"
+)
+
+func TestDiagnoseServer(t *testing.T) {
+	tests := []struct {
+		name     string
+		srv      pkgoauth.ServerAuthStatus
+		healthy  bool
+		category string
+		next     string
+		detail   string
+	}{
+		{
+			name:    "connected",
+			srv:     pkgoauth.ServerAuthStatus{Name: "kube", Status: pkgoauth.SessionServerStatusConnected},
+			healthy: true,
+		},
+		{
+			name: "not authenticated",
+			srv: pkgoauth.ServerAuthStatus{Name: "github", Status: pkgoauth.SessionServerStatusAuthRequired, Issuer: "https://github.com/login/oauth",
+				FailureCategory: pkgoauth.AuthFailureNotAuthenticated, RemediationHint: "Run: muster auth login --server github"},
+			category: "not_authenticated",
+			next:     "muster auth login --server github",
+			detail:   "(issuer https://github.com/login/oauth).",
+		},
+		{
+			name: "unreachable",
+			srv: pkgoauth.ServerAuthStatus{Name: "down", Status: pkgoauth.SessionServerStatusUnreachable,
+				FailureCategory: pkgoauth.AuthFailureUnreachable, RemediationHint: "MCP server down cannot be reached"},
+			category: "unreachable",
+			next:     "MCP server down cannot be reached",
+			detail:   "not an authentication problem",
+		},
+		{
+			name: "token expired",
+			srv: pkgoauth.ServerAuthStatus{Name: "sso", Status: pkgoauth.SessionServerStatusReauthRequired, TokenForwardingEnabled: true,
+				FailureCategory: pkgoauth.AuthFailureTokenExpired, RemediationHint: "Run: muster auth login", Error: "token expired"},
+			category: "token_expired",
+			next:     "muster auth login",
+			detail:   "Error: token expired",
+		},
+		{
+			name: "scope mismatch",
+			srv: pkgoauth.ServerAuthStatus{Name: "gh", Status: pkgoauth.SessionServerStatusAuthRequired, Scope: "repo",
+				FailureCategory: pkgoauth.AuthFailureScopeMismatch, RemediationHint: "Run: muster auth logout --server gh && muster auth login --server gh"},
+			category: "scope_mismatch",
+			next:     "muster auth logout --server gh && muster auth login --server gh",
+			detail:   "Required scope: repo",
+		},
+		{
+			name: "sso failed",
+			srv: pkgoauth.ServerAuthStatus{Name: "mc", Status: pkgoauth.SessionServerStatusAuthRequired, SSOAttemptFailed: true, TokenExchangeEnabled: true, Issuer: "https://dex.remote",
+				FailureCategory: pkgoauth.AuthFailureSSOFailed, RemediationHint: "SSO to mc failed"},
+			category: "sso_failed",
+			next:     "SSO to mc failed",
+			detail:   "(issuer https://dex.remote)",
+		},
+		{
+			name:     "sso pending",
+			srv:      pkgoauth.ServerAuthStatus{Name: "mc", Status: pkgoauth.SessionServerStatusSSOPending},
+			category: "sso_pending",
+			next:     "muster auth status --server mc",
+		},
+		{
+			name:     "misconfigured without remediation",
+			srv:      pkgoauth.ServerAuthStatus{Name: "x", Status: pkgoauth.SessionServerStatusError, FailureCategory: pkgoauth.AuthFailureMisconfigured, Error: "boom"},
+			category: "misconfigured",
+			next:     "muster events --resource-name x --type Warning",
+			detail:   "Error: boom",
+		},
+		{
+			name:     "aggregator without failure categories",
+			srv:      pkgoauth.ServerAuthStatus{Name: "x", Status: pkgoauth.SessionServerStatusError},
+			category: "error",
+			next:     "muster auth status --server x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, needsAction := diagnoseServer(tt.srv)
+			if tt.healthy {
+				assert.False(t, needsAction)
+				return
+			}
+			assert.True(t, needsAction)
+			assert.Equal(t, tt.category, d.Category)
+			if tt.next != "" {
+				assert.Equal(t, tt.next, d.Next)
+			}
+			if tt.detail != "" {
+				assert.Contains(t, d.Detail, tt.detail)
+			}
+		})
+	}
+}
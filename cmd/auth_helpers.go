@@ -42,19 +42,26 @@ func ensureAuthHandler() (api.AuthHandler, error) {
 
 // ensureAuthHandlerWithOptions ensures an auth handler with options is registered and returns it.
 func ensureAuthHandlerWithOptions(opts AuthHandlerOptions) (api.AuthHandler, error) {
+	// Each context authenticates with its own token store
+	profile, err := cli.ResolveAuthProfile(authEndpoint, authContext)
+	if err != nil {
+		return nil, err
+	}
+
 	handler := api.GetAuthHandler()
 	if handler != nil {
 		// If handler already exists, try to update its silent refresh setting
 		if adapter, ok := handler.(*cli.AuthAdapter); ok {
 			adapter.SetNoSilentRefresh(opts.NoSilentRefresh)
+			if err := adapter.SetAuthProfile(profile); err != nil {
+				return nil, fmt.Errorf("failed to initialize authentication: %w", err)
+			}
 		}
 		return handler, nil
 	}
 
 	// Create and register the auth adapter with options
-	adapter, err := cli.NewAuthAdapterWithConfig(cli.AuthAdapterConfig{
-		NoSilentRefresh: opts.NoSilentRefresh,
-	})
+	adapter, err := cli.NewAuthAdapterWithConfig(cli.AuthAdapterConfigForProfile(profile, opts.NoSilentRefresh))
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize authentication: %w", err)
 	}
@@ -112,7 +119,11 @@ func createStatusClient(ctx context.Context, endpoint string) (*agent.Client, er
 
 	client := agent.NewClient(endpoint, logger, agentTransportType)
 
-	oauthCfg, agentStore, err := oauth.SetupOAuthConfig(endpoint)
+	profile, err := cli.ResolveAuthProfile(authEndpoint, authContext)
+	if err != nil {
+		return nil, err
+	}
+	oauthCfg, agentStore, err := oauth.SetupOAuthConfigWithProfile(endpoint, profile)
 	if err == nil {
 		client.SetOAuthConfig(*oauthCfg, agentStore)
 	}
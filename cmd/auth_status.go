@@ -169,6 +169,23 @@ func showMCPServerStatus(ctx context.Context, handler api.AuthHandler, aggregato
 			if srv.Issuer != "" {
 				authPrint("  Issuer:   %s\n", srv.Issuer)
 			}
+			if len(srv.RequiredScopes) > 0 {
+				authPrint("  Scopes:   %s\n", strings.Join(srv.RequiredScopes, " "))
+			}
+			if srv.FailureCategory != "" {
+				authPrint("  Failure:  %s\n", srv.FailureCategory)
+			}
+			if srv.LastAttempt != nil {
+				authPrint("  Last try: %s\n", srv.LastAttempt.Local().Format(time.RFC3339))
+			}
+			if srv.Error != "" {
+				authPrint("  Error:    %s\n", srv.Error)
+			}
+			if srv.RemediationHint != "" {
+				// Servers reporting a remediation know best what restores access
+				authPrint("  Action:   %s\n", srv.RemediationHint)
+				return nil
+			}
 			if srv.Status == pkgoauth.SessionServerStatusAuthRequired {
 				if srv.SSOAttemptFailed && (srv.TokenForwardingEnabled || srv.TokenExchangeEnabled) {
 					authPrint("  Action:   SSO failed - check server configuration\n")
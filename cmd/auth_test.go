@@ -34,7 +34,7 @@ func TestAuthCommandStructure(t *testing.T) {
 			t.Error("expected auth to have subcommands")
 		}
 
-		expectedSubcommands := []string{"login", "logout", "status", "whoami"}
+		expectedSubcommands := []string{"login", "logout", "status", "doctor", "whoami"}
 		foundCommands := make(map[string]bool)
 		for _, cmd := range subcommands {
 			foundCommands[cmd.Name()] = true
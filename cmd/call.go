@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -57,6 +56,7 @@ func callToolNameCompletion(cmd *cobra.Command, toComplete string) ([]string, co
 		Format:     cli.OutputFormatJSON,
 		Quiet:      true,
 		ConfigPath: callFlags.ConfigPath,
+		Namespace:  callFlags.Namespace,
 	})
 	if err != nil {
 		return nil, cobra.ShellCompDirectiveNoFileComp
@@ -246,7 +246,7 @@ func runCall(cmd *cobra.Command, args []string) error {
 	if jsonArg != "" {
 		toolArgs = make(map[string]interface{})
 		if err := json.Unmarshal([]byte(jsonArg), &toolArgs); err != nil {
-			return fmt.Errorf("invalid JSON argument: %w", err)
+			return cli.NewValidationError("invalid JSON argument: %v", err)
 		}
 	} else {
 		toolArgs = parseCallArguments(toolName, os.Args)
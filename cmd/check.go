@@ -1,8 +1,6 @@
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/internal/cli"
 
@@ -74,7 +72,7 @@ func runCheck(cmd *cobra.Command, args []string) error {
 	// Validate resource type
 	toolName, exists := checkResourceMappings[resourceType]
 	if !exists {
-		return fmt.Errorf("unknown resource type '%s'. Available types: mcpserver, workflow", resourceType)
+		return cli.NewValidationError("unknown resource type '%s'. Available types: mcpserver, workflow", resourceType)
 	}
 
 	opts, err := checkFlags.ToExecutorOptions()
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/giantswarm/muster/internal/cli"
+	"github.com/giantswarm/muster/internal/config"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	configValidatePath   string
+	configValidateOutput string
+	configValidateStrict bool
+)
+
+// configCmd groups offline configuration commands.
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and validate muster configuration",
+	Long: `Work with a muster configuration directory without starting the server.
+
+Examples:
+  muster config validate
+  muster config validate --config-path ./.muster
+  muster config schema workflow`,
+	Args: cobra.NoArgs,
+}
+
+// configValidateCmd validates config.yaml and all entity definitions.
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate config.yaml and entity definitions",
+	Long: `Statically validate a configuration directory: config.yaml plus every
+MCPServer and Workflow definition under mcpservers/ and workflows/.
+
+Each file is checked against its JSON Schema (see 'muster config schema')
+for YAML syntax, unknown fields, required fields, field types, and enum
+values, followed by semantic checks of workflow step structure and Go
+template syntax in workflow arguments, conditions, and outputs. Each problem
+is reported with its file, line, and field.
+
+The command does not contact a running aggregator, so it can be used as a
+CI gate. It exits with code 5 (validation failed) when any error is found
+(or any warning, with --strict).
+
+Examples:
+  muster config validate
+  muster config validate --config-path ./.muster
+  muster config validate --strict -o json`,
+	Args: cobra.NoArgs,
+	RunE: runConfigValidate,
+}
+
+// configSchemaCmd prints the JSON Schemas the configuration is checked against.
+var configSchemaCmd = &cobra.Command{
+	Use:   "schema <config|mcpserver|workflow>",
+	Short: "Print the JSON Schema of config.yaml or an entity definition",
+	Long: `Print the JSON Schema that config.yaml (config), MCPServer definitions
+(mcpserver), or Workflow definitions (workflow) are validated against, e.g.
+for editor completion with the YAML language server.
+
+Examples:
+  muster config schema config > config.schema.json
+  muster config schema workflow`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: config.SchemaNames,
+	RunE:      runConfigSchema,
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configValidateCmd)
+	configCmd.AddCommand(configSchemaCmd)
+
+	configValidateCmd.Flags().StringVar(&configValidatePath, "config-path", config.GetDefaultConfigPathOrPanic(), "Configuration directory")
+	configValidateCmd.Flags().StringVarP(&configValidateOutput, "output", "o", "text", "Output format (text, json)")
+	configValidateCmd.Flags().BoolVar(&configValidateStrict, "strict", false, "Treat warnings as errors")
+}
+
+func runConfigValidate(cmd *cobra.Command, _ []string) error {
+	if configValidateOutput != "text" && configValidateOutput != string(cli.OutputFormatJSON) {
+		return cli.NewValidationError("unsupported output format '%s' (supported: text, json)", configValidateOutput)
+	}
+
+	report, err := config.ValidateDirectory(configValidatePath)
+	if err != nil {
+		return err
+	}
+
+	failed := !report.Valid() || (configValidateStrict && report.WarningCount() > 0)
+	jsonOutput := configValidateOutput == string(cli.OutputFormatJSON)
+	out := cmd.OutOrStdout()
+
+	if !failed {
+		if jsonOutput {
+			return writeJSON(out, report)
+		}
+		printValidationReport(out, report)
+		return nil
+	}
+
+	validationErr := cli.NewValidationError("configuration in %s is invalid: %d error(s), %d warning(s)",
+		report.ConfigPath, report.ErrorCount(), report.WarningCount())
+	if jsonOutput {
+		// The report travels in the error envelope's details so JSON output
+		// stays a single document.
+		validationErr.Details = report
+	} else {
+		printValidationReport(out, report)
+	}
+	return validationErr
+}
+
+func runConfigSchema(cmd *cobra.Command, args []string) error {
+	schema, err := config.Schema(args[0])
+	if err != nil {
+		return cli.NewValidationError("%v", err)
+	}
+	_, err = cmd.OutOrStdout().Write(schema)
+	return err
+}
+
+// writeJSON writes v as indented JSON.
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode output: %w", err)
+	}
+	return nil
+}
+
+// printValidationReport writes one line per issue followed by a summary.
+func printValidationReport(w io.Writer, report *config.ValidationReport) {
+	for _, issue := range report.Issues {
+		_, _ = fmt.Fprintln(w, issue.String())
+	}
+	if len(report.Issues) == 0 {
+		_, _ = fmt.Fprintf(w, "%s: %d file(s) checked, no issues found\n", report.ConfigPath, report.FilesChecked)
+		return
+	}
+	_, _ = fmt.Fprintf(w, "\n%d file(s) checked: %d error(s), %d warning(s)\n",
+		report.FilesChecked, report.ErrorCount(), report.WarningCount())
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"text/tabwriter"
 
@@ -22,6 +23,12 @@ var (
 	contextQuiet            bool
 	contextShowOutputFormat string
 	contextUpdateEndpoint   string
+	contextNamespace        string
+
+	// Per-context auth flags, shared by add and update
+	contextTokenDir string
+	contextClientID string
+	contextIdP      string
 )
 
 // contextCmd represents the context command group
@@ -42,15 +49,31 @@ Examples:
   muster context add staging --endpoint <url> # Add new context
   muster context add staging --endpoint <url> --use  # Add and switch
   muster context update staging --endpoint <url>     # Update context (alias: set)
+  muster context update prod --token-dir ~/.config/muster/tokens-prod  # Separate tokens
+  muster context update team-a --namespace team-a    # Default namespace
   muster context delete staging               # Remove a context (alias: rm)
   muster context delete staging --force       # Remove without confirmation
   muster context rename staging stage         # Rename a context
   muster context show production              # Show details (alias: describe)
   muster context show production -o json      # Show as JSON
+  muster context discover                     # Add contexts from kubeconfig clusters
 
 Context Configuration:
   Contexts are stored in ~/.config/muster/contexts.yaml
 
+Per-Context Credentials:
+  A context can have its own token directory, OAuth client ID and preferred
+  identity provider (--token-dir, --client-id, --idp). Commands using the
+  context log in and read tokens with these, so switching between contexts
+  never shares or overwrites tokens. Contexts without them share the default
+  token directory (~/.config/muster/tokens).
+
+Default Namespace:
+  A context can have a default Kubernetes namespace (--namespace). Commands
+  using the context manage the MCPServers and Workflows of that namespace on
+  servers running in Kubernetes mode. The -n flag of these commands overrides
+  it. Without one, the namespace the server runs in is used.
+
 Precedence (highest to lowest):
   1. --endpoint flag
   2. --context flag
@@ -122,7 +145,10 @@ Context names must:
 Examples:
   muster context add local --endpoint http://localhost:8090/mcp
   muster context add staging --endpoint https://muster-staging.example.com/mcp
-  muster context add production --endpoint https://muster.example.com/mcp --use`,
+  muster context add production --endpoint https://muster.example.com/mcp --use
+  muster context add production --endpoint https://muster.example.com/mcp \
+    --token-dir ~/.config/muster/tokens-prod --idp github
+  muster context add team-a --endpoint https://muster.example.com/mcp --namespace team-a`,
 	Args: cobra.ExactArgs(1),
 	RunE: runContextAdd,
 }
@@ -191,14 +217,20 @@ Examples:
 
 // contextUpdateCmd updates an existing context
 var contextUpdateCmd = &cobra.Command{
-	Use:     "update <name> --endpoint <url>",
+	Use:     "update <name> [--endpoint <url>] [--namespace <ns>] [--token-dir <dir>] [--client-id <id>] [--idp <idp>]",
 	Aliases: []string{"set"},
 	Short:   "Update an existing context",
-	Long: `Update the endpoint or settings of an existing context.
+	Long: `Update the endpoint, settings or credentials of an existing context.
+
+Only the given flags are changed. Pass an empty value to clear the namespace or
+a credential setting, e.g. --idp "".
 
 Examples:
   muster context update staging --endpoint https://new-staging.example.com/mcp
-  muster context set production --endpoint https://muster.example.com/mcp`,
+  muster context set production --endpoint https://muster.example.com/mcp
+  muster context update production --token-dir ~/.config/muster/tokens-prod
+  muster context update production --client-id muster-prod --idp github
+  muster context update team-a --namespace team-a`,
 	Args:              cobra.ExactArgs(1),
 	ValidArgsFunction: completeContextNames,
 	RunE:              runContextUpdate,
@@ -221,7 +253,9 @@ func init() {
 	// Add-specific flags
 	contextAddCmd.Flags().StringVar(&contextAddEndpoint, "endpoint", "", "Endpoint URL for the context (required)")
 	contextAddCmd.Flags().BoolVar(&contextAddSetCurrent, "use", false, "Set as current context after adding")
+	contextAddCmd.Flags().StringVarP(&contextNamespace, "namespace", "n", "", "Default Kubernetes namespace of the context")
 	_ = contextAddCmd.MarkFlagRequired("endpoint")
+	addContextAuthFlags(contextAddCmd)
 
 	// Delete-specific flags
 	contextDeleteCmd.Flags().BoolVarP(&contextDeleteForce, "force", "f", false, "Skip confirmation prompt")
@@ -230,8 +264,49 @@ func init() {
 	contextShowCmd.Flags().StringVarP(&contextShowOutputFormat, "output", "o", "text", "Output format (text, json, yaml)")
 
 	// Update-specific flags
-	contextUpdateCmd.Flags().StringVar(&contextUpdateEndpoint, "endpoint", "", "New endpoint URL for the context (required)")
-	_ = contextUpdateCmd.MarkFlagRequired("endpoint")
+	contextUpdateCmd.Flags().StringVar(&contextUpdateEndpoint, "endpoint", "", "New endpoint URL for the context")
+	contextUpdateCmd.Flags().StringVarP(&contextNamespace, "namespace", "n", "", "Default Kubernetes namespace of the context")
+	addContextAuthFlags(contextUpdateCmd)
+}
+
+// addContextAuthFlags adds the per-context credential flags to cmd.
+func addContextAuthFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&contextTokenDir, "token-dir", "", "Directory to store this context's tokens in (default: shared ~/.config/muster/tokens)")
+	cmd.Flags().StringVar(&contextClientID, "client-id", "", "OAuth client ID to log in to this context with")
+	cmd.Flags().StringVar(&contextIdP, "idp", "", "Preferred identity provider to log in to this context with")
+}
+
+// contextAuthFromFlags applies the per-context credential flags given on cmd
+// to existing. It reports whether any flag was given.
+func contextAuthFromFlags(cmd *cobra.Command, existing *musterctx.ContextAuth) (*musterctx.ContextAuth, bool, error) {
+	auth := &musterctx.ContextAuth{}
+	if existing != nil {
+		*auth = *existing
+	}
+
+	changed := false
+	if cmd.Flags().Changed("token-dir") {
+		tokenDir := contextTokenDir
+		// Keep "~/" paths portable; they are expanded when used
+		if tokenDir != "" && !strings.HasPrefix(tokenDir, "~/") {
+			abs, err := filepath.Abs(tokenDir)
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid token directory %q: %w", tokenDir, err)
+			}
+			tokenDir = abs
+		}
+		auth.TokenDir = tokenDir
+		changed = true
+	}
+	if cmd.Flags().Changed("client-id") {
+		auth.ClientID = contextClientID
+		changed = true
+	}
+	if cmd.Flags().Changed("idp") {
+		auth.IdP = contextIdP
+		changed = true
+	}
+	return auth, changed, nil
 }
 
 // completeContextNames provides shell completion for context names
@@ -279,14 +354,14 @@ func runContextList(cmd *cobra.Command, args []string) error {
 
 	// Use tabwriter for aligned output
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "CURRENT\tNAME\tENDPOINT")
+	_, _ = fmt.Fprintln(w, "CURRENT\tNAME\tENDPOINT\tNAMESPACE")
 
 	for _, ctx := range config.Contexts {
 		current := ""
 		if ctx.Name == config.CurrentContext {
 			current = "*"
 		}
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", current, ctx.Name, ctx.Endpoint)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", current, ctx.Name, ctx.Endpoint, ctx.Namespace)
 	}
 
 	return w.Flush()
@@ -343,9 +418,29 @@ func runContextAdd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to initialize context storage: %w", err)
 	}
 
+	auth, authChanged, err := contextAuthFromFlags(cmd, nil)
+	if err != nil {
+		return err
+	}
+	if contextNamespace != "" {
+		if err := musterctx.ValidateNamespace(contextNamespace); err != nil {
+			return err
+		}
+	}
+
 	if err := storage.AddContext(name, contextAddEndpoint, nil); err != nil {
 		return fmt.Errorf("failed to add context: %w", err)
 	}
+	if contextNamespace != "" {
+		if err := storage.SetContextNamespace(name, contextNamespace); err != nil {
+			return fmt.Errorf("failed to set context namespace: %w", err)
+		}
+	}
+	if authChanged {
+		if err := storage.SetContextAuth(name, auth); err != nil {
+			return fmt.Errorf("failed to set context credentials: %w", err)
+		}
+	}
 
 	if !contextQuiet {
 		fmt.Printf("Context %q added.\n", name)
@@ -446,10 +541,12 @@ func runContextRename(cmd *cobra.Command, args []string) error {
 
 // contextDetails represents the output structure for show command
 type contextDetails struct {
-	Name     string                     `json:"name" yaml:"name"`
-	Endpoint string                     `json:"endpoint" yaml:"endpoint"`
-	Current  bool                       `json:"current" yaml:"current"`
-	Settings *musterctx.ContextSettings `json:"settings,omitempty" yaml:"settings,omitempty"`
+	Name      string                     `json:"name" yaml:"name"`
+	Endpoint  string                     `json:"endpoint" yaml:"endpoint"`
+	Namespace string                     `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Current   bool                       `json:"current" yaml:"current"`
+	Settings  *musterctx.ContextSettings `json:"settings,omitempty" yaml:"settings,omitempty"`
+	Auth      *musterctx.ContextAuth     `json:"auth,omitempty" yaml:"auth,omitempty"`
 }
 
 func runContextShow(cmd *cobra.Command, args []string) error {
@@ -475,10 +572,12 @@ func runContextShow(cmd *cobra.Command, args []string) error {
 	switch contextShowOutputFormat {
 	case "json":
 		output := contextDetails{
-			Name:     ctx.Name,
-			Endpoint: ctx.Endpoint,
-			Current:  isCurrent,
-			Settings: ctx.Settings,
+			Name:      ctx.Name,
+			Endpoint:  ctx.Endpoint,
+			Namespace: ctx.Namespace,
+			Current:   isCurrent,
+			Settings:  ctx.Settings,
+			Auth:      ctx.Auth,
 		}
 		data, err := json.MarshalIndent(output, "", "  ")
 		if err != nil {
@@ -488,10 +587,12 @@ func runContextShow(cmd *cobra.Command, args []string) error {
 
 	case "yaml":
 		output := contextDetails{
-			Name:     ctx.Name,
-			Endpoint: ctx.Endpoint,
-			Current:  isCurrent,
-			Settings: ctx.Settings,
+			Name:      ctx.Name,
+			Endpoint:  ctx.Endpoint,
+			Namespace: ctx.Namespace,
+			Current:   isCurrent,
+			Settings:  ctx.Settings,
+			Auth:      ctx.Auth,
 		}
 		data, err := yaml.Marshal(output)
 		if err != nil {
@@ -502,6 +603,9 @@ func runContextShow(cmd *cobra.Command, args []string) error {
 	default: // "text" or any other value
 		fmt.Printf("Name:     %s\n", ctx.Name)
 		fmt.Printf("Endpoint: %s\n", ctx.Endpoint)
+		if ctx.Namespace != "" {
+			fmt.Printf("Namespace: %s\n", ctx.Namespace)
+		}
 
 		if isCurrent {
 			fmt.Printf("Current:  yes\n")
@@ -513,6 +617,19 @@ func runContextShow(cmd *cobra.Command, args []string) error {
 				fmt.Printf("  output: %s\n", ctx.Settings.Output)
 			}
 		}
+
+		if ctx.Auth != nil {
+			fmt.Println("Auth:")
+			if ctx.Auth.TokenDir != "" {
+				fmt.Printf("  token-dir: %s\n", ctx.Auth.TokenDir)
+			}
+			if ctx.Auth.ClientID != "" {
+				fmt.Printf("  client-id: %s\n", ctx.Auth.ClientID)
+			}
+			if ctx.Auth.IdP != "" {
+				fmt.Printf("  idp: %s\n", ctx.Auth.IdP)
+			}
+		}
 	}
 
 	return nil
@@ -526,12 +643,38 @@ func runContextUpdate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to initialize context storage: %w", err)
 	}
 
-	if err := storage.UpdateContext(name, contextUpdateEndpoint, nil); err != nil {
-		var notFoundErr *musterctx.ContextNotFoundError
-		if errors.As(err, &notFoundErr) {
-			return fmt.Errorf("context %q not found. Use 'muster context add' to create a new context", name)
+	existing, err := storage.GetContext(name)
+	if err != nil {
+		return fmt.Errorf("failed to get context: %w", err)
+	}
+	if existing == nil {
+		return fmt.Errorf("context %q not found. Use 'muster context add' to create a new context", name)
+	}
+
+	endpointChanged := cmd.Flags().Changed("endpoint")
+	auth, authChanged, err := contextAuthFromFlags(cmd, existing.Auth)
+	if err != nil {
+		return err
+	}
+	namespaceChanged := cmd.Flags().Changed("namespace")
+	if !endpointChanged && !namespaceChanged && !authChanged {
+		return fmt.Errorf("nothing to update: specify --endpoint, --namespace, --token-dir, --client-id or --idp")
+	}
+
+	if endpointChanged {
+		if err := storage.UpdateContext(name, contextUpdateEndpoint, nil); err != nil {
+			return fmt.Errorf("failed to update context: %w", err)
+		}
+	}
+	if namespaceChanged {
+		if err := storage.SetContextNamespace(name, contextNamespace); err != nil {
+			return fmt.Errorf("failed to set context namespace: %w", err)
+		}
+	}
+	if authChanged {
+		if err := storage.SetContextAuth(name, auth); err != nil {
+			return fmt.Errorf("failed to set context credentials: %w", err)
 		}
-		return fmt.Errorf("failed to update context: %w", err)
 	}
 
 	if !contextQuiet {
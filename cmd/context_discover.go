@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musterctx "github.com/giantswarm/muster/internal/context"
+)
+
+var (
+	contextDiscoverKubeconfig   string
+	contextDiscoverKubeContexts []string
+	contextDiscoverDryRun       bool
+	contextDiscoverTimeout      time.Duration
+)
+
+// contextDiscoverCmd creates contexts for the muster installations in kubeconfig clusters
+var contextDiscoverCmd = &cobra.Command{
+	Use:   "discover",
+	Short: "Create contexts for muster installations found in kubeconfig clusters",
+	Long: `Inspect the clusters of your kubeconfig for muster installations and
+create a context for each aggregator endpoint found.
+
+An Ingress or Service is a muster installation if it is labelled
+app.kubernetes.io/name=muster (as set by the muster Helm chart) or has the
+muster.giantswarm.io/endpoint annotation. The endpoint is taken from:
+  - the muster.giantswarm.io/endpoint annotation, if set
+  - the hosts of an Ingress (https if the host has TLS)
+  - the load balancer address of a LoadBalancer Service
+The aggregator path is /mcp, or the muster.giantswarm.io/aggregator-path annotation.
+
+Contexts are named after the kubeconfig context. Endpoints that already have a
+context, and names that are already taken, are skipped. Clusters that cannot be
+reached are reported and skipped.
+
+Examples:
+  muster context discover                            # All kubeconfig contexts
+  muster context discover --kube-context prod-eu     # One cluster
+  muster context discover --dry-run                  # Only show what would be added
+  muster context discover --kubeconfig ~/.kube/other`,
+	Args: cobra.NoArgs,
+	RunE: runContextDiscover,
+}
+
+func init() {
+	contextCmd.AddCommand(contextDiscoverCmd)
+
+	contextDiscoverCmd.Flags().StringVar(&contextDiscoverKubeconfig, "kubeconfig", "", "Kubeconfig file (default: $KUBECONFIG or ~/.kube/config)")
+	contextDiscoverCmd.Flags().StringSliceVar(&contextDiscoverKubeContexts, "kube-context", nil, "Kubeconfig contexts to inspect (default: all)")
+	contextDiscoverCmd.Flags().BoolVar(&contextDiscoverDryRun, "dry-run", false, "Show the contexts that would be added without adding them")
+	contextDiscoverCmd.Flags().DurationVar(&contextDiscoverTimeout, "timeout", 10*time.Second, "Timeout for inspecting each cluster")
+}
+
+// discoveredContext is a context to add for a discovered endpoint.
+type discoveredContext struct {
+	name        string
+	kubeContext string
+	endpoint    musterctx.DiscoveredEndpoint
+	status      string
+}
+
+func runContextDiscover(cmd *cobra.Command, args []string) error {
+	storage, err := musterctx.NewStorage()
+	if err != nil {
+		return fmt.Errorf("failed to initialize context storage: %w", err)
+	}
+	config, err := storage.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load contexts: %w", err)
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = contextDiscoverKubeconfig
+	rawConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).RawConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	kubeContexts := contextDiscoverKubeContexts
+	if len(kubeContexts) == 0 {
+		for name := range rawConfig.Contexts {
+			kubeContexts = append(kubeContexts, name)
+		}
+		sort.Strings(kubeContexts)
+	}
+	if len(kubeContexts) == 0 {
+		return fmt.Errorf("no contexts found in kubeconfig")
+	}
+
+	// Endpoints and names already configured, including the ones added now
+	knownEndpoints := make(map[string]string)
+	takenNames := make(map[string]bool)
+	for _, ctx := range config.Contexts {
+		knownEndpoints[ctx.Endpoint] = ctx.Name
+		takenNames[ctx.Name] = true
+	}
+
+	var discovered []discoveredContext
+	for _, kubeContext := range kubeContexts {
+		if _, ok := rawConfig.Contexts[kubeContext]; !ok {
+			fmt.Fprintf(os.Stderr, "Warning: kubeconfig context %q not found\n", kubeContext)
+			continue
+		}
+		endpoints, err := discoverInKubeContext(cmd.Context(), loadingRules, kubeContext)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: skipping kubeconfig context %q: %v\n", kubeContext, err)
+			continue
+		}
+
+		for i, endpoint := range endpoints {
+			d := discoveredContext{
+				name:        musterctx.DiscoveredContextName(kubeContext, endpoint, i == 0),
+				kubeContext: kubeContext,
+				endpoint:    endpoint,
+			}
+			switch existing, ok := knownEndpoints[endpoint.Endpoint]; {
+			case ok:
+				d.status = fmt.Sprintf("exists as %q", existing)
+			case musterctx.ValidateContextName(d.name) != nil:
+				d.status = "skipped: no valid context name"
+			case takenNames[d.name]:
+				d.status = "skipped: name taken"
+			case contextDiscoverDryRun:
+				d.status = "would add"
+			default:
+				if err := storage.AddContext(d.name, endpoint.Endpoint, nil); err != nil {
+					d.status = fmt.Sprintf("failed: %v", err)
+					break
+				}
+				d.status = "added"
+			}
+			if d.status == "added" || d.status == "would add" {
+				knownEndpoints[endpoint.Endpoint] = d.name
+				takenNames[d.name] = true
+			}
+			discovered = append(discovered, d)
+		}
+	}
+
+	if len(discovered) == 0 {
+		if !contextQuiet {
+			fmt.Println("No muster installations found.")
+		}
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tENDPOINT\tKUBE CONTEXT\tSOURCE\tSTATUS")
+	for _, d := range discovered {
+		source := fmt.Sprintf("%s %s/%s", d.endpoint.Kind, d.endpoint.Namespace, d.endpoint.Name)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", d.name, d.endpoint.Endpoint, d.kubeContext, source, d.status)
+	}
+	return w.Flush()
+}
+
+// discoverInKubeContext discovers the muster endpoints in the cluster of a
+// kubeconfig context.
+func discoverInKubeContext(ctx context.Context, loadingRules *clientcmd.ClientConfigLoadingRules, kubeContext string) ([]musterctx.DiscoveredEndpoint, error) {
+	restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loadingRules,
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext},
+	).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	restConfig.Timeout = contextDiscoverTimeout
+
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, contextDiscoverTimeout)
+	defer cancel()
+	return musterctx.DiscoverEndpoints(ctx, c)
+}
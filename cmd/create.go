@@ -231,7 +231,7 @@ func runCreate(cmd *cobra.Command, args []string) error {
 	resourceName := args[1]
 	toolName, exists := createResourceMappings[resourceType]
 	if !exists {
-		return fmt.Errorf("unknown resource type '%s'. Available types: workflow, mcpserver", resourceType)
+		return cli.NewValidationError("unknown resource type '%s'. Available types: workflow, mcpserver", resourceType)
 	}
 
 	toolArgs := map[string]interface{}{
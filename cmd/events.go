@@ -21,8 +21,8 @@ var (
 	eventsFlags        cli.CommandFlags
 	eventsResourceType string
 	eventsResourceName string
-	eventsNamespace    string
 	eventsEventType    string
+	eventsReason       string
 	eventsSince        string
 	eventsUntil        string
 	eventsLimit        int
@@ -42,8 +42,10 @@ lifecycle operations and can be queried with various filters.
 Filtering Options:
   --resource-type     Filter by resource type (mcpserver, workflow)
   --resource-name     Filter by specific resource name
-  --namespace         Filter by namespace (default: all namespaces)
+  --namespace, -n     Filter by namespace (default: the namespace of the
+                      current context, or all namespaces)
   --type              Filter by event type (Normal, Warning)
+  --reason            Filter by event reason (e.g. MCPServerFailed)
   --since             Show events after this time (1h, 30m, 2024-01-15T10:00:00Z)
   --until             Show events before this time (2024-01-15T18:00:00Z)
   --limit             Limit number of events returned (default: 50)
@@ -72,6 +74,9 @@ Examples:
   muster events --type Warning
   muster events --type Normal
 
+  # Filter by event reason
+  muster events --reason MCPServerFailed --since 24h
+
   # Combine filters and change output format
   muster events --resource-type mcpserver --namespace default --limit 20 --output json
 
@@ -95,8 +100,8 @@ func init() {
 	// Filtering flags
 	eventsCmd.PersistentFlags().StringVar(&eventsResourceType, "resource-type", "", "Filter by resource type (mcpserver, workflow)")
 	eventsCmd.PersistentFlags().StringVar(&eventsResourceName, "resource-name", "", "Filter by resource name")
-	eventsCmd.PersistentFlags().StringVar(&eventsNamespace, "namespace", "", "Filter by namespace")
 	eventsCmd.PersistentFlags().StringVar(&eventsEventType, "type", "", "Filter by event type (Normal, Warning)")
+	eventsCmd.PersistentFlags().StringVar(&eventsReason, "reason", "", "Filter by event reason (e.g. MCPServerFailed)")
 	eventsCmd.PersistentFlags().StringVar(&eventsSince, "since", "", "Show events after this time (e.g., 1h, 30m, 2024-01-15T10:00:00Z)")
 	eventsCmd.PersistentFlags().StringVar(&eventsUntil, "until", "", "Show events before this time (e.g., 2024-01-15T18:00:00Z)")
 	eventsCmd.PersistentFlags().IntVar(&eventsLimit, "limit", 50, "Limit number of events returned")
@@ -111,7 +116,7 @@ func runEvents(cmd *cobra.Command, args []string) error {
 	if eventsResourceType != "" {
 		validTypes := []string{api.ResourceTypeMCPServer, api.ResourceTypeWorkflow}
 		if !contains(validTypes, strings.ToLower(eventsResourceType)) {
-			return fmt.Errorf("invalid resource type '%s'. Valid types: %s", eventsResourceType, strings.Join(validTypes, ", "))
+			return cli.NewValidationError("invalid resource type '%s'. Valid types: %s", eventsResourceType, strings.Join(validTypes, ", "))
 		}
 		// Normalize the resource type for consistency
 		eventsResourceType = strings.ToLower(eventsResourceType)
@@ -132,7 +137,7 @@ func runEvents(cmd *cobra.Command, args []string) error {
 		case "warning":
 			eventsEventType = "Warning"
 		default:
-			return fmt.Errorf("invalid event type '%s'. Valid types: Normal, Warning", eventsEventType)
+			return cli.NewValidationError("invalid event type '%s'. Valid types: Normal, Warning", eventsEventType)
 		}
 	}
 
@@ -141,7 +146,7 @@ func runEvents(cmd *cobra.Command, args []string) error {
 	if eventsSince != "" {
 		since, err := cli.ParseTimeFilter(eventsSince)
 		if err != nil {
-			return fmt.Errorf("invalid --since value '%s': %w", eventsSince, err)
+			return cli.NewValidationError("invalid --since value '%s': %v", eventsSince, err)
 		}
 		sinceTime = &since
 	}
@@ -149,7 +154,7 @@ func runEvents(cmd *cobra.Command, args []string) error {
 	if eventsUntil != "" {
 		until, err := cli.ParseTimeFilter(eventsUntil)
 		if err != nil {
-			return fmt.Errorf("invalid --until value '%s': %w", eventsUntil, err)
+			return cli.NewValidationError("invalid --until value '%s': %v", eventsUntil, err)
 		}
 		untilTime = &until
 	}
@@ -189,12 +194,15 @@ func runEvents(cmd *cobra.Command, args []string) error {
 	if eventsResourceName != "" {
 		toolArgs["resourceName"] = eventsResourceName
 	}
-	if eventsNamespace != "" {
-		toolArgs["namespace"] = eventsNamespace
+	if eventsFlags.Namespace != "" {
+		toolArgs["namespace"] = eventsFlags.Namespace
 	}
 	if eventsEventType != "" {
 		toolArgs["eventType"] = eventsEventType
 	}
+	if eventsReason != "" {
+		toolArgs["reason"] = eventsReason
+	}
 	if sinceTime != nil {
 		toolArgs["since"] = sinceTime.Format(time.RFC3339)
 	}
@@ -332,6 +340,9 @@ func humanFollowLine(ev map[string]interface{}) string {
 	line := fmt.Sprintf("[%s] %-7s %s %s/%s: %s - %s",
 		get("timestamp"), eventType, get("resource_type"), get("namespace"),
 		get("resource_name"), get("reason"), get("message"))
+	if count, ok := ev["count"].(float64); ok && count > 1 {
+		line += fmt.Sprintf(" (x%d)", int(count))
+	}
 	if stdoutIsTTY && eventType == "Warning" {
 		return text.FgYellow.Sprint(line)
 	}
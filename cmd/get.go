@@ -61,6 +61,7 @@ func getResourceNameCompletion(cmd *cobra.Command, args []string, toComplete str
 		Format:     cli.OutputFormatJSON,
 		Quiet:      true,
 		ConfigPath: getFlags.ConfigPath,
+		Namespace:  getFlags.Namespace,
 	})
 	if err != nil {
 		// Fallback if server not available
@@ -257,7 +258,7 @@ func runGet(cmd *cobra.Command, args []string) error {
 	// Validate resource type
 	toolName, exists := getResourceMappings[resourceType]
 	if !exists {
-		return fmt.Errorf("unknown resource type '%s'. Available types: %s", resourceType, availableGetResourceTypes())
+		return cli.NewValidationError("unknown resource type '%s'. Available types: %s", resourceType, availableGetResourceTypes())
 	}
 
 	opts, err := getFlags.ToExecutorOptions()
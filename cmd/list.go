@@ -19,6 +19,9 @@ var (
 	listServer      string
 	listShowAll     bool
 	listVerbose     bool
+	listLimit       int
+	listCursor      string
+	listSelector    string
 )
 
 // Resource configurations mapping tool names to their aliases
@@ -29,6 +32,14 @@ var listResourceConfigs = map[string][]string{
 	"core_workflow_execution_list": {api.ResourceTypeWorkflowExecution, api.ResourceTypeWorkflowExecutions},
 }
 
+// paginatedListTools are the list tools that filter by name and label
+// selector and paginate on the server side.
+var paginatedListTools = map[string]bool{
+	"core_service_list":   true,
+	"core_mcpserver_list": true,
+	"core_workflow_list":  true,
+}
+
 // Build resource types for autocompletion
 func getListResourceTypes() []string {
 	var types []string
@@ -193,10 +204,18 @@ Available resource types:
   resource(s)             - List all MCP resources from aggregated servers
   prompt(s)               - List all MCP prompts from aggregated servers
 
-Filtering (for MCP primitives only: tool, resource, prompt):
+Filtering:
   --filter <pattern>       - Filter by name pattern (wildcards * and ? supported)
-  --description <text>     - Filter by description content (case-insensitive substring)
-  --server <name>          - Filter by server name prefix (e.g., "github", "core")
+  --description <text>     - Filter by description content (case-insensitive substring, MCP primitives only)
+  --server <name>          - Filter by server name prefix (e.g., "github", "core", MCP primitives only)
+  --selector/-l <selector> - Filter by label selector (service, mcpserver, workflow only)
+
+Services can be selected by the labels type, state and health, MCP servers
+by type and state, and workflows by the labels of their definition.
+
+Pagination (for service, mcpserver, workflow):
+  --limit <n>              - Return at most n items (1-1000)
+  --cursor <cursor>        - Continue a previous listing from the cursor it printed
 
 Output options:
   --output/-o <format>     - Output format: table (default), wide, json, yaml
@@ -223,6 +242,10 @@ Examples:
   muster list tools --filter "*service*" --description "status"
   muster list resources --output yaml
   muster list mcpservers --no-headers | awk '{print $1}'
+  muster list services --selector state=failed
+  muster list workflows --filter "deploy-*" -l team=platform
+  muster list mcpservers --limit 20
+  muster list mcpservers --limit 20 --cursor <next_cursor>
 
 Note: The aggregator server must be running (use 'muster serve') before using these commands.`,
 	Args:                  cobra.ExactArgs(1),
@@ -237,11 +260,14 @@ func init() {
 	cli.RegisterCommonFlags(listCmd, &listFlags)
 
 	// List-specific filtering flags
-	listCmd.PersistentFlags().StringVar(&listFilter, "filter", "", "Filter by name pattern (wildcards * and ? supported, for MCP primitives only)")
+	listCmd.PersistentFlags().StringVar(&listFilter, "filter", "", "Filter by name pattern (wildcards * and ? supported, not for workflow-execution)")
 	listCmd.PersistentFlags().StringVar(&listDescription, "description", "", "Filter by description content (case-insensitive substring, for MCP primitives only)")
 	listCmd.PersistentFlags().StringVar(&listServer, "server", "", "Filter by server name prefix (for MCP primitives only)")
 	listCmd.PersistentFlags().BoolVar(&listShowAll, "all", false, "Show all servers including unreachable ones (for mcpserver only)")
 	listCmd.PersistentFlags().BoolVar(&listVerbose, "verbose", false, "Show detailed error information for failed/unreachable servers (for mcpserver only)")
+	listCmd.PersistentFlags().StringVarP(&listSelector, "selector", "l", "", "Filter by label selector, e.g. team=platform (for service, mcpserver, workflow only)")
+	listCmd.PersistentFlags().IntVar(&listLimit, "limit", 0, "Maximum number of items to return (for service, mcpserver, workflow, workflow-execution)")
+	listCmd.PersistentFlags().StringVar(&listCursor, "cursor", "", "Continue listing from a cursor printed by a previous page (for service, mcpserver, workflow only)")
 }
 
 func runList(cmd *cobra.Command, args []string) error {
@@ -256,30 +282,21 @@ func runList(cmd *cobra.Command, args []string) error {
 	resourceMappings := getListResourceMappings()
 	toolName, exists := resourceMappings[resourceType]
 	if !exists {
-		return fmt.Errorf("unknown resource type '%s'. Available types: %s", resourceType, availableListResourceTypes())
+		return cli.NewValidationError("unknown resource type '%s'. Available types: %s", resourceType, availableListResourceTypes())
 	}
 
 	// Warn if MCP-only filter flags are used with non-MCP resources
-	filterOpts := MCPFilterOptions{
-		Pattern:     listFilter,
-		Description: listDescription,
-		Server:      listServer,
-	}
-	if filterOpts.HasMCPOnlyFilters() && !listFlags.Quiet {
-		var ignoredFlags []string
-		if listFilter != "" {
-			ignoredFlags = append(ignoredFlags, "--filter")
-		}
-		if listDescription != "" {
-			ignoredFlags = append(ignoredFlags, "--description")
-		}
-		if listServer != "" {
-			ignoredFlags = append(ignoredFlags, "--server")
-		}
+	if ignoredFlags := ignoredMCPFilterFlags(toolName); len(ignoredFlags) > 0 && !listFlags.Quiet {
 		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s ignored for '%s' (only works with tools, resources, prompts)\n",
 			strings.Join(ignoredFlags, ", "), resourceType)
 	}
 
+	// Warn if pagination and selector flags are used with resources that do not support them
+	if ignoredFlags := ignoredPaginationFlags(toolName); len(ignoredFlags) > 0 && !listFlags.Quiet {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s ignored for '%s' (only works with service, mcpserver, workflow)\n",
+			strings.Join(ignoredFlags, ", "), resourceType)
+	}
+
 	// Warn if mcpserver-specific flags are used with non-mcpserver resources
 	if toolName != "core_mcpserver_list" && !listFlags.Quiet {
 		var mcpserverFlags []string
@@ -311,10 +328,51 @@ func runList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	return executor.Execute(ctx, toolName, listToolArgs(toolName))
+}
+
+// ignoredMCPFilterFlags returns the MCP primitive filter flags that are set
+// but not supported by the given list tool.
+func ignoredMCPFilterFlags(toolName string) []string {
+	var ignored []string
+	if listFilter != "" && !paginatedListTools[toolName] {
+		ignored = append(ignored, "--filter")
+	}
+	if listDescription != "" {
+		ignored = append(ignored, "--description")
+	}
+	if listServer != "" {
+		ignored = append(ignored, "--server")
+	}
+	return ignored
+}
+
+// ignoredPaginationFlags returns the pagination and selector flags that are
+// set but not supported by the given list tool.
+func ignoredPaginationFlags(toolName string) []string {
+	if paginatedListTools[toolName] {
+		return nil
+	}
+	var ignored []string
+	if listSelector != "" {
+		ignored = append(ignored, "--selector")
+	}
+	if listCursor != "" {
+		ignored = append(ignored, "--cursor")
+	}
+	// workflow_execution_list has its own limit argument
+	if listLimit != 0 && toolName != "core_workflow_execution_list" {
+		ignored = append(ignored, "--limit")
+	}
+	return ignored
+}
+
+// listToolArgs builds the arguments of the given list tool from the list flags.
+func listToolArgs(toolName string) map[string]interface{} {
+	toolArgs := map[string]interface{}{}
+
 	// For mcpserver list, pass the showAll and verbose parameters
-	var toolArgs map[string]interface{}
 	if toolName == "core_mcpserver_list" {
-		toolArgs = map[string]interface{}{}
 		if listShowAll {
 			toolArgs["showAll"] = true
 		}
@@ -323,7 +381,26 @@ func runList(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	return executor.Execute(ctx, toolName, toolArgs)
+	if paginatedListTools[toolName] {
+		if listFilter != "" {
+			toolArgs[api.ListArgFilter] = listFilter
+		}
+		if listSelector != "" {
+			toolArgs[api.ListArgLabelSelector] = listSelector
+		}
+		if listCursor != "" {
+			toolArgs[api.ListArgCursor] = listCursor
+		}
+	}
+
+	if listLimit != 0 && (paginatedListTools[toolName] || toolName == "core_workflow_execution_list") {
+		toolArgs[api.ListArgLimit] = listLimit
+	}
+
+	if len(toolArgs) == 0 {
+		return nil
+	}
+	return toolArgs
 }
 
 // runListMCP handles listing MCP primitives (tools, resources, prompts)
@@ -649,3 +649,39 @@ func TestMatchesMCPFilterWithServer(t *testing.T) {
 		})
 	}
 }
+
+func TestListToolArgs(t *testing.T) {
+	setFlags := func(filter, selector, cursor string, limit int) {
+		listFilter, listSelector, listCursor, listLimit = filter, selector, cursor, limit
+	}
+	t.Cleanup(func() { setFlags("", "", "", 0) })
+
+	setFlags("", "", "", 0)
+	if args := listToolArgs("core_service_list"); args != nil {
+		t.Errorf("expected no args without flags, got %v", args)
+	}
+
+	setFlags("svc-*", "state=failed", "YWJj", 20)
+	args := listToolArgs("core_service_list")
+	if args["filter"] != "svc-*" || args["label_selector"] != "state=failed" || args["cursor"] != "YWJj" || args["limit"] != 20 {
+		t.Errorf("unexpected args for core_service_list: %v", args)
+	}
+	if ignored := ignoredPaginationFlags("core_service_list"); len(ignored) != 0 {
+		t.Errorf("expected no ignored flags for core_service_list, got %v", ignored)
+	}
+
+	// workflow-execution only supports --limit
+	args = listToolArgs("core_workflow_execution_list")
+	if len(args) != 1 || args["limit"] != 20 {
+		t.Errorf("unexpected args for core_workflow_execution_list: %v", args)
+	}
+	if got := strings.Join(ignoredPaginationFlags("core_workflow_execution_list"), ","); got != "--selector,--cursor" {
+		t.Errorf("unexpected ignored flags for core_workflow_execution_list: %s", got)
+	}
+	if got := strings.Join(ignoredMCPFilterFlags("core_workflow_execution_list"), ","); got != "--filter" {
+		t.Errorf("unexpected ignored MCP filter flags for core_workflow_execution_list: %s", got)
+	}
+	if ignored := ignoredMCPFilterFlags("core_workflow_list"); len(ignored) != 0 {
+		t.Errorf("expected --filter to be supported by core_workflow_list, got %v", ignored)
+	}
+}
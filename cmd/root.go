@@ -1,7 +1,7 @@
 package cmd
 
 import (
-	"errors"
+	"fmt"
 	"os"
 
 	"github.com/giantswarm/muster/internal/cli"
@@ -10,16 +10,25 @@ import (
 )
 
 // Exit codes for CLI commands.
-// These follow common conventions and are documented in docs/reference/cli/auth.md
+// These follow common conventions and are documented in docs/reference/cli/README.md.
+// The values are defined alongside the error-code taxonomy in internal/cli.
 const (
 	// ExitCodeSuccess indicates successful execution.
-	ExitCodeSuccess = 0
+	ExitCodeSuccess = cli.ExitCodeSuccess
 	// ExitCodeError indicates a general error (command failed, invalid arguments).
-	ExitCodeError = 1
+	ExitCodeError = cli.ExitCodeError
 	// ExitCodeAuthRequired indicates authentication is required but not available.
-	ExitCodeAuthRequired = 2
+	ExitCodeAuthRequired = cli.ExitCodeAuthRequired
 	// ExitCodeAuthFailed indicates the OAuth flow failed.
-	ExitCodeAuthFailed = 3
+	ExitCodeAuthFailed = cli.ExitCodeAuthFailed
+	// ExitCodeConnection indicates the aggregator could not be reached.
+	ExitCodeConnection = cli.ExitCodeConnection
+	// ExitCodeValidation indicates invalid input or configuration.
+	ExitCodeValidation = cli.ExitCodeValidation
+	// ExitCodeNotFound indicates the requested resource does not exist.
+	ExitCodeNotFound = cli.ExitCodeNotFound
+	// ExitCodeToolError indicates the tool ran and returned an error result.
+	ExitCodeToolError = cli.ExitCodeToolError
 )
 
 // rootCmd represents the base command for the muster application.
@@ -55,35 +64,48 @@ func Execute() {
 	// This is used when the --version flag is invoked.
 	rootCmd.SetVersionTemplate(`{{printf "muster version %s\n" .Version}}`)
 
-	err := rootCmd.Execute()
+	// Errors are printed here rather than by cobra so that commands run with
+	// --output json emit a machine-readable envelope instead of plain text.
+	rootCmd.SilenceErrors = true
+	rootCmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return cli.NewValidationError("%v", err)
+	})
+
+	cmd, err := rootCmd.ExecuteC()
 	if err != nil {
+		reportError(cmd, err)
 		// Check for specific error types and return appropriate exit codes
 		exitCode := getExitCode(err)
 		os.Exit(exitCode)
 	}
 }
 
-// getExitCode determines the appropriate exit code based on the error type.
-// This provides semantic exit codes for scripting and automation.
-func getExitCode(err error) int {
-	// Check for authentication-related errors
-	var authRequired *cli.AuthRequiredError
-	if errors.As(err, &authRequired) {
-		return ExitCodeAuthRequired
-	}
-
-	var authExpired *cli.AuthExpiredError
-	if errors.As(err, &authExpired) {
-		return ExitCodeAuthRequired
+// reportError prints a failed command's error. With --output json the
+// classified error envelope goes to stdout so scripts parsing the command's
+// output see a single JSON document either way; otherwise the error is
+// printed to stderr the way cobra would.
+func reportError(cmd *cobra.Command, err error) {
+	if wantsJSONOutput(cmd) {
+		if werr := cli.WriteErrorEnvelope(os.Stdout, err); werr == nil {
+			return
+		}
 	}
+	fmt.Fprintln(os.Stderr, "Error:", err.Error())
+}
 
-	var authFailed *cli.AuthFailedError
-	if errors.As(err, &authFailed) {
-		return ExitCodeAuthFailed
+// wantsJSONOutput reports whether the executed command was asked for JSON output.
+func wantsJSONOutput(cmd *cobra.Command) bool {
+	if cmd == nil {
+		return false
 	}
+	flag := cmd.Flags().Lookup("output")
+	return flag != nil && flag.Value.String() == string(cli.OutputFormatJSON)
+}
 
-	// Default to general error
-	return ExitCodeError
+// getExitCode determines the appropriate exit code based on the error type.
+// This provides semantic exit codes for scripting and automation.
+func getExitCode(err error) int {
+	return cli.ClassifyError(err).ExitCode()
 }
 
 // init is a special Go function that is executed when the package is initialized.
@@ -2,9 +2,12 @@ package cmd
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/giantswarm/muster/internal/cli"
+
 	"github.com/spf13/cobra"
 )
 
@@ -114,3 +117,30 @@ necessary connections like Prometheus port-forwarding.`,
 		t.Errorf("Help output should contain the long description. Got: %q", output)
 	}
 }
+
+func TestGetExitCode(t *testing.T) {
+	if code := getExitCode(&cli.AuthRequiredError{Endpoint: "https://x"}); code != ExitCodeAuthRequired {
+		t.Errorf("Expected exit code %d for auth required, got %d", ExitCodeAuthRequired, code)
+	}
+	if code := getExitCode(cli.NewValidationError("bad")); code != ExitCodeValidation {
+		t.Errorf("Expected exit code %d for validation error, got %d", ExitCodeValidation, code)
+	}
+	if code := getExitCode(errors.New("boom")); code != ExitCodeError {
+		t.Errorf("Expected exit code %d for general error, got %d", ExitCodeError, code)
+	}
+}
+
+func TestWantsJSONOutput(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().StringP("output", "o", "table", "")
+	if wantsJSONOutput(cmd) {
+		t.Error("Expected table output not to request JSON errors")
+	}
+	_ = cmd.Flags().Set("output", "json")
+	if !wantsJSONOutput(cmd) {
+		t.Error("Expected --output json to request JSON errors")
+	}
+	if wantsJSONOutput(&cobra.Command{Use: "plain"}) {
+		t.Error("Expected command without --output flag not to request JSON errors")
+	}
+}
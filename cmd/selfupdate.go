@@ -2,10 +2,17 @@ package cmd
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"os"
 
 	"github.com/creativeprojects/go-selfupdate"
 	"github.com/spf13/cobra"
+
+	"github.com/giantswarm/muster/pkg/project"
 )
 
 // githubRepoSlug specifies the GitHub repository (owner/repo) to check for updates.
@@ -13,16 +20,35 @@ const (
 	githubRepoSlug = "giantswarm/muster" // Replace with your actual repo path
 )
 
+// defaultChecksumsFile is the release asset holding the SHA-256 checksums of
+// the other assets.
+const defaultChecksumsFile = "checksums.txt"
+
+var (
+	selfUpdateChecksums  = defaultChecksumsFile
+	selfUpdatePublicKey  string
+	selfUpdateSkipVerify bool
+)
+
 // newSelfUpdateCmd creates the Cobra command for the self-update functionality.
 // This allows the application to update itself to the latest version from GitHub.
 func newSelfUpdateCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "self-update",
 		Short: "Update muster to the latest version",
 		Long: `Checks for the latest release of muster on GitHub and
-updates the current binary if a newer version is found.`,
+updates the current binary if a newer version is found.
+
+The downloaded binary is verified against the SHA-256 checksums published
+with the release. With --public-key, the checksums file must also carry a
+valid ECDSA signature (<checksums>.sig) by that key, so a compromised
+download location cannot substitute both the binary and its checksum.`,
 		RunE: runSelfUpdate,
 	}
+	cmd.Flags().StringVar(&selfUpdateChecksums, "checksums", defaultChecksumsFile, "Release asset holding the SHA-256 checksums of the binaries")
+	cmd.Flags().StringVar(&selfUpdatePublicKey, "public-key", "", "PEM file of the ECDSA public key the checksums file must be signed with")
+	cmd.Flags().BoolVar(&selfUpdateSkipVerify, "skip-verify", false, "Install the release without verifying its checksum (not recommended)")
+	return cmd
 }
 
 // runSelfUpdate performs the self-update logic.
@@ -34,13 +60,22 @@ func runSelfUpdate(cmd *cobra.Command, args []string) error {
 	if currentVersion == "" || currentVersion == "dev" {
 		return fmt.Errorf("cannot self-update a development version")
 	}
+	if !project.IsRelease(currentVersion) {
+		return fmt.Errorf("cannot self-update %s, which is not a release version", currentVersion)
+	}
+
+	validator, err := selfUpdateValidator()
+	if err != nil {
+		return err
+	}
 
 	fmt.Printf("Current version: %s\n", currentVersion)
 	fmt.Println("Checking for updates...")
 
 	updater, err := selfupdate.NewUpdater(selfupdate.Config{
-		// For public GitHub repositories, no specific configuration is usually needed for the updater.
-		// The library can automatically detect releases.
+		// Releases without the validation assets fail detection, so only
+		// verifiable releases are installed.
+		Validator: validator,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create updater: %w", err)
@@ -48,6 +83,9 @@ func runSelfUpdate(cmd *cobra.Command, args []string) error {
 
 	// DetectLatest fetches the latest release information from the specified GitHub repository.
 	latest, found, err := updater.DetectLatest(context.Background(), selfupdate.ParseSlug(githubRepoSlug))
+	if errors.Is(err, selfupdate.ErrValidationAssetNotFound) {
+		return fmt.Errorf("the latest release cannot be verified, it lacks %s (use --skip-verify to install it anyway): %w", selfUpdateChecksums, err)
+	}
 	if err != nil {
 		return fmt.Errorf("error detecting latest version: %w", err)
 	}
@@ -62,6 +100,9 @@ func runSelfUpdate(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("Found newer version: %s (published at %s)\n", latest.Version(), latest.PublishedAt)
+	if project.VersionSkew(currentVersion, "v"+latest.Version()) == project.SkewIncompatible {
+		fmt.Printf("Warning: %s may be incompatible with servers still running %s, update them too.\n", latest.Version(), currentVersion)
+	}
 	fmt.Printf("Release notes:\n%s\n", latest.ReleaseNotes)
 
 	// Get the path to the currently running executable to replace it with the new version.
@@ -72,7 +113,8 @@ func runSelfUpdate(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Updating %s to version %s...\n", exe, latest.Version())
 
-	// Perform the update. This will download the new binary and replace the current one.
+	// Perform the update. This will download the new binary, verify it, and
+	// replace the current one.
 	if err := updater.UpdateTo(context.Background(), latest, exe); err != nil {
 		return fmt.Errorf("update failed: %w", err)
 	}
@@ -80,3 +122,69 @@ func runSelfUpdate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Successfully updated to version %s\n", latest.Version())
 	return nil
 }
+
+// selfUpdateValidator returns the validator of downloaded releases: the
+// checksums file, signed by the public key if one is given. It returns nil
+// with --skip-verify.
+func selfUpdateValidator() (selfupdate.Validator, error) {
+	if selfUpdateSkipVerify {
+		if selfUpdatePublicKey != "" {
+			return nil, fmt.Errorf("--skip-verify and --public-key are mutually exclusive")
+		}
+		return nil, nil
+	}
+
+	checksums := selfUpdateChecksums
+	if checksums == "" {
+		return nil, fmt.Errorf("--checksums must name the checksums file of the releases")
+	}
+	if selfUpdatePublicKey == "" {
+		return &selfupdate.ChecksumValidator{UniqueFilename: checksums}, nil
+	}
+
+	data, err := os.ReadFile(selfUpdatePublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+	key, err := parseECDSAPublicKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key %s: %w", selfUpdatePublicKey, err)
+	}
+	return new(selfupdate.PatternValidator).
+		Add(checksums, &selfupdate.ECDSAValidator{PublicKey: key}).
+		Add("*", &selfupdate.ChecksumValidator{UniqueFilename: checksums}).
+		SkipValidation("*.sig"), nil
+}
+
+// parseECDSAPublicKey parses a PEM encoded ECDSA public key, or the key of a
+// PEM encoded certificate.
+func parseECDSAPublicKey(data []byte) (*ecdsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	var key any
+	switch block.Type {
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key = parsed
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		key = cert.PublicKey
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q, expected PUBLIC KEY or CERTIFICATE", block.Type)
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("not an ECDSA public key")
+	}
+	return ecdsaKey, nil
+}
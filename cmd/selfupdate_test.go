@@ -2,6 +2,13 @@ package cmd
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -92,3 +99,52 @@ func TestGithubRepoSlug(t *testing.T) {
 		t.Errorf("Expected githubRepoSlug to be %s, got %s", expected, githubRepoSlug)
 	}
 }
+
+func TestSelfUpdateValidator(t *testing.T) {
+	defer func() {
+		selfUpdateChecksums, selfUpdatePublicKey, selfUpdateSkipVerify = defaultChecksumsFile, "", false
+	}()
+
+	validator, err := selfUpdateValidator()
+	if err != nil {
+		t.Fatalf("selfUpdateValidator() error = %v", err)
+	}
+	if got := validator.GetValidationAssetName("muster-linux-amd64"); got != "checksums.txt" {
+		t.Errorf("validation asset = %q, want checksums.txt", got)
+	}
+
+	selfUpdateSkipVerify = true
+	if validator, err := selfUpdateValidator(); err != nil || validator != nil {
+		t.Errorf("selfUpdateValidator() with --skip-verify = %v, %v, want no validator", validator, err)
+	}
+
+	// A signed checksums file needs a valid ECDSA key
+	selfUpdateSkipVerify = false
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(keyFile, []byte("not a key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	selfUpdatePublicKey = keyFile
+	if _, err := selfUpdateValidator(); err == nil || !strings.Contains(err.Error(), "no PEM block found") {
+		t.Errorf("selfUpdateValidator() error = %v, want invalid key", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	validator, err = selfUpdateValidator()
+	if err != nil {
+		t.Fatalf("selfUpdateValidator() error = %v", err)
+	}
+	if got := validator.GetValidationAssetName("checksums.txt"); got != "checksums.txt.sig" {
+		t.Errorf("validation asset of the checksums = %q, want checksums.txt.sig", got)
+	}
+}
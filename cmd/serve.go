@@ -32,14 +32,30 @@ var serveDebug bool
 // configured, is unaffected — that's controlled via OTEL_* env vars.
 var serveSilent bool
 
+// serveLogFormat selects the console log format, "text" or "json". When empty,
+// logging.format of the configuration is used.
+var serveLogFormat string
+
+// serveLogFile writes the log output to a rotated file in addition to the
+// console. When empty, logging.file.path of the configuration is used.
+var serveLogFile string
+
 // yolo disables the denylist for destructive tool calls.
 // When enabled, all MCP tools can be executed without restrictions.
 var serveYolo bool
 
+// serveReadOnly disables the mutating core tools while keeping discovery and
+// read operations available.
+var serveReadOnly bool
+
 // configPath specifies the configuration directory.
 // The directory should contain config.yaml and subdirectories: mcpservers/, workflows/
 var serveConfigPath string
 
+// serveProfile selects the profile in the configuration directory whose files
+// overlay the configuration, e.g. dev, staging, or prod.
+var serveProfile string
+
 // OAuth MCP Client/Proxy configuration flags (for authenticating TO remote MCP servers - ADR 004)
 var (
 	// serveOAuthMCPClientEnabled enables the OAuth MCP client/proxy functionality for remote MCP servers
@@ -89,7 +105,11 @@ Configuration:
   Use --config-path to specify a custom directory containing all configuration files:
   - config.yaml (main configuration)
   - mcpservers/ (MCP server definitions)
-  - workflows/ (workflow definitions)`,
+  - workflows/ (workflow definitions)
+
+  Use --profile to merge the files of profiles/<name>/ in the configuration
+  directory over these, so one configuration tree can serve several
+  environments.`,
 	Args: cobra.NoArgs, // No arguments required
 	RunE: runServe,
 }
@@ -101,19 +121,35 @@ func runServe(cmd *cobra.Command, args []string) error {
 		ctx = context.Background()
 	}
 
-	level := logging.LevelInfo
-	if serveDebug {
-		level = logging.LevelDebug
+	logCfg, err := resolveLogging()
+	if err != nil {
+		return err
 	}
 	var output io.Writer = os.Stderr
 	if serveSilent {
 		output = io.Discard
 	}
-	shutdownLogging, err := logging.Init(ctx, level, output, "muster", GetVersion())
+	if logCfg.file != "" {
+		logFile, err := logging.OpenRotatingFile(logCfg.file, logCfg.rotate)
+		if err != nil {
+			return fmt.Errorf("open log file: %w", err)
+		}
+		defer func() { _ = logFile.Close() }()
+		if serveSilent {
+			output = logFile
+		} else {
+			output = io.MultiWriter(os.Stderr, logFile)
+		}
+	}
+	shutdownLogging, err := logging.Init(ctx, logCfg.level, logCfg.format, output, "muster", GetVersion())
 	if err != nil {
 		return fmt.Errorf("init logging: %w", err)
 	}
 	defer otelShutdown("logging", shutdownLogging)
+	for subsystem, level := range logCfg.subsystems {
+		logging.SetSubsystemLevel(subsystem, level)
+	}
+	logging.SetSamplingRules(logCfg.sampling)
 
 	shutdownTracing, err := tracing.Init(ctx,
 		tracing.WithServiceName("muster"),
@@ -138,7 +174,9 @@ func runServe(cmd *cobra.Command, args []string) error {
 		WithVersion(GetVersion()).
 		WithOAuthMCPClient(serveOAuthMCPClientEnabled, serveOAuthMCPClientPublicURL, serveOAuthMCPClientID).
 		WithOAuthServer(serveOAuthServerEnabled, serveOAuthServerBaseURL).
-		WithExtraCAFile(serveExtraCAFile)
+		WithExtraCAFile(serveExtraCAFile).
+		WithProfile(serveProfile).
+		WithReadOnly(serveReadOnly)
 
 	// Create and initialize the application
 	application, err := app.NewApplication(cfg)
@@ -160,6 +198,91 @@ func otelShutdown(name string, shutdown func(context.Context) error) {
 	}
 }
 
+// serveLogging is the resolved log configuration of muster serve.
+type serveLogging struct {
+	level      logging.LogLevel
+	format     logging.Format
+	subsystems map[string]logging.LogLevel
+	file       string
+	rotate     logging.RotateOptions
+	sampling   []logging.SamplingRule
+}
+
+// resolveLogging resolves the log configuration from the --debug,
+// --log-format, and --log-file flags, falling back to the logging section of the
+// configuration. Logging is initialized before the configuration is loaded,
+// so the configuration is read here on its own; errors reading it are left
+// for the bootstrap to report.
+func resolveLogging() (serveLogging, error) {
+	resolved := serveLogging{level: logging.LevelInfo}
+	var logCfg config.LoggingConfig
+	if cfg, err := config.LoadConfigWithProfile(serveConfigPath, serveProfile); err == nil {
+		logCfg = cfg.Logging
+	}
+
+	var err error
+	if serveLogFormat != "" {
+		if resolved.format, err = logging.ParseFormat(serveLogFormat); err != nil {
+			return resolved, fmt.Errorf("invalid --log-format: %w", err)
+		}
+	} else if resolved.format, err = logging.ParseFormat(logCfg.Format); err != nil {
+		return resolved, fmt.Errorf("invalid logging.format: %w", err)
+	}
+
+	switch {
+	case serveDebug:
+		resolved.level = logging.LevelDebug
+	case logCfg.Level != "":
+		if resolved.level, err = logging.ParseLevel(logCfg.Level); err != nil {
+			return resolved, fmt.Errorf("invalid logging.level: %w", err)
+		}
+	}
+
+	resolved.subsystems = make(map[string]logging.LogLevel, len(logCfg.Subsystems))
+	for subsystem, name := range logCfg.Subsystems {
+		level, err := logging.ParseLevel(name)
+		if err != nil {
+			return resolved, fmt.Errorf("invalid logging.subsystems.%s: %w", subsystem, err)
+		}
+		resolved.subsystems[subsystem] = level
+	}
+
+	fileCfg := logCfg.File
+	resolved.file = fileCfg.Path
+	if serveLogFile != "" {
+		resolved.file = serveLogFile
+	}
+	resolved.rotate = logging.RotateOptions{
+		MaxSize:    int64(fileCfg.MaxSizeMB) * 1024 * 1024,
+		MaxBackups: fileCfg.MaxBackups,
+	}
+	if fileCfg.RotateInterval != "" {
+		if resolved.rotate.Interval, err = time.ParseDuration(fileCfg.RotateInterval); err != nil {
+			return resolved, fmt.Errorf("invalid logging.file.rotateInterval: %w", err)
+		}
+	}
+	if fileCfg.MaxAge != "" {
+		if resolved.rotate.MaxAge, err = time.ParseDuration(fileCfg.MaxAge); err != nil {
+			return resolved, fmt.Errorf("invalid logging.file.maxAge: %w", err)
+		}
+	}
+
+	resolved.sampling = logging.DefaultSamplingRules
+	if logCfg.Sampling != nil {
+		resolved.sampling = make([]logging.SamplingRule, 0, len(logCfg.Sampling))
+		for i, ruleCfg := range logCfg.Sampling {
+			rule := logging.SamplingRule{Subsystem: ruleCfg.Subsystem, Message: ruleCfg.Message, Burst: ruleCfg.Burst}
+			if ruleCfg.Interval != "" {
+				if rule.Interval, err = time.ParseDuration(ruleCfg.Interval); err != nil {
+					return resolved, fmt.Errorf("invalid logging.sampling[%d].interval: %w", i, err)
+				}
+			}
+			resolved.sampling = append(resolved.sampling, rule)
+		}
+	}
+	return resolved, nil
+}
+
 // init registers the serve command and its flags with the root command.
 // This is called automatically when the package is imported.
 func init() {
@@ -168,8 +291,12 @@ func init() {
 	// Register command flags
 	serveCmd.Flags().BoolVar(&serveDebug, "debug", false, "Enable general debug logging")
 	serveCmd.Flags().BoolVar(&serveSilent, "silent", false, "Disable console log output. Does not silence OTLP — unset OTEL_EXPORTER_OTLP_* or set OTEL_SDK_DISABLED=true for that.")
+	serveCmd.Flags().StringVar(&serveLogFormat, "log-format", "", "Console log format: text or json (default: logging.format of the configuration, else json inside a Kubernetes pod and text otherwise)")
+	serveCmd.Flags().StringVar(&serveLogFile, "log-file", "", "Also write the log output to this file, rotated by size (default: logging.file.path of the configuration)")
 	serveCmd.Flags().BoolVar(&serveYolo, "yolo", false, "Disable denylist for destructive tool calls (use with caution)")
+	serveCmd.Flags().BoolVar(&serveReadOnly, "read-only", false, "Disable the core tools that create, update, delete, start, or stop anything")
 	serveCmd.Flags().StringVar(&serveConfigPath, "config-path", config.GetDefaultConfigPathOrPanic(), "Configuration directory")
+	serveCmd.Flags().StringVar(&serveProfile, "profile", "", "Profile in the configuration directory to merge over the configuration (profiles/<name>/)")
 
 	// OAuth MCP Client/Proxy flags (for authenticating TO remote MCP servers - ADR 004)
 	// These configure muster as an OAuth client when connecting to remote MCP servers
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+func TestResolveLogging(t *testing.T) {
+	dir := t.TempDir()
+	configYAML := "logging:\n  format: json\n  level: warn\n  subsystems:\n    Aggregator: debug\n" +
+		"  file:\n    path: /var/log/muster.log\n    maxSizeMB: 10\n    rotateInterval: 24h\n"
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(configYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	oldConfigPath, oldProfile, oldFormat, oldDebug, oldFile := serveConfigPath, serveProfile, serveLogFormat, serveDebug, serveLogFile
+	t.Cleanup(func() {
+		serveConfigPath, serveProfile, serveLogFormat, serveDebug, serveLogFile = oldConfigPath, oldProfile, oldFormat, oldDebug, oldFile
+	})
+	serveConfigPath, serveProfile, serveLogFormat, serveDebug = dir, "", "", false
+
+	resolved, err := resolveLogging()
+	if err != nil {
+		t.Fatalf("resolveLogging() error = %v", err)
+	}
+	if resolved.format != logging.FormatJSON || resolved.level != logging.LevelWarn ||
+		resolved.subsystems["Aggregator"] != logging.LevelDebug {
+		t.Errorf("resolveLogging() = %+v", resolved)
+	}
+	if resolved.file != "/var/log/muster.log" || resolved.rotate.MaxSize != 10*1024*1024 || resolved.rotate.Interval != 24*time.Hour {
+		t.Errorf("resolveLogging() file = %q, %+v", resolved.file, resolved.rotate)
+	}
+	if len(resolved.sampling) != len(logging.DefaultSamplingRules) {
+		t.Errorf("resolveLogging() sampling = %+v, want the default rules", resolved.sampling)
+	}
+
+	// Flags take precedence over the configuration
+	serveLogFormat, serveDebug, serveLogFile = "text", true, "muster.log"
+	resolved, err = resolveLogging()
+	if err != nil {
+		t.Fatalf("resolveLogging() error = %v", err)
+	}
+	if resolved.format != logging.FormatText || resolved.level != logging.LevelDebug || resolved.file != "muster.log" {
+		t.Errorf("resolveLogging() with flags = %+v", resolved)
+	}
+
+	configYAML = "logging:\n  sampling:\n    - subsystem: OAuth\n      interval: 30s\n      burst: 3\n"
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(configYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	resolved, err = resolveLogging()
+	if err != nil {
+		t.Fatalf("resolveLogging() error = %v", err)
+	}
+	want := logging.SamplingRule{Subsystem: "OAuth", Interval: 30 * time.Second, Burst: 3}
+	if len(resolved.sampling) != 1 || resolved.sampling[0] != want {
+		t.Errorf("resolveLogging() sampling = %+v", resolved.sampling)
+	}
+
+	serveLogFormat = "yaml"
+	if _, err := resolveLogging(); err == nil {
+		t.Error("expected an error for an invalid --log-format")
+	}
+}
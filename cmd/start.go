@@ -41,6 +41,7 @@ func startWorkflowNameCompletion(cmd *cobra.Command, args []string, toComplete s
 		Format:     cli.OutputFormatJSON,
 		Quiet:      true,
 		ConfigPath: startFlags.ConfigPath,
+		Namespace:  startFlags.Namespace,
 	})
 	if err != nil {
 		return nil, cobra.ShellCompDirectiveNoFileComp
@@ -216,7 +217,7 @@ func runStart(cmd *cobra.Command, args []string) error {
 	// Handle other resource types (services)
 	toolName, exists := startResourceMappings[resourceType]
 	if !exists {
-		return fmt.Errorf("unknown resource type '%s'. Available types: service, workflow", resourceType)
+		return cli.NewValidationError("unknown resource type '%s'. Available types: service, workflow", resourceType)
 	}
 
 	toolArgs := map[string]interface{}{
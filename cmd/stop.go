@@ -1,8 +1,6 @@
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/internal/cli"
 
@@ -71,7 +69,7 @@ func runStop(cmd *cobra.Command, args []string) error {
 	// Validate resource type
 	toolName, exists := stopResourceMappings[resourceType]
 	if !exists {
-		return fmt.Errorf("unknown resource type '%s'. Available types: service", resourceType)
+		return cli.NewValidationError("unknown resource type '%s'. Available types: service", resourceType)
 	}
 
 	opts, err := stopFlags.ToExecutorOptions()
@@ -29,6 +29,7 @@ var (
 	testScenario   string
 	testConfigPath string
 	testReportPath string
+	testHTMLReport string
 	testFailFast   bool
 	testParallel   int
 	testMCPServer  bool
@@ -47,6 +48,35 @@ var (
 	testMusterConfigPath string
 	// Flag to keep temporary config for debugging
 	testKeepTempConfig bool
+	// Tool coverage flags
+	testCoverage          bool
+	testCoverageReport    string
+	testCoverageThreshold float64
+	// Kubernetes mode flag
+	testKubernetes string
+	// Snapshot flags
+	testUpdateSnapshots bool
+	testSnapshotDir     string
+	// Load testing flags
+	testLoad         bool
+	testLoadTPS      int
+	testLoadDuration time.Duration
+	testLoadWorkers  int
+	testSLOP95       time.Duration
+	testSLOP99       time.Duration
+	testSLOErrorRate float64
+	// Flaky test handling flags
+	testRetries        int
+	testFlakeDB        string
+	testQuarantinePath string
+	// Chaos mode flags
+	testChaos         bool
+	testChaosSeed     int64
+	testChaosRate     float64
+	testChaosMaxDelay time.Duration
+	// Instance reuse flags
+	testReuseInstances   bool
+	testMaxIdleInstances int
 )
 
 // completeCategoryFlag provides shell completion for the category flag
@@ -111,6 +141,10 @@ Test execution modes:
 6. Schema Generation (--generate-schema): Generate API schema from muster serve instance
 7. Scenario Validation (--validate-scenarios): Validate test scenarios against API schema
 
+Subcommands:
+  validate-scenarios   Check scenario files for structural errors and against the API schema
+  new-scenario         Scaffold a scenario from an existing workflow (--from-workflow)
+
 Test Categories:
 - behavioral: BDD-style scenarios validating expected behavior
 - integration: Component interaction and end-to-end validation
@@ -138,6 +172,18 @@ Example usage:
   muster test --mcp-server                # Run as MCP server (stdio transport)
   muster test --generate-schema           # Generate API schema from muster serve
   muster test --validate-scenarios        # Validate scenarios against schema
+  muster test --coverage                  # Report which tools were exercised
+  muster test --kubernetes=envtest        # Run against muster in Kubernetes CRD mode
+  muster test --update-snapshots          # Accept changed snapshot responses
+
+Tool Coverage Examples:
+  muster test --coverage --verbose                       # List untested tools per group
+  muster test --coverage-report=coverage.json            # Write the coverage report as JSON
+  muster test --coverage-threshold=80                    # Fail if less than 80% of tools are covered
+
+Kubernetes Mode Examples:
+  muster test --kubernetes=envtest --concept=workflow    # Local API server via envtest (needs KUBEBUILDER_ASSETS)
+  muster test --kubernetes=cluster                       # Current kubeconfig cluster, e.g. kind
 
 Schema Generation Examples:
   muster test --generate-schema --verbose --schema-output=api-v2.json
@@ -149,8 +195,10 @@ In MCP Server mode:
 - It's designed for integration with AI assistants like Claude or Cursor
 - Configure it in your AI assistant's MCP settings
 
-The test framework uses YAML-based test scenario definitions and automatically
-creates clean, isolated muster serve instances for each test scenario.
+The test framework uses YAML-based test scenario definitions, or Gherkin
+.feature files in a constrained subset (Given pre-configuration, When tool
+calls, Then assertions), and automatically creates clean, isolated muster
+serve instances for each test scenario.
 Each scenario can specify pre-configuration including MCP servers, workflows,
 capabilities, service classes, and service instances.
 
@@ -177,6 +225,7 @@ func init() {
 	// Test configuration and reporting
 	testCmd.Flags().StringVar(&testConfigPath, "config", "", "Path to test configuration directory (default: internal test scenarios)")
 	testCmd.Flags().StringVar(&testReportPath, "report", "", "Path to save detailed test report (default: stdout only)")
+	testCmd.Flags().StringVar(&testHTMLReport, "html-report", "", "Write a self-contained HTML report with step timelines, responses, and instance logs to this file")
 
 	// Test execution control
 	testCmd.Flags().BoolVar(&testFailFast, "fail-fast", false, "Stop test execution on first failure")
@@ -204,10 +253,47 @@ func init() {
 	// Flag to keep temporary config for debugging
 	testCmd.Flags().BoolVar(&testKeepTempConfig, "keep-temp-config", false, "Keep temporary config directory after test execution for debugging")
 
+	// Tool coverage flags
+	testCmd.Flags().BoolVar(&testCoverage, "coverage", false, "Report which aggregator tools were exercised by the scenarios")
+	testCmd.Flags().StringVar(&testCoverageReport, "coverage-report", "", "Write the tool coverage report as JSON to this file (implies --coverage)")
+	testCmd.Flags().Float64Var(&testCoverageThreshold, "coverage-threshold", 0, "Fail if tool coverage is below this percentage (implies --coverage)")
+
+	// Kubernetes mode flag
+	testCmd.Flags().StringVar(&testKubernetes, "kubernetes", "", "Run scenarios against muster in Kubernetes CRD mode (envtest, cluster)")
+
+	// Snapshot flags
+	testCmd.Flags().BoolVar(&testUpdateSnapshots, "update-snapshots", false, "Overwrite snapshots that do not match the step response")
+	testCmd.Flags().StringVar(&testSnapshotDir, "snapshot-dir", "", "Directory for snapshot files (default: __snapshots__ next to the scenarios)")
+
+	// Load testing flags
+	testCmd.Flags().BoolVar(&testLoad, "load", false, "Drive sustained concurrent tool calls from --scenario and report latency and error rates")
+	testCmd.Flags().IntVar(&testLoadTPS, "tps", 50, "Target tool calls per second in load mode")
+	testCmd.Flags().DurationVar(&testLoadDuration, "duration", time.Minute, "How long to generate load")
+	testCmd.Flags().IntVar(&testLoadWorkers, "concurrency", 16, "Number of concurrent MCP sessions in load mode")
+	testCmd.Flags().DurationVar(&testSLOP95, "slo-p95", 0, "Fail the load test if p95 latency exceeds this duration")
+	testCmd.Flags().DurationVar(&testSLOP99, "slo-p99", 0, "Fail the load test if p99 latency exceeds this duration")
+	testCmd.Flags().Float64Var(&testSLOErrorRate, "slo-error-rate", 0, "Fail the load test if the error rate exceeds this percentage")
+
+	// Flaky test handling flags
+	testCmd.Flags().IntVar(&testRetries, "retries", 0, "Rerun failed scenarios up to this many times; scenarios passing on a retry are reported as flaky")
+	testCmd.Flags().StringVar(&testFlakeDB, "flake-db", "", "JSON file accumulating scenario failure rates across runs (created if missing)")
+	testCmd.Flags().StringVar(&testQuarantinePath, "quarantine", "", "YAML file listing scenarios to skip until they are fixed")
+
+	// Chaos mode flags
+	testCmd.Flags().BoolVar(&testChaos, "chaos", false, "Randomly kill mock MCP servers, revoke mock OAuth tokens, and delay mock tool responses during scenarios")
+	testCmd.Flags().Int64Var(&testChaosSeed, "chaos-seed", 0, "Seed for chaos mode decisions; reuse the printed seed to reproduce a run (default: random)")
+	testCmd.Flags().Float64Var(&testChaosRate, "chaos-rate", 0.2, "Probability (0-1) that a chaos action precedes a step")
+	testCmd.Flags().DurationVar(&testChaosMaxDelay, "chaos-max-delay", 500*time.Millisecond, "Maximum delay chaos mode adds to mock tool responses")
+
+	// Instance reuse flags
+	testCmd.Flags().BoolVar(&testReuseInstances, "reuse-instances", false, "Reuse running muster instances for scenarios with identical pre-configuration, resetting their state in between")
+	testCmd.Flags().IntVar(&testMaxIdleInstances, "max-idle-instances", 4, "Maximum number of idle muster instances kept for reuse")
+
 	// Shell completion for test flags
 	_ = testCmd.RegisterFlagCompletionFunc("category", completeCategoryFlag)
 	_ = testCmd.RegisterFlagCompletionFunc("concept", completeConceptFlag)
 	_ = testCmd.RegisterFlagCompletionFunc("scenario", completeScenarioFlag)
+	_ = testCmd.RegisterFlagCompletionFunc("kubernetes", cobra.FixedCompletions([]string{testing.KubernetesModeEnvtest, testing.KubernetesModeCluster}, cobra.ShellCompDirectiveNoFileComp))
 
 	// Mark flags as mutually exclusive with MCP server mode
 	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "category")
@@ -217,6 +303,16 @@ func init() {
 	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "parallel")
 	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "generate-schema")
 	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "keep-temp-config")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "coverage")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "kubernetes")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "update-snapshots")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "retries")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "flake-db")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "quarantine")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "html-report")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "chaos")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "reuse-instances")
+	testCmd.MarkFlagsMutuallyExclusive("mcp-server", "load")
 
 	// Mark flags as mutually exclusive with mock MCP server mode
 	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "category")
@@ -226,6 +322,16 @@ func init() {
 	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "mcp-server")
 	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "generate-schema")
 	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "keep-temp-config")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "coverage")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "kubernetes")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "update-snapshots")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "retries")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "flake-db")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "quarantine")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "html-report")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "chaos")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "reuse-instances")
+	testCmd.MarkFlagsMutuallyExclusive("mock-mcp-server", "load")
 
 	// Mark flags as mutually exclusive with schema generation mode
 	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "category")
@@ -234,6 +340,16 @@ func init() {
 	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "fail-fast")
 	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "parallel")
 	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "keep-temp-config")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "coverage")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "kubernetes")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "update-snapshots")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "retries")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "flake-db")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "quarantine")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "html-report")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "chaos")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "reuse-instances")
+	testCmd.MarkFlagsMutuallyExclusive("generate-schema", "load")
 
 	// Mark flags as mutually exclusive with scenario validation mode
 	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "category")
@@ -243,6 +359,30 @@ func init() {
 	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "parallel")
 	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "generate-schema")
 	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "keep-temp-config")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "coverage")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "kubernetes")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "update-snapshots")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "retries")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "flake-db")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "quarantine")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "html-report")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "chaos")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "reuse-instances")
+	testCmd.MarkFlagsMutuallyExclusive("validate-scenarios", "load")
+
+	// Mark flags as mutually exclusive with load testing mode
+	testCmd.MarkFlagsMutuallyExclusive("load", "category")
+	testCmd.MarkFlagsMutuallyExclusive("load", "concept")
+	testCmd.MarkFlagsMutuallyExclusive("load", "fail-fast")
+	testCmd.MarkFlagsMutuallyExclusive("load", "parallel")
+	testCmd.MarkFlagsMutuallyExclusive("load", "coverage")
+	testCmd.MarkFlagsMutuallyExclusive("load", "update-snapshots")
+	testCmd.MarkFlagsMutuallyExclusive("load", "retries")
+	testCmd.MarkFlagsMutuallyExclusive("load", "flake-db")
+	testCmd.MarkFlagsMutuallyExclusive("load", "quarantine")
+	testCmd.MarkFlagsMutuallyExclusive("load", "html-report")
+	testCmd.MarkFlagsMutuallyExclusive("load", "chaos")
+	testCmd.MarkFlagsMutuallyExclusive("load", "reuse-instances")
 
 	// Validate parallel flag
 	testCmd.PreRunE = func(cmd *cobra.Command, args []string) error {
@@ -252,6 +392,41 @@ func init() {
 		if testMockMCPServer && testMockConfig == "" {
 			return fmt.Errorf("--mock-config is required when using --mock-mcp-server")
 		}
+		if testCoverageThreshold < 0 || testCoverageThreshold > 100 {
+			return cli.NewValidationError("--coverage-threshold must be between 0 and 100, got %g", testCoverageThreshold)
+		}
+		if testKubernetes != "" && testKubernetes != testing.KubernetesModeEnvtest && testKubernetes != testing.KubernetesModeCluster {
+			return cli.NewValidationError("--kubernetes must be %q or %q, got %q", testing.KubernetesModeEnvtest, testing.KubernetesModeCluster, testKubernetes)
+		}
+		if testRetries < 0 {
+			return cli.NewValidationError("--retries cannot be negative, got %d", testRetries)
+		}
+		if testChaosRate < 0 || testChaosRate > 1 {
+			return cli.NewValidationError("--chaos-rate must be between 0 and 1, got %g", testChaosRate)
+		}
+		if testMaxIdleInstances < 1 {
+			return cli.NewValidationError("--max-idle-instances must be at least 1, got %d", testMaxIdleInstances)
+		}
+		if testChaosMaxDelay < 0 {
+			return cli.NewValidationError("--chaos-max-delay cannot be negative, got %s", testChaosMaxDelay)
+		}
+		if testLoad {
+			if testScenario == "" {
+				return cli.NewValidationError("--load requires --scenario")
+			}
+			if testLoadTPS < 1 {
+				return cli.NewValidationError("--tps must be at least 1, got %d", testLoadTPS)
+			}
+			if testLoadWorkers < 1 {
+				return cli.NewValidationError("--concurrency must be at least 1, got %d", testLoadWorkers)
+			}
+			if testLoadDuration <= 0 || testLoadDuration >= testTimeout {
+				return cli.NewValidationError("--duration must be positive and shorter than --timeout (%s), got %s", testTimeout, testLoadDuration)
+			}
+			if testSLOErrorRate < 0 || testSLOErrorRate > 100 {
+				return cli.NewValidationError("--slo-error-rate must be between 0 and 100, got %g", testSLOErrorRate)
+			}
+		}
 		// No additional validation needed for --validate-scenarios since schema-input has a default value
 		return nil
 	}
@@ -339,15 +514,36 @@ func runTest(cmd *cobra.Command, args []string) error {
 
 	// Create test configuration
 	testConfig := testing.TestConfiguration{
-		Timeout:        testTimeout,
-		Parallel:       testParallel,
-		FailFast:       testFailFast,
-		Verbose:        testVerbose,
-		Debug:          testDebug,
-		ConfigPath:     testConfigPath,
-		ReportPath:     testReportPath,
-		BasePort:       testBasePort,
-		KeepTempConfig: testKeepTempConfig,
+		Timeout:         testTimeout,
+		Parallel:        testParallel,
+		FailFast:        testFailFast,
+		Verbose:         testVerbose,
+		Debug:           testDebug,
+		ConfigPath:      testConfigPath,
+		ReportPath:      testReportPath,
+		BasePort:        testBasePort,
+		KeepTempConfig:  testKeepTempConfig,
+		Coverage:        testCoverage || testCoverageReport != "" || testCoverageThreshold > 0,
+		SnapshotDir:     testSnapshotDir,
+		UpdateSnapshots: testUpdateSnapshots,
+		Retries:         testRetries,
+	}
+
+	if testQuarantinePath != "" {
+		quarantine, err := testing.LoadQuarantine(testQuarantinePath)
+		if err != nil {
+			return err
+		}
+		testConfig.Quarantine = quarantine
+	}
+
+	if testChaos {
+		seed := testChaosSeed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		testConfig.Chaos = &testing.ChaosConfig{Seed: seed, Rate: testChaosRate, MaxDelay: testChaosMaxDelay}
+		fmt.Printf("🌪️  Chaos mode enabled (seed %d); rerun with --chaos-seed %d to reproduce\n", seed, seed)
 	}
 
 	// Parse category filter
@@ -391,6 +587,18 @@ func runTest(cmd *cobra.Command, args []string) error {
 	}
 	defer func() { _ = framework.Cleanup() }()
 
+	if testKubernetes != "" {
+		if err := framework.EnableKubernetes(testKubernetes); err != nil {
+			return fmt.Errorf("failed to set up Kubernetes mode: %w", err)
+		}
+	}
+
+	if testReuseInstances {
+		if err := framework.EnableInstanceReuse(testMaxIdleInstances); err != nil {
+			return err
+		}
+	}
+
 	// Load test scenarios using unified path determination
 	scenarioPath := testing.GetScenarioPath(testConfigPath)
 	scenarios, err := framework.Loader.LoadScenarios(scenarioPath)
@@ -408,17 +616,69 @@ func runTest(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if testLoad {
+		return runLoadTest(timeoutCtx, framework, scenarios)
+	}
+
+	suites, err := testing.LoadSuites(scenarioPath)
+	if err != nil {
+		return fmt.Errorf("failed to load test suites: %w", err)
+	}
+	testConfig.Suites = suites
+
 	// Execute test suite
 	result, err := framework.Runner.Run(timeoutCtx, testConfig, scenarios)
 	if err != nil {
 		return fmt.Errorf("test execution failed: %w", err)
 	}
 
+	if testHTMLReport != "" {
+		if err := testing.WriteHTMLReport(testHTMLReport, result); err != nil {
+			return err
+		}
+		fmt.Printf("🌐 HTML report saved to: %s\n", testHTMLReport)
+	}
+
+	if result.Coverage != nil && testCoverageReport != "" {
+		if err := writeCoverageReport(result.Coverage, testCoverageReport); err != nil {
+			return err
+		}
+		fmt.Printf("🧭 Tool coverage report saved to: %s\n", testCoverageReport)
+	}
+
+	if testFlakeDB != "" {
+		db, err := testing.LoadFlakeDB(testFlakeDB)
+		if err != nil {
+			return err
+		}
+		db.Record(result)
+		if err := db.Save(); err != nil {
+			return err
+		}
+		testing.PrintFlakeSummary(os.Stdout, db, result)
+	}
+
 	// Set exit code based on results
 	if result.FailedScenarios > 0 || result.ErrorScenarios > 0 {
 		os.Exit(1)
 	}
 
+	if result.Coverage != nil && result.Coverage.Percent < testCoverageThreshold {
+		return fmt.Errorf("tool coverage %.1f%% is below the required %.1f%%", result.Coverage.Percent, testCoverageThreshold)
+	}
+
+	return nil
+}
+
+// writeCoverageReport writes the tool coverage report as indented JSON.
+func writeCoverageReport(report *testing.ToolCoverageReport, filename string) error {
+	jsonData, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal coverage report to JSON: %w", err)
+	}
+	if err := os.WriteFile(filename, jsonData, 0o600); err != nil {
+		return fmt.Errorf("failed to write coverage report: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/giantswarm/muster/internal/testing"
+)
+
+// runLoadTest runs the --load mode against the scenario selected with
+// --scenario and fails if any SLO is violated.
+func runLoadTest(ctx context.Context, framework *testing.TestFramework, scenarios []testing.TestScenario) error {
+	var scenario *testing.TestScenario
+	var names []string
+	for i := range scenarios {
+		names = append(names, scenarios[i].Name)
+		if scenarios[i].Name == testScenario {
+			scenario = &scenarios[i]
+		}
+	}
+	if scenario == nil {
+		return fmt.Errorf("scenario %q not found; available: %s", testScenario, strings.Join(names, ", "))
+	}
+
+	cfg := testing.LoadTestConfig{
+		TPS:         testLoadTPS,
+		Duration:    testLoadDuration,
+		Concurrency: testLoadWorkers,
+		SLO: testing.LoadSLO{
+			P95:          testSLOP95,
+			P99:          testSLOP99,
+			MaxErrorRate: testSLOErrorRate,
+		},
+	}
+	report, err := testing.RunLoadTest(ctx, framework.InstanceManager, *scenario, cfg, framework.Logger, testDebug)
+	if report == nil {
+		return fmt.Errorf("load test failed: %w", err)
+	}
+	testing.PrintLoadTestReport(os.Stdout, report)
+	if err != nil {
+		return fmt.Errorf("load test interrupted after %s: %w", report.Elapsed, err)
+	}
+
+	if testReportPath != "" {
+		jsonData, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal load test report to JSON: %w", err)
+		}
+		if err := os.WriteFile(testReportPath, jsonData, 0o600); err != nil {
+			return fmt.Errorf("failed to write load test report: %w", err)
+		}
+		fmt.Printf("📄 Load test report saved to: %s\n", testReportPath)
+	}
+
+	if len(report.SLOViolations) > 0 {
+		return fmt.Errorf("load test violated %d SLO(s): %s", len(report.SLOViolations), strings.Join(report.SLOViolations, "; "))
+	}
+	return nil
+}
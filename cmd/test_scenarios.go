@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/giantswarm/muster/internal/cli"
+	"github.com/giantswarm/muster/internal/config"
+	"github.com/giantswarm/muster/internal/testing"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultScenarioSchema is the published API schema checked into the
+// repository root and regenerated with 'muster test --generate-schema'.
+const defaultScenarioSchema = "schema.json"
+
+var (
+	validateScenariosSchema  string
+	validateScenariosVerbose bool
+	validateScenariosStrict  bool
+
+	newScenarioFromWorkflow string
+	newScenarioConfigPath   string
+	newScenarioOutput       string
+)
+
+// validateScenariosCmd checks scenario files without running them.
+var validateScenariosCmd = &cobra.Command{
+	Use:   "validate-scenarios [path]",
+	Short: "Validate test scenario files against the scenario format and API schema",
+	Long: `Validate test scenario YAML files without starting muster.
+
+Each file is decoded strictly. Missing required fields (name, category,
+concept, steps, step id and tool), type errors, and duplicate scenario names
+are errors. Unknown fields are warnings: the test runner silently ignores
+them, so they usually indicate a typo or an option that does not exist; use
+--strict to fail on them. Scenarios without errors are then checked against
+the published API schema, which verifies that every core_* tool exists and
+that step args match its input schema.
+
+The path defaults to the built-in scenario directory and may point to a
+single file. The schema defaults to schema.json; if that file does not exist
+or describes no tools, the schema check is skipped.
+
+Examples:
+  muster test validate-scenarios
+  muster test validate-scenarios ./my-scenarios --schema api-v2.json
+  muster test validate-scenarios --strict
+  muster test validate-scenarios internal/testing/scenarios/workflow-advanced.yaml`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runValidateScenariosCmd,
+}
+
+// newScenarioCmd scaffolds a scenario from an existing workflow.
+var newScenarioCmd = &cobra.Command{
+	Use:   "new-scenario",
+	Short: "Scaffold a test scenario from an existing workflow",
+	Long: `Generate a starting-point test scenario for a workflow defined in a muster
+configuration directory.
+
+The scenario pre-configures the workflow, adds a mock MCP server stub for
+every downstream tool the workflow calls, checks that the workflow is
+available, and executes it with placeholder values for its required args.
+Refine the mock responses and expectations before committing the scenario.
+
+Examples:
+  muster test new-scenario --from-workflow deploy-app
+  muster test new-scenario --from-workflow deploy-app -o internal/testing/scenarios/deploy-app.yaml`,
+	Args: cobra.NoArgs,
+	RunE: runNewScenario,
+}
+
+func init() {
+	testCmd.AddCommand(validateScenariosCmd)
+	testCmd.AddCommand(newScenarioCmd)
+
+	validateScenariosCmd.Flags().StringVar(&validateScenariosSchema, "schema", defaultScenarioSchema, "API schema file to validate tool args against")
+	validateScenariosCmd.Flags().BoolVar(&validateScenariosVerbose, "verbose", false, "Show per-step validation results")
+	validateScenariosCmd.Flags().BoolVar(&validateScenariosStrict, "strict", false, "Treat unknown fields as errors")
+
+	newScenarioCmd.Flags().StringVar(&newScenarioFromWorkflow, "from-workflow", "", "Name of the workflow to scaffold a scenario for")
+	newScenarioCmd.Flags().StringVar(&newScenarioConfigPath, "config-path", config.GetDefaultConfigPathOrPanic(), "Configuration directory containing the workflow")
+	newScenarioCmd.Flags().StringVarP(&newScenarioOutput, "output", "o", "", "File to write the scenario to (default: stdout)")
+	_ = newScenarioCmd.MarkFlagRequired("from-workflow")
+}
+
+func runValidateScenariosCmd(cmd *cobra.Command, args []string) error {
+	path := testing.GetDefaultScenarioPath()
+	if len(args) == 1 {
+		path = args[0]
+	}
+
+	scenarios, issues, err := testing.CheckScenarioFiles(path)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	warnings := 0
+	for _, issue := range issues {
+		symbol := "❌"
+		if issue.Severity == testing.IssueSeverityWarning {
+			symbol = "⚠️ "
+			warnings++
+		}
+		_, _ = fmt.Fprintf(out, "%s %s: %s\n", symbol, issue.File, issue.Message)
+	}
+	fileErrors := len(issues) - warnings
+
+	schemaErrors := 0
+	schema, err := testing.LoadSchemaFromFile(validateScenariosSchema)
+	switch {
+	case err == nil && testing.SchemaToolCount(schema) == 0:
+		_, _ = fmt.Fprintf(out, "⚠️  %s describes no tools, skipping API schema validation (regenerate it with 'muster test --generate-schema')\n", validateScenariosSchema)
+	case err == nil:
+		results := testing.ValidateScenariosAgainstSchema(scenarios, schema, validateScenariosVerbose, false)
+		_, _ = fmt.Fprint(out, testing.FormatValidationResults(results, validateScenariosVerbose))
+		schemaErrors = results.TotalErrors
+	case errors.Is(err, os.ErrNotExist) && !cmd.Flags().Changed("schema"):
+		_, _ = fmt.Fprintf(out, "⚠️  %s not found, skipping API schema validation\n", validateScenariosSchema)
+	default:
+		return cli.NewValidationError("failed to load schema from %s: %v", validateScenariosSchema, err)
+	}
+
+	if fileErrors > 0 || schemaErrors > 0 || (validateScenariosStrict && warnings > 0) {
+		return cli.NewValidationError("scenario validation failed: %d error(s), %d warning(s), %d schema error(s)", fileErrors, warnings, schemaErrors)
+	}
+	_, _ = fmt.Fprintf(out, "\n✅ %d scenario(s) passed validation (%d warning(s))\n", len(scenarios), warnings)
+	return nil
+}
+
+func runNewScenario(cmd *cobra.Command, _ []string) error {
+	file := filepath.Join(newScenarioConfigPath, "workflows", newScenarioFromWorkflow+".yaml")
+	data, err := os.ReadFile(file) //nolint:gosec
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cli.NewValidationError("workflow %q not found in %s", newScenarioFromWorkflow, filepath.Dir(file))
+		}
+		return fmt.Errorf("failed to read workflow: %w", err)
+	}
+
+	scenario, err := testing.ScaffoldScenarioFromWorkflow(data)
+	if err != nil {
+		return cli.NewValidationError("cannot scaffold scenario from %s: %v", file, err)
+	}
+
+	if newScenarioOutput == "" {
+		return testing.EncodeScenarioYAML(cmd.OutOrStdout(), scenario)
+	}
+
+	if _, err := os.Stat(newScenarioOutput); err == nil {
+		return cli.NewValidationError("%s already exists", newScenarioOutput)
+	}
+	f, err := os.Create(newScenarioOutput) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("failed to create scenario file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if err := testing.EncodeScenarioYAML(f, scenario); err != nil {
+		return err
+	}
+	fmt.Printf("Scenario written to %s\n", newScenarioOutput)
+	return nil
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/cli"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	topFlags    cli.CommandFlags
+	topWatch    bool
+	topInterval time.Duration
+)
+
+// topCmd shows runtime resource usage of MCP servers and aggregator call rates.
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Show resource usage of MCP servers and aggregator call rates",
+	Long: `Display CPU and memory usage of the stdio MCP server processes managed by
+muster, together with the aggregator's tool call rates.
+
+Usage is sampled by the aggregator every 5 seconds; CPU is averaged over that
+window, where 100% equals one fully used core. Remote (streamable-http, sse)
+servers are listed without usage because muster does not manage their process.
+
+Examples:
+  muster top
+  muster top --watch
+  muster top --watch --interval 10s
+  muster top -o json
+
+Note: The aggregator server must be running (use 'muster serve') before using this command.`,
+	Args: cobra.NoArgs,
+	RunE: runTop,
+}
+
+func init() {
+	rootCmd.AddCommand(topCmd)
+	cli.RegisterCommonFlags(topCmd, &topFlags)
+	topCmd.Flags().BoolVarP(&topWatch, "watch", "w", false, "Refresh the display until interrupted")
+	topCmd.Flags().DurationVar(&topInterval, "interval", 5*time.Second, "Refresh interval in watch mode")
+}
+
+func runTop(cmd *cobra.Command, _ []string) error {
+	opts, err := topFlags.ToExecutorOptions()
+	if err != nil {
+		return err
+	}
+	humanOutput := opts.Format == cli.OutputFormatTable || opts.Format == cli.OutputFormatWide
+	if topWatch && !humanOutput {
+		return cli.NewValidationError("--watch only supports table and wide output")
+	}
+	if topInterval <= 0 {
+		return cli.NewValidationError("--interval must be positive, got %s", topInterval)
+	}
+
+	executor, err := cli.NewToolExecutor(opts)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = executor.Close() }()
+
+	ctx := cmd.Context()
+	if err := executor.Connect(ctx); err != nil {
+		return err
+	}
+
+	if !humanOutput {
+		return executor.Execute(ctx, "core_service_stats", nil)
+	}
+
+	for {
+		raw, err := executor.ExecuteJSON(ctx, "core_service_stats", nil)
+		if err != nil {
+			return err
+		}
+		stats, err := decodeRuntimeStats(raw)
+		if err != nil {
+			return err
+		}
+		if topWatch {
+			// Clear the screen and move the cursor home before redrawing.
+			fmt.Print("\033[H\033[2J")
+		}
+		if err := printTop(os.Stdout, stats, opts.Format == cli.OutputFormatWide, opts.NoHeaders); err != nil {
+			return err
+		}
+		if !topWatch {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(topInterval):
+		}
+	}
+}
+
+// decodeRuntimeStats converts the parsed core_service_stats result back into
+// its typed form.
+func decodeRuntimeStats(raw interface{}) (*api.RuntimeStats, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode stats: %w", err)
+	}
+	var stats api.RuntimeStats
+	if err := json.Unmarshal(data, &stats); err != nil {
+		return nil, fmt.Errorf("unexpected core_service_stats result: %w", err)
+	}
+	return &stats, nil
+}
+
+// printTop renders the server table followed by the aggregator call rates.
+// The wide format adds the server type and the busiest tools.
+func printTop(out io.Writer, stats *api.RuntimeStats, wide, noHeaders bool) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if !noHeaders {
+		if wide {
+			_, _ = fmt.Fprintln(w, "NAME\tTYPE\tSTATE\tPID\tCPU\tMEMORY\tNOTE")
+		} else {
+			_, _ = fmt.Fprintln(w, "NAME\tSTATE\tPID\tCPU\tMEMORY")
+		}
+	}
+	for _, s := range stats.Servers {
+		pid, cpu, mem := "-", "-", "-"
+		if s.Unavailable == "" {
+			pid = fmt.Sprintf("%d", s.PID)
+			cpu = fmt.Sprintf("%.1f%%", s.CPUPercent)
+			mem = formatBytes(s.MemoryBytes)
+		}
+		if wide {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", s.Name, s.Type, s.State, pid, cpu, mem, s.Unavailable)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", s.Name, s.State, pid, cpu, mem)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	agg := stats.Aggregator
+	if agg == nil {
+		return nil
+	}
+	_, _ = fmt.Fprintf(out, "\nAggregator: %.2f calls/s, %.2f errors/s (%d calls, %d errors total)\n",
+		agg.CallsPerSec, agg.ErrorsPerSec, agg.TotalCalls, agg.TotalErrors)
+	if !wide || len(agg.TopTools) == 0 {
+		return nil
+	}
+
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if !noHeaders {
+		_, _ = fmt.Fprintln(w, "TOOL\tCALLS/S\tTOTAL")
+	}
+	for _, t := range agg.TopTools {
+		_, _ = fmt.Fprintf(w, "%s\t%.2f\t%d\n", t.Tool, t.CallsPerSec, t.TotalCalls)
+	}
+	return w.Flush()
+}
+
+// formatBytes renders a byte count using binary units.
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := uint64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ci", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512B", formatBytes(512))
+	assert.Equal(t, "1.0Ki", formatBytes(1024))
+	assert.Equal(t, "1.5Mi", formatBytes(1536*1024))
+	assert.Equal(t, "2.0Gi", formatBytes(2*1024*1024*1024))
+}
+
+func TestPrintTop(t *testing.T) {
+	stats := &api.RuntimeStats{
+		Servers: []api.ProcessStats{
+			{Name: "kube", Type: "stdio", State: "running", PID: 7, CPUPercent: 3.25, MemoryBytes: 1024 * 1024},
+			{Name: "github", Type: "streamable-http", State: "running", Unavailable: "remote server, process not managed by muster"},
+		},
+		Aggregator: &api.AggregatorCallStats{
+			TotalCalls:  12,
+			CallsPerSec: 1.5,
+			TopTools:    []api.ToolCallRate{{Tool: "x_kube_get", CallsPerSec: 1.5, TotalCalls: 12}},
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, printTop(&out, stats, false, false))
+	assert.Contains(t, out.String(), "NAME")
+	assert.Contains(t, out.String(), "3.2%")
+	assert.Contains(t, out.String(), "1.0Mi")
+	assert.Contains(t, out.String(), "Aggregator: 1.50 calls/s")
+	assert.NotContains(t, out.String(), "x_kube_get")
+
+	out.Reset()
+	require.NoError(t, printTop(&out, stats, true, true))
+	assert.NotContains(t, out.String(), "NAME")
+	assert.Contains(t, out.String(), "not managed by muster")
+	assert.Contains(t, out.String(), "x_kube_get")
+}
+
+func TestDecodeRuntimeStats(t *testing.T) {
+	raw := map[string]interface{}{
+		"servers": []interface{}{map[string]interface{}{"name": "kube", "pid": 7.0, "memoryBytes": 2048.0}},
+	}
+	stats, err := decodeRuntimeStats(raw)
+	require.NoError(t, err)
+	require.Len(t, stats.Servers, 1)
+	assert.Equal(t, 7, stats.Servers[0].PID)
+	assert.Equal(t, uint64(2048), stats.Servers[0].MemoryBytes)
+}
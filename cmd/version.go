@@ -3,27 +3,36 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/giantswarm/muster/internal/agent"
 	"github.com/giantswarm/muster/internal/cli"
 	"github.com/giantswarm/muster/pkg/project"
 
+	"github.com/creativeprojects/go-selfupdate"
 	"github.com/spf13/cobra"
 )
 
 // versionCheckTimeout is the timeout for connecting to the server to retrieve version info.
 const versionCheckTimeout = 5 * time.Second
 
+// versionCheck makes the version command check for version skew with the
+// server and for a newer release.
+var versionCheck bool
+
 // newVersionCmd creates the Cobra command for displaying the application version.
 // The command displays both the CLI version (from build-time injection) and the
 // server version (if the muster aggregator is running).
 func newVersionCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Print the version number of muster CLI and server",
 		Long: `Displays the muster CLI version and, if the aggregator server is running,
-also displays the server version obtained from the MCP protocol handshake.`,
+also displays the server version obtained from the MCP protocol handshake.
+
+With --check, also reports whether the CLI and server versions may be
+incompatible and whether a newer release is available on GitHub.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "muster version %s\n", rootCmd.Version)
 			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "  commit: %s\n", project.GitSHA())
@@ -33,12 +42,72 @@ also displays the server version obtained from the MCP protocol handshake.`,
 			serverVersion, serverName, err := getServerVersion()
 			if err != nil {
 				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "\nServer: (not running)\n")
-				return
+			} else {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "\nServer: %s (%s)\n", serverVersion, serverName)
 			}
 
-			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "\nServer: %s (%s)\n", serverVersion, serverName)
+			if versionCheck {
+				ctx, cancel := context.WithTimeout(context.Background(), releaseCheckTimeout)
+				defer cancel()
+				latest, newer, latestErr := detectLatestVersion(ctx, rootCmd.Version)
+				printVersionCheck(cmd.OutOrStdout(), rootCmd.Version, serverVersion, latest, newer, latestErr)
+			}
 		},
 	}
+	cmd.Flags().BoolVar(&versionCheck, "check", false, "Check for version skew with the server and for a newer release")
+	return cmd
+}
+
+// releaseCheckTimeout bounds the lookup of the latest release on GitHub.
+const releaseCheckTimeout = 10 * time.Second
+
+// printVersionCheck reports the version skew between the CLI and the server,
+// if it is running, and the latest release, which is newer than the CLI if
+// newer is set.
+func printVersionCheck(out io.Writer, cliVersion, serverVersion, latest string, newer bool, latestErr error) {
+	_, _ = fmt.Fprintln(out)
+	if serverVersion != "" {
+		switch project.VersionSkew(cliVersion, serverVersion) {
+		case project.SkewNone:
+			_, _ = fmt.Fprintf(out, "Version skew: none\n")
+		case project.SkewCompatible:
+			_, _ = fmt.Fprintf(out, "Version skew: compatible (CLI %s, server %s)\n", cliVersion, serverVersion)
+		case project.SkewIncompatible:
+			_, _ = fmt.Fprintf(out, "Version skew: INCOMPATIBLE (CLI %s, server %s), upgrade the older one\n", cliVersion, serverVersion)
+		default:
+			_, _ = fmt.Fprintf(out, "Version skew: unknown, CLI %s or server %s is not a release\n", cliVersion, serverVersion)
+		}
+	}
+
+	switch {
+	case latestErr != nil:
+		_, _ = fmt.Fprintf(out, "Latest release: unknown (%v)\n", latestErr)
+	case newer:
+		_, _ = fmt.Fprintf(out, "Latest release: %s, run 'muster self-update' to update\n", latest)
+	case !project.IsRelease(cliVersion):
+		_, _ = fmt.Fprintf(out, "Latest release: %s\n", latest)
+	default:
+		_, _ = fmt.Fprintf(out, "Latest release: %s, up to date\n", latest)
+	}
+}
+
+// detectLatestVersion looks up the version of the latest release on GitHub
+// and whether it is newer than current. It is a variable so tests can replace
+// the network lookup.
+var detectLatestVersion = func(ctx context.Context, current string) (string, bool, error) {
+	updater, err := selfupdate.NewUpdater(selfupdate.Config{})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create updater: %w", err)
+	}
+	latest, found, err := updater.DetectLatest(ctx, selfupdate.ParseSlug(githubRepoSlug))
+	if err != nil {
+		return "", false, fmt.Errorf("error detecting latest version: %w", err)
+	}
+	if !found {
+		return "", false, fmt.Errorf("no release found for %s", githubRepoSlug)
+	}
+	newer := project.IsRelease(current) && latest.GreaterThan(current)
+	return "v" + latest.Version(), newer, nil
 }
 
 // getServerVersion attempts to connect to the muster aggregator and retrieve
@@ -125,3 +125,33 @@ func TestGetServerVersion_ReturnsInfo(t *testing.T) {
 		}
 	}
 }
+
+func TestPrintVersionCheck(t *testing.T) {
+	tests := []struct {
+		name          string
+		cliVersion    string
+		serverVersion string
+		latest        string
+		newer         bool
+		want          []string
+	}{
+		{"same version", "v1.2.0", "v1.2.0", "v1.2.0", false, []string{"Version skew: none", "Latest release: v1.2.0, up to date"}},
+		{"incompatible server", "v2.0.0", "v1.4.0", "v2.1.0", true, []string{"Version skew: INCOMPATIBLE (CLI v2.0.0, server v1.4.0)", "run 'muster self-update'"}},
+		{"compatible server", "v1.2.0", "v1.3.0", "v1.3.0", true, []string{"Version skew: compatible"}},
+		{"no server", "dev", "", "v1.3.0", false, []string{"Latest release: v1.3.0\n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			printVersionCheck(&buf, tt.cliVersion, tt.serverVersion, tt.latest, tt.newer, nil)
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("output %q does not contain %q", buf.String(), want)
+				}
+			}
+			if tt.serverVersion == "" && strings.Contains(buf.String(), "Version skew") {
+				t.Errorf("output %q reports skew without a server", buf.String())
+			}
+		})
+	}
+}
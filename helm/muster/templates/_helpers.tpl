@@ -43,6 +43,13 @@ app.kubernetes.io/managed-by: {{ .Release.Service }}
 application.giantswarm.io/team: {{ index .Chart.Annotations "io.giantswarm.application.team" | quote }}
 {{- end }}
 
+{{/*
+Discovery annotations, so "muster context discover" finds the aggregator endpoint
+*/}}
+{{- define "muster.discoveryAnnotations" -}}
+muster.giantswarm.io/aggregator-path: {{ ternary "/sse" "/mcp" (eq .Values.muster.aggregator.transport "sse") | quote }}
+{{- end }}
+
 {{/*
 Selector labels
 */}}
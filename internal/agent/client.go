@@ -14,7 +14,9 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 
 	agentoauth "github.com/giantswarm/muster/internal/agent/oauth"
+	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/internal/metatools"
+	"github.com/giantswarm/muster/pkg/project"
 )
 
 // TransportType defines the transport type for MCP connections.
@@ -76,6 +78,60 @@ type Client struct {
 	// continuousListening makes the streamable-http transport open a standalone
 	// GET stream to receive server-initiated notifications (e.g. events --follow).
 	continuousListening bool
+
+	// toolCallObserver is notified after every non-meta tool call, e.g. to
+	// record a REPL session into a test scenario.
+	toolCallObserver func(name string, args map[string]any, result *mcp.CallToolResult, err error)
+
+	// defaultNamespace is passed as the namespace argument of tools that take
+	// one when the caller does not set it.
+	defaultNamespace string
+}
+
+// SetDefaultNamespace sets the namespace passed to tools with a namespace
+// argument, such as the MCPServer and Workflow management tools, when the
+// caller does not set one. Pass "" to use the server's namespace.
+func (c *Client) SetDefaultNamespace(namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.defaultNamespace = namespace
+}
+
+// withDefaultNamespace returns args with the default namespace added if the
+// tool takes a namespace argument the caller did not set. args is not modified.
+func (c *Client) withDefaultNamespace(name string, args map[string]any) map[string]any {
+	c.mu.RLock()
+	namespace := c.defaultNamespace
+	c.mu.RUnlock()
+	if namespace == "" {
+		return args
+	}
+	if _, ok := args[api.NamespaceArg]; ok {
+		return args
+	}
+	tool := c.GetToolByName(name)
+	if tool == nil {
+		return args
+	}
+	if _, ok := tool.InputSchema.Properties[api.NamespaceArg]; !ok {
+		return args
+	}
+
+	withNamespace := make(map[string]any, len(args)+1)
+	for k, v := range args {
+		withNamespace[k] = v
+	}
+	withNamespace[api.NamespaceArg] = namespace
+	return withNamespace
+}
+
+// SetToolCallObserver registers a function that is called after every tool
+// call made through CallTool or CallToolWithTimeout, with the unwrapped result.
+// Meta-tool calls are not reported. Pass nil to remove the observer.
+func (c *Client) SetToolCallObserver(observer func(name string, args map[string]any, result *mcp.CallToolResult, err error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.toolCallObserver = observer
 }
 
 // SetContinuousListening enables a standalone server-to-client notification
@@ -427,7 +483,7 @@ func (c *Client) initialize(ctx context.Context) error {
 					}
 					return "muster-cli" // Programmatic/headless mode
 				}(),
-				Version: "1.0.0",
+				Version: project.Version(),
 			},
 			Capabilities: mcp.ClientCapabilities{},
 		},
@@ -459,6 +515,10 @@ func (c *Client) initialize(ctx context.Context) error {
 	}
 	c.mu.Unlock()
 
+	if c.logger != nil && project.VersionSkew(project.Version(), result.ServerInfo.Version) == project.SkewIncompatible {
+		c.logger.Info("Warning: muster %s may be incompatible with server version %s, upgrade the older one", project.Version(), result.ServerInfo.Version)
+	}
+
 	// Log response only if logger is available
 	if c.logger != nil {
 		c.logger.Response("initialize", result)
@@ -743,6 +803,9 @@ func (c *Client) listPrompts(ctx context.Context, initial bool) error {
 // These are the discovery and execution tools exposed directly by the aggregator.
 var metaToolNames = map[string]bool{
 	"call_tool":         true,
+	"call_tools":        true,
+	"tool_stats":        true,
+	"export_catalog":    true,
 	"list_tools":        true,
 	"describe_tool":     true,
 	"filter_tools":      true,
@@ -777,6 +840,8 @@ func (c *Client) wrapAndCallTool(ctx context.Context, name string, args map[stri
 		return callFn(ctx, name, args)
 	}
 
+	args = c.withDefaultNamespace(name, args)
+
 	// All other tools are wrapped through call_tool meta-tool
 	wrappedArgs := map[string]any{
 		"name":      name,
@@ -785,11 +850,24 @@ func (c *Client) wrapAndCallTool(ctx context.Context, name string, args map[stri
 
 	result, err := callFn(ctx, "call_tool", wrappedArgs)
 	if err != nil {
+		c.notifyToolCall(name, args, nil, err)
 		return nil, err
 	}
 
 	// Unwrap the nested response from call_tool
-	return c.unwrapMetaToolResponse(result, name)
+	result, err = c.unwrapMetaToolResponse(result, name)
+	c.notifyToolCall(name, args, result, err)
+	return result, err
+}
+
+// notifyToolCall reports a completed tool call to the observer, if any.
+func (c *Client) notifyToolCall(name string, args map[string]any, result *mcp.CallToolResult, err error) {
+	c.mu.RLock()
+	observer := c.toolCallObserver
+	c.mu.RUnlock()
+	if observer != nil {
+		observer(name, args, result, err)
+	}
 }
 
 // CallTool executes a tool on the MCP server with the provided arguments.
@@ -879,6 +957,11 @@ func (c *Client) unwrapMetaToolResponse(result *mcp.CallToolResult, toolName str
 
 	// Check if the meta-tool call itself failed
 	if result.IsError {
+		// A failure of the tool's handler carries its error code as structured
+		// content; return it as a tool error result so the code reaches the caller
+		if result.StructuredContent != nil {
+			return result, nil
+		}
 		var errorMsgs []string
 		for _, content := range result.Content {
 			if textContent, ok := mcp.AsTextContent(content); ok {
@@ -93,6 +93,30 @@ func TestGetToolByName(t *testing.T) {
 	assert.Nil(t, toolNil)
 }
 
+func TestWithDefaultNamespace(t *testing.T) {
+	client := NewClient("http://localhost:8090/mcp", NewLogger(false, false, false), TransportStreamableHTTP)
+	client.toolCache = []mcp.Tool{
+		{Name: "core_mcpserver_list", InputSchema: mcp.ToolInputSchema{Properties: map[string]any{"namespace": map[string]any{"type": "string"}}}},
+		{Name: "x_kubernetes_get", InputSchema: mcp.ToolInputSchema{Properties: map[string]any{"kind": map[string]any{"type": "string"}}}},
+	}
+
+	// Without a default namespace the args are passed through
+	args := map[string]any{}
+	assert.Equal(t, args, client.withDefaultNamespace("core_mcpserver_list", args))
+
+	client.SetDefaultNamespace("team-a")
+	assert.Equal(t, map[string]any{"namespace": "team-a"}, client.withDefaultNamespace("core_mcpserver_list", args))
+	assert.Empty(t, args, "the caller's args must not be modified")
+
+	// An explicit namespace wins
+	explicit := map[string]any{"namespace": "team-b"}
+	assert.Equal(t, explicit, client.withDefaultNamespace("core_mcpserver_list", explicit))
+
+	// Tools without a namespace argument and unknown tools are left alone
+	assert.Equal(t, map[string]any{"kind": "Pod"}, client.withDefaultNamespace("x_kubernetes_get", map[string]any{"kind": "Pod"}))
+	assert.Equal(t, map[string]any{}, client.withDefaultNamespace("unknown", map[string]any{}))
+}
+
 func TestGetResourceByURI(t *testing.T) {
 	logger := NewLogger(false, false, false)
 	client := NewClient("http://localhost:8090/mcp", logger, TransportStreamableHTTP)
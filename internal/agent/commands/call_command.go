@@ -4,20 +4,30 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
+// ArgPrompter reads the value of a tool argument from the user. It shows
+// prompt with defaultValue pre-filled and offers choices for completion. It
+// returns an error when the user aborts, e.g. with Ctrl+C.
+type ArgPrompter func(prompt, defaultValue string, choices []string) (string, error)
+
 // CallCommand executes tools with arguments
 type CallCommand struct {
 	*BaseCommand
+	prompt ArgPrompter
 }
 
-// NewCallCommand creates a new call command
-func NewCallCommand(client ClientInterface, output OutputLogger, transport TransportInterface) *CallCommand {
+// NewCallCommand creates a new call command. With a prompter, the command
+// asks for the required arguments a call leaves out; without one, it shows
+// the arguments of the tool instead.
+func NewCallCommand(client ClientInterface, output OutputLogger, transport TransportInterface, prompt ArgPrompter) *CallCommand {
 	return &CallCommand{
 		BaseCommand: NewBaseCommand(client, output, transport),
+		prompt:      prompt,
 	}
 }
 
@@ -61,8 +71,18 @@ func (c *CallCommand) Execute(ctx context.Context, args []string) error {
 		toolArgs = make(map[string]interface{})
 	}
 
+	// Ask for the required arguments the call leaves out
+	if c.prompt != nil && tool != nil {
+		if missing := c.getMissingParams(tool, toolArgs); len(missing) > 0 {
+			if err := c.promptForArgs(tool, missing, toolArgs); err != nil {
+				c.output.Info("Call of %s canceled", toolName)
+				return nil
+			}
+		}
+	}
+
 	// If no arguments provided, show the tool schema to help the user
-	if len(parsed) == 1 && tool != nil {
+	if c.prompt == nil && len(parsed) == 1 && tool != nil {
 		requiredParams := c.getRequiredParams(tool)
 		if len(requiredParams) > 0 {
 			c.showArgumentHelp(toolName, tool)
@@ -143,6 +163,140 @@ func (c *CallCommand) getRequiredParams(tool *mcp.Tool) []string {
 	return tool.InputSchema.Required
 }
 
+// getMissingParams returns the required parameters of a tool that args
+// leaves out, in the order the schema declares them.
+func (c *CallCommand) getMissingParams(tool *mcp.Tool, args map[string]interface{}) []string {
+	var missing []string
+	for _, name := range c.getRequiredParams(tool) {
+		if _, ok := args[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// promptForArgs asks for the value of each of the named parameters and adds
+// it to args. Each parameter is shown with its type, description, and
+// allowed values, its default is pre-filled, and invalid values are asked
+// for again. It returns the error of the prompter when the user aborts.
+func (c *CallCommand) promptForArgs(tool *mcp.Tool, names []string, args map[string]interface{}) error {
+	for _, name := range names {
+		propMap, _ := tool.InputSchema.Properties[name].(map[string]interface{})
+		paramType := getStringFromMap(propMap, "type", "")
+		description := getStringFromMap(propMap, "description", "")
+
+		var choices []string
+		enum, _ := propMap["enum"].([]interface{})
+		for _, value := range enum {
+			choices = append(choices, formatArgValue(value))
+		}
+		defaultValue := ""
+		if value, ok := propMap["default"]; ok {
+			defaultValue = formatArgValue(value)
+		}
+
+		label := name
+		if paramType != "" {
+			label = fmt.Sprintf("%s (%s)", name, paramType)
+		}
+		if description != "" {
+			c.output.OutputLine("%s: %s", name, description)
+		}
+		if len(choices) > 0 {
+			c.output.OutputLine("  one of: %s", strings.Join(choices, ", "))
+		}
+
+		for {
+			input, err := c.prompt(label+": ", defaultValue, choices)
+			if err != nil {
+				return err
+			}
+			value, err := parseArgValue(strings.TrimSpace(input), paramType, enum)
+			if err != nil {
+				c.output.Error("Invalid value for %s: %v", name, err)
+				continue
+			}
+			args[name] = value
+			break
+		}
+	}
+	return nil
+}
+
+// formatArgValue formats a value of a tool argument for display and
+// editing: strings as they are, other values as JSON.
+func formatArgValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
+
+// parseArgValue parses the input for a tool argument of the JSON schema type
+// paramType. Strings are taken as they are, other types are parsed as JSON.
+// Without a type, input is parsed as JSON if possible, like key=value
+// arguments. With enum, the value must be one of its values.
+func parseArgValue(input, paramType string, enum []interface{}) (interface{}, error) {
+	if input == "" {
+		return nil, fmt.Errorf("a value is required")
+	}
+
+	var value interface{}
+	switch paramType {
+	case "string":
+		value = input
+	case "":
+		if err := json.Unmarshal([]byte(input), &value); err != nil {
+			value = input
+		}
+	default:
+		if err := json.Unmarshal([]byte(input), &value); err != nil {
+			return nil, fmt.Errorf("expected a JSON %s", paramType)
+		}
+		if !matchesSchemaType(value, paramType) {
+			return nil, fmt.Errorf("expected %s, got %s", paramType, input)
+		}
+	}
+
+	if len(enum) > 0 {
+		for _, allowed := range enum {
+			if reflect.DeepEqual(value, allowed) {
+				return value, nil
+			}
+		}
+		return nil, fmt.Errorf("must be one of the listed values")
+	}
+	return value, nil
+}
+
+// matchesSchemaType reports whether a value decoded from JSON has the JSON
+// schema type paramType. Unknown types match any value.
+func matchesSchemaType(value interface{}, paramType string) bool {
+	switch paramType {
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == float64(int64(n))
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
 // getToolParams returns all parameter names for a tool.
 // Delegates to the shared getToolParamNames helper.
 func (c *CallCommand) getToolParams(tool *mcp.Tool) []string {
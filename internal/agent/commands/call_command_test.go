@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,6 +16,7 @@ type mockClientForCall struct {
 	prompts        []mcp.Prompt
 	callToolResult *mcp.CallToolResult
 	callToolError  error
+	callToolArgs   map[string]interface{} // arguments of the last tool call
 }
 
 func (m *mockClientForCall) GetToolCache() []mcp.Tool {
@@ -42,6 +44,7 @@ func (m *mockClientForCall) RefreshPromptCache(ctx context.Context) error {
 }
 
 func (m *mockClientForCall) CallTool(ctx context.Context, name string, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	m.callToolArgs = args
 	if m.callToolError != nil {
 		return nil, m.callToolError
 	}
@@ -134,7 +137,7 @@ func TestCallCommand_ParseKeyValueArgs(t *testing.T) {
 	client := &mockClientForCall{}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	tests := []struct {
 		name     string
@@ -214,7 +217,7 @@ func TestCallCommand_FindTool(t *testing.T) {
 	client := &mockClientForCall{tools: tools}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	// Test finding existing tool
 	tool := cmd.findTool("tool_one")
@@ -242,7 +245,7 @@ func TestCallCommand_GetRequiredParams(t *testing.T) {
 	client := &mockClientForCall{}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	required := cmd.getRequiredParams(tool)
 	assert.Equal(t, []string{"required_param"}, required)
@@ -268,7 +271,7 @@ func TestCallCommand_GetToolParams(t *testing.T) {
 	client := &mockClientForCall{}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	params := cmd.getToolParams(tool)
 	// Should be sorted alphabetically
@@ -316,7 +319,7 @@ func TestCallCommand_Execute_WithKeyValueArgs(t *testing.T) {
 	}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	// Execute with key=value syntax
 	err := cmd.Execute(context.Background(), []string{"kubernetes_list", "resourceType=pod", "namespace=default"})
@@ -357,7 +360,7 @@ func TestCallCommand_Execute_WithJSONArgs(t *testing.T) {
 	}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	// Execute with JSON syntax
 	err := cmd.Execute(context.Background(), []string{"test_tool", `{"param1": "value1"}`})
@@ -385,7 +388,7 @@ func TestCallCommand_Execute_ShowsHelpForRequiredParams(t *testing.T) {
 	client := &mockClientForCall{tools: tools}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	// Execute without arguments - should show help
 	err := cmd.Execute(context.Background(), []string{"test_tool"})
@@ -406,6 +409,113 @@ func TestCallCommand_Execute_ShowsHelpForRequiredParams(t *testing.T) {
 	assert.True(t, foundRequiredMarker, "Should show required parameter legend")
 }
 
+func TestCallCommand_Execute_PromptsForMissingRequiredArgs(t *testing.T) {
+	tools := []mcp.Tool{
+		{
+			Name: "scale",
+			InputSchema: mcp.ToolInputSchema{
+				Type: "object",
+				Properties: map[string]interface{}{
+					"name":     map[string]interface{}{"type": "string", "description": "Deployment name"},
+					"replicas": map[string]interface{}{"type": "integer", "default": float64(1)},
+					"strategy": map[string]interface{}{"type": "string", "enum": []interface{}{"rolling", "recreate"}},
+					"dryRun":   map[string]interface{}{"type": "boolean"},
+				},
+				Required: []string{"name", "replicas", "strategy"},
+			},
+		},
+	}
+
+	type promptCall struct {
+		prompt, defaultValue string
+		choices              []string
+	}
+	var prompts []promptCall
+	answers := []string{"2.5", "3", "blue-green", "rolling"}
+	prompter := func(prompt, defaultValue string, choices []string) (string, error) {
+		prompts = append(prompts, promptCall{prompt, defaultValue, choices})
+		answer := answers[0]
+		answers = answers[1:]
+		return answer, nil
+	}
+
+	client := &mockClientForCall{tools: tools}
+	cmd := NewCallCommand(client, &mockOutput{}, &mockTransport{}, prompter)
+
+	// name is given, so only replicas and strategy are asked for, and
+	// invalid values are asked for again
+	err := cmd.Execute(context.Background(), []string{"scale", "name=api"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "api", "replicas": float64(3), "strategy": "rolling"}, client.callToolArgs)
+	assert.Equal(t, []promptCall{
+		{"replicas (integer): ", "1", nil},
+		{"replicas (integer): ", "1", nil},
+		{"strategy (string): ", "", []string{"rolling", "recreate"}},
+		{"strategy (string): ", "", []string{"rolling", "recreate"}},
+	}, prompts)
+}
+
+func TestCallCommand_Execute_PromptCanceled(t *testing.T) {
+	tools := []mcp.Tool{
+		{
+			Name: "test_tool",
+			InputSchema: mcp.ToolInputSchema{
+				Type:       "object",
+				Properties: map[string]interface{}{"required_param": map[string]interface{}{"type": "string"}},
+				Required:   []string{"required_param"},
+			},
+		},
+	}
+
+	client := &mockClientForCall{tools: tools}
+	output := &mockOutput{}
+	cmd := NewCallCommand(client, output, &mockTransport{}, func(string, string, []string) (string, error) {
+		return "", errors.New("interrupted")
+	})
+
+	err := cmd.Execute(context.Background(), []string{"test_tool"})
+	assert.NoError(t, err)
+	assert.Nil(t, client.callToolArgs, "Should not call the tool")
+	assert.Contains(t, output.messages, "INFO: Call of %s canceled")
+}
+
+func TestParseArgValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		paramType string
+		enum      []interface{}
+		expected  interface{}
+		wantErr   bool
+	}{
+		{name: "string is taken as is", input: "42", paramType: "string", expected: "42"},
+		{name: "number", input: "1.5", paramType: "number", expected: 1.5},
+		{name: "integer", input: "3", paramType: "integer", expected: float64(3)},
+		{name: "fractional integer", input: "3.5", paramType: "integer", wantErr: true},
+		{name: "boolean", input: "true", paramType: "boolean", expected: true},
+		{name: "invalid boolean", input: "yes", paramType: "boolean", wantErr: true},
+		{name: "array", input: `["a"]`, paramType: "array", expected: []interface{}{"a"}},
+		{name: "object", input: `{"a":1}`, paramType: "object", expected: map[string]interface{}{"a": float64(1)}},
+		{name: "untyped JSON", input: "7", expected: float64(7)},
+		{name: "untyped string", input: "prod", expected: "prod"},
+		{name: "empty", input: "", paramType: "string", wantErr: true},
+		{name: "enum value", input: "b", paramType: "string", enum: []interface{}{"a", "b"}, expected: "b"},
+		{name: "not an enum value", input: "c", paramType: "string", enum: []interface{}{"a", "b"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := parseArgValue(tt.input, tt.paramType, tt.enum)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
 func TestCallCommand_Execute_ShowsHintForInvalidJSON(t *testing.T) {
 	tools := []mcp.Tool{
 		{
@@ -423,7 +533,7 @@ func TestCallCommand_Execute_ShowsHintForInvalidJSON(t *testing.T) {
 	client := &mockClientForCall{tools: tools}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	// Execute with invalid JSON syntax
 	err := cmd.Execute(context.Background(), []string{"test_tool", `{"param1": "value1",}`})
@@ -459,7 +569,7 @@ func TestCallCommand_Execute_ShowsNoOutputMessage(t *testing.T) {
 	}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	// Execute tool that returns no content
 	err := cmd.Execute(context.Background(), []string{"empty_tool"})
@@ -502,7 +612,7 @@ func TestCallCommand_Completions(t *testing.T) {
 	client := &mockClientForCall{tools: tools}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	// Test tool name completions
 	completions := cmd.Completions("call")
@@ -523,7 +633,7 @@ func TestCallCommand_Usage(t *testing.T) {
 	client := &mockClientForCall{}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	usage := cmd.Usage()
 	assert.Contains(t, usage, "key=value")
@@ -534,7 +644,7 @@ func TestCallCommand_Aliases(t *testing.T) {
 	client := &mockClientForCall{}
 	output := &mockOutput{}
 	transport := &mockTransport{}
-	cmd := NewCallCommand(client, output, transport)
+	cmd := NewCallCommand(client, output, transport, nil)
 
 	aliases := cmd.Aliases()
 	assert.Contains(t, aliases, "run")
@@ -0,0 +1,173 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/giantswarm/muster/internal/testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ToolCallObserverSetter registers a function that is called after every tool
+// call of the session, or removes it when passed nil.
+type ToolCallObserverSetter func(observer func(name string, args map[string]interface{}, result *mcp.CallToolResult, err error))
+
+// RecordCommand records the tool calls of the REPL session into a replayable
+// test scenario.
+type RecordCommand struct {
+	*BaseCommand
+	setObserver ToolCallObserverSetter
+	recorder    *testing.SessionRecorder
+}
+
+// NewRecordCommand creates a new record command
+func NewRecordCommand(client ClientInterface, output OutputLogger, transport TransportInterface, setObserver ToolCallObserverSetter) *RecordCommand {
+	return &RecordCommand{
+		BaseCommand: NewBaseCommand(client, output, transport),
+		setObserver: setObserver,
+	}
+}
+
+// Execute starts, inspects, saves, or cancels a recording
+func (r *RecordCommand) Execute(ctx context.Context, args []string) error {
+	parsed, err := r.parseArgs(args, 1, r.Usage())
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(parsed[0]) {
+	case "start":
+		if r.recorder != nil {
+			return fmt.Errorf("already recording (%d call(s) so far); use 'record stop <file>' or 'record cancel' first", len(r.recorder.Calls()))
+		}
+		r.recorder = testing.NewSessionRecorder()
+		r.setObserver(r.recorder.RecordToolCall)
+		r.output.Success("Recording tool calls. Use 'record stop <file>' to save them as a test scenario.")
+	case "status":
+		if r.recorder == nil {
+			r.output.OutputLine("Not recording")
+			return nil
+		}
+		r.output.OutputLine("Recording: %d tool call(s)", len(r.recorder.Calls()))
+	case "cancel":
+		if r.recorder == nil {
+			return fmt.Errorf("not recording")
+		}
+		r.stop()
+		r.output.Success("Recording discarded")
+	case "stop":
+		if len(parsed) < 2 {
+			return fmt.Errorf("usage: %s", r.Usage())
+		}
+		if r.recorder == nil {
+			return fmt.Errorf("not recording; use 'record start' first")
+		}
+		return r.save(ctx, parsed[1])
+	default:
+		return fmt.Errorf("invalid action: %s. Use 'start', 'status', 'stop <file>', or 'cancel'", parsed[0])
+	}
+	return nil
+}
+
+// stop detaches the recorder from the client.
+func (r *RecordCommand) stop() {
+	r.setObserver(nil)
+	r.recorder = nil
+}
+
+// save writes the recording as a scenario named after the file and stops
+// recording. The recording is kept if the file cannot be written.
+func (r *RecordCommand) save(ctx context.Context, file string) error {
+	if _, err := os.Stat(file); err == nil {
+		return fmt.Errorf("%s already exists", file)
+	}
+	recorder := r.recorder
+	if len(recorder.Calls()) == 0 {
+		return fmt.Errorf("no tool calls recorded yet")
+	}
+
+	// Fetch the definitions of executed workflows before detaching, without
+	// recording these lookups themselves.
+	r.setObserver(nil)
+	workflows := r.fetchWorkflows(ctx, recorder.WorkflowTools())
+
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	scenario := recorder.BuildScenario(name, r.client.GetToolCache(), workflows)
+
+	f, err := os.Create(file) //nolint:gosec
+	if err != nil {
+		r.setObserver(recorder.RecordToolCall)
+		return fmt.Errorf("failed to create scenario file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if err := testing.EncodeScenarioYAML(f, scenario); err != nil {
+		r.setObserver(recorder.RecordToolCall)
+		return err
+	}
+
+	r.stop()
+	r.output.Success("Recorded %d step(s) to %s", len(scenario.Steps), file)
+	if scenario.PreConfiguration != nil && len(scenario.PreConfiguration.MCPServers) > 0 {
+		r.output.OutputLine("Downstream MCP servers were replaced by mocks returning the recorded responses.")
+	}
+	r.output.OutputLine("Review the expectations before running it with 'muster test --config %s'.", file)
+	return nil
+}
+
+// fetchWorkflows loads workflow definitions with core_workflow_get. Workflows
+// that cannot be fetched are skipped with a warning.
+func (r *RecordCommand) fetchWorkflows(ctx context.Context, names []string) []testing.WorkflowConfig {
+	var workflows []testing.WorkflowConfig
+	for _, name := range names {
+		result, err := r.client.CallTool(ctx, "core_workflow_get", map[string]interface{}{"name": name})
+		if err != nil || result == nil || result.IsError || len(result.Content) == 0 {
+			r.output.Error("Could not fetch workflow %s; add it to pre_configuration manually", name)
+			continue
+		}
+		var response struct {
+			Workflow map[string]interface{} `json:"workflow"`
+		}
+		text, ok := mcp.AsTextContent(result.Content[0])
+		if !ok || json.Unmarshal([]byte(text.Text), &response) != nil || response.Workflow == nil {
+			r.output.Error("Could not parse workflow %s; add it to pre_configuration manually", name)
+			continue
+		}
+		var labels map[string]string
+		if raw, ok := response.Workflow["labels"].(map[string]interface{}); ok {
+			labels = make(map[string]string, len(raw))
+			for k, v := range raw {
+				labels[k] = fmt.Sprint(v)
+			}
+		}
+		for _, field := range []string{"labels", "available", "createdAt", "lastModified"} {
+			delete(response.Workflow, field)
+		}
+		workflows = append(workflows, testing.WorkflowConfig{Name: name, Labels: labels, Config: response.Workflow})
+	}
+	return workflows
+}
+
+// Usage returns the usage string
+func (r *RecordCommand) Usage() string {
+	return "record <start|status|stop <file>|cancel>"
+}
+
+// Description returns the command description
+func (r *RecordCommand) Description() string {
+	return "Record tool calls into a replayable test scenario"
+}
+
+// Completions returns possible completions
+func (r *RecordCommand) Completions(input string) []string {
+	return []string{"start", "status", "stop", "cancel"}
+}
+
+// Aliases returns command aliases
+func (r *RecordCommand) Aliases() []string {
+	return []string{"rec"}
+}
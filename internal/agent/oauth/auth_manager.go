@@ -75,6 +75,13 @@ type AuthManagerConfig struct {
 
 	// FileMode enables file-based token persistence.
 	FileMode bool
+
+	// ClientID is the OAuth client ID to log in with.
+	// Defaults to DefaultAgentClientID if not specified.
+	ClientID string
+
+	// IdP is the identity provider to log in with (see ClientConfig.IdP).
+	IdP string
 }
 
 // NewAuthManager creates a new auth manager.
@@ -85,6 +92,8 @@ func NewAuthManager(cfg AuthManagerConfig) (*AuthManager, error) {
 			StorageDir: cfg.TokenStorageDir,
 			FileMode:   cfg.FileMode,
 		},
+		ClientID: cfg.ClientID,
+		IdP:      cfg.IdP,
 	}
 
 	client, err := NewClient(clientCfg)
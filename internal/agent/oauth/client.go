@@ -99,6 +99,8 @@ type Client struct {
 	currentFlow   *AuthFlow
 	metadataCache map[string]*cachedMetadata
 	oauthClient   *pkgoauth.Client // Shared OAuth client for protocol operations
+	clientID      string
+	idp           string
 }
 
 // ClientConfig configures the OAuth client.
@@ -112,6 +114,15 @@ type ClientConfig struct {
 
 	// HTTPClient is an optional custom HTTP client.
 	HTTPClient *http.Client
+
+	// ClientID is the OAuth client ID to log in with.
+	// Defaults to DefaultAgentClientID if not specified.
+	ClientID string
+
+	// IdP is the identity provider to log in with. When set, it is passed to
+	// the authorization endpoint as connector_id, which Dex uses to skip its
+	// connector selection.
+	IdP string
 }
 
 // NewClient creates a new OAuth client with the specified configuration.
@@ -139,12 +150,19 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 		pkgoauth.WithMetadataCacheTTL(MetadataCacheTTL),
 	)
 
+	clientID := cfg.ClientID
+	if clientID == "" {
+		clientID = DefaultAgentClientID
+	}
+
 	return &Client{
 		tokenStore:    tokenStore,
 		httpClient:    httpClient,
 		callbackPort:  callbackPort,
 		metadataCache: make(map[string]*cachedMetadata),
 		oauthClient:   oauthClient,
+		clientID:      clientID,
+		idp:           cfg.IdP,
 	}, nil
 }
 
@@ -436,7 +454,7 @@ const DefaultAgentClientID = "https://giantswarm.github.io/muster/muster-agent.j
 func (c *Client) buildAuthorizationURLWithOptions(metadata *OAuthMetadata, redirectURI, state string, pkce *pkgoauth.PKCEChallenge, opts *AuthFlowOptions) (string, error) {
 	// Use golang.org/x/oauth2 Config for constructing the authorization URL
 	cfg := &oauth2.Config{
-		ClientID:    DefaultAgentClientID,
+		ClientID:    c.clientID,
 		RedirectURL: redirectURI,
 		Endpoint: oauth2.Endpoint{
 			AuthURL:  metadata.AuthorizationEndpoint,
@@ -465,6 +483,9 @@ func (c *Client) buildAuthorizationURLWithOptions(metadata *OAuthMetadata, redir
 		// Convert to oauth2 options
 		authOpts = append(authOpts, providers.ApplyAuthorizationURLOptions(providerOpts)...)
 	}
+	if c.idp != "" {
+		authOpts = append(authOpts, oauth2.SetAuthURLParam("connector_id", c.idp))
+	}
 
 	return cfg.AuthCodeURL(state, authOpts...), nil
 }
@@ -474,7 +495,7 @@ func (c *Client) buildAuthorizationURLWithOptions(metadata *OAuthMetadata, redir
 func (c *Client) exchangeCode(ctx context.Context, flow *AuthFlow, code string) (*oauth2.Token, error) {
 	// Create OAuth2 config for token exchange
 	cfg := &oauth2.Config{
-		ClientID:    DefaultAgentClientID,
+		ClientID:    c.clientID,
 		RedirectURL: flow.CallbackServer.GetRedirectURI(),
 		Endpoint: oauth2.Endpoint{
 			AuthURL:   flow.Metadata.AuthorizationEndpoint,
@@ -0,0 +1,53 @@
+package oauth
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	musterctx "github.com/giantswarm/muster/internal/context"
+)
+
+// AuthProfile selects the token store and OAuth client settings used to
+// authenticate to a muster endpoint. Each muster context can have its own
+// profile, so switching contexts never shares or overwrites tokens.
+//
+// The zero value is the default profile: tokens in ~/.config/muster/tokens,
+// logged in as DefaultAgentClientID.
+type AuthProfile struct {
+	// TokenStorageDir is the directory tokens are stored in.
+	TokenStorageDir string
+
+	// ClientID is the OAuth client ID to log in with.
+	ClientID string
+
+	// IdP is the identity provider to log in with (see ClientConfig.IdP).
+	IdP string
+}
+
+// ProfileForContext returns the auth profile of a muster context. A nil
+// context, or one without auth section, has the default profile. A token
+// directory starting with "~/" is relative to the home directory.
+func ProfileForContext(ctx *musterctx.Context) AuthProfile {
+	if ctx == nil || ctx.Auth == nil {
+		return AuthProfile{}
+	}
+	return AuthProfile{
+		TokenStorageDir: expandHome(ctx.Auth.TokenDir),
+		ClientID:        ctx.Auth.ClientID,
+		IdP:             ctx.Auth.IdP,
+	}
+}
+
+// expandHome replaces a leading "~/" in path with the home directory.
+func expandHome(path string) string {
+	rest, ok := strings.CutPrefix(path, "~/")
+	if !ok {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, rest)
+}
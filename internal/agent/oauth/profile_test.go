@@ -0,0 +1,53 @@
+package oauth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	musterctx "github.com/giantswarm/muster/internal/context"
+)
+
+func TestProfileForContext(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("no home directory: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		ctx  *musterctx.Context
+		want AuthProfile
+	}{
+		{
+			name: "nil context has the default profile",
+			ctx:  nil,
+			want: AuthProfile{},
+		},
+		{
+			name: "context without auth has the default profile",
+			ctx:  &musterctx.Context{Name: "dev", Endpoint: "https://dev.example.com/mcp"},
+			want: AuthProfile{},
+		},
+		{
+			name: "context auth is copied",
+			ctx: &musterctx.Context{Name: "prod", Auth: &musterctx.ContextAuth{
+				TokenDir: "/var/lib/muster/prod", ClientID: "muster-prod", IdP: "github",
+			}},
+			want: AuthProfile{TokenStorageDir: "/var/lib/muster/prod", ClientID: "muster-prod", IdP: "github"},
+		},
+		{
+			name: "token dir relative to home is expanded",
+			ctx:  &musterctx.Context{Name: "prod", Auth: &musterctx.ContextAuth{TokenDir: "~/.config/muster/tokens-prod"}},
+			want: AuthProfile{TokenStorageDir: filepath.Join(home, ".config/muster/tokens-prod")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProfileForContext(tt.ctx); got != tt.want {
+				t.Errorf("ProfileForContext() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,13 @@ func SetupOAuthConfig(serverURL string) (*transport.OAuthConfig, *AgentTokenStor
 // SetupOAuthConfigWithDir creates an AgentTokenStore with a custom storage directory.
 // If tokenStorageDir is empty, defaults to ~/.config/muster/tokens/.
 func SetupOAuthConfigWithDir(serverURL, tokenStorageDir string) (*transport.OAuthConfig, *AgentTokenStore, error) {
+	return SetupOAuthConfigWithProfile(serverURL, AuthProfile{TokenStorageDir: tokenStorageDir})
+}
+
+// SetupOAuthConfigWithProfile creates an AgentTokenStore in the token directory
+// of profile, and an OAuthConfig that refreshes tokens as the profile's client.
+func SetupOAuthConfigWithProfile(serverURL string, profile AuthProfile) (*transport.OAuthConfig, *AgentTokenStore, error) {
+	tokenStorageDir := profile.TokenStorageDir
 	if tokenStorageDir == "" {
 		var err error
 		tokenStorageDir, err = pkgoauth.DefaultTokenDir()
@@ -40,8 +47,13 @@ func SetupOAuthConfigWithDir(serverURL, tokenStorageDir string) (*transport.OAut
 	normalizedURL := pkgoauth.NormalizeServerURL(serverURL)
 	agentStore := NewAgentTokenStore(normalizedURL, tokenStore)
 
+	clientID := profile.ClientID
+	if clientID == "" {
+		clientID = DefaultAgentClientID
+	}
+
 	config := &transport.OAuthConfig{
-		ClientID:    DefaultAgentClientID,
+		ClientID:    clientID,
 		TokenStore:  agentStore,
 		Scopes:      agentOAuthScopes,
 		PKCEEnabled: true,
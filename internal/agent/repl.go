@@ -74,12 +74,20 @@ type REPL struct {
 	stopChan         chan struct{}
 	wg               sync.WaitGroup
 	commandRegistry  *commands.Registry
-	currentContext   string // Current muster context name for prompt display
-	authRequired     bool   // Whether any servers require authentication
-	useUnicode       bool   // Whether to use unicode characters in prompt
+	currentContext   string                 // Current muster context name for prompt display
+	authRequired     bool                   // Whether any servers require authentication
+	useUnicode       bool                   // Whether to use unicode characters in prompt
+	authProfile      agentoauth.AuthProfile // Token store and OAuth client of the current context
+	namespace        string                 // Namespace set with -n, overriding the context's namespace
 	mu               sync.RWMutex
 }
 
+// authProfileSwitcher is implemented by auth handlers that can switch to the
+// token store of another context.
+type authProfileSwitcher interface {
+	SetAuthProfile(profile agentoauth.AuthProfile) error
+}
+
 // NewREPL creates a new REPL instance with the specified client and logger.
 // It initializes the command registry and registers all available commands
 // with their respective aliases and completion handlers.
@@ -244,6 +252,77 @@ func (r *REPL) updatePrompt() {
 	}
 }
 
+// SetAuthProfile sets the auth profile the REPL authenticates with. It is
+// the profile of the context the REPL was started in; switching contexts
+// switches to the new context's profile.
+func (r *REPL) SetAuthProfile(profile agentoauth.AuthProfile) {
+	r.mu.Lock()
+	r.authProfile = profile
+	r.mu.Unlock()
+}
+
+// getAuthProfile returns the auth profile the REPL authenticates with.
+func (r *REPL) getAuthProfile() agentoauth.AuthProfile {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.authProfile
+}
+
+// SetNamespace sets the namespace the REPL's tools default to instead of
+// the namespace of the current context, as with the -n flag. Pass "" to use
+// the namespace of the current context.
+func (r *REPL) SetNamespace(namespace string) {
+	r.mu.Lock()
+	r.namespace = namespace
+	r.mu.Unlock()
+}
+
+// currentContextConfig loads the configuration of the current context.
+func (r *REPL) currentContextConfig() (*musterctx.Context, error) {
+	r.mu.RLock()
+	name := r.currentContext
+	r.mu.RUnlock()
+
+	storage, err := musterctx.NewStorage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize context storage: %w", err)
+	}
+	ctxConfig, err := storage.GetContext(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load context %q: %w", name, err)
+	}
+	return ctxConfig, nil
+}
+
+// applyContextNamespace makes the tools default to the namespace of the
+// current context, unless a namespace was set with SetNamespace.
+func (r *REPL) applyContextNamespace(ctxConfig *musterctx.Context) {
+	r.mu.RLock()
+	namespace := r.namespace
+	r.mu.RUnlock()
+	if namespace == "" && ctxConfig != nil {
+		namespace = ctxConfig.Namespace
+	}
+	r.client.SetDefaultNamespace(namespace)
+}
+
+// applyContextAuthProfile switches the REPL and the auth handler to the auth
+// profile of the current context. It reports whether the profile changed.
+func (r *REPL) applyContextAuthProfile(ctxConfig *musterctx.Context) bool {
+	profile := agentoauth.ProfileForContext(ctxConfig)
+	if profile == r.getAuthProfile() {
+		return false
+	}
+	r.SetAuthProfile(profile)
+
+	if switcher, ok := api.GetAuthHandler().(authProfileSwitcher); ok {
+		if err := switcher.SetAuthProfile(profile); err != nil {
+			r.logger.Debug("Failed to switch auth profile: %v", err)
+		}
+	}
+	return true
+}
+
 // setCurrentContext updates the current context and refreshes the prompt.
 // This is called by the context command when switching contexts.
 func (r *REPL) setCurrentContext(name string) {
@@ -256,21 +335,37 @@ func (r *REPL) setCurrentContext(name string) {
 
 // reconnectToEndpoint reconnects the client to a new endpoint.
 // This is called when switching to a context with a different endpoint.
-// Returns nil if the endpoint hasn't changed.
+// Returns nil if neither the endpoint nor the context's auth profile changed.
+// Tokens are read from the token store of the new context.
 //
 // If authentication fails (401), this method will automatically attempt to
 // re-authenticate using the auth handler, then retry the connection.
 func (r *REPL) reconnectToEndpoint(ctx context.Context, newEndpoint string) error {
+	ctxConfig, err := r.currentContextConfig()
+	if err != nil {
+		r.logger.Debug("%v", err)
+	}
+	r.applyContextNamespace(ctxConfig)
+	profileChanged := err == nil && r.applyContextAuthProfile(ctxConfig)
 	currentEndpoint := r.client.GetEndpoint()
-	if currentEndpoint == newEndpoint {
+	if currentEndpoint == newEndpoint && !profileChanged {
 		r.logger.Debug("Same endpoint, skipping reconnection")
 		return nil // Same endpoint, no reconnection needed
 	}
 
+	// Use the new context's token store for the new endpoint
+	if isRemoteEndpoint(newEndpoint) {
+		oauthCfg, agentStore, err := agentoauth.SetupOAuthConfigWithProfile(newEndpoint, r.getAuthProfile())
+		if err != nil {
+			return fmt.Errorf("failed to set up OAuth: %w", err)
+		}
+		r.client.SetOAuthConfig(*oauthCfg, agentStore)
+	}
+
 	// Show connecting indicator
 	r.logger.Output("Connecting...")
 
-	err := r.client.Reconnect(ctx, newEndpoint)
+	err = r.client.Reconnect(ctx, newEndpoint)
 	if err != nil {
 		// Check if this is an authentication error
 		if isAuthError(err) {
@@ -321,7 +416,7 @@ func (r *REPL) authenticateForEndpoint(ctx context.Context, endpoint string) err
 	}
 
 	// Set up mcp-go OAuth transport for this endpoint
-	oauthCfg, agentStore, err := agentoauth.SetupOAuthConfig(endpoint)
+	oauthCfg, agentStore, err := agentoauth.SetupOAuthConfigWithProfile(endpoint, r.getAuthProfile())
 	if err != nil {
 		return fmt.Errorf("failed to set up OAuth: %w", err)
 	}
@@ -394,7 +489,7 @@ func (r *REPL) checkAuthRequired() {
 //   - help: Command documentation and usage information
 //   - list: Display available tools, resources, and prompts
 //   - describe: Detailed information about specific items
-//   - call: Execute tools with argument validation
+//   - call: Execute tools, prompting for missing required arguments
 //   - get: Retrieve resources and execute prompts
 //   - prompt: Template-based prompt execution
 //   - filter: Advanced pattern-based tool filtering
@@ -413,16 +508,42 @@ func (r *REPL) registerCommands() {
 	r.commandRegistry.Register("help", commands.NewHelpCommand(r.client, r.logger, transport, r.commandRegistry))
 	r.commandRegistry.Register("list", commands.NewListCommand(r.client, r.logger, transport))
 	r.commandRegistry.Register("describe", commands.NewDescribeCommand(r.client, r.logger, transport))
-	r.commandRegistry.Register("call", commands.NewCallCommand(r.client, r.logger, transport))
+	r.commandRegistry.Register("call", commands.NewCallCommand(r.client, r.logger, transport, r.readArgument))
 	r.commandRegistry.Register("get", commands.NewGetCommand(r.client, r.logger, transport))
 	r.commandRegistry.Register("prompt", commands.NewPromptCommand(r.client, r.logger, transport))
 	r.commandRegistry.Register("filter", commands.NewFilterCommand(r.client, r.logger, transport))
 	r.commandRegistry.Register("notifications", commands.NewNotificationsCommand(r.client, r.logger, transport))
 	r.commandRegistry.Register("workflow", commands.NewWorkflowCommand(r.client, r.logger, transport))
 	r.commandRegistry.Register("context", commands.NewContextCommand(r.client, r.logger, transport, r.setCurrentContext, r.reconnectToEndpoint))
+	r.commandRegistry.Register("record", commands.NewRecordCommand(r.client, r.logger, transport, r.client.SetToolCallObserver))
 	r.commandRegistry.Register("exit", commands.NewExitCommand(r.client, r.logger, transport))
 }
 
+// readArgument reads the value of a tool argument for the call command, with
+// defaultValue pre-filled and choices offered for completion. The value is
+// not added to the command history.
+func (r *REPL) readArgument(prompt, defaultValue string, choices []string) (string, error) {
+	if r.rl == nil {
+		return "", fmt.Errorf("no interactive terminal")
+	}
+
+	var items []readline.PrefixCompleterInterface
+	for _, choice := range choices {
+		items = append(items, readline.PcItem(choice))
+	}
+	completer := r.rl.Config.AutoComplete
+	r.rl.Config.AutoComplete = readline.NewPrefixCompleter(items...)
+	r.rl.HistoryDisable()
+	r.rl.SetPrompt(prompt)
+	defer func() {
+		r.rl.Config.AutoComplete = completer
+		r.rl.HistoryEnable()
+		r.updatePrompt()
+	}()
+
+	return r.rl.ReadlineWithDefault(defaultValue)
+}
+
 // transportAdapter adapts Client to TransportInterface for the command system.
 // This adapter enables commands to query transport capabilities without
 // directly depending on the Client implementation, maintaining clean
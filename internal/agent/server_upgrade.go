@@ -88,6 +88,48 @@ func registerAgentTools(m *MCPServer) {
 	)
 	m.mcpServer.AddTool(callToolTool, m.forwardToServerMetaTool("call_tool"))
 
+	// Call tools
+	callToolsTool := mcp.NewTool("call_tools",
+		mcp.WithDescription("Execute several tools concurrently and return the result of each call"),
+		mcp.WithArray("calls",
+			mcp.Required(),
+			mcp.Description("Tool calls to execute, each with the tool name and its args (as JSON object)"),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"tool": map[string]any{"type": "string"},
+					"args": map[string]any{"type": "object"},
+				},
+				"required": []string{"tool"},
+			}),
+		),
+		mcp.WithNumber("concurrency",
+			mcp.Description("Maximum number of calls executed at once (default: 4)"),
+		),
+	)
+	m.mcpServer.AddTool(callToolsTool, m.forwardToServerMetaTool("call_tools"))
+
+	// Tool stats
+	toolStatsTool := mcp.NewTool("tool_stats",
+		mcp.WithDescription("Report call counts, error rates, and latency of each tool since muster started, and the available tools that were never called"),
+		mcp.WithString("sort_by",
+			mcp.Description("Order of the tools, highest first: calls, errors, error_rate, latency, or name (default: calls)"),
+		),
+		mcp.WithString("pattern",
+			mcp.Description("Pattern to match against tool names (supports wildcards like *)"),
+		),
+	)
+	m.mcpServer.AddTool(toolStatsTool, m.forwardToServerMetaTool("tool_stats"))
+
+	// Export catalog
+	exportCatalogTool := mcp.NewTool("export_catalog",
+		mcp.WithDescription("Export the complete catalog of tools with their input schemas, resources, and prompts, grouped by server, as a single JSON document"),
+		mcp.WithString("server",
+			mcp.Description("Only export the catalog of this server (\"muster\" for the core tools)"),
+		),
+	)
+	m.mcpServer.AddTool(exportCatalogTool, m.forwardToServerMetaTool("export_catalog"))
+
 	// Get resource
 	getResourceTool := mcp.NewTool("get_resource",
 		mcp.WithDescription("Retrieve the contents of a resource"),
@@ -0,0 +1,71 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/logging"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// registerArtifactResourceTemplate registers the
+// execution://{execution_id}/{step_id}/output resource template serving the
+// artifacts of workflow steps with the MCP server.
+func (a *AggregatorServer) registerArtifactResourceTemplate() {
+	a.mu.RLock()
+	mcpServer := a.mcpServer
+	a.mu.RUnlock()
+
+	if mcpServer == nil {
+		logging.Warn("Aggregator", "Cannot register artifact resource template: MCP server not initialized")
+		return
+	}
+
+	template := mcp.NewResourceTemplate(
+		api.ExecutionArtifactURITemplate,
+		"workflow_step_artifact",
+		mcp.WithTemplateDescription("Result of a workflow step that stores its result as an artifact (artifact: true) instead of inlining it into the workflow's response."),
+	)
+
+	mcpServer.AddResourceTemplate(template, a.handleArtifactResource)
+	logging.Info("Aggregator", "Registered %s resource template", api.ExecutionArtifactURITemplate)
+}
+
+// handleArtifactResource handles requests for workflow step artifacts.
+func (a *AggregatorServer) handleArtifactResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	result, err := a.readArtifactResource(ctx, request.Params.URI)
+	if err != nil {
+		return nil, err
+	}
+	return result.Contents, nil
+}
+
+// readArtifactResource reads the workflow step artifact at uri.
+func (a *AggregatorServer) readArtifactResource(ctx context.Context, uri string) (*mcp.ReadResourceResult, error) {
+	executionID, stepID, ok := api.ParseExecutionArtifactURI(uri)
+	if !ok {
+		return nil, fmt.Errorf("invalid artifact URI %q, expected %s", uri, api.ExecutionArtifactURITemplate)
+	}
+
+	provider, ok := api.GetWorkflow().(api.WorkflowArtifactProvider)
+	if !ok {
+		return nil, fmt.Errorf("workflow artifacts are not available")
+	}
+
+	artifact, err := provider.GetStepArtifact(ctx, executionID, stepID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []mcp.ResourceContents{
+			mcp.TextResourceContents{
+				URI:      uri,
+				MIMEType: artifact.MIMEType,
+				Text:     artifact.Data,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,90 @@
+package aggregator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// authActionLogout is the auth event action of a logout, which is not an
+// authentication attempt.
+const authActionLogout = "logout"
+
+// authActionTokenExpired is the auth event action of a token an MCP server
+// rejected as expired or invalid.
+const authActionTokenExpired = "token_expired"
+
+// authAttempt is the outcome of the last authentication attempt of a user to
+// an MCP server.
+type authAttempt struct {
+	action string
+	failed bool
+	err    string
+	at     time.Time
+}
+
+// authAttemptTracker records the last authentication attempt per user subject
+// and server from the auth events on the event bus, so auth://status can
+// report when and how authentication last failed.
+type authAttemptTracker struct {
+	mu       sync.RWMutex
+	attempts map[string]map[string]authAttempt // sub -> serverName -> attempt
+}
+
+func newAuthAttemptTracker() *authAttemptTracker {
+	return &authAttemptTracker{attempts: make(map[string]map[string]authAttempt)}
+}
+
+// subscribe starts recording the auth events on the event bus. It returns a
+// function that stops recording.
+func (t *authAttemptTracker) subscribe() func() {
+	return api.SubscribeEvents(api.EventFilter{Kinds: []api.EventKind{api.EventKindAuth}}, func(event api.Event) {
+		if payload, ok := event.Payload.(api.AuthEvent); ok {
+			t.record(payload)
+		}
+	})
+}
+
+// record stores event as the last attempt of its subject to its server. A
+// logout forgets the attempts, since the user starts over.
+func (t *authAttemptTracker) record(event api.AuthEvent) {
+	if event.Subject == "" || event.ServerName == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if event.Action == authActionLogout {
+		if m, ok := t.attempts[event.Subject]; ok {
+			delete(m, event.ServerName)
+			if len(m) == 0 {
+				delete(t.attempts, event.Subject)
+			}
+		}
+		return
+	}
+
+	// Events are delivered asynchronously, so an older attempt must not
+	// replace a newer one.
+	if prev, ok := t.attempts[event.Subject][event.ServerName]; ok && prev.at.After(event.Timestamp) {
+		return
+	}
+	if t.attempts[event.Subject] == nil {
+		t.attempts[event.Subject] = make(map[string]authAttempt)
+	}
+	t.attempts[event.Subject][event.ServerName] = authAttempt{
+		action: event.Action,
+		failed: event.Outcome == "failure",
+		err:    event.Error,
+		at:     event.Timestamp,
+	}
+}
+
+// last returns the last attempt of sub to serverName.
+func (t *authAttemptTracker) last(sub, serverName string) (authAttempt, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	attempt, ok := t.attempts[sub][serverName]
+	return attempt, ok
+}
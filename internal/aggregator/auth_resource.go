@@ -3,6 +3,8 @@ package aggregator
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -62,12 +64,35 @@ func (a *AggregatorServer) registerAuthStatusResource() {
 func (a *AggregatorServer) handleAuthStatusResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
 	sub := getUserSubjectFromContext(ctx)
 	sessionID := getSessionIDFromContext(ctx)
-	hasSession := sub != "" && sessionID != ""
-	if !hasSession {
+	if sub == "" || sessionID == "" {
 		logging.Warn("Aggregator", "handleAuthStatusResource: missing session context (hasSub=%t, hasSessionID=%t) — returning infrastructure-level status only",
 			sub != "", sessionID != "")
 	}
 
+	response := a.authStatus(ctx)
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      AuthStatusResourceURI,
+			MIMEType: "application/json",
+			Text:     string(data),
+		},
+	}, nil
+}
+
+// authStatus builds the auth status of all MCP servers for the user and
+// session carried by ctx, or their infrastructure-level status without a
+// session. It backs both the auth://status resource and the REST gateway.
+func (a *AggregatorServer) authStatus(ctx context.Context) pkgoauth.AuthStatusResponse {
+	sub := getUserSubjectFromContext(ctx)
+	sessionID := getSessionIDFromContext(ctx)
+	hasSession := sub != "" && sessionID != ""
+
 	servers := a.registry.GetAllServers()
 	response := pkgoauth.AuthStatusResponse{Servers: make([]pkgoauth.ServerAuthStatus, 0, len(servers))}
 
@@ -100,10 +125,11 @@ func (a *AggregatorServer) handleAuthStatusResource(ctx context.Context, request
 		}
 
 		if info.AuthInfo != nil {
+			status.Issuer = info.AuthInfo.Issuer
+			status.Scope = info.AuthInfo.Scope
+			status.RequiredScopes = strings.Fields(info.AuthInfo.Scope)
 			switch status.Status {
 			case pkgoauth.SessionServerStatusAuthRequired, pkgoauth.SessionServerStatusReauthRequired:
-				status.Issuer = info.AuthInfo.Issuer
-				status.Scope = info.AuthInfo.Scope
 				if status.Status == pkgoauth.SessionServerStatusReauthRequired ||
 					(!status.TokenForwardingEnabled && !status.TokenExchangeEnabled) {
 					status.AuthTool = "core_auth_login"
@@ -111,24 +137,90 @@ func (a *AggregatorServer) handleAuthStatusResource(ctx context.Context, request
 			}
 		}
 
-		response.Servers = append(response.Servers, status)
-	}
+		var attempt *authAttempt
+		if hasSession && a.authAttempts != nil {
+			if last, ok := a.authAttempts.last(sub, name); ok {
+				attempt = &last
+				status.LastAttempt = &last.at
+			}
+		}
+		addAuthRemediation(&status, info, attempt)
 
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
+		response.Servers = append(response.Servers, status)
 	}
 
 	logging.Debug("Aggregator", "Returning auth status for %d servers (sub=%s)",
 		len(response.Servers), sub)
 
-	return []mcp.ResourceContents{
-		mcp.TextResourceContents{
-			URI:      AuthStatusResourceURI,
-			MIMEType: "application/json",
-			Text:     string(data),
-		},
-	}, nil
+	return response
+}
+
+// addAuthRemediation sets the failure category and remediation of status
+// from its session status, the infrastructure state of the server, and the
+// user's last authentication attempt, which is nil if unknown.
+func addAuthRemediation(status *pkgoauth.ServerAuthStatus, info *ServerInfo, attempt *authAttempt) {
+	failed := func(category pkgoauth.AuthFailureCategory, action pkgoauth.RemediationAction, hint string, args ...any) {
+		status.FailureCategory = category
+		status.Remediation = action
+		status.RemediationHint = fmt.Sprintf(hint, args...)
+	}
+	loginHint := "Run: muster auth login"
+	if status.AuthTool != "" && status.Status == pkgoauth.SessionServerStatusAuthRequired {
+		loginHint = "Run: muster auth login --server " + status.Name
+	}
+
+	switch status.Status {
+	case pkgoauth.SessionServerStatusConnected, pkgoauth.SessionServerStatusSSOPending:
+		return
+
+	case pkgoauth.SessionServerStatusUnreachable:
+		failed(pkgoauth.AuthFailureUnreachable, pkgoauth.RemediationUnreachable,
+			"MCP server %s cannot be reached, check that it is running and reachable from muster", status.Name)
+
+	case pkgoauth.SessionServerStatusReauthRequired:
+		failed(pkgoauth.AuthFailureTokenExpired, pkgoauth.RemediationLogin,
+			"Your session for %s expired and could not be refreshed. %s", status.Name, loginHint)
+
+	case pkgoauth.SessionServerStatusAuthRequired:
+		switch {
+		case info.AuthInfo == nil:
+			failed(pkgoauth.AuthFailureMisconfigured, pkgoauth.RemediationMisconfigured,
+				"MCP server %s requires authentication but announced no OAuth issuer, check its configuration", status.Name)
+		case status.SSOAttemptFailed && (status.TokenForwardingEnabled || status.TokenExchangeEnabled):
+			// Logging in does not help while the server rejects muster's token
+			mechanism := "forwarded ID token"
+			if status.TokenExchangeEnabled {
+				mechanism = "exchanged token"
+			}
+			failed(pkgoauth.AuthFailureSSOFailed, pkgoauth.RemediationMisconfigured,
+				"SSO to %s failed, it rejected muster's %s. Check that it trusts muster's identity provider and audience", status.Name, mechanism)
+		case attempt != nil && attempt.failed && attempt.action == authActionTokenExpired:
+			failed(pkgoauth.AuthFailureTokenExpired, pkgoauth.RemediationLogin,
+				"MCP server %s rejected your token as expired or invalid. %s", status.Name, loginHint)
+		case attempt != nil && attempt.failed && strings.Contains(strings.ToLower(attempt.err), "scope"):
+			failed(pkgoauth.AuthFailureScopeMismatch, pkgoauth.RemediationLogin,
+				"MCP server %s rejected the scopes of your token. Run: muster auth logout --server %s && muster auth login --server %s", status.Name, status.Name, status.Name)
+		case attempt != nil && attempt.failed:
+			failed(pkgoauth.AuthFailureLoginFailed, pkgoauth.RemediationLogin,
+				"Authentication (%s) to %s failed. %s", attempt.action, status.Name, loginHint)
+		default:
+			failed(pkgoauth.AuthFailureNotAuthenticated, pkgoauth.RemediationLogin, "%s", loginHint)
+		}
+
+	default:
+		switch info.GetStatus() {
+		case api.StateFailed, api.StateError:
+			failed(pkgoauth.AuthFailureMisconfigured, pkgoauth.RemediationMisconfigured,
+				"MCP server %s failed, check its configuration and logs", status.Name)
+		default:
+			failed(pkgoauth.AuthFailureUnreachable, pkgoauth.RemediationUnreachable,
+				"MCP server %s is not connected, check that it is running and reachable from muster", status.Name)
+		}
+	}
+
+	if attempt != nil && attempt.failed && status.Error == "" {
+		status.Error = attempt.err
+	}
 }
 
 // determineSessionAuthStatus determines the auth/connection status for a specific
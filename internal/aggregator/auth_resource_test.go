@@ -783,3 +783,167 @@ func TestInitSSOForSession_PersistsIDToken(t *testing.T) {
 		}
 	})
 }
+
+func TestAuthStatus_RemediationFromLastAttempt(t *testing.T) {
+	attempts := newAuthAttemptTracker()
+	aggServer := &AggregatorServer{
+		registry:     NewServerRegistry("x"),
+		authAttempts: attempts,
+	}
+
+	err := aggServer.registry.RegisterPendingAuth(PendingAuthRegistration{
+		ServerRegistration: ServerRegistration{Name: "github", ToolPrefix: "gh"},
+		URL:                "https://github.example.com",
+		AuthInfo:           &AuthInfo{Issuer: "https://dex.example.com", Scope: "openid repo"},
+	})
+	if err != nil {
+		t.Fatalf("failed to register server: %v", err)
+	}
+
+	srv := aggServer.authStatus(testSessionCtx()).Servers[0]
+	assert.Equal(t, pkgoauth.AuthFailureNotAuthenticated, srv.FailureCategory)
+	assert.Equal(t, pkgoauth.RemediationLogin, srv.Remediation)
+	assert.Equal(t, "Run: muster auth login --server github", srv.RemediationHint)
+	assert.Equal(t, []string{"openid", "repo"}, srv.RequiredScopes)
+	assert.Nil(t, srv.LastAttempt)
+
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	attempts.record(api.AuthEvent{
+		Action: authActionTokenExpired, Outcome: "failure", ServerName: "github",
+		Subject: "test-user", Error: "401 Unauthorized", Timestamp: at,
+	})
+
+	srv = aggServer.authStatus(testSessionCtx()).Servers[0]
+	assert.Equal(t, pkgoauth.AuthFailureTokenExpired, srv.FailureCategory)
+	assert.Equal(t, pkgoauth.RemediationLogin, srv.Remediation)
+	assert.Equal(t, "401 Unauthorized", srv.Error)
+	if assert.NotNil(t, srv.LastAttempt) {
+		assert.True(t, srv.LastAttempt.Equal(at))
+	}
+
+	// Attempts are per user, and not reported without a session
+	srv = aggServer.authStatus(context.Background()).Servers[0]
+	assert.Nil(t, srv.LastAttempt)
+	assert.Equal(t, pkgoauth.AuthFailureNotAuthenticated, srv.FailureCategory)
+}
+
+func TestAddAuthRemediation(t *testing.T) {
+	authInfo := &AuthInfo{Issuer: "https://dex.example.com"}
+	tests := []struct {
+		name         string
+		status       pkgoauth.SessionServerStatus
+		authTool     string
+		sso          bool
+		info         *ServerInfo
+		attempt      *authAttempt
+		wantCategory pkgoauth.AuthFailureCategory
+		wantAction   pkgoauth.RemediationAction
+	}{
+		{
+			name:   "connected",
+			status: pkgoauth.SessionServerStatusConnected,
+			info:   &ServerInfo{Name: "srv"},
+		},
+		{
+			name:   "sso pending",
+			status: pkgoauth.SessionServerStatusSSOPending,
+			info:   &ServerInfo{Name: "srv", AuthInfo: authInfo},
+		},
+		{
+			name:         "unreachable",
+			status:       pkgoauth.SessionServerStatusUnreachable,
+			info:         &ServerInfo{Name: "srv"},
+			wantCategory: pkgoauth.AuthFailureUnreachable,
+			wantAction:   pkgoauth.RemediationUnreachable,
+		},
+		{
+			name:         "disconnected",
+			status:       pkgoauth.SessionServerStatusDisconnected,
+			info:         &ServerInfo{Name: "srv"},
+			wantCategory: pkgoauth.AuthFailureUnreachable,
+			wantAction:   pkgoauth.RemediationUnreachable,
+		},
+		{
+			name:         "reauth required",
+			status:       pkgoauth.SessionServerStatusReauthRequired,
+			authTool:     "core_auth_login",
+			info:         &ServerInfo{Name: "srv", AuthInfo: authInfo},
+			wantCategory: pkgoauth.AuthFailureTokenExpired,
+			wantAction:   pkgoauth.RemediationLogin,
+		},
+		{
+			name:         "sso failed",
+			status:       pkgoauth.SessionServerStatusAuthRequired,
+			sso:          true,
+			info:         &ServerInfo{Name: "srv", AuthInfo: authInfo},
+			attempt:      &authAttempt{action: "token_forwarding", failed: true, err: "401 Unauthorized"},
+			wantCategory: pkgoauth.AuthFailureSSOFailed,
+			wantAction:   pkgoauth.RemediationMisconfigured,
+		},
+		{
+			name:         "scope mismatch",
+			status:       pkgoauth.SessionServerStatusAuthRequired,
+			authTool:     "core_auth_login",
+			info:         &ServerInfo{Name: "srv", AuthInfo: authInfo},
+			attempt:      &authAttempt{action: "login", failed: true, err: "403 insufficient_scope"},
+			wantCategory: pkgoauth.AuthFailureScopeMismatch,
+			wantAction:   pkgoauth.RemediationLogin,
+		},
+		{
+			name:         "auth required without issuer",
+			status:       pkgoauth.SessionServerStatusAuthRequired,
+			info:         &ServerInfo{Name: "srv"},
+			wantCategory: pkgoauth.AuthFailureMisconfigured,
+			wantAction:   pkgoauth.RemediationMisconfigured,
+		},
+		{
+			name:         "login failed",
+			status:       pkgoauth.SessionServerStatusAuthRequired,
+			authTool:     "core_auth_login",
+			info:         &ServerInfo{Name: "srv", AuthInfo: authInfo},
+			attempt:      &authAttempt{action: "login", failed: true, err: "invalid_grant"},
+			wantCategory: pkgoauth.AuthFailureLoginFailed,
+			wantAction:   pkgoauth.RemediationLogin,
+		},
+		{
+			name:         "last login succeeded",
+			status:       pkgoauth.SessionServerStatusAuthRequired,
+			authTool:     "core_auth_login",
+			info:         &ServerInfo{Name: "srv", AuthInfo: authInfo},
+			attempt:      &authAttempt{action: "login"},
+			wantCategory: pkgoauth.AuthFailureNotAuthenticated,
+			wantAction:   pkgoauth.RemediationLogin,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := pkgoauth.ServerAuthStatus{Name: "srv", Status: tt.status, AuthTool: tt.authTool,
+				TokenForwardingEnabled: tt.sso, SSOAttemptFailed: tt.sso}
+			addAuthRemediation(&status, tt.info, tt.attempt)
+			assert.Equal(t, tt.wantCategory, status.FailureCategory)
+			assert.Equal(t, tt.wantAction, status.Remediation)
+			assert.Equal(t, tt.wantAction == "", status.RemediationHint == "")
+		})
+	}
+}
+
+func TestAuthAttemptTracker(t *testing.T) {
+	tracker := newAuthAttemptTracker()
+	now := time.Now()
+
+	tracker.record(api.AuthEvent{Action: "login", Outcome: "failure", ServerName: "github", Subject: "alice", Timestamp: now})
+	// An older event delivered late does not replace the last attempt
+	tracker.record(api.AuthEvent{Action: "login", Outcome: "success", ServerName: "github", Subject: "alice", Timestamp: now.Add(-time.Minute)})
+	// Events without a subject are not attributable to a user
+	tracker.record(api.AuthEvent{Action: "login", Outcome: "success", ServerName: "github", Timestamp: now})
+
+	attempt, ok := tracker.last("alice", "github")
+	assert.True(t, ok)
+	assert.True(t, attempt.failed)
+	_, ok = tracker.last("bob", "github")
+	assert.False(t, ok)
+
+	tracker.record(api.AuthEvent{Action: authActionLogout, Outcome: "success", ServerName: "github", Subject: "alice", Timestamp: now})
+	_, ok = tracker.last("alice", "github")
+	assert.False(t, ok)
+}
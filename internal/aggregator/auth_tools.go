@@ -254,6 +254,7 @@ func (p *AuthToolProvider) handleAuthLogin(ctx context.Context, args map[string]
 			if p.aggregator.authMetrics != nil {
 				p.aggregator.authMetrics.RecordLoginSuccess(serverName, sub)
 			}
+			publishAuthEvent("login", serverName, sub, nil)
 			if p.aggregator.authRateLimiter != nil {
 				p.aggregator.authRateLimiter.Reset(sub)
 			}
@@ -264,12 +265,14 @@ func (p *AuthToolProvider) handleAuthLogin(ctx context.Context, args map[string]
 		if is401Error(connectErr) {
 			logging.Info("AuthTools", "Token for server %s is expired/invalid, clearing and requesting fresh auth", serverName)
 			oauthHandler.ClearTokenByIssuer(sessionID, authInfo.Issuer)
+			publishAuthEvent(authActionTokenExpired, serverName, sub, connectErr)
 		} else {
 			// Some other error - report it
 			logging.Error("AuthTools", connectErr, "Failed to connect to server %s with existing token", serverName)
 			if p.aggregator.authMetrics != nil {
 				p.aggregator.authMetrics.RecordLoginFailure(serverName, sub, "connection_failed")
 			}
+			publishAuthEvent("login", serverName, sub, connectErr)
 			return &api.CallToolResult{
 				Content: []any{fmt.Sprintf(
 					"Failed to connect to '%s': %v\n\nPlease try again or check the server status.",
@@ -399,6 +402,7 @@ func (p *AuthToolProvider) handleAuthLogout(ctx context.Context, args map[string
 	if p.aggregator.authMetrics != nil {
 		p.aggregator.authMetrics.RecordLogoutSuccess(serverName, sub)
 	}
+	publishAuthEvent(authActionLogout, serverName, sub, nil)
 
 	return &api.CallToolResult{
 		Content: []any{fmt.Sprintf(
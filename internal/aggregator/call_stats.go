@@ -0,0 +1,144 @@
+package aggregator
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// maxLastErrorLen caps the error message kept per tool.
+const maxLastErrorLen = 200
+
+// callStats keeps cumulative in-process tool call counters. Unlike the OTEL
+// instruments in Metrics, these are readable without an exporter and back the
+// call rates shown by `muster top`, and the per-tool usage served by the
+// tool_stats meta-tool and the stats://tools resource.
+type callStats struct {
+	mu      sync.Mutex
+	calls   uint64
+	errors  uint64
+	perTool map[string]uint64
+	usage   map[string]*toolUsage
+}
+
+// toolUsage accumulates the calls of one tool as recorded by recordUsage.
+type toolUsage struct {
+	calls        uint64
+	errors       uint64
+	totalLatency time.Duration
+	maxLatency   time.Duration
+	lastCalledAt time.Time
+	lastError    string
+}
+
+func newCallStats() *callStats {
+	return &callStats{perTool: make(map[string]uint64), usage: make(map[string]*toolUsage)}
+}
+
+// middleware counts every tool call passing through the aggregator.
+func (c *callStats) middleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			res, err := next(ctx, req)
+			c.record(req.Params.Name, classify(res, err) != outcomeOK)
+			return res, err
+		}
+	}
+}
+
+func (c *callStats) record(tool string, failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	if failed {
+		c.errors++
+	}
+	c.perTool[tool]++
+}
+
+// recordUsage records a call of tool that started at started and returned res
+// and err. It is called by CallToolInternal, which every tool call goes
+// through, whether it comes from call_tool, call_tools or a workflow step.
+func (c *callStats) recordUsage(tool string, started time.Time, res *mcp.CallToolResult, err error) {
+	latency := time.Since(started)
+	lastError := callErrorMessage(res, err)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	u, ok := c.usage[tool]
+	if !ok {
+		u = &toolUsage{}
+		c.usage[tool] = u
+	}
+	u.calls++
+	u.totalLatency += latency
+	u.maxLatency = max(u.maxLatency, latency)
+	if started.After(u.lastCalledAt) {
+		u.lastCalledAt = started
+	}
+	if classify(res, err) != outcomeOK {
+		u.errors++
+		u.lastError = lastError
+	}
+}
+
+// callErrorMessage returns the message of a failed call: the Go error, or the
+// first text content of an error result.
+func callErrorMessage(res *mcp.CallToolResult, err error) string {
+	var msg string
+	switch {
+	case err != nil:
+		msg = err.Error()
+	case res != nil && res.IsError:
+		msg = "error result"
+		for _, content := range res.Content {
+			if text, ok := mcp.AsTextContent(content); ok {
+				msg = text.Text
+				break
+			}
+		}
+	default:
+		return ""
+	}
+	if len(msg) > maxLastErrorLen {
+		msg = msg[:maxLastErrorLen] + "..."
+	}
+	return msg
+}
+
+// ToolCallTotals implements api.ToolCallStatsProvider.
+func (c *callStats) ToolCallTotals() api.ToolCallTotals {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return api.ToolCallTotals{
+		Calls:   c.calls,
+		Errors:  c.errors,
+		PerTool: maps.Clone(c.perTool),
+	}
+}
+
+// ToolUsage implements api.ToolCallStatsProvider.
+func (c *callStats) ToolUsage() []api.ToolUsageStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	usage := make([]api.ToolUsageStats, 0, len(c.usage))
+	for tool, u := range c.usage {
+		usage = append(usage, api.ToolUsageStats{
+			Tool:         tool,
+			Calls:        u.calls,
+			Errors:       u.errors,
+			ErrorRate:    float64(u.errors) / float64(u.calls),
+			AvgLatencyMs: float64(u.totalLatency.Microseconds()) / float64(u.calls) / 1000,
+			MaxLatencyMs: float64(u.maxLatency.Microseconds()) / 1000,
+			LastCalledAt: u.lastCalledAt,
+			LastError:    u.lastError,
+		})
+	}
+	return usage
+}
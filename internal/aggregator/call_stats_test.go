@@ -0,0 +1,50 @@
+package aggregator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallStats_RecordUsage(t *testing.T) {
+	c := newCallStats()
+	started := time.Now().Add(-50 * time.Millisecond)
+
+	c.recordUsage("x_ok", started, &mcp.CallToolResult{}, nil)
+	c.recordUsage("x_broken", started, nil, errors.New("connection refused"))
+	c.recordUsage("x_broken", started.Add(time.Millisecond), &mcp.CallToolResult{
+		IsError: true,
+		Content: []mcp.Content{mcp.NewTextContent(strings.Repeat("x", 300))},
+	}, nil)
+
+	usage := map[string]int{}
+	got := c.ToolUsage()
+	require.Len(t, got, 2)
+	for i, u := range got {
+		usage[u.Tool] = i
+	}
+
+	ok := got[usage["x_ok"]]
+	assert.Equal(t, uint64(1), ok.Calls)
+	assert.Zero(t, ok.Errors)
+	assert.Zero(t, ok.ErrorRate)
+	assert.Empty(t, ok.LastError)
+	assert.GreaterOrEqual(t, ok.AvgLatencyMs, 50.0)
+	assert.Equal(t, started, ok.LastCalledAt)
+
+	broken := got[usage["x_broken"]]
+	assert.Equal(t, uint64(2), broken.Calls)
+	assert.Equal(t, uint64(2), broken.Errors)
+	assert.Equal(t, 1.0, broken.ErrorRate)
+	assert.GreaterOrEqual(t, broken.MaxLatencyMs, broken.AvgLatencyMs)
+	assert.Equal(t, started.Add(time.Millisecond), broken.LastCalledAt)
+	assert.Len(t, broken.LastError, maxLastErrorLen+len("..."))
+
+	// Usage is recorded apart from the protocol-level call totals.
+	assert.Zero(t, c.ToolCallTotals().Calls)
+}
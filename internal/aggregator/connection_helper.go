@@ -361,6 +361,7 @@ func EstablishConnectionWithTokenForwarding(
 
 		// Emit event for token forwarding failure
 		emitTokenForwardingEvent(serverInfo.Name, serverInfo.GetNamespace(), false, err.Error())
+		publishAuthEvent("token_forwarding", serverInfo.Name, sub, err)
 
 		return nil, fmt.Errorf("ID token forwarding failed: %w", err)
 	}
@@ -369,6 +370,7 @@ func EstablishConnectionWithTokenForwarding(
 	logging.Info("Connection", "ID token forwarding succeeded for user %s to server %s",
 		logging.TruncateIdentifier(sub), serverInfo.Name)
 	emitTokenForwardingEvent(serverInfo.Name, serverInfo.GetNamespace(), true, "")
+	publishAuthEvent("token_forwarding", serverInfo.Name, sub, nil)
 
 	// Fetch tools from the server
 	tools, err := client.ListTools(ctx)
@@ -608,6 +610,7 @@ func EstablishConnectionWithTokenExchange(
 
 		// Emit event for token exchange failure
 		emitTokenExchangeEvent(serverInfo.Name, serverInfo.GetNamespace(), false, err.Error())
+		publishAuthEvent("token_exchange", serverInfo.Name, sub, err)
 
 		// Audit log for failed token exchange (compliance/security monitoring)
 		logging.Audit(logging.AuditEvent{
@@ -627,6 +630,7 @@ func EstablishConnectionWithTokenExchange(
 	logging.Info("Connection", "Token exchange succeeded for user %s to server %s",
 		logging.TruncateIdentifier(sub), serverInfo.Name)
 	emitTokenExchangeEvent(serverInfo.Name, serverInfo.GetNamespace(), true, "")
+	publishAuthEvent("token_exchange", serverInfo.Name, sub, nil)
 
 	// Audit log for successful token exchange (compliance/security monitoring)
 	logging.Audit(logging.AuditEvent{
@@ -758,6 +762,22 @@ func emitTokenExchangeEvent(serverName, namespace string, success bool, errorMsg
 	})
 }
 
+// publishAuthEvent publishes the outcome of an authentication action for a
+// user and MCP server on the API event bus. A nil err means success.
+func publishAuthEvent(action, serverName, subject string, err error) {
+	event := api.AuthEvent{
+		Action:     action,
+		Outcome:    "success",
+		ServerName: serverName,
+		Subject:    subject,
+	}
+	if err != nil {
+		event.Outcome = "failure"
+		event.Error = err.Error()
+	}
+	api.PublishAuthEvent(event)
+}
+
 // tokenExchangeRefreshMargin is the time before expiry at which a pooled
 // token-exchange client triggers a background re-exchange. Dex access tokens
 // typically live for 30 minutes, so a 5-minute margin gives ample time for
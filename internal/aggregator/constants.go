@@ -5,3 +5,25 @@ import "time"
 // httpReadHeaderTimeout caps how long the HTTP servers wait for request
 // headers. Bounds Slowloris-style request-header attacks.
 const httpReadHeaderTimeout = 30 * time.Second
+
+// Defaults of the registration of MCP servers, used when the aggregator
+// configuration does not set them.
+const (
+	defaultConnectConcurrency = 8
+	defaultConnectTimeout     = 30 * time.Second
+)
+
+// Defaults of the liveness monitoring of the connections to the MCP servers,
+// used when the aggregator configuration does not set them.
+const (
+	defaultLivenessInterval         = 30 * time.Second
+	defaultLivenessTimeout          = 10 * time.Second
+	defaultLivenessFailureThreshold = 3
+)
+
+// Defaults of the warm standby, used when the aggregator configuration does
+// not set them.
+const (
+	defaultStandbyLockFile     = "aggregator.lock"
+	defaultStandbyPollInterval = time.Second
+)
@@ -140,5 +140,8 @@ func eventFollowOptions(args map[string]interface{}) api.EventQueryOptions {
 	if v, ok := args["eventType"].(string); ok {
 		options.EventType = v
 	}
+	if v, ok := args["reason"].(string); ok {
+		options.Reason = v
+	}
 	return options
 }
@@ -3,6 +3,7 @@ package aggregator
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/internal/events"
@@ -34,6 +35,14 @@ type EventHandler struct {
 	isServerAuthRequired func(string) bool                   // Callback to check if server is in auth_required state
 	isServerSSOBased     func(string) bool                   // Callback to check if server uses SSO token forwarding/exchange
 
+	// Events are processed by a bounded pool of workers, in order per
+	// server, so a slow server does not hold up the registration of others.
+	workers      int                                       // Number of events processed at the same time
+	timeout      time.Duration                             // Time budget of each registration
+	workerSlots  chan struct{}                             // Semaphore of the workers
+	queueMu      sync.Mutex                                // Protects serverQueues
+	serverQueues map[string][]api.ServiceStateChangedEvent // Events waiting per server; a server has a queue while its events are processed
+
 	// Lifecycle management
 	ctx        context.Context    // Context for coordinating shutdown
 	cancelFunc context.CancelFunc // Function to cancel the context
@@ -69,6 +78,23 @@ func NewEventHandler(
 		deregisterFunc:       deregisterFunc,
 		isServerAuthRequired: isServerAuthRequired,
 		isServerSSOBased:     isServerSSOBased,
+		workers:              defaultConnectConcurrency,
+		timeout:              defaultConnectTimeout,
+		serverQueues:         make(map[string][]api.ServiceStateChangedEvent),
+	}
+}
+
+// SetRegistrationLimits sets the number of events processed at the same time
+// and the time budget of each registration. Non-positive values keep the
+// defaults. It must be called before Start.
+func (eh *EventHandler) SetRegistrationLimits(workers int, timeout time.Duration) {
+	eh.mu.Lock()
+	defer eh.mu.Unlock()
+	if workers > 0 {
+		eh.workers = workers
+	}
+	if timeout > 0 {
+		eh.timeout = timeout
 	}
 }
 
@@ -95,6 +121,7 @@ func (eh *EventHandler) Start(ctx context.Context) error {
 	}
 
 	eh.ctx, eh.cancelFunc = context.WithCancel(ctx)
+	eh.workerSlots = make(chan struct{}, eh.workers)
 	eh.running = true
 
 	// Subscribe to state changes from the orchestrator
@@ -181,8 +208,56 @@ func (eh *EventHandler) handleEvents(eventChan <-chan api.ServiceStateChangedEve
 				return
 			}
 
-			eh.processEvent(event)
+			eh.dispatchEvent(event)
+		}
+	}
+}
+
+// dispatchEvent queues event behind the other events of its server and, if
+// none are being processed, starts processing them.
+func (eh *EventHandler) dispatchEvent(event api.ServiceStateChangedEvent) {
+	if !eh.isMCPServiceEvent(event) {
+		return
+	}
+
+	eh.queueMu.Lock()
+	defer eh.queueMu.Unlock()
+
+	queued, processing := eh.serverQueues[event.Name]
+	eh.serverQueues[event.Name] = append(queued, event)
+	if !processing {
+		eh.wg.Add(1)
+		go eh.processServerEvents(event.Name)
+	}
+}
+
+// processServerEvents processes the queued events of a server in order, each
+// in a worker slot, until its queue is empty.
+func (eh *EventHandler) processServerEvents(name string) {
+	defer eh.wg.Done()
+
+	for {
+		eh.queueMu.Lock()
+		queued := eh.serverQueues[name]
+		if len(queued) == 0 {
+			delete(eh.serverQueues, name)
+			eh.queueMu.Unlock()
+			return
+		}
+		event := queued[0]
+		eh.serverQueues[name] = queued[1:]
+		eh.queueMu.Unlock()
+
+		select {
+		case eh.workerSlots <- struct{}{}:
+		case <-eh.ctx.Done():
+			eh.queueMu.Lock()
+			delete(eh.serverQueues, name)
+			eh.queueMu.Unlock()
+			return
 		}
+		eh.processEvent(event)
+		<-eh.workerSlots
 	}
 }
 
@@ -228,7 +303,10 @@ func (eh *EventHandler) processEvent(event api.ServiceStateChangedEvent) {
 		// Register the healthy running/connected server
 		logging.Info("Aggregator-EventHandler", "Registering healthy MCP server: %s", event.Name)
 
-		if err := eh.registerFunc(context.Background(), event.Name); err != nil {
+		registerCtx, cancel := context.WithTimeout(eh.ctx, eh.timeout)
+		err := eh.registerFunc(registerCtx, event.Name)
+		cancel()
+		if err != nil {
 			logging.Error("Aggregator-EventHandler", err, "Failed to register MCP server %s", event.Name)
 			// Generate event for failed tool registration
 			eh.generateEvent(event.Name, events.ReasonMCPServerToolsUnavailable, events.EventData{
@@ -897,3 +897,101 @@ func TestEventHandler_Issue318_MultipleServerTypes(t *testing.T) {
 		t.Error("sso-server-2 should NOT have been registered (SSO with tokenExchange)")
 	}
 }
+
+func TestEventHandler_SlowServerDoesNotBlockOthers(t *testing.T) {
+	provider := newMockOrchestratorAPI()
+	callbacks := newMockCallbacks()
+
+	release := make(chan struct{})
+	var deadline time.Time
+	register := func(ctx context.Context, serverName string) error {
+		if serverName == "slow" {
+			deadline, _ = ctx.Deadline()
+			<-release
+		}
+		return callbacks.register(ctx, serverName)
+	}
+	handler := NewEventHandler(provider, register, callbacks.deregister, callbacks.isAuthRequired, callbacks.isSSOBased)
+	handler.SetRegistrationLimits(2, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := handler.Start(ctx); err != nil {
+		t.Fatalf("Failed to start handler: %v", err)
+	}
+	defer func() { _ = handler.Stop() }()
+
+	for _, name := range []string{"slow", "fast"} {
+		provider.sendEvent(api.ServiceStateChangedEvent{
+			Name:        name,
+			ServiceType: "MCPServer",
+			OldState:    "stopped",
+			NewState:    "running",
+			Health:      "healthy",
+		})
+	}
+
+	// The fast server registers while the slow one is still connecting
+	if err := callbacks.waitForCallbacks(1, 5*time.Second); err != nil {
+		t.Fatalf("Failed waiting for callbacks: %v", err)
+	}
+	if registered := callbacks.getRegisteredServers(); len(registered) != 1 || registered[0] != "fast" {
+		t.Errorf("Expected only fast to be registered, got %v", registered)
+	}
+
+	close(release)
+	if err := callbacks.waitForCallbacks(1, 5*time.Second); err != nil {
+		t.Fatalf("Failed waiting for callbacks: %v", err)
+	}
+	if deadline.IsZero() || time.Until(deadline) > time.Minute {
+		t.Errorf("Expected the registration to have a deadline within the time budget, got %v", deadline)
+	}
+}
+
+func TestEventHandler_KeepsServerEventOrder(t *testing.T) {
+	provider := newMockOrchestratorAPI()
+	callbacks := newMockCallbacks()
+	handler := NewEventHandler(provider, callbacks.register, callbacks.deregister, callbacks.isAuthRequired, callbacks.isSSOBased)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := handler.Start(ctx); err != nil {
+		t.Fatalf("Failed to start handler: %v", err)
+	}
+	defer func() { _ = handler.Stop() }()
+
+	var calls []string
+	var mu sync.Mutex
+	registering := make(chan struct{})
+	releaseRegister := make(chan struct{})
+	handler.registerFunc = func(ctx context.Context, serverName string) error {
+		close(registering)
+		<-releaseRegister
+		mu.Lock()
+		calls = append(calls, "register")
+		mu.Unlock()
+		return callbacks.register(ctx, serverName)
+	}
+	handler.deregisterFunc = func(serverName string) error {
+		mu.Lock()
+		calls = append(calls, "deregister")
+		mu.Unlock()
+		return callbacks.deregister(serverName)
+	}
+
+	// The server stops while it is being registered: its deregistration is
+	// queued, and runs once the registration finished
+	provider.sendEvent(api.ServiceStateChangedEvent{Name: "kubernetes", ServiceType: "MCPServer", NewState: "running", Health: "healthy"})
+	<-registering
+	handler.dispatchEvent(api.ServiceStateChangedEvent{Name: "kubernetes", ServiceType: "MCPServer", NewState: "stopped", Health: "unknown"})
+	close(releaseRegister)
+
+	if err := callbacks.waitForCallbacks(2, 5*time.Second); err != nil {
+		t.Fatalf("Failed waiting for callbacks: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(calls) != 2 || calls[0] != "register" || calls[1] != "deregister" {
+		t.Errorf("Expected register then deregister, got %v", calls)
+	}
+}
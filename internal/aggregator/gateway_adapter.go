@@ -0,0 +1,18 @@
+package aggregator
+
+import (
+	"context"
+
+	"github.com/giantswarm/muster/internal/gateway"
+)
+
+// gatewayDeps builds the callbacks that gateway.Server needs from the
+// aggregator beyond the API-layer handlers it calls directly.
+func (a *AggregatorServer) gatewayDeps() gateway.Deps {
+	return gateway.Deps{
+		AuthStatus: func(ctx context.Context) (any, error) {
+			return a.authStatus(ctx), nil
+		},
+		ReadOnly: a.IsReadOnlyMode,
+	}
+}
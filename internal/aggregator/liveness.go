@@ -0,0 +1,184 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/events"
+	"github.com/giantswarm/muster/pkg/logging"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// livenessMonitor pings the connections to the MCP servers, so a connection
+// that died silently, such as a half-open SSE or streamable HTTP connection
+// whose NAT mapping timed out, is detected before the next tool call on it
+// fails.
+//
+// It is only used by the goroutine of runLivenessMonitor and needs no lock.
+type livenessMonitor struct {
+	interval  time.Duration
+	timeout   time.Duration
+	threshold int
+
+	// failures counts the consecutive failed pings by connection. Shared
+	// connections of the registry have an empty SessionID.
+	failures map[poolKey]int
+}
+
+// newLivenessMonitor creates a liveness monitor, using the defaults for the
+// settings cfg leaves unset.
+func newLivenessMonitor(cfg LivenessConfig) *livenessMonitor {
+	m := &livenessMonitor{
+		interval:  cfg.Interval,
+		timeout:   cfg.Timeout,
+		threshold: cfg.FailureThreshold,
+		failures:  make(map[poolKey]int),
+	}
+	if m.interval <= 0 {
+		m.interval = defaultLivenessInterval
+	}
+	if m.timeout <= 0 {
+		m.timeout = defaultLivenessTimeout
+	}
+	if m.threshold <= 0 {
+		m.threshold = defaultLivenessFailureThreshold
+	}
+	return m
+}
+
+// check pings the clients concurrently, each within the timeout, and returns
+// the keys of those that failed threshold pings in a row, sorted. Their
+// counters start over, and the counters of the connections that are gone
+// are dropped.
+func (m *livenessMonitor) check(ctx context.Context, clients map[poolKey]MCPClient) []poolKey {
+	type pingResult struct {
+		key poolKey
+		err error
+	}
+	results := make(chan pingResult, len(clients))
+	for key, client := range clients {
+		go func() {
+			pingCtx, cancel := context.WithTimeout(ctx, m.timeout)
+			defer cancel()
+			results <- pingResult{key: key, err: client.Ping(pingCtx)}
+		}()
+	}
+
+	failures := make(map[poolKey]int)
+	var dead []poolKey
+	for range clients {
+		result := <-results
+		if result.err == nil {
+			continue
+		}
+		count := m.failures[result.key] + 1
+		if count < m.threshold {
+			logging.Debug("Liveness", "Ping %d/%d of server %s (session=%s) failed: %v",
+				count, m.threshold, result.key.ServerName, logging.TruncateIdentifier(result.key.SessionID), result.err)
+			failures[result.key] = count
+			continue
+		}
+		logging.Warn("Liveness", "Connection to server %s (session=%s) is dead after %d failed pings: %v",
+			result.key.ServerName, logging.TruncateIdentifier(result.key.SessionID), count, result.err)
+		dead = append(dead, result.key)
+	}
+	m.failures = failures
+
+	sort.Slice(dead, func(i, j int) bool {
+		if dead[i].ServerName != dead[j].ServerName {
+			return dead[i].ServerName < dead[j].ServerName
+		}
+		return dead[i].SessionID < dead[j].SessionID
+	})
+	return dead
+}
+
+// runLivenessMonitor pings the connections to the MCP servers at the interval
+// of m until the aggregator stops.
+func (a *AggregatorServer) runLivenessMonitor(m *livenessMonitor) {
+	defer a.wg.Done()
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-ticker.C:
+			a.checkLiveness(m)
+		}
+	}
+}
+
+// checkLiveness pings the shared connections of the registry and the
+// per-session connections of the pool once. A dead shared connection is
+// reconnected; a dead per-session connection is evicted, so the next tool
+// call of the session opens a new one.
+func (a *AggregatorServer) checkLiveness(m *livenessMonitor) {
+	clients := make(map[poolKey]MCPClient)
+	namespaces := make(map[string]string)
+	for name, info := range a.registry.GetAllServers() {
+		if info.Client != nil {
+			clients[poolKey{ServerName: name}] = info.Client
+			namespaces[name] = info.Namespace
+		}
+	}
+	if a.connPool != nil {
+		maps.Copy(clients, a.connPool.clients())
+	}
+
+	for _, key := range m.check(a.ctx, clients) {
+		if key.SessionID != "" {
+			a.connPool.evictClient(key, clients[key], "liveness")
+			continue
+		}
+		emitHealthCheckFailedEvent(key.ServerName, namespaces[key.ServerName], m.threshold)
+		a.reconnectServer(key.ServerName)
+	}
+}
+
+// reconnectServer replaces the dead shared connection of the named server.
+// Its MCPServer service is restarted, which deregisters the server and
+// registers it again once it is connected; a server without a service is
+// deregistered.
+func (a *AggregatorServer) reconnectServer(name string) {
+	if registry := api.GetServiceRegistry(); registry != nil {
+		if _, ok := registry.Get(name); ok {
+			if manager := api.GetServiceManager(); manager != nil {
+				logging.Info("Liveness", "Restarting MCPServer %s to reconnect", name)
+				if err := manager.RestartService(name); err != nil {
+					logging.Error("Liveness", err, "Failed to restart MCPServer %s", name)
+				}
+				return
+			}
+		}
+	}
+	if err := a.DeregisterServer(name); err != nil {
+		logging.Error("Liveness", err, "Failed to deregister MCPServer %s", name)
+	}
+}
+
+// emitHealthCheckFailedEvent records that the shared connection to the
+// named server failed threshold pings in a row.
+func emitHealthCheckFailedEvent(serverName, namespace string, threshold int) {
+	eventManager := api.GetEventManager()
+	if eventManager == nil {
+		return
+	}
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+
+	objRef := api.ObjectReference{
+		Kind:      "MCPServer",
+		Name:      serverName,
+		Namespace: namespace,
+	}
+	_ = eventManager.CreateEventWithData(context.Background(), objRef, string(events.ReasonMCPServerHealthCheckFailed), api.EventData{
+		Error: fmt.Sprintf("no response to %d pings in a row", threshold),
+	})
+}
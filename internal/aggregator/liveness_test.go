@@ -0,0 +1,101 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLivenessMonitor_Defaults(t *testing.T) {
+	m := newLivenessMonitor(LivenessConfig{})
+	assert.Equal(t, defaultLivenessInterval, m.interval)
+	assert.Equal(t, defaultLivenessTimeout, m.timeout)
+	assert.Equal(t, defaultLivenessFailureThreshold, m.threshold)
+
+	m = newLivenessMonitor(LivenessConfig{Interval: time.Minute, Timeout: time.Second, FailureThreshold: 5})
+	assert.Equal(t, time.Minute, m.interval)
+	assert.Equal(t, time.Second, m.timeout)
+	assert.Equal(t, 5, m.threshold)
+}
+
+func TestLivenessMonitor_Check(t *testing.T) {
+	m := newLivenessMonitor(LivenessConfig{FailureThreshold: 2})
+	healthy := &mockMCPClient{initialized: true}
+	broken := &mockMCPClient{initialized: true, pingErr: errors.New("connection reset")}
+	healthyKey := poolKey{ServerName: "healthy"}
+	brokenKey := poolKey{SessionID: "s1", ServerName: "broken"}
+	clients := map[poolKey]MCPClient{healthyKey: healthy, brokenKey: broken}
+
+	// One failure is below the threshold
+	assert.Empty(t, m.check(context.Background(), clients))
+	assert.Equal(t, map[poolKey]int{brokenKey: 1}, m.failures)
+
+	// The second failure in a row reports the connection and resets its counter
+	assert.Equal(t, []poolKey{brokenKey}, m.check(context.Background(), clients))
+	assert.Empty(t, m.failures)
+
+	// A successful ping resets the counter
+	assert.Empty(t, m.check(context.Background(), clients))
+	broken.pingErr = nil
+	assert.Empty(t, m.check(context.Background(), clients))
+	assert.Empty(t, m.failures)
+
+	// The counters of connections that are gone are dropped
+	broken.pingErr = errors.New("connection reset")
+	assert.Empty(t, m.check(context.Background(), clients))
+	assert.Empty(t, m.check(context.Background(), map[poolKey]MCPClient{healthyKey: healthy}))
+	assert.Empty(t, m.failures)
+}
+
+func TestAggregatorServer_CheckLiveness(t *testing.T) {
+	ctx := context.Background()
+	server := NewAggregatorServer(AggregatorConfig{Host: "localhost", Port: 0}, nil)
+	server.ctx = ctx
+	defer server.connPool.Stop()
+
+	shared := &mockMCPClient{pingErr: errors.New("timeout")}
+	require.NoError(t, server.registry.Register(ctx, ServerRegistration{Name: "remote"}, shared))
+
+	pooled := &poolTestClient{}
+	deadPooled := &mockMCPClient{initialized: true, pingErr: errors.New("timeout")}
+	server.connPool.Put("s1", "sso", pooled)
+	server.connPool.Put("s2", "sso", deadPooled)
+
+	m := newLivenessMonitor(LivenessConfig{FailureThreshold: 1})
+	server.checkLiveness(m)
+
+	// Without an MCPServer service, the dead shared connection is deregistered
+	_, exists := server.registry.GetServerInfo("remote")
+	assert.False(t, exists)
+	assert.True(t, shared.closed)
+
+	// Only the dead per-session connection is evicted
+	_, ok := server.connPool.Get("s1", "sso")
+	assert.True(t, ok)
+	_, ok = server.connPool.Get("s2", "sso")
+	assert.False(t, ok)
+	assert.True(t, deadPooled.closed)
+}
+
+func TestSessionConnectionPool_EvictClient(t *testing.T) {
+	pool := newTestPool()
+	defer pool.Stop()
+	old := &poolTestClient{}
+	replacement := &poolTestClient{}
+	key := poolKey{SessionID: "s1", ServerName: "srv"}
+
+	pool.Put("s1", "srv", old)
+	pool.Put("s1", "srv", replacement)
+
+	// A client that was replaced in the meantime is not evicted
+	assert.False(t, pool.evictClient(key, old, "test"))
+	assert.Equal(t, 1, pool.Len())
+
+	assert.True(t, pool.evictClient(key, replacement, "test"))
+	assert.Equal(t, 0, pool.Len())
+	assert.Equal(t, int32(1), replacement.closeCount.Load())
+}
@@ -19,6 +19,18 @@ const LogSubsystem = "MCP-Tool"
 // in Loki use this as the anchor (e.g. `msg = "tool call"`).
 const LogMessage = "tool call"
 
+// CorrelationID returns a ToolHandlerMiddleware that gives each tool call a
+// new correlation ID, so the log entries and events produced while handling
+// it, across components, can be found by the ID. It must run before the
+// other middlewares for their log lines to carry the ID.
+func CorrelationID() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return next(logging.EnsureCorrelationID(ctx), req)
+		}
+	}
+}
+
 // Logging returns a ToolHandlerMiddleware that emits one structured
 // info-level log line per tool call with fields: tool, outcome,
 // duration_s, error (when set).
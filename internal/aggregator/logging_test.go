@@ -80,6 +80,26 @@ func TestLogging(t *testing.T) {
 	}
 }
 
+func TestCorrelationID(t *testing.T) {
+	buf := captureLog(t)
+	var seen string
+	handler := func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		seen = logging.CorrelationIDFromContext(ctx)
+		return &mcp.CallToolResult{}, nil
+	}
+	wrapped := CorrelationID()(Logging()(handler))
+	_, _ = wrapped(context.Background(), callRequest("x_github_list_issues"))
+
+	require.NotEmpty(t, seen)
+	line := lastJSONLine(t, buf)
+	require.Equal(t, seen, line[logging.CorrelationIDKey])
+
+	// A second call gets an ID of its own
+	first := seen
+	_, _ = wrapped(context.Background(), callRequest("x_github_list_issues"))
+	require.NotEqual(t, first, seen)
+}
+
 // lastJSONLine returns the last newline-terminated JSON object written
 // to buf. muster's TextHandler is the default, so we accept the line
 // being either JSON or a "key=value" string; here we parse the slog
@@ -140,6 +140,7 @@ func (am *AggregatorManager) Start(ctx context.Context) error {
 		am.isServerAuthRequired,
 		am.isServerSSOBased,
 	)
+	am.eventHandler.SetRegistrationLimits(am.connectLimits())
 
 	// Start the event handler for automatic updates
 	if err := am.eventHandler.Start(am.ctx); err != nil {
@@ -210,9 +211,10 @@ func (am *AggregatorManager) GetServiceData() map[string]interface{} {
 	defer am.mu.RUnlock()
 
 	data := map[string]interface{}{
-		"port": am.config.Port,
-		"host": am.config.Host,
-		"yolo": am.config.Yolo,
+		"port":     am.config.Port,
+		"host":     am.config.Host,
+		"yolo":     am.config.Yolo,
+		"readOnly": am.config.ReadOnly,
 	}
 
 	// Add aggregator server metrics if available
@@ -278,6 +280,10 @@ func (am *AggregatorManager) GetServiceData() map[string]interface{} {
 // that are both running and healthy, as this guarantees that their MCP clients
 // are ready for use.
 //
+// The servers are registered in parallel, at most ConnectConcurrency at the
+// same time, and each within its ConnectTimeout budget, so one slow server
+// does not delay the aggregator becoming ready.
+//
 // Returns an error if the service registry is unavailable, but continues
 // processing even if individual server registrations fail.
 func (am *AggregatorManager) registerHealthyMCPServers(ctx context.Context) error {
@@ -288,22 +294,44 @@ func (am *AggregatorManager) registerHealthyMCPServers(ctx context.Context) erro
 	// Get all MCP services from the registry
 	mcpServices := am.serviceRegistry.GetByType(api.TypeMCPServer)
 
-	registeredCount := 0
+	concurrency, timeout := am.connectLimits()
+	sem := make(chan struct{}, concurrency)
+	var (
+		wg              sync.WaitGroup
+		countMu         sync.Mutex
+		registeredCount int
+	)
 	for _, service := range mcpServices {
 		// Only register servers that are running/connected AND healthy (client is guaranteed ready)
 		if !api.IsActiveState(service.GetState()) || service.GetHealth() != api.HealthHealthy {
 			continue
 		}
 
-		// Attempt to register the healthy server
-		if err := am.registerSingleServer(ctx, service.GetName()); err != nil {
-			logging.Warn("Aggregator-Manager", "Failed to register healthy MCP server %s: %v",
-				service.GetName(), err)
-			// Continue with other servers
-		} else {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			// Attempt to register the healthy server
+			registerCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			if err := am.registerSingleServer(registerCtx, name); err != nil {
+				logging.Warn("Aggregator-Manager", "Failed to register healthy MCP server %s: %v", name, err)
+				// Continue with other servers
+				return
+			}
+			countMu.Lock()
 			registeredCount++
-		}
+			countMu.Unlock()
+		}(service.GetName())
 	}
+	wg.Wait()
 
 	if registeredCount > 0 {
 		logging.Info("Aggregator-Manager", "Initial sync completed: registered %d healthy MCP servers", registeredCount)
@@ -312,6 +340,19 @@ func (am *AggregatorManager) registerHealthyMCPServers(ctx context.Context) erro
 	return nil
 }
 
+// connectLimits returns the number of servers registered at the same time
+// and the time budget of each registration, with the defaults applied.
+func (am *AggregatorManager) connectLimits() (int, time.Duration) {
+	concurrency, timeout := am.config.ConnectConcurrency, am.config.ConnectTimeout
+	if concurrency <= 0 {
+		concurrency = defaultConnectConcurrency
+	}
+	if timeout <= 0 {
+		timeout = defaultConnectTimeout
+	}
+	return concurrency, timeout
+}
+
 // registerSingleServer registers a single MCP server with the aggregator.
 //
 // This method is called when a server becomes healthy and running. Since the
@@ -493,6 +534,7 @@ func (am *AggregatorManager) handleAuthCompletion(ctx context.Context, sessionID
 	ctx = api.WithSubject(ctx, userID)
 
 	result, err := aggregatorServer.tryConnectWithToken(ctx, serverName, serverInfo.URL, issuer, scope, accessToken)
+	publishAuthEvent("login", serverName, userID, err)
 	if err != nil {
 		return fmt.Errorf("failed to establish connection: %w", err)
 	}
@@ -543,6 +585,32 @@ func (am *AggregatorManager) GetEndpoint() string {
 	return ""
 }
 
+// SetHandoverCallback sets the function called once the standby aggregator
+// holds the standby lock (see AggregatorServer.SetHandoverCallback).
+func (am *AggregatorManager) SetHandoverCallback(fn func()) {
+	am.aggregatorServer.SetHandoverCallback(fn)
+}
+
+// ApplySettings applies the settings of config that can change while the
+// aggregator is running: the muster prefix, yolo mode, and read-only mode.
+// The other fields of config are ignored; changing them requires restarting
+// the manager.
+func (am *AggregatorManager) ApplySettings(config AggregatorConfig) {
+	am.mu.Lock()
+	am.config.MusterPrefix = config.MusterPrefix
+	am.config.Yolo = config.Yolo
+	am.config.ReadOnly = config.ReadOnly
+	server := am.aggregatorServer
+	am.mu.Unlock()
+
+	if server == nil {
+		return
+	}
+	server.SetYoloMode(config.Yolo)
+	server.SetReadOnlyMode(config.ReadOnly)
+	server.SetMusterPrefix(config.MusterPrefix)
+}
+
 // GetAggregatorServer returns the underlying aggregator server instance.
 //
 // This method provides access to advanced aggregator operations that are
@@ -621,6 +689,7 @@ func (am *AggregatorManager) attemptPendingRegistrations(ctx context.Context) {
 
 	// Get all MCP services from the registry
 	mcpServices := am.serviceRegistry.GetByType(api.TypeMCPServer)
+	_, timeout := am.connectLimits()
 
 	for _, service := range mcpServices {
 		// Only try services that are running/connected and healthy
@@ -636,7 +705,10 @@ func (am *AggregatorManager) attemptPendingRegistrations(ctx context.Context) {
 		}
 
 		// Attempt registration
-		if err := am.registerSingleServer(ctx, service.GetName()); err != nil {
+		registerCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := am.registerSingleServer(registerCtx, service.GetName())
+		cancel()
+		if err != nil {
 			logging.Debug("Aggregator-Manager", "Retry registration failed for %s: %v", service.GetName(), err)
 		} else {
 			logging.Info("Aggregator-Manager", "Successfully registered %s on retry", service.GetName())
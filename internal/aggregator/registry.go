@@ -166,6 +166,26 @@ func (r *ServerRegistry) SetServerPrefix(serverName, prefix string) {
 	r.setServerPrefixLocked(serverName, prefix)
 }
 
+// SetMusterPrefix changes the global prefix applied to all aggregated
+// capabilities. Names exposed under the previous prefix no longer resolve, and
+// subscribers are notified so they rebuild the exposed capabilities.
+func (r *ServerRegistry) SetMusterPrefix(musterPrefix string) {
+	if musterPrefix == "" {
+		musterPrefix = "x"
+	}
+	r.nameMu.Lock()
+	if r.musterPrefix == musterPrefix {
+		r.nameMu.Unlock()
+		return
+	}
+	r.musterPrefix = musterPrefix
+	r.nameMapping = make(map[string]resolvedName)
+	r.familyMappings = make(map[string]*familyBucket)
+	r.nameMu.Unlock()
+
+	r.notifyUpdate()
+}
+
 // setServerPrefixLocked sets the prefix for a server. Caller must hold nameMu.
 func (r *ServerRegistry) setServerPrefixLocked(serverName, prefix string) {
 	if prefix == "" {
@@ -187,11 +207,13 @@ func (r *ServerRegistry) setServerFamilyLocked(serverName string, family *api.MC
 
 // familyExposedName returns the family-scoped exposed name for a tool:
 // {musterPrefix}_{family}_{toolName}. If the original tool name already
-// carries the family prefix it is not duplicated.
+// carries the family prefix it is not duplicated. Caller must NOT hold nameMu.
 func (r *ServerRegistry) familyExposedName(family, toolName string) string {
 	if !strings.HasPrefix(toolName, family+"_") {
 		toolName = family + "_" + toolName
 	}
+	r.nameMu.RLock()
+	defer r.nameMu.RUnlock()
 	return r.musterPrefix + "_" + toolName
 }
 
@@ -214,13 +236,17 @@ func (r *ServerRegistry) familyExposedName(family, toolName string) string {
 // Returns an error if the server name is already registered, client initialization
 // fails, or the server cannot be reached.
 func (r *ServerRegistry) Register(ctx context.Context, registration ServerRegistration, client MCPClient) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	if _, exists := r.servers[registration.Name]; exists {
+	r.mu.RLock()
+	_, exists := r.servers[registration.Name]
+	r.mu.RUnlock()
+	if exists {
 		return fmt.Errorf("server %s already registered", registration.Name)
 	}
 
+	// The server is connected to and queried without holding the lock, so
+	// slow servers neither hold up the registration of others nor reads of
+	// the registry.
+
 	// Check if client is already initialized, if not try to initialize
 	if initializer, ok := client.(interface{ Initialize(context.Context) error }); ok {
 		// Use a short timeout to avoid blocking the registration process
@@ -239,8 +265,6 @@ func (r *ServerRegistry) Register(ctx context.Context, registration ServerRegist
 		Family:     cloneFamily(registration.Family),
 	}
 
-	r.applyServerRegistrationLocked(registration.Name, registration.ToolPrefix, registration.Family)
-
 	// Fetch initial capabilities from the server
 	if err := r.refreshServerCapabilities(ctx, info); err != nil {
 		logging.Warn("Aggregator", "Failed to get initial capabilities for %s: %v", registration.Name, err)
@@ -256,6 +280,13 @@ func (r *ServerRegistry) Register(ctx context.Context, registration ServerRegist
 		info.mu.RUnlock()
 	}
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.servers[registration.Name]; exists {
+		return fmt.Errorf("server %s already registered", registration.Name)
+	}
+
+	r.applyServerRegistrationLocked(registration.Name, registration.ToolPrefix, registration.Family)
 	r.servers[registration.Name] = info
 	r.notifyUpdate()
 
@@ -185,6 +185,40 @@ func TestServerRegistry_ResolveName(t *testing.T) {
 	}
 }
 
+func TestServerRegistry_SetMusterPrefix(t *testing.T) {
+	registry := NewServerRegistry("x")
+	registry.SetServerPrefix("serverA", "serverA")
+	assert.Equal(t, "x_serverA_read_file", registry.ExposedToolName("serverA", "read_file"))
+
+	registry.SetMusterPrefix("m")
+	select {
+	case <-registry.GetUpdateChannel():
+	default:
+		t.Fatal("SetMusterPrefix should notify subscribers")
+	}
+
+	// Names exposed under the old prefix no longer resolve
+	_, _, err := registry.ResolveToolName("x_serverA_read_file")
+	assert.Error(t, err)
+
+	assert.Equal(t, "m_serverA_read_file", registry.ExposedToolName("serverA", "read_file"))
+	serverName, originalName, err := registry.ResolveToolName("m_serverA_read_file")
+	require.NoError(t, err)
+	assert.Equal(t, "serverA", serverName)
+	assert.Equal(t, "read_file", originalName)
+
+	// An unchanged prefix is a no-op
+	registry.SetMusterPrefix("m")
+	select {
+	case <-registry.GetUpdateChannel():
+		t.Fatal("an unchanged prefix should not notify subscribers")
+	default:
+	}
+
+	registry.SetMusterPrefix("")
+	assert.Equal(t, "x_serverA_read_file", registry.ExposedToolName("serverA", "read_file"))
+}
+
 // Helper function to split "server.tool" into ["server", "tool"]
 func splitKey(key string) []string {
 	for i := 0; i < len(key); i++ {
@@ -19,11 +19,14 @@ import (
 	"github.com/giantswarm/muster/internal/admin"
 	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/internal/config"
+	"github.com/giantswarm/muster/internal/conversion"
+	"github.com/giantswarm/muster/internal/gateway"
 	internalmcp "github.com/giantswarm/muster/internal/mcpserver"
 	oauthstore "github.com/giantswarm/muster/internal/oauth/store"
 	"github.com/giantswarm/muster/internal/server"
 	"github.com/giantswarm/muster/pkg/logging"
 	pkgoauth "github.com/giantswarm/muster/pkg/oauth"
+	"github.com/giantswarm/muster/pkg/project"
 
 	"github.com/coreos/go-systemd/v22/activation"
 	oauth "github.com/giantswarm/mcp-oauth"
@@ -116,6 +119,9 @@ type AggregatorServer struct {
 	authRateLimiter *AuthRateLimiter // Per-user rate limiting for auth operations
 	authMetrics     *AuthMetrics     // Authentication metrics for monitoring
 
+	// In-process tool call counters backing the runtime stats collector.
+	callStats *callStats
+
 	// Per-session auth store tracks which sessions have authenticated to which servers.
 	// Separated from capabilityStore so that clearing stale capabilities does not
 	// accidentally revoke authentication (see capability freshness plan).
@@ -149,6 +155,12 @@ type AggregatorServer struct {
 	// SSO tracking for proactive SSO initialization (replaces SessionRegistry SSO methods)
 	ssoTracker *ssoTracker
 
+	// authAttempts records the last authentication attempt per user and
+	// server from the auth events, reported by auth://status.
+	// stopAuthAttempts stops recording; it is set while the server runs.
+	authAttempts     *authAttemptTracker
+	stopAuthAttempts func()
+
 	// Maps user subjects to their MCP client session IDs for targeted notifications.
 	// Populated in sessionToolFilter, cleaned up via OnUnregisterSession hook.
 	subjectSessions *subjectSessionTracker
@@ -159,6 +171,19 @@ type AggregatorServer struct {
 	eventFollows   map[string]*eventFollow
 	eventFollowsMu sync.Mutex
 
+	// handover is the standby lock while the aggregator holds or waits for
+	// it. cancelStandby stops waiting; standbyDone is closed once it stopped.
+	handover      *handoverLock
+	cancelStandby context.CancelFunc
+	standbyDone   chan struct{}
+	// onHandover is called once the aggregator holds the standby lock,
+	// before it opens its listeners
+	onHandover func()
+
+	// sessionDefaults holds the default arguments of each MCP session, set
+	// with core_session_defaults_set and cleared when the session disconnects.
+	sessionDefaults *sessionDefaultArgs
+
 	// valkeyClient is the shared Valkey client used by authStore and capabilityStore
 	// when Valkey storage is configured. Nil when using in-memory stores.
 	// Closed during Stop().
@@ -173,6 +198,9 @@ type AggregatorServer struct {
 
 	// adminServer is the optional admin web UI listener. Nil when disabled.
 	adminServer *admin.Server
+
+	// gatewayServer is the optional REST gateway listener. Nil when disabled.
+	gatewayServer *gateway.Server
 }
 
 // getValkeyClient returns the shared Valkey client if one was configured,
@@ -542,12 +570,15 @@ func NewAggregatorServer(aggConfig AggregatorConfig, errorCallback func(error))
 		errorCallback:   errorCallback,
 		authRateLimiter: rateLimiter,
 		authMetrics:     NewAuthMetrics(),
+		callStats:       newCallStats(),
 		authStore:       stores.authStore,
 		capabilityStore: stores.capabilityStore,
 		connPool:        NewSessionConnectionPool(DefaultConnectionPoolMaxAge),
 		ssoTracker:      newSSOTracker(),
+		authAttempts:    newAuthAttemptTracker(),
 		subjectSessions: newSubjectSessionTracker(),
 		eventFollows:    make(map[string]*eventFollow),
+		sessionDefaults: newSessionDefaultArgs(),
 		valkeyClient:    stores.valkeyClient,
 		valkeyKeyPrefix: stores.keyPrefix,
 		valkeyEncryptor: stores.encryptor,
@@ -690,6 +721,9 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 
 	// Create cancellable context for coordinating shutdown across all components
 	a.ctx, a.cancelFunc = context.WithCancel(ctx)
+	if a.authAttempts != nil {
+		a.stopAuthAttempts = a.authAttempts.subscribe()
+	}
 
 	// Determine the server version to report
 	serverVersion := a.config.Version
@@ -704,6 +738,7 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 			logging.TransportSessionID(session.SessionID()))
 		a.subjectSessions.RemoveSession(session.SessionID())
 		a.stopEventFollow(session.SessionID())
+		a.sessionDefaults.Clear(session.SessionID())
 	})
 
 	hooks.AddOnRegisterSession(func(ctx context.Context, session mcpserver.ClientSession) {
@@ -717,6 +752,16 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 			slog.String("protocol", string(msg.Params.ProtocolVersion)),
 			logging.TransportSessionID(getTransportSessionID(ctx)),
 			slog.String("serverVersion", result.ServerInfo.Version))
+
+		// The muster CLI and agent report their version in the handshake
+		clientInfo := msg.Params.ClientInfo
+		if strings.HasPrefix(clientInfo.Name, "muster-") &&
+			project.VersionSkew(clientInfo.Version, result.ServerInfo.Version) == project.SkewIncompatible {
+			logging.WarnWithAttrsCtx(ctx, "MCP-Protocol", "Client version may be incompatible with the server",
+				slog.String("client", clientInfo.Name+"/"+clientInfo.Version),
+				slog.String("serverVersion", result.ServerInfo.Version),
+				logging.TransportSessionID(getTransportSessionID(ctx)))
+		}
 	})
 
 	hooks.AddAfterListTools(func(ctx context.Context, _ any, _ *mcp.ListToolsRequest, result *mcp.ListToolsResult) {
@@ -783,6 +828,9 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 		mcpserver.WithHooks(hooks),                     // Clean up subject-session mappings on disconnect
 	}
 	opts = append(opts, mcpServerOptions()...)
+	if a.callStats != nil {
+		opts = append(opts, mcpserver.WithToolHandlerMiddleware(a.callStats.middleware()))
+	}
 	mcpSrv := mcpserver.NewMCPServer("muster-aggregator", serverVersion, opts...)
 
 	a.mcpServer = mcpSrv
@@ -796,6 +844,12 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 	a.wg.Add(1)
 	go a.runSSOTrackerCleanup()
 
+	// Start periodic pings of the connections to the MCP servers
+	if !a.config.Liveness.Disabled {
+		a.wg.Add(1)
+		go a.runLivenessMonitor(newLivenessMonitor(a.config.Liveness))
+	}
+
 	// Subscribe to tool update events from workflow and other managers
 	// This ensures the aggregator stays synchronized with core muster components
 	logging.Info("Aggregator", "Subscribing to tool update events...")
@@ -810,12 +864,24 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 	// NOTE: Must be called after releasing lock since registerAuthStatusResource acquires RLock
 	a.registerAuthStatusResource()
 
+	// Register the stats://tools resource for exposing tool usage statistics
+	a.registerToolStatsResource()
+
+	// Register the execution://{execution_id}/{step_id}/output resource
+	// template serving the artifacts of workflow steps
+	a.registerArtifactResourceTemplate()
+
 	// Register this aggregator as the MetaToolsDataProvider (Issue #343)
 	// This enables the metatools package to access tools, resources, and prompts
 	// through the aggregator for the server-side meta-tools migration.
 	api.RegisterMetaToolsDataProvider(a)
 	logging.Info("Aggregator", "Registered as MetaToolsDataProvider")
 
+	// Expose tool call counters to the orchestrator's runtime stats collector.
+	if a.callStats != nil {
+		api.RegisterToolCallStatsProvider(a.callStats)
+	}
+
 	// Perform initial capability discovery and registration
 	a.updateCapabilities()
 
@@ -846,6 +912,23 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 		}
 	}
 
+	// A standby keeps its listeners closed until the serving instance exits
+	if a.config.Standby.Enabled {
+		standing, err := a.startStandby(func() error {
+			return a.serve(addr, useSystemdActivation, systemdListeners)
+		})
+		if err != nil || standing {
+			return err
+		}
+	}
+
+	return a.serve(addr, useSystemdActivation, systemdListeners)
+}
+
+// serve opens the listeners of the aggregator: the configured transport on
+// addr, or on the systemd listeners, and the optional admin UI and REST
+// gateway.
+func (a *AggregatorServer) serve(addr string, useSystemdActivation bool, systemdListeners []net.Listener) error {
 	a.mu.Lock()
 
 	switch a.config.Transport {
@@ -889,9 +972,14 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 				Handler:           handler,
 				ReadHeaderTimeout: httpReadHeaderTimeout,
 			}
+			listener, err := a.listen(addr)
+			if err != nil {
+				a.mu.Unlock()
+				return err
+			}
 			a.httpServer = append(a.httpServer, server)
 			go func() {
-				if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
 					logging.Error("Aggregator", err, "SSE server error")
 					a.errorCallback(err)
 				}
@@ -954,9 +1042,14 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 				Handler:           handler,
 				ReadHeaderTimeout: httpReadHeaderTimeout,
 			}
+			listener, err := a.listen(addr)
+			if err != nil {
+				a.mu.Unlock()
+				return err
+			}
 			a.httpServer = append(a.httpServer, server)
 			go func() {
-				if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
 					logging.Error("Aggregator", err, "Streamable HTTP server error")
 					a.errorCallback(err)
 				}
@@ -987,6 +1080,27 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 		}
 	}
 
+	// Start the optional REST gateway the same way: a failure is logged but
+	// does not stop the aggregator.
+	if a.config.Gateway.Enabled {
+		gatewayCfg := gateway.Config{
+			BindAddress: a.config.Gateway.BindAddress,
+			Port:        a.config.Gateway.Port,
+		}
+		gatewaySrv, err := gateway.NewServer(gatewayCfg, a.gatewayDeps())
+		if err != nil {
+			logging.Error("Aggregator", err, "Failed to construct REST gateway (port %d)", gatewayCfg.Port)
+		} else if err := gatewaySrv.Start(); err != nil {
+			logging.Error("Aggregator", err, "Failed to start REST gateway on %s", gatewaySrv.Addr())
+		} else {
+			a.mu.Lock()
+			a.gatewayServer = gatewaySrv
+			a.mu.Unlock()
+			logging.InfoWithAttrs("Aggregator", "REST gateway listening",
+				slog.String("addr", gatewaySrv.Addr()))
+		}
+	}
+
 	return nil
 }
 
@@ -1013,6 +1127,8 @@ func (a *AggregatorServer) Start(ctx context.Context) error {
 //
 // Returns an error if shutdown encounters issues, though cleanup continues regardless.
 func (a *AggregatorServer) Stop(ctx context.Context) error {
+	a.stopStandby()
+
 	a.mu.Lock()
 	if a.isShuttingDown {
 		a.mu.Unlock()
@@ -1030,22 +1146,42 @@ func (a *AggregatorServer) Stop(ctx context.Context) error {
 	httpServer := a.httpServer
 	adminServer := a.adminServer
 	a.adminServer = nil
+	gatewayServer := a.gatewayServer
+	a.gatewayServer = nil
+	stopAuthAttempts := a.stopAuthAttempts
+	a.stopAuthAttempts = nil
 	a.mu.Unlock()
 
-	// Shut down the admin listener first — it is cheap and has no in-flight
-	// MCP work to wait for.
+	if stopAuthAttempts != nil {
+		stopAuthAttempts()
+	}
+
+	// Shut down the admin and gateway listeners first — they are cheap and
+	// have no in-flight MCP work to wait for.
 	if adminServer != nil {
 		if err := adminServer.Stop(ctx); err != nil {
 			logging.WarnWithAttrs("Aggregator", "Error shutting down admin server",
 				slog.String("error", err.Error()))
 		}
 	}
+	if gatewayServer != nil {
+		if err := gatewayServer.Stop(ctx); err != nil {
+			logging.WarnWithAttrs("Aggregator", "Error shutting down REST gateway",
+				slog.String("error", err.Error()))
+		}
+	}
 
 	// Cancel context to signal shutdown to all background routines
 	if cancelFunc != nil {
 		cancelFunc()
 	}
 
+	// With SO_REUSEPORT a standby can bind the address while the connections
+	// drain, so hand over before the shutdown
+	if a.config.ReusePort {
+		a.releaseHandover()
+	}
+
 	// Shutdown transport servers with timeout
 	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -1068,6 +1204,8 @@ func (a *AggregatorServer) Stop(ctx context.Context) error {
 
 	// Note: Stdio server stops automatically on context cancellation, no explicit shutdown needed
 
+	a.releaseHandover()
+
 	// Wait for all background routines to complete
 	a.wg.Wait()
 
@@ -1440,6 +1578,9 @@ func (a *AggregatorServer) createStandardMux(mcpHandler http.Handler) http.Handl
 		_, _ = w.Write([]byte(`{"status":"ok"}`))
 	})
 
+	// CRD conversion webhook, called by the Kubernetes API server
+	mux.Handle("POST "+conversion.Path, conversion.NewHandler())
+
 	// Check if OAuth proxy is enabled and mount OAuth-related handlers (for downstream auth)
 	oauthHandler := api.GetOAuthHandler()
 	if oauthHandler != nil && oauthHandler.IsEnabled() {
@@ -1589,6 +1730,10 @@ func (a *AggregatorServer) createOAuthProtectedMux(mcpHandler http.Handler) (htt
 		_, _ = w.Write([]byte(`{"status":"ok"}`))
 	})
 
+	// The CRD conversion webhook is called by the Kubernetes API server, which
+	// authenticates muster through the CRD's caBundle rather than a Bearer token.
+	outerMux.Handle("POST "+conversion.Path, conversion.NewHandler())
+
 	outerMux.Handle("DELETE /user-tokens", oauthHTTPServer.ValidateTokenWithSubject(
 		http.HandlerFunc(a.handleUserTokensDeletion)))
 	outerMux.Handle("DELETE /auth/{server}", oauthHTTPServer.ValidateTokenWithSubject(
@@ -1838,6 +1983,44 @@ func (a *AggregatorServer) IsYoloMode() bool {
 	return a.config.Yolo
 }
 
+// SetYoloMode enables or disables yolo mode on the running server.
+//
+// The change applies to the next tool listing and tool call; tools already
+// listed by a client are not re-announced.
+func (a *AggregatorServer) SetYoloMode(yolo bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.config.Yolo = yolo
+}
+
+// IsReadOnlyMode returns whether read-only mode is enabled, in which the
+// mutating core tools are disabled.
+func (a *AggregatorServer) IsReadOnlyMode() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.config.ReadOnly
+}
+
+// SetReadOnlyMode enables or disables read-only mode on the running server.
+//
+// The change applies to the next tool listing and tool call, like
+// SetYoloMode.
+func (a *AggregatorServer) SetReadOnlyMode(readOnly bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.config.ReadOnly = readOnly
+}
+
+// SetMusterPrefix changes the global prefix of the aggregated capabilities on
+// the running server. The registry notifies its subscribers, so connected
+// clients receive the renamed tools through the regular capability update.
+func (a *AggregatorServer) SetMusterPrefix(prefix string) {
+	a.mu.Lock()
+	a.config.MusterPrefix = prefix
+	a.mu.Unlock()
+	a.registry.SetMusterPrefix(prefix)
+}
+
 // CallToolInternal provides internal tool calling capability for muster components.
 //
 // This method allows internal muster components to execute tools through the
@@ -1865,6 +2048,15 @@ func (a *AggregatorServer) CallToolInternal(ctx context.Context, toolName string
 	logging.DebugWithAttrs("Aggregator", "CallToolInternal called",
 		slog.String("tool", toolName))
 
+	if a.callStats != nil {
+		started := time.Now()
+		defer func() { a.callStats.recordUsage(toolName, started, res, err) }()
+	}
+
+	// Arguments the session set defaults for apply unless the call sets them,
+	// including the instance argument of family-grouped tools
+	ctx, args = a.applySessionDefaults(ctx, toolName, args)
+
 	sub := getUserSubjectFromContext(ctx)
 	sessionID := getSessionIDFromContext(ctx)
 
@@ -1931,7 +2123,7 @@ func (a *AggregatorServer) CallToolInternal(ctx context.Context, toolName string
 
 	logging.DebugWithAttrs("Aggregator", "Tool not found in registry, session, or core tools",
 		slog.String("tool", toolName))
-	return nil, fmt.Errorf("tool not found: %s", toolName)
+	return nil, &api.NotFoundError{ResourceType: "tool", ResourceName: toolName, Message: fmt.Sprintf("tool not found: %s", toolName)}
 }
 
 // dispatchResolvedTool routes a tool call to the backend server once the
@@ -1981,8 +2173,12 @@ func (a *AggregatorServer) isCoreToolByName(toolName string) bool {
 		"core_config_",
 		"core_mcpserver_",
 		"core_events",
-		"core_auth_", // Authentication tools (core_auth_login, core_auth_logout)
-		"workflow_",  // Direct workflow execution tools
+		"core_resource_events",
+		"core_auth_",    // Authentication tools (core_auth_login, core_auth_logout)
+		"core_system_",  // System tools (core_system_status, core_system_gc)
+		"core_logging_", // Logging tools (core_logging_set_level)
+		"core_session_", // Session tools (core_session_defaults_set)
+		"workflow_",     // Direct workflow execution tools
 	}
 
 	for _, prefix := range coreToolPrefixes {
@@ -2006,6 +2202,8 @@ func (a *AggregatorServer) isCoreToolByName(toolName string) bool {
 //   - service_*: Routed to the service manager for service lifecycle operations
 //   - config_*: Routed to the config manager for configuration operations
 //   - mcpserver_*: Routed to the MCP server manager for MCP server operations
+//   - system_*: Routed to the system tool provider for diagnostics
+//   - session_*: Routed to the session tool provider for session default arguments
 //
 // The method removes the "core_" prefix from tool names before routing to ensure
 // proper tool resolution within each component's tool provider interface.
@@ -2026,18 +2224,24 @@ func (a *AggregatorServer) callCoreToolDirectly(ctx context.Context, toolName st
 	logging.DebugWithAttrs("Aggregator", "Original tool name after prefix removal",
 		slog.String("tool", originalToolName))
 
+	// Workflow steps call core tools through here too, so read-only mode
+	// also covers the mutations of workflows
+	if api.IsMutatingTool(originalToolName) && a.IsReadOnlyMode() {
+		return nil, api.NewReadOnlyError(toolName)
+	}
+
 	// Route to the appropriate provider based on tool name prefix
 	switch {
 	case strings.HasPrefix(originalToolName, "workflow_"):
 		// Workflow management and execution tools
 		handler := api.GetWorkflow()
 		if handler == nil {
-			return nil, fmt.Errorf("workflow handler not available")
+			return nil, api.NewUnavailableError("workflow handler", nil)
 		}
 		if provider, ok := handler.(api.ToolProvider); ok {
 			// Check if this is a workflow management tool or a workflow execution tool
 			managementTools := []string{"workflow_list", "workflow_get", "workflow_create",
-				"workflow_update", "workflow_delete", "workflow_validate", "workflow_available",
+				"workflow_update", "workflow_delete", "workflow_validate", "workflow_batch", "workflow_available",
 				"workflow_execution_list", "workflow_execution_get"}
 
 			isManagementTool := slices.Contains(managementTools, originalToolName)
@@ -2046,7 +2250,7 @@ func (a *AggregatorServer) callCoreToolDirectly(ctx context.Context, toolName st
 				// Use the original tool name for workflow management tools
 				logging.DebugWithAttrs("Aggregator", "Calling workflow management tool directly",
 					slog.String("tool", originalToolName))
-				result, err := provider.ExecuteTool(ctx, originalToolName, args)
+				result, err := api.InvokeTool(ctx, "workflow", provider.ExecuteTool, originalToolName, args)
 				if err != nil {
 					return nil, err
 				}
@@ -2056,7 +2260,7 @@ func (a *AggregatorServer) callCoreToolDirectly(ctx context.Context, toolName st
 				actionToolName := strings.Replace(originalToolName, "workflow_", "action_", 1)
 				logging.DebugWithAttrs("Aggregator", "Mapping workflow execution tool to action tool",
 					slog.String("tool", originalToolName), slog.String("actionTool", actionToolName))
-				result, err := provider.ExecuteTool(ctx, actionToolName, args)
+				result, err := api.InvokeTool(ctx, "workflow", provider.ExecuteTool, actionToolName, args)
 				if err != nil {
 					return nil, err
 				}
@@ -2069,10 +2273,10 @@ func (a *AggregatorServer) callCoreToolDirectly(ctx context.Context, toolName st
 		// Service lifecycle management operations
 		handler := api.GetServiceManager()
 		if handler == nil {
-			return nil, fmt.Errorf("service manager handler not available")
+			return nil, api.NewUnavailableError("service manager handler", nil)
 		}
 		if provider, ok := handler.(api.ToolProvider); ok {
-			result, err := provider.ExecuteTool(ctx, originalToolName, args)
+			result, err := api.InvokeTool(ctx, "service", provider.ExecuteTool, originalToolName, args)
 			if err != nil {
 				return nil, err
 			}
@@ -2084,10 +2288,10 @@ func (a *AggregatorServer) callCoreToolDirectly(ctx context.Context, toolName st
 		// Configuration management operations
 		handler := api.GetConfigHandler()
 		if handler == nil {
-			return nil, fmt.Errorf("config handler not available")
+			return nil, api.NewUnavailableError("config handler", nil)
 		}
 		if provider, ok := handler.(api.ToolProvider); ok {
-			result, err := provider.ExecuteTool(ctx, originalToolName, args)
+			result, err := api.InvokeTool(ctx, "config", provider.ExecuteTool, originalToolName, args)
 			if err != nil {
 				return nil, err
 			}
@@ -2099,10 +2303,10 @@ func (a *AggregatorServer) callCoreToolDirectly(ctx context.Context, toolName st
 		// MCP server management operations
 		handler := api.GetMCPServerManager()
 		if handler == nil {
-			return nil, fmt.Errorf("MCP server manager handler not available")
+			return nil, api.NewUnavailableError("MCP server manager handler", nil)
 		}
 		if provider, ok := handler.(api.ToolProvider); ok {
-			result, err := provider.ExecuteTool(ctx, originalToolName, args)
+			result, err := api.InvokeTool(ctx, "mcpserver", provider.ExecuteTool, originalToolName, args)
 			if err != nil {
 				return nil, err
 			}
@@ -2120,10 +2324,10 @@ func (a *AggregatorServer) callCoreToolDirectly(ctx context.Context, toolName st
 		// Event management operations
 		handler := api.GetEventManager()
 		if handler == nil {
-			return nil, fmt.Errorf("event manager handler not available")
+			return nil, api.NewUnavailableError("event manager handler", nil)
 		}
 		if provider, ok := handler.(api.ToolProvider); ok {
-			result, err := provider.ExecuteTool(ctx, originalToolName, args)
+			result, err := api.InvokeTool(ctx, "events", provider.ExecuteTool, originalToolName, args)
 			if err != nil {
 				return nil, err
 			}
@@ -2137,17 +2341,49 @@ func (a *AggregatorServer) callCoreToolDirectly(ctx context.Context, toolName st
 		}
 		return nil, fmt.Errorf("event manager does not implement ToolProvider interface")
 
+	case originalToolName == "resource_events":
+		// Merged timeline of a resource
+		handler := api.GetEventManager()
+		if handler == nil {
+			return nil, api.NewUnavailableError("event manager handler", nil)
+		}
+		if provider, ok := handler.(api.ToolProvider); ok {
+			result, err := api.InvokeTool(ctx, "events", provider.ExecuteTool, originalToolName, args)
+			if err != nil {
+				return nil, err
+			}
+			return convertToMCPResult(result), nil
+		}
+		return nil, fmt.Errorf("event manager does not implement ToolProvider interface")
+
 	case strings.HasPrefix(originalToolName, "auth_"):
 		// Authentication operations (auth_login, auth_logout)
 		authProvider := NewAuthToolProvider(a)
-		result, err := authProvider.ExecuteTool(ctx, originalToolName, args)
+		result, err := api.InvokeTool(ctx, "auth", authProvider.ExecuteTool, originalToolName, args)
+		if err != nil {
+			return nil, err
+		}
+		return convertToMCPResult(result), nil
+
+	case strings.HasPrefix(originalToolName, "system_"), strings.HasPrefix(originalToolName, "logging_"):
+		// Diagnostics, garbage collection, and log levels of muster itself
+		// (system_status, system_gc, logging_set_level)
+		result, err := api.InvokeTool(ctx, "system", NewSystemToolProvider().ExecuteTool, originalToolName, args)
+		if err != nil {
+			return nil, err
+		}
+		return convertToMCPResult(result), nil
+
+	case strings.HasPrefix(originalToolName, "session_"):
+		// Default arguments of the caller's MCP session
+		result, err := api.InvokeTool(ctx, "session", NewSessionToolProvider(a).ExecuteTool, originalToolName, args)
 		if err != nil {
 			return nil, err
 		}
 		return convertToMCPResult(result), nil
 
 	default:
-		return nil, fmt.Errorf("no handler found for core tool: %s", originalToolName)
+		return nil, &api.NotFoundError{ResourceType: "tool", ResourceName: toolName, Message: fmt.Sprintf("no handler found for core tool: %s", originalToolName)}
 	}
 }
 
@@ -2651,6 +2887,22 @@ func getSessionIDFromContext(ctx context.Context) string {
 	return ""
 }
 
+// CallerIdentity returns the identity of the authenticated caller of a
+// request handled by the aggregator, or the zero identity for unauthenticated
+// requests. Email and groups come from the user info of the validated OAuth
+// token. It is the resolver for api.AuthContextMiddleware.
+func CallerIdentity(ctx context.Context) api.Identity {
+	identity := api.Identity{
+		Subject:   getUserSubjectFromContext(ctx),
+		SessionID: getSessionIDFromContext(ctx),
+	}
+	if userInfo, ok := oauthhandler.UserInfoFromContext(ctx); ok && userInfo != nil && userInfo.ID == identity.Subject {
+		identity.Email = userInfo.Email
+		identity.Groups = userInfo.Groups
+	}
+	return identity
+}
+
 // tearDownSession clears all per-session server state: auth store entries,
 // capability cache, pooled connections, and the subject tracker mapping.
 // The oauth token store is NOT touched here — callers do that separately
@@ -3214,6 +3466,11 @@ func (a *AggregatorServer) ListPromptsForContext(ctx context.Context) []mcp.Prom
 // ReadResource retrieves the contents of a resource by URI.
 // This resolves the resource URI to its origin server and reads the content.
 func (a *AggregatorServer) ReadResource(ctx context.Context, uri string) (*mcp.ReadResourceResult, error) {
+	// Workflow step artifacts are served by muster itself
+	if _, _, ok := api.ParseExecutionArtifactURI(uri); ok {
+		return a.readArtifactResource(ctx, uri)
+	}
+
 	// Resolve the exposed URI back to server and original URI
 	serverName, originalURI, err := a.registry.ResolveResourceName(uri)
 	if err != nil {
@@ -3265,6 +3522,24 @@ func (a *AggregatorServer) GetPrompt(ctx context.Context, name string, args map[
 	return result, nil
 }
 
+// NotifySessions sends a notifications/message logging notification with
+// level, logger, and data to every connected MCP session. It does nothing
+// while the aggregator is stopped.
+func (a *AggregatorServer) NotifySessions(level, logger string, data any) {
+	a.mu.RLock()
+	mcpServer := a.mcpServer
+	a.mu.RUnlock()
+	if mcpServer == nil {
+		return
+	}
+
+	mcpServer.SendNotificationToAllClients(string(mcp.MethodNotificationMessage), map[string]any{
+		"level":  level,
+		"logger": logger,
+		"data":   data,
+	})
+}
+
 // ListServersRequiringAuth returns a list of servers that require authentication
 // for the current session. This enables the list_tools meta-tool to inform users
 // about servers that are available but require authentication before their tools
@@ -3314,3 +3589,88 @@ func (a *AggregatorServer) ListServersRequiringAuth(ctx context.Context) []api.S
 
 	return authRequired
 }
+
+// CapabilityOriginsForContext returns the MCP servers providing the tools,
+// resources, and prompts available to the current session context.
+//
+// Names are resolved through the registry first, and through the session's
+// CapabilityStore entries for OAuth-protected servers. Core tools, which no
+// MCP server provides, are left out.
+func (a *AggregatorServer) CapabilityOriginsForContext(ctx context.Context) api.CapabilityOrigins {
+	sessionID := getSessionIDFromContext(ctx)
+	origins := api.CapabilityOrigins{
+		Tools:     map[string][]string{},
+		Resources: map[string]string{},
+		Prompts:   map[string]string{},
+	}
+
+	for _, tool := range a.ListToolsForContext(ctx) {
+		if a.registry.IsFamilyTool(tool.Name) {
+			servers := a.registry.GetToolServerNames(tool.Name)
+			sort.Strings(servers)
+			origins.Tools[tool.Name] = servers
+			continue
+		}
+		if serverName, _, err := a.registry.ResolveToolName(tool.Name); err == nil {
+			origins.Tools[tool.Name] = []string{serverName}
+			continue
+		}
+		if sessionID != "" {
+			if serverName, _, err := a.resolveUserTool(sessionID, tool.Name); err == nil {
+				origins.Tools[tool.Name] = []string{serverName}
+			}
+		}
+	}
+
+	// Session-scoped resources and prompts of OAuth-protected servers are not
+	// in the registry's name mapping, so they are matched by exposed name.
+	sessionCaps := a.sessionCapabilities(ctx, sessionID)
+	for _, resource := range a.ListResourcesForContext(ctx) {
+		if serverName, _, err := a.registry.ResolveResourceName(resource.URI); err == nil {
+			origins.Resources[resource.URI] = serverName
+			continue
+		}
+		for serverName, caps := range sessionCaps {
+			for _, r := range caps.Resources {
+				if a.registry.ExposedResourceURI(serverName, r.URI) == resource.URI {
+					origins.Resources[resource.URI] = serverName
+				}
+			}
+		}
+	}
+	for _, prompt := range a.ListPromptsForContext(ctx) {
+		if serverName, _, err := a.registry.ResolvePromptName(prompt.Name); err == nil {
+			origins.Prompts[prompt.Name] = serverName
+			continue
+		}
+		for serverName, caps := range sessionCaps {
+			for _, p := range caps.Prompts {
+				if a.registry.ExposedPromptName(serverName, p.Name) == prompt.Name {
+					origins.Prompts[prompt.Name] = serverName
+				}
+			}
+		}
+	}
+
+	return origins
+}
+
+// sessionCapabilities returns the CapabilityStore entries of sessionID for
+// the OAuth-protected servers, keyed by server name.
+func (a *AggregatorServer) sessionCapabilities(ctx context.Context, sessionID string) map[string]*oauthstore.Capabilities {
+	result := map[string]*oauthstore.Capabilities{}
+	if sessionID == "" || a.capabilityStore == nil {
+		return result
+	}
+	for serverName, info := range a.registry.GetAllServers() {
+		if !info.RequiresSessionAuth() {
+			continue
+		}
+		caps, err := a.capabilityStore.Get(ctx, sessionID, serverName)
+		if err != nil || caps == nil {
+			continue
+		}
+		result[serverName] = caps
+	}
+	return result
+}
@@ -249,3 +249,39 @@ func TestListServersRequiringAuth(t *testing.T) {
 		assert.Equal(t, "disabled-exchange", result[0].Name)
 	})
 }
+
+func TestCapabilityOriginsForContext(t *testing.T) {
+	ctx := api.WithSessionID(context.Background(), "test-session")
+
+	reg := NewServerRegistry("x")
+	require.NoError(t, reg.Register(ctx, ServerRegistration{Name: "plain", ToolPrefix: "plain"}, &mockMCPClient{
+		tools:     []mcp.Tool{{Name: "get"}},
+		resources: []mcp.Resource{{URI: "file:///readme", Name: "readme"}},
+		prompts:   []mcp.Prompt{{Name: "explain"}},
+	}))
+	require.NoError(t, reg.RegisterPendingAuth(PendingAuthRegistration{
+		ServerRegistration: ServerRegistration{Name: "oauth", ToolPrefix: "oauth"},
+		URL:                "https://oauth.example.com",
+		AuthInfo:           &AuthInfo{Issuer: "https://dex.example.com", Scope: "openid"},
+	}))
+
+	capStore := oauthstore.NewInMemoryCapabilityStore(30 * time.Minute)
+	defer capStore.Stop()
+	require.NoError(t, capStore.Set(context.Background(), "test-session", "oauth", &oauthstore.Capabilities{
+		Tools:   []mcp.Tool{{Name: "search"}},
+		Prompts: []mcp.Prompt{{Name: "triage"}},
+	}))
+
+	agg := &AggregatorServer{registry: reg, capabilityStore: capStore}
+	origins := agg.CapabilityOriginsForContext(ctx)
+
+	assert.Equal(t, map[string][]string{
+		"x_plain_get":    {"plain"},
+		"x_oauth_search": {"oauth"},
+	}, origins.Tools)
+	assert.Equal(t, map[string]string{reg.ExposedResourceURI("plain", "file:///readme"): "plain"}, origins.Resources)
+	assert.Equal(t, map[string]string{
+		"x_plain_explain": "plain",
+		"x_oauth_triage":  "oauth",
+	}, origins.Prompts)
+}
@@ -15,10 +15,12 @@ import (
 // middlewares execute inside that tool-handler span, so log records pick up
 // trace_id / span_id via the slog ↔ OTel bridge and histogram observations
 // attach the active TraceID as an exemplar — the join Grafana uses to pivot
-// from a latency bucket to the originating trace.
+// from a latency bucket to the originating trace. CorrelationID runs first,
+// so the log lines of Logging carry the correlation ID of the call.
 func mcpServerOptions() []server.ServerOption {
 	return []server.ServerOption{
 		mcpotel.WithServerTracing(otel.Tracer(observability.TracerName)),
+		server.WithToolHandlerMiddleware(CorrelationID()),
 		server.WithToolHandlerMiddleware(Logging()),
 		server.WithToolHandlerMiddleware(Metrics()),
 	}
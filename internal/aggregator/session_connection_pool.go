@@ -298,6 +298,39 @@ func (p *SessionConnectionPool) EvictServer(serverName string) {
 	}
 }
 
+// clients returns the pooled clients by key, for the liveness monitor.
+func (p *SessionConnectionPool) clients() map[poolKey]MCPClient {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	clients := make(map[poolKey]MCPClient, len(p.pool))
+	for key, entry := range p.pool {
+		if entry.Client != nil {
+			clients[key] = entry.Client
+		}
+	}
+	return clients
+}
+
+// evictClient removes and closes the entry for key if it still holds client,
+// so a connection that was replaced in the meantime is kept. It reports
+// whether the entry was evicted.
+func (p *SessionConnectionPool) evictClient(key poolKey, client MCPClient, reason string) bool {
+	p.mu.Lock()
+	entry, ok := p.pool[key]
+	if ok && entry.Client == client {
+		delete(p.pool, key)
+	} else {
+		ok = false
+	}
+	p.mu.Unlock()
+
+	if ok {
+		closeQuietly(client, key.SessionID, key.ServerName, reason)
+	}
+	return ok
+}
+
 // DrainAll closes and removes every entry in the pool. Intended for use
 // during graceful shutdown.
 func (p *SessionConnectionPool) DrainAll() {
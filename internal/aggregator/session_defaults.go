@@ -0,0 +1,210 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// sessionDefaultArgs holds the default arguments of each MCP session, such as
+// cluster=prod, which are merged into the tool calls of the session that
+// leave them out. They live as long as the transport session and are not
+// persisted.
+//
+// All methods are safe for concurrent use.
+type sessionDefaultArgs struct {
+	mu       sync.RWMutex
+	sessions map[string]map[string]any // MCP session ID -> argument -> value
+}
+
+func newSessionDefaultArgs() *sessionDefaultArgs {
+	return &sessionDefaultArgs{sessions: make(map[string]map[string]any)}
+}
+
+// Get returns a copy of the default arguments of the session, empty if it
+// has none.
+func (s *sessionDefaultArgs) Get(sessionID string) map[string]any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	defaults := make(map[string]any, len(s.sessions[sessionID]))
+	maps.Copy(defaults, s.sessions[sessionID])
+	return defaults
+}
+
+// Set merges values into the default arguments of the session, with a nil
+// value removing the argument, and returns the resulting defaults. With
+// replace, the existing defaults are dropped first.
+func (s *sessionDefaultArgs) Set(sessionID string, values map[string]any, replace bool) map[string]any {
+	s.mu.Lock()
+	defaults := s.sessions[sessionID]
+	if defaults == nil || replace {
+		defaults = make(map[string]any)
+	}
+	for name, value := range values {
+		if value == nil {
+			delete(defaults, name)
+		} else {
+			defaults[name] = value
+		}
+	}
+	if len(defaults) == 0 {
+		delete(s.sessions, sessionID)
+	} else {
+		s.sessions[sessionID] = defaults
+	}
+	s.mu.Unlock()
+	return s.Get(sessionID)
+}
+
+// Clear removes the default arguments of the session. Called when the
+// session is unregistered.
+func (s *sessionDefaultArgs) Clear(sessionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, sessionID)
+}
+
+// sessionDefaultsAppliedKey marks a context whose tool call already received
+// the default arguments of its session, so the tool calls of the steps of a
+// workflow execution get only the arguments the workflow passes them.
+type sessionDefaultsAppliedKey struct{}
+
+// applySessionDefaults returns args with the default arguments of the MCP
+// session in ctx added that the input schema of the tool declares and args
+// leaves out. Explicit arguments always win. The returned context marks the
+// defaults as applied, so the nested tool calls of the same request keep
+// their arguments. Without defaults, ctx and args are returned unchanged.
+func (a *AggregatorServer) applySessionDefaults(ctx context.Context, toolName string, args map[string]any) (context.Context, map[string]any) {
+	if a.sessionDefaults == nil || strings.HasPrefix(toolName, "core_session_") {
+		return ctx, args
+	}
+	if applied, _ := ctx.Value(sessionDefaultsAppliedKey{}).(bool); applied {
+		return ctx, args
+	}
+	sessionID := getTransportSessionID(ctx)
+	if sessionID == "" {
+		return ctx, args
+	}
+	defaults := a.sessionDefaults.Get(sessionID)
+	if len(defaults) == 0 {
+		return ctx, args
+	}
+	ctx = context.WithValue(ctx, sessionDefaultsAppliedKey{}, true)
+
+	properties := a.toolProperties(ctx, toolName)
+	merged := make(map[string]any, len(args)+len(defaults))
+	maps.Copy(merged, args)
+	var added []string
+	for name, value := range defaults {
+		if _, declared := properties[name]; !declared {
+			continue
+		}
+		if _, set := merged[name]; set {
+			continue
+		}
+		merged[name] = value
+		added = append(added, name)
+	}
+	if len(added) == 0 {
+		return ctx, args
+	}
+	logging.DebugWithAttrs("Aggregator", "Applied session default arguments",
+		slog.String("tool", toolName), slog.Any("args", added),
+		logging.TransportSessionID(sessionID))
+	return ctx, merged
+}
+
+// toolProperties returns the properties of the input schema of the tool
+// exposed as toolName to the session in ctx, or nil if it is not found.
+func (a *AggregatorServer) toolProperties(ctx context.Context, toolName string) map[string]any {
+	var tools []mcp.Tool
+	if a.isCoreToolByName(toolName) {
+		tools = a.getAllCoreToolsAsMCPTools()
+	} else {
+		tools = a.ListToolsForContext(ctx)
+	}
+	for _, tool := range tools {
+		if tool.Name == toolName {
+			return tool.InputSchema.Properties
+		}
+	}
+	return nil
+}
+
+// SessionToolProvider provides the core tools that manage the default
+// arguments of the caller's MCP session, such as core_session_defaults_set.
+type SessionToolProvider struct {
+	aggregator *AggregatorServer
+}
+
+// NewSessionToolProvider creates a new session tool provider.
+func NewSessionToolProvider(aggregator *AggregatorServer) *SessionToolProvider {
+	return &SessionToolProvider{aggregator: aggregator}
+}
+
+// GetTools returns the session tools.
+func (p *SessionToolProvider) GetTools() []api.ToolMetadata {
+	return []api.ToolMetadata{
+		{
+			Name:        "session_defaults_get",
+			Description: "Return the default arguments of this session, which are added to its tool calls and workflow executions that declare them but leave them out",
+		},
+		{
+			Name:        "session_defaults_set",
+			Description: "Set default arguments for this session, e.g. {\"cluster\": \"prod\"}, which are added to its later tool calls and workflow executions that declare them but leave them out",
+			Args: []api.ArgMetadata{
+				{
+					Name:        "defaults",
+					Type:        api.ArgTypeObject,
+					Required:    true,
+					Description: "Argument names and their default values; a null value removes the default",
+				},
+				{
+					Name:        "replace",
+					Type:        api.ArgTypeBoolean,
+					Required:    false,
+					Description: "Drop the existing defaults of the session instead of merging into them (default: false)",
+					Default:     false,
+				},
+			},
+		},
+		{
+			Name:        "session_defaults_clear",
+			Description: "Remove all default arguments of this session",
+		},
+	}
+}
+
+// ExecuteTool executes a session tool by name.
+func (p *SessionToolProvider) ExecuteTool(ctx context.Context, toolName string, args map[string]any) (*api.CallToolResult, error) {
+	sessionID := getTransportSessionID(ctx)
+	if sessionID == "" || p.aggregator.sessionDefaults == nil {
+		return api.HandleError(api.NewValidationFailedError("%s requires an MCP session", "core_"+toolName)), nil
+	}
+	defaults := p.aggregator.sessionDefaults
+
+	switch toolName {
+	case "session_defaults_get":
+		return &api.CallToolResult{Content: []any{defaults.Get(sessionID)}}, nil
+	case "session_defaults_set":
+		values, ok := args["defaults"].(map[string]any)
+		if !ok {
+			return api.HandleError(api.NewValidationFailedError("defaults must be an object")), nil
+		}
+		replace, _ := args["replace"].(bool)
+		return &api.CallToolResult{Content: []any{defaults.Set(sessionID, values, replace)}}, nil
+	case "session_defaults_clear":
+		defaults.Clear(sessionID)
+		return &api.CallToolResult{Content: []any{map[string]any{}}}, nil
+	default:
+		return nil, fmt.Errorf("unknown session tool: %s", toolName)
+	}
+}
@@ -0,0 +1,128 @@
+package aggregator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// defaultsTestSession is a minimal MCP client session.
+type defaultsTestSession struct {
+	id string
+}
+
+func (s *defaultsTestSession) Initialize()       {}
+func (s *defaultsTestSession) Initialized() bool { return true }
+func (s *defaultsTestSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return make(chan mcp.JSONRPCNotification, 1)
+}
+func (s *defaultsTestSession) SessionID() string { return s.id }
+
+// sessionContext returns a context of a tool call of the MCP session id.
+func sessionContext(id string) context.Context {
+	ctx := api.WithSessionID(context.Background(), "user")
+	return mcpserver.NewMCPServer("test", "1.0.0").WithContext(ctx, &defaultsTestSession{id: id})
+}
+
+func TestSessionDefaultArgs(t *testing.T) {
+	s := newSessionDefaultArgs()
+	assert.Empty(t, s.Get("s1"))
+
+	assert.Equal(t, map[string]any{"cluster": "prod", "namespace": "team-x"},
+		s.Set("s1", map[string]any{"cluster": "prod", "namespace": "team-x"}, false))
+	assert.Equal(t, map[string]any{"cluster": "staging", "namespace": "team-x"},
+		s.Set("s1", map[string]any{"cluster": "staging"}, false))
+	assert.Equal(t, map[string]any{"cluster": "staging"},
+		s.Set("s1", map[string]any{"namespace": nil}, false))
+	assert.Equal(t, map[string]any{"region": "eu"},
+		s.Set("s1", map[string]any{"region": "eu"}, true))
+	assert.Empty(t, s.Get("s2"))
+
+	// Returned defaults are copies
+	s.Get("s1")["region"] = "us"
+	assert.Equal(t, "eu", s.Get("s1")["region"])
+
+	s.Clear("s1")
+	assert.Empty(t, s.Get("s1"))
+}
+
+func TestApplySessionDefaults(t *testing.T) {
+	ctx := context.Background()
+	server := NewAggregatorServer(AggregatorConfig{Host: "localhost", Port: 0, MusterPrefix: "x"}, nil)
+	defer server.connPool.Stop()
+
+	client := &mockMCPClient{tools: []mcp.Tool{{
+		Name: "get_pods",
+		InputSchema: mcp.ToolInputSchema{
+			Type:       "object",
+			Properties: map[string]any{"cluster": map[string]any{"type": "string"}, "namespace": map[string]any{"type": "string"}},
+		},
+	}}}
+	require.NoError(t, server.registry.Register(ctx, ServerRegistration{Name: "kubernetes"}, client))
+	toolName := server.registry.ExposedToolName("kubernetes", "get_pods")
+
+	sessionCtx := sessionContext("s1")
+	server.sessionDefaults.Set("s1", map[string]any{"cluster": "prod", "namespace": "team-x", "region": "eu"}, false)
+
+	// Declared arguments the call leaves out are added, explicit ones win
+	callCtx, args := server.applySessionDefaults(sessionCtx, toolName, map[string]any{"namespace": "kube-system"})
+	assert.Equal(t, map[string]any{"cluster": "prod", "namespace": "kube-system"}, args)
+
+	// Nested calls of the same request keep their arguments
+	_, nested := server.applySessionDefaults(callCtx, toolName, map[string]any{})
+	assert.Empty(t, nested)
+
+	// Core tools get the defaults they declare
+	_, args = server.applySessionDefaults(sessionCtx, "core_logging_set_level", map[string]any{"level": "debug"})
+	assert.Equal(t, map[string]any{"level": "debug"}, args)
+	server.sessionDefaults.Set("s1", map[string]any{"subsystem": "Aggregator"}, false)
+	_, args = server.applySessionDefaults(sessionCtx, "core_logging_set_level", map[string]any{"level": "debug"})
+	assert.Equal(t, map[string]any{"level": "debug", "subsystem": "Aggregator"}, args)
+
+	// Other sessions and calls without a session are unaffected
+	_, args = server.applySessionDefaults(sessionContext("s2"), toolName, map[string]any{})
+	assert.Empty(t, args)
+	_, args = server.applySessionDefaults(ctx, toolName, map[string]any{})
+	assert.Empty(t, args)
+}
+
+func TestCoreSessionDefaultsTools(t *testing.T) {
+	server := NewAggregatorServer(AggregatorConfig{Host: "localhost", Port: 0}, nil)
+	defer server.connPool.Stop()
+	assert.True(t, server.isCoreToolByName("core_session_defaults_set"))
+
+	var toolNames []string
+	for _, tool := range server.getAllCoreToolsAsMCPTools() {
+		toolNames = append(toolNames, tool.Name)
+	}
+	assert.Subset(t, toolNames, []string{"core_session_defaults_get", "core_session_defaults_set", "core_session_defaults_clear"})
+
+	call := func(ctx context.Context, name string, args map[string]any) map[string]any {
+		t.Helper()
+		result, err := server.callCoreToolDirectly(ctx, name, args)
+		require.NoError(t, err)
+		require.False(t, result.IsError, "%v", result.Content)
+		var defaults map[string]any
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &defaults))
+		return defaults
+	}
+
+	ctx := sessionContext("s1")
+	assert.Equal(t, map[string]any{"cluster": "prod"},
+		call(ctx, "core_session_defaults_set", map[string]any{"defaults": map[string]any{"cluster": "prod"}}))
+	assert.Equal(t, map[string]any{"cluster": "prod"}, call(ctx, "core_session_defaults_get", nil))
+	assert.Empty(t, call(ctx, "core_session_defaults_clear", nil))
+	assert.Empty(t, call(ctx, "core_session_defaults_get", nil))
+
+	// Without an MCP session there is nothing to keep the defaults for
+	result, err := server.callCoreToolDirectly(context.Background(), "core_session_defaults_get", nil)
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
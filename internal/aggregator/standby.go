@@ -0,0 +1,191 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// handoverLock is the lock file the instances of a warm standby setup
+// compete for. The instance holding it serves. The operating system releases
+// the lock when the process exits, so a crashed instance hands over too.
+type handoverLock struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+// TryAcquire takes the lock without waiting and reports whether it got it.
+// The holder writes its PID into the file, for operators to find it.
+func (l *handoverLock) TryAcquire() (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file != nil {
+		return true, nil
+	}
+
+	file, err := os.OpenFile(l.path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return false, fmt.Errorf("failed to open lock file: %w", err)
+	}
+	locked, err := tryLockFile(file)
+	if err != nil || !locked {
+		_ = file.Close()
+		return false, err
+	}
+	if err := file.Truncate(0); err == nil {
+		_, _ = file.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0)
+	}
+	l.file = file
+	return true, nil
+}
+
+// Release gives the lock up. It is safe to call without holding the lock.
+func (l *handoverLock) Release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return
+	}
+	if err := l.file.Close(); err != nil {
+		logging.Debug("Aggregator", "Error closing standby lock file %s: %v", l.path, err)
+	}
+	l.file = nil
+}
+
+// startStandby takes the standby lock before the aggregator opens its
+// listeners. If another instance holds it, the aggregator stands by: it
+// reports standing, and a goroutine calls serve once it gets the lock. Until
+// then the MCP servers register as usual, so the standby takes over with its
+// backend connections in place.
+func (a *AggregatorServer) startStandby(serve func() error) (bool, error) {
+	lockFile := a.config.Standby.LockFile
+	if lockFile == "" {
+		lockFile = filepath.Join(a.config.ConfigDir, defaultStandbyLockFile)
+	}
+	lock := &handoverLock{path: lockFile}
+	acquired, err := lock.TryAcquire()
+	if err != nil {
+		return false, fmt.Errorf("failed to take standby lock %s: %w", lockFile, err)
+	}
+
+	a.mu.Lock()
+	a.handover = lock
+	if acquired {
+		a.mu.Unlock()
+		logging.Info("Aggregator", "Holding standby lock %s, serving", lockFile)
+		a.handedOver()
+		return false, nil
+	}
+	ctx, cancel := context.WithCancel(a.ctx)
+	done := make(chan struct{})
+	a.cancelStandby, a.standbyDone = cancel, done
+	a.mu.Unlock()
+
+	interval := a.config.Standby.PollInterval
+	if interval <= 0 {
+		interval = defaultStandbyPollInterval
+	}
+	logging.Info("Aggregator", "Standing by: standby lock %s is held by another instance", lockFile)
+	go a.runStandby(ctx, done, lock, interval, serve)
+	return true, nil
+}
+
+// runStandby tries to take the standby lock every interval until it gets it
+// and calls serve, or ctx is done. It closes done when it returns.
+func (a *AggregatorServer) runStandby(ctx context.Context, done chan struct{}, lock *handoverLock, interval time.Duration, serve func() error) {
+	defer close(done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			acquired, err := lock.TryAcquire()
+			if err != nil {
+				logging.Warn("Aggregator", "Failed to take standby lock %s: %v", lock.path, err)
+				continue
+			}
+			if !acquired {
+				continue
+			}
+			logging.Info("Aggregator", "Took standby lock %s, taking over", lock.path)
+			a.handedOver()
+			if err := serve(); err != nil {
+				logging.Error("Aggregator", err, "Failed to take over from the previous instance")
+				if a.errorCallback != nil {
+					a.errorCallback(err)
+				}
+			}
+			return
+		}
+	}
+}
+
+// SetHandoverCallback sets the function called once a standby aggregator
+// holds the standby lock, right before it opens its listeners: on start if no
+// other instance serves, or else on takeover. It is not called without
+// standby, and must be set before Start.
+func (a *AggregatorServer) SetHandoverCallback(fn func()) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.onHandover = fn
+}
+
+// handedOver calls the handover callback, if set.
+func (a *AggregatorServer) handedOver() {
+	a.mu.RLock()
+	fn := a.onHandover
+	a.mu.RUnlock()
+	if fn != nil {
+		fn()
+	}
+}
+
+// stopStandby stops waiting for the standby lock, if the aggregator stands
+// by, so a takeover cannot open listeners during the shutdown.
+func (a *AggregatorServer) stopStandby() {
+	a.mu.Lock()
+	cancel, done := a.cancelStandby, a.standbyDone
+	a.cancelStandby, a.standbyDone = nil, nil
+	a.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// releaseHandover releases the standby lock, if the aggregator holds it, so
+// a standby takes over.
+func (a *AggregatorServer) releaseHandover() {
+	a.mu.Lock()
+	lock := a.handover
+	a.handover = nil
+	a.mu.Unlock()
+	if lock != nil {
+		lock.Release()
+	}
+}
+
+// listen opens the TCP listener of the transport on addr, with SO_REUSEPORT
+// if configured.
+func (a *AggregatorServer) listen(addr string) (net.Listener, error) {
+	var lc net.ListenConfig
+	if a.config.ReusePort {
+		lc.Control = reusePortControl
+	}
+	listener, err := lc.Listen(a.ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	return listener, nil
+}
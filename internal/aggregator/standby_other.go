@@ -0,0 +1,23 @@
+//go:build !linux && !darwin
+
+package aggregator
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// errStandbyUnsupported is reported for the standby lock and SO_REUSEPORT on
+// platforms without flock and SO_REUSEPORT.
+var errStandbyUnsupported = errors.New("the standby lock and reusePort are only supported on Linux and macOS")
+
+// tryLockFile is only supported on Linux and macOS.
+func tryLockFile(*os.File) (bool, error) {
+	return false, errStandbyUnsupported
+}
+
+// reusePortControl is only supported on Linux and macOS.
+func reusePortControl(_, _ string, _ syscall.RawConn) error {
+	return errStandbyUnsupported
+}
@@ -0,0 +1,126 @@
+//go:build linux || darwin
+
+package aggregator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandoverLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "aggregator.lock")
+	first := &handoverLock{path: path}
+	second := &handoverLock{path: path}
+
+	acquired, err := first.TryAcquire()
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// The holder records its PID
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(os.Getpid()), strings.TrimSpace(string(content)))
+
+	acquired, err = second.TryAcquire()
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	first.Release()
+	first.Release()
+	acquired, err = second.TryAcquire()
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	second.Release()
+}
+
+func TestAggregatorServer_StandbyTakeover(t *testing.T) {
+	dir := t.TempDir()
+	holder := &handoverLock{path: filepath.Join(dir, defaultStandbyLockFile)}
+	acquired, err := holder.TryAcquire()
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	server := NewAggregatorServer(AggregatorConfig{
+		Host:      "localhost",
+		ConfigDir: dir,
+		Standby:   StandbyConfig{Enabled: true, PollInterval: 10 * time.Millisecond},
+	}, nil)
+	defer server.connPool.Stop()
+	server.ctx = context.Background()
+	handedOver := make(chan struct{})
+	server.SetHandoverCallback(func() { close(handedOver) })
+
+	served := make(chan struct{})
+	standing, err := server.startStandby(func() error {
+		select {
+		case <-handedOver:
+		default:
+			t.Error("standby served before the handover callback")
+		}
+		close(served)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, standing)
+
+	select {
+	case <-served:
+		t.Fatal("standby served while the lock was held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	holder.Release()
+	select {
+	case <-served:
+	case <-time.After(5 * time.Second):
+		t.Fatal("standby did not take over")
+	}
+
+	server.stopStandby()
+	server.releaseHandover()
+	acquired, err = holder.TryAcquire()
+	require.NoError(t, err)
+	assert.True(t, acquired, "the lock is released when the aggregator stops")
+	holder.Release()
+}
+
+func TestAggregatorServer_StopStandby(t *testing.T) {
+	dir := t.TempDir()
+	holder := &handoverLock{path: filepath.Join(dir, "custom.lock")}
+	acquired, err := holder.TryAcquire()
+	require.NoError(t, err)
+	require.True(t, acquired)
+	defer holder.Release()
+
+	server := NewAggregatorServer(AggregatorConfig{
+		Host:    "localhost",
+		Standby: StandbyConfig{Enabled: true, LockFile: holder.path, PollInterval: 10 * time.Millisecond},
+	}, nil)
+	defer server.connPool.Stop()
+	server.ctx = context.Background()
+
+	standing, err := server.startStandby(func() error {
+		t.Error("stopped standby served")
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, standing)
+
+	// Once stopped, the standby no longer takes over: stopStandby waits for
+	// it, so the released lock stays free
+	server.stopStandby()
+	holder.Release()
+	other := &handoverLock{path: holder.path}
+	acquired, err = other.TryAcquire()
+	require.NoError(t, err)
+	assert.True(t, acquired, "the stopped standby took the lock")
+	other.Release()
+}
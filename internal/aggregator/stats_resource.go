@@ -0,0 +1,62 @@
+package aggregator
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/logging"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ToolStatsResourceURI is the URI for the tool usage statistics MCP resource.
+// The tool_stats meta-tool serves the same report with sorting options.
+const ToolStatsResourceURI = "stats://tools"
+
+// registerToolStatsResource registers the stats://tools resource with the MCP server.
+func (a *AggregatorServer) registerToolStatsResource() {
+	a.mu.RLock()
+	mcpServer := a.mcpServer
+	a.mu.RUnlock()
+
+	if a.callStats == nil {
+		return
+	}
+	if mcpServer == nil {
+		logging.Warn("Aggregator", "Cannot register tool stats resource: MCP server not initialized")
+		return
+	}
+
+	resource := mcp.NewResource(
+		ToolStatsResourceURI,
+		"Tool usage statistics: call counts, error rates, and latency of each tool since muster started, and the available tools that were never called.",
+		mcp.WithMIMEType("application/json"),
+	)
+
+	mcpServer.AddResource(resource, a.handleToolStatsResource)
+	logging.Info("Aggregator", "Registered stats://tools resource")
+}
+
+// handleToolStatsResource handles requests for the stats://tools resource.
+// The unused tools are the ones visible to the requesting session.
+func (a *AggregatorServer) handleToolStatsResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	tools := a.ListToolsForContext(ctx)
+	available := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		available = append(available, tool.Name)
+	}
+
+	data, err := json.Marshal(api.NewToolStatsReport(a.callStats.ToolUsage(), available, api.ToolStatsSortCalls))
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      ToolStatsResourceURI,
+			MIMEType: "application/json",
+			Text:     string(data),
+		},
+	}, nil
+}
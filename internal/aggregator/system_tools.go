@@ -0,0 +1,111 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// SystemToolProvider provides core tools that report on or adjust muster
+// itself rather than a managed resource, such as core_system_status and
+// core_logging_set_level.
+type SystemToolProvider struct{}
+
+// NewSystemToolProvider creates a new system tool provider.
+func NewSystemToolProvider() *SystemToolProvider {
+	return &SystemToolProvider{}
+}
+
+// GetTools returns the system tools.
+func (p *SystemToolProvider) GetTools() []api.ToolMetadata {
+	return []api.ToolMetadata{
+		{
+			Name:        "system_status",
+			Description: "Report which internal handlers are registered, their versions and health, and their recent tool call errors",
+		},
+		{
+			Name:        "system_gc",
+			Description: "Find and clean up orphaned runtime artifacts: MCPServer services whose definition was deleted, and stdio server processes that outlived muster or their service",
+			Args: []api.ArgMetadata{
+				{
+					Name:        "dry_run",
+					Type:        api.ArgTypeBoolean,
+					Required:    false,
+					Description: "Only report the orphaned artifacts without removing them",
+				},
+			},
+		},
+		{
+			Name:        "logging_set_level",
+			Description: "Set the log level of muster globally or of a single subsystem until restart, and return the resulting levels",
+			Args: []api.ArgMetadata{
+				{
+					Name:        "level",
+					Type:        api.ArgTypeString,
+					Required:    true,
+					Description: "Log level: debug, info, warn, or error; with a subsystem, 'default' removes its own level",
+				},
+				{
+					Name:        "subsystem",
+					Type:        api.ArgTypeString,
+					Required:    false,
+					Description: "Subsystem to set the level of, e.g. Aggregator (case-insensitive); all subsystems without a level of their own if omitted",
+				},
+			},
+		},
+	}
+}
+
+// ExecuteTool executes a system tool by name.
+func (p *SystemToolProvider) ExecuteTool(ctx context.Context, toolName string, args map[string]any) (*api.CallToolResult, error) {
+	switch toolName {
+	case "system_status":
+		return &api.CallToolResult{
+			Content: []any{api.GetDiagnostics()},
+			IsError: false,
+		}, nil
+	case "system_gc":
+		return handleSystemGC(ctx, args), nil
+	case "logging_set_level":
+		return handleLoggingSetLevel(args), nil
+	default:
+		return nil, fmt.Errorf("unknown system tool: %s", toolName)
+	}
+}
+
+// handleSystemGC handles the system_gc tool.
+func handleSystemGC(ctx context.Context, args map[string]any) *api.CallToolResult {
+	gc, ok := api.GetServiceManager().(api.GarbageCollector)
+	if !ok {
+		return api.HandleError(api.NewUnavailableError("service manager", nil))
+	}
+	dryRun, _ := args["dry_run"].(bool)
+	return &api.CallToolResult{Content: []any{gc.CollectGarbage(ctx, dryRun)}}
+}
+
+// handleLoggingSetLevel handles the logging_set_level tool.
+func handleLoggingSetLevel(args map[string]any) *api.CallToolResult {
+	levelName, _ := args["level"].(string)
+	if levelName == "" {
+		return api.HandleError(api.NewValidationFailedError("level is required"))
+	}
+	subsystem, _ := args["subsystem"].(string)
+
+	if subsystem != "" && levelName == "default" {
+		logging.ResetSubsystemLevel(subsystem)
+	} else {
+		level, err := logging.ParseLevel(levelName)
+		if err != nil {
+			return api.HandleError(&api.ValidationFailedError{Err: err})
+		}
+		if subsystem != "" {
+			logging.SetSubsystemLevel(subsystem, level)
+		} else {
+			logging.SetLevel(level)
+		}
+	}
+
+	return &api.CallToolResult{Content: []any{logging.GetLevels()}}
+}
@@ -0,0 +1,71 @@
+package aggregator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+func TestCoreSystemStatus(t *testing.T) {
+	a := &AggregatorServer{}
+	assert.True(t, a.isCoreToolByName("core_system_status"))
+
+	var toolNames []string
+	for _, tool := range a.getAllCoreToolsAsMCPTools() {
+		toolNames = append(toolNames, tool.Name)
+	}
+	assert.Contains(t, toolNames, "core_system_status")
+
+	result, err := a.callCoreToolDirectly(context.Background(), "core_system_status", nil)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, result.Content, 1)
+
+	text, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok, "expected text content, got %T", result.Content[0])
+	var diagnostics api.Diagnostics
+	require.NoError(t, json.Unmarshal([]byte(text.Text), &diagnostics))
+	assert.NotEmpty(t, diagnostics.Version)
+
+	var handlerNames []string
+	for _, h := range diagnostics.Handlers {
+		handlerNames = append(handlerNames, h.Name)
+	}
+	assert.Contains(t, handlerNames, "workflow")
+	assert.Contains(t, handlerNames, "aggregator")
+}
+
+func TestCoreLoggingSetLevel(t *testing.T) {
+	t.Cleanup(func() {
+		logging.SetLevel(logging.LevelInfo)
+		logging.ResetSubsystemLevel("Aggregator")
+	})
+	a := &AggregatorServer{}
+	assert.True(t, a.isCoreToolByName("core_logging_set_level"))
+
+	result, err := a.callCoreToolDirectly(context.Background(), "core_logging_set_level",
+		map[string]interface{}{"subsystem": "Aggregator", "level": "debug"})
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	text, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok, "expected text content, got %T", result.Content[0])
+	assert.JSONEq(t, `{"level":"info","subsystems":{"aggregator":"debug"}}`, text.Text)
+
+	_, err = a.callCoreToolDirectly(context.Background(), "core_logging_set_level",
+		map[string]interface{}{"subsystem": "aggregator", "level": "default"})
+	require.NoError(t, err)
+	assert.Empty(t, logging.GetLevels().Subsystems)
+
+	result, err = a.callCoreToolDirectly(context.Background(), "core_logging_set_level",
+		map[string]interface{}{"level": "verbose"})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Equal(t, logging.LevelInfo, logging.GetLevels().Level)
+}
@@ -92,7 +92,7 @@ func (a *AggregatorServer) createMetaToolHandler(provider api.ToolProvider, tool
 		}
 
 		// Execute the meta-tool through the provider
-		result, err := provider.ExecuteTool(ctx, toolName, args)
+		result, err := api.InvokeTool(ctx, "metatools", provider.ExecuteTool, toolName, args)
 		if err != nil {
 			logging.Error("AggregatorMetaToolHandler", err, "Meta-tool execution failed for %s with args %+v", toolName, args)
 			return mcp.NewToolResultError(fmt.Sprintf("Meta-tool execution failed: %v", err)), nil
@@ -200,6 +200,8 @@ func mapWorkflowToolName(name string) string {
 //   - core_mcpserver_* tools (MCP server management)
 //   - core_events tool (event management)
 //   - core_auth_* tools (authentication operations)
+//   - core_system_* tools (diagnostics of muster itself)
+//   - core_session_* tools (default arguments of the caller's session)
 //
 // Each tool is prefixed with "core_" to distinguish it from MCP server tools
 // which are prefixed with "x_<server>_".
@@ -211,10 +213,14 @@ func mapWorkflowToolName(name string) string {
 // surface matches the spec. See callCoreToolDirectly for the inverse mapping
 // that routes execution requests back to the provider.
 //
+// Each management tool carries a readOnlyHint annotation. In read-only mode
+// the mutating tools are left out.
+//
 // Returns a slice of MCP tools representing all available core tools.
 func (a *AggregatorServer) getAllCoreToolsAsMCPTools() []mcp.Tool {
 	var tools []mcp.Tool
 	const corePrefix = "core_"
+	readOnly := a.IsReadOnlyMode()
 
 	// Helper to add tools from a provider, with optional name remapping.
 	addToolsFromProvider := func(handler any, remap func(string) string) {
@@ -227,11 +233,19 @@ func (a *AggregatorServer) getAllCoreToolsAsMCPTools() []mcp.Tool {
 				if remap != nil {
 					name = remap(toolMeta.Name)
 				}
+				if readOnly && api.IsMutatingTool(toolMeta.Name) {
+					continue
+				}
 				tool := mcp.Tool{
 					Name:        name,
 					Description: toolMeta.Description,
 					InputSchema: convertToMCPSchema(toolMeta.Args),
 				}
+				// Workflow execution tools run arbitrary steps, so only the
+				// management tools are annotated
+				if name == corePrefix+toolMeta.Name {
+					tool.Annotations = coreToolAnnotations(toolMeta.Name)
+				}
 				// Stash discovery labels (e.g. Workflow CRD labels) in _meta so
 				// the filter_tools discovery tier can facet on them in-process.
 				// list_tools / describe_tool ignore _meta, so this is invisible
@@ -260,6 +274,8 @@ func (a *AggregatorServer) getAllCoreToolsAsMCPTools() []mcp.Tool {
 		api.GetConfigHandler(),
 		api.GetMCPServerManager(),
 		api.GetEventManager(),
+		NewSystemToolProvider(),
+		NewSessionToolProvider(a),
 	}
 
 	for _, provider := range otherProviders {
@@ -304,6 +320,13 @@ func (a *AggregatorServer) getAllCoreToolsAsMCPTools() []mcp.Tool {
 	return tools
 }
 
+// coreToolAnnotations returns the annotations of the core tool toolName,
+// without the core_ prefix, which tell clients whether it modifies muster.
+func coreToolAnnotations(toolName string) mcp.ToolAnnotation {
+	readOnly := !api.IsMutatingTool(toolName)
+	return mcp.ToolAnnotation{ReadOnlyHint: &readOnly}
+}
+
 // convertToMCPResult converts an internal tool result to MCP format.
 //
 // This function handles the conversion from the internal CallToolResult format
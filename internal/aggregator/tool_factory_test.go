@@ -1,9 +1,12 @@
 package aggregator
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
+	"github.com/mark3labs/mcp-go/mcp"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -73,3 +76,40 @@ func TestConvertToMCPResult_StructuredContent(t *testing.T) {
 	result = convertToMCPResult(&api.CallToolResult{Content: []any{"some text"}})
 	assert.Nil(t, result.StructuredContent)
 }
+
+// TestReadOnlyMode verifies that read-only mode hides and rejects the
+// mutating core tools while the readOnlyHint annotation tells them apart.
+func TestReadOnlyMode(t *testing.T) {
+	coreTools := func(a *AggregatorServer) map[string]mcp.Tool {
+		tools := make(map[string]mcp.Tool)
+		for _, tool := range a.getAllCoreToolsAsMCPTools() {
+			tools[tool.Name] = tool
+		}
+		return tools
+	}
+
+	a := &AggregatorServer{}
+	tools := coreTools(a)
+	require.Contains(t, tools, "core_system_gc")
+	require.NotNil(t, tools["core_system_gc"].Annotations.ReadOnlyHint)
+	assert.False(t, *tools["core_system_gc"].Annotations.ReadOnlyHint)
+	require.Contains(t, tools, "core_system_status")
+	require.NotNil(t, tools["core_system_status"].Annotations.ReadOnlyHint)
+	assert.True(t, *tools["core_system_status"].Annotations.ReadOnlyHint)
+
+	a.SetReadOnlyMode(true)
+	assert.True(t, a.IsReadOnlyMode())
+	tools = coreTools(a)
+	assert.NotContains(t, tools, "core_system_gc")
+	assert.NotContains(t, tools, "core_logging_set_level")
+	assert.Contains(t, tools, "core_system_status")
+
+	_, err := a.callCoreToolDirectly(context.Background(), "core_logging_set_level", map[string]any{"level": "debug"})
+	require.Error(t, err)
+	assert.True(t, api.IsUnauthorized(err))
+	assert.Contains(t, err.Error(), "read-only mode")
+
+	result, err := a.callCoreToolDirectly(context.Background(), "core_system_status", nil)
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+}
@@ -244,6 +244,31 @@ type AggregatorConfig struct {
 	// This should only be enabled in development environments.
 	Yolo bool
 
+	// ReadOnly disables the mutating core tools, such as the create, update,
+	// delete, start, and stop tools, for demo and audit deployments. They are
+	// left out of tool listings and their calls are rejected.
+	ReadOnly bool
+
+	// ConnectConcurrency is the number of MCP servers that are connected to
+	// and have their capabilities fetched at the same time, at startup and
+	// when they become healthy (default: 8).
+	ConnectConcurrency int
+
+	// ConnectTimeout is the time budget of each MCP server for connecting and
+	// fetching its capabilities (default: 30s).
+	ConnectTimeout time.Duration
+
+	// Liveness configures the periodic pings of the connections to the MCP
+	// servers.
+	Liveness LivenessConfig
+
+	// ReusePort binds the listener of the transport with SO_REUSEPORT, so
+	// another instance can bind the same address during a handover.
+	ReusePort bool
+
+	// Standby configures the warm standby handover between instances.
+	Standby StandbyConfig
+
 	// ConfigDir is the user configuration directory for workflows and other configs.
 	// This is used to load workflow definitions and make them available as tools.
 	ConfigDir string
@@ -273,6 +298,43 @@ type AggregatorConfig struct {
 	// Admin, when enabled, starts a separate HTTP listener that serves the
 	// session management web UI. See internal/admin for details.
 	Admin AdminConfig
+
+	// Gateway, when enabled, starts a separate HTTP listener that serves the
+	// REST gateway for core operations. See internal/gateway for details.
+	Gateway GatewayConfig
+}
+
+// LivenessConfig holds the liveness monitoring configuration of the
+// connections to the MCP servers. Zero values use the defaults.
+type LivenessConfig struct {
+	// Disabled turns the pings off.
+	Disabled bool
+
+	// Interval is the time between the pings of a connection (default: 30s).
+	Interval time.Duration
+
+	// Timeout is the time a ping may take before it counts as failed
+	// (default: 10s).
+	Timeout time.Duration
+
+	// FailureThreshold is the number of failed pings in a row after which a
+	// connection is considered dead (default: 3).
+	FailureThreshold int
+}
+
+// StandbyConfig holds the warm standby configuration of the aggregator.
+type StandbyConfig struct {
+	// Enabled makes the aggregator take the lock file before it opens its
+	// listeners, and wait as a standby while another instance holds it.
+	Enabled bool
+
+	// LockFile is the path of the lock file (default: aggregator.lock in
+	// ConfigDir).
+	LockFile string
+
+	// PollInterval is the time between the attempts of a standby to take the
+	// lock (default: 1s).
+	PollInterval time.Duration
 }
 
 // AdminConfig holds admin web UI configuration for the aggregator.
@@ -287,6 +349,18 @@ type AdminConfig struct {
 	BindAddress string
 }
 
+// GatewayConfig holds REST gateway configuration for the aggregator.
+type GatewayConfig struct {
+	// Enabled controls whether the gateway listener is started.
+	Enabled bool
+
+	// Port is the TCP port for the gateway listener (default: 9998 when enabled).
+	Port int
+
+	// BindAddress is the interface to bind the gateway listener to (default: 127.0.0.1).
+	BindAddress string
+}
+
 // OAuthServerConfig holds OAuth server configuration for protecting the Muster Server.
 // This is a simplified configuration that references the full config from the config package.
 type OAuthServerConfig struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/giantswarm/muster/internal/config"
 	"github.com/giantswarm/muster/pkg/logging"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -14,9 +15,9 @@ import (
 // to call tools through the aggregator without directly coupling to the aggregator implementation.
 // This follows the service locator pattern to maintain architectural boundaries.
 //
-// It exposes only tool execution (CallTool / CallToolInternal). Tool-availability
-// checks live on ToolChecker; consumers that need them depend on ToolChecker
-// directly.
+// It exposes tool execution (CallTool / CallToolInternal) and prompt rendering
+// (GetPrompt). Tool-availability checks live on ToolChecker; consumers that
+// need them depend on ToolChecker directly.
 type ToolCaller struct{}
 
 // NewToolCaller creates a new API-based tool caller instance.
@@ -126,6 +127,54 @@ type AggregatorHandler interface {
 	// AuthConfig within registration may be nil; in either case the server
 	// is flagged as requiring per-session authentication.
 	RegisterServerPendingAuth(registration PendingAuthRegistration) error
+
+	// ApplyConfig applies a reloaded aggregator configuration to the running
+	// aggregator. The muster prefix and yolo mode change in place; changed
+	// listener or OAuth settings restart the aggregator service in the
+	// background. A changed port is not applied and only takes effect after
+	// muster is restarted.
+	//
+	// Args:
+	//   - ctx: Context for the operation
+	//   - cfg: The aggregator section of the reloaded configuration
+	//
+	// Returns:
+	//   - *AggregatorConfigChanges: The changed settings and how they are applied
+	//   - error: nil on success, or an error if the configuration cannot be applied
+	ApplyConfig(ctx context.Context, cfg config.AggregatorConfig) (*AggregatorConfigChanges, error)
+}
+
+// SessionNotifier is implemented by AggregatorHandlers that can notify the
+// connected MCP sessions, which the "mcp" notification channel requires.
+type SessionNotifier interface {
+	// NotifySessions sends a notifications/message logging notification to
+	// every connected MCP session.
+	//
+	// Args:
+	//   - level: The MCP logging level, e.g. "warning" or "error"
+	//   - logger: The name of the notification's logger, e.g. "muster"
+	//   - data: The JSON-serializable notification data
+	NotifySessions(level, logger string, data any)
+}
+
+// AggregatorConfigChanges reports how a reloaded aggregator configuration was
+// applied. Settings are named by their config.yaml key below aggregator, e.g.
+// "musterPrefix" or "oauth.server".
+type AggregatorConfigChanges struct {
+	// Applied lists the settings applied to the running aggregator.
+	Applied []string `json:"applied,omitempty"`
+
+	// Restarted lists the settings applied by restarting the aggregator service.
+	Restarted []string `json:"restarted,omitempty"`
+
+	// RestartRequired lists the settings that only take effect after muster
+	// is restarted.
+	RestartRequired []string `json:"restartRequired,omitempty"`
+}
+
+// HasChanges reports whether any aggregator setting changed.
+func (c *AggregatorConfigChanges) HasChanges() bool {
+	return c != nil && len(c.Applied)+len(c.Restarted)+len(c.RestartRequired) > 0
 }
 
 // PendingAuthRegistration describes a remote MCP server that responded with
@@ -238,6 +287,34 @@ func (atc *ToolCaller) CallToolInternal(ctx context.Context, toolName string, ar
 	return result, nil
 }
 
+// GetPrompt renders a prompt through the registered meta-tools data provider
+// (the aggregator), like the get_prompt meta-tool. This lets workflows render
+// prompts in prompt steps.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout control
+//   - name: The name of the prompt to render
+//   - args: Arguments to pass to the prompt
+//
+// Returns:
+//   - *mcp.GetPromptResult: The rendered prompt messages
+//   - error: nil on success, or an error if the prompt cannot be rendered
+func (atc *ToolCaller) GetPrompt(ctx context.Context, name string, args map[string]string) (*mcp.GetPromptResult, error) {
+	provider := GetMetaToolsDataProvider()
+	if provider == nil {
+		return nil, fmt.Errorf("prompt provider not available")
+	}
+
+	logging.Debug("APIToolCaller", "Getting prompt %s with args: %v", name, args)
+
+	result, err := provider.GetPrompt(ctx, name, args)
+	if err != nil {
+		logging.Error("APIToolCaller", err, "Failed to get prompt %s", name)
+		return nil, fmt.Errorf("failed to get prompt %s: %w", name, err)
+	}
+	return result, nil
+}
+
 // ToolChecker implements config.ToolAvailabilityChecker using the API layer.
 // It provides a way for the configuration system to validate tool availability
 // without direct coupling to the aggregator implementation.
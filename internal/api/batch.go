@@ -0,0 +1,267 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaxBatchItems is the largest number of items accepted in one batch request.
+const MaxBatchItems = 100
+
+// BatchOperation is the operation of a batch item.
+type BatchOperation string
+
+const (
+	// BatchOperationCreate creates a resource, like the <resource>_create tool.
+	BatchOperationCreate BatchOperation = "create"
+	// BatchOperationUpdate updates a resource, like the <resource>_update tool.
+	BatchOperationUpdate BatchOperation = "update"
+	// BatchOperationDelete deletes a resource, like the <resource>_delete tool.
+	BatchOperationDelete BatchOperation = "delete"
+)
+
+// BatchItem is one operation of a batch request.
+type BatchItem struct {
+	// Operation is the operation to perform (required).
+	Operation BatchOperation `json:"operation"`
+
+	// Args are the arguments of the operation, the same as for the
+	// single-resource tool (e.g. mcpserver_create). They must include the
+	// name of the resource.
+	Args map[string]interface{} `json:"args"`
+}
+
+// Name returns the name of the resource the item operates on.
+func (i BatchItem) Name() string {
+	name, _ := i.Args[FieldName].(string)
+	return name
+}
+
+// BatchRequest represents a request to create, update and delete several
+// resources of one type in a single call (core_<resource>_batch).
+//
+// Items are applied in order. Without Atomic, every item is applied even if
+// earlier items failed. With Atomic, all items are validated before any is
+// applied, and the items already applied are reverted when an item fails.
+//
+// Example:
+//
+//	request := BatchRequest{
+//	    Atomic: true,
+//	    Items: []BatchItem{
+//	        {Operation: BatchOperationCreate, Args: map[string]interface{}{"name": "git", "type": "stdio", "command": "mcp-git"}},
+//	        {Operation: BatchOperationDelete, Args: map[string]interface{}{"name": "legacy"}},
+//	    },
+//	}
+type BatchRequest struct {
+	// Items are the operations to perform, in order (required).
+	Items []BatchItem `json:"items"`
+
+	// Atomic applies either all items or none of them.
+	Atomic bool `json:"atomic,omitempty"`
+}
+
+// BatchItemStatus is the outcome of a batch item.
+type BatchItemStatus string
+
+const (
+	// BatchItemSucceeded means the item was applied.
+	BatchItemSucceeded BatchItemStatus = "succeeded"
+	// BatchItemFailed means the item could not be validated or applied.
+	BatchItemFailed BatchItemStatus = "failed"
+	// BatchItemSkipped means the item was not applied because an atomic
+	// batch failed.
+	BatchItemSkipped BatchItemStatus = "skipped"
+	// BatchItemRolledBack means the item was applied and then reverted
+	// because a later item of an atomic batch failed.
+	BatchItemRolledBack BatchItemStatus = "rolled_back"
+	// BatchItemRollbackFailed means the item was applied, but reverting it
+	// after a later item of an atomic batch failed did not succeed.
+	BatchItemRollbackFailed BatchItemStatus = "rollback_failed"
+)
+
+// BatchItemResult is the result of one batch item.
+type BatchItemResult struct {
+	// Index is the position of the item in the request.
+	Index int `json:"index"`
+
+	// Operation is the operation of the item.
+	Operation BatchOperation `json:"operation"`
+
+	// Name is the name of the resource the item operates on.
+	Name string `json:"name"`
+
+	// Status is the outcome of the item.
+	Status BatchItemStatus `json:"status"`
+
+	// Error describes why the item failed or could not be reverted.
+	Error *ErrorInfo `json:"error,omitempty"`
+}
+
+// BatchResult is the result of a batch request.
+type BatchResult struct {
+	// Results are the results of the items, in request order.
+	Results []BatchItemResult `json:"results"`
+
+	// Succeeded is the number of items that were applied and not reverted.
+	Succeeded int `json:"succeeded"`
+
+	// Failed is the number of items that failed.
+	Failed int `json:"failed"`
+
+	// Atomic reports whether the batch was atomic.
+	Atomic bool `json:"atomic"`
+}
+
+// BatchTarget applies batch items to one type of resource. It is implemented
+// by the handlers that offer a core_<resource>_batch tool.
+type BatchTarget interface {
+	// ValidateBatchItem checks an item without applying it. Atomic batches
+	// validate all items before applying the first.
+	ValidateBatchItem(ctx context.Context, item BatchItem) error
+
+	// ApplyBatchItem applies an item and returns a function that reverts it,
+	// used to roll back atomic batches.
+	ApplyBatchItem(ctx context.Context, item BatchItem) (revert func(context.Context) error, err error)
+}
+
+// BatchArgs returns the argument metadata of a core_<resource>_batch tool.
+func BatchArgs(resource string) []ArgMetadata {
+	return []ArgMetadata{
+		{
+			Name:     "items",
+			Type:     ArgTypeArray,
+			Required: true,
+			Description: fmt.Sprintf("Operations to apply in order (at most %d). Each item has an operation (create, update or delete) "+
+				"and the args of the matching %s_<operation> tool, including the name.", MaxBatchItems, resource),
+			Schema: map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"operation": map[string]interface{}{
+							"type": "string",
+							"enum": []string{string(BatchOperationCreate), string(BatchOperationUpdate), string(BatchOperationDelete)},
+						},
+						"args": map[string]interface{}{"type": "object"},
+					},
+					"required": []string{"operation", "args"},
+				},
+			},
+		},
+		{
+			Name:        "atomic",
+			Type:        ArgTypeBoolean,
+			Required:    false,
+			Description: "Validate all items before applying any, and revert the applied items if one fails (default: false)",
+			Default:     false,
+		},
+	}
+}
+
+// ParseBatchRequest parses and checks the arguments of a batch tool.
+func ParseBatchRequest(args map[string]interface{}) (*BatchRequest, error) {
+	var req BatchRequest
+	if err := ParseRequest(args, &req); err != nil {
+		return nil, err
+	}
+	if len(req.Items) == 0 {
+		return nil, NewValidationFailedError("items must contain at least one operation")
+	}
+	if len(req.Items) > MaxBatchItems {
+		return nil, NewValidationFailedError("items must contain at most %d operations, got %d", MaxBatchItems, len(req.Items))
+	}
+	for i, item := range req.Items {
+		switch item.Operation {
+		case BatchOperationCreate, BatchOperationUpdate, BatchOperationDelete:
+		default:
+			return nil, NewValidationFailedError("items[%d]: unsupported operation %q (supported: create, update, delete)", i, item.Operation)
+		}
+		if item.Name() == "" {
+			return nil, NewValidationFailedError("items[%d]: args.name is required", i)
+		}
+	}
+	return &req, nil
+}
+
+// ExecuteBatch applies the items of req to target and reports the outcome of
+// every item.
+func ExecuteBatch(ctx context.Context, req *BatchRequest, target BatchTarget) *BatchResult {
+	result := &BatchResult{Results: make([]BatchItemResult, len(req.Items)), Atomic: req.Atomic}
+	for i, item := range req.Items {
+		result.Results[i] = BatchItemResult{Index: i, Operation: item.Operation, Name: item.Name(), Status: BatchItemSkipped}
+	}
+
+	if !req.Atomic {
+		for i, item := range req.Items {
+			if _, err := target.ApplyBatchItem(ctx, item); err != nil {
+				result.Results[i].fail(err)
+				continue
+			}
+			result.Results[i].Status = BatchItemSucceeded
+		}
+		result.count()
+		return result
+	}
+
+	// Validate everything first so that most failures leave nothing to revert
+	valid := true
+	for i, item := range req.Items {
+		if err := target.ValidateBatchItem(ctx, item); err != nil {
+			result.Results[i].fail(err)
+			valid = false
+		}
+	}
+	if !valid {
+		result.count()
+		return result
+	}
+
+	reverts := make([]func(context.Context) error, 0, len(req.Items))
+	for i, item := range req.Items {
+		revert, err := target.ApplyBatchItem(ctx, item)
+		if err != nil {
+			result.Results[i].fail(err)
+			// Revert in reverse order, so that e.g. an update is reverted
+			// before the create of the same resource
+			for j := len(reverts) - 1; j >= 0; j-- {
+				if revertErr := reverts[j](ctx); revertErr != nil {
+					result.Results[j].Status = BatchItemRollbackFailed
+					result.Results[j].Error = &ErrorInfo{Code: ErrorCodeOf(revertErr), Message: revertErr.Error()}
+				} else {
+					result.Results[j].Status = BatchItemRolledBack
+				}
+			}
+			break
+		}
+		result.Results[i].Status = BatchItemSucceeded
+		reverts = append(reverts, revert)
+	}
+	result.count()
+	return result
+}
+
+// BatchToolResult converts a batch result to a tool result, which is an
+// error result when an item failed.
+func BatchToolResult(result *BatchResult) *CallToolResult {
+	return &CallToolResult{
+		Content: []interface{}{result},
+		IsError: result.Failed > 0,
+	}
+}
+
+func (r *BatchItemResult) fail(err error) {
+	r.Status = BatchItemFailed
+	r.Error = &ErrorInfo{Code: ErrorCodeOf(err), Message: err.Error()}
+}
+
+func (r *BatchResult) count() {
+	for _, item := range r.Results {
+		switch item.Status {
+		case BatchItemSucceeded, BatchItemRollbackFailed:
+			r.Succeeded++
+		case BatchItemFailed:
+			r.Failed++
+		}
+	}
+}
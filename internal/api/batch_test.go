@@ -0,0 +1,205 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fakeBatchTarget records applied and reverted items. Items named in
+// invalid fail validation, items named in failing fail to apply and items
+// named in irreversible fail to revert.
+type fakeBatchTarget struct {
+	invalid      map[string]bool
+	failing      map[string]bool
+	irreversible map[string]bool
+
+	applied  []string
+	reverted []string
+}
+
+func (f *fakeBatchTarget) ValidateBatchItem(ctx context.Context, item BatchItem) error {
+	if f.invalid[item.Name()] {
+		return NewValidationFailedError("%s is invalid", item.Name())
+	}
+	return nil
+}
+
+func (f *fakeBatchTarget) ApplyBatchItem(ctx context.Context, item BatchItem) (func(context.Context) error, error) {
+	name := item.Name()
+	if f.failing[name] {
+		return nil, NewConflictError("mcpserver", name)
+	}
+	f.applied = append(f.applied, name)
+	return func(ctx context.Context) error {
+		if f.irreversible[name] {
+			return errors.New("revert failed")
+		}
+		f.reverted = append(f.reverted, name)
+		return nil
+	}, nil
+}
+
+func batchItems(names ...string) []BatchItem {
+	items := make([]BatchItem, len(names))
+	for i, name := range names {
+		items[i] = BatchItem{Operation: BatchOperationCreate, Args: map[string]interface{}{"name": name}}
+	}
+	return items
+}
+
+func batchStatuses(result *BatchResult) []BatchItemStatus {
+	statuses := make([]BatchItemStatus, len(result.Results))
+	for i, item := range result.Results {
+		statuses[i] = item.Status
+	}
+	return statuses
+}
+
+func equalStrings[T ~string](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestParseBatchRequest(t *testing.T) {
+	req, err := ParseBatchRequest(map[string]interface{}{
+		"atomic": true,
+		"items": []interface{}{
+			map[string]interface{}{"operation": "create", "args": map[string]interface{}{"name": "a", "type": "stdio"}},
+			map[string]interface{}{"operation": "delete", "args": map[string]interface{}{"name": "b"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ParseBatchRequest() error = %v", err)
+	}
+	if !req.Atomic || len(req.Items) != 2 || req.Items[0].Name() != "a" || req.Items[1].Operation != BatchOperationDelete {
+		t.Errorf("ParseBatchRequest() = %+v", req)
+	}
+
+	tooMany := make([]interface{}, MaxBatchItems+1)
+	for i := range tooMany {
+		tooMany[i] = map[string]interface{}{"operation": "delete", "args": map[string]interface{}{"name": "x"}}
+	}
+
+	invalid := []map[string]interface{}{
+		{},
+		{"items": []interface{}{}},
+		{"items": tooMany},
+		{"items": []interface{}{map[string]interface{}{"operation": "patch", "args": map[string]interface{}{"name": "a"}}}},
+		{"items": []interface{}{map[string]interface{}{"operation": "create", "args": map[string]interface{}{}}}},
+		{"items": []interface{}{map[string]interface{}{"operation": "create", "args": map[string]interface{}{"name": "a"}, "force": true}}},
+		{"items": []interface{}{}, "unknown": true},
+	}
+	for i, args := range invalid {
+		if _, err := ParseBatchRequest(args); ErrorCodeOf(err) != ErrorCodeValidationFailed {
+			t.Errorf("case %d: ParseBatchRequest() error = %v, want validation_failed", i, err)
+		}
+	}
+}
+
+func TestExecuteBatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		atomic       bool
+		target       *fakeBatchTarget
+		wantStatuses []BatchItemStatus
+		wantApplied  []string
+		wantReverted []string
+		wantFailed   int
+	}{
+		{
+			name:         "all succeed",
+			target:       &fakeBatchTarget{},
+			wantStatuses: []BatchItemStatus{BatchItemSucceeded, BatchItemSucceeded, BatchItemSucceeded},
+			wantApplied:  []string{"a", "b", "c"},
+		},
+		{
+			name:         "non-atomic continues after failure",
+			target:       &fakeBatchTarget{failing: map[string]bool{"b": true}},
+			wantStatuses: []BatchItemStatus{BatchItemSucceeded, BatchItemFailed, BatchItemSucceeded},
+			wantApplied:  []string{"a", "c"},
+			wantFailed:   1,
+		},
+		{
+			name:         "atomic validation failure applies nothing",
+			atomic:       true,
+			target:       &fakeBatchTarget{invalid: map[string]bool{"c": true}},
+			wantStatuses: []BatchItemStatus{BatchItemSkipped, BatchItemSkipped, BatchItemFailed},
+			wantFailed:   1,
+		},
+		{
+			name:         "atomic apply failure rolls back in reverse order",
+			atomic:       true,
+			target:       &fakeBatchTarget{failing: map[string]bool{"c": true}},
+			wantStatuses: []BatchItemStatus{BatchItemRolledBack, BatchItemRolledBack, BatchItemFailed},
+			wantApplied:  []string{"a", "b"},
+			wantReverted: []string{"b", "a"},
+			wantFailed:   1,
+		},
+		{
+			name:         "atomic rollback failure is reported",
+			atomic:       true,
+			target:       &fakeBatchTarget{failing: map[string]bool{"b": true}, irreversible: map[string]bool{"a": true}},
+			wantStatuses: []BatchItemStatus{BatchItemRollbackFailed, BatchItemFailed, BatchItemSkipped},
+			wantApplied:  []string{"a"},
+			wantFailed:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExecuteBatch(context.Background(), &BatchRequest{Items: batchItems("a", "b", "c"), Atomic: tt.atomic}, tt.target)
+
+			if got := batchStatuses(result); !equalStrings(got, tt.wantStatuses) {
+				t.Errorf("statuses = %v, want %v", got, tt.wantStatuses)
+			}
+			if !equalStrings(tt.target.applied, tt.wantApplied) {
+				t.Errorf("applied = %v, want %v", tt.target.applied, tt.wantApplied)
+			}
+			if !equalStrings(tt.target.reverted, tt.wantReverted) {
+				t.Errorf("reverted = %v, want %v", tt.target.reverted, tt.wantReverted)
+			}
+			if result.Failed != tt.wantFailed {
+				t.Errorf("Failed = %d, want %d", result.Failed, tt.wantFailed)
+			}
+			if got := BatchToolResult(result).IsError; got != (tt.wantFailed > 0) {
+				t.Errorf("BatchToolResult().IsError = %v, want %v", got, tt.wantFailed > 0)
+			}
+			for _, item := range result.Results {
+				if item.Status == BatchItemFailed && item.Error == nil {
+					t.Errorf("item %d failed without an error", item.Index)
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteBatchErrorCodes(t *testing.T) {
+	target := &fakeBatchTarget{failing: map[string]bool{"a": true}}
+	result := ExecuteBatch(context.Background(), &BatchRequest{Items: batchItems("a")}, target)
+	if result.Results[0].Error == nil || result.Results[0].Error.Code != ErrorCodeConflict {
+		t.Errorf("Error = %+v, want code %q", result.Results[0].Error, ErrorCodeConflict)
+	}
+}
+
+func TestErrorFromResult(t *testing.T) {
+	if err := ErrorFromResult(&CallToolResult{Content: []interface{}{"ok"}}); err != nil {
+		t.Errorf("ErrorFromResult(success) = %v, want nil", err)
+	}
+
+	err := ErrorFromResult(HandleErrorWithPrefix(NewMCPServerNotFoundError("git"), "Failed to update MCP server"))
+	if ErrorCodeOf(err) != ErrorCodeNotFound {
+		t.Errorf("ErrorCodeOf() = %q, want %q", ErrorCodeOf(err), ErrorCodeNotFound)
+	}
+
+	err = ErrorFromResult(&CallToolResult{Content: []interface{}{"boom"}, IsError: true})
+	if ErrorCodeOf(err) != ErrorCodeInternal || err.Error() != "boom" {
+		t.Errorf("ErrorFromResult() = %v (%s), want internal boom", err, ErrorCodeOf(err))
+	}
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"time"
+
+	"github.com/giantswarm/muster/internal/config"
+)
+
+// ConfigExportVersion is the version of the ConfigExport format written by
+// core_config_export.
+const ConfigExportVersion = "v1"
+
+// ConfigExport is a manifest of a whole muster environment, written by
+// core_config_export and applied by core_config_import to clone or restore
+// an environment.
+//
+// The definitions are stored as the arguments of the matching create tools,
+// so the manifest can also be edited by hand or replayed with the
+// core_<resource>_batch tools.
+type ConfigExport struct {
+	// Version is the manifest format version, ConfigExportVersion.
+	Version string `json:"version"`
+
+	// ExportedAt is when the manifest was written.
+	ExportedAt time.Time `json:"exportedAt"`
+
+	// Config is the main configuration (config.yaml).
+	Config *config.MusterConfig `json:"config,omitempty"`
+
+	// MCPServers are the MCPServer definitions.
+	MCPServers []MCPServerCreateRequest `json:"mcpServers"`
+
+	// Workflows are the Workflow definitions.
+	Workflows []WorkflowCreateRequest `json:"workflows"`
+}
+
+// ConfigImportResult is the result of core_config_import.
+type ConfigImportResult struct {
+	// ConfigApplied reports whether the main configuration was replaced.
+	ConfigApplied bool `json:"configApplied"`
+
+	// Aggregator reports how the imported aggregator settings were applied to
+	// the running aggregator. It is nil unless ConfigApplied is set.
+	Aggregator *AggregatorConfigChanges `json:"aggregator,omitempty"`
+
+	// MCPServers is the outcome of importing the MCPServer definitions.
+	MCPServers *BatchResult `json:"mcpServers,omitempty"`
+
+	// Workflows is the outcome of importing the Workflow definitions.
+	Workflows *BatchResult `json:"workflows,omitempty"`
+
+	// Skipped lists the definitions that already existed and were left
+	// unchanged, as "<type>/<name>". Existing definitions are only updated
+	// with overwrite.
+	Skipped []string `json:"skipped,omitempty"`
+}
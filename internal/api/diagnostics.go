@@ -0,0 +1,311 @@
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/muster/pkg/project"
+)
+
+// Handler health values reported by GetDiagnostics.
+const (
+	// HandlerHealthHealthy means the handler is registered and neither its
+	// health check nor its recent calls report errors.
+	HandlerHealthHealthy = "healthy"
+	// HandlerHealthDegraded means the handler is registered but some of its
+	// tool calls failed within the recent error window.
+	HandlerHealthDegraded = "degraded"
+	// HandlerHealthUnhealthy means the handler's health check failed.
+	HandlerHealthUnhealthy = "unhealthy"
+	// HandlerHealthNotRegistered means no handler is registered, so Get
+	// returns nil and callers fail with "handler not available" errors.
+	HandlerHealthNotRegistered = "not_registered"
+)
+
+// diagnosticsErrorWindow is the period in which failed tool calls count as
+// recent errors.
+const diagnosticsErrorWindow = 15 * time.Minute
+
+// maxRecentErrors caps the number of recent error timestamps kept per handler.
+const maxRecentErrors = 1000
+
+// VersionedHandler is implemented by handlers that report their own version.
+// Handlers that do not implement it report the muster build version.
+type VersionedHandler interface {
+	Version() string
+}
+
+// HealthCheckedHandler is implemented by handlers that can check their own
+// health, e.g. whether a background component they depend on is running.
+type HealthCheckedHandler interface {
+	// CheckHealth returns an error describing why the handler is unhealthy,
+	// or nil if it is healthy.
+	CheckHealth() error
+}
+
+// HandlerDiagnostics describes a handler slot of the API service locator.
+type HandlerDiagnostics struct {
+	// Name identifies the handler, e.g. "workflow" or "service_registry".
+	// Handlers that serve core tools use the handler name of InvokeTool.
+	Name string `json:"name"`
+
+	// Registered reports whether a non-nil handler is registered.
+	Registered bool `json:"registered"`
+
+	// Type is the Go type of the registered implementation.
+	Type string `json:"type,omitempty"`
+
+	// Version is the version of the handler, see VersionedHandler.
+	Version string `json:"version,omitempty"`
+
+	// Health is one of the HandlerHealth values.
+	Health string `json:"health"`
+
+	// HealthError is the error returned by the handler's health check.
+	HealthError string `json:"healthError,omitempty"`
+
+	// Calls is the number of tool calls made through InvokeTool.
+	Calls uint64 `json:"calls"`
+
+	// Errors is the number of those calls that returned an error or an
+	// error result.
+	Errors uint64 `json:"errors"`
+
+	// RecentErrors is the number of errors within the last 15 minutes.
+	RecentErrors int `json:"recentErrors"`
+
+	// LastError is the message of the most recent error.
+	LastError string `json:"lastError,omitempty"`
+
+	// LastErrorAt is the time of the most recent error.
+	LastErrorAt *time.Time `json:"lastErrorAt,omitempty"`
+}
+
+// Diagnostics is a snapshot of the API layer's handler registry, returned by
+// GetDiagnostics and the core_system_status tool.
+type Diagnostics struct {
+	// Version is the muster build version.
+	Version string `json:"version"`
+
+	// Handlers lists all handler slots, sorted by name.
+	Handlers []HandlerDiagnostics `json:"handlers"`
+
+	// Middleware is the number of registered handler middleware.
+	Middleware int `json:"middleware"`
+
+	// EventSubscribers is the number of event bus subscriptions.
+	EventSubscribers int `json:"eventSubscribers"`
+
+	// Timestamp records when the snapshot was taken.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// handlerCallStats holds the InvokeTool counters of one handler.
+type handlerCallStats struct {
+	calls        uint64
+	errors       uint64
+	recentErrors []time.Time
+	lastError    string
+	lastErrorAt  time.Time
+}
+
+var (
+	// handlerStats maps handler names to their call counters.
+	// Access is protected by handlerStatsMutex.
+	handlerStats      = map[string]*handlerCallStats{}
+	handlerStatsMutex sync.Mutex
+)
+
+// recordHandlerCall updates the call counters of handler with the outcome of
+// a tool call made through InvokeTool.
+func recordHandlerCall(handler string, result *CallToolResult, err error) {
+	message := ""
+	switch {
+	case err != nil:
+		message = err.Error()
+	case result != nil && result.IsError:
+		message = "error result"
+		if len(result.Content) > 0 {
+			if text, ok := result.Content[0].(string); ok {
+				message = text
+			}
+		}
+	}
+
+	handlerStatsMutex.Lock()
+	defer handlerStatsMutex.Unlock()
+
+	stats, ok := handlerStats[handler]
+	if !ok {
+		stats = &handlerCallStats{}
+		handlerStats[handler] = stats
+	}
+	stats.calls++
+	if err == nil && (result == nil || !result.IsError) {
+		return
+	}
+
+	now := time.Now()
+	stats.errors++
+	stats.lastError = truncateDiagnosticMessage(message)
+	stats.lastErrorAt = now
+	stats.recentErrors = append(pruneRecentErrors(stats.recentErrors, now), now)
+	if len(stats.recentErrors) > maxRecentErrors {
+		stats.recentErrors = stats.recentErrors[len(stats.recentErrors)-maxRecentErrors:]
+	}
+}
+
+// pruneRecentErrors drops the timestamps older than diagnosticsErrorWindow.
+func pruneRecentErrors(timestamps []time.Time, now time.Time) []time.Time {
+	cutoff := now.Add(-diagnosticsErrorWindow)
+	i := sort.Search(len(timestamps), func(i int) bool { return timestamps[i].After(cutoff) })
+	return timestamps[i:]
+}
+
+func truncateDiagnosticMessage(message string) string {
+	const maxLen = 200
+	if len(message) <= maxLen {
+		return message
+	}
+	return message[:maxLen] + "..."
+}
+
+// ResetDiagnostics clears the handler call counters.
+// It is intended for tests.
+func ResetDiagnostics() {
+	handlerStatsMutex.Lock()
+	defer handlerStatsMutex.Unlock()
+	handlerStats = map[string]*handlerCallStats{}
+}
+
+// GetDiagnostics reports which handlers are registered, their versions and
+// health, and their tool call error counts, so that failures such as a tool
+// returning "handler not available" can be traced to a missing or failing
+// handler at runtime.
+//
+// Handlers that serve tool calls through InvokeTool without being registered
+// with the API layer, such as the aggregator's auth tools, are listed with
+// the counters of their calls.
+//
+// Thread-safe: Yes.
+//
+// Example:
+//
+//	for _, h := range api.GetDiagnostics().Handlers {
+//	    if h.Health != api.HandlerHealthHealthy {
+//	        fmt.Printf("%s: %s %s\n", h.Name, h.Health, h.LastError)
+//	    }
+//	}
+func GetDiagnostics() Diagnostics {
+	// Names of core tool handlers match the handler names passed to
+	// InvokeTool by the aggregator, so their call counters line up.
+	slots := map[string]any{
+		"service_registry":   GetServiceRegistry(),
+		"service":            GetServiceManager(),
+		"mcpserver":          GetMCPServerManager(),
+		"aggregator":         GetAggregator(),
+		"config":             GetConfigHandler(),
+		"workflow":           GetWorkflow(),
+		"events":             GetEventManager(),
+		"reconcile":          GetReconcileManager(),
+		"metatools":          GetMetaTools(),
+		"metatools_data":     GetMetaToolsDataProvider(),
+		"oauth":              GetOAuthHandler(),
+		"auth_client":        GetAuthHandler(),
+		"secret_credentials": GetSecretCredentialsHandler(),
+		"tool_call_stats":    GetToolCallStatsProvider(),
+	}
+
+	now := time.Now()
+	handlerStatsMutex.Lock()
+	stats := make(map[string]handlerCallStats, len(handlerStats))
+	for name, s := range handlerStats {
+		s.recentErrors = pruneRecentErrors(s.recentErrors, now)
+		stats[name] = *s
+	}
+	handlerStatsMutex.Unlock()
+
+	buildVersion := project.Version()
+	handlers := make([]HandlerDiagnostics, 0, len(slots)+len(stats))
+	for name, handler := range slots {
+		d := HandlerDiagnostics{Name: name, Health: HandlerHealthNotRegistered}
+		if !isNilHandler(handler) {
+			d.Registered = true
+			d.Type = fmt.Sprintf("%T", handler)
+			d.Version = buildVersion
+			if versioned, ok := handler.(VersionedHandler); ok {
+				d.Version = versioned.Version()
+			}
+			d.Health = HandlerHealthHealthy
+			if checked, ok := handler.(HealthCheckedHandler); ok {
+				if err := checked.CheckHealth(); err != nil {
+					d.Health = HandlerHealthUnhealthy
+					d.HealthError = err.Error()
+				}
+			}
+		}
+		handlers = append(handlers, d)
+	}
+	for name := range stats {
+		if _, ok := slots[name]; !ok {
+			handlers = append(handlers, HandlerDiagnostics{
+				Name:       name,
+				Registered: true,
+				Version:    buildVersion,
+				Health:     HandlerHealthHealthy,
+			})
+		}
+	}
+
+	for i := range handlers {
+		s, ok := stats[handlers[i].Name]
+		if !ok {
+			continue
+		}
+		handlers[i].Calls = s.calls
+		handlers[i].Errors = s.errors
+		handlers[i].RecentErrors = len(s.recentErrors)
+		if s.errors > 0 {
+			lastErrorAt := s.lastErrorAt
+			handlers[i].LastError = s.lastError
+			handlers[i].LastErrorAt = &lastErrorAt
+		}
+		if handlers[i].Health == HandlerHealthHealthy && handlers[i].RecentErrors > 0 {
+			handlers[i].Health = HandlerHealthDegraded
+		}
+	}
+	sort.Slice(handlers, func(i, j int) bool { return handlers[i].Name < handlers[j].Name })
+
+	handlerMiddlewareMutex.RLock()
+	middleware := len(handlerMiddleware)
+	handlerMiddlewareMutex.RUnlock()
+
+	eventBusMutex.RLock()
+	subscribers := len(eventSubscriptions)
+	eventBusMutex.RUnlock()
+
+	return Diagnostics{
+		Version:          buildVersion,
+		Handlers:         handlers,
+		Middleware:       middleware,
+		EventSubscribers: subscribers,
+		Timestamp:        now,
+	}
+}
+
+// isNilHandler reports whether handler is nil or an interface holding a nil
+// pointer, which a Get function would return as a non-nil interface.
+func isNilHandler(handler any) bool {
+	if handler == nil {
+		return true
+	}
+	v := reflect.ValueOf(handler)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type diagnosticsTestProvider struct {
+	healthErr error
+}
+
+func (p *diagnosticsTestProvider) ToolCallTotals() ToolCallTotals { return ToolCallTotals{} }
+
+func (p *diagnosticsTestProvider) ToolUsage() []ToolUsageStats { return nil }
+
+func (p *diagnosticsTestProvider) Version() string { return "v1.2.3" }
+
+func (p *diagnosticsTestProvider) CheckHealth() error { return p.healthErr }
+
+func findHandlerDiagnostics(t *testing.T, d Diagnostics, name string) HandlerDiagnostics {
+	t.Helper()
+	for _, h := range d.Handlers {
+		if h.Name == name {
+			return h
+		}
+	}
+	t.Fatalf("handler %q not found in diagnostics", name)
+	return HandlerDiagnostics{}
+}
+
+func TestGetDiagnostics_Registration(t *testing.T) {
+	t.Cleanup(func() { RegisterToolCallStatsProvider(nil) })
+
+	RegisterToolCallStatsProvider(nil)
+	if h := findHandlerDiagnostics(t, GetDiagnostics(), "tool_call_stats"); h.Registered || h.Health != HandlerHealthNotRegistered {
+		t.Fatalf("expected unregistered handler, got %+v", h)
+	}
+
+	// A typed nil pointer is reported as not registered as well
+	RegisterToolCallStatsProvider((*diagnosticsTestProvider)(nil))
+	if h := findHandlerDiagnostics(t, GetDiagnostics(), "tool_call_stats"); h.Registered {
+		t.Fatalf("expected typed nil handler to be reported as not registered, got %+v", h)
+	}
+
+	RegisterToolCallStatsProvider(&diagnosticsTestProvider{})
+	h := findHandlerDiagnostics(t, GetDiagnostics(), "tool_call_stats")
+	if !h.Registered || h.Health != HandlerHealthHealthy || h.Version != "v1.2.3" || h.Type != "*api.diagnosticsTestProvider" {
+		t.Fatalf("unexpected diagnostics for registered handler: %+v", h)
+	}
+
+	RegisterToolCallStatsProvider(&diagnosticsTestProvider{healthErr: errors.New("collector stopped")})
+	h = findHandlerDiagnostics(t, GetDiagnostics(), "tool_call_stats")
+	if h.Health != HandlerHealthUnhealthy || h.HealthError != "collector stopped" {
+		t.Fatalf("expected unhealthy handler, got %+v", h)
+	}
+}
+
+func TestGetDiagnostics_CallErrors(t *testing.T) {
+	t.Cleanup(func() {
+		ClearHandlerMiddleware()
+		ResetDiagnostics()
+		RegisterServiceManager(nil)
+	})
+	ClearHandlerMiddleware()
+	ResetDiagnostics()
+	RegisterServiceManager(&mockOrchestratorHandler{})
+
+	outcomes := []struct {
+		result *CallToolResult
+		err    error
+	}{
+		{result: &CallToolResult{Content: []interface{}{"ok"}}},
+		{result: HandleError(NewServiceNotFoundError("svc"))},
+		{err: errors.New("connection refused")},
+	}
+	for _, outcome := range outcomes {
+		execute := func(context.Context, string, map[string]any) (*CallToolResult, error) {
+			return outcome.result, outcome.err
+		}
+		_, _ = InvokeTool(context.Background(), "service", execute, "service_status", nil)
+	}
+	_, _ = InvokeTool(context.Background(), "auth", func(context.Context, string, map[string]any) (*CallToolResult, error) {
+		return &CallToolResult{}, nil
+	}, "auth_login", nil)
+
+	d := GetDiagnostics()
+
+	service := findHandlerDiagnostics(t, d, "service")
+	if !service.Registered || service.Calls != 3 || service.Errors != 2 || service.RecentErrors != 2 {
+		t.Fatalf("unexpected service counters: %+v", service)
+	}
+	if service.Health != HandlerHealthDegraded || service.LastError != "connection refused" || service.LastErrorAt == nil {
+		t.Fatalf("unexpected service health: %+v", service)
+	}
+
+	// Handlers that are not registered with the API layer are listed by their calls
+	auth := findHandlerDiagnostics(t, d, "auth")
+	if !auth.Registered || auth.Calls != 1 || auth.Errors != 0 || auth.Health != HandlerHealthHealthy {
+		t.Fatalf("unexpected auth diagnostics: %+v", auth)
+	}
+}
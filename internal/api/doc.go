@@ -98,6 +98,63 @@
 // This enables managers to automatically refresh availability when the tool
 // landscape changes, supporting real-time reactivity throughout the system.
 //
+// # Event Bus
+//
+// Tool update events are one kind of event on a typed in-process event bus.
+// Components publish service state changes (PublishServiceStateEvent), auth
+// outcomes (PublishAuthEvent), reconcile state transitions
+// (PublishReconcileEvent), and workflow lifecycle events (PublishWorkflowEvent),
+// and subscribe with an EventFilter on kind, type, and source:
+//
+//	// Ordered delivery through a buffered channel
+//	events, unsubscribe := api.SubscribeEventsChan(api.EventFilter{
+//	    Kinds:   []api.EventKind{api.EventKindServiceState},
+//	    Sources: []string{"mcp-aggregator"},
+//	}, 10)
+//	defer unsubscribe()
+//
+//	// Asynchronous callbacks
+//	unsubscribeAuth := api.SubscribeEvents(api.EventFilter{
+//	    Kinds: []api.EventKind{api.EventKindAuth},
+//	}, handleAuthEvent)
+//
+// Publishing never blocks: callbacks run in their own goroutines, and events
+// for a full channel are dropped.
+//
+// # Diagnostics
+//
+// GetDiagnostics reports for every handler slot whether a handler is
+// registered, its type, version (VersionedHandler), and health
+// (HealthCheckedHandler), together with the calls and recent errors counted by
+// InvokeTool. The aggregator exposes it as the core_system_status tool, so a
+// failing core tool can be traced to a missing or failing handler at runtime.
+//
+// # Handler Middleware
+//
+// Tool calls routed to handlers go through InvokeTool, which applies a chain
+// of HandlerMiddleware registered once at startup:
+//
+//	api.RegisterHandlerMiddleware(
+//	    api.RecoveryMiddleware(),
+//	    api.AuthContextMiddleware(resolveCaller),
+//	    api.MetricsMiddleware(),
+//	    api.LoggingMiddleware(),
+//	)
+//
+//	result, err := api.InvokeTool(ctx, "workflow", provider.ExecuteTool, "workflow_list", args)
+//
+// Logging, metrics, panic recovery, and auth context propagation therefore
+// live in one place instead of in every adapter's ExecuteTool.
+//
+// AuthContextMiddleware adds the caller's Identity (subject, email, groups,
+// and session ID) to the context, so handlers and the components they call can
+// attribute operations to the user:
+//
+//	identity := api.IdentityFromContext(ctx)
+//	if identity.IsAuthenticated() {
+//	    logging.Info("Workflow", "Workflow %s deleted by %s", name, logging.TruncateIdentifier(identity.Subject))
+//	}
+//
 // # API Registration Pattern
 //
 // **Critical**: All packages must follow the registration pattern:
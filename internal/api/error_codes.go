@@ -0,0 +1,308 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorCode is a stable, machine-readable identifier for the category of a
+// handler error. Codes are returned to MCP clients in the structured content
+// of error results and mapped to CLI exit codes, so existing values must never
+// be renamed.
+type ErrorCode string
+
+const (
+	// ErrorCodeNotFound means the requested resource does not exist.
+	ErrorCodeNotFound ErrorCode = "not_found"
+	// ErrorCodeConflict means the request conflicts with the current state,
+	// e.g. a resource that already exists or is modified concurrently.
+	ErrorCodeConflict ErrorCode = "conflict"
+	// ErrorCodeValidationFailed means the request or the definition it
+	// carries is invalid.
+	ErrorCodeValidationFailed ErrorCode = "validation_failed"
+	// ErrorCodeUnavailable means a component or backend needed for the
+	// request is not available; retrying later may succeed.
+	ErrorCodeUnavailable ErrorCode = "unavailable"
+	// ErrorCodeUnauthorized means the caller is not authenticated or not
+	// allowed to perform the request.
+	ErrorCodeUnauthorized ErrorCode = "unauthorized"
+	// ErrorCodeInternal is any error not covered by a more specific code.
+	ErrorCodeInternal ErrorCode = "internal"
+)
+
+// CodedError is implemented by errors that carry an ErrorCode.
+type CodedError interface {
+	error
+	Code() ErrorCode
+}
+
+// ErrorCodeOf returns the code of the first CodedError in the chain of err.
+// Errors that require authentication (see IsAuthRequiredError) are
+// ErrorCodeUnauthorized, other errors ErrorCodeInternal, and nil has no code.
+func ErrorCodeOf(err error) ErrorCode {
+	if err == nil {
+		return ""
+	}
+	var coded CodedError
+	if errors.As(err, &coded) {
+		return coded.Code()
+	}
+	if IsAuthRequiredError(err) {
+		return ErrorCodeUnauthorized
+	}
+	return ErrorCodeInternal
+}
+
+// ErrorInfo is the structured content of an error result created by
+// HandleError or HandleErrorWithPrefix, returned under the "error" key.
+type ErrorInfo struct {
+	// Code categorizes the error.
+	Code ErrorCode `json:"code"`
+	// Message is the error message without the result's prefix.
+	Message string `json:"message"`
+}
+
+// errorStructuredContent returns the structured content of an error result.
+func errorStructuredContent(err error) map[string]ErrorInfo {
+	if err == nil {
+		return nil
+	}
+	return map[string]ErrorInfo{"error": {Code: ErrorCodeOf(err), Message: err.Error()}}
+}
+
+// HandleError creates an error CallToolResult from err, carrying its code as
+// structured content.
+//
+// Example:
+//
+//	if err != nil {
+//	    return api.HandleError(err), nil
+//	}
+func HandleError(err error) *CallToolResult {
+	return NewErrorResult(err.Error(), err)
+}
+
+// NewErrorResult creates an error CallToolResult with a custom message for
+// err, e.g. one with instructions for the user, carrying the code of err as
+// structured content.
+func NewErrorResult(message string, err error) *CallToolResult {
+	return &CallToolResult{
+		Content:           []interface{}{message},
+		IsError:           true,
+		StructuredContent: errorStructuredContent(err),
+	}
+}
+
+// ConflictError indicates that a request conflicts with the current state of
+// a resource, e.g. creating a resource that already exists.
+type ConflictError struct {
+	// ResourceType categorizes the resource (e.g., "workflow", "MCP server")
+	ResourceType string
+
+	// ResourceName is the identifier of the conflicting resource
+	ResourceName string
+
+	// Message provides a custom error message if the default format is insufficient
+	Message string
+}
+
+// NewConflictError creates a ConflictError for an already existing resource.
+//
+// Example:
+//
+//	return api.NewConflictError("workflow", "deploy-app")
+func NewConflictError(resourceType, resourceName string) *ConflictError {
+	return &ConflictError{ResourceType: resourceType, ResourceName: resourceName}
+}
+
+// Error implements the error interface for ConflictError.
+func (e *ConflictError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s %s already exists", e.ResourceType, e.ResourceName)
+}
+
+// Code implements CodedError for ConflictError.
+func (e *ConflictError) Code() ErrorCode {
+	return ErrorCodeConflict
+}
+
+// IsConflict reports whether err is or wraps an error with ErrorCodeConflict.
+func IsConflict(err error) bool {
+	return ErrorCodeOf(err) == ErrorCodeConflict
+}
+
+// ValidationFailedError indicates invalid tool arguments or an invalid
+// resource definition.
+type ValidationFailedError struct {
+	// Message describes what is invalid.
+	Message string
+
+	// Err is the underlying validation error, if any.
+	Err error
+}
+
+// NewValidationFailedError formats a ValidationFailedError.
+//
+// Example:
+//
+//	return api.NewValidationFailedError("name argument is required")
+func NewValidationFailedError(format string, args ...interface{}) *ValidationFailedError {
+	return &ValidationFailedError{Message: fmt.Sprintf(format, args...)}
+}
+
+// Error implements the error interface for ValidationFailedError.
+func (e *ValidationFailedError) Error() string {
+	switch {
+	case e.Err == nil:
+		return e.Message
+	case e.Message == "":
+		return e.Err.Error()
+	default:
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+}
+
+// Unwrap returns the underlying validation error.
+func (e *ValidationFailedError) Unwrap() error {
+	return e.Err
+}
+
+// Code implements CodedError for ValidationFailedError.
+func (e *ValidationFailedError) Code() ErrorCode {
+	return ErrorCodeValidationFailed
+}
+
+// IsValidationFailed reports whether err is or wraps an error with
+// ErrorCodeValidationFailed.
+func IsValidationFailed(err error) bool {
+	return ErrorCodeOf(err) == ErrorCodeValidationFailed
+}
+
+// UnavailableError indicates that a component or backend needed for a request
+// is not available, e.g. a handler that is not registered or an MCP server
+// that is not connected.
+type UnavailableError struct {
+	// Component names what is unavailable (e.g., "workflow handler").
+	Component string
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+// NewUnavailableError creates an UnavailableError for component.
+//
+// Example:
+//
+//	return api.NewUnavailableError("workflow handler", nil)
+func NewUnavailableError(component string, err error) *UnavailableError {
+	return &UnavailableError{Component: component, Err: err}
+}
+
+// Error implements the error interface for UnavailableError.
+func (e *UnavailableError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s not available: %v", e.Component, e.Err)
+	}
+	return fmt.Sprintf("%s not available", e.Component)
+}
+
+// Unwrap returns the underlying error.
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// Code implements CodedError for UnavailableError.
+func (e *UnavailableError) Code() ErrorCode {
+	return ErrorCodeUnavailable
+}
+
+// IsUnavailable reports whether err is or wraps an error with
+// ErrorCodeUnavailable.
+func IsUnavailable(err error) bool {
+	return ErrorCodeOf(err) == ErrorCodeUnavailable
+}
+
+// UnauthorizedError indicates that the caller is not authenticated or not
+// allowed to perform a request.
+type UnauthorizedError struct {
+	// Message describes why the request is not authorized.
+	Message string
+}
+
+// NewUnauthorizedError formats an UnauthorizedError.
+func NewUnauthorizedError(format string, args ...interface{}) *UnauthorizedError {
+	return &UnauthorizedError{Message: fmt.Sprintf(format, args...)}
+}
+
+// Error implements the error interface for UnauthorizedError.
+func (e *UnauthorizedError) Error() string {
+	return e.Message
+}
+
+// Code implements CodedError for UnauthorizedError.
+func (e *UnauthorizedError) Code() ErrorCode {
+	return ErrorCodeUnauthorized
+}
+
+// IsUnauthorized reports whether err is or wraps an error with
+// ErrorCodeUnauthorized.
+func IsUnauthorized(err error) bool {
+	return ErrorCodeOf(err) == ErrorCodeUnauthorized
+}
+
+// ResultError is the error of an error CallToolResult, as returned by
+// ErrorFromResult.
+type ResultError struct {
+	// ErrorCode is the code carried by the result, or ErrorCodeInternal.
+	ErrorCode ErrorCode
+
+	// Message is the text content of the result.
+	Message string
+}
+
+// Error implements the error interface for ResultError.
+func (e *ResultError) Error() string {
+	return e.Message
+}
+
+// Code implements CodedError for ResultError.
+func (e *ResultError) Code() ErrorCode {
+	return e.ErrorCode
+}
+
+// ErrorFromResult returns the error of an error result created by HandleError
+// or NewErrorResult, keeping its code, or nil if result is not an error.
+// It lets callers of a ToolProvider treat error results like returned errors.
+//
+// Example:
+//
+//	result, err := provider.ExecuteTool(ctx, "mcpserver_create", args)
+//	if err == nil {
+//	    err = api.ErrorFromResult(result)
+//	}
+func ErrorFromResult(result *CallToolResult) error {
+	if result == nil || !result.IsError {
+		return nil
+	}
+
+	resultErr := &ResultError{ErrorCode: ErrorCodeInternal}
+	if structured, ok := result.StructuredContent.(map[string]ErrorInfo); ok {
+		if info, ok := structured["error"]; ok && info.Code != "" {
+			resultErr.ErrorCode = info.Code
+		}
+	}
+
+	parts := make([]string, 0, len(result.Content))
+	for _, item := range result.Content {
+		if text, ok := item.(string); ok {
+			parts = append(parts, text)
+		} else {
+			parts = append(parts, fmt.Sprintf("%v", item))
+		}
+	}
+	resultErr.Message = strings.Join(parts, "\n")
+	return resultErr
+}
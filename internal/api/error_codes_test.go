@@ -0,0 +1,73 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type authRequiredTestError struct{}
+
+func (authRequiredTestError) Error() string      { return "auth required" }
+func (authRequiredTestError) AuthRequired() bool { return true }
+
+func TestErrorCodeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCode
+	}{
+		{"nil", nil, ""},
+		{"plain", errors.New("boom"), ErrorCodeInternal},
+		{"not found", NewWorkflowNotFoundError("deploy"), ErrorCodeNotFound},
+		{"wrapped not found", fmt.Errorf("loading: %w", NewServiceNotFoundError("svc")), ErrorCodeNotFound},
+		{"conflict", NewConflictError("workflow", "deploy"), ErrorCodeConflict},
+		{"validation", NewValidationFailedError("name is required"), ErrorCodeValidationFailed},
+		{"unavailable", NewUnavailableError("workflow handler", nil), ErrorCodeUnavailable},
+		{"unauthorized", NewUnauthorizedError("not allowed"), ErrorCodeUnauthorized},
+		{"auth required", fmt.Errorf("start: %w", authRequiredTestError{}), ErrorCodeUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorCodeOf(tt.err); got != tt.want {
+				t.Errorf("ErrorCodeOf() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if !IsNotFound(NewMCPServerNotFoundError("x")) || IsNotFound(errors.New("x not found")) {
+		t.Error("IsNotFound must match NotFoundError only")
+	}
+}
+
+func TestErrorMessages(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{NewConflictError("workflow", "deploy"), "workflow deploy already exists"},
+		{&ValidationFailedError{Err: errors.New("unknown field")}, "unknown field"},
+		{&ValidationFailedError{Message: "invalid step", Err: errors.New("tool is required")}, "invalid step: tool is required"},
+		{NewUnavailableError("workflow handler", nil), "workflow handler not available"},
+		{NewUnavailableError("MCP server github", errors.New("connection refused")), "MCP server github not available: connection refused"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestHandleErrorWithPrefix_StructuredContent(t *testing.T) {
+	result := HandleErrorWithPrefix(NewWorkflowNotFoundError("deploy"), "Failed to get workflow")
+	if !result.IsError || result.Content[0] != "Failed to get workflow: workflow deploy not found" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	structured, ok := result.StructuredContent.(map[string]ErrorInfo)
+	if !ok {
+		t.Fatalf("unexpected structured content %T", result.StructuredContent)
+	}
+	if got := structured["error"]; got.Code != ErrorCodeNotFound || got.Message != "workflow deploy not found" {
+		t.Errorf("unexpected error info: %+v", got)
+	}
+}
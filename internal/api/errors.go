@@ -36,6 +36,11 @@ func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("%s %s not found", e.ResourceType, e.ResourceName)
 }
 
+// Code implements CodedError for NotFoundError.
+func (e *NotFoundError) Code() ErrorCode {
+	return ErrorCodeNotFound
+}
+
 // IsNotFound checks if an error is a NotFoundError using error unwrapping.
 // This function provides a type-safe way to check for not found conditions
 // in error handling code, supporting wrapped errors.
@@ -48,6 +53,13 @@ func (e *NotFoundError) Error() string {
 //
 // Example:
 //
+//	if api.IsNotFound(err) {
+//	    return api.HandleErrorWithPrefix(err, "Failed to get workflow"), nil
+//	}
+func IsNotFound(err error) bool {
+	return ErrorCodeOf(err) == ErrorCodeNotFound
+}
+
 // NewNotFoundError creates a new NotFoundError with the specified resource type and name.
 // This is the standard way to create not found errors throughout the API.
 //
@@ -194,7 +206,8 @@ func IsAuthRequiredError(err error) bool {
 
 // HandleErrorWithPrefix creates an appropriate CallToolResult with a custom prefix.
 // This function is similar to HandleError but allows customizing the error message prefix
-// for more specific error context.
+// for more specific error context. The error code of err is returned as
+// structured content, see ErrorInfo.
 //
 // Args:
 //   - err: The error to handle and format
@@ -209,8 +222,5 @@ func IsAuthRequiredError(err error) bool {
 //	    return api.HandleErrorWithPrefix(err, "Failed to create service")
 //	}
 func HandleErrorWithPrefix(err error, prefix string) *CallToolResult {
-	return &CallToolResult{
-		Content: []interface{}{fmt.Sprintf("%s: %v", prefix, err)},
-		IsError: true, // All failures are treated as errors for test framework compatibility
-	}
+	return NewErrorResult(fmt.Sprintf("%s: %v", prefix, err), err)
 }
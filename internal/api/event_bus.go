@@ -0,0 +1,355 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// EventKind identifies the family of an event published on the internal
+// event bus. The kind determines the type of the event's Payload.
+type EventKind string
+
+const (
+	// EventKindToolUpdate events carry a ToolUpdateEvent payload.
+	EventKindToolUpdate EventKind = "tool_update"
+	// EventKindServiceState events carry a ServiceStateChangedEvent payload.
+	EventKindServiceState EventKind = "service_state"
+	// EventKindAuth events carry an AuthEvent payload.
+	EventKindAuth EventKind = "auth"
+	// EventKindReconcile events carry a ReconcileEvent payload.
+	EventKindReconcile EventKind = "reconcile"
+	// EventKindWorkflow events carry a WorkflowLifecycleEvent payload.
+	EventKindWorkflow EventKind = "workflow"
+)
+
+// Event is a single message on the internal event bus. Kind, Type, and Source
+// are copied from the payload so subscribers can filter without inspecting it.
+type Event struct {
+	// Kind is the family of the event and determines the type of Payload.
+	Kind EventKind
+
+	// Type is the kind-specific event type, e.g. "server_registered" for tool
+	// updates, the new state for service state changes, or the action for
+	// auth events.
+	Type string
+
+	// Source names the resource the event is about, e.g. the MCP server,
+	// service, or workflow name.
+	Source string
+
+	// Timestamp records when the event occurred.
+	Timestamp time.Time
+
+	// Payload is the typed event: ToolUpdateEvent, ServiceStateChangedEvent,
+	// AuthEvent, ReconcileEvent, or WorkflowLifecycleEvent.
+	Payload any
+}
+
+// EventFilter selects the events delivered to a subscription. Each non-empty
+// field restricts the events to those matching one of its values; the zero
+// filter matches every event.
+type EventFilter struct {
+	// Kinds restricts the event kinds.
+	Kinds []EventKind
+
+	// Types restricts the kind-specific event types.
+	Types []string
+
+	// Sources restricts the resources the events are about.
+	Sources []string
+}
+
+// Matches reports whether event passes the filter.
+func (f EventFilter) Matches(event Event) bool {
+	if len(f.Kinds) > 0 && !slices.Contains(f.Kinds, event.Kind) {
+		return false
+	}
+	if len(f.Types) > 0 && !slices.Contains(f.Types, event.Type) {
+		return false
+	}
+	if len(f.Sources) > 0 && !slices.Contains(f.Sources, event.Source) {
+		return false
+	}
+	return true
+}
+
+// AuthEvent describes an authentication outcome for a user and MCP server.
+type AuthEvent struct {
+	// Action is the authentication action, e.g. "login", "logout",
+	// "token_exchange", "token_forwarding", or "token_expired", published
+	// when an MCP server rejects a token as expired or invalid.
+	Action string `json:"action"`
+
+	// Outcome is "success" or "failure".
+	Outcome string `json:"outcome"`
+
+	// ServerName identifies the MCP server the action was performed for.
+	ServerName string `json:"server_name"`
+
+	// Subject is the user's subject (sub claim). It is not truncated, so
+	// subscribers must truncate it before logging.
+	Subject string `json:"subject,omitempty"`
+
+	// Error contains the error message if Outcome is "failure".
+	Error string `json:"error,omitempty"`
+
+	// Timestamp records when the action completed.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ReconcileEvent describes a reconciliation state transition of a resource.
+type ReconcileEvent struct {
+	// ResourceType is the type of the reconciled resource, e.g. "MCPServer".
+	ResourceType string `json:"resource_type"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// Namespace is the Kubernetes namespace (empty for filesystem mode).
+	Namespace string `json:"namespace,omitempty"`
+
+	// State is the new reconcile state, e.g. "Reconciling", "Synced",
+	// "Error", or "Failed".
+	State string `json:"state"`
+
+	// RetryCount is the number of failed attempts since the last success.
+	RetryCount int `json:"retry_count"`
+
+	// Error contains the sanitized error message for failed reconciliations.
+	Error string `json:"error,omitempty"`
+
+	// Timestamp records when the transition occurred.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// WorkflowLifecycleEvent describes a change to a workflow definition or a
+// workflow execution, such as creation, validation, or a step completing.
+type WorkflowLifecycleEvent struct {
+	// Workflow is the name of the workflow.
+	Workflow string `json:"workflow"`
+
+	// Reason is the event reason, e.g. "WorkflowCreated" or
+	// "WorkflowExecutionCompleted".
+	Reason string `json:"reason"`
+
+	// ExecutionID identifies the execution for execution and step events.
+	ExecutionID string `json:"execution_id,omitempty"`
+
+	// StepID identifies the step for step events.
+	StepID string `json:"step_id,omitempty"`
+
+	// Error contains error information for failure events.
+	Error string `json:"error,omitempty"`
+
+	// Timestamp records when the event occurred.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// eventSubscription is a single subscriber of the event bus. Exactly one of
+// handler and ch is set.
+type eventSubscription struct {
+	id      uint64
+	filter  EventFilter
+	handler func(Event)
+
+	// ch receives events of channel subscriptions; mu guards sending on and
+	// closing it.
+	ch     chan Event
+	mu     sync.Mutex
+	closed bool
+}
+
+var (
+	// eventSubscriptions stores the event bus subscribers.
+	// Access is protected by eventBusMutex.
+	eventSubscriptions []*eventSubscription
+	nextSubscriptionID uint64
+	eventBusMutex      sync.RWMutex
+)
+
+// SubscribeEvents registers handler for events matching filter and returns a
+// function that cancels the subscription.
+//
+// Like tool update subscribers, handler is called in a separate goroutine for
+// every event, so events may be handled concurrently and out of order. Use
+// SubscribeEventsChan when order matters. Panics in handler are recovered and
+// logged as errors.
+//
+// Example:
+//
+//	unsubscribe := api.SubscribeEvents(api.EventFilter{
+//	    Kinds: []api.EventKind{api.EventKindAuth},
+//	}, func(event api.Event) {
+//	    auth := event.Payload.(api.AuthEvent)
+//	    fmt.Printf("%s %s for %s\n", auth.Action, auth.Outcome, auth.ServerName)
+//	})
+//	defer unsubscribe()
+func SubscribeEvents(filter EventFilter, handler func(Event)) (unsubscribe func()) {
+	return addEventSubscription(&eventSubscription{filter: filter, handler: handler})
+}
+
+// SubscribeEventsChan returns a channel receiving events matching filter in
+// publish order, and a function that cancels the subscription and closes the
+// channel. Events are dropped when the channel buffer is full, so the
+// publisher is never blocked by a slow subscriber.
+//
+// Example:
+//
+//	events, unsubscribe := api.SubscribeEventsChan(api.EventFilter{
+//	    Kinds: []api.EventKind{api.EventKindServiceState},
+//	}, 100)
+//	defer unsubscribe()
+//	for event := range events {
+//	    change := event.Payload.(api.ServiceStateChangedEvent)
+//	    ...
+//	}
+func SubscribeEventsChan(filter EventFilter, buffer int) (<-chan Event, func()) {
+	sub := &eventSubscription{filter: filter, ch: make(chan Event, buffer)}
+	return sub.ch, addEventSubscription(sub)
+}
+
+func addEventSubscription(sub *eventSubscription) func() {
+	eventBusMutex.Lock()
+	nextSubscriptionID++
+	sub.id = nextSubscriptionID
+	eventSubscriptions = append(eventSubscriptions, sub)
+	total := len(eventSubscriptions)
+	eventBusMutex.Unlock()
+
+	logging.Debug("API", "Added event subscriber %d (kinds=%v), total subscribers: %d", sub.id, sub.filter.Kinds, total)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { removeEventSubscription(sub) })
+	}
+}
+
+func removeEventSubscription(sub *eventSubscription) {
+	eventBusMutex.Lock()
+	eventSubscriptions = slices.DeleteFunc(eventSubscriptions, func(s *eventSubscription) bool {
+		return s.id == sub.id
+	})
+	eventBusMutex.Unlock()
+
+	if sub.ch != nil {
+		sub.mu.Lock()
+		sub.closed = true
+		close(sub.ch)
+		sub.mu.Unlock()
+	}
+}
+
+// PublishEvent delivers event to all subscribers whose filter matches it.
+// A zero Timestamp is set to the current time. Publishing never blocks on
+// subscribers.
+//
+// Components usually publish through the typed helpers PublishToolUpdateEvent,
+// PublishServiceStateEvent, PublishAuthEvent, PublishReconcileEvent, and
+// PublishWorkflowEvent, which fill in Kind, Type, and Source.
+func PublishEvent(event Event) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	eventBusMutex.RLock()
+	subscribers := make([]*eventSubscription, 0, len(eventSubscriptions))
+	for _, sub := range eventSubscriptions {
+		if sub.filter.Matches(event) {
+			subscribers = append(subscribers, sub)
+		}
+	}
+	eventBusMutex.RUnlock()
+
+	for _, sub := range subscribers {
+		sub.deliver(event)
+	}
+}
+
+func (s *eventSubscription) deliver(event Event) {
+	if s.handler != nil {
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					logging.Error("API", fmt.Errorf("panic in %s event subscriber: %v", event.Kind, r), "Event subscriber panicked")
+				}
+			}()
+			s.handler(event)
+		}()
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.ch <- event:
+	default:
+		logging.Debug("API", "Event subscriber %d blocked, dropping %s event %s for %s", s.id, event.Kind, event.Type, event.Source)
+	}
+}
+
+// PublishServiceStateEvent publishes a service state change on the event bus.
+// The event type is the new state and the source the service name.
+func PublishServiceStateEvent(event ServiceStateChangedEvent) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	PublishEvent(Event{
+		Kind:      EventKindServiceState,
+		Type:      event.NewState,
+		Source:    event.Name,
+		Timestamp: event.Timestamp,
+		Payload:   event,
+	})
+}
+
+// PublishAuthEvent publishes an authentication outcome on the event bus.
+// The event type is the action and the source the MCP server name.
+func PublishAuthEvent(event AuthEvent) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	PublishEvent(Event{
+		Kind:      EventKindAuth,
+		Type:      event.Action,
+		Source:    event.ServerName,
+		Timestamp: event.Timestamp,
+		Payload:   event,
+	})
+}
+
+// PublishReconcileEvent publishes a reconcile state transition on the event
+// bus. The event type is the new state and the source the resource name.
+func PublishReconcileEvent(event ReconcileEvent) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	PublishEvent(Event{
+		Kind:      EventKindReconcile,
+		Type:      event.State,
+		Source:    event.Name,
+		Timestamp: event.Timestamp,
+		Payload:   event,
+	})
+}
+
+// PublishWorkflowEvent publishes a workflow lifecycle event on the event bus.
+// The event type is the reason and the source the workflow name.
+func PublishWorkflowEvent(event WorkflowLifecycleEvent) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	PublishEvent(Event{
+		Kind:      EventKindWorkflow,
+		Type:      event.Reason,
+		Source:    event.Workflow,
+		Timestamp: event.Timestamp,
+		Payload:   event,
+	})
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEventFilter_Matches(t *testing.T) {
+	event := Event{Kind: EventKindServiceState, Type: "Failed", Source: "mcp-aggregator"}
+
+	tests := []struct {
+		name   string
+		filter EventFilter
+		want   bool
+	}{
+		{name: "zero filter", filter: EventFilter{}, want: true},
+		{name: "matching kind", filter: EventFilter{Kinds: []EventKind{EventKindAuth, EventKindServiceState}}, want: true},
+		{name: "other kind", filter: EventFilter{Kinds: []EventKind{EventKindAuth}}, want: false},
+		{name: "matching type and source", filter: EventFilter{Types: []string{"Failed"}, Sources: []string{"mcp-aggregator"}}, want: true},
+		{name: "other type", filter: EventFilter{Types: []string{"Running"}}, want: false},
+		{name: "other source", filter: EventFilter{Sources: []string{"kubernetes"}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(event); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubscribeEventsChan_FiltersAndKeepsOrder(t *testing.T) {
+	events, unsubscribe := SubscribeEventsChan(EventFilter{
+		Kinds:   []EventKind{EventKindServiceState},
+		Sources: []string{"svc"},
+	}, 10)
+
+	PublishServiceStateEvent(ServiceStateChangedEvent{Name: "svc", NewState: "Starting"})
+	PublishServiceStateEvent(ServiceStateChangedEvent{Name: "other", NewState: "Starting"})
+	PublishAuthEvent(AuthEvent{Action: "login", ServerName: "svc"})
+	PublishServiceStateEvent(ServiceStateChangedEvent{Name: "svc", NewState: "Running"})
+
+	for _, want := range []string{"Starting", "Running"} {
+		event := <-events
+		change, ok := event.Payload.(ServiceStateChangedEvent)
+		if !ok {
+			t.Fatalf("payload type = %T, want ServiceStateChangedEvent", event.Payload)
+		}
+		if event.Type != want || change.NewState != want {
+			t.Fatalf("got event type %q (state %q), want %q", event.Type, change.NewState, want)
+		}
+		if event.Timestamp.IsZero() || change.Timestamp.IsZero() {
+			t.Fatalf("expected timestamps to be set")
+		}
+	}
+
+	unsubscribe()
+	if _, ok := <-events; ok {
+		t.Fatalf("expected channel to be closed after unsubscribe")
+	}
+
+	// Publishing after unsubscribe and unsubscribing twice must not panic
+	PublishServiceStateEvent(ServiceStateChangedEvent{Name: "svc", NewState: "Stopped"})
+	unsubscribe()
+}
+
+func TestSubscribeEventsChan_DropsWhenFull(t *testing.T) {
+	events, unsubscribe := SubscribeEventsChan(EventFilter{Kinds: []EventKind{EventKindReconcile}}, 1)
+	defer unsubscribe()
+
+	PublishReconcileEvent(ReconcileEvent{Name: "first", State: "Reconciling"})
+	PublishReconcileEvent(ReconcileEvent{Name: "second", State: "Synced"})
+
+	if event := <-events; event.Source != "first" {
+		t.Fatalf("got event for %q, want first", event.Source)
+	}
+	select {
+	case event := <-events:
+		t.Fatalf("expected second event to be dropped, got %+v", event)
+	default:
+	}
+}
+
+type recordingToolSubscriber struct {
+	events chan ToolUpdateEvent
+}
+
+func (s *recordingToolSubscriber) OnToolsUpdated(event ToolUpdateEvent) {
+	s.events <- event
+}
+
+func TestSubscribeEvents_ToolUpdatesAndPanics(t *testing.T) {
+	// A panicking subscriber must not affect other subscribers
+	unsubscribe := SubscribeEvents(EventFilter{Kinds: []EventKind{EventKindToolUpdate}}, func(Event) {
+		panic("boom")
+	})
+	defer unsubscribe()
+
+	subscriber := &recordingToolSubscriber{events: make(chan ToolUpdateEvent, 1)}
+	SubscribeToToolUpdates(subscriber)
+
+	workflowEvents := make(chan Event, 1)
+	unsubscribeWorkflow := SubscribeEvents(EventFilter{Kinds: []EventKind{EventKindWorkflow}}, func(event Event) {
+		workflowEvents <- event
+	})
+	defer unsubscribeWorkflow()
+
+	PublishToolUpdateEvent(ToolUpdateEvent{Type: "server_registered", ServerName: "kubernetes", Tools: []string{"get_pods"}})
+	PublishWorkflowEvent(WorkflowLifecycleEvent{Workflow: "deploy", Reason: "WorkflowCreated"})
+
+	select {
+	case event := <-subscriber.events:
+		if event.ServerName != "kubernetes" || len(event.Tools) != 1 {
+			t.Fatalf("unexpected tool update: %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("tool update subscriber was not notified")
+	}
+
+	select {
+	case event := <-workflowEvents:
+		if event.Type != "WorkflowCreated" || event.Source != "deploy" {
+			t.Fatalf("unexpected workflow event: %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("workflow subscriber was not notified")
+	}
+}
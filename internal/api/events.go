@@ -19,6 +19,9 @@ type EventQueryOptions struct {
 	// EventType filters by event type (Normal, Warning)
 	EventType string `json:"eventType,omitempty"`
 
+	// Reason filters events by reason (e.g. MCPServerFailed)
+	Reason string `json:"reason,omitempty"`
+
 	// Since filters events that occurred after this time
 	Since *time.Time `json:"since,omitempty"`
 
@@ -29,11 +32,16 @@ type EventQueryOptions struct {
 	Limit int `json:"limit,omitempty"`
 }
 
-// EventResult represents a single event result.
+// EventResult represents a single event result. Identical events recurring
+// within a short window are aggregated into one result, whose Count,
+// FirstTimestamp and Timestamp record how often and when they occurred.
 type EventResult struct {
-	// Timestamp when the event occurred
+	// Timestamp when the event last occurred
 	Timestamp time.Time `json:"timestamp"`
 
+	// FirstTimestamp when the event first occurred, for aggregated events
+	FirstTimestamp time.Time `json:"firstTimestamp,omitzero"`
+
 	// Namespace of the involved object
 	Namespace string `json:"namespace"`
 
@@ -52,8 +60,14 @@ type EventResult struct {
 	// Source component that generated the event
 	Source string `json:"source"`
 
-	// Count for how many times this event occurred (Kubernetes mode)
+	// Count for how many times this event occurred
 	Count int32 `json:"count,omitempty"`
+
+	// CorrelationID identifies the request the event was generated for, as in
+	// the correlationID of its log entries. For aggregated events it is that
+	// of the last occurrence in filesystem mode and of the first in
+	// Kubernetes mode
+	CorrelationID string `json:"correlationID,omitempty"`
 }
 
 // EventQueryResult represents the result of an event query.
@@ -112,7 +126,8 @@ type EventData struct {
 //
 // The handler automatically adapts to the current client mode:
 //   - Kubernetes mode: Creates actual Kubernetes Events API objects
-//   - Filesystem mode: Logs events to console and events.log file
+//   - Filesystem mode: Logs events to console and stores them in daily files
+//     of the events directory, bounded by the configured retention
 //
 // Key features:
 // - Unified event generation across both Kubernetes and filesystem modes
@@ -215,7 +230,7 @@ type EventManagerHandler interface {
 	//	if handler.IsKubernetesMode() {
 	//		// Can access events via kubectl get events
 	//	} else {
-	//		// Events are logged to console and events.log
+	//		// Events are stored in the events directory
 	//	}
 	IsKubernetesMode() bool
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/giantswarm/muster/pkg/logging"
 
@@ -22,11 +23,6 @@ var (
 	eventManagerHandler     EventManagerHandler
 	reconcileManagerHandler ReconcileManagerHandler
 
-	// toolUpdateSubscribers stores the list of components subscribed to tool update events.
-	// Access is protected by toolUpdateMutex.
-	toolUpdateSubscribers []ToolUpdateSubscriber
-	toolUpdateMutex       sync.Mutex
-
 	// handlerMutex protects all handler registry operations for thread-safe registration and access.
 	handlerMutex sync.RWMutex
 )
@@ -384,14 +380,14 @@ func CollectRequiredAudiences() []string {
 // Subscribers will receive notifications when tools are added, removed, or updated across MCP servers.
 // This enables components to react to changes in the tool landscape in real-time.
 //
-// The subscription is thread-safe and can be called from any goroutine.
-// Subscriber callbacks are executed in separate goroutines to prevent blocking
-// the event publishing mechanism.
+// It is a convenience wrapper around SubscribeEvents for EventKindToolUpdate
+// events. Subscriber callbacks are executed in separate goroutines to prevent
+// blocking the event publishing mechanism.
 //
 // Args:
 //   - subscriber: ToolUpdateSubscriber that will receive tool update notifications
 //
-// Thread-safe: Yes, protected by toolUpdateMutex.
+// Thread-safe: Yes, protected by the event bus.
 //
 // Note: Subscriber callbacks are executed asynchronously and should not block.
 // Panics in subscriber callbacks are recovered and logged as errors.
@@ -406,27 +402,27 @@ func CollectRequiredAudiences() []string {
 //	subscriber := &MySubscriber{}
 //	api.SubscribeToToolUpdates(subscriber)
 func SubscribeToToolUpdates(subscriber ToolUpdateSubscriber) {
-	toolUpdateMutex.Lock()
-	defer toolUpdateMutex.Unlock()
-	toolUpdateSubscribers = append(toolUpdateSubscribers, subscriber)
-	logging.Debug("API", "Added tool update subscriber, total subscribers: %d", len(toolUpdateSubscribers))
+	SubscribeEvents(EventFilter{Kinds: []EventKind{EventKindToolUpdate}}, func(event Event) {
+		if update, ok := event.Payload.(ToolUpdateEvent); ok {
+			subscriber.OnToolsUpdated(update)
+		}
+	})
 }
 
 // PublishToolUpdateEvent publishes a tool update event to all registered subscribers.
 // This function is used to notify components about changes in tool availability,
 // such as when MCP servers are registered/deregistered or their tools change.
 //
-// The event is delivered asynchronously to all subscribers. Each subscriber
-// receives the event in a separate goroutine to prevent blocking, ensuring
-// that slow or failing subscribers don't affect other subscribers or the publisher.
+// The event is published on the event bus as an EventKindToolUpdate event with
+// the event's Type and ServerName as type and source, so it reaches both
+// ToolUpdateSubscribers and SubscribeEvents subscribers.
 //
 // Args:
 //   - event: ToolUpdateEvent containing details about the tool update
 //
-// Thread-safe: Yes, subscriber list is safely copied before notification.
+// Thread-safe: Yes, the subscriber list is safely copied before notification.
 //
-// Note: Each subscriber is notified in a separate goroutine to prevent blocking.
-// Panics in subscriber callbacks are recovered and logged as errors.
+// Note: Subscribers never block the publisher; see SubscribeEvents.
 //
 // Example:
 //
@@ -438,25 +434,20 @@ func SubscribeToToolUpdates(subscriber ToolUpdateSubscriber) {
 //	}
 //	api.PublishToolUpdateEvent(event)
 func PublishToolUpdateEvent(event ToolUpdateEvent) {
-	toolUpdateMutex.Lock()
-	subscribers := make([]ToolUpdateSubscriber, len(toolUpdateSubscribers))
-	copy(subscribers, toolUpdateSubscribers)
-	toolUpdateMutex.Unlock()
-
-	logging.Debug("API", "Publishing tool update event: type=%s, server=%s, tools=%d, subscribers=%d",
-		event.Type, event.ServerName, len(event.Tools), len(subscribers))
-
-	for _, subscriber := range subscribers {
-		// Call subscriber in goroutine to avoid blocking
-		go func(s ToolUpdateSubscriber) {
-			defer func() {
-				if r := recover(); r != nil {
-					logging.Error("API", fmt.Errorf("panic in tool update subscriber: %v", r), "Tool update subscriber panicked")
-				}
-			}()
-			s.OnToolsUpdated(event)
-		}(subscriber)
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
 	}
+
+	logging.Debug("API", "Publishing tool update event: type=%s, server=%s, tools=%d",
+		event.Type, event.ServerName, len(event.Tools))
+
+	PublishEvent(Event{
+		Kind:      EventKindToolUpdate,
+		Type:      event.Type,
+		Source:    event.ServerName,
+		Timestamp: event.Timestamp,
+		Payload:   event,
+	})
 }
 
 // RegisterEventManager registers the event manager handler implementation.
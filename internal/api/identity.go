@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"slices"
+)
+
+// Identity describes the authenticated caller of an operation. It is added to
+// the context of handler tool calls by AuthContextMiddleware, so components
+// such as audit logging, policies, and events can attribute operations to a
+// user rather than just a session.
+type Identity struct {
+	// Subject is the user's subject (sub claim). It is not truncated, so
+	// callers must truncate it before logging.
+	Subject string `json:"subject,omitempty"`
+
+	// Email is the user's email address, if the identity provider returned
+	// one.
+	Email string `json:"email,omitempty"`
+
+	// Groups are the user's group memberships from the identity provider.
+	Groups []string `json:"groups,omitempty"`
+
+	// SessionID identifies the login session (token family) of the caller.
+	SessionID string `json:"session_id,omitempty"`
+}
+
+// IsAuthenticated reports whether the identity has a subject.
+func (i Identity) IsAuthenticated() bool {
+	return i.Subject != ""
+}
+
+// InGroup reports whether the identity is a member of group.
+func (i Identity) InGroup(group string) bool {
+	return slices.Contains(i.Groups, group)
+}
+
+// identityContextKey is the context key for storing the caller's Identity.
+type identityContextKey struct{}
+
+// WithIdentity returns a new context with the caller's identity set. The
+// subject and session ID are also set for GetSubjectFromContext and
+// GetSessionIDFromContext.
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	ctx = context.WithValue(ctx, identityContextKey{}, identity)
+	if identity.Subject != "" {
+		ctx = WithSubject(ctx, identity.Subject)
+	}
+	if identity.SessionID != "" {
+		ctx = WithSessionID(ctx, identity.SessionID)
+	}
+	return ctx
+}
+
+// IdentityFromContext returns the caller's identity from context. When only a
+// subject or session ID were set, via WithSubject or WithSessionID, the
+// identity carries just those. The zero Identity is returned for
+// unauthenticated calls.
+func IdentityFromContext(ctx context.Context) Identity {
+	identity, _ := ctx.Value(identityContextKey{}).(Identity)
+	if subject := GetSubjectFromContext(ctx); subject != "" {
+		identity.Subject = subject
+	}
+	if sessionID := GetSessionIDFromContext(ctx); sessionID != "" {
+		identity.SessionID = sessionID
+	}
+	return identity
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIdentityFromContext(t *testing.T) {
+	if identity := IdentityFromContext(context.Background()); identity.IsAuthenticated() || identity.SessionID != "" {
+		t.Fatalf("expected zero identity, got %+v", identity)
+	}
+
+	ctx := WithIdentity(context.Background(), Identity{Subject: "user-1", Groups: []string{"dev"}, SessionID: "session-1"})
+	if GetSubjectFromContext(ctx) != "user-1" || GetSessionIDFromContext(ctx) != "session-1" {
+		t.Fatalf("WithIdentity must set subject and session, got %q %q", GetSubjectFromContext(ctx), GetSessionIDFromContext(ctx))
+	}
+	if identity := IdentityFromContext(ctx); !identity.IsAuthenticated() || !identity.InGroup("dev") || identity.InGroup("admins") {
+		t.Fatalf("unexpected identity %+v", identity)
+	}
+
+	// Subject and session set individually are reflected in the identity
+	ctx = WithSessionID(WithSubject(context.Background(), "user-2"), "session-2")
+	if identity := IdentityFromContext(ctx); identity.Subject != "user-2" || identity.SessionID != "session-2" {
+		t.Fatalf("unexpected identity %+v", identity)
+	}
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"time"
+)
+
+// Kinds of orphaned runtime artifacts collected by the janitor.
+const (
+	// OrphanKindProcess is a stdio MCP server process that outlived the
+	// muster instance or the MCP server that started it.
+	OrphanKindProcess = "process"
+
+	// OrphanKindService is an MCPServer service whose MCPServer definition
+	// no longer exists.
+	OrphanKindService = "service"
+)
+
+// OrphanedArtifact is a runtime artifact the janitor found orphaned.
+type OrphanedArtifact struct {
+	// Kind is the kind of the artifact, e.g. OrphanKindProcess.
+	Kind string `json:"kind"`
+	// Name identifies the artifact, e.g. the MCP server name or the command
+	// of a process.
+	Name string `json:"name"`
+	// PID is the process ID of an orphaned process, 0 otherwise.
+	PID int `json:"pid,omitempty"`
+	// Reason explains why the artifact is orphaned.
+	Reason string `json:"reason"`
+	// Removed reports whether the artifact was cleaned up. It is false in a
+	// dry run and when the cleanup failed.
+	Removed bool `json:"removed"`
+	// Error is the error of a failed cleanup.
+	Error string `json:"error,omitempty"`
+}
+
+// GCReport is the result of a garbage collection run of the janitor, served
+// by the core_system_gc tool.
+type GCReport struct {
+	// CollectedAt is when the run started.
+	CollectedAt time.Time `json:"collectedAt"`
+	// DryRun reports whether the orphans were only detected, not removed.
+	DryRun bool `json:"dryRun"`
+	// Orphans lists the orphaned artifacts found.
+	Orphans []OrphanedArtifact `json:"orphans"`
+	// Skipped lists the artifact kinds that could not be checked, with the
+	// reason, e.g. processes on platforms without /proc.
+	Skipped map[string]string `json:"skipped,omitempty"`
+}
+
+// GarbageCollector is implemented by service managers that can detect and
+// clean up orphaned runtime artifacts. Callers find it by type assertion on
+// GetServiceManager().
+type GarbageCollector interface {
+	// CollectGarbage detects the orphaned runtime artifacts and, unless
+	// dryRun is set, removes them.
+	CollectGarbage(ctx context.Context, dryRun bool) GCReport
+}
@@ -33,6 +33,7 @@ const (
 	FieldExecutionID = "execution_id"
 	FieldInput       = "input"
 	FieldInputSchema = "inputSchema"
+	FieldAnnotations = "annotations"
 	FieldURI         = "uri"
 	FieldLabel       = "label"
 	FieldID          = "id"
@@ -0,0 +1,208 @@
+package api
+
+import (
+	"encoding/base64"
+	"path"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// MaxListLimit is the largest page size accepted by list tools.
+const MaxListLimit = 1000
+
+// Argument and result keys shared by the paginated list tools.
+const (
+	ListArgLimit         = "limit"
+	ListArgCursor        = "cursor"
+	ListArgFilter        = "filter"
+	ListArgLabelSelector = "label_selector"
+
+	// ListKeyNextCursor is the result key holding the cursor of the next
+	// page. It is omitted on the last page.
+	ListKeyNextCursor = "next_cursor"
+)
+
+// ListOptions holds the pagination and filter arguments of a list tool
+// (core_service_list, core_mcpserver_list, core_workflow_list).
+type ListOptions struct {
+	// Limit is the maximum number of items in the page; 0 means no limit.
+	Limit int
+
+	// Cursor continues a previous listing after the item it was returned for.
+	Cursor string
+
+	// Filter is a case-insensitive wildcard pattern (* and ?) matched
+	// against item names.
+	Filter string
+
+	// LabelSelector is a Kubernetes-style label selector, e.g.
+	// "team=platform,tier!=experimental".
+	LabelSelector string
+
+	selector labels.Selector
+}
+
+// ListArgs returns the argument metadata of the pagination and filter
+// arguments, to be appended to the arguments of a list tool. labelHelp
+// describes which labels the items of the list carry.
+func ListArgs(labelHelp string) []ArgMetadata {
+	return []ArgMetadata{
+		{
+			Name:        ListArgLimit,
+			Type:        ArgTypeNumber,
+			Required:    false,
+			Description: "Maximum number of items to return (1-1000, default: all). Use next_cursor of the result to fetch the next page.",
+		},
+		{
+			Name:        ListArgCursor,
+			Type:        ArgTypeString,
+			Required:    false,
+			Description: "Cursor returned as next_cursor by the previous page",
+		},
+		{
+			Name:        ListArgFilter,
+			Type:        ArgTypeString,
+			Required:    false,
+			Description: "Filter by name pattern (case-insensitive, wildcards * and ? supported)",
+		},
+		{
+			Name:        ListArgLabelSelector,
+			Type:        ArgTypeString,
+			Required:    false,
+			Description: "Label selector, e.g. \"key=value,other!=value\". " + labelHelp,
+		},
+	}
+}
+
+// ParseListOptions reads the pagination and filter arguments from the
+// arguments of a list tool. Invalid arguments are reported as a
+// ValidationFailedError.
+func ParseListOptions(args map[string]interface{}) (ListOptions, error) {
+	var opts ListOptions
+
+	if limitVal, ok := args[ListArgLimit]; ok && limitVal != nil {
+		var limit int
+		switch v := limitVal.(type) {
+		case float64:
+			limit = int(v)
+		case int:
+			limit = v
+		case int64:
+			limit = int(v)
+		default:
+			return opts, NewValidationFailedError("limit must be a number")
+		}
+		if limit < 1 || limit > MaxListLimit {
+			return opts, NewValidationFailedError("limit must be between 1 and %d", MaxListLimit)
+		}
+		opts.Limit = limit
+	}
+
+	if cursor, ok := args[ListArgCursor].(string); ok && cursor != "" {
+		if _, err := decodeListCursor(cursor); err != nil {
+			return opts, NewValidationFailedError("invalid cursor %q", cursor)
+		}
+		opts.Cursor = cursor
+	}
+
+	if filter, ok := args[ListArgFilter].(string); ok && filter != "" {
+		if _, err := path.Match(filter, ""); err != nil {
+			return opts, NewValidationFailedError("invalid filter %q: %v", filter, err)
+		}
+		opts.Filter = filter
+	}
+
+	if selector, ok := args[ListArgLabelSelector].(string); ok && selector != "" {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return opts, &ValidationFailedError{Message: "invalid label selector", Err: err}
+		}
+		opts.LabelSelector = selector
+		opts.selector = parsed
+	}
+
+	return opts, nil
+}
+
+// Matches reports whether an item with the given name and labels passes the
+// filter and label selector.
+func (o ListOptions) Matches(name string, itemLabels map[string]string) bool {
+	if o.Filter != "" {
+		matched, err := path.Match(strings.ToLower(o.Filter), strings.ToLower(name))
+		if err != nil || !matched {
+			return false
+		}
+	}
+	if o.selector != nil && !o.selector.Matches(labels.Set(itemLabels)) {
+		return false
+	}
+	return true
+}
+
+// ListPage is a page of a list returned by PaginateList.
+type ListPage[T any] struct {
+	// Items are the items of the page, sorted by name.
+	Items []T
+
+	// Total is the number of items matching the filter across all pages.
+	Total int
+
+	// NextCursor continues the listing after this page; empty on the last
+	// page.
+	NextCursor string
+}
+
+// PaginateList filters items by opts, sorts them by name, and returns the
+// page selected by opts.Cursor and opts.Limit. key returns the name and
+// labels of an item; names must be unique.
+//
+// Cursors encode the name of the last item of a page, so listing continues
+// correctly when items are added or removed between pages.
+//
+// Example:
+//
+//	page := api.PaginateList(servers, opts, func(s api.MCPServerInfo) (string, map[string]string) {
+//	    return s.Name, map[string]string{"type": s.Type}
+//	})
+func PaginateList[T any](items []T, opts ListOptions, key func(T) (string, map[string]string)) ListPage[T] {
+	type entry struct {
+		name string
+		item T
+	}
+	matched := make([]entry, 0, len(items))
+	for _, item := range items {
+		name, itemLabels := key(item)
+		if opts.Matches(name, itemLabels) {
+			matched = append(matched, entry{name: name, item: item})
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].name < matched[j].name })
+
+	page := ListPage[T]{Total: len(matched), Items: make([]T, 0, len(matched))}
+
+	start := 0
+	if opts.Cursor != "" {
+		after, _ := decodeListCursor(opts.Cursor)
+		start = sort.Search(len(matched), func(i int) bool { return matched[i].name > after })
+	}
+	end := len(matched)
+	if opts.Limit > 0 && start+opts.Limit < end {
+		end = start + opts.Limit
+		page.NextCursor = encodeListCursor(matched[end-1].name)
+	}
+	for _, e := range matched[start:end] {
+		page.Items = append(page.Items, e.item)
+	}
+	return page
+}
+
+func encodeListCursor(name string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(name))
+}
+
+func decodeListCursor(cursor string) (string, error) {
+	name, err := base64.RawURLEncoding.DecodeString(cursor)
+	return string(name), err
+}
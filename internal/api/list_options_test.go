@@ -0,0 +1,117 @@
+package api
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type listTestItem struct {
+	name   string
+	labels map[string]string
+}
+
+func listTestKey(item listTestItem) (string, map[string]string) {
+	return item.name, item.labels
+}
+
+func listTestNames(items []listTestItem) []string {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		names = append(names, item.name)
+	}
+	return names
+}
+
+func TestParseListOptions(t *testing.T) {
+	opts, err := ParseListOptions(map[string]interface{}{
+		"limit":          float64(10),
+		"cursor":         encodeListCursor("b"),
+		"filter":         "svc-*",
+		"label_selector": "team=platform,tier!=experimental",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Limit != 10 || opts.Cursor == "" || opts.Filter != "svc-*" || opts.LabelSelector != "team=platform,tier!=experimental" {
+		t.Fatalf("unexpected options: %+v", opts)
+	}
+
+	opts, err = ParseListOptions(nil)
+	if err != nil || opts.Limit != 0 || opts.Cursor != "" || opts.Filter != "" || opts.LabelSelector != "" {
+		t.Fatalf("expected empty options without error, got %+v, %v", opts, err)
+	}
+
+	invalid := []map[string]interface{}{
+		{"limit": float64(0)},
+		{"limit": float64(MaxListLimit + 1)},
+		{"limit": "10"},
+		{"cursor": "not base64!"},
+		{"filter": "[a-"},
+		{"label_selector": "team in (a"},
+	}
+	for _, args := range invalid {
+		_, err := ParseListOptions(args)
+		var validationErr *ValidationFailedError
+		if !errors.As(err, &validationErr) {
+			t.Errorf("ParseListOptions(%v): expected ValidationFailedError, got %v", args, err)
+		}
+	}
+}
+
+func TestPaginateList(t *testing.T) {
+	items := []listTestItem{
+		{name: "delta", labels: map[string]string{"team": "platform"}},
+		{name: "alpha", labels: map[string]string{"team": "platform"}},
+		{name: "Charlie", labels: map[string]string{"team": "security"}},
+		{name: "bravo", labels: map[string]string{"team": "platform", "tier": "experimental"}},
+		{name: "echo"},
+	}
+
+	// Without options all items are returned, sorted by name
+	page := PaginateList(items, ListOptions{}, listTestKey)
+	if got, want := listTestNames(page.Items), []string{"Charlie", "alpha", "bravo", "delta", "echo"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if page.Total != 5 || page.NextCursor != "" {
+		t.Fatalf("unexpected total %d or cursor %q", page.Total, page.NextCursor)
+	}
+
+	// Filter is case-insensitive and combines with the label selector
+	opts, err := ParseListOptions(map[string]interface{}{"filter": "*A*", "label_selector": "team=platform,tier!=experimental"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	page = PaginateList(items, opts, listTestKey)
+	if got, want := listTestNames(page.Items), []string{"alpha", "delta"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	// Walk all pages following the cursors
+	var walked []string
+	opts = ListOptions{Limit: 2}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("pagination did not terminate")
+		}
+		page = PaginateList(items, opts, listTestKey)
+		if page.Total != 5 {
+			t.Fatalf("expected total 5 on every page, got %d", page.Total)
+		}
+		walked = append(walked, listTestNames(page.Items)...)
+		if page.NextCursor == "" {
+			break
+		}
+		opts.Cursor = page.NextCursor
+	}
+	if want := []string{"Charlie", "alpha", "bravo", "delta", "echo"}; !reflect.DeepEqual(walked, want) {
+		t.Fatalf("expected pages to cover %v, got %v", want, walked)
+	}
+
+	// A cursor stays valid when the item it points at is removed
+	withoutAlpha := append([]listTestItem{items[0]}, items[2:]...)
+	page = PaginateList(withoutAlpha, ListOptions{Limit: 2, Cursor: encodeListCursor("alpha")}, listTestKey)
+	if got, want := listTestNames(page.Items), []string{"bravo", "delta"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v after removing the cursor item, got %v", want, got)
+	}
+}
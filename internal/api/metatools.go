@@ -91,6 +91,26 @@ type MetaToolsDataProvider interface {
 	// Returns:
 	//   - []ServerAuthInfo: List of servers requiring authentication
 	ListServersRequiringAuth(ctx context.Context) []ServerAuthInfo
+
+	// CapabilityOriginsForContext returns the MCP servers providing the tools,
+	// resources, and prompts available to the current session context.
+	//
+	// Args:
+	//   - ctx: Context containing session information
+	//
+	// Returns:
+	//   - CapabilityOrigins: The servers of each tool, resource, and prompt
+	CapabilityOriginsForContext(ctx context.Context) CapabilityOrigins
+}
+
+// CapabilityOrigins maps the exposed names of tools and prompts, and the
+// exposed URIs of resources, to the names of the MCP servers providing them.
+// A tool of a server family maps to every server of the family. Muster's own
+// core tools are not included, as no MCP server provides them.
+type CapabilityOrigins struct {
+	Tools     map[string][]string `json:"tools"`
+	Resources map[string]string   `json:"resources"`
+	Prompts   map[string]string   `json:"prompts"`
 }
 
 // ServerAuthInfo contains information about a server requiring authentication.
@@ -239,4 +259,15 @@ type MetaToolsHandler interface {
 	// Returns:
 	//   - []ServerAuthInfo: List of servers requiring authentication
 	ListServersRequiringAuth(ctx context.Context) []ServerAuthInfo
+
+	// CapabilityOrigins returns the MCP servers providing the tools, resources,
+	// and prompts available to the current session. This enables the
+	// export_catalog meta-tool to group the catalog by server.
+	//
+	// Args:
+	//   - ctx: Context containing session information
+	//
+	// Returns:
+	//   - CapabilityOrigins: The servers of each tool, resource, and prompt
+	CapabilityOrigins(ctx context.Context) CapabilityOrigins
 }
@@ -0,0 +1,232 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/giantswarm/muster/pkg/logging"
+	"github.com/giantswarm/muster/pkg/observability"
+)
+
+// ToolCall describes a tool invocation on a registered handler as it passes
+// through the handler middleware chain.
+type ToolCall struct {
+	// Handler names the handler the tool belongs to, e.g. "workflow",
+	// "service", "config", or "mcpserver".
+	Handler string
+
+	// Tool is the tool name as known to the handler, without the core_ prefix.
+	Tool string
+
+	// Args are the arguments of the call. Middleware may replace them.
+	Args map[string]any
+}
+
+// ToolInvoker executes a tool call. The innermost invoker of the chain calls
+// the ExecuteTool method of the handler.
+type ToolInvoker func(ctx context.Context, call *ToolCall) (*CallToolResult, error)
+
+// ToolExecuteFunc has the signature of ToolProvider.ExecuteTool.
+type ToolExecuteFunc func(ctx context.Context, toolName string, args map[string]any) (*CallToolResult, error)
+
+// HandlerMiddleware wraps a ToolInvoker with cross-cutting behaviour such as
+// logging, metrics, panic recovery, or context enrichment. A middleware
+// decides whether and how to call next.
+type HandlerMiddleware func(next ToolInvoker) ToolInvoker
+
+var (
+	// handlerMiddleware stores the middleware chain applied by InvokeTool.
+	// Access is protected by handlerMiddlewareMutex.
+	handlerMiddleware      []HandlerMiddleware
+	handlerMiddlewareMutex sync.RWMutex
+)
+
+// RegisterHandlerMiddleware appends middleware to the chain applied to every
+// handler tool call made through InvokeTool. Middleware registered first is
+// outermost, so it sees the call before and the result after all others.
+//
+// The registration is thread-safe and should be called once during system
+// initialization, before handlers are invoked.
+//
+// Example:
+//
+//	api.RegisterHandlerMiddleware(
+//	    api.RecoveryMiddleware(),
+//	    api.LoggingMiddleware(),
+//	)
+func RegisterHandlerMiddleware(mw ...HandlerMiddleware) {
+	handlerMiddlewareMutex.Lock()
+	defer handlerMiddlewareMutex.Unlock()
+	handlerMiddleware = append(handlerMiddleware, mw...)
+}
+
+// ClearHandlerMiddleware removes all registered handler middleware.
+// It is intended for tests and shutdown.
+func ClearHandlerMiddleware() {
+	handlerMiddlewareMutex.Lock()
+	defer handlerMiddlewareMutex.Unlock()
+	handlerMiddleware = nil
+}
+
+// InvokeTool executes a tool of a handler through the registered middleware
+// chain. Callers that route tool calls to handlers, such as the aggregator,
+// use it instead of calling ToolProvider.ExecuteTool directly, so every
+// handler gets the same logging, metrics, recovery, and auth context.
+//
+// Args:
+//   - ctx: Context for the tool execution
+//   - handler: Name of the handler, used by middleware for logs and metrics
+//   - execute: The handler's ExecuteTool method
+//   - toolName: Tool name as known to the handler
+//   - args: Arguments of the call
+//
+// Returns the result of the handler, or an error from the handler or a
+// middleware. The outcome is counted in the handler's diagnostics, see
+// GetDiagnostics.
+//
+// A call without a correlation ID in ctx gets a new one (see
+// logging.EnsureCorrelationID), so the logs and events of the call and of
+// the calls it makes share an ID.
+func InvokeTool(ctx context.Context, handler string, execute ToolExecuteFunc, toolName string, args map[string]any) (*CallToolResult, error) {
+	ctx = logging.EnsureCorrelationID(ctx)
+	handlerMiddlewareMutex.RLock()
+	chain := make([]HandlerMiddleware, len(handlerMiddleware))
+	copy(chain, handlerMiddleware)
+	handlerMiddlewareMutex.RUnlock()
+
+	invoke := ToolInvoker(func(ctx context.Context, call *ToolCall) (*CallToolResult, error) {
+		return execute(ctx, call.Tool, call.Args)
+	})
+	for i := len(chain) - 1; i >= 0; i-- {
+		invoke = chain[i](invoke)
+	}
+	result, err := invoke(ctx, &ToolCall{Handler: handler, Tool: toolName, Args: args})
+	recordHandlerCall(handler, result, err)
+	return result, err
+}
+
+// RecoveryMiddleware turns a panic in a handler into an error, so a bug in
+// one tool does not take down the muster process.
+func RecoveryMiddleware() HandlerMiddleware {
+	return func(next ToolInvoker) ToolInvoker {
+		return func(ctx context.Context, call *ToolCall) (result *CallToolResult, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("panic in %s tool %s: %v", call.Handler, call.Tool, r)
+					logging.ErrorCtx(ctx, "API", err, "Handler panicked\n%s", debug.Stack())
+					result = nil
+				}
+			}()
+			return next(ctx, call)
+		}
+	}
+}
+
+// LoggingMiddleware logs every handler tool call at debug level, and failed
+// calls at warn level, with the handler, tool, duration, and caller subject.
+func LoggingMiddleware() HandlerMiddleware {
+	return func(next ToolInvoker) ToolInvoker {
+		return func(ctx context.Context, call *ToolCall) (*CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, call)
+			attrs := []slog.Attr{
+				slog.String("handler", call.Handler),
+				slog.String("tool", call.Tool),
+				slog.Duration("duration", time.Since(start)),
+			}
+			if subject := GetSubjectFromContext(ctx); subject != "" {
+				attrs = append(attrs, slog.String("subject", logging.TruncateIdentifier(subject)))
+			}
+			switch {
+			case err != nil:
+				logging.WarnWithAttrsCtx(ctx, "API", "Handler tool call failed", append(attrs, slog.String("error", err.Error()))...)
+			case result != nil && result.IsError:
+				logging.DebugWithAttrsCtx(ctx, "API", "Handler tool call returned an error result", attrs...)
+			default:
+				logging.DebugWithAttrsCtx(ctx, "API", "Handler tool call completed", attrs...)
+			}
+			return result, err
+		}
+	}
+}
+
+// MetricsMiddleware records muster.handler_calls (counter) and
+// muster.handler_call.duration (histogram, unit "s") with the attributes
+// handler, tool, and outcome ("ok", "error", or "error_result").
+func MetricsMiddleware() HandlerMiddleware {
+	m := otel.Meter(observability.TracerName)
+	calls, err := m.Int64Counter("muster.handler_calls",
+		metric.WithDescription("Number of tool calls handled by muster's internal handlers."),
+		metric.WithUnit("{call}"),
+	)
+	if err != nil {
+		logging.Warn("API", "create muster.handler_calls counter: %v", err)
+		return passthroughMiddleware
+	}
+	duration, err := m.Float64Histogram("muster.handler_call.duration",
+		metric.WithDescription("Duration of tool calls handled by muster's internal handlers."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		logging.Warn("API", "create muster.handler_call.duration histogram: %v", err)
+		return passthroughMiddleware
+	}
+	return func(next ToolInvoker) ToolInvoker {
+		return func(ctx context.Context, call *ToolCall) (*CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, call)
+			outcome := "ok"
+			switch {
+			case err != nil:
+				outcome = "error"
+			case result != nil && result.IsError:
+				outcome = "error_result"
+			}
+			attrs := metric.WithAttributes(
+				attribute.String("handler", call.Handler),
+				attribute.String("tool", call.Tool),
+				attribute.String("outcome", outcome),
+			)
+			calls.Add(ctx, 1, attrs)
+			duration.Record(ctx, time.Since(start).Seconds(), attrs)
+			return result, err
+		}
+	}
+}
+
+// AuthContextMiddleware makes the caller's identity available to handlers
+// through IdentityFromContext, GetSubjectFromContext, and
+// GetSessionIDFromContext. resolve returns the identity of the caller from the
+// request context, e.g. from the OAuth token validated by the transport;
+// values already set in the context are kept.
+func AuthContextMiddleware(resolve func(ctx context.Context) Identity) HandlerMiddleware {
+	return func(next ToolInvoker) ToolInvoker {
+		return func(ctx context.Context, call *ToolCall) (*CallToolResult, error) {
+			resolved := resolve(ctx)
+			identity := IdentityFromContext(ctx)
+			switch {
+			case identity.Subject == "":
+				identity.Subject, identity.Email, identity.Groups = resolved.Subject, resolved.Email, resolved.Groups
+			case identity.Subject == resolved.Subject && identity.Email == "" && len(identity.Groups) == 0:
+				identity.Email, identity.Groups = resolved.Email, resolved.Groups
+			}
+			if identity.SessionID == "" {
+				identity.SessionID = resolved.SessionID
+			}
+			if identity.Subject != "" || identity.SessionID != "" {
+				ctx = WithIdentity(ctx, identity)
+			}
+			return next(ctx, call)
+		}
+	}
+}
+
+func passthroughMiddleware(next ToolInvoker) ToolInvoker { return next }
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+type middlewareTestProvider struct {
+	execute func(ctx context.Context, toolName string, args map[string]any) (*CallToolResult, error)
+}
+
+func (p *middlewareTestProvider) GetTools() []ToolMetadata { return nil }
+
+func (p *middlewareTestProvider) ExecuteTool(ctx context.Context, toolName string, args map[string]any) (*CallToolResult, error) {
+	return p.execute(ctx, toolName, args)
+}
+
+func TestInvokeTool_MiddlewareOrder(t *testing.T) {
+	t.Cleanup(ClearHandlerMiddleware)
+	ClearHandlerMiddleware()
+
+	var trace []string
+	record := func(name string) HandlerMiddleware {
+		return func(next ToolInvoker) ToolInvoker {
+			return func(ctx context.Context, call *ToolCall) (*CallToolResult, error) {
+				trace = append(trace, name+":before")
+				result, err := next(ctx, call)
+				trace = append(trace, name+":after")
+				return result, err
+			}
+		}
+	}
+	RegisterHandlerMiddleware(record("outer"), record("inner"))
+
+	provider := &middlewareTestProvider{execute: func(_ context.Context, toolName string, args map[string]any) (*CallToolResult, error) {
+		trace = append(trace, "tool:"+toolName+":"+args["name"].(string))
+		return &CallToolResult{Content: []interface{}{"done"}}, nil
+	}}
+	result, err := InvokeTool(context.Background(), "service", provider.ExecuteTool, "service_start", map[string]any{"name": "svc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Content) != 1 || result.Content[0] != "done" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	want := "outer:before inner:before tool:service_start:svc inner:after outer:after"
+	if got := strings.Join(trace, " "); got != want {
+		t.Fatalf("call order = %q, want %q", got, want)
+	}
+}
+
+func TestRecoveryMiddleware(t *testing.T) {
+	t.Cleanup(ClearHandlerMiddleware)
+	ClearHandlerMiddleware()
+	RegisterHandlerMiddleware(RecoveryMiddleware(), LoggingMiddleware(), MetricsMiddleware())
+
+	provider := &middlewareTestProvider{execute: func(context.Context, string, map[string]any) (*CallToolResult, error) {
+		panic("boom")
+	}}
+	result, err := InvokeTool(context.Background(), "workflow", provider.ExecuteTool, "workflow_list", nil)
+	if err == nil || !strings.Contains(err.Error(), "panic in workflow tool workflow_list: boom") {
+		t.Fatalf("expected panic error, got %v", err)
+	}
+	if result != nil {
+		t.Fatalf("expected nil result, got %+v", result)
+	}
+}
+
+func TestAuthContextMiddleware(t *testing.T) {
+	t.Cleanup(ClearHandlerMiddleware)
+	ClearHandlerMiddleware()
+	RegisterHandlerMiddleware(AuthContextMiddleware(func(context.Context) Identity {
+		return Identity{Subject: "resolved-subject", Email: "jane@example.com", Groups: []string{"admins"}, SessionID: "resolved-session"}
+	}))
+
+	var subject, sessionID string
+	var identity Identity
+	provider := &middlewareTestProvider{execute: func(ctx context.Context, _ string, _ map[string]any) (*CallToolResult, error) {
+		subject, sessionID = GetSubjectFromContext(ctx), GetSessionIDFromContext(ctx)
+		identity = IdentityFromContext(ctx)
+		return &CallToolResult{}, nil
+	}}
+
+	if _, err := InvokeTool(context.Background(), "config", provider.ExecuteTool, "config_get", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if subject != "resolved-subject" || sessionID != "resolved-session" {
+		t.Fatalf("got subject=%q session=%q from resolver", subject, sessionID)
+	}
+	if identity.Email != "jane@example.com" || !identity.InGroup("admins") {
+		t.Fatalf("got identity %+v from resolver", identity)
+	}
+
+	// Identity already in the context wins over the resolver, and the email
+	// and groups of the resolved user are not attributed to another subject
+	ctx := WithSubject(context.Background(), "explicit-subject")
+	if _, err := InvokeTool(ctx, "config", provider.ExecuteTool, "config_get", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if subject != "explicit-subject" || sessionID != "resolved-session" {
+		t.Fatalf("got subject=%q session=%q with explicit subject", subject, sessionID)
+	}
+	if identity.Email != "" || len(identity.Groups) != 0 {
+		t.Fatalf("got identity %+v with explicit subject", identity)
+	}
+}
+
+func TestInvokeTool_CorrelationID(t *testing.T) {
+	t.Cleanup(ClearHandlerMiddleware)
+	ClearHandlerMiddleware()
+
+	var got string
+	provider := &middlewareTestProvider{execute: func(ctx context.Context, _ string, _ map[string]any) (*CallToolResult, error) {
+		got = logging.CorrelationIDFromContext(ctx)
+		return &CallToolResult{}, nil
+	}}
+
+	if _, err := InvokeTool(context.Background(), "service", provider.ExecuteTool, "service_list", nil); err != nil {
+		t.Fatalf("InvokeTool() error = %v", err)
+	}
+	if got == "" {
+		t.Error("expected InvokeTool to assign a correlation ID")
+	}
+
+	ctx := logging.WithCorrelationID(context.Background(), "req-1")
+	if _, err := InvokeTool(ctx, "service", provider.ExecuteTool, "service_list", nil); err != nil {
+		t.Fatalf("InvokeTool() error = %v", err)
+	}
+	if got != "req-1" {
+		t.Errorf("correlation ID = %q, want the caller's req-1", got)
+	}
+}
@@ -0,0 +1,28 @@
+package api
+
+// NamespaceArg is the optional argument of the MCPServer and Workflow
+// management tools selecting the namespace they operate in: a Kubernetes
+// namespace, or in filesystem mode a directory below namespaces/ of the
+// configuration directory. Clients fill it in from the -n flag or the
+// namespace of the current context.
+const NamespaceArg = "namespace"
+
+// NamespaceArgMetadata returns the metadata of the namespace argument, to be
+// appended to the arguments of a namespaced management tool.
+func NamespaceArgMetadata() ArgMetadata {
+	return ArgMetadata{
+		Name:        NamespaceArg,
+		Type:        ArgTypeString,
+		Required:    false,
+		Description: "Namespace of the resource (default: the namespace muster runs in)",
+	}
+}
+
+// NamespaceFrom returns the namespace argument of args, or defaultNamespace
+// if it is not set.
+func NamespaceFrom(args map[string]interface{}, defaultNamespace string) string {
+	if namespace, ok := args[NamespaceArg].(string); ok && namespace != "" {
+		return namespace
+	}
+	return defaultNamespace
+}
@@ -0,0 +1,25 @@
+package api
+
+import "testing"
+
+func TestNamespaceFrom(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want string
+	}{
+		{name: "set", args: map[string]interface{}{"namespace": "team-a"}, want: "team-a"},
+		{name: "missing", args: map[string]interface{}{}, want: "default"},
+		{name: "empty", args: map[string]interface{}{"namespace": ""}, want: "default"},
+		{name: "wrong type", args: map[string]interface{}{"namespace": 1}, want: "default"},
+		{name: "nil args", args: nil, want: "default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NamespaceFrom(tt.args, "default"); got != tt.want {
+				t.Errorf("NamespaceFrom() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package api
+
+// mutatingTools lists the handler tools, by their name without the core_
+// prefix, that change the state of muster: its definitions, services,
+// configuration, or runtime settings. Read-only mode disables them.
+//
+// Tools are read-only unless listed here, so a mutating tool must be added
+// when it is introduced.
+var mutatingTools = map[string]bool{
+	// MCPServer definitions
+	"mcpserver_create": true,
+	"mcpserver_update": true,
+	"mcpserver_delete": true,
+	"mcpserver_batch":  true,
+
+	// Workflow definitions
+	"workflow_create": true,
+	"workflow_update": true,
+	"workflow_delete": true,
+	"workflow_batch":  true,
+
+	// Service lifecycle
+	"service_start":   true,
+	"service_stop":    true,
+	"service_restart": true,
+
+	// Configuration
+	"config_update_aggregator": true,
+	"config_save":              true,
+	"config_reload":            true,
+	"config_rollback":          true,
+	"config_import":            true,
+
+	// Runtime
+	"system_gc":         true,
+	"logging_set_level": true,
+}
+
+// IsMutatingTool reports whether the handler tool toolName, without the
+// core_ prefix, changes the state of muster and is disabled in read-only
+// mode.
+func IsMutatingTool(toolName string) bool {
+	return mutatingTools[toolName]
+}
+
+// NewReadOnlyError returns the error of a call to the mutating tool toolName
+// in read-only mode.
+func NewReadOnlyError(toolName string) *UnauthorizedError {
+	return NewUnauthorizedError("%s is disabled: muster runs in read-only mode", toolName)
+}
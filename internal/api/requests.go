@@ -47,6 +47,10 @@ type MCPServerCreateRequest struct {
 	// Name is the unique identifier for the MCP server (required).
 	Name string `json:"name" validate:"required"`
 
+	// Namespace is the Kubernetes namespace of the MCP server.
+	// Optional; defaults to the namespace muster runs in.
+	Namespace string `json:"namespace,omitempty"`
+
 	// Type specifies the MCP server type (required).
 	// Valid values: "stdio", "streamable-http", "sse"
 	Type string `json:"type" validate:"required"`
@@ -98,6 +102,10 @@ type MCPServerUpdateRequest struct {
 	// Name of the MCP server to update (required).
 	Name string `json:"name" validate:"required"`
 
+	// Namespace is the Kubernetes namespace of the MCP server.
+	// Optional; defaults to the namespace muster runs in.
+	Namespace string `json:"namespace,omitempty"`
+
 	// Type can be changed, but may require significant reconfiguration.
 	Type string `json:"type" validate:"required"`
 
@@ -142,6 +150,10 @@ type MCPServerValidateRequest struct {
 	// Name for validation (required).
 	Name string `json:"name" validate:"required"`
 
+	// Namespace is the Kubernetes namespace of the MCP server.
+	// Optional; defaults to the namespace muster runs in.
+	Namespace string `json:"namespace,omitempty"`
+
 	// Type for validation (required).
 	Type string `json:"type" validate:"required"`
 
@@ -212,6 +224,10 @@ type WorkflowCreateRequest struct {
 	// Must be unique across all workflows in the system.
 	Name string `json:"name" validate:"required"`
 
+	// Namespace is the Kubernetes namespace of the workflow.
+	// Optional; defaults to the namespace muster runs in.
+	Namespace string `json:"namespace,omitempty"`
+
 	// Version indicates the workflow version for compatibility tracking.
 	// Recommended to use semantic versioning.
 	Version string `json:"version,omitempty"`
@@ -236,6 +252,9 @@ type WorkflowCreateRequest struct {
 
 	// Output is an optional output template that shapes the returned document.
 	Output map[string]interface{} `json:"output,omitempty"`
+
+	// Concurrency limits the executions of the workflow that run at the same time.
+	Concurrency *WorkflowConcurrency `json:"concurrency,omitempty"`
 }
 
 // WorkflowUpdateRequest represents a request to update an existing workflow definition.
@@ -244,6 +263,10 @@ type WorkflowUpdateRequest struct {
 	// Name of the workflow to update (required).
 	Name string `json:"name" validate:"required"`
 
+	// Namespace is the Kubernetes namespace of the workflow.
+	// Optional; defaults to the namespace muster runs in.
+	Namespace string `json:"namespace,omitempty"`
+
 	// Version can be updated to reflect changes.
 	Version string `json:"version,omitempty"`
 
@@ -264,6 +287,9 @@ type WorkflowUpdateRequest struct {
 
 	// Output is an optional output template that shapes the returned document.
 	Output map[string]interface{} `json:"output,omitempty"`
+
+	// Concurrency limits the executions of the workflow that run at the same time.
+	Concurrency *WorkflowConcurrency `json:"concurrency,omitempty"`
 }
 
 // WorkflowValidateRequest represents a request to validate a workflow definition
@@ -272,6 +298,10 @@ type WorkflowValidateRequest struct {
 	// Name for validation (required).
 	Name string `json:"name" validate:"required"`
 
+	// Namespace is the Kubernetes namespace of the workflow.
+	// Optional; defaults to the namespace muster runs in.
+	Namespace string `json:"namespace,omitempty"`
+
 	// Version for validation.
 	Version string `json:"version,omitempty"`
 
@@ -290,6 +320,9 @@ type WorkflowValidateRequest struct {
 
 	// Output is an optional output template that shapes the returned document.
 	Output map[string]interface{} `json:"output,omitempty"`
+
+	// Concurrency limits the executions of the workflow that run at the same time.
+	Concurrency *WorkflowConcurrency `json:"concurrency,omitempty"`
 }
 
 // ParseRequest converts a map[string]interface{} to a typed request struct.
@@ -318,6 +351,13 @@ type WorkflowValidateRequest struct {
 //	    return fmt.Errorf("invalid request: %w", err)
 //	}
 func ParseRequest[T any](args map[string]interface{}, request *T) error {
+	if err := parseRequest(args, request); err != nil {
+		return &ValidationFailedError{Err: err}
+	}
+	return nil
+}
+
+func parseRequest[T any](args map[string]interface{}, request *T) error {
 	// First validate that no unknown args are present
 	if err := validateStrictArgs(args, request); err != nil {
 		return err
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"sort"
+	"time"
+)
+
+// ToolCallTotals holds cumulative tool call counters since aggregator start.
+// Rates are derived by the consumer from the difference between two samples.
+type ToolCallTotals struct {
+	// Calls is the total number of tool calls handled.
+	Calls uint64 `json:"calls"`
+	// Errors is the number of calls that returned a Go error or an error result.
+	Errors uint64 `json:"errors"`
+	// PerTool maps tool names to their call counts.
+	PerTool map[string]uint64 `json:"perTool,omitempty"`
+}
+
+// ToolCallStatsProvider exposes the aggregator's cumulative tool call counters.
+// It is implemented by the aggregator and consumed by the orchestrator's
+// runtime stats collector.
+type ToolCallStatsProvider interface {
+	// ToolCallTotals returns a snapshot of the cumulative counters.
+	ToolCallTotals() ToolCallTotals
+	// ToolUsage returns the usage statistics of every tool called since
+	// aggregator start, in no particular order.
+	ToolUsage() []ToolUsageStats
+}
+
+// ToolUsageStats holds the usage statistics of one tool since aggregator
+// start. Unlike ToolCallTotals, which counts the calls of the MCP protocol
+// (mostly meta-tools), it counts the calls of the tools themselves.
+type ToolUsageStats struct {
+	// Tool is the name of the tool as exposed by the aggregator.
+	Tool string `json:"tool"`
+	// Calls is the number of calls.
+	Calls uint64 `json:"calls"`
+	// Errors is the number of calls that returned a Go error or an error result.
+	Errors uint64 `json:"errors"`
+	// ErrorRate is Errors divided by Calls.
+	ErrorRate float64 `json:"errorRate"`
+	// AvgLatencyMs is the mean call duration in milliseconds.
+	AvgLatencyMs float64 `json:"avgLatencyMs"`
+	// MaxLatencyMs is the longest call duration in milliseconds.
+	MaxLatencyMs float64 `json:"maxLatencyMs"`
+	// LastCalledAt is when the last call started.
+	LastCalledAt time.Time `json:"lastCalledAt"`
+	// LastError is the message of the last failed call, if any.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// ToolStatsReport is the tool usage report served by the tool_stats
+// meta-tool and the stats://tools resource.
+type ToolStatsReport struct {
+	// Tools lists the usage of the tools called since aggregator start.
+	Tools []ToolUsageStats `json:"tools"`
+	// Unused lists the available tools that were never called, sorted by name.
+	Unused []string `json:"unused"`
+}
+
+// Sort orders for NewToolStatsReport.
+const (
+	ToolStatsSortCalls     = "calls"
+	ToolStatsSortErrors    = "errors"
+	ToolStatsSortErrorRate = "error_rate"
+	ToolStatsSortLatency   = "latency"
+	ToolStatsSortName      = "name"
+)
+
+// ToolStatsSortOrders lists the valid sort orders of NewToolStatsReport.
+var ToolStatsSortOrders = []string{
+	ToolStatsSortCalls, ToolStatsSortErrors, ToolStatsSortErrorRate, ToolStatsSortLatency, ToolStatsSortName,
+}
+
+// NewToolStatsReport builds the report of usage, sorted by sortBy (highest
+// first, or by name; calls when empty), with the names of the available tools
+// missing from usage listed as unused.
+func NewToolStatsReport(usage []ToolUsageStats, available []string, sortBy string) ToolStatsReport {
+	report := ToolStatsReport{Tools: make([]ToolUsageStats, len(usage)), Unused: []string{}}
+	copy(report.Tools, usage)
+
+	called := make(map[string]bool, len(usage))
+	for _, u := range usage {
+		called[u.Tool] = true
+	}
+	for _, name := range available {
+		if !called[name] {
+			called[name] = true
+			report.Unused = append(report.Unused, name)
+		}
+	}
+	sort.Strings(report.Unused)
+
+	key := func(u ToolUsageStats) float64 {
+		switch sortBy {
+		case ToolStatsSortErrors:
+			return float64(u.Errors)
+		case ToolStatsSortErrorRate:
+			return u.ErrorRate
+		case ToolStatsSortLatency:
+			return u.AvgLatencyMs
+		default:
+			return float64(u.Calls)
+		}
+	}
+	sort.SliceStable(report.Tools, func(i, j int) bool {
+		a, b := report.Tools[i], report.Tools[j]
+		if sortBy != ToolStatsSortName {
+			if ka, kb := key(a), key(b); ka != kb {
+				return ka > kb
+			}
+		}
+		return a.Tool < b.Tool
+	})
+	return report
+}
+
+// ProcessStats describes the resource usage of one MCP server.
+type ProcessStats struct {
+	// Name is the MCP server service name.
+	Name string `json:"name"`
+	// Type is the MCP server type (stdio, streamable-http, sse).
+	Type string `json:"type"`
+	// State is the current service state.
+	State string `json:"state"`
+	// PID is the process ID of a managed stdio server, 0 otherwise.
+	PID int `json:"pid,omitempty"`
+	// CPUPercent is the CPU usage over the last sampling interval, where 100
+	// means one fully used core.
+	CPUPercent float64 `json:"cpuPercent"`
+	// MemoryBytes is the resident set size of the process.
+	MemoryBytes uint64 `json:"memoryBytes"`
+	// Unavailable explains why no usage is reported (e.g. a remote server
+	// whose process muster does not manage). Empty when usage is reported.
+	Unavailable string `json:"unavailable,omitempty"`
+}
+
+// ToolCallRate is the call rate of a single tool.
+type ToolCallRate struct {
+	Tool        string  `json:"tool"`
+	CallsPerSec float64 `json:"callsPerSec"`
+	TotalCalls  uint64  `json:"totalCalls"`
+}
+
+// AggregatorCallStats summarizes aggregator tool call throughput over the
+// last sampling interval.
+type AggregatorCallStats struct {
+	// TotalCalls is the cumulative number of tool calls since start.
+	TotalCalls uint64 `json:"totalCalls"`
+	// TotalErrors is the cumulative number of failed tool calls since start.
+	TotalErrors uint64 `json:"totalErrors"`
+	// CallsPerSec is the call rate over the last sampling interval.
+	CallsPerSec float64 `json:"callsPerSec"`
+	// ErrorsPerSec is the failure rate over the last sampling interval.
+	ErrorsPerSec float64 `json:"errorsPerSec"`
+	// TopTools lists the busiest tools in the last interval, highest rate first.
+	TopTools []ToolCallRate `json:"topTools,omitempty"`
+}
+
+// RuntimeStats is a point-in-time snapshot produced by the orchestrator's
+// runtime stats collector and returned by the core_service_stats tool.
+type RuntimeStats struct {
+	// CollectedAt is when the snapshot was taken.
+	CollectedAt time.Time `json:"collectedAt"`
+	// IntervalSeconds is the length of the window that rates and CPU usage
+	// are averaged over.
+	IntervalSeconds float64 `json:"intervalSeconds"`
+	// Servers lists the resource usage of each MCP server service.
+	Servers []ProcessStats `json:"servers"`
+	// Aggregator holds tool call rates, or nil if the aggregator is not running.
+	Aggregator *AggregatorCallStats `json:"aggregator,omitempty"`
+}
+
+// toolCallStatsProvider stores the registered ToolCallStatsProvider implementation.
+var toolCallStatsProvider ToolCallStatsProvider
+
+// RegisterToolCallStatsProvider registers the provider of tool call counters.
+// This is typically the aggregator.
+//
+// Thread-safe: Yes, protected by handlerMutex.
+func RegisterToolCallStatsProvider(p ToolCallStatsProvider) {
+	handlerMutex.Lock()
+	defer handlerMutex.Unlock()
+	toolCallStatsProvider = p
+}
+
+// GetToolCallStatsProvider returns the registered tool call counter provider,
+// or nil if none has been registered.
+//
+// Thread-safe: Yes, protected by handlerMutex read lock.
+func GetToolCallStatsProvider() ToolCallStatsProvider {
+	handlerMutex.RLock()
+	defer handlerMutex.RUnlock()
+	return toolCallStatsProvider
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewToolStatsReport(t *testing.T) {
+	usage := []ToolUsageStats{
+		{Tool: "b", Calls: 5, Errors: 1, ErrorRate: 0.2, AvgLatencyMs: 30},
+		{Tool: "a", Calls: 5, Errors: 0, ErrorRate: 0, AvgLatencyMs: 10},
+		{Tool: "c", Calls: 1, Errors: 1, ErrorRate: 1, AvgLatencyMs: 20},
+	}
+	available := []string{"d", "a", "b", "c", "e", "d"}
+
+	tests := []struct {
+		sortBy string
+		want   []string
+	}{
+		{"", []string{"a", "b", "c"}},
+		{ToolStatsSortCalls, []string{"a", "b", "c"}},
+		{ToolStatsSortErrors, []string{"b", "c", "a"}},
+		{ToolStatsSortErrorRate, []string{"c", "b", "a"}},
+		{ToolStatsSortLatency, []string{"b", "c", "a"}},
+		{ToolStatsSortName, []string{"a", "b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sortBy, func(t *testing.T) {
+			report := NewToolStatsReport(usage, available, tt.sortBy)
+			var got []string
+			for _, u := range report.Tools {
+				got = append(got, u.Tool)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tools = %v, want %v", got, tt.want)
+			}
+			if want := []string{"d", "e"}; !reflect.DeepEqual(report.Unused, want) {
+				t.Errorf("unused = %v, want %v", report.Unused, want)
+			}
+		})
+	}
+
+	if usage[0].Tool != "b" {
+		t.Errorf("NewToolStatsReport reordered its input: %v", usage)
+	}
+}
+
+func TestNewToolStatsReport_Empty(t *testing.T) {
+	report := NewToolStatsReport(nil, nil, "")
+	if report.Tools == nil || report.Unused == nil {
+		t.Errorf("expected empty, non-nil slices for JSON arrays, got %+v", report)
+	}
+}
@@ -335,6 +335,10 @@ type SchemaProperty struct {
 type WorkflowExecutionStatus string
 
 const (
+	// WorkflowExecutionPending indicates the execution is queued, waiting for
+	// a worker to run it
+	WorkflowExecutionPending WorkflowExecutionStatus = "pending"
+
 	// WorkflowExecutionInProgress indicates the execution is currently running
 	WorkflowExecutionInProgress WorkflowExecutionStatus = "inprogress"
 
@@ -403,6 +407,10 @@ type WorkflowExecutionStep struct {
 	// Tool is the name of the tool that was executed for this step
 	Tool string `json:"tool"`
 
+	// Prompt is the name of the prompt that was rendered for this step, if
+	// it is a prompt step instead of a tool call
+	Prompt string `json:"prompt,omitempty"`
+
 	// Status indicates the current state of the step execution
 	Status WorkflowExecutionStatus `json:"status"`
 
@@ -426,6 +434,10 @@ type WorkflowExecutionStep struct {
 
 	// StoredAs is the variable name where the step result was stored (from workflow definition)
 	StoredAs string `json:"stored_as,omitempty"`
+
+	// Artifact is the URI of the artifact holding the step result, if the
+	// step stored its result as an artifact
+	Artifact string `json:"artifact,omitempty"`
 }
 
 // ListWorkflowExecutionsRequest represents a request to list workflow executions
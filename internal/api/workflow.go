@@ -49,6 +49,10 @@ type Workflow struct {
 	// is returned.
 	Output map[string]interface{} `yaml:"output,omitempty" json:"output,omitempty"`
 
+	// Concurrency limits the executions of this workflow that run at the
+	// same time. When nil, only the limits of the execution queue apply.
+	Concurrency *WorkflowConcurrency `yaml:"concurrency,omitempty" json:"concurrency,omitempty"`
+
 	// Runtime state fields (for API responses only) - Dynamic runtime information
 
 	// Available indicates whether this workflow is currently available for execution
@@ -63,6 +67,20 @@ type Workflow struct {
 	LastModified time.Time `yaml:"lastModified,omitempty" json:"lastModified"`
 }
 
+// WorkflowConcurrency limits the executions of a workflow that run at the
+// same time. Executions beyond the limits wait in the execution queue.
+type WorkflowConcurrency struct {
+	// MaxConcurrent is the number of executions of the workflow that run at
+	// the same time; 1 runs them one after the other. 0 sets no limit.
+	MaxConcurrent int `yaml:"maxConcurrent,omitempty" json:"maxConcurrent,omitempty"`
+
+	// Mutexes names mutexes an execution holds while it runs: of all the
+	// executions that name the same mutex, of any workflow, only one runs at
+	// a time. The names are templates rendered against the execution
+	// arguments as .input, e.g. "deploy-{{ .input.cluster }}".
+	Mutexes []string `yaml:"mutexes,omitempty" json:"mutexes,omitempty"`
+}
+
 // OutputEnabled resolves the effective "include in returned result" flag for a
 // step from its Output pointer and the deprecated Store alias. Output takes
 // precedence when set; otherwise Store is used for backwards compatibility.
@@ -237,16 +255,22 @@ type WorkflowStep struct {
 	// Must correspond to an available tool in the aggregator.
 	Tool string `yaml:"tool" json:"tool"`
 
-	// Args provides the arguments to pass to the tool.
+	// Prompt specifies the name of an MCP prompt to render (get_prompt)
+	// instead of calling a tool. Args are passed to the prompt as strings and
+	// the step result holds the rendered messages.
+	// Mutually exclusive with Tool, ForEach, and Parallel.
+	Prompt string `yaml:"prompt,omitempty" json:"prompt,omitempty"`
+
+	// Args provides the arguments to pass to the tool or prompt.
 	// Can include templated values that are resolved at runtime using previous step results.
 	Args map[string]interface{} `yaml:"args,omitempty" json:"args,omitempty"`
 
 	// ForEach executes a body of sub-steps once per item of a list.
-	// Mutually exclusive with Tool and Parallel.
+	// Mutually exclusive with Tool, Prompt, and Parallel.
 	ForEach *WorkflowForEach `yaml:"forEach,omitempty" json:"forEach,omitempty"`
 
 	// Parallel executes a group of sub-steps concurrently.
-	// Mutually exclusive with Tool and ForEach.
+	// Mutually exclusive with Tool, Prompt, and ForEach.
 	Parallel []WorkflowSubStep `yaml:"parallel,omitempty" json:"parallel,omitempty"`
 
 	// AllowFailure indicates whether this step is allowed to fail without failing the workflow.
@@ -265,6 +289,12 @@ type WorkflowStep struct {
 	// result visibility. Prefer Output.
 	Store bool `yaml:"store,omitempty" json:"store,omitempty"`
 
+	// Artifact stores the result of a tool step as an artifact of the
+	// execution instead of inlining it into the returned document, which then
+	// references it by its URI (see ExecutionArtifactURI). The result stays
+	// referenceable by later steps and the output template.
+	Artifact bool `yaml:"artifact,omitempty" json:"artifact,omitempty"`
+
 	// Description provides human-readable documentation for this step's purpose
 	Description string `yaml:"description,omitempty" json:"description,omitempty"`
 }
@@ -457,6 +487,58 @@ type WorkflowHandler interface {
 	ToolProvider
 }
 
+// ExecutionArtifactURITemplate is the URI template of the MCP resources
+// serving the artifacts of workflow steps (see WorkflowStep.Artifact).
+const ExecutionArtifactURITemplate = "execution://{execution_id}/{step_id}/output"
+
+// ExecutionArtifactURI returns the URI of the artifact of step stepID of
+// workflow execution executionID.
+func ExecutionArtifactURI(executionID, stepID string) string {
+	return fmt.Sprintf("execution://%s/%s/output", executionID, stepID)
+}
+
+// ParseExecutionArtifactURI returns the execution and step IDs of an
+// artifact URI, or ok false if uri is not one.
+func ParseExecutionArtifactURI(uri string) (executionID, stepID string, ok bool) {
+	rest, found := strings.CutPrefix(uri, "execution://")
+	if !found {
+		return "", "", false
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] != "output" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// StepArtifact is the stored result of a workflow step that declares
+// artifact: true.
+type StepArtifact struct {
+	// ExecutionID is the workflow execution the step ran in
+	ExecutionID string `json:"execution_id"`
+
+	// StepID is the step that produced the artifact
+	StepID string `json:"step_id"`
+
+	// MIMEType is application/json for JSON results and text/plain otherwise
+	MIMEType string `json:"mime_type"`
+
+	// Data is the text result of the step
+	Data string `json:"data"`
+
+	// CreatedAt is when the artifact was stored
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WorkflowArtifactProvider is implemented by workflow handlers that keep the
+// artifacts of workflow steps, which the aggregator serves as MCP resources
+// at ExecutionArtifactURITemplate.
+type WorkflowArtifactProvider interface {
+	// GetStepArtifact returns the artifact of step stepID of execution
+	// executionID, or a NotFoundError if there is none.
+	GetStepArtifact(ctx context.Context, executionID, stepID string) (*StepArtifact, error)
+}
+
 // CreateWorkflowRequest represents a request to create a new workflow.
 // This is used for API-based workflow creation with validation and structured input.
 type CreateWorkflowRequest struct {
@@ -84,3 +84,17 @@ func TestAuthoringWarnings_Clean(t *testing.T) {
 		t.Errorf("expected no warnings for nil workflow, got: %v", w)
 	}
 }
+
+func TestParseExecutionArtifactURI(t *testing.T) {
+	uri := ExecutionArtifactURI("3f2b", "fetch-logs")
+	executionID, stepID, ok := ParseExecutionArtifactURI(uri)
+	if !ok || executionID != "3f2b" || stepID != "fetch-logs" {
+		t.Errorf("ParseExecutionArtifactURI(%q) = %q, %q, %t", uri, executionID, stepID, ok)
+	}
+
+	for _, invalid := range []string{"stats://tools", "execution://3f2b/fetch-logs", "execution://3f2b//output", "execution://3f2b/fetch-logs/input"} {
+		if _, _, ok := ParseExecutionArtifactURI(invalid); ok {
+			t.Errorf("ParseExecutionArtifactURI(%q) accepted an invalid URI", invalid)
+		}
+	}
+}
@@ -3,11 +3,15 @@ package app
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/giantswarm/muster/internal/config"
 	"github.com/giantswarm/muster/pkg/logging"
 )
 
+// sourceSyncTimeout bounds fetching the configuration from a remote source.
+const sourceSyncTimeout = 2 * time.Minute
+
 // Application represents the main application structure that bootstraps and runs muster.
 // It encapsulates all necessary configuration and services required for the application's
 // lifecycle, including initialization, service management, and execution mode handling.
@@ -40,6 +44,10 @@ type Application struct {
 // Configuration Loading Behavior:
 //   - If cfg.ConfigPath is set: loads from the specified directory only
 //   - If cfg.ConfigPath is empty: uses layered loading (defaults + user + project)
+//   - If the loaded config.yaml sets a source: fetches the configuration from
+//     it and continues with the fetched copy as cfg.ConfigPath
+//   - If cfg.Profile is set: merges the files of the profile over the
+//     configuration, after fetching it from a source
 //
 // The function returns an error if any critical initialization step fails,
 // including configuration loading or service initialization failures.
@@ -74,6 +82,29 @@ func NewApplication(cfg *Config) (*Application, error) {
 		return nil, fmt.Errorf("failed to load muster configuration from path %s: %w", cfg.ConfigPath, err)
 	}
 
+	// A remote source replaces the configuration directory for the rest of
+	// the bootstrap, so every component reads the fetched copy.
+	if musterCfg.Source.Type != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), sourceSyncTimeout)
+		dir, err := config.SyncSource(ctx, musterCfg, cfg.ConfigPath)
+		cancel()
+		if err != nil {
+			logging.Error("Bootstrap", err, "Failed to load configuration from %s source", musterCfg.Source.Type)
+			return nil, fmt.Errorf("failed to load configuration from %s source: %w", musterCfg.Source.Type, err)
+		}
+		cfg.ConfigPath = dir
+	}
+
+	// The profile is merged over the configuration muster runs from, so a
+	// remote source is selected by the local config.yaml alone
+	if musterCfg.Source.Type != "" || cfg.Profile != "" {
+		musterCfg, err = config.LoadConfigWithProfile(cfg.ConfigPath, cfg.Profile)
+		if err != nil {
+			logging.Error("Bootstrap", err, "Failed to load muster configuration from path: %s", cfg.ConfigPath)
+			return nil, fmt.Errorf("failed to load muster configuration from path %s: %w", cfg.ConfigPath, err)
+		}
+	}
+
 	cfg.MusterConfig = &musterCfg
 
 	// Initialize services
@@ -30,11 +30,19 @@ type Config struct {
 	// Use with caution in production environments.
 	Yolo bool
 
+	// ReadOnly disables the mutating core tools, for demo and audit
+	// deployments. It is combined with aggregator.readOnly of config.yaml.
+	ReadOnly bool
+
 	// ConfigPath specifies a custom configuration directory path.
 	// When set, disables layered configuration loading and loads from this path only.
 	// When empty, uses standard layered configuration loading strategy.
 	ConfigPath string
 
+	// Profile names the profile in ConfigPath whose files are merged over the
+	// configuration. Empty uses the configuration as is.
+	Profile string
+
 	// MusterConfig holds the loaded muster environment configuration.
 	// This field is populated during application bootstrap after configuration loading.
 	MusterConfig *config.MusterConfig
@@ -139,3 +147,16 @@ func (c *Config) WithExtraCAFile(path string) *Config {
 	c.ExtraCAFile = path
 	return c
 }
+
+// WithReadOnly enables read-only mode. See Config.ReadOnly.
+func (c *Config) WithReadOnly(readOnly bool) *Config {
+	c.ReadOnly = readOnly
+	return c
+}
+
+// WithProfile selects the profile whose files are merged over the
+// configuration. See Config.Profile.
+func (c *Config) WithProfile(profile string) *Config {
+	c.Profile = profile
+	return c
+}
@@ -20,6 +20,8 @@ import (
 type ConfigAdapter struct {
 	config     *config.MusterConfig
 	configPath string
+	configDir  string
+	profile    string
 	mu         sync.RWMutex
 }
 
@@ -33,6 +35,22 @@ func NewConfigAdapter(cfg *config.MusterConfig, configPath string) *ConfigAdapte
 	}
 }
 
+// SetConfigDir sets the configuration directory checked by the config_validate
+// tool and reloaded by the config_reload tool.
+func (a *ConfigAdapter) SetConfigDir(dir string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.configDir = dir
+}
+
+// SetProfile sets the profile merged over config.yaml by the config_reload
+// tool.
+func (a *ConfigAdapter) SetProfile(profile string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.profile = profile
+}
+
 // Register registers the adapter with the API layer.
 // This must be called during application initialization to make the config
 // handler available to other components through the API system.
@@ -85,25 +103,50 @@ func (a *ConfigAdapter) SaveConfig(ctx context.Context) error {
 }
 
 // ReloadConfig reloads the configuration from disk using the centralized loader.
-// This replaces the current in-memory configuration with the version from disk.
+// This replaces the current in-memory configuration with the version from disk
+// and applies the reloaded aggregator settings to the running aggregator.
 func (a *ConfigAdapter) ReloadConfig(ctx context.Context) error {
-	a.mu.Lock()
-	defer a.mu.Unlock()
+	_, err := a.reloadConfig(ctx)
+	return err
+}
 
+// reloadConfig reloads the configuration and reports how the aggregator
+// settings were applied. The report is nil if no aggregator is registered.
+func (a *ConfigAdapter) reloadConfig(ctx context.Context) (*api.AggregatorConfigChanges, error) {
+	a.mu.Lock()
 	// Load config using the centralized loader
-	musterConfig, err := config.LoadConfig(a.configPath)
+	musterConfig, err := config.LoadConfigWithProfile(a.reloadDir(), a.profile)
 	if err != nil {
-		return fmt.Errorf("failed to reload configuration: %w", err)
+		a.mu.Unlock()
+		return nil, fmt.Errorf("failed to reload configuration: %w", err)
 	}
-
 	a.config = &musterConfig
-	return nil
+	a.mu.Unlock()
+
+	aggHandler := api.GetAggregator()
+	if aggHandler == nil {
+		return nil, nil
+	}
+	changes, err := aggHandler.ApplyConfig(ctx, musterConfig.Aggregator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply aggregator configuration: %w", err)
+	}
+	return changes, nil
+}
+
+// reloadDir returns the directory to reload config.yaml from: the directory
+// muster was started with, if set. Caller must hold mu.
+func (a *ConfigAdapter) reloadDir() string {
+	if a.configDir != "" {
+		return a.configDir
+	}
+	return a.configPath
 }
 
 // GetTools returns metadata for all configuration management tools provided by this adapter.
 // These tools are exposed through the MCP aggregator for external clients.
 func (a *ConfigAdapter) GetTools() []api.ToolMetadata {
-	return []api.ToolMetadata{
+	tools := []api.ToolMetadata{
 		{
 			Name:        "config_get",
 			Description: "Get the current muster configuration",
@@ -131,9 +174,34 @@ func (a *ConfigAdapter) GetTools() []api.ToolMetadata {
 		},
 		{
 			Name:        "config_reload",
-			Description: "Reload configuration from file",
+			Description: "Reload configuration from file and apply changed aggregator settings",
+		},
+		{
+			Name:        "config_validate",
+			Description: "Validate config.yaml and the MCPServer and Workflow definitions in the configuration directory against their schemas",
+		},
+		{
+			Name:        "config_history",
+			Description: "List the recorded revisions of MCPServer and Workflow definitions, or show the definition at one revision",
+			Args: []api.ArgMetadata{
+				{Name: "type", Type: api.ArgTypeString, Required: true, Description: "Entity type: mcpserver or workflow"},
+				{Name: "name", Type: api.ArgTypeString, Required: false, Description: "Entity name; lists the entities with recorded revisions if omitted"},
+				{Name: "revision", Type: api.ArgTypeInteger, Required: false, Description: "Revision whose definition to return"},
+				api.NamespaceArgMetadata(),
+			},
+		},
+		{
+			Name:        "config_rollback",
+			Description: "Restore an MCPServer or Workflow definition to a recorded revision",
+			Args: []api.ArgMetadata{
+				{Name: "type", Type: api.ArgTypeString, Required: true, Description: "Entity type: mcpserver or workflow"},
+				{Name: "name", Type: api.ArgTypeString, Required: true, Description: "Entity name"},
+				{Name: "revision", Type: api.ArgTypeInteger, Required: true, Description: "Revision to restore, as listed by config_history"},
+				api.NamespaceArgMetadata(),
+			},
 		},
 	}
+	return append(tools, configExportTools()...)
 }
 
 // ExecuteTool executes a configuration management tool by name with the provided arguments.
@@ -150,6 +218,16 @@ func (a *ConfigAdapter) ExecuteTool(ctx context.Context, toolName string, args m
 		return a.handleConfigSave(ctx)
 	case "config_reload":
 		return a.handleConfigReload(ctx)
+	case "config_validate":
+		return a.handleConfigValidate()
+	case "config_history":
+		return a.handleConfigHistory(args)
+	case "config_rollback":
+		return a.handleConfigRollback(args)
+	case "config_export":
+		return a.handleConfigExport(ctx)
+	case "config_import":
+		return a.handleConfigImport(ctx, args)
 	default:
 		return nil, fmt.Errorf("tool '%s' not found", toolName)
 	}
@@ -194,10 +272,7 @@ func (a *ConfigAdapter) saveConfig() error {
 func (a *ConfigAdapter) handleConfigGet(ctx context.Context) (*api.CallToolResult, error) {
 	cfg, err := a.GetConfig(ctx)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to get configuration: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to get configuration"), nil
 	}
 
 	return &api.CallToolResult{
@@ -211,10 +286,7 @@ func (a *ConfigAdapter) handleConfigGet(ctx context.Context) (*api.CallToolResul
 func (a *ConfigAdapter) handleConfigGetAggregator(ctx context.Context) (*api.CallToolResult, error) {
 	aggregator, err := a.GetAggregatorConfig(ctx)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to get aggregator config: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to get aggregator config"), nil
 	}
 
 	return &api.CallToolResult{
@@ -228,26 +300,17 @@ func (a *ConfigAdapter) handleConfigGetAggregator(ctx context.Context) (*api.Cal
 func (a *ConfigAdapter) handleConfigUpdateAggregator(ctx context.Context, args map[string]interface{}) (*api.CallToolResult, error) {
 	aggregatorData, ok := args["aggregator"]
 	if !ok {
-		return &api.CallToolResult{
-			Content: []interface{}{"aggregator is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("aggregator is required")), nil
 	}
 
 	// Convert to config.AggregatorConfig
 	var aggregator config.AggregatorConfig
 	if err := convertToStruct(aggregatorData, &aggregator); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to parse aggregator config: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(&api.ValidationFailedError{Err: err}, "Failed to parse aggregator config"), nil
 	}
 
 	if err := a.UpdateAggregatorConfig(ctx, aggregator); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to update aggregator config: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to update aggregator config"), nil
 	}
 
 	return &api.CallToolResult{
@@ -275,7 +338,8 @@ func (a *ConfigAdapter) handleConfigSave(ctx context.Context) (*api.CallToolResu
 // Reloads configuration from disk and triggers definition reloads for other components.
 func (a *ConfigAdapter) handleConfigReload(ctx context.Context) (*api.CallToolResult, error) {
 	// Reload main configuration
-	if err := a.ReloadConfig(ctx); err != nil {
+	changes, err := a.reloadConfig(ctx)
+	if err != nil {
 		return nil, err
 	}
 
@@ -288,10 +352,155 @@ func (a *ConfigAdapter) handleConfigReload(ctx context.Context) (*api.CallToolRe
 		}
 	}
 
+	content := []interface{}{"Configuration reloaded successfully"}
+	if changes.HasChanges() {
+		content = append(content, map[string]interface{}{"aggregator": changes})
+	}
+	return &api.CallToolResult{Content: content}, nil
+}
+
+// handleConfigValidate handles the 'config_validate' tool call.
+// Statically validates the configuration directory muster was started with
+// and returns the report. The result is an error if the report has errors.
+func (a *ConfigAdapter) handleConfigValidate() (*api.CallToolResult, error) {
+	a.mu.RLock()
+	configDir := a.configDir
+	a.mu.RUnlock()
+
+	if configDir == "" {
+		return api.NewErrorResult("No configuration directory to validate", api.NewUnavailableError("configuration directory", nil)), nil
+	}
+
+	report, err := config.ValidateDirectory(configDir)
+	if err != nil {
+		return api.HandleErrorWithPrefix(err, "Failed to validate configuration"), nil
+	}
+
 	return &api.CallToolResult{
-		Content: []interface{}{
-			"Configuration reloaded successfully",
-		},
+		Content: []interface{}{report},
+		IsError: !report.Valid(),
+	}, nil
+}
+
+// historyEntityTypes maps the entity types accepted by the history tools to
+// their configuration subdirectories.
+var historyEntityTypes = map[string]string{
+	"mcpserver": "mcpservers",
+	"workflow":  "workflows",
+}
+
+// historyArgs resolves the history of the namespace argument in the
+// configuration directory and the type, name, and revision arguments of the
+// history tools. It returns an error result if they are unusable.
+func (a *ConfigAdapter) historyArgs(args map[string]interface{}, requireName, requireRevision bool) (*config.History, string, string, int, *api.CallToolResult) {
+	a.mu.RLock()
+	configDir := a.configDir
+	kubernetes := a.config != nil && a.config.Kubernetes
+	home := ""
+	if a.config != nil {
+		home = a.config.Namespace
+	}
+	a.mu.RUnlock()
+
+	fail := func(format string, v ...interface{}) (*config.History, string, string, int, *api.CallToolResult) {
+		return nil, "", "", 0, &api.CallToolResult{Content: []interface{}{fmt.Sprintf(format, v...)}, IsError: true}
+	}
+	if configDir == "" || kubernetes {
+		return fail("Configuration history is only recorded for a configuration directory in filesystem mode")
+	}
+
+	typeArg, _ := args["type"].(string)
+	entityType, ok := historyEntityTypes[typeArg]
+	if !ok {
+		return fail("type must be mcpserver or workflow, got %q", typeArg)
+	}
+	name, _ := args["name"].(string)
+	if name == "" && requireName {
+		return fail("name is required")
+	}
+
+	revision := 0
+	switch v := args["revision"].(type) {
+	case nil:
+		if requireRevision {
+			return fail("revision is required")
+		}
+	case float64:
+		revision = int(v)
+	case int:
+		revision = v
+	default:
+		return fail("revision must be an integer")
+	}
+	if revision < 0 || (revision == 0 && requireRevision) {
+		return fail("revision must be positive")
+	}
+
+	namespace := api.NamespaceFrom(args, "")
+	if namespace != "" {
+		if err := config.CheckNamespace(namespace); err != nil {
+			return fail("%v", err)
+		}
+	}
+	return config.NewHistory(config.NamespacePath(configDir, home, namespace)), entityType, name, revision, nil
+}
+
+// handleConfigHistory handles the 'config_history' tool call.
+// Lists the entities with recorded revisions, the revisions of one entity, or
+// the definition at one revision.
+func (a *ConfigAdapter) handleConfigHistory(args map[string]interface{}) (*api.CallToolResult, error) {
+	history, entityType, name, revision, errResult := a.historyArgs(args, false, false)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	var result interface{}
+	var err error
+	switch {
+	case name == "":
+		var names []string
+		names, err = history.Entities(entityType)
+		result = map[string]interface{}{"type": args["type"], "names": names}
+	case revision == 0:
+		var revisions []config.Revision
+		revisions, err = history.Revisions(entityType, name)
+		result = map[string]interface{}{"type": args["type"], "name": name, "revisions": revisions}
+	default:
+		var r config.Revision
+		var data []byte
+		r, data, err = history.Load(entityType, name, revision)
+		result = map[string]interface{}{"type": args["type"], "name": name, "revision": r, "definition": string(data)}
+	}
+	if err != nil {
+		return api.HandleErrorWithPrefix(err, "Failed to read configuration history"), nil
+	}
+
+	return &api.CallToolResult{
+		Content: []interface{}{result},
+		IsError: false,
+	}, nil
+}
+
+// handleConfigRollback handles the 'config_rollback' tool call.
+// Restores the definition file of an entity from its history; the filesystem
+// watcher then reconciles the change like a manual edit.
+func (a *ConfigAdapter) handleConfigRollback(args map[string]interface{}) (*api.CallToolResult, error) {
+	history, entityType, name, revision, errResult := a.historyArgs(args, true, true)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	r, err := history.Rollback(entityType, name, revision)
+	if err != nil {
+		return &api.CallToolResult{
+			Content: []interface{}{fmt.Sprintf("Failed to roll back %s %s: %v", args["type"], name, err)},
+			IsError: true,
+		}, nil
+	}
+
+	return &api.CallToolResult{
+		Content: []interface{}{fmt.Sprintf("Rolled back %s %s to revision %d (recorded as revision %d)", args["type"], name, revision, r.Revision)},
+		IsError: false,
 	}, nil
 }
 
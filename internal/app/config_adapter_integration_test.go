@@ -95,7 +95,7 @@ func TestConfigReloadTool(t *testing.T) {
 	for _, tool := range tools {
 		if tool.Name == "config_reload" {
 			found = true
-			assert.Equal(t, "Reload configuration from file", tool.Description)
+			assert.Equal(t, "Reload configuration from file and apply changed aggregator settings", tool.Description)
 			break
 		}
 	}
@@ -109,3 +109,81 @@ func TestConfigReloadTool(t *testing.T) {
 	assert.False(t, result.IsError)
 	assert.Equal(t, "Configuration reloaded successfully", result.Content[0])
 }
+
+func TestConfigValidateTool(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	assert.NoError(t, os.WriteFile(configPath, []byte("aggregator:\n  port: 8090\n"), 0644))
+
+	adapter := NewConfigAdapter(&config.MusterConfig{}, tmpDir)
+	ctx := context.Background()
+
+	// Without a configuration directory there is nothing to validate
+	result, err := adapter.ExecuteTool(ctx, "config_validate", nil)
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	adapter.SetConfigDir(tmpDir)
+	result, err = adapter.ExecuteTool(ctx, "config_validate", nil)
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	report, ok := result.Content[0].(*config.ValidationReport)
+	assert.True(t, ok)
+	assert.Equal(t, 1, report.FilesChecked)
+
+	assert.NoError(t, os.WriteFile(configPath, []byte("aggregator:\n  port: high\n"), 0644))
+	result, err = adapter.ExecuteTool(ctx, "config_validate", nil)
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+	report, ok = result.Content[0].(*config.ValidationReport)
+	assert.True(t, ok)
+	assert.Equal(t, 1, report.ErrorCount())
+}
+
+func TestConfigHistoryTools(t *testing.T) {
+	tmpDir := t.TempDir()
+	history := config.NewHistory(tmpDir)
+	assert.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "workflows"), 0755))
+	assert.NoError(t, history.RecordSave("workflows", "deploy", nil, []byte("v1\n")))
+	assert.NoError(t, history.RecordSave("workflows", "deploy", []byte("v1\n"), []byte("v2\n")))
+	assert.NoError(t, os.WriteFile(filepath.Join(tmpDir, "workflows", "deploy.yaml"), []byte("v2\n"), 0644))
+
+	adapter := NewConfigAdapter(&config.MusterConfig{}, "")
+	adapter.SetConfigDir(tmpDir)
+	ctx := context.Background()
+
+	result, err := adapter.ExecuteTool(ctx, "config_history", map[string]interface{}{"type": "workflow", "name": "deploy"})
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	revisions := result.Content[0].(map[string]interface{})["revisions"].([]config.Revision)
+	assert.Len(t, revisions, 2)
+
+	result, err = adapter.ExecuteTool(ctx, "config_rollback", map[string]interface{}{"type": "workflow", "name": "deploy", "revision": float64(1)})
+	assert.NoError(t, err)
+	assert.False(t, result.IsError, result.Content)
+	data, err := os.ReadFile(filepath.Join(tmpDir, "workflows", "deploy.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "v1\n", string(data))
+
+	result, err = adapter.ExecuteTool(ctx, "config_rollback", map[string]interface{}{"type": "serviceclass", "name": "deploy", "revision": float64(1)})
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	result, err = adapter.ExecuteTool(ctx, "config_rollback", map[string]interface{}{"type": "workflow", "name": "deploy"})
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	// The history of another namespace is kept in its directory
+	teamDir := filepath.Join(tmpDir, config.NamespacesDir, "team-a")
+	assert.NoError(t, config.NewHistory(teamDir).RecordSave("workflows", "deploy", nil, []byte("team\n")))
+	result, err = adapter.ExecuteTool(ctx, "config_rollback", map[string]interface{}{"type": "workflow", "name": "deploy", "revision": float64(1), "namespace": "team-a"})
+	assert.NoError(t, err)
+	assert.False(t, result.IsError, result.Content)
+	data, err = os.ReadFile(filepath.Join(teamDir, "workflows", "deploy.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "team\n", string(data))
+
+	result, err = adapter.ExecuteTool(ctx, "config_history", map[string]interface{}{"type": "workflow", "namespace": "../team-a"})
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+}
@@ -0,0 +1,246 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/config"
+)
+
+// configExportTools returns the metadata of the config_export and
+// config_import tools.
+func configExportTools() []api.ToolMetadata {
+	return []api.ToolMetadata{
+		{
+			Name:        "config_export",
+			Description: "Export the main configuration and all MCPServer and Workflow definitions as one manifest, for config_import to clone or restore the environment",
+		},
+		{
+			Name:        "config_import",
+			Description: "Apply a manifest written by config_export: create the missing MCPServer and Workflow definitions and, optionally, replace the main configuration",
+			Args: []api.ArgMetadata{
+				{Name: "manifest", Type: api.ArgTypeObject, Required: true, Description: "Manifest returned by config_export"},
+				{Name: "overwrite", Type: api.ArgTypeBoolean, Required: false, Description: "Update the definitions that already exist instead of skipping them (default: false)", Default: false},
+				{Name: "include_config", Type: api.ArgTypeBoolean, Required: false, Description: "Replace and save the main configuration with the one of the manifest (default: true)", Default: true},
+				{Name: "atomic", Type: api.ArgTypeBoolean, Required: false, Description: "Apply the definitions of each type all or nothing, and keep the main configuration if any fails (default: false)", Default: false},
+			},
+		},
+	}
+}
+
+// handleConfigExport handles the 'config_export' tool call.
+// Returns the manifest of the current environment.
+func (a *ConfigAdapter) handleConfigExport(ctx context.Context) (*api.CallToolResult, error) {
+	export, err := a.exportConfig(ctx)
+	if err != nil {
+		return api.HandleErrorWithPrefix(err, "Failed to export configuration"), nil
+	}
+
+	return &api.CallToolResult{
+		Content: []interface{}{export},
+		IsError: false,
+	}, nil
+}
+
+// exportConfig collects the main configuration and the definitions of the
+// registered MCPServer manager and workflow handler, sorted by name.
+func (a *ConfigAdapter) exportConfig(ctx context.Context) (*api.ConfigExport, error) {
+	cfg, err := a.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	manager := api.GetMCPServerManager()
+	if manager == nil {
+		return nil, api.NewUnavailableError("MCPServer manager", nil)
+	}
+	workflows := api.GetWorkflow()
+	if workflows == nil {
+		return nil, api.NewUnavailableError("workflow handler", nil)
+	}
+
+	a.mu.RLock()
+	cfgCopy := *cfg
+	a.mu.RUnlock()
+
+	export := &api.ConfigExport{
+		Version:    api.ConfigExportVersion,
+		ExportedAt: time.Now(),
+		Config:     &cfgCopy,
+		MCPServers: []api.MCPServerCreateRequest{},
+		Workflows:  []api.WorkflowCreateRequest{},
+	}
+	for _, info := range manager.ListMCPServers() {
+		export.MCPServers = append(export.MCPServers, api.MCPServerCreateRequest{
+			Name:        info.Name,
+			Type:        info.Type,
+			ToolPrefix:  info.ToolPrefix,
+			Family:      info.Family,
+			Description: info.Description,
+			AutoStart:   info.AutoStart,
+			Command:     info.Command,
+			Args:        info.Args,
+			URL:         info.URL,
+			Env:         info.Env,
+			Headers:     info.Headers,
+			Timeout:     info.Timeout,
+			Auth:        info.Auth,
+		})
+	}
+	for _, wf := range workflows.GetWorkflows() {
+		export.Workflows = append(export.Workflows, api.WorkflowCreateRequest{
+			Name:        wf.Name,
+			Description: wf.Description,
+			Args:        wf.Args,
+			Steps:       wf.Steps,
+			OnFailure:   wf.OnFailure,
+			Output:      wf.Output,
+			Concurrency: wf.Concurrency,
+		})
+	}
+	sort.Slice(export.MCPServers, func(i, j int) bool { return export.MCPServers[i].Name < export.MCPServers[j].Name })
+	sort.Slice(export.Workflows, func(i, j int) bool { return export.Workflows[i].Name < export.Workflows[j].Name })
+	return export, nil
+}
+
+// handleConfigImport handles the 'config_import' tool call.
+// Applies the MCPServer definitions, then the Workflow definitions, since
+// workflows may call the tools of the servers, and finally the main
+// configuration. The result is an error if any definition failed.
+func (a *ConfigAdapter) handleConfigImport(ctx context.Context, args map[string]interface{}) (*api.CallToolResult, error) {
+	manifestArg, ok := args["manifest"]
+	if !ok {
+		return api.HandleError(api.NewValidationFailedError("manifest is required")), nil
+	}
+	var manifest api.ConfigExport
+	if err := convertToStruct(manifestArg, &manifest); err != nil {
+		return api.HandleErrorWithPrefix(&api.ValidationFailedError{Err: err}, "Failed to parse manifest"), nil
+	}
+	if manifest.Version != api.ConfigExportVersion {
+		return api.HandleError(api.NewValidationFailedError("unsupported manifest version %q (supported: %s)", manifest.Version, api.ConfigExportVersion)), nil
+	}
+	overwrite, _ := args["overwrite"].(bool)
+	atomic, _ := args["atomic"].(bool)
+	includeConfig := true
+	if v, ok := args["include_config"].(bool); ok {
+		includeConfig = v
+	}
+
+	manager := api.GetMCPServerManager()
+	serverTarget, ok := manager.(api.BatchTarget)
+	if !ok {
+		return api.HandleError(api.NewUnavailableError("MCPServer manager", nil)), nil
+	}
+	workflows := api.GetWorkflow()
+	workflowTarget, ok := workflows.(api.BatchTarget)
+	if !ok {
+		return api.HandleError(api.NewUnavailableError("workflow handler", nil)), nil
+	}
+
+	result := &api.ConfigImportResult{}
+	var items []api.BatchItem
+	for _, server := range manifest.MCPServers {
+		_, err := manager.GetMCPServer(server.Name)
+		if err != nil && !api.IsNotFound(err) {
+			return api.HandleErrorWithPrefix(err, fmt.Sprintf("Failed to get MCPServer %s", server.Name)), nil
+		}
+		item, skip, err := importItem(server, err == nil, overwrite)
+		if err != nil {
+			return api.HandleErrorWithPrefix(err, fmt.Sprintf("Invalid MCPServer %s", server.Name)), nil
+		}
+		if skip {
+			result.Skipped = append(result.Skipped, "mcpserver/"+server.Name)
+			continue
+		}
+		items = append(items, item)
+	}
+	if len(items) > 0 {
+		result.MCPServers = api.ExecuteBatch(ctx, &api.BatchRequest{Items: items, Atomic: atomic}, serverTarget)
+	}
+
+	items = nil
+	for _, wf := range manifest.Workflows {
+		// The workflow handler reports every failure as not found
+		_, err := workflows.GetWorkflow(wf.Name)
+		item, skip, err := importItem(wf, err == nil, overwrite)
+		if err != nil {
+			return api.HandleErrorWithPrefix(err, fmt.Sprintf("Invalid workflow %s", wf.Name)), nil
+		}
+		if skip {
+			result.Skipped = append(result.Skipped, "workflow/"+wf.Name)
+			continue
+		}
+		items = append(items, item)
+	}
+	if len(items) > 0 {
+		result.Workflows = api.ExecuteBatch(ctx, &api.BatchRequest{Items: items, Atomic: atomic}, workflowTarget)
+
+		// Rollbacks bypass the handlers, so refresh the capabilities to drop
+		// the tools of workflows whose creation was reverted
+		if aggregator := api.GetAggregator(); aggregator != nil && atomic && result.Workflows.Failed > 0 {
+			aggregator.UpdateCapabilities()
+		}
+	}
+
+	failed := importFailed(result.MCPServers) || importFailed(result.Workflows)
+	if includeConfig && manifest.Config != nil && !(atomic && failed) {
+		changes, err := a.importConfig(ctx, manifest.Config)
+		if err != nil {
+			return api.HandleErrorWithPrefix(err, "Failed to import configuration"), nil
+		}
+		result.ConfigApplied = true
+		result.Aggregator = changes
+	}
+
+	return &api.CallToolResult{
+		Content: []interface{}{result},
+		IsError: failed,
+	}, nil
+}
+
+// importItem converts a definition of a manifest to a batch item that
+// creates it, or updates it if it exists. It reports skip for an existing
+// definition without overwrite.
+func importItem(definition interface{}, exists, overwrite bool) (api.BatchItem, bool, error) {
+	item := api.BatchItem{Operation: api.BatchOperationCreate}
+	if exists {
+		if !overwrite {
+			return item, true, nil
+		}
+		item.Operation = api.BatchOperationUpdate
+	}
+	if err := convertToStruct(definition, &item.Args); err != nil {
+		return item, false, err
+	}
+	return item, false, nil
+}
+
+// importFailed reports whether a batch of config_import had failed items.
+func importFailed(result *api.BatchResult) bool {
+	return result != nil && result.Failed > 0
+}
+
+// importConfig replaces and saves the main configuration, and applies its
+// aggregator settings to the running aggregator. The changes are nil if no
+// aggregator is registered.
+func (a *ConfigAdapter) importConfig(ctx context.Context, cfg *config.MusterConfig) (*api.AggregatorConfigChanges, error) {
+	a.mu.Lock()
+	a.config = cfg
+	err := a.saveConfig()
+	a.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	aggHandler := api.GetAggregator()
+	if aggHandler == nil {
+		return nil, nil
+	}
+	changes, err := aggHandler.ApplyConfig(ctx, cfg.Aggregator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply aggregator configuration: %w", err)
+	}
+	return changes, nil
+}
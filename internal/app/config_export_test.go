@@ -0,0 +1,170 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServerManager is an MCPServer manager holding its servers in memory.
+type fakeServerManager struct {
+	api.MCPServerManagerHandler
+	servers map[string]api.MCPServerInfo
+	applied []api.BatchItem
+}
+
+func (f *fakeServerManager) ListMCPServers() []api.MCPServerInfo {
+	var servers []api.MCPServerInfo
+	for _, s := range f.servers {
+		servers = append(servers, s)
+	}
+	return servers
+}
+
+func (f *fakeServerManager) GetMCPServer(name string) (*api.MCPServerInfo, error) {
+	s, ok := f.servers[name]
+	if !ok {
+		return nil, api.NewMCPServerNotFoundError(name)
+	}
+	return &s, nil
+}
+
+func (f *fakeServerManager) ValidateBatchItem(ctx context.Context, item api.BatchItem) error {
+	return nil
+}
+
+func (f *fakeServerManager) ApplyBatchItem(ctx context.Context, item api.BatchItem) (func(context.Context) error, error) {
+	f.applied = append(f.applied, item)
+	return func(context.Context) error { return nil }, nil
+}
+
+// fakeWorkflows is a workflow handler holding its workflows in memory.
+type fakeWorkflows struct {
+	api.WorkflowHandler
+	workflows map[string]api.Workflow
+	applied   []api.BatchItem
+}
+
+func (f *fakeWorkflows) GetWorkflows() []api.Workflow {
+	var workflows []api.Workflow
+	for _, wf := range f.workflows {
+		workflows = append(workflows, wf)
+	}
+	return workflows
+}
+
+func (f *fakeWorkflows) GetWorkflow(name string) (*api.Workflow, error) {
+	wf, ok := f.workflows[name]
+	if !ok {
+		return nil, api.NewWorkflowNotFoundError(name)
+	}
+	return &wf, nil
+}
+
+func (f *fakeWorkflows) ValidateBatchItem(ctx context.Context, item api.BatchItem) error {
+	return nil
+}
+
+func (f *fakeWorkflows) ApplyBatchItem(ctx context.Context, item api.BatchItem) (func(context.Context) error, error) {
+	f.applied = append(f.applied, item)
+	return func(context.Context) error { return nil }, nil
+}
+
+func registerFakes(t *testing.T, servers *fakeServerManager, workflows *fakeWorkflows) {
+	api.RegisterMCPServerManager(servers)
+	api.RegisterWorkflow(workflows)
+	t.Cleanup(func() {
+		api.RegisterMCPServerManager(nil)
+		api.RegisterWorkflow(nil)
+	})
+}
+
+func TestConfigExportImport(t *testing.T) {
+	ctx := context.Background()
+
+	// Export from the source environment
+	source := NewConfigAdapter(&config.MusterConfig{Namespace: "source"}, filepath.Join(t.TempDir(), "config.yaml"))
+	registerFakes(t, &fakeServerManager{servers: map[string]api.MCPServerInfo{
+		"git":    {Name: "git", Type: "stdio", Command: "mcp-git", AutoStart: true, State: "running"},
+		"github": {Name: "github", Type: "streamable-http", URL: "https://example.com/mcp", Timeout: 30},
+	}}, &fakeWorkflows{workflows: map[string]api.Workflow{
+		"deploy": {Name: "deploy", Description: "Deploy", Steps: []api.WorkflowStep{{ID: "apply", Tool: "x_apply"}}, Available: true},
+	}})
+
+	result, err := source.ExecuteTool(ctx, "config_export", nil)
+	require.NoError(t, err)
+	require.False(t, result.IsError, "%v", result.Content)
+	export := result.Content[0].(*api.ConfigExport)
+	assert.Equal(t, api.ConfigExportVersion, export.Version)
+	require.Len(t, export.MCPServers, 2)
+	assert.Equal(t, "git", export.MCPServers[0].Name)
+	assert.Equal(t, "github", export.MCPServers[1].Name)
+	require.Len(t, export.Workflows, 1)
+
+	// Clients send the manifest back as JSON
+	data, err := json.Marshal(export)
+	require.NoError(t, err)
+	var manifest map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &manifest))
+
+	// Import into a target environment that already has one of the servers
+	targetPath := filepath.Join(t.TempDir(), "config.yaml")
+	target := NewConfigAdapter(&config.MusterConfig{Namespace: "target"}, targetPath)
+	servers := &fakeServerManager{servers: map[string]api.MCPServerInfo{"git": {Name: "git", Type: "stdio", Command: "old"}}}
+	workflows := &fakeWorkflows{workflows: map[string]api.Workflow{}}
+	registerFakes(t, servers, workflows)
+
+	result, err = target.ExecuteTool(ctx, "config_import", map[string]interface{}{"manifest": manifest})
+	require.NoError(t, err)
+	require.False(t, result.IsError, "%v", result.Content)
+	imported := result.Content[0].(*api.ConfigImportResult)
+	assert.True(t, imported.ConfigApplied)
+	assert.Equal(t, []string{"mcpserver/git"}, imported.Skipped)
+
+	require.Len(t, servers.applied, 1)
+	assert.Equal(t, api.BatchOperationCreate, servers.applied[0].Operation)
+	assert.Equal(t, map[string]interface{}{"name": "github", "type": "streamable-http", "url": "https://example.com/mcp", "timeout": float64(30)}, servers.applied[0].Args)
+	require.Len(t, workflows.applied, 1)
+	assert.Equal(t, api.BatchOperationCreate, workflows.applied[0].Operation)
+	assert.Equal(t, "deploy", workflows.applied[0].Name())
+	assert.NotContains(t, workflows.applied[0].Args, "available")
+
+	cfg, err := target.GetConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "source", cfg.Namespace)
+	saved, err := os.ReadFile(targetPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(saved), "namespace: source")
+
+	// With overwrite, existing definitions are updated
+	servers.applied = nil
+	result, err = target.ExecuteTool(ctx, "config_import", map[string]interface{}{"manifest": manifest, "overwrite": true, "include_config": false})
+	require.NoError(t, err)
+	require.False(t, result.IsError, "%v", result.Content)
+	imported = result.Content[0].(*api.ConfigImportResult)
+	assert.False(t, imported.ConfigApplied)
+	assert.Empty(t, imported.Skipped)
+	require.Len(t, servers.applied, 2)
+	assert.Equal(t, api.BatchOperationUpdate, servers.applied[0].Operation)
+	assert.Equal(t, "mcp-git", servers.applied[0].Args["command"])
+}
+
+func TestConfigImportRejectsUnknownVersion(t *testing.T) {
+	adapter := NewConfigAdapter(&config.MusterConfig{}, filepath.Join(t.TempDir(), "config.yaml"))
+	registerFakes(t, &fakeServerManager{}, &fakeWorkflows{})
+
+	result, err := adapter.ExecuteTool(context.Background(), "config_import", map[string]interface{}{
+		"manifest": map[string]interface{}{"version": "v0"},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0], "unsupported manifest version")
+}
@@ -62,6 +62,11 @@
 //   - `config_update_global_settings`: Update global settings
 //   - `config_save`: Persist current configuration to disk
 //   - `config_reload`: Reload configuration from disk and trigger component reloads
+//   - `config_validate`: Validate the configuration directory against the shipped schemas
+//   - `config_history`: List recorded revisions of MCPServer and Workflow definitions
+//   - `config_rollback`: Restore a definition to a recorded revision
+//   - `config_export`: Export the configuration and all definitions as one manifest
+//   - `config_import`: Apply a manifest exported by another instance
 //
 // ## Service Management (services.go)
 //
@@ -6,6 +6,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/giantswarm/muster/internal/api"
 	serv "github.com/giantswarm/muster/internal/services"
 
 	"github.com/giantswarm/muster/pkg/logging"
@@ -35,18 +36,23 @@ import (
 // a critical error during operation.
 func runOrchestrator(ctx context.Context, services *Services) error {
 	logging.Info("CLI", "--- Setting up orchestrator for service management ---")
+	// Deferred so the database is also closed when startup fails, and only
+	// after the orchestrator stopped the workflows writing to it
+	defer services.closeExecutionStore()
 
 	aggregatorFailed := false
 	sigChan := make(chan os.Signal, 1)
-	changeChan := services.Orchestrator.SubscribeToStateChanges()
+	aggregatorFailures, unsubscribe := api.SubscribeEventsChan(api.EventFilter{
+		Kinds:   []api.EventKind{api.EventKindServiceState},
+		Types:   []string{string(serv.StateFailed)},
+		Sources: []string{"mcp-aggregator"},
+	}, 1)
+	defer unsubscribe()
 	go func() {
-		for change := range changeChan {
-			if change.Name == "mcp-aggregator" && serv.ServiceState(change.NewState) == serv.StateFailed {
-				logging.Info("CLI", "MCP Aggregator failed: %v", change)
-				aggregatorFailed = true
-				sigChan <- nil
-				break
-			}
+		if change, ok := <-aggregatorFailures; ok {
+			logging.Info("CLI", "MCP Aggregator failed: %v", change.Payload)
+			aggregatorFailed = true
+			sigChan <- nil
 		}
 	}()
 
@@ -91,6 +97,12 @@ func runOrchestrator(ctx context.Context, services *Services) error {
 		return err
 	}
 
+	// Resume the workflow executions a previous run left pending, once the
+	// MCP servers they call are starting. A standby waits until it takes over
+	if services.Workflows != nil {
+		go services.Workflows.ResumePendingExecutions(ctx)
+	}
+
 	logging.Info("CLI", "Services started. Press Ctrl+C to stop all services and exit.")
 
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
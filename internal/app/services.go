@@ -1,7 +1,11 @@
 package app
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"sync"
+	"time"
 
 	mcpserverPkg "github.com/giantswarm/muster/internal/mcpserver"
 	aggregatorService "github.com/giantswarm/muster/internal/services/aggregator"
@@ -9,6 +13,7 @@ import (
 	"github.com/giantswarm/muster/internal/aggregator"
 	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/internal/client"
+	"github.com/giantswarm/muster/internal/client/filesystem"
 	"github.com/giantswarm/muster/internal/config"
 	"github.com/giantswarm/muster/internal/events"
 	"github.com/giantswarm/muster/internal/metatools"
@@ -71,6 +76,23 @@ type Services struct {
 	// the reconciliation system. This enables status sync when services change
 	// state at runtime (e.g., crash, health check failure, restart).
 	StateChangeBridge *reconciler.StateChangeBridge
+
+	// ExecutionStore holds workflow executions outside Kubernetes mode. It is
+	// closed on shutdown if it is a database.
+	ExecutionStore config.EntityStorage
+
+	// Workflows runs workflow executions. The executions a previous run left
+	// pending are resumed once the orchestrator has started.
+	Workflows *workflow.Adapter
+}
+
+// closeExecutionStore closes the execution storage if it is a database.
+func (s *Services) closeExecutionStore() {
+	if closer, ok := s.ExecutionStore.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			logging.Error("CLI", err, "Error closing execution storage")
+		}
+	}
 }
 
 // InitializeServices creates and registers all required services for the application.
@@ -118,7 +140,7 @@ func InitializeServices(cfg *Config) (*Services, error) {
 
 	orchConfig := orchestrator.Config{
 		Aggregator: cfg.MusterConfig.Aggregator,
-		Yolo:       cfg.Yolo,
+		Yolo:       cfg.Yolo || cfg.MusterConfig.Aggregator.Yolo,
 	}
 
 	orch := orchestrator.New(orchConfig)
@@ -128,11 +150,14 @@ func InitializeServices(cfg *Config) (*Services, error) {
 
 	// Step 1: Create unified muster client once
 	// This avoids redundant Kubernetes connection attempts and CRD validation
-	musterClient, err := createMusterClientWithConfig(cfg.ConfigPath, cfg.Debug, *cfg.MusterConfig)
+	musterClient, err := createMusterClientWithConfig(cfg.ConfigPath, cfg.Profile, cfg.Debug, *cfg.MusterConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create muster client: %w", err)
 	}
 
+	// Cross-cutting behaviour of every handler tool call
+	registerHandlerMiddleware()
+
 	// Step 2: Create and register adapters using the muster client
 	// This is critical - APIs need handlers to be registered first
 
@@ -146,6 +171,8 @@ func InitializeServices(cfg *Config) (*Services, error) {
 
 	// Register configuration adapter
 	configAdapter := NewConfigAdapter(cfg.MusterConfig, "") // Empty path means auto-detect
+	configAdapter.SetConfigDir(cfg.ConfigPath)
+	configAdapter.SetProfile(cfg.Profile)
 	configAdapter.Register()
 
 	// Get namespace from config, defaulting to "default" if not specified
@@ -158,10 +185,45 @@ func InitializeServices(cfg *Config) (*Services, error) {
 	// is always enabled; it works in both Kubernetes (real Events) and
 	// filesystem (on-disk event log) modes.
 	eventAdapter := events.NewAdapter(musterClient, namespace)
+	sinks := make(map[string]events.Sink, len(cfg.MusterConfig.Events.Sinks))
+	for _, sinkConfig := range cfg.MusterConfig.Events.Sinks {
+		sink, filter, err := newEventSink(sinkConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event sink %s: %w", sinkConfig.Name, err)
+		}
+		eventAdapter.AddSink(sink, filter)
+		sinks[sinkConfig.Name] = sink
+	}
+	for _, routeConfig := range cfg.MusterConfig.Notifications.Routes {
+		route := events.NotificationRoute{
+			Name:    routeConfig.Name,
+			Events:  routeConfig.Events,
+			Sources: routeConfig.Sources,
+		}
+		for _, channelConfig := range routeConfig.Channels {
+			channel, err := newNotificationChannel(routeConfig.Name, channelConfig, sinks)
+			if err != nil {
+				return nil, fmt.Errorf("invalid notification route %s: %w", routeConfig.Name, err)
+			}
+			route.Channels = append(route.Channels, channel)
+		}
+		eventAdapter.AddNotificationRoute(route)
+	}
 	eventAdapter.Register()
 
+	// Outside Kubernetes mode, workflow executions go to the storage engine
+	// selected in config.yaml
+	var executionStore config.EntityStorage
+	if !cfg.MusterConfig.Kubernetes {
+		executionStore, err = config.NewEntityStorage(context.Background(), cfg.MusterConfig.Storage, cfg.ConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create execution storage: %w", err)
+		}
+	}
+
 	// Create and register Workflow adapter using the muster client
-	workflowAdapter := workflow.NewAdapterWithClient(musterClient, namespace, toolCaller, toolChecker, cfg.ConfigPath)
+	workflowAdapter := workflow.NewAdapterWithClient(musterClient, namespace, toolCaller, toolChecker, cfg.ConfigPath, executionStore)
+	workflowAdapter.SetExecutionLimits(cfg.MusterConfig.Workflows.Workers, cfg.MusterConfig.Workflows.MaxConcurrentPerWorkflow)
 	workflowAdapter.Register()
 
 	// Initialize and register MCPServer adapter using the muster client
@@ -187,68 +249,22 @@ func InitializeServices(cfg *Config) (*Services, error) {
 	// Need to get the service registry handler from the registry adapter
 	registryHandler := api.GetServiceRegistry()
 	if registryHandler != nil {
-		// Merge OAuth MCP client/proxy config: serve command flags override config
-		// file, but use config file as fallback. Start from the parsed config
-		// value so fields without a flag override flow through untouched; a
-		// field-by-field rebuild here silently dropped fields more than once.
-		mergedOAuthMCPClientConfig := cfg.MusterConfig.Aggregator.OAuth.MCPClient
-		mergedOAuthMCPClientConfig.Enabled = cfg.OAuthMCPClientEnabled || mergedOAuthMCPClientConfig.Enabled
-		if cfg.OAuthMCPClientPublicURL != "" {
-			mergedOAuthMCPClientConfig.PublicURL = cfg.OAuthMCPClientPublicURL
-		}
-		if cfg.OAuthMCPClientID != "" {
-			mergedOAuthMCPClientConfig.ClientID = cfg.OAuthMCPClientID
-		}
-		// Forwards --extra-ca-file for the token-exchange internal-deployment heuristic
-		mergedOAuthMCPClientConfig.ExtraCAFile = cfg.ExtraCAFile
-
-		// Convert config types
-		aggConfig := aggregator.AggregatorConfig{
-			Port:         cfg.MusterConfig.Aggregator.Port,
-			Host:         cfg.MusterConfig.Aggregator.Host,
-			Transport:    cfg.MusterConfig.Aggregator.Transport,
-			MusterPrefix: cfg.MusterConfig.Aggregator.MusterPrefix,
-			Version:      cfg.Version,
-			Yolo:         cfg.Yolo,
-			ConfigDir:    cfg.ConfigPath,
-			Debug:        cfg.Debug,
-			OAuth:        mergedOAuthMCPClientConfig,
-			OAuthServer: aggregator.OAuthServerConfig{
-				// serve command flag overrides config file if enabled
-				Enabled: cfg.OAuthServerEnabled || cfg.MusterConfig.Aggregator.OAuth.Server.Enabled,
-				Config:  mergeOAuthServerConfig(cfg),
-			},
-			Admin: aggregator.AdminConfig{
-				Enabled:     cfg.MusterConfig.Aggregator.Admin.Enabled,
-				Port:        cfg.MusterConfig.Aggregator.Admin.Port,
-				BindAddress: cfg.MusterConfig.Aggregator.Admin.BindAddress,
-			},
-		}
-
-		// Set defaults if not specified
-		if aggConfig.Port == 0 {
-			aggConfig.Port = 8090
-		}
-		if aggConfig.Host == "" {
-			aggConfig.Host = "localhost"
-		}
-		if aggConfig.Transport == "" {
-			aggConfig.Transport = config.MCPTransportStreamableHTTP
-		}
-		if aggConfig.Admin.Enabled {
-			if aggConfig.Admin.Port == 0 {
-				aggConfig.Admin.Port = 9999
-			}
-			if aggConfig.Admin.BindAddress == "" {
-				aggConfig.Admin.BindAddress = "127.0.0.1"
-			}
-		}
-
 		aggService := aggregatorService.NewAggregatorService(
-			aggConfig,
+			newAggregatorConfig(cfg, cfg.MusterConfig.Aggregator),
 			orchestratorAPI,
 			registryHandler,
 		)
+		// config_reload re-applies the aggregator section with the same
+		// command line overrides
+		aggService.SetConfigBuilder(func(agg config.AggregatorConfig) aggregator.AggregatorConfig {
+			return newAggregatorConfig(cfg, agg)
+		})
+		// A warm standby leaves the executions of the serving instance alone
+		// until it takes over
+		if cfg.MusterConfig.Aggregator.Standby.Enabled {
+			workflowAdapter.StandBy()
+			aggService.SetHandoverCallback(workflowAdapter.TakeOver)
+		}
 		_ = registry.Register(aggService)
 
 		// Create aggregator API adapter
@@ -275,6 +291,7 @@ func InitializeServices(cfg *Config) (*Services, error) {
 		reconcileConfig := reconciler.ManagerConfig{
 			Mode:           watchMode,
 			FilesystemPath: cfg.ConfigPath,
+			Profile:        cfg.Profile,
 			Namespace:      namespace,
 			WorkerCount:    2,
 			MaxRetries:     5,
@@ -334,12 +351,32 @@ func InitializeServices(cfg *Config) (*Services, error) {
 		AggregatorPort:    cfg.MusterConfig.Aggregator.Port,
 		ReconcileManager:  reconcileManager,
 		StateChangeBridge: stateChangeBridge,
+		ExecutionStore:    executionStore,
+		Workflows:         workflowAdapter,
 	}, nil
 }
 
+// handlerMiddlewareOnce keeps repeated initialization, e.g. in tests, from
+// stacking the handler middleware chain.
+var handlerMiddlewareOnce sync.Once
+
+// registerHandlerMiddleware registers the middleware applied to all handler
+// tool calls. Recovery is outermost so it also catches panics of the other
+// middleware.
+func registerHandlerMiddleware() {
+	handlerMiddlewareOnce.Do(func() {
+		api.RegisterHandlerMiddleware(
+			api.RecoveryMiddleware(),
+			api.AuthContextMiddleware(aggregator.CallerIdentity),
+			api.MetricsMiddleware(),
+			api.LoggingMiddleware(),
+		)
+	})
+}
+
 // createMusterClientWithConfig creates a muster client with full configuration context.
 // This avoids redundant Kubernetes connection attempts and CRD validation.
-func createMusterClientWithConfig(configPath string, debug bool, musterConfig config.MusterConfig) (client.MusterClient, error) {
+func createMusterClientWithConfig(configPath, profile string, debug bool, musterConfig config.MusterConfig) (client.MusterClient, error) {
 	if configPath == "" {
 		// No config path specified, use default client creation
 		return client.NewMusterClient()
@@ -356,9 +393,19 @@ func createMusterClientWithConfig(configPath string, debug bool, musterConfig co
 	// Otherwise, force filesystem mode for local development and tests.
 	clientConfig := &client.MusterClientConfig{
 		FilesystemPath:      configPath,
+		Profile:             profile,
 		Namespace:           namespace,
 		ForceFilesystemMode: !musterConfig.Kubernetes,
 		Debug:               debug,
+		CacheResources:      true,
+		EventRetention:      filesystem.EventRetention{MaxEvents: musterConfig.Events.MaxEvents},
+	}
+	if musterConfig.Events.MaxAge != "" {
+		maxAge, err := time.ParseDuration(musterConfig.Events.MaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("invalid events.maxAge %q: %w", musterConfig.Events.MaxAge, err)
+		}
+		clientConfig.EventRetention.MaxAge = maxAge
 	}
 
 	musterClient, err := client.NewMusterClientWithConfig(clientConfig)
@@ -369,10 +416,132 @@ func createMusterClientWithConfig(configPath string, debug bool, musterConfig co
 	return musterClient, nil
 }
 
+// newAggregatorConfig converts the aggregator section of config.yaml into the
+// aggregator configuration, applying the serve command flags and defaults.
+func newAggregatorConfig(cfg *Config, agg config.AggregatorConfig) aggregator.AggregatorConfig {
+	// Merge OAuth MCP client/proxy config: serve command flags override config
+	// file, but use config file as fallback. Start from the parsed config
+	// value so fields without a flag override flow through untouched; a
+	// field-by-field rebuild here silently dropped fields more than once.
+	mergedOAuthMCPClientConfig := agg.OAuth.MCPClient
+	mergedOAuthMCPClientConfig.Enabled = cfg.OAuthMCPClientEnabled || mergedOAuthMCPClientConfig.Enabled
+	if cfg.OAuthMCPClientPublicURL != "" {
+		mergedOAuthMCPClientConfig.PublicURL = cfg.OAuthMCPClientPublicURL
+	}
+	if cfg.OAuthMCPClientID != "" {
+		mergedOAuthMCPClientConfig.ClientID = cfg.OAuthMCPClientID
+	}
+	// Forwards --extra-ca-file for the token-exchange internal-deployment heuristic
+	mergedOAuthMCPClientConfig.ExtraCAFile = cfg.ExtraCAFile
+
+	// Convert config types
+	aggConfig := aggregator.AggregatorConfig{
+		Port:         agg.Port,
+		Host:         agg.Host,
+		Transport:    agg.Transport,
+		MusterPrefix: agg.MusterPrefix,
+		Version:      cfg.Version,
+		// A non-positive concurrency or zero timeout uses the default
+		ConnectConcurrency: agg.ConnectConcurrency,
+		// --yolo enables yolo mode regardless of the config file
+		Yolo: cfg.Yolo || agg.Yolo,
+		// --read-only enables read-only mode regardless of the config file
+		ReadOnly:  cfg.ReadOnly || agg.ReadOnly,
+		ConfigDir: cfg.ConfigPath,
+		Debug:     cfg.Debug,
+		OAuth:     mergedOAuthMCPClientConfig,
+		OAuthServer: aggregator.OAuthServerConfig{
+			// serve command flag overrides config file if enabled
+			Enabled: cfg.OAuthServerEnabled || agg.OAuth.Server.Enabled,
+			Config:  mergeOAuthServerConfig(cfg, agg.OAuth.Server),
+		},
+		Admin: aggregator.AdminConfig{
+			Enabled:     agg.Admin.Enabled,
+			Port:        agg.Admin.Port,
+			BindAddress: agg.Admin.BindAddress,
+		},
+		Gateway: aggregator.GatewayConfig{
+			Enabled:     agg.Gateway.Enabled,
+			Port:        agg.Gateway.Port,
+			BindAddress: agg.Gateway.BindAddress,
+		},
+		// A zero interval, timeout, or threshold uses the default
+		Liveness: aggregator.LivenessConfig{
+			Disabled:         agg.Liveness.Disabled,
+			FailureThreshold: agg.Liveness.FailureThreshold,
+		},
+		ReusePort: agg.ReusePort,
+		// An empty lock file is placed in the configuration directory
+		Standby: aggregator.StandbyConfig{
+			Enabled:  agg.Standby.Enabled,
+			LockFile: agg.Standby.LockFile,
+		},
+	}
+
+	// Set defaults if not specified
+	if aggConfig.Port == 0 {
+		aggConfig.Port = 8090
+	}
+	if aggConfig.Host == "" {
+		aggConfig.Host = "localhost"
+	}
+	if aggConfig.Transport == "" {
+		aggConfig.Transport = config.MCPTransportStreamableHTTP
+	}
+	if agg.ConnectTimeout != "" {
+		timeout, err := time.ParseDuration(agg.ConnectTimeout)
+		if err != nil || timeout <= 0 {
+			logging.Warn("Services", "Ignoring invalid aggregator.connectTimeout %q, using the default", agg.ConnectTimeout)
+		} else {
+			aggConfig.ConnectTimeout = timeout
+		}
+	}
+	if agg.Liveness.Interval != "" {
+		interval, err := time.ParseDuration(agg.Liveness.Interval)
+		if err != nil || interval <= 0 {
+			logging.Warn("Services", "Ignoring invalid aggregator.liveness.interval %q, using the default", agg.Liveness.Interval)
+		} else {
+			aggConfig.Liveness.Interval = interval
+		}
+	}
+	if agg.Liveness.Timeout != "" {
+		timeout, err := time.ParseDuration(agg.Liveness.Timeout)
+		if err != nil || timeout <= 0 {
+			logging.Warn("Services", "Ignoring invalid aggregator.liveness.timeout %q, using the default", agg.Liveness.Timeout)
+		} else {
+			aggConfig.Liveness.Timeout = timeout
+		}
+	}
+	if agg.Standby.PollInterval != "" {
+		interval, err := time.ParseDuration(agg.Standby.PollInterval)
+		if err != nil || interval <= 0 {
+			logging.Warn("Services", "Ignoring invalid aggregator.standby.pollInterval %q, using the default", agg.Standby.PollInterval)
+		} else {
+			aggConfig.Standby.PollInterval = interval
+		}
+	}
+	if aggConfig.Admin.Enabled {
+		if aggConfig.Admin.Port == 0 {
+			aggConfig.Admin.Port = 9999
+		}
+		if aggConfig.Admin.BindAddress == "" {
+			aggConfig.Admin.BindAddress = "127.0.0.1"
+		}
+	}
+	if aggConfig.Gateway.Enabled {
+		if aggConfig.Gateway.Port == 0 {
+			aggConfig.Gateway.Port = 9998
+		}
+		if aggConfig.Gateway.BindAddress == "" {
+			aggConfig.Gateway.BindAddress = "127.0.0.1"
+		}
+	}
+	return aggConfig
+}
+
 // mergeOAuthServerConfig merges OAuth server configuration from CLI flags and config file.
 // CLI flags override config file settings where specified.
-func mergeOAuthServerConfig(cfg *Config) config.OAuthServerConfig {
-	serverCfg := cfg.MusterConfig.Aggregator.OAuth.Server
+func mergeOAuthServerConfig(cfg *Config, serverCfg config.OAuthServerConfig) config.OAuthServerConfig {
 
 	// Override base URL from CLI if provided
 	if cfg.OAuthServerBaseURL != "" {
@@ -397,6 +566,55 @@ func mergeOAuthServerConfig(cfg *Config) config.OAuthServerConfig {
 	return serverCfg
 }
 
-// Note: Removed the individual adapter creation functions as they're now replaced by the unified muster client approach
+// newEventSink creates the event sink and filter configured by sinkConfig.
+// Without a filter, only Warning events are sent.
+func newEventSink(sinkConfig config.EventSinkConfig) (events.Sink, events.SinkFilter, error) {
+	filter := events.SinkFilter{Types: sinkConfig.Types, Reasons: sinkConfig.Reasons}
+	if len(filter.Types) == 0 && len(filter.Reasons) == 0 {
+		filter.Types = []string{string(events.EventTypeWarning)}
+	}
+
+	format := events.WebhookFormatJSON
+	switch sinkConfig.Type {
+	case config.EventSinkTypeNATS:
+		sink, err := events.NewNATSSink(sinkConfig.Name, sinkConfig.URL, sinkConfig.Subject)
+		if err != nil {
+			return nil, filter, err
+		}
+		return sink, filter, nil
+	case config.EventSinkTypeSlack:
+		format = events.WebhookFormatSlack
+	case config.EventSinkTypeCloudEvents:
+		format = events.WebhookFormatCloudEvents
+	}
+	return events.NewWebhookSink(sinkConfig.Name, sinkConfig.URL, format, sinkConfig.Headers), filter, nil
+}
+
+// newNotificationChannel creates the channel of a notification route. The
+// "sink" channel sends to the sink of the events.sinks entry it names.
+func newNotificationChannel(route string, channelConfig config.NotificationChannelConfig, sinks map[string]events.Sink) (events.Sink, error) {
+	switch channelConfig.Type {
+	case config.NotificationChannelLog:
+		return events.NewLogSink(channelConfig.Level), nil
+	case config.NotificationChannelMCP:
+		return events.NewSessionSink(channelConfig.Level), nil
+	case config.NotificationChannelSink:
+		sink, ok := sinks[channelConfig.Sink]
+		if !ok {
+			return nil, fmt.Errorf("event sink %s is not defined", channelConfig.Sink)
+		}
+		return sink, nil
+	case config.NotificationChannelWebhook:
+		format := channelConfig.Format
+		if format == "" {
+			format = events.WebhookFormatJSON
+		}
+		return events.NewWebhookSink(route, channelConfig.URL, format, channelConfig.Headers), nil
+	default:
+		return nil, fmt.Errorf("unsupported channel type %q", channelConfig.Type)
+	}
+}
 
 // Note: MCPServer service creation moved to orchestrator for proper dependency management
+
+// Note: Removed the individual adapter creation functions as they're now replaced by the unified muster client approach
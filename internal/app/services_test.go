@@ -1,9 +1,12 @@
 package app
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/giantswarm/muster/internal/config"
 )
@@ -138,3 +141,16 @@ func TestServices_Creation(t *testing.T) {
 	assert.NotNil(t, services.Orchestrator)
 	assert.NotNil(t, services.OrchestratorAPI)
 }
+
+func TestServices_CloseExecutionStore(t *testing.T) {
+	store, err := config.NewSQLStorage(context.Background(), config.StorageTypeSQLite, filepath.Join(t.TempDir(), "muster.db"))
+	require.NoError(t, err)
+
+	(&Services{ExecutionStore: store}).closeExecutionStore()
+	_, err = store.List("executions")
+	assert.Error(t, err, "the database is closed")
+
+	// Storage without a database has nothing to close
+	(&Services{ExecutionStore: config.NewStorageWithPath(t.TempDir())}).closeExecutionStore()
+	(&Services{}).closeExecutionStore()
+}
@@ -51,6 +51,10 @@ type AuthAdapter struct {
 	// tokenStorageDir is the directory for storing tokens.
 	tokenStorageDir string
 
+	// clientID and idp are the OAuth client ID and identity provider to log in with.
+	clientID string
+	idp      string
+
 	// noSilentRefresh disables silent re-authentication attempts.
 	// When true, Login() always uses interactive authentication.
 	noSilentRefresh bool
@@ -62,6 +66,13 @@ type AuthAdapterConfig struct {
 	// If empty, defaults to ~/.config/muster/tokens
 	TokenStorageDir string
 
+	// ClientID is the OAuth client ID to log in with.
+	// If empty, defaults to the muster agent's client ID.
+	ClientID string
+
+	// IdP is the identity provider to log in with, if the IdP supports selecting one.
+	IdP string
+
 	// NoSilentRefresh disables silent re-authentication attempts.
 	// When true, Login() always uses interactive authentication.
 	NoSilentRefresh bool
@@ -75,27 +86,83 @@ func NewAuthAdapter() (*AuthAdapter, error) {
 // NewAuthAdapterWithConfig creates a new auth adapter with the specified configuration.
 // This is useful for testing or advanced use cases where custom token storage is needed.
 func NewAuthAdapterWithConfig(cfg AuthAdapterConfig) (*AuthAdapter, error) {
-	tokenDir := cfg.TokenStorageDir
+	tokenDir, err := prepareTokenDir(cfg.TokenStorageDir)
+	if err != nil {
+		return nil, err
+	}
+
+	adapter := &AuthAdapter{
+		managers:        make(map[string]*oauth.AuthManager),
+		tokenStorageDir: tokenDir,
+		clientID:        cfg.ClientID,
+		idp:             cfg.IdP,
+		noSilentRefresh: cfg.NoSilentRefresh,
+	}
+
+	return adapter, nil
+}
+
+// prepareTokenDir returns tokenDir, or the default token directory if it is
+// empty, after creating it.
+func prepareTokenDir(tokenDir string) (string, error) {
 	if tokenDir == "" {
 		var err error
 		tokenDir, err = pkgoauth.DefaultTokenDir()
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 	}
 
 	// Ensure the token directory exists
 	if err := os.MkdirAll(tokenDir, 0700); err != nil {
-		return nil, fmt.Errorf("failed to create token storage directory: %w", err)
+		return "", fmt.Errorf("failed to create token storage directory: %w", err)
 	}
+	return tokenDir, nil
+}
 
-	adapter := &AuthAdapter{
-		managers:        make(map[string]*oauth.AuthManager),
-		tokenStorageDir: tokenDir,
-		noSilentRefresh: cfg.NoSilentRefresh,
+// AuthAdapterConfigForProfile returns the adapter configuration that
+// authenticates with the given auth profile.
+func AuthAdapterConfigForProfile(profile oauth.AuthProfile, noSilentRefresh bool) AuthAdapterConfig {
+	return AuthAdapterConfig{
+		TokenStorageDir: profile.TokenStorageDir,
+		ClientID:        profile.ClientID,
+		IdP:             profile.IdP,
+		NoSilentRefresh: noSilentRefresh,
 	}
+}
 
-	return adapter, nil
+// SetAuthProfile switches the adapter to the token store and OAuth client of
+// profile, as when the REPL switches to a context with its own credentials.
+// Cached auth managers of the previous profile are closed.
+func (a *AuthAdapter) SetAuthProfile(profile oauth.AuthProfile) error {
+	tokenDir, err := prepareTokenDir(profile.TokenStorageDir)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.tokenStorageDir == tokenDir && a.clientID == profile.ClientID && a.idp == profile.IdP {
+		return nil
+	}
+	for endpoint, mgr := range a.managers {
+		if err := mgr.Close(); err != nil {
+			logging.Debug("AuthAdapter", "Error closing manager for %s: %v", endpoint, err)
+		}
+	}
+	a.managers = make(map[string]*oauth.AuthManager)
+	a.tokenStorageDir = tokenDir
+	a.clientID = profile.ClientID
+	a.idp = profile.IdP
+	return nil
+}
+
+// storageDir returns the directory tokens are stored in.
+func (a *AuthAdapter) storageDir() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.tokenStorageDir
 }
 
 // SetNoSilentRefresh enables or disables silent re-authentication.
@@ -137,6 +204,8 @@ func (a *AuthAdapter) getOrCreateManager(endpoint string) (*oauth.AuthManager, e
 		CallbackPort:    getCallbackPort(),
 		TokenStorageDir: a.tokenStorageDir,
 		FileMode:        true,
+		ClientID:        a.clientID,
+		IdP:             a.idp,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create auth manager: %w", err)
@@ -373,7 +442,7 @@ func (a *AuthAdapter) Logout(endpoint string) error {
 
 	// Read the stored refresh token before any cleanup so we can revoke it.
 	store, err := oauth.NewTokenStore(oauth.TokenStoreConfig{
-		StorageDir: a.tokenStorageDir,
+		StorageDir: a.storageDir(),
 		FileMode:   true,
 	})
 	if err != nil {
@@ -410,7 +479,7 @@ func (a *AuthAdapter) Logout(endpoint string) error {
 func (a *AuthAdapter) LogoutAll() error {
 	// Create a token store to read refresh tokens before cleanup.
 	store, err := oauth.NewTokenStore(oauth.TokenStoreConfig{
-		StorageDir: a.tokenStorageDir,
+		StorageDir: a.storageDir(),
 		FileMode:   true,
 	})
 	if err != nil {
@@ -687,7 +756,8 @@ type tokenFileInfo struct {
 
 // listTokenFiles scans the token directory for stored tokens.
 func (a *AuthAdapter) listTokenFiles() ([]tokenFileInfo, error) {
-	entries, err := os.ReadDir(a.tokenStorageDir)
+	tokenDir := a.storageDir()
+	entries, err := os.ReadDir(tokenDir)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -702,7 +772,7 @@ func (a *AuthAdapter) listTokenFiles() ([]tokenFileInfo, error) {
 		}
 
 		// Read token file to get server URL
-		filePath := filepath.Join(a.tokenStorageDir, entry.Name())
+		filePath := filepath.Join(tokenDir, entry.Name())
 		token, err := readTokenFile(filePath)
 		if err != nil {
 			continue
@@ -1096,3 +1096,34 @@ func TestGetStatusFromManager_RefreshExpiresAt(t *testing.T) {
 		}
 	})
 }
+
+func TestAuthAdapter_SetAuthProfile(t *testing.T) {
+	adapter, err := NewAuthAdapterWithConfig(AuthAdapterConfig{TokenStorageDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+	defer func() { _ = adapter.Close() }()
+
+	if _, err := adapter.getOrCreateManager("https://dev.example.com/mcp"); err != nil {
+		t.Fatalf("failed to create manager: %v", err)
+	}
+
+	prodDir := filepath.Join(t.TempDir(), "prod")
+	profile := oauth.AuthProfile{TokenStorageDir: prodDir, ClientID: "muster-prod", IdP: "github"}
+	if err := adapter.SetAuthProfile(profile); err != nil {
+		t.Fatalf("SetAuthProfile failed: %v", err)
+	}
+
+	if adapter.storageDir() != prodDir {
+		t.Errorf("expected token dir %q, got %q", prodDir, adapter.storageDir())
+	}
+	if adapter.clientID != "muster-prod" || adapter.idp != "github" {
+		t.Errorf("expected client muster-prod/github, got %q/%q", adapter.clientID, adapter.idp)
+	}
+	if len(adapter.managers) != 0 {
+		t.Errorf("expected managers of the previous profile to be dropped, got %d", len(adapter.managers))
+	}
+	if _, err := os.Stat(prodDir); err != nil {
+		t.Errorf("expected token dir to be created: %v", err)
+	}
+}
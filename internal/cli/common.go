@@ -92,13 +92,13 @@ func CheckServerRunning(endpoint string) error {
 	// Test the MCP endpoint directly with a GET request
 	resp, err := client.Get(endpoint)
 	if err != nil {
-		return fmt.Errorf("muster server is not running. Start it with: muster serve")
+		return &ServerUnavailableError{Endpoint: endpoint, Reason: err}
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// For streamable-http MCP, a GET request should return 202 Accepted or similar
 	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("muster server is not responding correctly (status: %d). Try restarting with: muster serve", resp.StatusCode)
+		return &ServerUnavailableError{Endpoint: endpoint, StatusCode: resp.StatusCode}
 	}
 
 	return nil
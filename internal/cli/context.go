@@ -3,6 +3,7 @@ package cli
 import (
 	"os"
 
+	agentoauth "github.com/giantswarm/muster/internal/agent/oauth"
 	musterctx "github.com/giantswarm/muster/internal/context"
 )
 
@@ -24,52 +25,92 @@ func ResolveEndpoint(explicitEndpoint, contextName string) (string, error) {
 		return explicitEndpoint, nil
 	}
 
-	// 2. Check for --context flag
+	ctx, err := ResolveContext(explicitEndpoint, contextName)
+	if err != nil {
+		return "", err
+	}
+	if ctx != nil {
+		return ctx.Endpoint, nil
+	}
+
+	// 5. No context configured - return empty string for config-based fallback
+	return "", nil
+}
+
+// ResolveContext resolves the context in use with the same precedence as
+// ResolveEndpoint. It returns nil if an explicit endpoint is given or no
+// context is configured.
+func ResolveContext(explicitEndpoint, contextName string) (*musterctx.Context, error) {
+	if explicitEndpoint != "" {
+		return nil, nil
+	}
+
+	// Check for --context flag
 	if contextName != "" {
-		return getEndpointFromContext(contextName)
+		return getContext(contextName)
 	}
 
-	// 3. Check for MUSTER_CONTEXT environment variable
+	// Check for MUSTER_CONTEXT environment variable
 	if envContext := os.Getenv(ContextEnvVar); envContext != "" {
-		return getEndpointFromContext(envContext)
+		return getContext(envContext)
 	}
 
-	// 4. Check for current-context in contexts.yaml
+	// Check for current-context in contexts.yaml
 	storage, err := musterctx.NewStorage()
 	if err != nil {
 		// Storage initialization failed - fall back to config-based resolution
-		return "", nil
+		return nil, nil
 	}
 
 	ctx, err := storage.GetCurrentContext()
 	if err != nil {
 		// Failed to get current context - fall back to config-based resolution
-		return "", nil
+		return nil, nil
 	}
+	return ctx, nil
+}
 
-	if ctx != nil {
-		return ctx.Endpoint, nil
+// ResolveAuthProfile returns the auth profile of the context resolved by
+// ResolveContext, so each context authenticates with its own token store.
+// An explicit endpoint, or no configured context, uses the default profile.
+func ResolveAuthProfile(explicitEndpoint, contextName string) (agentoauth.AuthProfile, error) {
+	ctx, err := ResolveContext(explicitEndpoint, contextName)
+	if err != nil {
+		return agentoauth.AuthProfile{}, err
 	}
+	return agentoauth.ProfileForContext(ctx), nil
+}
 
-	// 5. No context configured - return empty string for config-based fallback
-	return "", nil
+// ResolveNamespace returns the default Kubernetes namespace of the tools that
+// take a namespace argument: the explicit namespace (--namespace/-n), else the
+// namespace of the context resolved as by ResolveContext. An empty result
+// means the server's namespace.
+func ResolveNamespace(explicitNamespace, explicitEndpoint, contextName string) (string, error) {
+	if explicitNamespace != "" {
+		return explicitNamespace, nil
+	}
+	ctx, err := ResolveContext(explicitEndpoint, contextName)
+	if err != nil || ctx == nil {
+		return "", err
+	}
+	return ctx.Namespace, nil
 }
 
-// getEndpointFromContext retrieves the endpoint for a named context.
-func getEndpointFromContext(contextName string) (string, error) {
+// getContext retrieves a named context.
+func getContext(contextName string) (*musterctx.Context, error) {
 	storage, err := musterctx.NewStorage()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	ctx, err := storage.GetContext(contextName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if ctx == nil {
-		return "", &musterctx.ContextNotFoundError{Name: contextName}
+		return nil, &musterctx.ContextNotFoundError{Name: contextName}
 	}
 
-	return ctx.Endpoint, nil
+	return ctx, nil
 }
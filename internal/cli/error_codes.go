@@ -0,0 +1,281 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// ErrorCode is a stable, machine-readable identifier for the cause of a CLI
+// failure. Values are part of the CLI contract: scripts branch on them via the
+// JSON error envelope or the corresponding process exit code, so existing
+// values must never be renamed or renumbered.
+type ErrorCode string
+
+const (
+	// ErrorCodeGeneral is any failure not covered by a more specific code.
+	ErrorCodeGeneral ErrorCode = "error"
+	// ErrorCodeAuthRequired means the endpoint needs (re-)authentication.
+	ErrorCodeAuthRequired ErrorCode = "auth_required"
+	// ErrorCodeAuthFailed means an OAuth flow was attempted and failed.
+	ErrorCodeAuthFailed ErrorCode = "auth_failed"
+	// ErrorCodeConnection means the aggregator could not be reached.
+	ErrorCodeConnection ErrorCode = "connection_failed"
+	// ErrorCodeValidation means the command input or configuration is invalid.
+	ErrorCodeValidation ErrorCode = "validation_failed"
+	// ErrorCodeNotFound means the requested resource does not exist.
+	ErrorCodeNotFound ErrorCode = "not_found"
+	// ErrorCodeToolError means the tool ran and reported an error result.
+	ErrorCodeToolError ErrorCode = "tool_error"
+	// ErrorCodeConflict means the request conflicts with the current state,
+	// e.g. the resource to create already exists.
+	ErrorCodeConflict ErrorCode = "conflict"
+	// ErrorCodeUnavailable means a component or backend muster needs for the
+	// request is not available.
+	ErrorCodeUnavailable ErrorCode = "unavailable"
+)
+
+// Exit codes for CLI commands, one per ErrorCode. 0-3 predate the taxonomy
+// and keep their original meaning.
+const (
+	ExitCodeSuccess      = 0
+	ExitCodeError        = 1
+	ExitCodeAuthRequired = 2
+	ExitCodeAuthFailed   = 3
+	ExitCodeConnection   = 4
+	ExitCodeValidation   = 5
+	ExitCodeNotFound     = 6
+	ExitCodeToolError    = 7
+	ExitCodeConflict     = 8
+	ExitCodeUnavailable  = 9
+)
+
+// ExitCode returns the process exit code associated with the error code.
+func (c ErrorCode) ExitCode() int {
+	switch c {
+	case ErrorCodeAuthRequired:
+		return ExitCodeAuthRequired
+	case ErrorCodeAuthFailed:
+		return ExitCodeAuthFailed
+	case ErrorCodeConnection:
+		return ExitCodeConnection
+	case ErrorCodeValidation:
+		return ExitCodeValidation
+	case ErrorCodeNotFound:
+		return ExitCodeNotFound
+	case ErrorCodeToolError:
+		return ExitCodeToolError
+	case ErrorCodeConflict:
+		return ExitCodeConflict
+	case ErrorCodeUnavailable:
+		return ExitCodeUnavailable
+	default:
+		return ExitCodeError
+	}
+}
+
+// ServerUnavailableError indicates that the local aggregator did not answer
+// the liveness probe performed before connecting.
+type ServerUnavailableError struct {
+	// Endpoint is the URL that was probed.
+	Endpoint string
+	// StatusCode is the unexpected HTTP status, or 0 if the request failed.
+	StatusCode int
+	// Reason is the underlying transport error, if any.
+	Reason error
+}
+
+// Error returns a user-friendly error message with actionable guidance.
+func (e *ServerUnavailableError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("muster server is not responding correctly (status: %d). Try restarting with: muster serve", e.StatusCode)
+	}
+	return "muster server is not running. Start it with: muster serve"
+}
+
+// Unwrap returns the underlying error.
+func (e *ServerUnavailableError) Unwrap() error {
+	return e.Reason
+}
+
+// Error makes ConnectionError usable as an error value.
+func (e *ConnectionError) Error() string {
+	return fmt.Sprintf("%s connecting to %s: %v", e.Type, e.Endpoint, e.Reason)
+}
+
+// Unwrap returns the underlying error.
+func (e *ConnectionError) Unwrap() error {
+	return e.Reason
+}
+
+// ValidationError indicates invalid command input (arguments, flags, or
+// configuration) detected before anything was sent to the aggregator.
+type ValidationError struct {
+	// Message describes what is invalid.
+	Message string
+	// Details optionally carries structured findings (e.g. a validation
+	// report) that are included in the JSON error envelope.
+	Details interface{}
+}
+
+// NewValidationError formats a ValidationError.
+func NewValidationError(format string, args ...interface{}) *ValidationError {
+	return &ValidationError{Message: fmt.Sprintf(format, args...)}
+}
+
+// Error returns the validation message.
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// ToolError indicates that a tool call completed but returned an error result.
+type ToolError struct {
+	// Tool is the name of the tool that failed.
+	Tool string
+	// Message is the text content of the error result.
+	Message string
+	// APICode is the error code muster returned in the structured content of
+	// the result, if any.
+	APICode api.ErrorCode
+}
+
+// Error returns the tool's error text unchanged.
+func (e *ToolError) Error() string {
+	return e.Message
+}
+
+// notFoundMarkers are substrings of the messages of error results without
+// an error code, such as those of backend MCP servers, that report a missing
+// resource.
+var notFoundMarkers = []string{"not found", "does not exist", "no such"}
+
+// apiErrorCodes maps the error codes of muster's handlers to CLI error codes.
+var apiErrorCodes = map[api.ErrorCode]ErrorCode{
+	api.ErrorCodeNotFound:         ErrorCodeNotFound,
+	api.ErrorCodeConflict:         ErrorCodeConflict,
+	api.ErrorCodeValidationFailed: ErrorCodeValidation,
+	api.ErrorCodeUnavailable:      ErrorCodeUnavailable,
+	api.ErrorCodeUnauthorized:     ErrorCodeAuthRequired,
+	api.ErrorCodeInternal:         ErrorCodeToolError,
+}
+
+// Code classifies the tool error by the error code muster returned. Only
+// results without a code, such as those of backend MCP servers, are
+// classified by their message, as a last resort; a code the CLI does not
+// know is a tool error.
+func (e *ToolError) Code() ErrorCode {
+	if e.APICode != "" {
+		if code, ok := apiErrorCodes[e.APICode]; ok {
+			return code
+		}
+		return ErrorCodeToolError
+	}
+	msg := strings.ToLower(e.Message)
+	for _, marker := range notFoundMarkers {
+		if strings.Contains(msg, marker) {
+			return ErrorCodeNotFound
+		}
+	}
+	return ErrorCodeToolError
+}
+
+// ClassifyError maps an error returned by a command to its ErrorCode.
+func ClassifyError(err error) ErrorCode {
+	if err == nil {
+		return ""
+	}
+
+	var authRequired *AuthRequiredError
+	var authExpired *AuthExpiredError
+	var authFailed *AuthFailedError
+	var unavailable *ServerUnavailableError
+	var connErr *ConnectionError
+	var validation *ValidationError
+	var toolErr *ToolError
+
+	switch {
+	case errors.As(err, &authRequired), errors.As(err, &authExpired):
+		return ErrorCodeAuthRequired
+	case errors.As(err, &authFailed):
+		return ErrorCodeAuthFailed
+	case errors.As(err, &unavailable), errors.As(err, &connErr):
+		return ErrorCodeConnection
+	case errors.As(err, &validation):
+		return ErrorCodeValidation
+	case errors.As(err, &toolErr):
+		return toolErr.Code()
+	default:
+		return ErrorCodeGeneral
+	}
+}
+
+// ErrorEnvelope is the JSON document written for a failed command when
+// `--output json` is in effect.
+type ErrorEnvelope struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// ErrorDetail carries the classified failure.
+type ErrorDetail struct {
+	Code     ErrorCode   `json:"code"`
+	ExitCode int         `json:"exitCode"`
+	Message  string      `json:"message"`
+	Endpoint string      `json:"endpoint,omitempty"`
+	Tool     string      `json:"tool,omitempty"`
+	Details  interface{} `json:"details,omitempty"`
+}
+
+// NewErrorEnvelope builds the JSON envelope for err.
+func NewErrorEnvelope(err error) ErrorEnvelope {
+	code := ClassifyError(err)
+	detail := ErrorDetail{
+		Code:     code,
+		ExitCode: code.ExitCode(),
+		Message:  err.Error(),
+		Endpoint: errorEndpoint(err),
+	}
+	var toolErr *ToolError
+	if errors.As(err, &toolErr) {
+		detail.Tool = toolErr.Tool
+	}
+	var validation *ValidationError
+	if errors.As(err, &validation) {
+		detail.Details = validation.Details
+	}
+	return ErrorEnvelope{Error: detail}
+}
+
+// WriteErrorEnvelope writes the JSON envelope for err to w.
+func WriteErrorEnvelope(w io.Writer, err error) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(NewErrorEnvelope(err))
+}
+
+// errorEndpoint extracts the endpoint from endpoint-scoped error types.
+func errorEndpoint(err error) string {
+	var authRequired *AuthRequiredError
+	var authExpired *AuthExpiredError
+	var authFailed *AuthFailedError
+	var unavailable *ServerUnavailableError
+	var connErr *ConnectionError
+
+	switch {
+	case errors.As(err, &authRequired):
+		return authRequired.Endpoint
+	case errors.As(err, &authExpired):
+		return authExpired.Endpoint
+	case errors.As(err, &authFailed):
+		return authFailed.Endpoint
+	case errors.As(err, &unavailable):
+		return unavailable.Endpoint
+	case errors.As(err, &connErr):
+		return connErr.Endpoint
+	default:
+		return ""
+	}
+}
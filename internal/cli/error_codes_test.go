@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		code     ErrorCode
+		exitCode int
+	}{
+		{"general", errors.New("boom"), ErrorCodeGeneral, ExitCodeError},
+		{"auth required", &AuthRequiredError{Endpoint: "https://x"}, ErrorCodeAuthRequired, ExitCodeAuthRequired},
+		{"auth expired", &AuthExpiredError{Endpoint: "https://x"}, ErrorCodeAuthRequired, ExitCodeAuthRequired},
+		{"auth failed", &AuthFailedError{Endpoint: "https://x", Reason: errors.New("denied")}, ErrorCodeAuthFailed, ExitCodeAuthFailed},
+		{"server unavailable", &ServerUnavailableError{Endpoint: "http://localhost:8090/mcp"}, ErrorCodeConnection, ExitCodeConnection},
+		{"connection", ClassifyConnectionError(errors.New("connection refused"), "https://x"), ErrorCodeConnection, ExitCodeConnection},
+		{"validation", NewValidationError("bad flag"), ErrorCodeValidation, ExitCodeValidation},
+		{"tool not found", &ToolError{Tool: "core_mcpserver_get", Message: "MCP server 'x' not found", APICode: api.ErrorCodeNotFound}, ErrorCodeNotFound, ExitCodeNotFound},
+		{"tool error", &ToolError{Tool: "core_mcpserver_get", Message: "permission denied"}, ErrorCodeToolError, ExitCodeToolError},
+		{"tool conflict", &ToolError{Tool: "core_workflow_create", Message: "workflow w already exists", APICode: api.ErrorCodeConflict}, ErrorCodeConflict, ExitCodeConflict},
+		{"tool unavailable", &ToolError{Tool: "core_workflow_list", Message: "workflow handler not available", APICode: api.ErrorCodeUnavailable}, ErrorCodeUnavailable, ExitCodeUnavailable},
+		{"tool validation", &ToolError{Tool: "core_service_start", Message: "name is required", APICode: api.ErrorCodeValidationFailed}, ErrorCodeValidation, ExitCodeValidation},
+		{"tool unauthorized", &ToolError{Tool: "core_service_start", Message: "requires OAuth", APICode: api.ErrorCodeUnauthorized}, ErrorCodeAuthRequired, ExitCodeAuthRequired},
+		{"tool code wins over message", &ToolError{Tool: "core_config_get", Message: "file not found", APICode: api.ErrorCodeInternal}, ErrorCodeToolError, ExitCodeToolError},
+		{"tool not found code", &ToolError{Tool: "core_workflow_get", Message: "workflow 'w' is missing", APICode: api.ErrorCodeNotFound}, ErrorCodeNotFound, ExitCodeNotFound},
+		{"tool unknown code ignores message", &ToolError{Tool: "core_workflow_get", Message: "no such file", APICode: "rate_limited"}, ErrorCodeToolError, ExitCodeToolError},
+		{"backend tool without code", &ToolError{Tool: "x_kubernetes_get", Message: "pods \"web\" does not exist"}, ErrorCodeNotFound, ExitCodeNotFound},
+		{"wrapped", fmt.Errorf("context: %w", &AuthRequiredError{Endpoint: "https://x"}), ErrorCodeAuthRequired, ExitCodeAuthRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := ClassifyError(tt.err)
+			assert.Equal(t, tt.code, code)
+			assert.Equal(t, tt.exitCode, code.ExitCode())
+		})
+	}
+}
+
+func TestServerUnavailableErrorMessage(t *testing.T) {
+	assert.Equal(t, "muster server is not running. Start it with: muster serve",
+		(&ServerUnavailableError{}).Error())
+	assert.Contains(t, (&ServerUnavailableError{StatusCode: 500}).Error(), "status: 500")
+}
+
+func TestWriteErrorEnvelope(t *testing.T) {
+	var buf bytes.Buffer
+	err := fmt.Errorf("wrapped: %w", &ToolError{Tool: "core_workflow_get", Message: "workflow 'w' not found", APICode: api.ErrorCodeNotFound})
+	require.NoError(t, WriteErrorEnvelope(&buf, err))
+
+	var env ErrorEnvelope
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &env))
+	assert.Equal(t, ErrorCodeNotFound, env.Error.Code)
+	assert.Equal(t, ExitCodeNotFound, env.Error.ExitCode)
+	assert.Equal(t, "core_workflow_get", env.Error.Tool)
+	assert.Equal(t, "wrapped: workflow 'w' not found", env.Error.Message)
+
+	buf.Reset()
+	require.NoError(t, WriteErrorEnvelope(&buf, &AuthRequiredError{Endpoint: "https://muster.example.com/mcp"}))
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &env))
+	assert.Equal(t, "https://muster.example.com/mcp", env.Error.Endpoint)
+}
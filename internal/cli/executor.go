@@ -16,6 +16,7 @@ import (
 	"github.com/giantswarm/muster/internal/config"
 	"github.com/giantswarm/muster/internal/metatools"
 	pkgoauth "github.com/giantswarm/muster/pkg/oauth"
+	"github.com/giantswarm/muster/pkg/project"
 
 	"github.com/briandowns/spinner"
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -65,7 +66,7 @@ func ValidateOutputFormat(format string) error {
 	case OutputFormatTable, OutputFormatWide, OutputFormatJSON, OutputFormatYAML:
 		return nil
 	default:
-		return fmt.Errorf("unsupported output format: %q (valid: table, wide, json, yaml)", format)
+		return NewValidationError("unsupported output format: %q (valid: table, wide, json, yaml)", format)
 	}
 }
 
@@ -98,7 +99,7 @@ func ParseAuthMode(s string) (AuthMode, error) {
 	case "none":
 		return AuthModeNone, nil
 	default:
-		return AuthModeAuto, fmt.Errorf("invalid auth mode %q: must be one of 'auto', 'prompt', or 'none'", s)
+		return AuthModeAuto, NewValidationError("invalid auth mode %q: must be one of 'auto', 'prompt', or 'none'", s)
 	}
 }
 
@@ -149,6 +150,8 @@ type ExecutorOptions struct {
 	Endpoint string
 	// Context specifies a named context to use for endpoint resolution
 	Context string
+	// Namespace overrides the default Kubernetes namespace of the context
+	Namespace string
 	// AuthMode controls authentication behavior (auto, prompt, none)
 	AuthMode AuthMode
 	// ContinuousListening, when true, makes the streamable-http client open a
@@ -173,6 +176,8 @@ type ToolExecutor struct {
 	endpoint string
 	// isRemote indicates if this is a remote (non-localhost) connection
 	isRemote bool
+	// authProfile selects the token store of the resolved context
+	authProfile agentoauth.AuthProfile
 	// notifyMu guards notifyHandler.
 	notifyMu sync.Mutex
 	// notifyHandler, when set, receives every MCP notification from the server
@@ -224,6 +229,14 @@ func NewToolExecutor(options ExecutorOptions) (*ToolExecutor, error) {
 	if err != nil {
 		return nil, err
 	}
+	authProfile, err := ResolveAuthProfile(options.Endpoint, options.Context)
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := ResolveNamespace(options.Namespace, options.Endpoint, options.Context)
+	if err != nil {
+		return nil, err
+	}
 
 	if resolvedEndpoint != "" {
 		endpoint = resolvedEndpoint
@@ -269,13 +282,15 @@ func NewToolExecutor(options ExecutorOptions) (*ToolExecutor, error) {
 	if options.ContinuousListening {
 		client.SetContinuousListening(true)
 	}
+	client.SetDefaultNamespace(namespace)
 
 	executor := &ToolExecutor{
-		client:    client,
-		options:   options,
-		formatter: NewTableFormatter(options),
-		endpoint:  endpoint,
-		isRemote:  isRemote,
+		client:      client,
+		options:     options,
+		formatter:   NewTableFormatter(options),
+		endpoint:    endpoint,
+		isRemote:    isRemote,
+		authProfile: authProfile,
 	}
 
 	// Pump MCP notifications: forward to a registered handler (e.g. for
@@ -337,6 +352,7 @@ func (e *ToolExecutor) Connect(ctx context.Context) error {
 func (e *ToolExecutor) connectWithAuthHandling(ctx context.Context) error {
 	err := e.client.Connect(ctx)
 	if err == nil {
+		e.warnVersionSkew()
 		return nil
 	}
 
@@ -347,6 +363,22 @@ func (e *ToolExecutor) connectWithAuthHandling(ctx context.Context) error {
 	return err
 }
 
+// warnVersionSkew warns on stderr when the server version reported in the MCP
+// handshake may be incompatible with this CLI, since mixed versions fail in
+// confusing ways. It warns even in quiet mode, where stdout is parsed.
+func (e *ToolExecutor) warnVersionSkew() {
+	serverInfo := e.client.GetServerInfo()
+	if serverInfo == nil {
+		return
+	}
+	if project.VersionSkew(project.Version(), serverInfo.Version) != project.SkewIncompatible {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "%s\n", text.FgYellow.Sprintf(
+		"Warning: muster %s may be incompatible with server version %s. Run 'muster version --check' for details.",
+		project.Version(), serverInfo.Version))
+}
+
 // setupAuthentication configures the mcp-go OAuth transport for remote connections.
 // It creates an AgentTokenStore backed by the file-based token store and sets up
 // the OAuth config on the client. The transport automatically injects bearer tokens
@@ -354,7 +386,7 @@ func (e *ToolExecutor) connectWithAuthHandling(ctx context.Context) error {
 func (e *ToolExecutor) setupAuthentication(ctx context.Context) error {
 	authHandler := api.GetAuthHandler()
 	if authHandler == nil {
-		adapter, err := NewAuthAdapter()
+		adapter, err := NewAuthAdapterWithConfig(AuthAdapterConfigForProfile(e.authProfile, false))
 		if err != nil {
 			return nil
 		}
@@ -363,9 +395,13 @@ func (e *ToolExecutor) setupAuthentication(ctx context.Context) error {
 		if authHandler == nil {
 			return nil
 		}
+	} else if adapter, ok := authHandler.(*AuthAdapter); ok {
+		if err := adapter.SetAuthProfile(e.authProfile); err != nil {
+			return nil
+		}
 	}
 
-	oauthCfg, agentStore, err := agentoauth.SetupOAuthConfig(e.endpoint)
+	oauthCfg, agentStore, err := agentoauth.SetupOAuthConfigWithProfile(e.endpoint, e.authProfile)
 	if err != nil {
 		slog.Debug("Could not set up OAuth transport, proceeding without it",
 			"endpoint", e.endpoint, "error", err)
@@ -439,7 +475,11 @@ func (e *ToolExecutor) handleAuthError(ctx context.Context, originalErr error) e
 		return err
 	}
 
-	return e.client.Connect(ctx)
+	if err := e.client.Connect(ctx); err != nil {
+		return err
+	}
+	e.warnVersionSkew()
+	return nil
 }
 
 // Close gracefully closes the connection to the aggregator server.
@@ -488,7 +528,7 @@ func (e *ToolExecutor) Execute(ctx context.Context, toolName string, args map[st
 		if s != nil {
 			fmt.Fprintf(os.Stderr, "%s\n", text.FgRed.Sprint("❌ Command returned error"))
 		}
-		return e.formatError(result)
+		return e.formatError(toolName, result)
 	}
 
 	return e.formatOutput(result)
@@ -516,11 +556,12 @@ func (e *ToolExecutor) ExecuteJSON(ctx context.Context, toolName string, args ma
 // the exit code, but not printed directly to avoid duplicate error messages.
 //
 // Args:
+//   - toolName: Name of the tool that returned the error
 //   - result: MCP call result containing error information
 //
 // Returns:
-//   - error: Formatted error for propagation up the call stack
-func (e *ToolExecutor) formatError(result *mcp.CallToolResult) error {
+//   - error: *ToolError for propagation up the call stack
+func (e *ToolExecutor) formatError(toolName string, result *mcp.CallToolResult) error {
 	var errorMsgs []string
 	for _, content := range result.Content {
 		if textContent, ok := mcp.AsTextContent(content); ok {
@@ -530,7 +571,22 @@ func (e *ToolExecutor) formatError(result *mcp.CallToolResult) error {
 
 	errorMsg := strings.Join(errorMsgs, "\n")
 	// Don't print here - cobra will print the returned error
-	return fmt.Errorf("%s", errorMsg)
+	return &ToolError{Tool: toolName, Message: errorMsg, APICode: resultErrorCode(result)}
+}
+
+// resultErrorCode returns the error code in the structured content of an
+// error result created by api.HandleError, or "" if there is none.
+func resultErrorCode(result *mcp.CallToolResult) api.ErrorCode {
+	structured, ok := result.StructuredContent.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	info, ok := structured["error"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	code, _ := info["code"].(string)
+	return api.ErrorCode(code)
 }
 
 // formatOutput formats the tool output according to the specified format.
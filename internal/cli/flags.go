@@ -24,6 +24,8 @@ type CommandFlags struct {
 	Endpoint string
 	// Context specifies a named context to use for endpoint resolution
 	Context string
+	// Namespace overrides the default Kubernetes namespace of the context
+	Namespace string
 	// AuthMode controls authentication behavior (auto, prompt, none)
 	AuthMode string
 }
@@ -40,6 +42,7 @@ type CommandFlags struct {
 //   - --config-path: Configuration directory
 //   - --endpoint: Remote muster aggregator endpoint URL (env: MUSTER_ENDPOINT)
 //   - --context: Use a specific context (env: MUSTER_CONTEXT)
+//   - --namespace/-n: Kubernetes namespace (default: from the context)
 //   - --auth: Authentication mode (env: MUSTER_AUTH_MODE)
 func RegisterCommonFlags(cmd *cobra.Command, flags *CommandFlags) {
 	cmd.PersistentFlags().StringVarP(&flags.OutputFormat, "output", "o", "table", "Output format (table, wide, json, yaml)")
@@ -49,6 +52,7 @@ func RegisterCommonFlags(cmd *cobra.Command, flags *CommandFlags) {
 	cmd.PersistentFlags().StringVar(&flags.ConfigPath, "config-path", config.GetDefaultConfigPathOrPanic(), "Configuration directory")
 	cmd.PersistentFlags().StringVar(&flags.Endpoint, "endpoint", GetDefaultEndpoint(), "Remote muster aggregator endpoint URL (env: MUSTER_ENDPOINT)")
 	cmd.PersistentFlags().StringVar(&flags.Context, "context", "", "Use a specific context (env: MUSTER_CONTEXT)")
+	cmd.PersistentFlags().StringVarP(&flags.Namespace, "namespace", "n", "", "Kubernetes namespace of the resources (default: from the context)")
 	cmd.PersistentFlags().StringVar(&flags.AuthMode, "auth", "", "Authentication mode: auto (default), prompt, or none (env: MUSTER_AUTH_MODE)")
 }
 
@@ -74,6 +78,7 @@ func (f *CommandFlags) ToExecutorOptions() (ExecutorOptions, error) {
 		ConfigPath: f.ConfigPath,
 		Endpoint:   f.Endpoint,
 		Context:    f.Context,
+		Namespace:  f.Namespace,
 		AuthMode:   authMode,
 	}, nil
 }
@@ -241,6 +241,11 @@ func (f *TableFormatter) printFooterMessages(data map[string]interface{}, resour
 	if resourceType == "mcpServers" {
 		f.printServerStatusNotes(data)
 	}
+
+	// Check for a cursor of the next page of a paginated list
+	if cursor, ok := data[api.ListKeyNextCursor].(string); ok && cursor != "" {
+		fmt.Printf("\n(more results available, use --cursor %s to show the next page)\n", cursor)
+	}
 }
 
 // printServerStatusNotes prints actionable notes for servers requiring attention.
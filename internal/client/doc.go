@@ -53,6 +53,40 @@
 //	}
 //	client, err := client.NewMusterClientWithConfig(config)
 //
+// ## Offline Snapshots
+//
+// For offline tooling and unit tests, a read-only snapshot serves the
+// resources of a configuration directory or of a snapshot file (e.g. the
+// output of `kubectl get mcpservers,workflows -A -o yaml`) from memory,
+// without any backend. Writes fail with snapshot.ErrReadOnly:
+//
+//	client, err := client.NewMusterClientWithConfig(&client.MusterClientConfig{
+//	    Snapshot: "./snapshot.yaml",
+//	})
+//
+// Unit tests can create one from objects with snapshot.New(objs...).
+//
+// ## Multiple Clusters
+//
+//	clusters, err := client.NewMultiClusterClient(&client.MusterClientConfig{
+//	    Namespace: "muster-system",
+//	    Clusters: []client.ClusterConfig{
+//	        {Name: "prod", Context: "gs-prod"},
+//	        {Name: "dev", Context: "gs-dev"},
+//	    },
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer clusters.Close()
+//
+//	// Fan out across all clusters
+//	serversByCluster, err := clusters.ListMCPServers(ctx, "")
+//
+//	// Operate on one cluster
+//	prod, err := clusters.Cluster("prod")
+//	err = prod.DeleteMCPServer(ctx, "old-server", "muster-system")
+//
 // # Environment Detection
 //
 // The client automatically detects the execution environment:
@@ -69,9 +103,21 @@
 //
 // # Interface Compatibility
 //
-// MusterClient extends controller-runtime's client.Client interface, ensuring
+// MusterClient extends controller-runtime's client.WithWatch interface, ensuring
 // compatibility with existing Kubernetes tooling while adding muster-specific
-// convenience methods.
+// convenience methods. Watch works in both modes: the filesystem backend
+// watches the resource files with fsnotify and sends the same Added,
+// Modified, and Deleted events as the Kubernetes API:
+//
+//	w, err := client.Watch(ctx, &musterv1alpha1.MCPServerList{})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer w.Stop()
+//	for event := range w.ResultChan() {
+//	    server := event.Object.(*musterv1alpha1.MCPServer)
+//	    // Handle event.Type for server
+//	}
 //
 // # Future Extensibility
 //
@@ -0,0 +1,143 @@
+package filesystem
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// resourceCache is an informer-style cache of the resources of one type in
+// one namespace. It is filled when first read and kept up to date by a resourceWatch, so
+// repeated reads don't re-read and re-parse the YAML files.
+type resourceCache struct {
+	// items are the resources by name. mu also serializes refreshes, so the
+	// last refresh of a resource always reads its latest files.
+	mu    sync.Mutex
+	items map[string]client.Object
+
+	rw   *resourceWatch
+	stop chan struct{}
+}
+
+// EnableCache makes Get and List of MCPServers and Workflows, and the
+// per-type methods, read from an in-memory cache of each resource type. A
+// cache is filled on first use and kept up to date by watching the files:
+// writes through the client are visible right away, and changes made to the
+// files otherwise once fsnotify reports them. Resources missing from the
+// cache are read from their files, so a file that fails to load reports its
+// error.
+func (f *Client) EnableCache() {
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	f.cacheEnabled = true
+}
+
+// cacheKey returns the key of the cache of the resources of m in namespace.
+func cacheKey(namespace string, m resourceMeta) string {
+	return namespace + "/" + m.dir
+}
+
+// cache returns the cache of the resources of m in namespace, starting it on
+// first use, or nil if caching is disabled or the files cannot be watched.
+func (f *Client) cache(namespace string, m resourceMeta) *resourceCache {
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	if !f.cacheEnabled {
+		return nil
+	}
+	if c := f.caches[cacheKey(namespace, m)]; c != nil {
+		return c
+	}
+
+	rw, items, err := f.watchResources(namespace, m)
+	if err != nil {
+		logging.Warn("fs-client", "Failed to cache %s, reading the files instead: %v", m.gr.Resource, err)
+		return nil
+	}
+	c := &resourceCache{items: items, rw: rw, stop: make(chan struct{})}
+	go func() {
+		defer func() { _ = rw.watcher.Close() }()
+		rw.run(context.Background(), c.stop, func(name string) bool {
+			f.refreshCached(c, name)
+			return true
+		})
+	}()
+	if f.caches == nil {
+		f.caches = map[string]*resourceCache{}
+	}
+	f.caches[cacheKey(namespace, m)] = c
+	return c
+}
+
+// refreshCached re-reads the named resource into the cache c.
+func (f *Client) refreshCached(c *resourceCache, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f.resync(c.rw.namespace, name, c.rw.m, c.items)
+}
+
+// refreshCache re-reads the named resource of namespace into the cache of m,
+// if started, after the client wrote its file.
+func (f *Client) refreshCache(namespace, name string, m resourceMeta) {
+	f.cacheMu.Lock()
+	c := f.caches[cacheKey(namespace, m)]
+	f.cacheMu.Unlock()
+	if c != nil {
+		f.refreshCached(c, name)
+	}
+}
+
+// cachedGet copies the named resource of namespace from the cache of m into
+// obj, and reports whether it was cached.
+func (f *Client) cachedGet(namespace, name string, obj client.Object, m resourceMeta) bool {
+	c := f.cache(namespace, m)
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	item, ok := c.items[name]
+	c.mu.Unlock()
+	if !ok {
+		return false
+	}
+	// Cached objects are shared, so callers get a copy to modify
+	reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(item.DeepCopyObject()).Elem())
+	return true
+}
+
+// cachedItems returns the resources of namespace from the cache of m, sorted
+// by name like readResources, and reports whether the cache is enabled.
+func (f *Client) cachedItems(namespace string, m resourceMeta) ([]runtime.Object, bool) {
+	c := f.cache(namespace, m)
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	names := make([]string, 0, len(c.items))
+	for name := range c.items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	items := make([]runtime.Object, 0, len(names))
+	for _, name := range names {
+		items = append(items, c.items[name].DeepCopyObject())
+	}
+	c.mu.Unlock()
+	return items, true
+}
+
+// stopCaches stops watching the files of the caches and drops them.
+func (f *Client) stopCaches() {
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	for _, c := range f.caches {
+		close(c.stop)
+	}
+	f.caches = nil
+}
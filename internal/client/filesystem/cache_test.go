@@ -0,0 +1,125 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// listCommands returns the commands of the MCPServers c lists by name.
+func listCommands(c *Client) (map[string]string, error) {
+	servers, err := c.ListMCPServers(context.Background(), "")
+	if err != nil {
+		return nil, err
+	}
+	commands := map[string]string{}
+	for _, server := range servers {
+		commands[server.Name] = server.Spec.Command
+	}
+	return commands, nil
+}
+
+func serverCommands(t *testing.T, c *Client) map[string]string {
+	t.Helper()
+	commands, err := listCommands(c)
+	require.NoError(t, err)
+	return commands
+}
+
+func newCachingClient(t *testing.T) *Client {
+	t.Helper()
+	c := New(t.TempDir())
+	c.EnableCache()
+	t.Cleanup(func() { _ = c.Close() })
+	// Start the cache of the MCPServers
+	assert.Empty(t, serverCommands(t, c))
+	return c
+}
+
+func TestCache_WritesVisibleRightAway(t *testing.T) {
+	ctx := context.Background()
+	c := newCachingClient(t)
+
+	server := newMCPServer("server", "original")
+	require.NoError(t, c.CreateMCPServer(ctx, server))
+	assert.Equal(t, map[string]string{"server": "original"}, serverCommands(t, c))
+
+	server.Spec.Command = "updated"
+	require.NoError(t, c.UpdateMCPServer(ctx, server))
+	got, err := c.GetMCPServer(ctx, "server", "")
+	require.NoError(t, err)
+	assert.Equal(t, "updated", got.Spec.Command)
+	assert.Equal(t, map[string]string{"server": "updated"}, serverCommands(t, c))
+
+	require.NoError(t, c.DeleteMCPServer(ctx, "server", ""))
+	_, err = c.GetMCPServer(ctx, "server", "")
+	assert.True(t, errors.IsNotFound(err), "expected NotFound, got %v", err)
+	assert.Empty(t, serverCommands(t, c))
+}
+
+func TestCache_ExternalChanges(t *testing.T) {
+	ctx := context.Background()
+	c := newCachingClient(t)
+	file := filepath.Join(c.basePath, "mcpservers", "server.yaml")
+
+	require.NoError(t, os.WriteFile(file, []byte(editedServer), 0644))
+	require.Eventually(t, func() bool {
+		commands, err := listCommands(c)
+		return err == nil && commands["server"] == "edited"
+	}, 5*time.Second, 10*time.Millisecond, "created file is cached")
+
+	writeResourceFile(t, c.basePath, "mcpservers/server.yaml", `metadata:
+  name: server
+spec:
+  type: stdio
+  command: edited-again
+`)
+	require.Eventually(t, func() bool {
+		server, err := c.GetMCPServer(ctx, "server", "")
+		return err == nil && server.Spec.Command == "edited-again"
+	}, 5*time.Second, 10*time.Millisecond, "edited file is cached")
+
+	require.NoError(t, os.Remove(file))
+	require.Eventually(t, func() bool {
+		_, err := c.GetMCPServer(ctx, "server", "")
+		commands, listErr := listCommands(c)
+		return errors.IsNotFound(err) && listErr == nil && len(commands) == 0
+	}, 5*time.Second, 10*time.Millisecond, "deleted file is dropped")
+}
+
+func TestCache_CloseStopsWatches(t *testing.T) {
+	ctx := context.Background()
+	c := newCachingClient(t)
+	_, err := c.ListWorkflows(ctx, "")
+	require.NoError(t, err)
+
+	c.cacheMu.Lock()
+	caches := make([]*resourceCache, 0, len(c.caches))
+	for _, rc := range c.caches {
+		caches = append(caches, rc)
+	}
+	c.cacheMu.Unlock()
+	require.Len(t, caches, 2)
+
+	require.NoError(t, c.Close())
+	c.cacheMu.Lock()
+	assert.Empty(t, c.caches)
+	c.cacheMu.Unlock()
+	for _, rc := range caches {
+		// The watcher closes its Events channel once closed
+		assert.Eventually(t, func() bool {
+			select {
+			case _, ok := <-rc.rw.watcher.Events:
+				return !ok
+			default:
+				return false
+			}
+		}, 5*time.Second, 10*time.Millisecond, "watch of %s stopped", rc.rw.m.gr.Resource)
+	}
+}
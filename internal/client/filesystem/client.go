@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -12,35 +13,77 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/muster/internal/config"
 	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
 )
 
-// defaultNamespace is the namespace assumed for filesystem-backed resources
-// that don't carry an explicit namespace. Filesystem mode has no namespace
-// concept; this anchors the in-memory representation.
-const defaultNamespace = "default"
-
 // Client is a filesystem-backed implementation of the muster client interface.
 //
-// Files live directly under per-resource-type folders (no namespace
-// subdirectories):
+// The resources of the namespace the client runs in (see SetNamespace) live
+// directly under per-resource-type folders:
 //   - MCPServers: {basePath}/mcpservers/{name}.yaml
 //   - Workflows:  {basePath}/workflows/{name}.yaml
+//   - Events:     {basePath}/events/events-{YYYY-MM-DD}.json, bounded by
+//     the EventRetention
+//
+// The resources of any other namespace live in the same folders under
+// {basePath}/namespaces/{namespace}/ (see config.NamespacePath). With a
+// profile, the files of the same name under {basePath}/profiles/{profile}/,
+// laid out the same way, are merged over them.
 //
 // Per-domain CRUD methods live in sibling files (mcpserver.go, workflow.go,
 // events.go). This file keeps the type, the controller-runtime Client
 // interface methods, shared helpers, and the sub-resource writer types.
 type Client struct {
 	basePath string
+	history  *config.History
+	// namespace is the namespace whose resources live directly in basePath,
+	// and of requests without one
+	namespace string
+	// profile overlays the resources with the files of
+	// {basePath}/profiles/{profile}
+	profile string
+	// events indexes the event files of {basePath}/events
+	events *eventStore
+
+	// writeMu serializes the writes of resources, so an update cannot
+	// overwrite a write that happened after its resourceVersion was checked
+	writeMu sync.Mutex
+
+	// caches are the started resource caches by namespace and directory, if
+	// cacheEnabled
+	// (see EnableCache)
+	cacheMu      sync.Mutex
+	cacheEnabled bool
+	caches       map[string]*resourceCache
 }
 
 // New returns a filesystem-backed Client rooted at basePath. An empty
-// basePath defaults to the current working directory.
+// basePath defaults to the current working directory. Creates, spec updates,
+// and deletes are recorded in the config.History of basePath.
 func New(basePath string) *Client {
+	return NewWithProfile(basePath, "")
+}
+
+// NewWithProfile returns a Client like New that merges the files of the named
+// profile in basePath over the resource files (see config.MergeYAML).
+// Resources with a file in the profile can only have their status updated.
+func NewWithProfile(basePath, profile string) *Client {
 	if basePath == "" {
 		basePath = "."
 	}
-	return &Client{basePath: basePath}
+	return &Client{basePath: basePath, history: config.NewHistory(basePath), namespace: config.DefaultNamespace, profile: profile, events: newEventStore()}
+}
+
+// SetNamespace sets the namespace the client runs in: its resources live
+// directly in the base path, and requests without a namespace use it. An
+// empty namespace is config.DefaultNamespace. Like EnableCache, it must be
+// called before the client is used.
+func (f *Client) SetNamespace(namespace string) {
+	if namespace == "" {
+		namespace = config.DefaultNamespace
+	}
+	f.namespace = namespace
 }
 
 var (
@@ -72,13 +115,14 @@ func (f *Client) Get(ctx context.Context, key types.NamespacedName, obj client.O
 }
 
 // List retrieves a list of resources (implements client.Client interface).
+// Without a namespace, the resources of all namespaces are listed. Label and
+// field selectors are applied like the Kubernetes API server does.
 func (f *Client) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
-	namespace := defaultNamespace
-	for _, opt := range opts {
-		if nsOpt, ok := opt.(*client.ListOptions); ok && nsOpt.Namespace != "" {
-			namespace = nsOpt.Namespace
-		}
+	filter, err := newListFilter(opts)
+	if err != nil {
+		return err
 	}
+	namespace := (&client.ListOptions{}).ApplyOptions(opts).Namespace
 
 	switch v := list.(type) {
 	case *musterv1alpha1.MCPServerList:
@@ -87,17 +131,16 @@ func (f *Client) List(ctx context.Context, list client.ObjectList, opts ...clien
 			return err
 		}
 		v.Items = servers
-		return nil
 	case *musterv1alpha1.WorkflowList:
 		workflows, err := f.ListWorkflows(ctx, namespace)
 		if err != nil {
 			return err
 		}
 		v.Items = workflows
-		return nil
 	default:
 		return fmt.Errorf("filesystem client does not support type %T", list)
 	}
+	return filter.filterList(list)
 }
 
 // Create creates a new resource (implements client.Client interface).
@@ -195,8 +238,10 @@ func (f *Client) IsKubernetesMode() bool {
 	return false
 }
 
-// Close performs cleanup for the filesystem client.
+// Close performs cleanup for the filesystem client, stopping the watches of
+// its caches.
 func (f *Client) Close() error {
+	f.stopCaches()
 	return nil
 }
 
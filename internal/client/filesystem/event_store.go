@@ -0,0 +1,371 @@
+package filesystem
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+const (
+	// DefaultEventMaxAge is the default age after which stored events are
+	// deleted.
+	DefaultEventMaxAge = 30 * 24 * time.Hour
+
+	// DefaultEventMaxEvents is the default number of stored events.
+	DefaultEventMaxEvents = 10000
+
+	// eventDedupWindow is the time after the last occurrence of an event
+	// within which an identical event is aggregated into it, as the
+	// Kubernetes event correlator does.
+	eventDedupWindow = 10 * time.Minute
+
+	// eventFileDateLayout is the date in the names of the daily event files,
+	// events-YYYY-MM-DD.json.
+	eventFileDateLayout = "2006-01-02"
+
+	// legacyEventsLog is the file of the line-based event format written by
+	// earlier versions next to the JSON files.
+	legacyEventsLog = "events.log"
+)
+
+// EventRetention bounds the events stored in filesystem mode. Zero fields use
+// the defaults.
+type EventRetention struct {
+	// MaxAge deletes the daily event files of days before now-MaxAge
+	// (default: DefaultEventMaxAge).
+	MaxAge time.Duration
+
+	// MaxEvents caps the number of stored events; the oldest events are
+	// deleted first (default: DefaultEventMaxEvents).
+	MaxEvents int
+}
+
+// eventStore indexes the daily event files of the events directory by the
+// number of events they hold, so retention can be enforced on every write
+// without reading all files. The index is built from the files on first use.
+type eventStore struct {
+	mu        sync.Mutex
+	retention EventRetention
+
+	// counts maps event file names to the number of events in them; nil
+	// until the index is loaded.
+	counts map[string]int
+	total  int
+
+	// prunedDay is the day the files were last pruned by age.
+	prunedDay string
+
+	// recent maps the keys of the events written within eventDedupWindow to
+	// their stored records.
+	recent map[string]*recentEvent
+}
+
+// recentEvent is a stored event that identical events are aggregated into.
+type recentEvent struct {
+	// file is the name of the event file holding the record.
+	file string
+
+	// line is the record as stored.
+	line []byte
+
+	event api.EventResult
+}
+
+// eventKey identifies identical events: those of the same object with the
+// same reason, type and message.
+func eventKey(event api.EventResult) string {
+	return strings.Join([]string{
+		event.InvolvedObject.Kind, event.Namespace, event.InvolvedObject.Name,
+		event.Reason, event.Type, event.Message,
+	}, "\x00")
+}
+
+func newEventStore() *eventStore {
+	return &eventStore{retention: EventRetention{MaxAge: DefaultEventMaxAge, MaxEvents: DefaultEventMaxEvents}}
+}
+
+// SetEventRetention sets the bounds of the stored events. It takes effect
+// with the next event written.
+func (f *Client) SetEventRetention(retention EventRetention) {
+	if retention.MaxAge <= 0 {
+		retention.MaxAge = DefaultEventMaxAge
+	}
+	if retention.MaxEvents <= 0 {
+		retention.MaxEvents = DefaultEventMaxEvents
+	}
+	f.events.mu.Lock()
+	defer f.events.mu.Unlock()
+	f.events.retention = retention
+}
+
+// eventsDir returns the directory of the event files.
+func (f *Client) eventsDir() string {
+	return filepath.Join(f.basePath, "events")
+}
+
+// eventFileName returns the name of the daily file storing events of t.
+func eventFileName(t time.Time) string {
+	return "events-" + t.Format(eventFileDateLayout) + ".json"
+}
+
+// eventFileDate returns the date of a daily event file, and false for other
+// files.
+func eventFileDate(name string) (string, bool) {
+	if !strings.HasPrefix(name, "events-") || !strings.HasSuffix(name, ".json") {
+		return "", false
+	}
+	date := strings.TrimSuffix(strings.TrimPrefix(name, "events-"), ".json")
+	if _, err := time.Parse(eventFileDateLayout, date); err != nil {
+		return "", false
+	}
+	return date, true
+}
+
+// eventFiles returns the names of the daily event files in eventsDir holding
+// events between since and until, oldest first. Nil bounds are open.
+func eventFiles(eventsDir string, since, until *time.Time) []string {
+	entries, err := os.ReadDir(eventsDir)
+	if err != nil {
+		return nil
+	}
+
+	var first, last string
+	if since != nil {
+		first = since.In(time.Local).Format(eventFileDateLayout)
+	}
+	if until != nil {
+		last = until.In(time.Local).Format(eventFileDateLayout)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		date, ok := eventFileDate(entry.Name())
+		if entry.IsDir() || !ok {
+			continue
+		}
+		if (first != "" && date < first) || (last != "" && date > last) {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// added records an event appended to the file name and enforces the
+// retention. The caller must hold s.mu and have loaded the index before
+// appending.
+func (s *eventStore) added(eventsDir, name string, now time.Time) {
+	s.counts[name]++
+	s.total++
+
+	if day := now.Format(eventFileDateLayout); day != s.prunedDay {
+		s.pruneByAge(eventsDir, now)
+		s.prunedDay = day
+	}
+	if s.total > s.retention.MaxEvents {
+		// Trim a tenth below the cap, so the oldest file is not rewritten
+		// on every write once the store is full
+		s.pruneByCount(eventsDir, s.retention.MaxEvents-s.retention.MaxEvents/10)
+	}
+}
+
+// load builds the index from the event files unless it is loaded.
+func (s *eventStore) load(eventsDir string) {
+	if s.counts != nil {
+		return
+	}
+	s.counts = map[string]int{}
+	s.total = 0
+	for _, name := range eventFiles(eventsDir, nil, nil) {
+		count, err := countEventLines(filepath.Join(eventsDir, name))
+		if err != nil {
+			logging.Debug("fs-client", "Failed to index event file %s: %v", name, err)
+			continue
+		}
+		s.counts[name] = count
+		s.total += count
+	}
+}
+
+// pruneByAge deletes the files of days before now-MaxAge, and the legacy
+// events.log, which duplicates the events of the JSON files.
+func (s *eventStore) pruneByAge(eventsDir string, now time.Time) {
+	cutoff := eventFileName(now.Add(-s.retention.MaxAge))
+	for _, name := range eventFiles(eventsDir, nil, nil) {
+		if name >= cutoff {
+			break
+		}
+		s.remove(eventsDir, name)
+	}
+
+	legacy := filepath.Join(eventsDir, legacyEventsLog)
+	if err := os.Remove(legacy); err == nil {
+		logging.Info("fs-client", "Removed legacy event log %s, its events are kept in the daily event files", legacy)
+	}
+}
+
+// pruneByCount deletes the oldest events until at most target remain.
+func (s *eventStore) pruneByCount(eventsDir string, target int) {
+	for _, name := range eventFiles(eventsDir, nil, nil) {
+		if s.total <= target {
+			return
+		}
+		count := s.counts[name]
+		if s.total-count >= target {
+			s.remove(eventsDir, name)
+			continue
+		}
+		drop := s.total - target
+		if err := dropEventLines(filepath.Join(eventsDir, name), drop); err != nil {
+			logging.Debug("fs-client", "Failed to trim event file %s: %v", name, err)
+			return
+		}
+		s.counts[name] = count - drop
+		s.total -= drop
+	}
+}
+
+// remove deletes an event file and its index entry.
+func (s *eventStore) remove(eventsDir, name string) {
+	if err := os.Remove(filepath.Join(eventsDir, name)); err != nil && !os.IsNotExist(err) {
+		logging.Debug("fs-client", "Failed to remove event file %s: %v", name, err)
+		return
+	}
+	s.total -= s.counts[name]
+	delete(s.counts, name)
+	logging.Debug("fs-client", "Removed event file: %s", name)
+}
+
+// countEventLines returns the number of events in an event file.
+func countEventLines(path string) (int, error) {
+	file, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = file.Close() }()
+
+	count := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) > 0 {
+			count++
+		}
+	}
+	return count, scanner.Err()
+}
+
+// aggregate folds event into the stored record of an identical event that
+// occurred within eventDedupWindow, raising its count and last timestamp.
+// It returns false when there is no such record, so event must be written
+// as a new one. The caller must hold s.mu.
+func (s *eventStore) aggregate(eventsDir string, event api.EventResult) bool {
+	for key, recent := range s.recent {
+		if event.Timestamp.Sub(recent.event.Timestamp) > eventDedupWindow {
+			delete(s.recent, key)
+		}
+	}
+
+	recent, ok := s.recent[eventKey(event)]
+	if !ok || recent.file != eventFileName(event.Timestamp) {
+		return false
+	}
+
+	updated := recent.event
+	updated.Count++
+	updated.Timestamp = event.Timestamp
+	updated.CorrelationID = event.CorrelationID
+	line, err := json.Marshal(updated)
+	if err != nil {
+		return false
+	}
+	replaced, err := replaceEventLine(filepath.Join(eventsDir, recent.file), recent.line, line)
+	if err != nil {
+		logging.Debug("fs-client", "Failed to update event in %s: %v", recent.file, err)
+	}
+	if !replaced {
+		// The record was pruned or the file changed; store the event anew
+		return false
+	}
+	recent.line = line
+	recent.event = updated
+	return true
+}
+
+// remember records a newly written event for aggregation. The caller must
+// hold s.mu.
+func (s *eventStore) remember(name string, line []byte, event api.EventResult) {
+	if s.recent == nil {
+		s.recent = map[string]*recentEvent{}
+	}
+	s.recent[eventKey(event)] = &recentEvent{file: name, line: line, event: event}
+}
+
+// replaceEventLine replaces the last line of an event file equal to old with
+// line. It returns false when the file has no such line.
+func replaceEventLine(path string, old, line []byte) (bool, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	lines := bytes.Split(data, []byte("\n"))
+	for i := len(lines) - 1; i >= 0; i-- {
+		if bytes.Equal(lines[i], old) {
+			lines[i] = line
+			return true, writeEventFile(path, bytes.Join(lines, []byte("\n")))
+		}
+	}
+	return false, nil
+}
+
+// dropEventLines removes the first n events of an event file. Events are
+// appended in order, so these are the oldest.
+func dropEventLines(path string, n int) error {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	var kept bytes.Buffer
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		if n > 0 {
+			n--
+			continue
+		}
+		kept.Write(line)
+		kept.WriteByte('\n')
+	}
+	return writeEventFile(path, kept.Bytes())
+}
+
+// writeEventFile replaces an event file atomically, so concurrent readers see
+// either version.
+func writeEventFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil { //nolint:gosec
+		return fmt.Errorf("failed to write %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
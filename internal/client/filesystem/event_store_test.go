@@ -0,0 +1,184 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// writeEventLines writes an event file of eventsDir with an event per
+// message.
+func writeEventLines(t *testing.T, eventsDir, name string, messages ...string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(eventsDir, 0755))
+	var data []byte
+	for _, message := range messages {
+		line, err := json.Marshal(api.EventResult{Reason: "Test", Message: message, Count: 1})
+		require.NoError(t, err)
+		data = append(append(data, line...), '\n')
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(eventsDir, name), data, 0644))
+}
+
+// eventMessages returns the messages of the events of an event file.
+func eventMessages(t *testing.T, path string) []string {
+	t.Helper()
+	events, err := (&Client{}).readEventsFromFile(path)
+	require.NoError(t, err)
+	messages := []string{}
+	for _, event := range events {
+		messages = append(messages, event.Message)
+	}
+	return messages
+}
+
+func TestEventStore_PruneByAge(t *testing.T) {
+	eventsDir := t.TempDir()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.Local)
+	writeEventLines(t, eventsDir, "events-2026-03-07.json", "a")
+	writeEventLines(t, eventsDir, "events-2026-03-08.json", "b", "c")
+	writeEventLines(t, eventsDir, "events-2026-03-09.json", "d")
+	writeEventLines(t, eventsDir, "events-2026-03-10.json", "e")
+	require.NoError(t, os.WriteFile(filepath.Join(eventsDir, "notes.json"), []byte("kept\n"), 0644))
+
+	s := newEventStore()
+	s.retention.MaxAge = 2 * 24 * time.Hour
+	s.load(eventsDir)
+	require.Equal(t, 5, s.total)
+
+	s.pruneByAge(eventsDir, now)
+	assert.Equal(t, []string{"events-2026-03-08.json", "events-2026-03-09.json", "events-2026-03-10.json"}, eventFiles(eventsDir, nil, nil))
+	assert.Equal(t, map[string]int{"events-2026-03-08.json": 2, "events-2026-03-09.json": 1, "events-2026-03-10.json": 1}, s.counts)
+	assert.Equal(t, 4, s.total)
+	assert.FileExists(t, filepath.Join(eventsDir, "notes.json"), "other files are left alone")
+}
+
+func TestEventStore_PruneByCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		target int
+		want   map[string][]string
+	}{
+		{
+			name:   "below the target",
+			target: 10,
+			want: map[string][]string{
+				"events-2026-03-08.json": {"a", "b", "c"},
+				"events-2026-03-09.json": {"d", "e", "f"},
+				"events-2026-03-10.json": {"g", "h", "i"},
+			},
+		},
+		{
+			name:   "whole files",
+			target: 3,
+			want:   map[string][]string{"events-2026-03-10.json": {"g", "h", "i"}},
+		},
+		{
+			name:   "trims the oldest remaining file",
+			target: 4,
+			want: map[string][]string{
+				"events-2026-03-09.json": {"f"},
+				"events-2026-03-10.json": {"g", "h", "i"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventsDir := t.TempDir()
+			writeEventLines(t, eventsDir, "events-2026-03-08.json", "a", "b", "c")
+			writeEventLines(t, eventsDir, "events-2026-03-09.json", "d", "e", "f")
+			writeEventLines(t, eventsDir, "events-2026-03-10.json", "g", "h", "i")
+
+			s := newEventStore()
+			s.load(eventsDir)
+			s.pruneByCount(eventsDir, tt.target)
+
+			got := map[string][]string{}
+			total := 0
+			for _, name := range eventFiles(eventsDir, nil, nil) {
+				got[name] = eventMessages(t, filepath.Join(eventsDir, name))
+				assert.Equal(t, len(got[name]), s.counts[name], "index of %s", name)
+				total += len(got[name])
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, total, s.total)
+		})
+	}
+}
+
+func TestDropEventLines(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		n    int
+		want string
+	}{
+		{name: "none", data: "a\nb\n", n: 0, want: "a\nb\n"},
+		{name: "oldest", data: "a\nb\nc\n", n: 2, want: "c\n"},
+		{name: "all", data: "a\nb\n", n: 5, want: ""},
+		{name: "blank lines are not events", data: "\na\n\nb\nc", n: 1, want: "b\nc\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "events-2026-03-10.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.data), 0644))
+			require.NoError(t, dropEventLines(path, tt.n))
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(data))
+			assert.NoFileExists(t, path+".tmp")
+		})
+	}
+}
+
+func TestClient_EventsMigrateLegacyLog(t *testing.T) {
+	ctx := context.Background()
+	c := New(t.TempDir())
+	eventsDir := c.eventsDir()
+	yesterday := eventFileName(time.Now().AddDate(0, 0, -1))
+	writeEventLines(t, eventsDir, yesterday, "stored")
+	legacy := filepath.Join(eventsDir, legacyEventsLog)
+	require.NoError(t, os.WriteFile(legacy, []byte("2026-03-09T10:00:00Z [Normal] MCPServer default/kubernetes: Test - stored\n"), 0644))
+
+	require.NoError(t, c.CreateEventForCRD(ctx, "MCPServer", "kubernetes", "default", "Test", "new", "Normal"))
+
+	// The legacy log duplicates the daily files, so it is removed, and the
+	// events of the daily files are kept
+	assert.NoFileExists(t, legacy)
+	result, err := c.QueryEvents(ctx, api.EventQueryOptions{})
+	require.NoError(t, err)
+	var messages []string
+	for _, event := range result.Events {
+		messages = append(messages, event.Message)
+	}
+	assert.Equal(t, "new,stored", strings.Join(messages, ","))
+	assert.Equal(t, 2, c.events.total)
+}
+
+func TestClient_EventRetention(t *testing.T) {
+	ctx := context.Background()
+	c := New(t.TempDir())
+	c.SetEventRetention(EventRetention{MaxEvents: 10})
+
+	for i := 0; i < 25; i++ {
+		// Distinct messages, so the events are not aggregated
+		require.NoError(t, c.CreateEventForCRD(ctx, "Workflow", "deploy", "default", "Test", strings.Repeat("x", i+1), "Normal"))
+	}
+
+	result, err := c.QueryEvents(ctx, api.EventQueryOptions{})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, result.TotalCount, 10)
+	assert.Equal(t, strings.Repeat("x", 25), result.Events[0].Message, "the newest events are kept")
+	assert.Equal(t, result.TotalCount, c.events.total)
+}
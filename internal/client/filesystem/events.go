@@ -19,41 +19,32 @@ import (
 
 // CreateEvent logs an event for the given object in filesystem mode.
 func (f *Client) CreateEvent(ctx context.Context, obj client.Object, reason, message, eventType string) error {
-	logging.Info("event", "Event for %s/%s: %s - %s (%s)",
+	logging.InfoCtx(ctx, "event", "Event for %s/%s: %s - %s (%s)",
 		obj.GetNamespace(), obj.GetName(), reason, message, eventType)
 
-	return f.writeEventToFile(obj.GetNamespace(), obj.GetName(), obj.GetObjectKind().GroupVersionKind().Kind, reason, message, eventType)
+	return f.writeEventToFile(ctx, obj.GetNamespace(), obj.GetName(), obj.GetObjectKind().GroupVersionKind().Kind, reason, message, eventType)
 }
 
 // CreateEventForCRD logs an event for a CRD by type, name, and namespace in filesystem mode.
 func (f *Client) CreateEventForCRD(ctx context.Context, crdType, name, namespace, reason, message, eventType string) error {
-	logging.Info("event", "Event for %s %s/%s: %s - %s (%s)",
+	logging.InfoCtx(ctx, "event", "Event for %s %s/%s: %s - %s (%s)",
 		crdType, namespace, name, reason, message, eventType)
 
-	return f.writeEventToFile(namespace, name, crdType, reason, message, eventType)
+	return f.writeEventToFile(ctx, namespace, name, crdType, reason, message, eventType)
 }
 
 // QueryEvents retrieves events based on filtering options from filesystem storage.
+// Only the daily files of the days between Since and Until are read.
 func (f *Client) QueryEvents(ctx context.Context, options api.EventQueryOptions) (*api.EventQueryResult, error) {
-	eventsDir := filepath.Join(f.basePath, "events")
+	eventsDir := f.eventsDir()
 
 	if err := os.MkdirAll(eventsDir, 0755); err != nil { //nolint:gosec
 		return nil, fmt.Errorf("failed to create events directory: %w", err)
 	}
 
 	var allEvents []api.EventResult
-
-	entries, err := os.ReadDir(eventsDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read events directory: %w", err)
-	}
-
-	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasPrefix(entry.Name(), "events-") || !strings.HasSuffix(entry.Name(), ".json") {
-			continue
-		}
-
-		filePath := filepath.Join(eventsDir, entry.Name())
+	for _, name := range eventFiles(eventsDir, options.Since, options.Until) {
+		filePath := filepath.Join(eventsDir, name)
 		fileEvents, err := f.readEventsFromFile(filePath)
 		if err != nil {
 			logging.Debug("fs-client", "Failed to read events from %s: %v", filePath, err)
@@ -63,11 +54,6 @@ func (f *Client) QueryEvents(ctx context.Context, options api.EventQueryOptions)
 		allEvents = append(allEvents, fileEvents...)
 	}
 
-	legacyEvents, err := f.readLegacyEventsLog(eventsDir)
-	if err == nil {
-		allEvents = append(allEvents, legacyEvents...)
-	}
-
 	filteredEvents := f.filterEvents(allEvents, options)
 
 	sort.Slice(filteredEvents, func(i, j int) bool {
@@ -93,7 +79,7 @@ func (f *Client) QueryEvents(ctx context.Context, options api.EventQueryOptions)
 // returned channel is closed when ctx is cancelled. This is event-driven (no
 // timer polling): work happens only when the on-disk log actually changes.
 func (f *Client) WatchEvents(ctx context.Context, options api.EventQueryOptions) (<-chan api.EventResult, error) {
-	eventsDir := filepath.Join(f.basePath, "events")
+	eventsDir := f.eventsDir()
 	if err := os.MkdirAll(eventsDir, 0755); err != nil { //nolint:gosec
 		return nil, fmt.Errorf("failed to create events directory: %w", err)
 	}
@@ -151,21 +137,13 @@ func (f *Client) WatchEvents(ctx context.Context, options api.EventQueryOptions)
 	return out, nil
 }
 
-// collectJSONEvents reads the JSON daily files, applies the filter options, and
-// returns events strictly newer than after, sorted oldest-first. It deliberately
-// ignores the legacy events.log so each event is delivered once.
+// collectJSONEvents reads the JSON daily files from the day of after on,
+// applies the filter options, and returns events strictly newer than after,
+// sorted oldest-first.
 func (f *Client) collectJSONEvents(eventsDir string, options api.EventQueryOptions, after time.Time) []api.EventResult {
-	entries, err := os.ReadDir(eventsDir)
-	if err != nil {
-		return nil
-	}
-
 	var all []api.EventResult
-	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasPrefix(entry.Name(), "events-") || !strings.HasSuffix(entry.Name(), ".json") {
-			continue
-		}
-		fileEvents, err := f.readEventsFromFile(filepath.Join(eventsDir, entry.Name()))
+	for _, name := range eventFiles(eventsDir, &after, nil) {
+		fileEvents, err := f.readEventsFromFile(filepath.Join(eventsDir, name))
 		if err != nil {
 			continue
 		}
@@ -216,107 +194,6 @@ func (f *Client) readEventsFromFile(filePath string) ([]api.EventResult, error)
 	return events, nil
 }
 
-// readLegacyEventsLog reads events from the legacy events.log file.
-func (f *Client) readLegacyEventsLog(eventsDir string) ([]api.EventResult, error) {
-	legacyFile := filepath.Join(eventsDir, "events.log")
-	data, err := os.ReadFile(legacyFile) //nolint:gosec
-	if err != nil {
-		return nil, err
-	}
-
-	var events []api.EventResult
-	lines := strings.Split(string(data), "\n")
-
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
-
-		event, err := f.parseLegacyEventLine(line)
-		if err != nil {
-			continue
-		}
-		events = append(events, event)
-	}
-
-	return events, nil
-}
-
-// parseLegacyEventLine parses a line from the legacy events.log format:
-//
-//	[2024-01-15T14:30:00Z] MCPServer default/prometheus: MCPServerStarted - MCPServer prometheus started successfully (Normal)
-func (f *Client) parseLegacyEventLine(line string) (api.EventResult, error) {
-	if !strings.HasPrefix(line, "[") {
-		return api.EventResult{}, fmt.Errorf("invalid format")
-	}
-
-	parts := strings.SplitN(line, "] ", 2)
-	if len(parts) != 2 {
-		return api.EventResult{}, fmt.Errorf("invalid timestamp format")
-	}
-
-	timestampStr := parts[0][1:]
-	timestamp, err := time.Parse(time.RFC3339, timestampStr)
-	if err != nil {
-		return api.EventResult{}, fmt.Errorf("invalid timestamp: %w", err)
-	}
-
-	remaining := parts[1]
-
-	objectParts := strings.SplitN(remaining, ": ", 2)
-	if len(objectParts) != 2 {
-		return api.EventResult{}, fmt.Errorf("invalid object format")
-	}
-
-	objectInfo := strings.SplitN(objectParts[0], " ", 2)
-	if len(objectInfo) != 2 {
-		return api.EventResult{}, fmt.Errorf("invalid object info")
-	}
-
-	kind := objectInfo[0]
-	namespaceAndName := strings.SplitN(objectInfo[1], "/", 2)
-	if len(namespaceAndName) != 2 {
-		return api.EventResult{}, fmt.Errorf("invalid namespace/name format")
-	}
-
-	namespace := namespaceAndName[0]
-	name := namespaceAndName[1]
-
-	eventDetails := objectParts[1]
-
-	var eventType string
-	if strings.HasSuffix(eventDetails, ")") {
-		lastParen := strings.LastIndex(eventDetails, "(")
-		if lastParen > 0 {
-			eventType = eventDetails[lastParen+1 : len(eventDetails)-1]
-			eventDetails = strings.TrimSpace(eventDetails[:lastParen])
-		}
-	}
-
-	reasonAndMessage := strings.SplitN(eventDetails, " - ", 2)
-	if len(reasonAndMessage) != 2 {
-		return api.EventResult{}, fmt.Errorf("invalid reason/message format")
-	}
-
-	reason := reasonAndMessage[0]
-	message := reasonAndMessage[1]
-
-	return api.EventResult{
-		Timestamp: timestamp,
-		Namespace: namespace,
-		InvolvedObject: api.ObjectReference{
-			Kind:      kind,
-			Name:      name,
-			Namespace: namespace,
-		},
-		Reason:  reason,
-		Message: message,
-		Type:    eventType,
-		Source:  "muster",
-	}, nil
-}
-
 // filterEvents applies the given options to filter events.
 func (f *Client) filterEvents(events []api.EventResult, options api.EventQueryOptions) []api.EventResult {
 	var filtered []api.EventResult
@@ -334,6 +211,9 @@ func (f *Client) filterEvents(events []api.EventResult, options api.EventQueryOp
 		if options.EventType != "" && event.Type != options.EventType {
 			continue
 		}
+		if options.Reason != "" && event.Reason != options.Reason {
+			continue
+		}
 		if options.Since != nil && event.Timestamp.Before(*options.Since) {
 			continue
 		}
@@ -347,108 +227,71 @@ func (f *Client) filterEvents(events []api.EventResult, options api.EventQueryOp
 	return filtered
 }
 
-// writeEventToFile writes event information to both legacy and JSON formats.
-func (f *Client) writeEventToFile(namespace, name, kind, reason, message, eventType string) error {
-	eventsDir := filepath.Join(f.basePath, "events")
+// writeEventToFile appends an event to the daily JSON file and enforces the
+// event retention. An identical event that occurred within the dedup window
+// is updated instead, so a crash-looping server yields one record with a
+// count rather than one per crash.
+func (f *Client) writeEventToFile(ctx context.Context, namespace, name, kind, reason, message, eventType string) error {
+	eventsDir := f.eventsDir()
 	if err := os.MkdirAll(eventsDir, 0755); err != nil { //nolint:gosec
 		logging.Debug("fs-client", "Failed to create events directory: %v", err)
 		return nil
 	}
 
 	timestamp := time.Now()
-
-	if err := f.writeLegacyEvent(eventsDir, timestamp, namespace, name, kind, reason, message, eventType); err != nil {
-		logging.Debug("fs-client", "Failed to write legacy event: %v", err)
-	}
-
-	if err := f.writeJSONEvent(eventsDir, timestamp, namespace, name, kind, reason, message, eventType); err != nil {
-		logging.Debug("fs-client", "Failed to write JSON event: %v", err)
-	}
-
-	f.cleanupOldEventFiles(eventsDir)
-
-	return nil
-}
-
-// writeLegacyEvent appends a line to the legacy events.log format.
-func (f *Client) writeLegacyEvent(eventsDir string, timestamp time.Time, namespace, name, kind, reason, message, eventType string) error {
-	eventsFile := filepath.Join(eventsDir, "events.log")
-	eventLine := fmt.Sprintf("[%s] %s %s/%s: %s - %s (%s)\n",
-		timestamp.Format(time.RFC3339), kind, namespace, name, reason, message, eventType)
-
-	file, err := os.OpenFile(eventsFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec
-	if err != nil {
-		return err
-	}
-	defer func() { _ = file.Close() }()
-
-	_, err = file.WriteString(eventLine)
-	return err
-}
-
-// writeJSONEvent appends a JSON-per-line entry to the daily events file.
-func (f *Client) writeJSONEvent(eventsDir string, timestamp time.Time, namespace, name, kind, reason, message, eventType string) error {
-	dateStr := timestamp.Format("2006-01-02")
-	jsonFile := filepath.Join(eventsDir, fmt.Sprintf("events-%s.json", dateStr))
-
 	event := api.EventResult{
-		Timestamp: timestamp,
-		Namespace: namespace,
+		Timestamp:      timestamp,
+		FirstTimestamp: timestamp,
+		Namespace:      namespace,
 		InvolvedObject: api.ObjectReference{
 			Kind:      kind,
 			Name:      name,
 			Namespace: namespace,
 		},
-		Reason:  reason,
-		Message: message,
-		Type:    eventType,
-		Source:  "muster",
+		Reason:        reason,
+		Message:       message,
+		Type:          eventType,
+		Source:        "muster",
+		Count:         1,
+		CorrelationID: logging.CorrelationIDFromContext(ctx),
 	}
 
-	eventJSON, err := json.Marshal(event)
-	if err != nil {
-		return err
+	f.events.mu.Lock()
+	defer f.events.mu.Unlock()
+
+	f.events.load(eventsDir)
+	if f.events.aggregate(eventsDir, event) {
+		return nil
 	}
 
-	file, err := os.OpenFile(jsonFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec
+	fileName := eventFileName(timestamp)
+	line, err := writeJSONEvent(filepath.Join(eventsDir, fileName), event)
 	if err != nil {
-		return err
+		logging.Debug("fs-client", "Failed to write JSON event: %v", err)
+		return nil
 	}
-	defer func() { _ = file.Close() }()
+	f.events.remember(fileName, line, event)
+	f.events.added(eventsDir, fileName, timestamp)
 
-	_, err = file.WriteString(string(eventJSON) + "\n")
-	return err
+	return nil
 }
 
-// cleanupOldEventFiles removes event files older than 30 days.
-func (f *Client) cleanupOldEventFiles(eventsDir string) {
-	cutoffDate := time.Now().AddDate(0, 0, -30)
-	cutoffDateStr := cutoffDate.Format("2006-01-02")
-
-	entries, err := os.ReadDir(eventsDir)
+// writeJSONEvent appends a JSON-per-line entry to the daily events file and
+// returns the entry.
+func writeJSONEvent(jsonFile string, event api.EventResult) ([]byte, error) {
+	eventJSON, err := json.Marshal(event)
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasPrefix(entry.Name(), "events-") || !strings.HasSuffix(entry.Name(), ".json") {
-			continue
-		}
-
-		// Filename format is events-YYYY-MM-DD.json (21 chars total).
-		filename := entry.Name()
-		if len(filename) < 21 {
-			continue
-		}
+	file, err := os.OpenFile(jsonFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
 
-		dateStr := filename[7:17]
-		if dateStr < cutoffDateStr {
-			filePath := filepath.Join(eventsDir, filename)
-			if err := os.Remove(filePath); err != nil {
-				logging.Debug("fs-client", "Failed to remove old event file %s: %v", filePath, err)
-			} else {
-				logging.Debug("fs-client", "Removed old event file: %s", filename)
-			}
-		}
+	if _, err := file.Write(append(eventJSON, '\n')); err != nil {
+		return nil, err
 	}
+	return eventJSON, nil
 }
@@ -3,22 +3,29 @@ package filesystem
 import (
 	"context"
 
-	"sigs.k8s.io/controller-runtime/pkg/client"
-
 	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
 )
 
-func (f *Client) GetMCPServer(_ context.Context, name, _ string) (*musterv1alpha1.MCPServer, error) {
+func (f *Client) GetMCPServer(_ context.Context, name, namespace string) (*musterv1alpha1.MCPServer, error) {
+	namespace, err := f.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
 	var obj musterv1alpha1.MCPServer
-	if err := f.getResource(name, &obj, mcpServerMeta); err != nil {
+	if f.cachedGet(namespace, name, &obj, mcpServerMeta) {
+		return &obj, nil
+	}
+	if err := f.getResource(namespace, name, &obj, mcpServerMeta); err != nil {
 		return nil, err
 	}
 	return &obj, nil
 }
 
-func (f *Client) ListMCPServers(_ context.Context, _ string) ([]musterv1alpha1.MCPServer, error) {
+// ListMCPServers lists the MCPServers of namespace, or of all namespaces if it is
+// empty.
+func (f *Client) ListMCPServers(_ context.Context, namespace string) ([]musterv1alpha1.MCPServer, error) {
 	var list musterv1alpha1.MCPServerList
-	err := f.listResources(&list, func() client.Object { return &musterv1alpha1.MCPServer{} }, mcpServerMeta)
+	err := f.listResources(namespace, &list, mcpServerMeta)
 	return list.Items, err
 }
 
@@ -30,8 +37,8 @@ func (f *Client) UpdateMCPServer(_ context.Context, server *musterv1alpha1.MCPSe
 	return f.updateResource(server, mcpServerMeta)
 }
 
-func (f *Client) DeleteMCPServer(_ context.Context, name, _ string) error {
-	return f.deleteResource(name, mcpServerMeta)
+func (f *Client) DeleteMCPServer(_ context.Context, name, namespace string) error {
+	return f.deleteResource(namespace, name, mcpServerMeta)
 }
 
 // UpdateMCPServerStatus rewrites the entire YAML — filesystem mode embeds
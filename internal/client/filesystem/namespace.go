@@ -0,0 +1,75 @@
+package filesystem
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/giantswarm/muster/internal/config"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// resolveNamespace returns the namespace of a request for namespace: the one
+// the client runs in if it is empty. An invalid namespace is a BadRequest.
+func (f *Client) resolveNamespace(namespace string) (string, error) {
+	if namespace == "" {
+		return f.namespace, nil
+	}
+	if err := config.CheckNamespace(namespace); err != nil {
+		return "", errors.NewBadRequest(err.Error())
+	}
+	return namespace, nil
+}
+
+// namespacePath returns the directory of the resources of namespace in the
+// configuration directory basePath, or in a profile directory.
+func (f *Client) namespacePath(basePath, namespace string) string {
+	return config.NamespacePath(basePath, f.namespace, namespace)
+}
+
+// namespaces returns the namespaces with resources, or at least a directory
+// for them, sorted: the one the client runs in and those with a directory in
+// the base path or the selected profile.
+func (f *Client) namespaces() ([]string, error) {
+	basePaths := []string{f.basePath}
+	if f.profile != "" {
+		basePaths = append(basePaths, config.ProfilePath(f.basePath, f.profile))
+	}
+	namespaces := []string{f.namespace}
+	seen := map[string]bool{f.namespace: true}
+	for _, basePath := range basePaths {
+		names, err := config.Namespaces(basePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				namespaces = append(namespaces, name)
+			}
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
+// historyOf returns the history of the resources of namespace, kept in the
+// directory of the namespace, or nil if the client records none.
+func (f *Client) historyOf(namespace string) *config.History {
+	if f.history == nil || namespace == f.namespace {
+		return f.history
+	}
+	return config.NewHistory(f.namespacePath(f.basePath, namespace))
+}
+
+// recordSave records a write of the resource file in the history of its
+// namespace. The write already happened, so a failure is only logged.
+func (f *Client) recordSave(m resourceMeta, namespace, name string, previous, data []byte) {
+	history := f.historyOf(namespace)
+	if history == nil {
+		return
+	}
+	if err := history.RecordSave(m.dir, name, previous, data); err != nil {
+		logging.Warn("fs-client", "Failed to record history of %s %s: %v", m.gr.Resource, name, err)
+	}
+}
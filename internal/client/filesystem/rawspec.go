@@ -0,0 +1,76 @@
+package filesystem
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+
+	"filippo.io/age/armor"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/giantswarm/muster/internal/config"
+)
+
+// keepRawSpec keeps the spec of the file at filePath, with its ${VAR}
+// references and age encrypted values, when the update in data leaves the
+// resolved spec unchanged. Status updates rewrite the whole file and would
+// otherwise replace the references with the current environment's values and
+// the secrets with their plain text.
+func keepRawSpec(filePath string, obj client.Object, data []byte) []byte {
+	current, err := os.ReadFile(filePath) //nolint:gosec
+	if err != nil || (!bytes.Contains(current, []byte("${")) && !bytes.Contains(current, []byte(armor.Header))) {
+		return data
+	}
+	raw, updated, unchanged := specUnchanged(current, obj, data)
+	if !unchanged {
+		return data
+	}
+	updated["spec"] = raw["spec"]
+	preserved, err := yaml.Marshal(updated)
+	if err != nil {
+		return data
+	}
+	return preserved
+}
+
+// specUnchanged reports whether the update in data leaves the spec of the
+// stored file contents current unchanged once they are decrypted and
+// expanded, returning both documents.
+func specUnchanged(current []byte, obj client.Object, data []byte) (raw, updated map[string]interface{}, unchanged bool) {
+	resolved, err := config.Decrypt(current)
+	if err != nil {
+		return nil, nil, false
+	}
+	resolved, err = config.ExpandEnv(resolved)
+	if err != nil {
+		return nil, nil, false
+	}
+	updated, unchanged = resolvedSpecUnchanged(resolved, obj, data)
+	if updated == nil || yaml.Unmarshal(current, &raw) != nil {
+		return nil, nil, false
+	}
+	return raw, updated, unchanged
+}
+
+// resolvedSpecUnchanged reports whether the update in data leaves the spec of
+// the decrypted and expanded definition resolved unchanged, returning the
+// updated document.
+func resolvedSpecUnchanged(resolved []byte, obj client.Object, data []byte) (updated map[string]interface{}, unchanged bool) {
+	// Decode the resolved file like getResource so defaults and omitted
+	// fields do not count as spec changes
+	stored, ok := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+	if !ok || yaml.Unmarshal(resolved, stored) != nil {
+		return nil, false
+	}
+	storedData, err := yaml.Marshal(stored)
+	if err != nil {
+		return nil, false
+	}
+
+	var was map[string]interface{}
+	if yaml.Unmarshal(storedData, &was) != nil || yaml.Unmarshal(data, &updated) != nil {
+		return nil, false
+	}
+	return updated, reflect.DeepEqual(was["spec"], updated["spec"])
+}
@@ -0,0 +1,99 @@
+package filesystem
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// selectableFields are the fields field selectors can match, the ones the
+// Kubernetes API server supports for every custom resource.
+var selectableFields = map[string]bool{
+	"metadata.name":      true,
+	"metadata.namespace": true,
+}
+
+// listFilter holds the label and field selectors of list options, so List and
+// Watch select the same resources as in Kubernetes mode.
+type listFilter struct {
+	labels labels.Selector
+	fields fields.Selector
+}
+
+// newListFilter returns the filter of the selectors in opts. Like the
+// Kubernetes API server, it rejects field selectors on other fields than
+// metadata.name and metadata.namespace.
+func newListFilter(opts []client.ListOption) (*listFilter, error) {
+	listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+	lf := &listFilter{labels: listOpts.LabelSelector, fields: listOpts.FieldSelector}
+	if lf.fields != nil {
+		for _, requirement := range lf.fields.Requirements() {
+			if !selectableFields[requirement.Field] {
+				return nil, fmt.Errorf("field label not supported: %s", requirement.Field)
+			}
+		}
+	}
+	return lf, nil
+}
+
+// matches reports whether obj is selected.
+func (lf *listFilter) matches(obj client.Object) bool {
+	if lf.labels != nil && !lf.labels.Matches(labels.Set(obj.GetLabels())) {
+		return false
+	}
+	if lf.fields != nil && !lf.fields.Matches(fields.Set{
+		"metadata.name":      obj.GetName(),
+		"metadata.namespace": obj.GetNamespace(),
+	}) {
+		return false
+	}
+	return true
+}
+
+// filterList removes the items of list that are not selected.
+func (lf *listFilter) filterList(list client.ObjectList) error {
+	if lf.labels == nil && lf.fields == nil {
+		return nil
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	selected := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		if obj, ok := item.(client.Object); ok && lf.matches(obj) {
+			selected = append(selected, item)
+		}
+	}
+	return meta.SetList(list, selected)
+}
+
+// watchEvent converts the event of a resource that changed from previous to
+// obj into the event of the selected resources, or "" if there is none. As
+// with Kubernetes watches, a resource that becomes selected is Added, and one
+// that is no longer selected is Deleted.
+func (lf *listFilter) watchEvent(eventType watch.EventType, previous, obj client.Object) (watch.EventType, client.Object) {
+	switch eventType {
+	case watch.Added, watch.Deleted:
+		if !lf.matches(obj) {
+			return "", nil
+		}
+		return eventType, obj
+	case watch.Modified:
+		was, is := lf.matches(previous), lf.matches(obj)
+		switch {
+		case was && is:
+			return watch.Modified, obj
+		case is:
+			return watch.Added, obj
+		case was:
+			return watch.Deleted, obj
+		}
+	}
+	return "", nil
+}
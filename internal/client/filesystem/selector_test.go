@@ -0,0 +1,96 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+func TestClient_ListSelectors(t *testing.T) {
+	ctx := context.Background()
+	c := New(t.TempDir())
+	for name, tier := range map[string]string{"api": "backend", "db": "backend", "web": "frontend"} {
+		server := newMCPServer(name, name)
+		server.Labels = map[string]string{"tier": tier}
+		require.NoError(t, c.CreateMCPServer(ctx, server))
+	}
+
+	tests := []struct {
+		name    string
+		opts    []client.ListOption
+		want    []string
+		wantErr string
+	}{
+		{name: "no selector", want: []string{"api", "db", "web"}},
+		{name: "matching labels", opts: []client.ListOption{client.MatchingLabels{"tier": "backend"}}, want: []string{"api", "db"}},
+		{name: "label selector without matches", opts: []client.ListOption{client.MatchingLabels{"tier": "cache"}}, want: []string{}},
+		{name: "missing label", opts: []client.ListOption{client.HasLabels{"team"}}, want: []string{}},
+		{name: "name field", opts: []client.ListOption{client.MatchingFields{"metadata.name": "web"}}, want: []string{"web"}},
+		{name: "namespace field", opts: []client.ListOption{client.MatchingFields{"metadata.namespace": "other"}}, want: []string{}},
+		{
+			name: "labels and fields",
+			opts: []client.ListOption{
+				client.MatchingLabels{"tier": "backend"},
+				client.MatchingFieldsSelector{Selector: fields.OneTermNotEqualSelector("metadata.name", "api")},
+			},
+			want: []string{"db"},
+		},
+		{name: "unsupported field", opts: []client.ListOption{client.MatchingFields{"spec.type": "stdio"}}, wantErr: "field label not supported: spec.type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var list musterv1alpha1.MCPServerList
+			err := c.List(ctx, &list, tt.opts...)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			names := []string{}
+			for _, server := range list.Items {
+				names = append(names, server.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestClient_WatchSelectors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := New(t.TempDir())
+	server := newMCPServer("server", "original")
+	server.Labels = map[string]string{"tier": "frontend"}
+	require.NoError(t, c.CreateMCPServer(ctx, server))
+
+	w, err := c.Watch(ctx, &musterv1alpha1.MCPServerList{}, client.MatchingLabels{"tier": "backend"})
+	require.NoError(t, err)
+	defer w.Stop()
+
+	// A resource that becomes selected is Added, one that is no longer
+	// selected is Deleted
+	server.Labels["tier"] = "backend"
+	require.NoError(t, c.UpdateMCPServer(ctx, server))
+	eventType, name, _ := nextEvent(t, w)
+	assert.Equal(t, watch.Added, eventType)
+	assert.Equal(t, "server", name)
+
+	server.Spec.Command = "updated"
+	require.NoError(t, c.UpdateMCPServer(ctx, server))
+	eventType, _, command := nextEvent(t, w)
+	assert.Equal(t, watch.Modified, eventType)
+	assert.Equal(t, "updated", command)
+
+	server.Labels["tier"] = "frontend"
+	require.NoError(t, c.UpdateMCPServer(ctx, server))
+	eventType, _, _ = nextEvent(t, w)
+	assert.Equal(t, watch.Deleted, eventType)
+}
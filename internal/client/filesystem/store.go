@@ -6,22 +6,24 @@ import (
 	"path/filepath"
 
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/meta"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
+	"github.com/giantswarm/muster/internal/config"
 	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
-
 	"github.com/giantswarm/muster/pkg/logging"
 )
 
 // resourceMeta is the per-CRD persistence config: the GroupResource used in
-// NotFound/AlreadyExists errors and the storage directory under basePath.
+// NotFound/AlreadyExists errors, the storage directory under basePath, the
+// JSON Schema files are validated against, and the allocator of the typed
+// objects files are read into.
 type resourceMeta struct {
-	gr  schema.GroupResource
-	dir string
+	gr         schema.GroupResource
+	dir        string
+	schemaName string
+	newObject  func() client.Object
 }
 
 func (m resourceMeta) dirPath(basePath string) string {
@@ -34,128 +36,306 @@ func (m resourceMeta) filePath(basePath, name string) string {
 
 var (
 	mcpServerMeta = resourceMeta{
-		gr:  schema.GroupResource{Group: musterv1alpha1.GroupVersion.Group, Resource: "mcpservers"},
-		dir: "mcpservers",
+		gr:         schema.GroupResource{Group: musterv1alpha1.GroupVersion.Group, Resource: "mcpservers"},
+		dir:        "mcpservers",
+		schemaName: config.SchemaMCPServer,
+		newObject:  func() client.Object { return &musterv1alpha1.MCPServer{} },
 	}
 	workflowMeta = resourceMeta{
-		gr:  schema.GroupResource{Group: musterv1alpha1.GroupVersion.Group, Resource: "workflows"},
-		dir: "workflows",
+		gr:         schema.GroupResource{Group: musterv1alpha1.GroupVersion.Group, Resource: "workflows"},
+		dir:        "workflows",
+		schemaName: config.SchemaWorkflow,
+		newObject:  func() client.Object { return &musterv1alpha1.Workflow{} },
 	}
 )
 
-// getResource reads a YAML file into obj. Caller allocates obj (matches the
-// controller-runtime client.Get convention).
-func (f *Client) getResource(name string, obj client.Object, m resourceMeta) error {
-	filePath := m.filePath(f.basePath, name)
+// overlayPath returns the file of the selected profile that overlays the
+// named resource, or "" without a profile.
+func (f *Client) overlayPath(namespace, name string, m resourceMeta) string {
+	if f.profile == "" {
+		return ""
+	}
+	return m.filePath(f.namespacePath(config.ProfilePath(f.basePath, f.profile), namespace), name)
+}
 
-	data, err := os.ReadFile(filePath) //nolint:gosec
+// overlaid returns the profile file overlaying the named resource, or "" if
+// there is none.
+func (f *Client) overlaid(namespace, name string, m resourceMeta) string {
+	overlayPath := f.overlayPath(namespace, name, m)
+	if overlayPath == "" {
+		return ""
+	}
+	if _, err := os.Stat(overlayPath); err != nil {
+		return ""
+	}
+	return overlayPath
+}
+
+// getResource reads a YAML file of namespace into obj. Caller allocates obj
+// (matches the controller-runtime client.Get convention).
+func (f *Client) getResource(namespace, name string, obj client.Object, m resourceMeta) error {
+	data, source, err := f.readResource(namespace, name, m)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return errors.NewNotFound(m.gr, name)
-		}
-		return fmt.Errorf("failed to read %s file %s: %w", m.gr.Resource, filePath, err)
+		return err
 	}
 
 	if err := yaml.Unmarshal(data, obj); err != nil {
-		return fmt.Errorf("failed to unmarshal %s from %s: %w", m.gr.Resource, filePath, err)
+		return fmt.Errorf("failed to unmarshal %s from %s: %w", m.gr.Resource, source, err)
 	}
 
 	if obj.GetName() == "" {
 		obj.SetName(name)
 	}
-	if obj.GetNamespace() == "" {
-		obj.SetNamespace(defaultNamespace)
-	}
+	// The directory of a file, not its contents, selects the namespace
+	obj.SetNamespace(namespace)
+	obj.SetResourceVersion(resourceVersion(data))
 	return nil
 }
 
-// listResources populates list.Items by reading every YAML file under the
-// resource directory. factory allocates a fresh typed object per file.
-// Bad files are logged and skipped — same behaviour as before the refactor.
-func (f *Client) listResources(list client.ObjectList, factory func() client.Object, m resourceMeta) error {
-	dirPath := m.dirPath(f.basePath)
+// readResource returns the resolved definition of the named resource of
+// namespace, and the files it was read from for messages. With a profile, the
+// file of the same name in the profile is merged over the resource file, and
+// a resource may be defined in the profile alone.
+func (f *Client) readResource(namespace, name string, m resourceMeta) ([]byte, string, error) {
+	filePath := m.filePath(f.namespacePath(f.basePath, namespace), name)
+	data, err := readResourceFile(filePath, m, false)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, "", err
+	}
+	found := err == nil
+
+	overlayPath := f.overlayPath(namespace, name, m)
+	if overlayPath == "" {
+		if !found {
+			return nil, "", errors.NewNotFound(m.gr, name)
+		}
+		return data, filePath, nil
+	}
+	// Alone, the profile file has to be a complete definition
+	overlay, err := readResourceFile(overlayPath, m, found)
+	switch {
+	case err != nil && !os.IsNotExist(err):
+		return nil, "", err
+	case err != nil && !found:
+		return nil, "", errors.NewNotFound(m.gr, name)
+	case err != nil:
+		return data, filePath, nil
+	case !found:
+		return overlay, overlayPath, nil
+	}
+	merged, err := config.MergeYAML(data, overlay)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to merge %s file %s: %w", m.gr.Resource, overlayPath, err)
+	}
+	return merged, filePath + " with " + overlayPath, nil
+}
 
-	entries, err := os.ReadDir(dirPath)
+// readResourceFile reads a resource file, decrypts it, expands its
+// environment variable references, and checks it against the schema, as a
+// profile file overlaying another if overlay is set. A missing file is
+// reported with the error of os.ReadFile.
+func readResourceFile(filePath string, m resourceMeta, overlay bool) ([]byte, error) {
+	data, err := os.ReadFile(filePath) //nolint:gosec
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil
+			return nil, err
 		}
-		return fmt.Errorf("failed to read directory %s: %w", dirPath, err)
+		return nil, fmt.Errorf("failed to read %s file %s: %w", m.gr.Resource, filePath, err)
 	}
 
-	var items []runtime.Object
-	for _, entry := range entries {
-		if entry.IsDir() || !isYAMLFile(entry.Name()) {
-			continue
-		}
-		name := getNameFromFileName(entry.Name())
-		obj := factory()
-		if err := f.getResource(name, obj, m); err != nil {
-			logging.Error("fs-client", err, "Failed to load %s %s", m.gr.Resource, entry.Name())
-			continue
-		}
-		items = append(items, obj)
+	data, err = config.Decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s file %s: %w", m.gr.Resource, filePath, err)
 	}
-	return meta.SetList(list, items)
+	data, err = config.ExpandEnv(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand environment variables in %s file %s: %w", m.gr.Resource, filePath, err)
+	}
+	validate := config.ValidateSchema
+	if overlay {
+		validate = config.ValidateOverlaySchema
+	}
+	if err := validate(m.schemaName, filePath, data); err != nil {
+		return nil, fmt.Errorf("invalid %s file %s: %w", m.gr.Resource, filePath, err)
+	}
+	return data, nil
 }
 
-// createResource writes obj to its YAML file. Returns AlreadyExists if the
-// file is already present.
+// createResource writes obj to its YAML file in the directory of its
+// namespace. Returns AlreadyExists if the file is already present.
 func (f *Client) createResource(obj client.Object, m resourceMeta) error {
-	filePath := m.filePath(f.basePath, obj.GetName())
-	if _, err := os.Stat(filePath); err == nil {
+	namespace, err := f.resolveNamespace(obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+	obj.SetNamespace(namespace)
+
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
+
+	filePath := m.filePath(f.namespacePath(f.basePath, namespace), obj.GetName())
+	if _, err := os.Stat(filePath); err == nil || f.overlaid(namespace, obj.GetName(), m) != "" {
 		return errors.NewAlreadyExists(m.gr, obj.GetName())
 	}
 
-	dirPath := m.dirPath(f.basePath)
+	dirPath := m.dirPath(f.namespacePath(f.basePath, namespace))
 	if err := os.MkdirAll(dirPath, 0755); err != nil { //nolint:gosec
 		return fmt.Errorf("failed to create directory %s: %w", dirPath, err)
 	}
 
-	if obj.GetNamespace() == "" {
-		obj.SetNamespace(defaultNamespace)
-	}
-
-	data, err := yaml.Marshal(obj)
+	data, err := marshalResource(obj)
 	if err != nil {
 		return fmt.Errorf("failed to marshal %s %s: %w", m.gr.Resource, obj.GetName(), err)
 	}
 	if err := atomicWriteFile(filePath, data, 0644); err != nil {
 		return fmt.Errorf("failed to write %s file %s: %w", m.gr.Resource, filePath, err)
 	}
+	f.recordSave(m, namespace, obj.GetName(), nil, data)
+	f.refreshCache(namespace, obj.GetName(), m)
+	f.setResourceVersion(obj, m)
 	return nil
 }
 
-// updateResource rewrites obj's YAML file. Returns NotFound if the file is
-// missing.
+// updateResource rewrites obj's YAML file in the directory of its namespace.
+// Returns NotFound if the file is missing.
 func (f *Client) updateResource(obj client.Object, m resourceMeta) error {
-	filePath := m.filePath(f.basePath, obj.GetName())
+	namespace, err := f.resolveNamespace(obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+	obj.SetNamespace(namespace)
+
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
+
+	if overlayPath := f.overlaid(namespace, obj.GetName(), m); overlayPath != "" {
+		return f.updateOverlaidResource(obj, m, overlayPath)
+	}
+
+	filePath := m.filePath(f.namespacePath(f.basePath, namespace), obj.GetName())
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
 		return errors.NewNotFound(m.gr, obj.GetName())
 	}
-
-	if obj.GetNamespace() == "" {
-		obj.SetNamespace(defaultNamespace)
+	if err := f.checkResourceVersion(obj, m); err != nil {
+		return err
 	}
 
-	data, err := yaml.Marshal(obj)
+	data, err := marshalResource(obj)
 	if err != nil {
 		return fmt.Errorf("failed to marshal %s %s: %w", m.gr.Resource, obj.GetName(), err)
 	}
+	current, readErr := os.ReadFile(filePath) //nolint:gosec
+	if readErr == nil && config.IsSOPSEncrypted(current) {
+		// Rewriting the file would store the secrets in plain text and
+		// invalidate its MAC, so the status of the resource is not persisted
+		if _, _, unchanged := specUnchanged(current, obj, data); !unchanged {
+			return fmt.Errorf("cannot update %s %s: %s is encrypted with sops, edit it with sops instead", m.gr.Resource, obj.GetName(), filePath)
+		}
+		return nil
+	}
+	// Status updates are not revisions worth rolling back to
+	specChanged := true
+	if readErr == nil {
+		_, _, unchanged := specUnchanged(current, obj, data)
+		specChanged = !unchanged
+	}
+	data = keepRawSpec(filePath, obj, data)
 	if err := atomicWriteFile(filePath, data, 0644); err != nil {
 		return fmt.Errorf("failed to write %s file %s: %w", m.gr.Resource, filePath, err)
 	}
+	if specChanged {
+		f.recordSave(m, namespace, obj.GetName(), current, data)
+	}
+	f.refreshCache(namespace, obj.GetName(), m)
+	f.setResourceVersion(obj, m)
 	return nil
 }
 
-// deleteResource removes the YAML file. Returns NotFound if missing.
-func (f *Client) deleteResource(name string, m resourceMeta) error {
-	filePath := m.filePath(f.basePath, name)
+// updateOverlaidResource updates a resource with a file in the selected
+// profile. Writing the merged definition would copy the profile's values into
+// the resource file, so only status updates are accepted, and they only
+// replace the status in the resource file, or in the profile file for a
+// resource defined in the profile alone. The namespace of obj is resolved.
+func (f *Client) updateOverlaidResource(obj client.Object, m resourceMeta, overlayPath string) error {
+	namespace := obj.GetNamespace()
+	data, err := marshalResource(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s %s: %w", m.gr.Resource, obj.GetName(), err)
+	}
+	if err := f.checkResourceVersion(obj, m); err != nil {
+		return err
+	}
+	resolved, _, err := f.readResource(namespace, obj.GetName(), m)
+	if err != nil {
+		return err
+	}
+	updated, unchanged := resolvedSpecUnchanged(resolved, obj, data)
+	if !unchanged {
+		return fmt.Errorf("cannot update %s %s: it is overlaid by %s of profile %q, edit the files instead", m.gr.Resource, obj.GetName(), overlayPath, f.profile)
+	}
+
+	filePath := m.filePath(f.namespacePath(f.basePath, namespace), obj.GetName())
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		filePath = overlayPath
+	}
+	current, err := os.ReadFile(filePath) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("failed to read %s file %s: %w", m.gr.Resource, filePath, err)
+	}
+	if config.IsSOPSEncrypted(current) {
+		return nil
+	}
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(current, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal %s from %s: %w", m.gr.Resource, filePath, err)
+	}
+	if raw == nil {
+		raw = map[string]interface{}{}
+	}
+	if status, ok := updated["status"]; ok {
+		raw["status"] = status
+	} else {
+		delete(raw, "status")
+	}
+	data, err = yaml.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s %s: %w", m.gr.Resource, obj.GetName(), err)
+	}
+	if err := atomicWriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s file %s: %w", m.gr.Resource, filePath, err)
+	}
+	f.refreshCache(namespace, obj.GetName(), m)
+	f.setResourceVersion(obj, m)
+	return nil
+}
+
+// deleteResource removes the YAML file of the named resource of namespace.
+// Returns NotFound if missing.
+func (f *Client) deleteResource(namespace, name string, m resourceMeta) error {
+	namespace, err := f.resolveNamespace(namespace)
+	if err != nil {
+		return err
+	}
+
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
+
+	if overlayPath := f.overlaid(namespace, name, m); overlayPath != "" {
+		return fmt.Errorf("cannot delete %s %s: it is overlaid by %s of profile %q, remove the files instead", m.gr.Resource, name, overlayPath, f.profile)
+	}
+	filePath := m.filePath(f.namespacePath(f.basePath, namespace), name)
+	previous, err := os.ReadFile(filePath) //nolint:gosec
+	if os.IsNotExist(err) {
 		return errors.NewNotFound(m.gr, name)
 	}
 	if err := os.Remove(filePath); err != nil {
 		return fmt.Errorf("failed to delete %s file %s: %w", m.gr.Resource, filePath, err)
 	}
+	if history := f.historyOf(namespace); history != nil {
+		if err := history.RecordDelete(m.dir, name, previous); err != nil {
+			logging.Warn("fs-client", "Failed to record history of %s %s: %v", m.gr.Resource, name, err)
+		}
+	}
+	f.refreshCache(namespace, name, m)
 	return nil
 }
@@ -0,0 +1,137 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// newMCPServer returns an MCPServer that runs command over stdio.
+func newMCPServer(name, command string) *musterv1alpha1.MCPServer {
+	return &musterv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       musterv1alpha1.MCPServerSpec{Type: "stdio", Command: command},
+	}
+}
+
+// writeResourceFile writes a resource file to dir like an editor would.
+func writeResourceFile(t *testing.T, dir, path, content string) {
+	t.Helper()
+	file := filepath.Join(dir, path)
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+	require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+}
+
+// editedServer is the file of the MCPServer "server" after an edit outside
+// the client.
+const editedServer = `metadata:
+  name: server
+spec:
+  type: stdio
+  command: edited
+`
+
+func TestClient_ResourceVersion(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		// update modifies the server read after its creation, before it is
+		// updated
+		update       func(t *testing.T, c *Client, server *musterv1alpha1.MCPServer)
+		wantConflict bool
+		wantCommand  string
+	}{
+		{
+			name: "current resourceVersion",
+			update: func(t *testing.T, c *Client, server *musterv1alpha1.MCPServer) {
+				server.Spec.Command = "updated"
+			},
+			wantCommand: "updated",
+		},
+		{
+			name: "stale resourceVersion after an update through the client",
+			update: func(t *testing.T, c *Client, server *musterv1alpha1.MCPServer) {
+				other := server.DeepCopy()
+				other.Spec.Command = "other"
+				require.NoError(t, c.UpdateMCPServer(ctx, other))
+				server.Spec.Command = "updated"
+			},
+			wantConflict: true,
+			wantCommand:  "other",
+		},
+		{
+			name: "stale resourceVersion after an edit of the file",
+			update: func(t *testing.T, c *Client, server *musterv1alpha1.MCPServer) {
+				writeResourceFile(t, c.basePath, "mcpservers/server.yaml", editedServer)
+				server.Spec.Command = "updated"
+			},
+			wantConflict: true,
+			wantCommand:  "edited",
+		},
+		{
+			name: "no resourceVersion is unconditional",
+			update: func(t *testing.T, c *Client, server *musterv1alpha1.MCPServer) {
+				writeResourceFile(t, c.basePath, "mcpservers/server.yaml", editedServer)
+				server.Spec.Command = "updated"
+				server.SetResourceVersion("")
+			},
+			wantCommand: "updated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(t.TempDir())
+			require.NoError(t, c.CreateMCPServer(ctx, newMCPServer("server", "original")))
+			server, err := c.GetMCPServer(ctx, "server", "")
+			require.NoError(t, err)
+			require.NotEmpty(t, server.GetResourceVersion())
+
+			tt.update(t, c, server)
+			err = c.UpdateMCPServer(ctx, server)
+			if tt.wantConflict {
+				assert.True(t, errors.IsConflict(err), "expected a Conflict, got %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			stored, err := c.GetMCPServer(ctx, "server", "")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCommand, stored.Spec.Command)
+		})
+	}
+}
+
+func TestClient_ResourceVersionAfterWrite(t *testing.T) {
+	ctx := context.Background()
+	c := New(t.TempDir())
+
+	server := newMCPServer("server", "original")
+	require.NoError(t, c.CreateMCPServer(ctx, server))
+	created := server.GetResourceVersion()
+	require.NotEmpty(t, created, "create sets the resourceVersion")
+	stored, err := c.GetMCPServer(ctx, "server", "")
+	require.NoError(t, err)
+	assert.Equal(t, created, stored.GetResourceVersion())
+
+	// The object written can be updated again without reading it first
+	server.Spec.Command = "updated"
+	require.NoError(t, c.UpdateMCPServer(ctx, server))
+	updated := server.GetResourceVersion()
+	assert.NotEqual(t, created, updated, "update changes the resourceVersion")
+	stored, err = c.GetMCPServer(ctx, "server", "")
+	require.NoError(t, err)
+	assert.Equal(t, updated, stored.GetResourceVersion())
+
+	server.Spec.Command = "again"
+	require.NoError(t, c.UpdateMCPServer(ctx, server))
+	assert.NotEqual(t, updated, server.GetResourceVersion())
+}
@@ -0,0 +1,62 @@
+package filesystem
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// resourceVersion returns the resourceVersion of a resource with the resolved
+// definition data. It is a hash of the contents, so it changes with every
+// write of the resource's files, by muster or anyone else.
+func resourceVersion(data []byte) string {
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return strconv.FormatUint(h.Sum64(), 10)
+}
+
+// marshalResource marshals obj for storage without its resourceVersion, which
+// is derived from the stored contents instead.
+func marshalResource(obj client.Object) ([]byte, error) {
+	version := obj.GetResourceVersion()
+	obj.SetResourceVersion("")
+	defer obj.SetResourceVersion(version)
+	return yaml.Marshal(obj)
+}
+
+// checkResourceVersion returns a Conflict error if obj has a resourceVersion
+// other than the current one of the stored resource, i.e. it was read before
+// the last write. As in Kubernetes, an update without a resourceVersion is
+// unconditional. Caller must hold writeMu.
+func (f *Client) checkResourceVersion(obj client.Object, m resourceMeta) error {
+	if obj.GetResourceVersion() == "" {
+		return nil
+	}
+	data, _, err := f.readResource(obj.GetNamespace(), obj.GetName(), m)
+	if err != nil {
+		return err
+	}
+	if resourceVersion(data) != obj.GetResourceVersion() {
+		// Whatever obj was read from is stale, so the retry reads the latest
+		// version
+		f.refreshCache(obj.GetNamespace(), obj.GetName(), m)
+		return errors.NewConflict(m.gr, obj.GetName(), fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+	}
+	return nil
+}
+
+// setResourceVersion sets the resourceVersion of obj to the one of the stored
+// resource after a write, so obj can be updated again like after a
+// Kubernetes update.
+func (f *Client) setResourceVersion(obj client.Object, m resourceMeta) {
+	data, _, err := f.readResource(obj.GetNamespace(), obj.GetName(), m)
+	if err != nil {
+		obj.SetResourceVersion("")
+		return
+	}
+	obj.SetResourceVersion(resourceVersion(data))
+}
@@ -0,0 +1,205 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/muster/internal/config"
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+var _ client.WithWatch = (*Client)(nil)
+
+// resourceWatch watches the files of one resource type in one namespace, and
+// the profile files overlaying them, with fsnotify.
+type resourceWatch struct {
+	m         resourceMeta
+	namespace string
+	watcher   *fsnotify.Watcher
+}
+
+// Watch watches the MCPServers or Workflows of list (implements
+// client.WithWatch interface). Like a Kubernetes watch without a
+// resourceVersion, it first sends an Added event for each existing resource,
+// then Added, Modified, and Deleted events as their files, and the profile
+// files overlaying them, change. Changes are detected with fsnotify; a file
+// rewritten without changing the resource sends no event, and a file that
+// fails to load is logged and skipped until it is fixed. Label and field
+// selectors are applied like in List. Unlike List, a watch covers a single
+// namespace: the one of opts, or else the one the client runs in. A watch
+// cannot be paged or resumed, so the Limit, Continue, and Raw options are
+// rejected as a BadRequest. The watch ends when ctx is cancelled or it is
+// stopped.
+func (f *Client) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+	if listOpts.Limit != 0 || listOpts.Continue != "" || listOpts.Raw != nil {
+		return nil, errors.NewBadRequest("filesystem client watches do not support the limit, continue, and raw list options")
+	}
+	filter, err := newListFilter(opts)
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := f.resolveNamespace(listOpts.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var m resourceMeta
+	switch list.(type) {
+	case *musterv1alpha1.MCPServerList:
+		m = mcpServerMeta
+	case *musterv1alpha1.WorkflowList:
+		m = workflowMeta
+	default:
+		return nil, fmt.Errorf("filesystem client does not support watching type %T", list)
+	}
+	rw, known, err := f.watchResources(namespace, m)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(chan watch.Event)
+	w := watch.NewProxyWatcher(result)
+	go func() {
+		defer close(result)
+		defer func() { _ = rw.watcher.Close() }()
+
+		send := func(eventType watch.EventType, obj client.Object) bool {
+			select {
+			case result <- watch.Event{Type: eventType, Object: obj}:
+				return true
+			case <-w.StopChan():
+				return false
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		names := make([]string, 0, len(known))
+		for name := range known {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if filter.matches(known[name]) && !send(watch.Added, known[name]) {
+				return
+			}
+		}
+
+		rw.run(ctx, w.StopChan(), func(name string) bool {
+			previous := known[name]
+			eventType, obj := f.resync(rw.namespace, name, rw.m, known)
+			eventType, obj = filter.watchEvent(eventType, previous, obj)
+			return eventType == "" || send(eventType, obj)
+		})
+	}()
+	return w, nil
+}
+
+// watchResources starts watching the files of the resources of m in
+// namespace, and returns the watch with the current resources by name. They
+// are read after the watch started, so no change is missed in between.
+func (f *Client) watchResources(namespace string, m resourceMeta) (*resourceWatch, map[string]client.Object, error) {
+	dirPath := m.dirPath(f.namespacePath(f.basePath, namespace))
+	if err := os.MkdirAll(dirPath, 0755); err != nil { //nolint:gosec
+		return nil, nil, fmt.Errorf("failed to create directory %s: %w", dirPath, err)
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create %s watcher: %w", m.gr.Resource, err)
+	}
+	if err := watcher.Add(dirPath); err != nil {
+		_ = watcher.Close()
+		return nil, nil, fmt.Errorf("failed to watch directory %s: %w", dirPath, err)
+	}
+	// Profile directories are optional, so they are only watched if present
+	if f.profile != "" {
+		profilePath := m.dirPath(f.namespacePath(config.ProfilePath(f.basePath, f.profile), namespace))
+		if info, err := os.Stat(profilePath); err == nil && info.IsDir() {
+			if err := watcher.Add(profilePath); err != nil {
+				_ = watcher.Close()
+				return nil, nil, fmt.Errorf("failed to watch directory %s: %w", profilePath, err)
+			}
+		}
+	}
+
+	objs, err := f.readResources(namespace, m)
+	if err != nil {
+		_ = watcher.Close()
+		return nil, nil, err
+	}
+	known := make(map[string]client.Object, len(objs))
+	for _, obj := range objs {
+		known[obj.GetName()] = obj
+	}
+	return &resourceWatch{m: m, namespace: namespace, watcher: watcher}, known, nil
+}
+
+// run calls changed with the name of each resource whose files change, until
+// ctx is cancelled, stop is closed, or changed returns false.
+func (rw *resourceWatch) run(ctx context.Context, stop <-chan struct{}, changed func(name string) bool) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		case event, ok := <-rw.watcher.Events:
+			if !ok {
+				return
+			}
+			fileName := filepath.Base(event.Name)
+			if !isYAMLFile(fileName) {
+				continue
+			}
+			if !changed(getNameFromFileName(fileName)) {
+				return
+			}
+		case err, ok := <-rw.watcher.Errors:
+			if !ok {
+				return
+			}
+			logging.Warn("fs-client", "Error watching %s: %v", rw.m.gr.Resource, err)
+		}
+	}
+}
+
+// resync reads the named resource of namespace after a change to its files,
+// updates known, and returns the watch event to send, or "" if the resource
+// did not change.
+func (f *Client) resync(namespace, name string, m resourceMeta, known map[string]client.Object) (watch.EventType, client.Object) {
+	previous, existed := known[name]
+	obj := m.newObject()
+	err := f.getResource(namespace, name, obj, m)
+	switch {
+	case errors.IsNotFound(err):
+		if !existed {
+			return "", nil
+		}
+		delete(known, name)
+		return watch.Deleted, previous
+	case err != nil:
+		logging.Error("fs-client", err, "Failed to load %s %s", m.gr.Resource, name)
+		return "", nil
+	}
+
+	known[name] = obj
+	switch {
+	case !existed:
+		return watch.Added, obj
+	case equality.Semantic.DeepEqual(previous, obj):
+		return "", nil
+	default:
+		return watch.Modified, obj
+	}
+}
@@ -0,0 +1,94 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// nextEvent returns the next event of w as its type and the name and command
+// of its MCPServer.
+func nextEvent(t *testing.T, w watch.Interface) (watch.EventType, string, string) {
+	t.Helper()
+	select {
+	case event, ok := <-w.ResultChan():
+		require.True(t, ok, "watch ended")
+		server, ok := event.Object.(*musterv1alpha1.MCPServer)
+		require.True(t, ok, "unexpected object %T", event.Object)
+		return event.Type, server.Name, server.Spec.Command
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no watch event")
+		return "", "", ""
+	}
+}
+
+func TestClient_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := New(t.TempDir())
+	require.NoError(t, c.CreateMCPServer(ctx, newMCPServer("existing", "original")))
+
+	w, err := c.Watch(ctx, &musterv1alpha1.MCPServerList{})
+	require.NoError(t, err)
+	defer w.Stop()
+
+	eventType, name, command := nextEvent(t, w)
+	assert.Equal(t, []string{string(watch.Added), "existing", "original"}, []string{string(eventType), name, command})
+
+	server := newMCPServer("server", "original")
+	require.NoError(t, c.CreateMCPServer(ctx, server))
+	eventType, name, command = nextEvent(t, w)
+	assert.Equal(t, []string{string(watch.Added), "server", "original"}, []string{string(eventType), name, command})
+
+	server.Spec.Command = "updated"
+	require.NoError(t, c.UpdateMCPServer(ctx, server))
+	eventType, name, command = nextEvent(t, w)
+	assert.Equal(t, []string{string(watch.Modified), "server", "updated"}, []string{string(eventType), name, command})
+
+	require.NoError(t, c.DeleteMCPServer(ctx, "server", ""))
+	eventType, name, command = nextEvent(t, w)
+	assert.Equal(t, []string{string(watch.Deleted), "server", "updated"}, []string{string(eventType), name, command})
+
+	cancel()
+	select {
+	case _, ok := <-w.ResultChan():
+		assert.False(t, ok, "no event after the watch ended")
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "watch did not end with its context")
+	}
+}
+
+func TestClient_WatchOptions(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       []client.ListOption
+		badRequest bool
+	}{
+		{name: "namespace", opts: []client.ListOption{client.InNamespace("team-a")}},
+		{name: "label selector", opts: []client.ListOption{client.MatchingLabels{"app": "demo"}}},
+		{name: "limit", opts: []client.ListOption{client.Limit(1)}, badRequest: true},
+		{name: "continue", opts: []client.ListOption{client.Continue("token")}, badRequest: true},
+		{name: "invalid namespace", opts: []client.ListOption{client.InNamespace("Team_A")}, badRequest: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(t.TempDir())
+			w, err := c.Watch(context.Background(), &musterv1alpha1.MCPServerList{}, tt.opts...)
+			if tt.badRequest {
+				assert.True(t, errors.IsBadRequest(err), "expected a BadRequest, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			w.Stop()
+		})
+	}
+}
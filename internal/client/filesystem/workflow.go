@@ -3,22 +3,29 @@ package filesystem
 import (
 	"context"
 
-	"sigs.k8s.io/controller-runtime/pkg/client"
-
 	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
 )
 
-func (f *Client) GetWorkflow(_ context.Context, name, _ string) (*musterv1alpha1.Workflow, error) {
+func (f *Client) GetWorkflow(_ context.Context, name, namespace string) (*musterv1alpha1.Workflow, error) {
+	namespace, err := f.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
 	var obj musterv1alpha1.Workflow
-	if err := f.getResource(name, &obj, workflowMeta); err != nil {
+	if f.cachedGet(namespace, name, &obj, workflowMeta) {
+		return &obj, nil
+	}
+	if err := f.getResource(namespace, name, &obj, workflowMeta); err != nil {
 		return nil, err
 	}
 	return &obj, nil
 }
 
-func (f *Client) ListWorkflows(_ context.Context, _ string) ([]musterv1alpha1.Workflow, error) {
+// ListWorkflows lists the Workflows of namespace, or of all namespaces if it is
+// empty.
+func (f *Client) ListWorkflows(_ context.Context, namespace string) ([]musterv1alpha1.Workflow, error) {
 	var list musterv1alpha1.WorkflowList
-	err := f.listResources(&list, func() client.Object { return &musterv1alpha1.Workflow{} }, workflowMeta)
+	err := f.listResources(namespace, &list, workflowMeta)
 	return list.Items, err
 }
 
@@ -30,8 +37,8 @@ func (f *Client) UpdateWorkflow(_ context.Context, w *musterv1alpha1.Workflow) e
 	return f.updateResource(w, workflowMeta)
 }
 
-func (f *Client) DeleteWorkflow(_ context.Context, name, _ string) error {
-	return f.deleteResource(name, workflowMeta)
+func (f *Client) DeleteWorkflow(_ context.Context, name, namespace string) error {
+	return f.deleteResource(namespace, name, workflowMeta)
 }
 
 // UpdateWorkflowStatus rewrites the entire YAML — filesystem mode embeds
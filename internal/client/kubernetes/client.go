@@ -42,7 +42,7 @@ var crdFactories = map[string]func() client.Object{
 // workflow.go, events.go); this file keeps the type, constructor, scheme,
 // lifecycle methods, and discovery-based CRD validation.
 type Client struct {
-	client.Client
+	client.WithWatch
 	scheme    *runtime.Scheme
 	discovery discovery.DiscoveryInterface
 
@@ -67,7 +67,7 @@ func New(config *rest.Config) (*Client, error) {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(musterv1alpha1.AddToScheme(scheme))
 
-	k8sClient, err := client.New(config, client.Options{
+	k8sClient, err := client.NewWithWatch(config, client.Options{
 		Scheme: scheme,
 	})
 	if err != nil {
@@ -96,7 +96,7 @@ func New(config *rest.Config) (*Client, error) {
 	recorder := broadcaster.NewRecorder(scheme, corev1.EventSource{Component: sourceComponent})
 
 	c := &Client{
-		Client:             k8sClient,
+		WithWatch:          k8sClient,
 		scheme:             scheme,
 		discovery:          discoveryClient,
 		eventRecorder:      recorder,
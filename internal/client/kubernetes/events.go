@@ -14,8 +14,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/logging"
 )
 
+// correlationIDAnnotation records the correlation ID of the request an Event
+// was generated for.
+const correlationIDAnnotation = "muster.giantswarm.io/correlation-id"
+
 // CreateEvent creates a Kubernetes Event for the given object via the
 // EventBroadcaster, which aggregates duplicate events (Count) and rate-limits
 // per source/object instead of writing one Event object per call.
@@ -23,9 +28,7 @@ func (k *Client) CreateEvent(ctx context.Context, obj client.Object, reason, mes
 	if k.eventRecorder == nil {
 		return fmt.Errorf("event recorder not initialized")
 	}
-	// "%s" with message as an argument avoids interpreting any '%' the rendered
-	// message may contain as a format directive.
-	k.eventRecorder.Eventf(obj, eventType, reason, "%s", message)
+	k.recordEvent(ctx, obj, reason, message, eventType)
 	return nil
 }
 
@@ -51,10 +54,22 @@ func (k *Client) CreateEventForCRD(ctx context.Context, crdType, name, namespace
 		obj.SetNamespace(namespace)
 	}
 
-	k.eventRecorder.Eventf(obj, eventType, reason, "%s", message)
+	k.recordEvent(ctx, obj, reason, message, eventType)
 	return nil
 }
 
+// recordEvent records an Event for obj, annotated with the correlation ID of
+// ctx if it has one.
+func (k *Client) recordEvent(ctx context.Context, obj client.Object, reason, message, eventType string) {
+	// "%s" with message as an argument avoids interpreting any '%' the rendered
+	// message may contain as a format directive.
+	if id := logging.CorrelationIDFromContext(ctx); id != "" {
+		k.eventRecorder.AnnotatedEventf(obj, map[string]string{correlationIDAnnotation: id}, eventType, reason, "%s", message)
+		return
+	}
+	k.eventRecorder.Eventf(obj, eventType, reason, "%s", message)
+}
+
 // QueryEvents retrieves events from the Kubernetes Events API with filtering.
 // Time-range and source-component filtering happen client-side because the
 // Kubernetes field-selector API doesn't support those.
@@ -75,6 +90,9 @@ func (k *Client) QueryEvents(ctx context.Context, options api.EventQueryOptions)
 	if options.EventType != "" {
 		fieldSelectors = append(fieldSelectors, fmt.Sprintf("type=%s", options.EventType))
 	}
+	if options.Reason != "" {
+		fieldSelectors = append(fieldSelectors, fmt.Sprintf("reason=%s", options.Reason))
+	}
 
 	if len(fieldSelectors) > 0 {
 		listOptions.FieldSelector = fields.ParseSelectorOrDie(strings.Join(fieldSelectors, ","))
@@ -139,6 +157,9 @@ func (k *Client) WatchEvents(ctx context.Context, options api.EventQueryOptions)
 	if options.EventType != "" {
 		fieldSelectors = append(fieldSelectors, fmt.Sprintf("type=%s", options.EventType))
 	}
+	if options.Reason != "" {
+		fieldSelectors = append(fieldSelectors, fmt.Sprintf("reason=%s", options.Reason))
+	}
 	fieldSelector := strings.Join(fieldSelectors, ",")
 
 	out := make(chan api.EventResult)
@@ -222,8 +243,9 @@ func (k *Client) convertKubernetesEvent(event *corev1.Event) api.EventResult {
 	}
 
 	return api.EventResult{
-		Timestamp: timestamp,
-		Namespace: event.Namespace,
+		Timestamp:      timestamp,
+		FirstTimestamp: event.FirstTimestamp.Time,
+		Namespace:      event.Namespace,
 		InvolvedObject: api.ObjectReference{
 			APIVersion: event.InvolvedObject.APIVersion,
 			Kind:       event.InvolvedObject.Kind,
@@ -231,10 +253,11 @@ func (k *Client) convertKubernetesEvent(event *corev1.Event) api.EventResult {
 			Namespace:  event.InvolvedObject.Namespace,
 			UID:        string(event.InvolvedObject.UID),
 		},
-		Reason:  event.Reason,
-		Message: event.Message,
-		Type:    event.Type,
-		Source:  event.Source.Component,
-		Count:   event.Count,
+		Reason:        event.Reason,
+		Message:       event.Message,
+		Type:          event.Type,
+		Source:        event.Source.Component,
+		Count:         event.Count,
+		CorrelationID: event.Annotations[correlationIDAnnotation],
 	}
 }
@@ -57,7 +57,7 @@ func (k *Client) deleteResource(ctx context.Context, name, namespace, kind strin
 }
 
 func (k *Client) updateResourceStatus(ctx context.Context, kind string, obj client.Object) error {
-	if err := k.Client.Status().Update(ctx, obj); err != nil {
+	if err := k.WithWatch.Status().Update(ctx, obj); err != nil {
 		return fmt.Errorf("failed to update %s status %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err)
 	}
 	return nil
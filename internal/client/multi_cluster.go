@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+
+	"github.com/giantswarm/muster/internal/client/kubernetes"
+)
+
+// ClusterConfig configures one named Kubernetes cluster of a
+// MultiClusterClient.
+type ClusterConfig struct {
+	// Name identifies the cluster in MultiClusterClient operations
+	Name string
+
+	// Context is the kubeconfig context of the cluster (defaults to the current context)
+	Context string
+
+	// Kubeconfig is the kubeconfig file of Context (defaults to $KUBECONFIG or ~/.kube/config)
+	Kubeconfig string
+}
+
+// MultiClusterClient manages muster resources in several Kubernetes clusters,
+// for platform teams running muster in more than one cluster. Operations on a
+// single resource go through the MusterClient of the selected cluster, and
+// lists fan out across all clusters.
+type MultiClusterClient struct {
+	// names are the cluster names in configuration order
+	names    []string
+	clusters map[string]MusterClient
+
+	// namespace is the default namespace of the fan-out lists
+	namespace string
+}
+
+// NewMultiClusterClient connects to every cluster of cfg.Clusters. Like
+// NewMusterClientWithConfig in Kubernetes mode, it fails fast if a cluster
+// cannot be reached or hasn't installed muster's CRDs; there is no filesystem
+// fallback.
+func NewMultiClusterClient(cfg *MusterClientConfig) (*MultiClusterClient, error) {
+	if cfg == nil || len(cfg.Clusters) == 0 {
+		return nil, fmt.Errorf("no clusters configured")
+	}
+	m := &MultiClusterClient{clusters: map[string]MusterClient{}, namespace: cfg.Namespace}
+	if m.namespace == "" {
+		m.namespace = "default"
+	}
+	for _, cluster := range cfg.Clusters {
+		if cluster.Name == "" {
+			_ = m.Close()
+			return nil, fmt.Errorf("cluster with context %q has no name", cluster.Context)
+		}
+		if _, exists := m.clusters[cluster.Name]; exists {
+			_ = m.Close()
+			return nil, fmt.Errorf("duplicate cluster name %q", cluster.Name)
+		}
+		restConfig, err := clusterRESTConfig(cluster)
+		if err != nil {
+			_ = m.Close()
+			return nil, fmt.Errorf("failed to get Kubernetes config of cluster %s: %w", cluster.Name, err)
+		}
+		k8sClient, err := kubernetes.New(restConfig)
+		if err != nil {
+			_ = m.Close()
+			return nil, fmt.Errorf("failed to create client of cluster %s: %w", cluster.Name, err)
+		}
+		m.names = append(m.names, cluster.Name)
+		m.clusters[cluster.Name] = k8sClient
+	}
+	return m, nil
+}
+
+// clusterRESTConfig loads the REST config of the kubeconfig context of
+// cluster.
+func clusterRESTConfig(cluster ClusterConfig) (*rest.Config, error) {
+	if cluster.Kubeconfig == "" {
+		// Standard detection, including the in-cluster config
+		return ctrlconfig.GetConfigWithContext(cluster.Context)
+	}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: cluster.Kubeconfig},
+		&clientcmd.ConfigOverrides{CurrentContext: cluster.Context},
+	).ClientConfig()
+}
+
+// Clusters returns the cluster names in configuration order.
+func (m *MultiClusterClient) Clusters() []string {
+	return append([]string(nil), m.names...)
+}
+
+// Cluster returns the client of the named cluster, for operations against
+// that cluster only.
+func (m *MultiClusterClient) Cluster(name string) (MusterClient, error) {
+	c, ok := m.clusters[name]
+	if !ok {
+		known := append([]string(nil), m.names...)
+		sort.Strings(known)
+		return nil, fmt.Errorf("unknown cluster %q (known: %s)", name, strings.Join(known, ", "))
+	}
+	return c, nil
+}
+
+// ListMCPServers lists the MCPServers of namespace in every cluster
+// concurrently and returns them by cluster name. An empty namespace lists the
+// default namespace. Clusters that fail to list are missing from the result
+// and reported in the error, so the lists of the others can still be used.
+func (m *MultiClusterClient) ListMCPServers(ctx context.Context, namespace string) (map[string][]musterv1alpha1.MCPServer, error) {
+	return fanOut(ctx, m, func(ctx context.Context, c MusterClient) ([]musterv1alpha1.MCPServer, error) {
+		return c.ListMCPServers(ctx, m.namespaceOrDefault(namespace))
+	})
+}
+
+// ListWorkflows lists the Workflows of namespace in every cluster like
+// ListMCPServers.
+func (m *MultiClusterClient) ListWorkflows(ctx context.Context, namespace string) (map[string][]musterv1alpha1.Workflow, error) {
+	return fanOut(ctx, m, func(ctx context.Context, c MusterClient) ([]musterv1alpha1.Workflow, error) {
+		return c.ListWorkflows(ctx, m.namespaceOrDefault(namespace))
+	})
+}
+
+// Close closes the clients of all clusters.
+func (m *MultiClusterClient) Close() error {
+	var errs []error
+	for _, name := range m.names {
+		if err := m.clusters[name].Close(); err != nil {
+			errs = append(errs, fmt.Errorf("cluster %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *MultiClusterClient) namespaceOrDefault(namespace string) string {
+	if namespace == "" {
+		return m.namespace
+	}
+	return namespace
+}
+
+// fanOut calls list for every cluster of m concurrently and collects the
+// results by cluster name, joining the errors of the failed clusters.
+func fanOut[T any](ctx context.Context, m *MultiClusterClient, list func(context.Context, MusterClient) ([]T, error)) (map[string][]T, error) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string][]T, len(m.names))
+		errs    = make([]error, len(m.names))
+	)
+	for i, name := range m.names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			items, err := list(ctx, m.clusters[name])
+			if err != nil {
+				errs[i] = fmt.Errorf("cluster %s: %w", name, err)
+				return
+			}
+			mu.Lock()
+			results[name] = items
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return results, errors.Join(errs...)
+}
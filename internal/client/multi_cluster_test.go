@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/muster/internal/client/snapshot"
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// failingClient is a cluster whose lists fail.
+type failingClient struct {
+	MusterClient
+}
+
+func (failingClient) ListMCPServers(ctx context.Context, namespace string) ([]musterv1alpha1.MCPServer, error) {
+	return nil, errors.New("connection refused")
+}
+
+// clusterClient returns a client of a cluster with an MCPServer named after
+// the cluster in namespace.
+func clusterClient(t *testing.T, cluster, namespace string) MusterClient {
+	t.Helper()
+	c, err := snapshot.New(&musterv1alpha1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: cluster + "-server", Namespace: namespace},
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func newTestMultiClusterClient(clusters map[string]MusterClient, names ...string) *MultiClusterClient {
+	return &MultiClusterClient{names: names, clusters: clusters, namespace: "default"}
+}
+
+func TestMultiClusterClient_Cluster(t *testing.T) {
+	ctx := context.Background()
+	m := newTestMultiClusterClient(map[string]MusterClient{
+		"prod":    clusterClient(t, "prod", "default"),
+		"staging": clusterClient(t, "staging", "default"),
+	}, "staging", "prod")
+
+	assert.Equal(t, []string{"staging", "prod"}, m.Clusters())
+
+	for _, cluster := range []string{"prod", "staging"} {
+		c, err := m.Cluster(cluster)
+		require.NoError(t, err)
+		server, err := c.GetMCPServer(ctx, cluster+"-server", "")
+		require.NoError(t, err, "cluster %s has its own server", cluster)
+		assert.Equal(t, cluster+"-server", server.Name)
+	}
+
+	prod, err := m.Cluster("prod")
+	require.NoError(t, err)
+	_, err = prod.GetMCPServer(ctx, "staging-server", "")
+	assert.Error(t, err, "operations don't reach the other clusters")
+
+	_, err = m.Cluster("dev")
+	assert.EqualError(t, err, `unknown cluster "dev" (known: prod, staging)`)
+}
+
+func TestMultiClusterClient_ListMCPServers(t *testing.T) {
+	ctx := context.Background()
+	m := newTestMultiClusterClient(map[string]MusterClient{
+		"prod":    clusterClient(t, "prod", "default"),
+		"staging": clusterClient(t, "staging", "team-a"),
+		"dev":     failingClient{},
+	}, "prod", "staging", "dev")
+
+	names := func(servers map[string][]musterv1alpha1.MCPServer) map[string][]string {
+		result := map[string][]string{}
+		for cluster, items := range servers {
+			result[cluster] = []string{}
+			for _, server := range items {
+				result[cluster] = append(result[cluster], server.Name)
+			}
+		}
+		return result
+	}
+
+	servers, err := m.ListMCPServers(ctx, "")
+	assert.EqualError(t, err, "cluster dev: connection refused")
+	assert.Equal(t, map[string][]string{"prod": {"prod-server"}, "staging": {}}, names(servers))
+
+	servers, err = m.ListMCPServers(ctx, "team-a")
+	assert.EqualError(t, err, "cluster dev: connection refused")
+	assert.Equal(t, map[string][]string{"prod": {}, "staging": {"staging-server"}}, names(servers))
+}
+
+func TestNewMultiClusterClient_InvalidConfig(t *testing.T) {
+	_, err := NewMultiClusterClient(&MusterClientConfig{})
+	assert.EqualError(t, err, "no clusters configured")
+
+	_, err = NewMultiClusterClient(&MusterClientConfig{Clusters: []ClusterConfig{{Context: "kind-prod"}}})
+	assert.EqualError(t, err, `cluster with context "kind-prod" has no name`)
+}
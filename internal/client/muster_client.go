@@ -13,6 +13,7 @@ import (
 	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/internal/client/filesystem"
 	"github.com/giantswarm/muster/internal/client/kubernetes"
+	"github.com/giantswarm/muster/internal/client/snapshot"
 	"github.com/giantswarm/muster/pkg/logging"
 )
 
@@ -26,8 +27,9 @@ import (
 //
 // This abstraction allows the same code to work in both environments without modification.
 type MusterClient interface {
-	// Controller-runtime client interface for basic CRUD operations
-	client.Client
+	// Controller-runtime client interface for basic CRUD operations, and
+	// Watch for following changes to MCPServers and Workflows in both modes
+	client.WithWatch
 
 	// MCPServer operations
 	GetMCPServer(ctx context.Context, name, namespace string) (*musterv1alpha1.MCPServer, error)
@@ -66,6 +68,7 @@ type MusterClient interface {
 var (
 	_ MusterClient = (*kubernetes.Client)(nil)
 	_ MusterClient = (*filesystem.Client)(nil)
+	_ MusterClient = (*snapshot.Client)(nil)
 )
 
 // NewMusterClient creates a new unified muster client with automatic environment detection.
@@ -95,6 +98,15 @@ func NewMusterClientWithConfig(cfg *MusterClientConfig) (MusterClient, error) {
 		cfg = &MusterClientConfig{}
 	}
 
+	// A snapshot is served offline, without any backend
+	if cfg.Snapshot != "" {
+		snapshotClient, err := snapshot.Load(cfg.Snapshot, cfg.Profile, cfg.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load snapshot: %w", err)
+		}
+		return snapshotClient, nil
+	}
+
 	// Try Kubernetes configuration first
 	if restConfig, err := detectKubernetesConfig(cfg); err == nil && restConfig != nil {
 		k8sClient, err := kubernetes.New(restConfig)
@@ -110,7 +122,13 @@ func NewMusterClientWithConfig(cfg *MusterClientConfig) (MusterClient, error) {
 	}
 
 	// Fall back to filesystem mode
-	return filesystem.New(cfg.FilesystemPath), nil
+	fsClient := filesystem.NewWithProfile(cfg.FilesystemPath, cfg.Profile)
+	fsClient.SetNamespace(cfg.Namespace)
+	fsClient.SetEventRetention(cfg.EventRetention)
+	if cfg.CacheResources {
+		fsClient.EnableCache()
+	}
+	return fsClient, nil
 }
 
 // MusterClientConfig provides configuration options for client creation.
@@ -121,6 +139,23 @@ type MusterClientConfig struct {
 	// FilesystemPath is the base path for filesystem storage (defaults to current directory)
 	FilesystemPath string
 
+	// Profile is the profile in FilesystemPath overlaying the resources in filesystem mode
+	Profile string
+
+	// EventRetention bounds the events stored in filesystem mode (zero fields use the defaults)
+	EventRetention filesystem.EventRetention
+
+	// Snapshot serves reads from the configuration directory or snapshot file
+	// at this path, loaded once into memory; writes fail (see snapshot.Client)
+	Snapshot string
+
+	// Clusters are the named Kubernetes clusters of NewMultiClusterClient
+	Clusters []ClusterConfig
+
+	// CacheResources serves reads of MCPServers and Workflows in filesystem mode
+	// from an in-memory cache kept up to date by watching the files
+	CacheResources bool
+
 	// ForceFilesystemMode forces filesystem mode even if Kubernetes is available
 	ForceFilesystemMode bool
 
@@ -0,0 +1,368 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/muster/internal/api"
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// defaultNamespace is the namespace of resources and queries without one, as
+// in filesystem mode.
+const defaultNamespace = "default"
+
+// ErrReadOnly is returned by every write of a snapshot Client.
+var ErrReadOnly = errors.New("snapshot client is read-only")
+
+// Client is a read-only, in-memory implementation of the muster client
+// interface. It serves the resources it was created with, with the label
+// selectors and metadata field selectors of Kubernetes, and never reads
+// files or contacts a cluster afterwards. Writes fail with ErrReadOnly, and
+// events are logged but not stored.
+//
+// This file keeps the type, the constructor, and the controller-runtime
+// Client interface methods; the per-domain methods live in muster.go.
+type Client struct {
+	servers   *store
+	workflows *store
+}
+
+var (
+	_ client.WithWatch         = (*Client)(nil)
+	_ client.StatusWriter      = (*statusWriter)(nil)
+	_ client.SubResourceClient = (*statusWriter)(nil)
+)
+
+// New returns a Client serving copies of objs, which have to be MCPServers
+// and Workflows with unique names per namespace. Objects without a namespace
+// are in the default namespace.
+func New(objs ...client.Object) (*Client, error) {
+	c := &Client{
+		servers:   newStore(musterv1alpha1.GroupVersion.WithResource("mcpservers").GroupResource()),
+		workflows: newStore(musterv1alpha1.GroupVersion.WithResource("workflows").GroupResource()),
+	}
+	for _, obj := range objs {
+		s, err := c.storeFor(obj)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.add(obj); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// storeFor returns the store of the type of obj.
+func (c *Client) storeFor(obj runtime.Object) (*store, error) {
+	switch obj.(type) {
+	case *musterv1alpha1.MCPServer, *musterv1alpha1.MCPServerList:
+		return c.servers, nil
+	case *musterv1alpha1.Workflow, *musterv1alpha1.WorkflowList:
+		return c.workflows, nil
+	default:
+		return nil, fmt.Errorf("snapshot client does not support type %T", obj)
+	}
+}
+
+// Get retrieves a resource by name and namespace (implements client.Client interface).
+func (c *Client) Get(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+	s, err := c.storeFor(obj)
+	if err != nil {
+		return err
+	}
+	return s.get(key, obj)
+}
+
+// List retrieves a list of resources (implements client.Client interface).
+// Without a namespace, the resources of all namespaces are listed.
+func (c *Client) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	s, err := c.storeFor(list)
+	if err != nil {
+		return err
+	}
+	listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+	objs, err := s.list(listOpts)
+	if err != nil {
+		return err
+	}
+	items := make([]runtime.Object, 0, len(objs))
+	for _, obj := range objs {
+		items = append(items, obj)
+	}
+	return meta.SetList(list, items)
+}
+
+// Watch sends an Added event for each resource selected like in List
+// (implements client.WithWatch interface). The resources never change, so no
+// other events follow until the watch is stopped or ctx is cancelled.
+func (c *Client) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	s, err := c.storeFor(list)
+	if err != nil {
+		return nil, err
+	}
+	objs, err := s.list((&client.ListOptions{}).ApplyOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(chan watch.Event, len(objs))
+	for _, obj := range objs {
+		result <- watch.Event{Type: watch.Added, Object: obj}
+	}
+	w := watch.NewProxyWatcher(result)
+	go func() {
+		defer close(result)
+		select {
+		case <-w.StopChan():
+		case <-ctx.Done():
+		}
+	}()
+	return w, nil
+}
+
+// Create fails with ErrReadOnly.
+func (c *Client) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return readOnly("create", obj)
+}
+
+// Update fails with ErrReadOnly.
+func (c *Client) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return readOnly("update", obj)
+}
+
+// Delete fails with ErrReadOnly.
+func (c *Client) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return readOnly("delete", obj)
+}
+
+// Patch fails with ErrReadOnly.
+func (c *Client) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return readOnly("patch", obj)
+}
+
+// Apply fails with ErrReadOnly.
+func (c *Client) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+	return fmt.Errorf("cannot apply: %w", ErrReadOnly)
+}
+
+// DeleteAllOf fails with ErrReadOnly.
+func (c *Client) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return readOnly("delete all of", obj)
+}
+
+// Status returns a status writer failing with ErrReadOnly (implements client.Client interface).
+func (c *Client) Status() client.StatusWriter {
+	return &statusWriter{client: c}
+}
+
+// SubResource returns a sub-resource client failing with ErrReadOnly
+// (implements client.Client interface).
+func (c *Client) SubResource(subResource string) client.SubResourceClient {
+	return &statusWriter{client: c}
+}
+
+// Scheme returns the scheme (implements client.Client interface).
+func (c *Client) Scheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = musterv1alpha1.AddToScheme(scheme)
+	return scheme
+}
+
+// RESTMapper returns nil — there is no API server to map against.
+func (c *Client) RESTMapper() meta.RESTMapper {
+	return nil
+}
+
+// GroupVersionKindFor returns the GroupVersionKind for an object.
+func (c *Client) GroupVersionKindFor(obj runtime.Object) (schema.GroupVersionKind, error) {
+	switch obj.(type) {
+	case *musterv1alpha1.MCPServer:
+		return musterv1alpha1.GroupVersion.WithKind("MCPServer"), nil
+	case *musterv1alpha1.Workflow:
+		return musterv1alpha1.GroupVersion.WithKind("Workflow"), nil
+	default:
+		return schema.GroupVersionKind{}, fmt.Errorf("unknown object type %T", obj)
+	}
+}
+
+// IsObjectNamespaced — all muster resources are namespaced.
+func (c *Client) IsObjectNamespaced(obj runtime.Object) (bool, error) {
+	return true, nil
+}
+
+// IsKubernetesMode returns false since the snapshot has no cluster behind it.
+func (c *Client) IsKubernetesMode() bool {
+	return false
+}
+
+// Close is a no-op; the snapshot holds no resources besides memory.
+func (c *Client) Close() error {
+	return nil
+}
+
+// CreateEvent logs the event; snapshots don't store events.
+func (c *Client) CreateEvent(ctx context.Context, obj client.Object, reason, message, eventType string) error {
+	logging.DebugCtx(ctx, "event", "Event for %s/%s: %s - %s (%s)",
+		obj.GetNamespace(), obj.GetName(), reason, message, eventType)
+	return nil
+}
+
+// CreateEventForCRD logs the event; snapshots don't store events.
+func (c *Client) CreateEventForCRD(ctx context.Context, crdType, name, namespace, reason, message, eventType string) error {
+	logging.DebugCtx(ctx, "event", "Event for %s %s/%s: %s - %s (%s)",
+		crdType, namespace, name, reason, message, eventType)
+	return nil
+}
+
+// QueryEvents returns no events.
+func (c *Client) QueryEvents(ctx context.Context, options api.EventQueryOptions) (*api.EventQueryResult, error) {
+	return &api.EventQueryResult{Events: []api.EventResult{}}, nil
+}
+
+// WatchEvents returns a channel without events, closed when ctx is cancelled.
+func (c *Client) WatchEvents(ctx context.Context, options api.EventQueryOptions) (<-chan api.EventResult, error) {
+	out := make(chan api.EventResult)
+	go func() {
+		<-ctx.Done()
+		close(out)
+	}()
+	return out, nil
+}
+
+// readOnly returns the ErrReadOnly error of the write verb on obj.
+func readOnly(verb string, obj client.Object) error {
+	kind := fmt.Sprintf("%T", obj)
+	switch obj.(type) {
+	case *musterv1alpha1.MCPServer:
+		kind = "MCPServer"
+	case *musterv1alpha1.Workflow:
+		kind = "Workflow"
+	}
+	return fmt.Errorf("cannot %s %s %s: %w", verb, kind, obj.GetName(), ErrReadOnly)
+}
+
+// store holds the resources of one type by namespace and name.
+type store struct {
+	gr    schema.GroupResource
+	items map[types.NamespacedName]client.Object
+}
+
+func newStore(gr schema.GroupResource) *store {
+	return &store{gr: gr, items: map[types.NamespacedName]client.Object{}}
+}
+
+// add stores a copy of obj.
+func (s *store) add(obj client.Object) error {
+	obj = obj.DeepCopyObject().(client.Object)
+	if obj.GetName() == "" {
+		return fmt.Errorf("%s without name", s.gr.Resource)
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(defaultNamespace)
+	}
+	key := client.ObjectKeyFromObject(obj)
+	if _, exists := s.items[key]; exists {
+		return fmt.Errorf("duplicate %s %s", s.gr.Resource, key)
+	}
+	s.items[key] = obj
+	return nil
+}
+
+// get copies the resource of key into obj.
+func (s *store) get(key types.NamespacedName, obj client.Object) error {
+	if key.Namespace == "" {
+		key.Namespace = defaultNamespace
+	}
+	item, ok := s.items[key]
+	if !ok {
+		return apierrors.NewNotFound(s.gr, key.Name)
+	}
+	// Stored objects are shared, so callers get a copy to modify
+	reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(item.DeepCopyObject()).Elem())
+	return nil
+}
+
+// list returns copies of the resources selected by opts, sorted by namespace
+// and name. Like the Kubernetes API server, field selectors are supported on
+// metadata.name and metadata.namespace.
+func (s *store) list(opts *client.ListOptions) ([]client.Object, error) {
+	if opts.FieldSelector != nil {
+		for _, requirement := range opts.FieldSelector.Requirements() {
+			if requirement.Field != "metadata.name" && requirement.Field != "metadata.namespace" {
+				return nil, fmt.Errorf("field label not supported: %s", requirement.Field)
+			}
+		}
+	}
+
+	keys := make([]types.NamespacedName, 0, len(s.items))
+	for key, obj := range s.items {
+		if opts.Namespace != "" && key.Namespace != opts.Namespace {
+			continue
+		}
+		if opts.LabelSelector != nil && !opts.LabelSelector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		if opts.FieldSelector != nil && !opts.FieldSelector.Matches(fields.Set{
+			"metadata.name":      key.Name,
+			"metadata.namespace": key.Namespace,
+		}) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Namespace != keys[j].Namespace {
+			return keys[i].Namespace < keys[j].Namespace
+		}
+		return keys[i].Name < keys[j].Name
+	})
+
+	objs := make([]client.Object, 0, len(keys))
+	for _, key := range keys {
+		objs = append(objs, s.items[key].DeepCopyObject().(client.Object))
+	}
+	return objs, nil
+}
+
+// statusWriter implements client.StatusWriter and client.SubResourceClient
+// for the snapshot client: sub-resources can be read like their parent, and
+// every write fails with ErrReadOnly.
+type statusWriter struct {
+	client *Client
+}
+
+func (w *statusWriter) Get(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceGetOption) error {
+	return w.client.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+}
+
+func (w *statusWriter) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return readOnly("create", obj)
+}
+
+func (w *statusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return readOnly("update", obj)
+}
+
+func (w *statusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return readOnly("patch", obj)
+}
+
+func (w *statusWriter) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
+	return fmt.Errorf("cannot apply: %w", ErrReadOnly)
+}
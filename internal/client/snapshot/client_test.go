@@ -0,0 +1,135 @@
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+	c, err := New(
+		&musterv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Labels: map[string]string{"tier": "platform"}},
+			Spec:       musterv1alpha1.MCPServerSpec{Type: "stdio", Command: "mcp-kubernetes"},
+		},
+		&musterv1alpha1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "github", Namespace: "team-a"},
+			Spec:       musterv1alpha1.MCPServerSpec{Type: "stdio", Command: "mcp-github"},
+		},
+		&musterv1alpha1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "deploy"},
+		},
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func TestClient_ServesObjects(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	server, err := c.GetMCPServer(ctx, "kubernetes", "")
+	require.NoError(t, err)
+	assert.Equal(t, "default", server.Namespace)
+	assert.Equal(t, "mcp-kubernetes", server.Spec.Command)
+
+	// Callers get copies, so modifying them leaves the snapshot unchanged
+	server.Spec.Command = "modified"
+	server, err = c.GetMCPServer(ctx, "kubernetes", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "mcp-kubernetes", server.Spec.Command)
+
+	var workflow musterv1alpha1.Workflow
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "deploy"}, &workflow))
+	assert.Equal(t, "deploy", workflow.Name)
+
+	_, err = c.GetMCPServer(ctx, "github", "")
+	assert.True(t, apierrors.IsNotFound(err), "expected NotFound, got %v", err)
+}
+
+func TestClient_List(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	tests := []struct {
+		name    string
+		opts    []client.ListOption
+		want    []string
+		wantErr string
+	}{
+		{name: "all namespaces", want: []string{"default/kubernetes", "team-a/github"}},
+		{name: "namespace", opts: []client.ListOption{client.InNamespace("team-a")}, want: []string{"team-a/github"}},
+		{name: "label selector", opts: []client.ListOption{client.MatchingLabels{"tier": "platform"}}, want: []string{"default/kubernetes"}},
+		{name: "field selector", opts: []client.ListOption{client.MatchingFields{"metadata.name": "github"}}, want: []string{"team-a/github"}},
+		{name: "unsupported field", opts: []client.ListOption{client.MatchingFields{"spec.type": "stdio"}}, wantErr: "field label not supported: spec.type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var list musterv1alpha1.MCPServerList
+			err := c.List(ctx, &list, tt.opts...)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			names := []string{}
+			for _, server := range list.Items {
+				names = append(names, server.Namespace+"/"+server.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestClient_RejectsWrites(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	server, err := c.GetMCPServer(ctx, "kubernetes", "")
+	require.NoError(t, err)
+	workflow, err := c.GetWorkflow(ctx, "deploy", "")
+	require.NoError(t, err)
+
+	writes := map[string]func() error{
+		"Create":                func() error { return c.Create(ctx, server) },
+		"Update":                func() error { return c.Update(ctx, server) },
+		"Delete":                func() error { return c.Delete(ctx, server) },
+		"Patch":                 func() error { return c.Patch(ctx, server, client.MergeFrom(server)) },
+		"DeleteAllOf":           func() error { return c.DeleteAllOf(ctx, server) },
+		"Status().Update":       func() error { return c.Status().Update(ctx, server) },
+		"CreateMCPServer":       func() error { return c.CreateMCPServer(ctx, server) },
+		"UpdateMCPServer":       func() error { return c.UpdateMCPServer(ctx, server) },
+		"DeleteMCPServer":       func() error { return c.DeleteMCPServer(ctx, "kubernetes", "") },
+		"UpdateMCPServerStatus": func() error { return c.UpdateMCPServerStatus(ctx, server) },
+		"CreateWorkflow":        func() error { return c.CreateWorkflow(ctx, workflow) },
+		"UpdateWorkflow":        func() error { return c.UpdateWorkflow(ctx, workflow) },
+		"DeleteWorkflow":        func() error { return c.DeleteWorkflow(ctx, "deploy", "") },
+		"UpdateWorkflowStatus":  func() error { return c.UpdateWorkflowStatus(ctx, workflow) },
+	}
+	for name, write := range writes {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, write(), ErrReadOnly)
+		})
+	}
+
+	servers, err := c.ListMCPServers(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, servers, 2)
+}
+
+func TestNew_DuplicateObjects(t *testing.T) {
+	_, err := New(
+		&musterv1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "deploy"}},
+		&musterv1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "deploy", Namespace: "default"}},
+	)
+	assert.EqualError(t, err, "duplicate workflows default/deploy")
+}
@@ -0,0 +1,6 @@
+// Package snapshot is the read-only, in-memory implementation of the unified
+// muster client interface defined in the parent client package. Resources are
+// loaded once from a configuration directory or an exported snapshot file and
+// served without any backend, for offline tooling and unit tests. See
+// ../doc.go for the full architecture.
+package snapshot
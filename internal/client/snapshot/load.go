@@ -0,0 +1,142 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/muster/internal/client/filesystem"
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// Load loads the snapshot at path: a configuration directory (see
+// LoadDirectory) or a snapshot file (see LoadFile).
+func Load(path, profile, namespace string) (*Client, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %s: %w", path, err)
+	}
+	if info.IsDir() {
+		return LoadDirectory(path, profile, namespace)
+	}
+	if profile != "" {
+		return nil, fmt.Errorf("profiles only apply to configuration directories, %s is a file", path)
+	}
+	return LoadFile(path)
+}
+
+// LoadDirectory loads the MCPServers and Workflows of all namespaces of the
+// configuration directory path, in which muster runs in namespace, with the
+// files of the named profile merged over them, as the filesystem client
+// reads them. Files that fail to load are logged and skipped, as in
+// filesystem mode.
+func LoadDirectory(path, profile, namespace string) (*Client, error) {
+	fsClient := filesystem.NewWithProfile(path, profile)
+	fsClient.SetNamespace(namespace)
+	defer func() { _ = fsClient.Close() }()
+
+	ctx := context.Background()
+	servers, err := fsClient.ListMCPServers(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load MCP servers of %s: %w", path, err)
+	}
+	workflows, err := fsClient.ListWorkflows(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workflows of %s: %w", path, err)
+	}
+
+	objs := make([]client.Object, 0, len(servers)+len(workflows))
+	for i := range servers {
+		objs = append(objs, &servers[i])
+	}
+	for i := range workflows {
+		objs = append(objs, &workflows[i])
+	}
+	return New(objs...)
+}
+
+// LoadFile loads the snapshot file at path: a YAML stream or JSON document of
+// MCPServers and Workflows, or of Lists of them, such as the output of
+// `kubectl get mcpservers,workflows -A -o yaml`.
+func LoadFile(path string) (*Client, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %s: %w", path, err)
+	}
+	objs, err := decodeObjects(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot %s: %w", path, err)
+	}
+	return New(objs...)
+}
+
+// decodeObjects decodes the MCPServers and Workflows of the documents in data.
+func decodeObjects(data []byte) ([]client.Object, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	var objs []client.Object
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, err
+		}
+		if len(bytes.TrimSpace(raw)) == 0 || string(raw) == "null" {
+			continue
+		}
+		decoded, err := decodeObject(raw)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, decoded...)
+	}
+}
+
+// decodeObject decodes the MCPServer or Workflow in raw, or the items of the
+// List in raw.
+func decodeObject(raw json.RawMessage) ([]client.Object, error) {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, err
+	}
+
+	var obj client.Object
+	switch {
+	case typeMeta.Kind == "MCPServer":
+		obj = &musterv1alpha1.MCPServer{}
+	case typeMeta.Kind == "Workflow":
+		obj = &musterv1alpha1.Workflow{}
+	case strings.HasSuffix(typeMeta.Kind, "List"):
+		var list struct {
+			Items []json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", typeMeta.Kind, err)
+		}
+		var objs []client.Object
+		for _, item := range list.Items {
+			decoded, err := decodeObject(item)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, decoded...)
+		}
+		return objs, nil
+	default:
+		return nil, fmt.Errorf("unsupported kind %q (supported: MCPServer, Workflow, and Lists of them)", typeMeta.Kind)
+	}
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", typeMeta.Kind, err)
+	}
+	return []client.Object{obj}, nil
+}
@@ -0,0 +1,73 @@
+package snapshot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`apiVersion: v1
+kind: List
+items:
+- apiVersion: muster.giantswarm.io/v1alpha1
+  kind: MCPServer
+  metadata:
+    name: kubernetes
+    namespace: team-a
+  spec:
+    type: stdio
+    command: mcp-kubernetes
+---
+apiVersion: muster.giantswarm.io/v1alpha1
+kind: Workflow
+metadata:
+  name: deploy
+`), 0644))
+
+	c, err := Load(path, "", "")
+	require.NoError(t, err)
+	server, err := c.GetMCPServer(context.Background(), "kubernetes", "team-a")
+	require.NoError(t, err)
+	assert.Equal(t, "mcp-kubernetes", server.Spec.Command)
+	_, err = c.GetWorkflow(context.Background(), "deploy", "")
+	assert.NoError(t, err)
+
+	_, err = Load(path, "prod", "")
+	assert.EqualError(t, err, "profiles only apply to configuration directories, "+path+" is a file")
+}
+
+func TestLoadFile_UnsupportedKind(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n"), 0644))
+
+	_, err := LoadFile(path)
+	assert.ErrorContains(t, err, `unsupported kind "ConfigMap"`)
+}
+
+func TestLoadDirectory(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "mcpservers"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "mcpservers", "kubernetes.yaml"), []byte(`metadata:
+  name: kubernetes
+spec:
+  type: stdio
+  command: mcp-kubernetes
+`), 0644))
+
+	c, err := Load(dir, "", "team-a")
+	require.NoError(t, err)
+	server, err := c.GetMCPServer(context.Background(), "kubernetes", "team-a")
+	require.NoError(t, err)
+	assert.Equal(t, "mcp-kubernetes", server.Spec.Command)
+
+	// The directory is only read once
+	require.NoError(t, os.Remove(filepath.Join(dir, "mcpservers", "kubernetes.yaml")))
+	_, err = c.GetMCPServer(context.Background(), "kubernetes", "team-a")
+	assert.NoError(t, err)
+}
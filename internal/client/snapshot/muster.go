@@ -0,0 +1,71 @@
+package snapshot
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+func (c *Client) GetMCPServer(ctx context.Context, name, namespace string) (*musterv1alpha1.MCPServer, error) {
+	var obj musterv1alpha1.MCPServer
+	if err := c.servers.get(types.NamespacedName{Name: name, Namespace: namespace}, &obj); err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+func (c *Client) ListMCPServers(ctx context.Context, namespace string) ([]musterv1alpha1.MCPServer, error) {
+	var list musterv1alpha1.MCPServerList
+	err := c.List(ctx, &list, client.InNamespace(namespace))
+	return list.Items, err
+}
+
+func (c *Client) CreateMCPServer(ctx context.Context, server *musterv1alpha1.MCPServer) error {
+	return readOnly("create", server)
+}
+
+func (c *Client) UpdateMCPServer(ctx context.Context, server *musterv1alpha1.MCPServer) error {
+	return readOnly("update", server)
+}
+
+func (c *Client) DeleteMCPServer(ctx context.Context, name, namespace string) error {
+	return readOnly("delete", &musterv1alpha1.MCPServer{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})
+}
+
+func (c *Client) UpdateMCPServerStatus(ctx context.Context, server *musterv1alpha1.MCPServer) error {
+	return readOnly("update the status of", server)
+}
+
+func (c *Client) GetWorkflow(ctx context.Context, name, namespace string) (*musterv1alpha1.Workflow, error) {
+	var obj musterv1alpha1.Workflow
+	if err := c.workflows.get(types.NamespacedName{Name: name, Namespace: namespace}, &obj); err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+func (c *Client) ListWorkflows(ctx context.Context, namespace string) ([]musterv1alpha1.Workflow, error) {
+	var list musterv1alpha1.WorkflowList
+	err := c.List(ctx, &list, client.InNamespace(namespace))
+	return list.Items, err
+}
+
+func (c *Client) CreateWorkflow(ctx context.Context, workflow *musterv1alpha1.Workflow) error {
+	return readOnly("create", workflow)
+}
+
+func (c *Client) UpdateWorkflow(ctx context.Context, workflow *musterv1alpha1.Workflow) error {
+	return readOnly("update", workflow)
+}
+
+func (c *Client) DeleteWorkflow(ctx context.Context, name, namespace string) error {
+	return readOnly("delete", &musterv1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})
+}
+
+func (c *Client) UpdateWorkflowStatus(ctx context.Context, workflow *musterv1alpha1.Workflow) error {
+	return readOnly("update the status of", workflow)
+}
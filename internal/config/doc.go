@@ -76,6 +76,60 @@
 //	  enabled: true                       # Whether the aggregator is enabled (default: true)
 //	  musterPrefix: "x"                   # Pre-prefix for all tools (default: "x")
 //
+// # Environment Variables
+//
+// LoadConfig and the filesystem client expand ${NAME} and ${NAME:-default}
+// references in the values of config.yaml and the entity files with ExpandEnv
+// before decoding them. Unset variables without a default are an error, and
+// $${NAME} yields a literal ${NAME}.
+//
+// # Encrypted Secrets
+//
+// Before expanding variables, the same callers and Storage.Load decrypt files
+// encrypted with SOPS for age recipients and values that are ASCII-armored age
+// files with Decrypt. The age identities come from SOPS_AGE_KEY,
+// SOPS_AGE_KEY_FILE, or the sops keys.txt in the user config directory. The
+// filesystem client does not rewrite SOPS files: status updates are not
+// persisted and spec updates are rejected.
+//
+// # Remote Sources
+//
+// When config.yaml sets source, the application bootstrap calls SyncSource,
+// which fetches the configuration directory from an HTTP(S) tar archive, an
+// S3 bucket prefix, or a Kubernetes ConfigMap (see NewSourceStorage) and
+// copies it into a local cache directory. Muster then runs from that
+// directory as if it were the configuration path, so no other component is
+// aware of the source. The fetched copy is a read-only EntityStorage
+// (RemoteStorage); Storage is the EntityStorage of a local directory.
+//
+// # History
+//
+// History records a revision of an entity definition each time it is saved
+// or deleted, below .history in the configuration directory, and restores
+// earlier revisions with Rollback. The filesystem client records creates,
+// spec updates, and deletes; Storage records its saves and deletes once
+// SetHistory is called. The oldest revisions beyond DefaultHistoryRevisions
+// are pruned.
+//
+// # Database Storage
+//
+// SQLStorage is an EntityStorage kept in a single table of a SQLite or
+// PostgreSQL database, selected with the storage section of config.yaml.
+// NewEntityStorage returns the engine it selects; muster uses it for
+// workflow executions outside Kubernetes mode.
+//
+// # Static Validation
+//
+// ValidateDirectory checks config.yaml and every entity file in a configuration
+// directory without starting any service, returning a ValidationReport whose
+// issues carry file and line positions. It backs `muster config validate`.
+//
+// Each file is first checked against its JSON Schema, shipped in schemas/ and
+// available through Schema. LoadConfig and the filesystem entity store apply
+// the same schemas through ValidateSchema, so a malformed file fails to load
+// with the position of each problem; unknown fields are only reported by
+// ValidateDirectory.
+//
 // # Configuration API
 //
 // The configuration can be accessed and modified at runtime through the Configuration API.
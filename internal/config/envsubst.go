@@ -0,0 +1,103 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envReference matches ${NAME} and ${NAME:-default}, optionally preceded by
+// the $ that escapes a reference.
+var envReference = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// ExpandEnv replaces ${NAME} and ${NAME:-default} references in the values of
+// a YAML document with the value of the environment variable NAME. The
+// default is used when NAME is unset or empty; a reference to an unset
+// variable without a default is an error. $${NAME} yields a literal ${NAME}.
+//
+// Only scalar values are expanded, so references in comments are ignored and
+// a substituted value cannot change the structure of the document. Unquoted
+// values are re-typed after expansion, so `port: ${PORT}` yields a number.
+// Documents without references, or that are not valid YAML, are returned
+// unchanged so the caller reports syntax errors as usual.
+func ExpandEnv(data []byte) ([]byte, error) {
+	if !bytes.Contains(data, []byte("${")) {
+		return data, nil
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return data, nil
+	}
+	undefined := map[string]*yaml.Node{}
+	if !expandEnvNode(&root, undefined) {
+		return data, nil
+	}
+	if len(undefined) > 0 {
+		names := make([]string, 0, len(undefined))
+		for name := range undefined {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("undefined environment variable(s) without default: %s", strings.Join(names, ", "))
+	}
+
+	expanded, err := yaml.Marshal(&root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode expanded YAML: %w", err)
+	}
+	return expanded, nil
+}
+
+// expandEnvNode expands the references in every scalar below node in place
+// and reports whether any were found. Undefined variables are recorded in
+// undefined with the first node referencing them.
+func expandEnvNode(node *yaml.Node, undefined map[string]*yaml.Node) bool {
+	if node == nil {
+		return false
+	}
+	if node.Kind != yaml.ScalarNode {
+		changed := false
+		for _, child := range node.Content {
+			if expandEnvNode(child, undefined) {
+				changed = true
+			}
+		}
+		return changed
+	}
+	if !strings.Contains(node.Value, "${") {
+		return false
+	}
+
+	changed := false
+	node.Value = envReference.ReplaceAllStringFunc(node.Value, func(ref string) string {
+		changed = true
+		if strings.HasPrefix(ref, "$$") {
+			return ref[1:]
+		}
+		match := envReference.FindStringSubmatch(ref)
+		name, fallback := match[1], match[2]
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+		if !strings.Contains(ref, ":-") {
+			if _, set := os.LookupEnv(name); !set {
+				if _, seen := undefined[name]; !seen {
+					undefined[name] = node
+				}
+			}
+			return ""
+		}
+		return fallback
+	})
+	if changed && node.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+		// Let the encoder resolve the type of the expanded value
+		node.Tag = ""
+	}
+	return changed
+}
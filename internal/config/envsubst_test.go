@@ -0,0 +1,95 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("MUSTER_TEST_HOST", "example.com")
+	t.Setenv("MUSTER_TEST_PORT", "9000")
+	t.Setenv("MUSTER_TEST_EMPTY", "")
+
+	data := []byte(`# ${MUSTER_TEST_COMMENT} is ignored
+url: https://${MUSTER_TEST_HOST}/mcp
+port: ${MUSTER_TEST_PORT}
+quoted: "${MUSTER_TEST_PORT}"
+region: ${MUSTER_TEST_REGION:-eu-west-1}
+empty: ${MUSTER_TEST_EMPTY:-fallback}
+literal: $${MUSTER_TEST_HOST}
+value: "a: ${MUSTER_TEST_HOST}"
+`)
+	expanded, err := ExpandEnv(data)
+	require.NoError(t, err)
+
+	var got map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(expanded, &got))
+	assert.Equal(t, map[string]interface{}{
+		"url":     "https://example.com/mcp",
+		"port":    9000,
+		"quoted":  "9000",
+		"region":  "eu-west-1",
+		"empty":   "fallback",
+		"literal": "${MUSTER_TEST_HOST}",
+		"value":   "a: example.com",
+	}, got)
+
+	plain := []byte("url: https://example.com # no references\n")
+	unchanged, err := ExpandEnv(plain)
+	require.NoError(t, err)
+	assert.Equal(t, plain, unchanged)
+}
+
+func TestExpandEnv_Undefined(t *testing.T) {
+	t.Setenv("MUSTER_TEST_EMPTY", "")
+
+	_, err := ExpandEnv([]byte("a: ${MUSTER_TEST_UNSET_B}\nb: ${MUSTER_TEST_UNSET_A}\nc: ${MUSTER_TEST_UNSET_B}\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "MUSTER_TEST_UNSET_A, MUSTER_TEST_UNSET_B")
+
+	// A variable that is set to an empty value is defined
+	expanded, err := ExpandEnv([]byte("a: x${MUSTER_TEST_EMPTY}\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "a: x\n", string(expanded))
+}
+
+func TestLoadConfig_ExpandsEnv(t *testing.T) {
+	t.Setenv("MUSTER_TEST_PORT", "9123")
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  port: ${MUSTER_TEST_PORT}\n  host: ${MUSTER_TEST_HOST:-0.0.0.0}\n")
+
+	cfg, err := LoadConfig(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 9123, cfg.Aggregator.Port)
+	assert.Equal(t, "0.0.0.0", cfg.Aggregator.Host)
+
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  host: ${MUSTER_TEST_UNSET_HOST}\n")
+	_, err = LoadConfig(dir)
+	assert.ErrorContains(t, err, "MUSTER_TEST_UNSET_HOST")
+}
+
+func TestValidateDirectory_EnvReferences(t *testing.T) {
+	t.Setenv("MUSTER_TEST_PORT", "9123")
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  port: ${MUSTER_TEST_PORT}\n")
+	writeConfigFile(t, dir, "mcpservers/kube.yaml", `apiVersion: muster.giantswarm.io/v1alpha1
+kind: MCPServer
+metadata:
+  name: kube
+spec:
+  type: stdio
+  command: mcp-kubernetes
+  env:
+    KUBECONFIG: ${MUSTER_TEST_UNSET_KUBECONFIG}
+`)
+
+	report, err := ValidateDirectory(dir)
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 1)
+	issue := report.Issues[0]
+	assert.Contains(t, issue.Message, "MUSTER_TEST_UNSET_KUBECONFIG is not set")
+	assert.Equal(t, 9, issue.Line)
+}
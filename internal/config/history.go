@@ -0,0 +1,352 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HistoryDir is the directory below the configuration directory that holds
+// the revisions recorded by History.
+const HistoryDir = ".history"
+
+// DefaultHistoryRevisions is the number of revisions History keeps per entity.
+const DefaultHistoryRevisions = 50
+
+// Operations recorded in a Revision.
+const (
+	// HistoryOpInitial is the content an entity had before its first
+	// recorded change.
+	HistoryOpInitial  = "initial"
+	HistoryOpSave     = "save"
+	HistoryOpDelete   = "delete"
+	HistoryOpRollback = "rollback"
+)
+
+// historyMu serializes History instances, which may share a directory.
+var historyMu sync.Mutex
+
+// Revision describes the state of an entity after a recorded change.
+type Revision struct {
+	Revision  int       `json:"revision"`
+	Timestamp time.Time `json:"timestamp"`
+	Operation string    `json:"operation"`
+	// Deleted is set when the entity did not exist at this revision
+	Deleted bool `json:"deleted,omitempty"`
+
+	file string
+}
+
+// History records the definitions of entities in a configuration directory
+// each time they are saved or deleted, so a change can be rolled back.
+// Revisions of an entity are stored as files in
+// <configPath>/.history/<entityType>/<name>/, numbered from 1.
+type History struct {
+	configPath   string
+	maxRevisions int
+	now          func() time.Time
+}
+
+// NewHistory returns the History of the configuration directory configPath,
+// keeping DefaultHistoryRevisions revisions per entity.
+func NewHistory(configPath string) *History {
+	return &History{configPath: configPath, maxRevisions: DefaultHistoryRevisions, now: time.Now}
+}
+
+// RecordSave records that data was written as the definition of the entity.
+// previous is the definition it replaced, or nil if the entity is new.
+func (h *History) RecordSave(entityType, name string, previous, data []byte) error {
+	return h.record(entityType, name, previous, data, HistoryOpSave, false)
+}
+
+// RecordDelete records that the entity with the given definition was deleted.
+func (h *History) RecordDelete(entityType, name string, previous []byte) error {
+	return h.record(entityType, name, previous, nil, HistoryOpDelete, true)
+}
+
+func (h *History) record(entityType, name string, previous, data []byte, op string, deleted bool) error {
+	historyMu.Lock()
+	defer historyMu.Unlock()
+	return h.recordLocked(entityType, name, previous, data, op, deleted)
+}
+
+func (h *History) recordLocked(entityType, name string, previous, data []byte, op string, deleted bool) error {
+	dir, err := h.entityDir(entityType, name)
+	if err != nil {
+		return err
+	}
+	revisions, err := h.revisions(dir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create history directory %s: %w", dir, err)
+	}
+
+	next := 1
+	if len(revisions) > 0 {
+		next = revisions[0].Revision + 1
+	} else if previous != nil {
+		// Keep the definition written outside of muster, so the first
+		// recorded change can be rolled back too
+		if err := h.writeRevision(dir, next, HistoryOpInitial, previous, false); err != nil {
+			return err
+		}
+		next++
+	}
+	if err := h.writeRevision(dir, next, op, data, deleted); err != nil {
+		return err
+	}
+	return h.prune(dir)
+}
+
+func (h *History) writeRevision(dir string, revision int, op string, data []byte, deleted bool) error {
+	ext := ".yaml"
+	if deleted {
+		ext, data = ".deleted", nil
+	}
+	file := filepath.Join(dir, fmt.Sprintf("%06d-%d-%s%s", revision, h.now().UnixMilli(), op, ext))
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write revision %s: %w", file, err)
+	}
+	return nil
+}
+
+// prune removes the oldest revisions beyond maxRevisions.
+func (h *History) prune(dir string) error {
+	revisions, err := h.revisions(dir)
+	if err != nil {
+		return err
+	}
+	for i := h.maxRevisions; i < len(revisions); i++ {
+		if err := os.Remove(revisions[i].file); err != nil {
+			return fmt.Errorf("failed to remove revision %s: %w", revisions[i].file, err)
+		}
+	}
+	return nil
+}
+
+// Revisions returns the recorded revisions of an entity, newest first.
+func (h *History) Revisions(entityType, name string) ([]Revision, error) {
+	dir, err := h.entityDir(entityType, name)
+	if err != nil {
+		return nil, err
+	}
+	historyMu.Lock()
+	defer historyMu.Unlock()
+	return h.revisions(dir)
+}
+
+// Entities returns the names of the entities of the given type that have
+// recorded revisions, sorted.
+func (h *History) Entities(entityType string) ([]string, error) {
+	dir, err := h.typeDir(entityType)
+	if err != nil {
+		return nil, err
+	}
+	historyMu.Lock()
+	defer historyMu.Unlock()
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read history of %s: %w", entityType, err)
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Load returns a revision of an entity and the definition it recorded, which
+// is nil for a deleted entity.
+func (h *History) Load(entityType, name string, revision int) (Revision, []byte, error) {
+	dir, err := h.entityDir(entityType, name)
+	if err != nil {
+		return Revision{}, nil, err
+	}
+	historyMu.Lock()
+	defer historyMu.Unlock()
+	return h.load(dir, entityType, name, revision)
+}
+
+func (h *History) load(dir, entityType, name string, revision int) (Revision, []byte, error) {
+	revisions, err := h.revisions(dir)
+	if err != nil {
+		return Revision{}, nil, err
+	}
+	for _, r := range revisions {
+		if r.Revision != revision {
+			continue
+		}
+		if r.Deleted {
+			return r, nil, nil
+		}
+		data, err := os.ReadFile(r.file)
+		if err != nil {
+			return Revision{}, nil, fmt.Errorf("failed to read revision %d of %s/%s: %w", revision, entityType, name, err)
+		}
+		return r, data, nil
+	}
+	return Revision{}, nil, fmt.Errorf("revision %d of %s/%s not found", revision, entityType, name)
+}
+
+// Rollback restores the definition an entity had at the given revision,
+// deleting the entity if it did not exist then, and records the rollback as
+// a new revision, which it returns.
+func (h *History) Rollback(entityType, name string, revision int) (Revision, error) {
+	dir, err := h.entityDir(entityType, name)
+	if err != nil {
+		return Revision{}, err
+	}
+	historyMu.Lock()
+	defer historyMu.Unlock()
+
+	target, data, err := h.load(dir, entityType, name, revision)
+	if err != nil {
+		return Revision{}, err
+	}
+
+	filePath := filepath.Join(h.configPath, entityType, name+".yaml")
+	previous, err := os.ReadFile(filePath) //nolint:gosec
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return Revision{}, fmt.Errorf("failed to read %s: %w", filePath, err)
+		}
+		previous = nil
+	}
+
+	if target.Deleted {
+		if previous != nil {
+			if err := os.Remove(filePath); err != nil {
+				return Revision{}, fmt.Errorf("failed to delete %s: %w", filePath, err)
+			}
+		}
+	} else {
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil { //nolint:gosec
+			return Revision{}, fmt.Errorf("failed to create directory for %s: %w", filePath, err)
+		}
+		if err := writeFileAtomic(filePath, data, 0644); err != nil {
+			return Revision{}, fmt.Errorf("failed to write %s: %w", filePath, err)
+		}
+	}
+
+	if err := h.recordLocked(entityType, name, previous, data, HistoryOpRollback, target.Deleted); err != nil {
+		return Revision{}, err
+	}
+	revisions, err := h.revisions(dir)
+	if err != nil {
+		return Revision{}, err
+	}
+	return revisions[0], nil
+}
+
+// typeDir returns the history directory of the entities of a type.
+func (h *History) typeDir(entityType string) (string, error) {
+	if !isHistoryPathElement(entityType) {
+		return "", fmt.Errorf("invalid entity type %q", entityType)
+	}
+	return filepath.Join(h.configPath, HistoryDir, entityType), nil
+}
+
+// entityDir returns the history directory of an entity.
+func (h *History) entityDir(entityType, name string) (string, error) {
+	dir, err := h.typeDir(entityType)
+	if err != nil {
+		return "", err
+	}
+	if !isHistoryPathElement(name) {
+		return "", fmt.Errorf("invalid entity name %q", name)
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// isHistoryPathElement rejects names that would escape the history directory.
+func isHistoryPathElement(s string) bool {
+	return s != "" && !strings.HasPrefix(s, ".") && !strings.ContainsAny(s, `/\`)
+}
+
+// revisions reads the revisions in dir, newest first.
+func (h *History) revisions(dir string) ([]Revision, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Revision{}, nil
+		}
+		return nil, fmt.Errorf("failed to read history %s: %w", dir, err)
+	}
+
+	revisions := []Revision{}
+	for _, entry := range entries {
+		r, ok := parseRevisionFile(entry.Name())
+		if !ok {
+			continue
+		}
+		r.file = filepath.Join(dir, entry.Name())
+		revisions = append(revisions, r)
+	}
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i].Revision > revisions[j].Revision })
+	return revisions, nil
+}
+
+// parseRevisionFile parses a file name of the form
+// <revision>-<unix millis>-<operation>.<yaml|deleted>.
+func parseRevisionFile(file string) (Revision, bool) {
+	ext := filepath.Ext(file)
+	if ext != ".yaml" && ext != ".deleted" {
+		return Revision{}, false
+	}
+	parts := strings.SplitN(strings.TrimSuffix(file, ext), "-", 3)
+	if len(parts) != 3 {
+		return Revision{}, false
+	}
+	revision, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return Revision{}, false
+	}
+	millis, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return Revision{}, false
+	}
+	return Revision{
+		Revision:  revision,
+		Timestamp: time.UnixMilli(millis).UTC(),
+		Operation: parts[2],
+		Deleted:   ext == ".deleted",
+	}, true
+}
+
+// writeFileAtomic writes data to a temp file next to filePath and renames it,
+// so watchers never see a partially written file.
+func writeFileAtomic(filePath string, data []byte, perm os.FileMode) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(filePath), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	tempPath := tempFile.Name()
+	if _, err := tempFile.Write(data); err != nil {
+		_ = tempFile.Close()
+		_ = os.Remove(tempPath)
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		_ = os.Remove(tempPath)
+		return err
+	}
+	if err := os.Chmod(tempPath, perm); err != nil {
+		_ = os.Remove(tempPath)
+		return err
+	}
+	if err := os.Rename(tempPath, filePath); err != nil {
+		_ = os.Remove(tempPath)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory_Rollback(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "workflows", "deploy.yaml")
+	writeConfigFile(t, dir, "workflows/deploy.yaml", "v1\n")
+
+	ds := NewStorageWithPath(dir)
+	history := NewHistory(dir)
+	ds.SetHistory(history)
+	require.NoError(t, ds.Save("workflows", "deploy", []byte("v2\n")))
+	require.NoError(t, ds.Save("workflows", "deploy", []byte("v3\n")))
+	require.NoError(t, ds.Delete("workflows", "deploy"))
+
+	revisions, err := history.Revisions("workflows", "deploy")
+	require.NoError(t, err)
+	require.Len(t, revisions, 4)
+	var ops []string
+	for _, r := range revisions {
+		ops = append(ops, r.Operation)
+	}
+	assert.Equal(t, []string{HistoryOpDelete, HistoryOpSave, HistoryOpSave, HistoryOpInitial}, ops)
+	assert.Equal(t, 4, revisions[0].Revision)
+	assert.True(t, revisions[0].Deleted)
+
+	_, data, err := history.Load("workflows", "deploy", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "v1\n", string(data))
+
+	// Undo the deletion and the second save
+	r, err := history.Rollback("workflows", "deploy", 2)
+	require.NoError(t, err)
+	assert.Equal(t, 5, r.Revision)
+	assert.Equal(t, HistoryOpRollback, r.Operation)
+	assertFile(t, file, "v2\n")
+
+	// Rolling back to a deleted revision deletes the entity
+	_, err = history.Rollback("workflows", "deploy", 4)
+	require.NoError(t, err)
+	assert.NoFileExists(t, file)
+
+	_, err = history.Rollback("workflows", "deploy", 42)
+	assert.ErrorContains(t, err, "not found")
+
+	names, err := history.Entities("workflows")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deploy"}, names)
+}
+
+func TestHistory_Prune(t *testing.T) {
+	dir := t.TempDir()
+	history := NewHistory(dir)
+	history.maxRevisions = 3
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		require.NoError(t, history.RecordSave("mcpservers", "kube", nil, []byte(v)))
+	}
+
+	revisions, err := history.Revisions("mcpservers", "kube")
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	assert.Equal(t, 5, revisions[0].Revision)
+	assert.Equal(t, 3, revisions[2].Revision)
+
+	entries, err := os.ReadDir(filepath.Join(dir, HistoryDir, "mcpservers", "kube"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
+
+func TestHistory_InvalidNames(t *testing.T) {
+	history := NewHistory(t.TempDir())
+	for _, name := range []string{"", "..", "../config", ".hidden", `a\b`} {
+		_, err := history.Revisions("workflows", name)
+		assert.Error(t, err, name)
+	}
+	_, err := history.Entities("../workflows")
+	assert.ErrorContains(t, err, "invalid entity type")
+}
@@ -29,26 +29,60 @@ func GetDefaultConfigPathOrPanic() string {
 // LoadConfig loads configuration from a single specified directory.
 // The directory should contain config.yaml and subdirectories for other configuration types.
 func LoadConfig(configPath string) (MusterConfig, error) {
+	return LoadConfigWithProfile(configPath, "")
+}
+
+// LoadConfigWithProfile loads configuration like LoadConfig and merges the
+// config.yaml of the named profile over config.yaml (see MergeYAML). An empty
+// profile loads config.yaml alone; a profile without a directory in
+// configPath is an error.
+func LoadConfigWithProfile(configPath, profile string) (MusterConfig, error) {
 	// Load main config.yaml from the specified path
 	configFilePath := filepath.Join(configPath, configFileName)
 	config := GetDefaultConfigWithRoles() // Start with default config
 
-	// Start with default config
-	data, err := os.ReadFile(configFilePath) //nolint:gosec
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			logging.Info("ConfigLoader", "No config.yaml found at %s, using defaults", configFilePath)
-			return config, nil
-		}
+	data, err := readConfigFile(configFilePath, false)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
 		logging.Info("ConfigLoader", "Error loading config.yaml from %s: %s", configFilePath, err)
 		return MusterConfig{}, err
 	}
+	found := err == nil
+	loaded := configFilePath
+
+	if profile != "" {
+		if err := CheckProfile(configPath, profile); err != nil {
+			return MusterConfig{}, err
+		}
+		profileFilePath := filepath.Join(ProfilePath(configPath, profile), configFileName)
+		overlay, err := readConfigFile(profileFilePath, true)
+		switch {
+		case err == nil:
+			data, err = MergeYAML(data, overlay)
+			if err != nil {
+				return MusterConfig{}, fmt.Errorf("error merging %s: %w", profileFilePath, err)
+			}
+			if found {
+				loaded += " with " + profileFilePath
+			} else {
+				loaded = profileFilePath
+			}
+			found = true
+		case !errors.Is(err, os.ErrNotExist):
+			logging.Info("ConfigLoader", "Error loading config.yaml from %s: %s", profileFilePath, err)
+			return MusterConfig{}, err
+		}
+	}
+
+	if !found {
+		logging.Info("ConfigLoader", "No config.yaml found at %s, using defaults", configFilePath)
+		return config, nil
+	}
 	err = yaml.Unmarshal(data, &config)
 	if err != nil {
 		// config malformed
-		return MusterConfig{}, fmt.Errorf("error loading config from %s: %w", configFilePath, err)
+		return MusterConfig{}, fmt.Errorf("error loading config from %s: %w", loaded, err)
 	}
-	logging.Info("ConfigLoader", "Loaded configuration from %s", configFilePath)
+	logging.Info("ConfigLoader", "Loaded configuration from %s", loaded)
 
 	// Resolve secrets from files (recommended for production deployments)
 	if err := resolveSecretFiles(&config); err != nil {
@@ -58,6 +92,32 @@ func LoadConfig(configPath string) (MusterConfig, error) {
 	return config, nil
 }
 
+// readConfigFile reads a config.yaml file, decrypts it, expands its
+// environment variable references, and checks it against the config schema,
+// as a profile overlay if overlay is set.
+func readConfigFile(configFilePath string, overlay bool) ([]byte, error) {
+	data, err := os.ReadFile(configFilePath) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	data, err = Decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting %s: %w", configFilePath, err)
+	}
+	data, err = ExpandEnv(data)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding environment variables in %s: %w", configFilePath, err)
+	}
+	validate := ValidateSchema
+	if overlay {
+		validate = ValidateOverlaySchema
+	}
+	if err := validate(SchemaConfig, configFilePath, data); err != nil {
+		return nil, fmt.Errorf("invalid configuration in %s: %w", configFilePath, err)
+	}
+	return data, nil
+}
+
 // secretMapping defines a secret file to load and where to store it.
 type secretMapping struct {
 	file   string
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// NamespacesDir is the directory below the configuration directory that holds
+// the resources of the namespaces other than the one muster runs in, one
+// subdirectory per namespace.
+const NamespacesDir = "namespaces"
+
+// DefaultNamespace is the namespace muster runs in if config.yaml sets none.
+const DefaultNamespace = "default"
+
+// NamespacePath returns the directory of the resources of namespace in the
+// configuration directory configPath, in which muster runs in namespace home.
+// The resources of home live directly in configPath, those of any other
+// namespace in NamespacesDir/<namespace>, with the same mcpservers/ and
+// workflows/ layout. An empty home is DefaultNamespace.
+func NamespacePath(configPath, home, namespace string) string {
+	if home == "" {
+		home = DefaultNamespace
+	}
+	if namespace == "" || namespace == home {
+		return configPath
+	}
+	return filepath.Join(configPath, NamespacesDir, namespace)
+}
+
+// CheckNamespace returns an error if namespace is not a valid Kubernetes
+// namespace name, which also keeps it from escaping NamespacesDir.
+func CheckNamespace(namespace string) error {
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return fmt.Errorf("invalid namespace %q: %s", namespace, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// Namespaces returns the namespaces with a directory in the NamespacesDir of
+// configPath, sorted. The namespace muster runs in has none.
+func Namespaces(configPath string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(configPath, NamespacesDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read namespaces: %w", err)
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && CheckNamespace(entry.Name()) == nil {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
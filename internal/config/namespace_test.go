@@ -0,0 +1,41 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespacePath(t *testing.T) {
+	assert.Equal(t, "/cfg", NamespacePath("/cfg", "muster", "muster"))
+	assert.Equal(t, "/cfg", NamespacePath("/cfg", "muster", ""))
+	assert.Equal(t, "/cfg", NamespacePath("/cfg", "", DefaultNamespace))
+	assert.Equal(t, filepath.Join("/cfg", NamespacesDir, "team-a"), NamespacePath("/cfg", "muster", "team-a"))
+	assert.Equal(t, filepath.Join("/cfg", NamespacesDir, DefaultNamespace), NamespacePath("/cfg", "muster", DefaultNamespace))
+}
+
+func TestCheckNamespace(t *testing.T) {
+	assert.NoError(t, CheckNamespace("team-a"))
+	for _, namespace := range []string{"", "..", "team/a", "Team", ".history"} {
+		assert.Error(t, CheckNamespace(namespace), namespace)
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	dir := t.TempDir()
+	names, err := Namespaces(dir)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	for _, name := range []string{"team-b", "team-a", "Invalid"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, NamespacesDir, name), 0755))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, NamespacesDir, "file.yaml"), nil, 0644))
+
+	names, err = Namespaces(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team-a", "team-b"}, names)
+}
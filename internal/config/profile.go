@@ -0,0 +1,120 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProfilesDir is the directory below the configuration directory that holds
+// the profiles, one subdirectory per profile.
+const ProfilesDir = "profiles"
+
+// ProfilePath returns the directory of the named profile in the
+// configuration directory configPath. A profile directory has the layout of
+// a configuration directory: config.yaml, mcpservers/, and workflows/, each
+// file overlaying the file of the same name in configPath.
+func ProfilePath(configPath, profile string) string {
+	return filepath.Join(configPath, ProfilesDir, profile)
+}
+
+// CheckProfile returns an error if profile is not a valid profile name or
+// has no directory in configPath.
+func CheckProfile(configPath, profile string) error {
+	if !isHistoryPathElement(profile) {
+		return fmt.Errorf("invalid profile name %q", profile)
+	}
+	info, err := os.Stat(ProfilePath(configPath, profile))
+	if err != nil || !info.IsDir() {
+		available, _ := Profiles(configPath)
+		if len(available) == 0 {
+			return fmt.Errorf("profile %q not found: %s has no profiles", profile, filepath.Join(configPath, ProfilesDir))
+		}
+		return fmt.Errorf("profile %q not found (available: %v)", profile, available)
+	}
+	return nil
+}
+
+// Profiles returns the names of the profiles in configPath, sorted.
+func Profiles(configPath string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(configPath, ProfilesDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read profiles: %w", err)
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && isHistoryPathElement(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// MergeYAML merges the YAML document overlay over base. Mappings are merged
+// key by key, recursively; any other value in overlay, including a list,
+// replaces the value in base. A key set to null in overlay is removed. Either
+// document may be empty.
+func MergeYAML(base, overlay []byte) ([]byte, error) {
+	var baseDoc, overlayDoc yaml.Node
+	if err := yaml.Unmarshal(base, &baseDoc); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(overlay, &overlayDoc); err != nil {
+		return nil, err
+	}
+	baseRoot, overlayRoot := lookupNode(&baseDoc), lookupNode(&overlayDoc)
+	switch {
+	case isEmptyNode(overlayRoot):
+		return base, nil
+	case isEmptyNode(baseRoot):
+		return overlay, nil
+	}
+	return yaml.Marshal(mergeNode(baseRoot, overlayRoot))
+}
+
+// isEmptyNode reports whether the root node of a document leaves everything
+// unset.
+func isEmptyNode(node *yaml.Node) bool {
+	return node == nil || node.Kind == 0 || isNullNode(node)
+}
+
+// mergeNode merges overlay into base and returns the merged node.
+func mergeNode(base, overlay *yaml.Node) *yaml.Node {
+	if base.Kind != yaml.MappingNode || overlay.Kind != yaml.MappingNode {
+		return overlay
+	}
+	for i := 0; i+1 < len(overlay.Content); i += 2 {
+		key, value := overlay.Content[i], overlay.Content[i+1]
+		j := mappingIndex(base, key.Value)
+		switch {
+		case isNullNode(value):
+			if j >= 0 {
+				base.Content = append(base.Content[:j], base.Content[j+2:]...)
+			}
+		case j >= 0:
+			base.Content[j+1] = mergeNode(base.Content[j+1], value)
+		default:
+			base.Content = append(base.Content, key, value)
+		}
+	}
+	return base
+}
+
+// mappingIndex returns the index of key in the contents of a mapping node,
+// or -1.
+func mappingIndex(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func isNullNode(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeYAML(t *testing.T) {
+	base := `# base
+aggregator:
+  port: 8090
+  host: localhost
+  musterPrefix: x
+namespace: default
+roles: [a, b]
+`
+	overlay := `aggregator:
+  port: 9000
+  musterPrefix: null
+roles: [c]
+kubernetes: true
+`
+	merged, err := MergeYAML([]byte(base), []byte(overlay))
+	require.NoError(t, err)
+	assert.Equal(t, `# base
+aggregator:
+    port: 9000
+    host: localhost
+namespace: default
+roles: [c]
+kubernetes: true
+`, string(merged))
+
+	merged, err = MergeYAML(nil, []byte(overlay))
+	require.NoError(t, err)
+	assert.Equal(t, overlay, string(merged))
+	merged, err = MergeYAML([]byte(base), []byte("# nothing\n"))
+	require.NoError(t, err)
+	assert.Equal(t, base, string(merged))
+
+	_, err = MergeYAML([]byte(base), []byte("a: [\n"))
+	assert.Error(t, err)
+}
+
+func TestLoadConfigWithProfile(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "config.yaml", "namespace: base\naggregator:\n  port: 8090\n  host: 0.0.0.0\n")
+	writeConfigFile(t, dir, "profiles/prod/config.yaml", "aggregator:\n  port: ${PROD_PORT:-9000}\n")
+	writeConfigFile(t, dir, "profiles/dev/mcpservers/kube.yaml", "spec:\n  autoStart: false\n")
+
+	cfg, err := LoadConfigWithProfile(dir, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, "base", cfg.Namespace)
+	assert.Equal(t, 9000, cfg.Aggregator.Port)
+	assert.Equal(t, "0.0.0.0", cfg.Aggregator.Host)
+
+	// A profile without config.yaml leaves the configuration as is
+	cfg, err = LoadConfigWithProfile(dir, "dev")
+	require.NoError(t, err)
+	assert.Equal(t, 8090, cfg.Aggregator.Port)
+
+	_, err = LoadConfigWithProfile(dir, "staging")
+	assert.ErrorContains(t, err, `profile "staging" not found (available: [dev prod])`)
+	_, err = LoadConfigWithProfile(dir, "../prod")
+	assert.ErrorContains(t, err, "invalid profile name")
+
+	writeConfigFile(t, dir, "profiles/prod/config.yaml", "aggregator:\n  port: high\n")
+	_, err = LoadConfigWithProfile(dir, "prod")
+	assert.ErrorContains(t, err, "profiles/prod/config.yaml:2:9: error: aggregator.port: got string, want integer")
+}
+
+func TestValidateDirectory_Profiles(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "profiles/prod/config.yaml", "aggregator:\n  port: high\n")
+	// Overlays may leave required fields to the definition they overlay
+	writeConfigFile(t, dir, "profiles/prod/workflows/deploy.yaml", "spec:\n  description: prod\n")
+	writeConfigFile(t, dir, "profiles/prod/mcpservers/kube.yaml", "spec:\n  autoStrat: true\n")
+
+	report, err := ValidateDirectory(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.FilesChecked)
+	require.Len(t, report.Issues, 2)
+
+	issue := findIssue(report, "want integer")
+	require.NotNil(t, issue)
+	assert.Equal(t, "aggregator.port", issue.Path)
+	issue = findIssue(report, `unknown field "autoStrat"`)
+	require.NotNil(t, issue)
+	assert.Equal(t, SeverityError, issue.Severity)
+}
@@ -0,0 +1,285 @@
+package config
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"gopkg.in/yaml.v3"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// Names of the JSON Schemas shipped with muster, accepted by Schema and
+// ValidateSchema.
+const (
+	SchemaConfig    = "config"
+	SchemaMCPServer = "mcpserver"
+	SchemaWorkflow  = "workflow"
+)
+
+// SchemaNames lists the shipped schemas.
+var SchemaNames = []string{SchemaConfig, SchemaMCPServer, SchemaWorkflow}
+
+// schemaFiles holds the schemas. The entity schemas are derived from the CRD
+// OpenAPI schemas, with unknown fields rejected where the CRD prunes them.
+//
+//go:embed schemas/*.schema.json
+var schemaFiles embed.FS
+
+var (
+	compileSchemasOnce sync.Once
+	compiledSchemas    map[string]*jsonschema.Schema
+	compileSchemasErr  error
+)
+
+// Schema returns the JSON Schema document for config.yaml (SchemaConfig) or
+// an entity definition, e.g. for editor integration.
+func Schema(name string) ([]byte, error) {
+	data, err := schemaFiles.ReadFile("schemas/" + name + ".schema.json")
+	if err != nil {
+		return nil, fmt.Errorf("unknown schema %q (available: %s)", name, strings.Join(SchemaNames, ", "))
+	}
+	return data, nil
+}
+
+// compiledSchema returns the named schema, compiling all of them on first use.
+func compiledSchema(name string) (*jsonschema.Schema, error) {
+	compileSchemasOnce.Do(func() {
+		compiler := jsonschema.NewCompiler()
+		compiled := make(map[string]*jsonschema.Schema, len(SchemaNames))
+		for _, n := range SchemaNames {
+			data, err := Schema(n)
+			if err != nil {
+				compileSchemasErr = err
+				return
+			}
+			doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+			if err != nil {
+				compileSchemasErr = fmt.Errorf("failed to parse schema %s: %w", n, err)
+				return
+			}
+			url := "https://muster.giantswarm.io/schemas/" + n + ".schema.json"
+			if err := compiler.AddResource(url, doc); err != nil {
+				compileSchemasErr = fmt.Errorf("failed to add schema %s: %w", n, err)
+				return
+			}
+			if compiled[n], err = compiler.Compile(url); err != nil {
+				compileSchemasErr = fmt.Errorf("failed to compile schema %s: %w", n, err)
+				return
+			}
+		}
+		compiledSchemas = compiled
+	})
+	if compileSchemasErr != nil {
+		return nil, compileSchemasErr
+	}
+	sch, ok := compiledSchemas[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema %q (available: %s)", name, strings.Join(SchemaNames, ", "))
+	}
+	return sch, nil
+}
+
+// SchemaError lists the schema violations that prevent a file from loading.
+type SchemaError struct {
+	Issues []ValidationIssue
+}
+
+func (e *SchemaError) Error() string {
+	lines := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		lines[i] = issue.String()
+	}
+	return "schema validation failed:\n  " + strings.Join(lines, "\n  ")
+}
+
+// ValidateSchema checks data, the YAML contents of file, against the named
+// schema, so a malformed file fails with the position and field of each
+// problem instead of an unmarshal error. It returns a *SchemaError listing
+// the violations. Unknown fields are tolerated, as muster ignores them when
+// loading; ValidateDirectory reports them.
+func ValidateSchema(name, file string, data []byte) error {
+	return validateSchema(name, file, data, false)
+}
+
+// ValidateOverlaySchema checks data like ValidateSchema, for a profile file
+// that overlays another file: fields the schema requires may be left to the
+// file it overlays.
+func ValidateOverlaySchema(name, file string, data []byte) error {
+	return validateSchema(name, file, data, true)
+}
+
+func validateSchema(name, file string, data []byte, overlay bool) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		// Syntax errors are left to the caller's decoder
+		return nil
+	}
+	sink := &issueSink{file: file, overlay: overlay}
+	sink.checkSchema(name, &root, data, SeverityWarning)
+
+	var errs []ValidationIssue
+	for _, issue := range sink.issues {
+		if issue.Severity == SeverityError {
+			errs = append(errs, issue)
+		}
+	}
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(a, b int) bool { return errs[a].Line < errs[b].Line })
+		return &SchemaError{Issues: errs}
+	}
+	return nil
+}
+
+// checkSchema validates data against the named schema and reports each
+// violation at the YAML node it concerns. root is the parsed data. Unknown
+// fields are reported with the given severity.
+func (s *issueSink) checkSchema(name string, root *yaml.Node, data []byte, unknownFields IssueSeverity) {
+	sch, err := compiledSchema(name)
+	if err != nil {
+		s.errorf(nil, "", "%v", err)
+		return
+	}
+	jsonData, err := sigsyaml.YAMLToJSON(data)
+	if err != nil {
+		return
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(jsonData))
+	if err != nil || instance == nil {
+		// An empty file leaves everything at its default
+		return
+	}
+	// A null value leaves a field unset when decoding, so it is never a type error
+	instance = dropNulls(instance)
+
+	err = sch.Validate(instance)
+	if err == nil {
+		return
+	}
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		s.errorf(nil, "", "%v", err)
+		return
+	}
+	for _, leaf := range schemaViolations(validationErr) {
+		node, path := instanceNode(root, leaf.InstanceLocation)
+		switch k := leaf.ErrorKind.(type) {
+		case *kind.AdditionalProperties:
+			for _, field := range k.Properties {
+				s.add(unknownFields, mappingKey(node, field), joinPath(path, field), "unknown field %q", field)
+			}
+		case *kind.Required:
+			if s.overlay {
+				continue
+			}
+			for _, field := range k.Missing {
+				s.errorf(node, joinPath(path, field), "%s is required", field)
+			}
+		default:
+			s.errorf(node, path, "%s", leaf.BasicOutput().Error)
+		}
+	}
+}
+
+// schemaViolations returns the leaf errors of a validation error.
+func schemaViolations(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	var leaves []*jsonschema.ValidationError
+	var walk func(e *jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			leaves = append(leaves, e)
+			return
+		}
+		for _, cause := range e.Causes {
+			walk(cause)
+		}
+	}
+	walk(err)
+	return leaves
+}
+
+// instanceNode follows a JSON instance location through a parsed YAML
+// document and returns the deepest node reached, with its dotted path.
+func instanceNode(root *yaml.Node, location []string) (*yaml.Node, string) {
+	node := lookupNode(root)
+	path := ""
+	for _, token := range location {
+		if node == nil {
+			break
+		}
+		switch node.Kind {
+		case yaml.MappingNode:
+			next := mappingValue(node, token)
+			if next == nil {
+				return node, path
+			}
+			node, path = next, joinPath(path, token)
+		case yaml.SequenceNode:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node.Content) {
+				return node, path
+			}
+			node, path = node.Content[i], fmt.Sprintf("%s[%d]", path, i)
+		default:
+			return node, path
+		}
+	}
+	return node, path
+}
+
+// mappingKey returns the key node for key in a mapping node, or the mapping
+// itself if the key is not found.
+func mappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return node
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i]
+		}
+	}
+	return node
+}
+
+// dropNulls removes null values from objects.
+func dropNulls(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, item := range v {
+			if item == nil {
+				delete(v, k)
+				continue
+			}
+			v[k] = dropNulls(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = dropNulls(item)
+		}
+	}
+	return v
+}
+
+// mergeSchemaIssues appends the schema issues to the issues of the semantic
+// checks, leaving out those for paths a semantic check already reported with
+// a more specific message.
+func mergeSchemaIssues(issues, schemaIssues []ValidationIssue) []ValidationIssue {
+	reported := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			reported[issue.Path] = true
+		}
+	}
+	for _, issue := range schemaIssues {
+		if issue.Path == "" || !reported[issue.Path] {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+func TestSchema(t *testing.T) {
+	for _, name := range SchemaNames {
+		data, err := Schema(name)
+		require.NoError(t, err, name)
+		assert.True(t, json.Valid(data), name)
+
+		_, err = compiledSchema(name)
+		require.NoError(t, err, name)
+	}
+
+	_, err := Schema("serviceclass")
+	assert.ErrorContains(t, err, "unknown schema")
+}
+
+func TestValidateSchema(t *testing.T) {
+	err := ValidateSchema(SchemaConfig, "config.yaml", []byte("aggregator:\n  port: high\n  host: [localhost]\n"))
+	var schemaErr *SchemaError
+	require.True(t, errors.As(err, &schemaErr))
+	require.Len(t, schemaErr.Issues, 2)
+	assert.Equal(t, "aggregator.port", schemaErr.Issues[0].Path)
+	assert.Equal(t, 2, schemaErr.Issues[0].Line)
+	assert.Equal(t, "aggregator.host", schemaErr.Issues[1].Path)
+	assert.Equal(t, 3, schemaErr.Issues[1].Line)
+	assert.Contains(t, err.Error(), "config.yaml:2:")
+
+	// Unknown fields and nulls do not prevent loading
+	assert.NoError(t, ValidateSchema(SchemaConfig, "config.yaml", []byte("aggregator:\n  port: 8090\n  events: true\nnamespace:\n")))
+	assert.NoError(t, ValidateSchema(SchemaConfig, "config.yaml", nil))
+
+	err = ValidateSchema(SchemaWorkflow, "deploy.yaml", []byte(`apiVersion: muster.giantswarm.io/v1alpha1
+kind: Workflow
+metadata:
+  name: deploy
+spec:
+  steps:
+    - id: apply
+      tool: x_kubectl_apply
+      store: sometimes
+`))
+	require.True(t, errors.As(err, &schemaErr))
+	require.Len(t, schemaErr.Issues, 1)
+	assert.Equal(t, "spec.steps[0].store", schemaErr.Issues[0].Path)
+	assert.Equal(t, 9, schemaErr.Issues[0].Line)
+}
+
+func TestValidateDirectory_SchemaErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  port: \"8090\"\n")
+	writeConfigFile(t, dir, "mcpservers/kube.yaml", `apiVersion: muster.giantswarm.io/v1alpha1
+kind: MCPServer
+metadata:
+  name: kube
+spec:
+  type: stdio
+  command: [mcp-kubernetes]
+`)
+
+	report, err := ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue := findIssue(report, "want integer")
+	require.NotNil(t, issue)
+	assert.Equal(t, "aggregator.port", issue.Path)
+	assert.Equal(t, 2, issue.Line)
+
+	issue = findIssue(report, "want string")
+	require.NotNil(t, issue)
+	assert.Equal(t, "spec.command", issue.Path)
+	assert.Equal(t, 7, issue.Line)
+}
+
+// TestSchemasCoverTypes guards against fields added to the Go types without
+// updating the shipped schemas, which would then reject them.
+func TestSchemasCoverTypes(t *testing.T) {
+	cases := []struct {
+		schema string
+		typ    reflect.Type
+		tag    string
+		path   []string
+	}{
+		{SchemaConfig, reflect.TypeOf(MusterConfig{}), "yaml", nil},
+		{SchemaMCPServer, reflect.TypeOf(musterv1alpha1.MCPServerSpec{}), "json", []string{"spec"}},
+		{SchemaWorkflow, reflect.TypeOf(musterv1alpha1.WorkflowSpec{}), "json", []string{"spec"}},
+	}
+	for _, tc := range cases {
+		data, err := Schema(tc.schema)
+		require.NoError(t, err)
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &doc))
+
+		node := doc
+		for _, key := range tc.path {
+			node = schemaProperty(t, doc, node, key)
+			require.NotNil(t, node, "%s: %s", tc.schema, key)
+		}
+		assertSchemaCovers(t, doc, node, tc.typ, tc.tag, tc.schema)
+	}
+}
+
+// assertSchemaCovers checks that the schema node declares every field of t,
+// recursing through the muster types nested in structs, maps, and slices.
+func assertSchemaCovers(t *testing.T, doc, node map[string]interface{}, typ reflect.Type, tag, path string) {
+	t.Helper()
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	node = resolveSchemaRef(t, doc, node)
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		if !strings.HasPrefix(typ.PkgPath(), "github.com/giantswarm/muster/") {
+			return
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+			if name == "-" || !f.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			prop := schemaProperty(t, doc, node, name)
+			if !assert.NotNil(t, prop, "%s.%s has no schema property", path, name) {
+				continue
+			}
+			assertSchemaCovers(t, doc, prop, f.Type, tag, path+"."+name)
+		}
+	case reflect.Map:
+		if item, ok := node["additionalProperties"].(map[string]interface{}); ok {
+			assertSchemaCovers(t, doc, item, typ.Elem(), tag, path+".*")
+		}
+	case reflect.Slice:
+		if item, ok := node["items"].(map[string]interface{}); ok {
+			assertSchemaCovers(t, doc, item, typ.Elem(), tag, path+"[]")
+		}
+	}
+}
+
+// schemaProperty returns the schema of the named property of node.
+func schemaProperty(t *testing.T, doc, node map[string]interface{}, name string) map[string]interface{} {
+	t.Helper()
+	properties, _ := resolveSchemaRef(t, doc, node)["properties"].(map[string]interface{})
+	prop, _ := properties[name].(map[string]interface{})
+	return prop
+}
+
+// resolveSchemaRef follows a local $ref of the form #/$defs/<name>.
+func resolveSchemaRef(t *testing.T, doc, node map[string]interface{}) map[string]interface{} {
+	t.Helper()
+	ref, ok := node["$ref"].(string)
+	if !ok {
+		return node
+	}
+	defs, _ := doc["$defs"].(map[string]interface{})
+	def, ok := defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]interface{})
+	require.True(t, ok, "unresolved $ref %s", ref)
+	return def
+}
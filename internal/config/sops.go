@@ -0,0 +1,395 @@
+package config
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"gopkg.in/yaml.v3"
+)
+
+// sopsMetadataKey is the top-level key holding the SOPS metadata of an
+// encrypted file.
+const sopsMetadataKey = "sops"
+
+// sopsValue matches a value encrypted by SOPS.
+var sopsValue = regexp.MustCompile(`^ENC\[AES256_GCM,data:([^,]*),iv:([^,]+),tag:([^,]+),type:(str|int|float|bool|bytes|comment)\]$`)
+
+// ErrNoAgeIdentity is returned when a configuration file holds secrets but no
+// age identity is configured to decrypt them.
+var ErrNoAgeIdentity = errors.New("no age identity found: set SOPS_AGE_KEY or SOPS_AGE_KEY_FILE, or create the sops age keys.txt in the user config directory")
+
+// sopsMetadata is the part of the SOPS metadata needed to decrypt a file with
+// age.
+type sopsMetadata struct {
+	Age []struct {
+		Recipient string `yaml:"recipient"`
+		Enc       string `yaml:"enc"`
+	} `yaml:"age"`
+	KeyGroups        []interface{} `yaml:"key_groups"`
+	LastModified     string        `yaml:"lastmodified"`
+	MAC              string        `yaml:"mac"`
+	MACOnlyEncrypted bool          `yaml:"mac_only_encrypted"`
+}
+
+// Decrypt decrypts the secrets in a YAML document: a file encrypted with
+// SOPS using age recipients, and values that are ASCII-armored age files
+// (`age --armor`). The age identities are read like sops does, from
+// SOPS_AGE_KEY, the file named by SOPS_AGE_KEY_FILE, or sops/age/keys.txt in
+// the user config directory.
+//
+// The SOPS metadata is removed from the result, and the MAC of a SOPS file is
+// verified so tampered files are rejected. Documents without secrets, or that
+// are not valid YAML, are returned unchanged.
+func Decrypt(data []byte) ([]byte, error) {
+	if !mayHoldSecrets(data) {
+		return data, nil
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return data, nil
+	}
+	changed, err := decryptDocument(&root)
+	if err != nil || !changed {
+		return data, err
+	}
+
+	decrypted, err := yaml.Marshal(&root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode decrypted YAML: %w", err)
+	}
+	return decrypted, nil
+}
+
+// IsSOPSEncrypted reports whether data is a YAML document encrypted with
+// SOPS. Such files cannot be rewritten without re-encrypting them.
+func IsSOPSEncrypted(data []byte) bool {
+	if !bytes.Contains(data, []byte(sopsMetadataKey+":")) {
+		return false
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return false
+	}
+	_, metadata := sopsMetadataNode(&root)
+	return metadata != nil
+}
+
+// mayHoldSecrets is a cheap check for documents that need decrypting.
+func mayHoldSecrets(data []byte) bool {
+	return bytes.Contains(data, []byte(sopsMetadataKey+":")) || bytes.Contains(data, []byte(armor.Header))
+}
+
+// decryptDocument decrypts root in place and reports whether it held any
+// secrets.
+func decryptDocument(root *yaml.Node) (bool, error) {
+	d := &decrypter{}
+	doc, metadata := sopsMetadataNode(root)
+	if metadata != nil {
+		if err := d.useSOPS(metadata); err != nil {
+			return false, err
+		}
+		removeMappingKey(doc, sopsMetadataKey)
+	}
+
+	changed, err := d.walk(root, nil)
+	if err != nil {
+		return false, err
+	}
+	if d.sops != nil {
+		if err := d.verifyMAC(); err != nil {
+			return false, err
+		}
+	}
+	return changed || metadata != nil, nil
+}
+
+// sopsMetadataNode returns the top-level mapping of root and its SOPS
+// metadata, if any.
+func sopsMetadataNode(root *yaml.Node) (*yaml.Node, *yaml.Node) {
+	doc := lookupNode(root)
+	if doc == nil || doc.Kind != yaml.MappingNode {
+		return doc, nil
+	}
+	metadata := mappingValue(doc, sopsMetadataKey)
+	if metadata == nil || metadata.Kind != yaml.MappingNode {
+		return doc, nil
+	}
+	return doc, metadata
+}
+
+// removeMappingKey removes key and its value from a mapping node.
+func removeMappingKey(node *yaml.Node, key string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// decrypter decrypts the values of a document, loading the age identities on
+// first use.
+type decrypter struct {
+	identities []age.Identity
+
+	// sops is set for SOPS files
+	sops *sopsState
+}
+
+// sopsState holds the data key of a SOPS file and the running MAC over its
+// values.
+type sopsState struct {
+	metadata sopsMetadata
+	key      []byte
+	mac      hash.Hash
+}
+
+// ageIdentities returns the configured age identities.
+func (d *decrypter) ageIdentities() ([]age.Identity, error) {
+	if d.identities != nil {
+		return d.identities, nil
+	}
+
+	var sources []io.Reader
+	if key := os.Getenv("SOPS_AGE_KEY"); key != "" {
+		sources = append(sources, strings.NewReader(key))
+	}
+	keyFile := os.Getenv("SOPS_AGE_KEY_FILE")
+	if keyFile == "" {
+		if dir, err := os.UserConfigDir(); err == nil {
+			if _, err := os.Stat(filepath.Join(dir, "sops", "age", "keys.txt")); err == nil {
+				keyFile = filepath.Join(dir, "sops", "age", "keys.txt")
+			}
+		}
+	}
+	if keyFile != "" {
+		data, err := os.ReadFile(keyFile) //nolint:gosec
+		if err != nil {
+			return nil, fmt.Errorf("failed to read age key file: %w", err)
+		}
+		sources = append(sources, bytes.NewReader(data))
+	}
+
+	for _, source := range sources {
+		ids, err := age.ParseIdentities(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse age identities: %w", err)
+		}
+		d.identities = append(d.identities, ids...)
+	}
+	if len(d.identities) == 0 {
+		return nil, ErrNoAgeIdentity
+	}
+	return d.identities, nil
+}
+
+// decryptAge decrypts an ASCII-armored age file.
+func (d *decrypter) decryptAge(armored string) ([]byte, error) {
+	ids, err := d.ageIdentities()
+	if err != nil {
+		return nil, err
+	}
+	r, err := age.Decrypt(armor.NewReader(strings.NewReader(strings.TrimSpace(armored))), ids...)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// useSOPS decrypts the data key of a SOPS file with one of its age
+// recipients.
+func (d *decrypter) useSOPS(node *yaml.Node) error {
+	var metadata sopsMetadata
+	if err := node.Decode(&metadata); err != nil {
+		return fmt.Errorf("invalid sops metadata: %w", err)
+	}
+	if len(metadata.KeyGroups) > 0 {
+		return fmt.Errorf("sops key groups are not supported, encrypt the file with age recipients only")
+	}
+	if len(metadata.Age) == 0 {
+		return fmt.Errorf("the file is not encrypted for any age recipient, other sops key types are not supported")
+	}
+
+	var lastErr error
+	for _, recipient := range metadata.Age {
+		key, err := d.decryptAge(recipient.Enc)
+		if errors.Is(err, ErrNoAgeIdentity) {
+			return err
+		}
+		if err == nil {
+			d.sops = &sopsState{metadata: metadata, key: key, mac: sha512.New()}
+			return nil
+		}
+		lastErr = err
+	}
+	return fmt.Errorf("failed to decrypt the sops data key: %w", lastErr)
+}
+
+// walk decrypts the values below node and reports whether any were
+// encrypted. path holds the mapping keys leading to node; like in SOPS,
+// sequence indexes are not part of it.
+func (d *decrypter) walk(node *yaml.Node, path []string) (bool, error) {
+	changed := false
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			c, err := d.walk(child, path)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			c, err := d.walk(node.Content[i+1], append(path[:len(path):len(path)], node.Content[i].Value))
+			if err != nil {
+				return false, err
+			}
+			changed = changed || c
+		}
+	case yaml.ScalarNode:
+		return d.decryptScalar(node, path)
+	}
+	return changed, nil
+}
+
+// decryptScalar decrypts a SOPS or age encrypted value in place.
+func (d *decrypter) decryptScalar(node *yaml.Node, path []string) (bool, error) {
+	if d.sops != nil {
+		if match := sopsValue.FindStringSubmatch(node.Value); match != nil {
+			return true, d.sops.decryptValue(node, match, strings.Join(path, ":")+":")
+		}
+		if !d.sops.metadata.MACOnlyEncrypted {
+			var value interface{}
+			if err := node.Decode(&value); err != nil {
+				return false, fmt.Errorf("%s: %w", strings.Join(path, "."), err)
+			}
+			d.sops.mac.Write(sopsMACBytes(value))
+		}
+	}
+
+	if !strings.HasPrefix(strings.TrimSpace(node.Value), armor.Header) {
+		return false, nil
+	}
+	plaintext, err := d.decryptAge(node.Value)
+	if err != nil {
+		return false, fmt.Errorf("failed to decrypt %s: %w", strings.Join(path, "."), err)
+	}
+	node.Value, node.Tag, node.Style = string(plaintext), "!!str", 0
+	return true, nil
+}
+
+// decryptValue decrypts a value matched by sopsValue in place, restoring its
+// type, and adds it to the MAC.
+func (s *sopsState) decryptValue(node *yaml.Node, match []string, additionalData string) error {
+	plaintext, err := decryptSOPSValue(s.key, match, additionalData)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: %w", strings.TrimSuffix(strings.ReplaceAll(additionalData, ":", "."), "."), err)
+	}
+
+	var value interface{}
+	switch valueType := match[4]; valueType {
+	case "int":
+		i, err := strconv.Atoi(string(plaintext))
+		if err != nil {
+			return fmt.Errorf("invalid encrypted int: %w", err)
+		}
+		value, node.Value, node.Tag = i, strconv.Itoa(i), "!!int"
+	case "float":
+		f, err := strconv.ParseFloat(string(plaintext), 64)
+		if err != nil {
+			return fmt.Errorf("invalid encrypted float: %w", err)
+		}
+		value, node.Value, node.Tag = f, strconv.FormatFloat(f, 'f', -1, 64), "!!float"
+	case "bool":
+		b, err := strconv.ParseBool(string(plaintext))
+		if err != nil {
+			return fmt.Errorf("invalid encrypted bool: %w", err)
+		}
+		value, node.Value, node.Tag = b, strconv.FormatBool(b), "!!bool"
+	default:
+		value, node.Value, node.Tag = string(plaintext), string(plaintext), "!!str"
+	}
+	node.Style = 0
+	s.mac.Write(sopsMACBytes(value))
+	return nil
+}
+
+// verifyMAC checks the MAC over all values of a SOPS file.
+func (d *decrypter) verifyMAC() error {
+	match := sopsValue.FindStringSubmatch(d.sops.metadata.MAC)
+	if match == nil {
+		return fmt.Errorf("the sops metadata has no valid MAC")
+	}
+	stored, err := decryptSOPSValue(d.sops.key, match, d.sops.metadata.LastModified)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt the sops MAC: %w", err)
+	}
+	if computed := fmt.Sprintf("%X", d.sops.mac.Sum(nil)); string(stored) != computed {
+		return fmt.Errorf("sops MAC mismatch: the file was modified without sops")
+	}
+	return nil
+}
+
+// decryptSOPSValue decrypts the AES-256-GCM ciphertext of a value matched by
+// sopsValue.
+func decryptSOPSValue(key []byte, match []string, additionalData string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(match[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+	iv, err := base64.StdEncoding.DecodeString(match[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid iv: %w", err)
+	}
+	tag, err := base64.StdEncoding.DecodeString(match[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(iv))
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, iv, append(data, tag...), []byte(additionalData))
+}
+
+// sopsMACBytes returns the bytes SOPS adds to the MAC for a value.
+func sopsMACBytes(value interface{}) []byte {
+	switch v := value.(type) {
+	case string:
+		return []byte(v)
+	case int:
+		return []byte(strconv.Itoa(v))
+	case float64:
+		return []byte(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		if v {
+			return []byte("True")
+		}
+		return []byte("False")
+	case nil:
+		return nil
+	default:
+		return []byte(fmt.Sprint(v))
+	}
+}
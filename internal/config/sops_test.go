@@ -0,0 +1,225 @@
+package config
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const testLastModified = "2025-01-01T00:00:00Z"
+
+// useTestAgeIdentity configures a fresh age identity like SOPS_AGE_KEY does
+// and returns it.
+func useTestAgeIdentity(t *testing.T) *age.X25519Identity {
+	t.Helper()
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	t.Setenv("SOPS_AGE_KEY", identity.String())
+	t.Setenv("SOPS_AGE_KEY_FILE", "")
+	return identity
+}
+
+// ageArmor encrypts plaintext to recipient as an ASCII-armored age file.
+func ageArmor(t *testing.T, recipient age.Recipient, plaintext []byte) string {
+	t.Helper()
+	var buf bytes.Buffer
+	a := armor.NewWriter(&buf)
+	w, err := age.Encrypt(a, recipient)
+	require.NoError(t, err)
+	_, err = w.Write(plaintext)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, a.Close())
+	return buf.String()
+}
+
+// sopsEncrypt encrypts a YAML document the way `sops --encrypt --age` does:
+// every value except those under keys ending in _unencrypted, plus the MAC
+// over all values.
+func sopsEncrypt(t *testing.T, recipient *age.X25519Recipient, plain string) []byte {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := io.ReadFull(rand.Reader, key)
+	require.NoError(t, err)
+
+	var root yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(plain), &root))
+	mac := sha512.New()
+	sopsEncryptNode(t, key, mac, root.Content[0], nil, false)
+
+	metadata := map[string]interface{}{
+		"age": []map[string]string{{
+			"recipient": recipient.String(),
+			"enc":       ageArmor(t, recipient, key),
+		}},
+		"lastmodified":       testLastModified,
+		"mac":                sopsEncryptValue(t, key, fmt.Sprintf("%X", mac.Sum(nil)), "str", testLastModified),
+		"unencrypted_suffix": "_unencrypted",
+		"version":            "3.9.0",
+	}
+	var metadataNode yaml.Node
+	require.NoError(t, metadataNode.Encode(metadata))
+	root.Content[0].Content = append(root.Content[0].Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "sops"}, &metadataNode)
+
+	data, err := yaml.Marshal(&root)
+	require.NoError(t, err)
+	return data
+}
+
+func sopsEncryptNode(t *testing.T, key []byte, mac hash.Hash, node *yaml.Node, path []string, unencrypted bool) {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, child := range node.Content {
+			sopsEncryptNode(t, key, mac, child, path, unencrypted)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			k := node.Content[i].Value
+			sopsEncryptNode(t, key, mac, node.Content[i+1], append(path[:len(path):len(path)], k), unencrypted || strings.HasSuffix(k, "_unencrypted"))
+		}
+	case yaml.ScalarNode:
+		var value interface{}
+		require.NoError(t, node.Decode(&value))
+		plaintext := sopsMACBytes(value)
+		mac.Write(plaintext)
+		if unencrypted {
+			return
+		}
+		valueType := map[string]string{"!!int": "int", "!!float": "float", "!!bool": "bool"}[node.Tag]
+		if valueType == "" {
+			valueType = "str"
+		}
+		node.Value = sopsEncryptValue(t, key, string(plaintext), valueType, strings.Join(path, ":")+":")
+		node.Tag, node.Style = "!!str", 0
+	}
+}
+
+func sopsEncryptValue(t *testing.T, key []byte, plaintext, valueType, additionalData string) string {
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	iv := make([]byte, 32)
+	_, err = io.ReadFull(rand.Reader, iv)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(iv))
+	require.NoError(t, err)
+	sealed := gcm.Seal(nil, iv, []byte(plaintext), []byte(additionalData))
+	data, tag := sealed[:len(sealed)-gcm.Overhead()], sealed[len(sealed)-gcm.Overhead():]
+	enc := base64.StdEncoding.EncodeToString
+	return fmt.Sprintf("ENC[AES256_GCM,data:%s,iv:%s,tag:%s,type:%s]", enc(data), enc(iv), enc(tag), valueType)
+}
+
+func TestDecrypt_SOPS(t *testing.T) {
+	identity := useTestAgeIdentity(t)
+	encrypted := sopsEncrypt(t, identity.Recipient(), `apiVersion_unencrypted: v1
+spec:
+  command: mcp-kubernetes
+  port: 8090
+  enabled: true
+  args: [--token, secret]
+  env:
+    TOKEN: s3cr3t
+`)
+	assert.NotContains(t, string(encrypted), "s3cr3t")
+	assert.True(t, IsSOPSEncrypted(encrypted))
+
+	decrypted, err := Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.False(t, IsSOPSEncrypted(decrypted))
+
+	var got map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(decrypted, &got))
+	assert.Equal(t, map[string]interface{}{
+		"apiVersion_unencrypted": "v1",
+		"spec": map[string]interface{}{
+			"command": "mcp-kubernetes",
+			"port":    8090,
+			"enabled": true,
+			"args":    []interface{}{"--token", "secret"},
+			"env":     map[string]interface{}{"TOKEN": "s3cr3t"},
+		},
+	}, got)
+
+	// Values cannot be changed or moved without sops
+	tampered := bytes.Replace(encrypted, []byte("apiVersion_unencrypted: v1"), []byte("apiVersion_unencrypted: v2"), 1)
+	_, err = Decrypt(tampered)
+	assert.ErrorContains(t, err, "MAC mismatch")
+
+	_, err = Decrypt(bytes.Replace(encrypted, []byte("TOKEN:"), []byte("OTHER:"), 1))
+	assert.ErrorContains(t, err, "failed to decrypt spec.env.OTHER")
+
+	// Another identity cannot decrypt the file
+	useTestAgeIdentity(t)
+	_, err = Decrypt(encrypted)
+	assert.ErrorContains(t, err, "data key")
+}
+
+func TestDecrypt_AgeValues(t *testing.T) {
+	identity := useTestAgeIdentity(t)
+	armored := ageArmor(t, identity.Recipient(), []byte("s3cr3t"))
+
+	var doc yaml.Node
+	require.NoError(t, doc.Encode(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"headers": map[string]string{"Authorization": armored},
+			"url":     "https://example.com/mcp",
+		},
+	}))
+	data, err := yaml.Marshal(&doc)
+	require.NoError(t, err)
+
+	decrypted, err := Decrypt(data)
+	require.NoError(t, err)
+	assert.Equal(t, "spec:\n    headers:\n        Authorization: s3cr3t\n    url: https://example.com/mcp\n", string(decrypted))
+
+	plain := []byte("spec:\n  url: https://example.com/mcp\n")
+	unchanged, err := Decrypt(plain)
+	require.NoError(t, err)
+	assert.Equal(t, plain, unchanged)
+}
+
+func TestDecrypt_NoIdentity(t *testing.T) {
+	identity := useTestAgeIdentity(t)
+	data := sopsEncrypt(t, identity.Recipient(), "aggregator:\n  host: localhost\n")
+
+	t.Setenv("SOPS_AGE_KEY", "")
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	_, err := Decrypt(data)
+	assert.ErrorIs(t, err, ErrNoAgeIdentity)
+
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "config.yaml", string(data))
+	report, err := ValidateDirectory(dir)
+	require.NoError(t, err)
+	assert.True(t, report.Valid())
+	require.NotNil(t, findIssue(report, "encrypted values are not checked"))
+}
+
+func TestLoadConfig_DecryptsSOPS(t *testing.T) {
+	identity := useTestAgeIdentity(t)
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "config.yaml", string(sopsEncrypt(t, identity.Recipient(), "aggregator:\n  port: 9123\n  host: 127.0.0.1\n")))
+
+	cfg, err := LoadConfig(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 9123, cfg.Aggregator.Port)
+	assert.Equal(t, "127.0.0.1", cfg.Aggregator.Host)
+
+	report, err := ValidateDirectory(dir)
+	require.NoError(t, err)
+	assert.Empty(t, report.Issues)
+}
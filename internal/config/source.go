@@ -0,0 +1,302 @@
+package config
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// sourceEntityTypes are the entity directories copied from a remote source.
+var sourceEntityTypes = []string{"mcpservers", "workflows"}
+
+// maxSourceSize bounds the total size of the files read from a remote source.
+const maxSourceSize = 32 << 20
+
+// sourceHTTPClient fetches HTTP and S3 sources.
+var sourceHTTPClient = &http.Client{Timeout: time.Minute}
+
+// RemoteStorage is a read-only EntityStorage holding a configuration
+// directory fetched from a remote source.
+type RemoteStorage struct {
+	source string
+	size   int
+	// files maps slash-separated paths relative to the configuration
+	// directory to their content
+	files map[string][]byte
+}
+
+func newRemoteStorage(source string) *RemoteStorage {
+	return &RemoteStorage{source: source, files: map[string][]byte{}}
+}
+
+// add stores a file if it is config.yaml or an entity definition, of the
+// configuration directory or of a profile, and ignores anything else.
+func (r *RemoteStorage) add(rel string, data []byte) error {
+	rel = path.Clean(strings.TrimPrefix(rel, "./"))
+	if !isSourceFile(rel) {
+		if parts := strings.SplitN(rel, "/", 3); len(parts) != 3 || parts[0] != ProfilesDir ||
+			!isHistoryPathElement(parts[1]) || !isSourceFile(parts[2]) {
+			return nil
+		}
+	}
+
+	r.size += len(data)
+	if r.size > maxSourceSize {
+		return fmt.Errorf("configuration in %s exceeds %d bytes", r.source, maxSourceSize)
+	}
+	r.files[rel] = data
+	return nil
+}
+
+// isSourceFile reports whether rel, relative to a configuration or profile
+// directory, is config.yaml or an entity definition.
+func isSourceFile(rel string) bool {
+	dir, file := path.Split(rel)
+	dir = strings.TrimSuffix(dir, "/")
+	return rel == configFileName ||
+		(isSourceEntityType(dir) && file != "" && (strings.HasSuffix(file, ".yaml") || strings.HasSuffix(file, ".yml")))
+}
+
+func isSourceEntityType(entityType string) bool {
+	for _, t := range sourceEntityTypes {
+		if t == entityType {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigFile returns the config.yaml of the source, if it has one.
+func (r *RemoteStorage) ConfigFile() ([]byte, bool) {
+	data, ok := r.files[configFileName]
+	return data, ok
+}
+
+// Load returns the definition of the named entity.
+func (r *RemoteStorage) Load(entityType string, name string) ([]byte, error) {
+	for _, ext := range []string{".yaml", ".yml"} {
+		if data, ok := r.files[entityType+"/"+name+ext]; ok {
+			return Decrypt(data)
+		}
+	}
+	return nil, fmt.Errorf("entity %s/%s not found in %s", entityType, name, r.source)
+}
+
+// List returns the names of the entities of the given type, sorted.
+func (r *RemoteStorage) List(entityType string) ([]string, error) {
+	names := []string{}
+	for rel := range r.files {
+		dir, file := path.Split(rel)
+		if strings.TrimSuffix(dir, "/") == entityType {
+			names = append(names, strings.TrimSuffix(file, path.Ext(file)))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Save always fails, as remote sources are read-only.
+func (r *RemoteStorage) Save(string, string, []byte) error {
+	return fmt.Errorf("cannot save to %s: %w", r.source, ErrReadOnlyStorage)
+}
+
+// Delete always fails, as remote sources are read-only.
+func (r *RemoteStorage) Delete(string, string) error {
+	return fmt.Errorf("cannot delete from %s: %w", r.source, ErrReadOnlyStorage)
+}
+
+// NewSourceStorage fetches the configuration directory of a remote source.
+// namespace is the ConfigMap namespace used when the source sets none.
+func NewSourceStorage(ctx context.Context, source SourceConfig, namespace string) (*RemoteStorage, error) {
+	switch source.Type {
+	case SourceTypeHTTP:
+		return fetchHTTPSource(ctx, source.HTTP)
+	case SourceTypeS3:
+		return fetchS3Source(ctx, source.S3)
+	case SourceTypeConfigMap:
+		cm := source.ConfigMap
+		if cm.Namespace == "" {
+			cm.Namespace = namespace
+		}
+		return fetchConfigMapSource(ctx, cm)
+	default:
+		return nil, fmt.Errorf("unsupported configuration source type %q (supported: %s, %s, %s)",
+			source.Type, SourceTypeHTTP, SourceTypeS3, SourceTypeConfigMap)
+	}
+}
+
+// SyncSource copies the configuration directory of cfg.Source into its cache
+// directory and returns the directory. The cached config.yaml, entity
+// definitions, and profiles are replaced, so definitions removed from the
+// source are removed locally. If the source has no config.yaml, the one in localDir is
+// used.
+func SyncSource(ctx context.Context, cfg MusterConfig, localDir string) (string, error) {
+	storage, err := NewSourceStorage(ctx, cfg.Source, cfg.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	dir := cfg.Source.CacheDir
+	if dir == "" {
+		dir = defaultSourceCacheDir(cfg.Source)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create configuration cache directory %s: %w", dir, err)
+	}
+	if err := clearSourceCache(dir); err != nil {
+		return "", err
+	}
+
+	configData, ok := storage.ConfigFile()
+	if !ok {
+		configData, err = os.ReadFile(filepath.Join(localDir, configFileName)) //nolint:gosec
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", configFileName, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, configFileName), configData, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", configFileName, err)
+	}
+
+	for _, entityType := range sourceEntityTypes {
+		if err := os.MkdirAll(filepath.Join(dir, entityType), 0o700); err != nil {
+			return "", fmt.Errorf("failed to create directory for %s: %w", entityType, err)
+		}
+	}
+	for rel, data := range storage.files {
+		if rel == configFileName {
+			continue
+		}
+		target := filepath.Join(dir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+			return "", fmt.Errorf("failed to create directory for %s: %w", rel, err)
+		}
+		if err := os.WriteFile(target, data, 0o600); err != nil {
+			return "", fmt.Errorf("failed to write %s: %w", rel, err)
+		}
+	}
+
+	logging.Info("ConfigSource", "Loaded configuration from %s into %s (%d files)", storage.source, dir, len(storage.files))
+	return dir, nil
+}
+
+// defaultSourceCacheDir returns a cache directory per user and source, so
+// muster instances with different sources do not share it.
+func defaultSourceCacheDir(source SourceConfig) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%d|%+v", os.Getuid(), source)
+	return filepath.Join(os.TempDir(), "muster-source-"+hex.EncodeToString(h.Sum(nil))[:12])
+}
+
+// clearSourceCache removes the files a previous SyncSource wrote to dir.
+func clearSourceCache(dir string) error {
+	if err := os.Remove(filepath.Join(dir, configFileName)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove cached %s: %w", configFileName, err)
+	}
+	patterns := []string{filepath.Join(ProfilesDir, "*", configFileName)}
+	for _, entityType := range sourceEntityTypes {
+		for _, ext := range []string{"*.yaml", "*.yml"} {
+			patterns = append(patterns, filepath.Join(entityType, ext), filepath.Join(ProfilesDir, "*", entityType, ext))
+		}
+	}
+	for _, pattern := range patterns {
+		files, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if err := os.Remove(file); err != nil {
+				return fmt.Errorf("failed to remove cached %s: %w", file, err)
+			}
+		}
+	}
+	return nil
+}
+
+// fetchHTTPSource downloads a tar archive of a configuration directory.
+func fetchHTTPSource(ctx context.Context, cfg HTTPSourceConfig) (*RemoteStorage, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("source.http.url is required")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cfg.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source URL: %w", err)
+	}
+	for name, value := range cfg.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := sourceHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch configuration from %s: %w", cfg.URL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch configuration from %s: %s", cfg.URL, resp.Status)
+	}
+
+	storage := newRemoteStorage(cfg.URL)
+	if err := readTarSource(storage, resp.Body, cfg.Path); err != nil {
+		return nil, fmt.Errorf("failed to read configuration archive from %s: %w", cfg.URL, err)
+	}
+	return storage, nil
+}
+
+// readTarSource adds the files below dir in a tar archive, which may be
+// gzip-compressed, to storage.
+func readTarSource(storage *RemoteStorage, r io.Reader, dir string) error {
+	buffered := bufio.NewReader(io.LimitReader(r, 4*maxSourceSize))
+	if magic, err := buffered.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = gz.Close() }()
+		r = gz
+	} else {
+		r = buffered
+	}
+
+	prefix := strings.Trim(path.Clean("/"+dir), "/")
+	archive := tar.NewReader(r)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		rel := strings.TrimPrefix(path.Clean("/"+header.Name), "/")
+		if prefix != "" {
+			if !strings.HasPrefix(rel, prefix+"/") {
+				continue
+			}
+			rel = strings.TrimPrefix(rel, prefix+"/")
+		}
+		data, err := io.ReadAll(io.LimitReader(archive, maxSourceSize+1))
+		if err != nil {
+			return err
+		}
+		if err := storage.add(rel, data); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// fetchConfigMapSource reads the configuration directory from a ConfigMap.
+// ConfigMap keys cannot contain slashes, so entity definitions are stored
+// under keys of the form <directory>.<file>, e.g. mcpservers.github.yaml.
+func fetchConfigMapSource(ctx context.Context, cfg ConfigMapSourceConfig) (*RemoteStorage, error) {
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("source.configMap.name is required")
+	}
+	if cfg.Namespace == "" {
+		cfg.Namespace = "default"
+	}
+
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Kubernetes config: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes clientset: %w", err)
+	}
+	cm, err := clientset.CoreV1().ConfigMaps(cfg.Namespace).Get(ctx, cfg.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", cfg.Namespace, cfg.Name, err)
+	}
+
+	storage := newRemoteStorage(fmt.Sprintf("configmap %s/%s", cfg.Namespace, cfg.Name))
+	for key, value := range cm.Data {
+		if err := addConfigMapKey(storage, key, []byte(value)); err != nil {
+			return nil, err
+		}
+	}
+	for key, value := range cm.BinaryData {
+		if err := addConfigMapKey(storage, key, value); err != nil {
+			return nil, err
+		}
+	}
+	return storage, nil
+}
+
+func addConfigMapKey(storage *RemoteStorage, key string, data []byte) error {
+	rel, ok := configMapFilePath(key)
+	if !ok {
+		return nil
+	}
+	return storage.add(rel, data)
+}
+
+// configMapFilePath maps a ConfigMap key to the path of the file it holds,
+// and reports false for keys that hold no configuration.
+func configMapFilePath(key string) (string, bool) {
+	if key == configFileName {
+		return key, true
+	}
+	entityType, file, ok := strings.Cut(key, ".")
+	if !ok || !isSourceEntityType(entityType) || !strings.Contains(file, ".") {
+		return "", false
+	}
+	return entityType + "/" + file, true
+}
@@ -0,0 +1,265 @@
+package config
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// emptyPayloadHash is the SHA-256 of an empty request body.
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// s3Credentials are the AWS credentials requests are signed with.
+type s3Credentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+// s3Client reads objects from a bucket with the S3 REST API.
+type s3Client struct {
+	// baseURL addresses the bucket, without a trailing slash
+	baseURL     string
+	region      string
+	credentials *s3Credentials
+	now         func() time.Time
+}
+
+// listBucketResult is the response of ListObjectsV2.
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// newS3Client addresses the bucket virtual-hosted style on AWS, and
+// path-style on a custom endpoint.
+func newS3Client(cfg S3SourceConfig) (*s3Client, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("source.s3.bucket is required")
+	}
+	region := cfg.Region
+	for _, env := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		if region == "" {
+			region = os.Getenv(env)
+		}
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	c := &s3Client{region: region, now: time.Now}
+	if cfg.Endpoint != "" {
+		c.baseURL = strings.TrimSuffix(cfg.Endpoint, "/") + "/" + awsURIEscape(cfg.Bucket, false)
+	} else {
+		c.baseURL = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", cfg.Bucket, region)
+	}
+	if id, secret := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY"); id != "" && secret != "" {
+		c.credentials = &s3Credentials{accessKeyID: id, secretAccessKey: secret, sessionToken: os.Getenv("AWS_SESSION_TOKEN")}
+	}
+	return c, nil
+}
+
+// fetchS3Source reads the configuration directory below the prefix of a
+// bucket.
+func fetchS3Source(ctx context.Context, cfg S3SourceConfig) (*RemoteStorage, error) {
+	client, err := newS3Client(cfg)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.Trim(cfg.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	storage := newRemoteStorage(fmt.Sprintf("s3://%s/%s", cfg.Bucket, prefix))
+
+	keys, err := client.list(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		rel := strings.TrimPrefix(key, prefix)
+		if rel != configFileName && !strings.HasSuffix(rel, ".yaml") && !strings.HasSuffix(rel, ".yml") {
+			continue
+		}
+		data, err := client.get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if err := storage.add(rel, data); err != nil {
+			return nil, err
+		}
+	}
+	return storage, nil
+}
+
+// list returns the keys of the objects below prefix.
+func (c *s3Client) list(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		query := map[string]string{"list-type": "2", "prefix": prefix}
+		if token != "" {
+			query["continuation-token"] = token
+		}
+		body, err := c.do(ctx, "/", query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", c.baseURL, err)
+		}
+		var result listBucketResult
+		if err := xml.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed to parse object list of %s: %w", c.baseURL, err)
+		}
+		for _, object := range result.Contents {
+			keys = append(keys, object.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// get returns the content of an object.
+func (c *s3Client) get(ctx context.Context, key string) ([]byte, error) {
+	body, err := c.do(ctx, "/"+awsURIEscape(key, true), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	return body, nil
+}
+
+// do sends a signed GET request for the escaped path below the bucket.
+func (c *s3Client) do(ctx context.Context, escapedPath string, query map[string]string) ([]byte, error) {
+	rawQuery := canonicalQuery(query)
+	target := c.baseURL + escapedPath
+	if rawQuery != "" {
+		target += "?" + rawQuery
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.sign(req, rawQuery)
+
+	resp, err := sourceHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSourceSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req, which
+// must not have a body. Requests are unsigned without credentials.
+func (c *s3Client) sign(req *http.Request, rawQuery string) {
+	if c.credentials == nil {
+		return
+	}
+	now := c.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	req.Header.Set("X-Amz-Date", amzDate)
+	if c.credentials.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", c.credentials.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(values[0])
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		rawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		emptyPayloadHash,
+	}, "\n")
+	scope := date + "/" + c.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hexSHA256(canonicalRequest)
+
+	key := []byte("AWS4" + c.credentials.secretAccessKey)
+	for _, part := range []string{date, c.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.credentials.accessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery encodes query parameters sorted by name, as SigV4 requires.
+func canonicalQuery(query map[string]string) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = awsURIEscape(name, false) + "=" + awsURIEscape(query[name], false)
+	}
+	return strings.Join(parts, "&")
+}
+
+// awsURIEscape percent-encodes everything but unreserved characters and,
+// with keepSlash, slashes.
+func awsURIEscape(s string, keepSlash bool) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && keepSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hexSHA256(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,11 +11,28 @@ import (
 	"github.com/giantswarm/muster/pkg/logging"
 )
 
+// EntityStorage stores entity definitions by entity type (the subdirectory,
+// e.g. workflows) and name. Storage implements it on a local configuration
+// directory; the remote configuration sources implement it read-only.
+type EntityStorage interface {
+	Save(entityType string, name string, data []byte) error
+	Load(entityType string, name string) ([]byte, error)
+	Delete(entityType string, name string) error
+	List(entityType string) ([]string, error)
+}
+
+// ErrReadOnlyStorage is returned when saving to or deleting from a read-only
+// EntityStorage.
+var ErrReadOnlyStorage = errors.New("storage is read-only")
+
+var _ EntityStorage = (*Storage)(nil)
+
 // Storage provides generic storage functionality for dynamic entities
 // using a single configuration directory approach
 type Storage struct {
 	mu         sync.RWMutex
 	configPath string
+	history    *History
 }
 
 // NewStorageWithPath creates a new Storage instance with a custom config path
@@ -28,6 +46,13 @@ func NewStorageWithPath(configPath string) *Storage {
 	}
 }
 
+// SetHistory makes the storage record every save and delete in h.
+func (ds *Storage) SetHistory(h *History) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	ds.history = h
+}
+
 // Save stores data for the given entity type and name
 // entityType: subdirectory name (workflows, mcpservers)
 // name: filename without extension
@@ -54,18 +79,25 @@ func (ds *Storage) Save(entityType string, name string, data []byte) error {
 	// Create file path with .yaml extension
 	filename := ds.sanitizeFilename(name) + ".yaml"
 	filePath := filepath.Join(targetDir, filename)
+	previous, _ := os.ReadFile(filePath) //nolint:gosec
 
 	// Write file
 	if err := os.WriteFile(filePath, data, 0644); err != nil { //nolint:gosec
 		return fmt.Errorf("failed to write file %s: %w", filePath, err)
 	}
+	if ds.history != nil {
+		if err := ds.history.RecordSave(entityType, ds.sanitizeFilename(name), previous, data); err != nil {
+			logging.Warn("Storage", "Failed to record history of %s/%s: %v", entityType, name, err)
+		}
+	}
 
 	logging.Info("Storage", "Saved %s/%s to %s", entityType, name, filePath)
 	return nil
 }
 
 // Load retrieves data for the given entity type and name
-// Returns the file content, or an error if not found
+// Returns the file content with SOPS or age encrypted values decrypted (see
+// Decrypt), or an error if not found
 func (ds *Storage) Load(entityType string, name string) ([]byte, error) {
 	if entityType == "" {
 		return nil, fmt.Errorf("entityType cannot be empty")
@@ -86,6 +118,10 @@ func (ds *Storage) Load(entityType string, name string) ([]byte, error) {
 		}
 		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
 	}
+	data, err = Decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt file %s: %w", filePath, err)
+	}
 
 	logging.Info("Storage", "Loaded %s/%s from %s", entityType, name, filePath)
 	return data, nil
@@ -107,13 +143,19 @@ func (ds *Storage) Delete(entityType string, name string) error {
 	filename := ds.sanitizeFilename(name) + ".yaml"
 	filePath := filepath.Join(ds.configPath, entityType, filename)
 
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+	previous, err := os.ReadFile(filePath) //nolint:gosec
+	if os.IsNotExist(err) {
 		return fmt.Errorf("entity %s/%s not found", entityType, name)
 	}
 
 	if err := os.Remove(filePath); err != nil {
 		return fmt.Errorf("failed to delete file %s: %w", filePath, err)
 	}
+	if ds.history != nil {
+		if err := ds.history.RecordDelete(entityType, ds.sanitizeFilename(name), previous); err != nil {
+			logging.Warn("Storage", "Failed to record history of %s/%s: %v", entityType, name, err)
+		}
+	}
 
 	logging.Info("Storage", "Deleted %s/%s from %s", entityType, name, filePath)
 	return nil
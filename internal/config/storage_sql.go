@@ -0,0 +1,245 @@
+package config
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	// Database drivers of the SQL storage engines; both are pure Go, so
+	// muster still builds without cgo.
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+)
+
+// DefaultSQLiteFile is the name of the SQLite database created in the
+// configuration directory when StorageConfig.Path is not set.
+const DefaultSQLiteFile = "muster.db"
+
+// sqlDialect holds what differs between the supported databases.
+type sqlDialect struct {
+	driver   string
+	blobType string
+	timeType string
+	// numbered placeholders ($1) instead of ?
+	numbered bool
+}
+
+var sqlDialects = map[string]sqlDialect{
+	StorageTypeSQLite:   {driver: "sqlite", blobType: "BLOB", timeType: "TIMESTAMP"},
+	StorageTypePostgres: {driver: "pgx", blobType: "BYTEA", timeType: "TIMESTAMPTZ", numbered: true},
+}
+
+// rebind rewrites the ? placeholders of query for the dialect.
+func (d sqlDialect) rebind(query string) string {
+	if !d.numbered {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+var _ EntityStorage = (*SQLStorage)(nil)
+
+// SQLStorage is an EntityStorage kept in a SQLite or PostgreSQL database.
+// Entities are rows of a single table keyed by entity type and name, so
+// listing an entity type is an index scan instead of a directory read, and
+// every write runs in its own transaction.
+type SQLStorage struct {
+	db      *sql.DB
+	dialect sqlDialect
+}
+
+// NewEntityStorage returns the EntityStorage selected by cfg, using the
+// configuration directory configPath for the filesystem engine and as the
+// default location of the SQLite database.
+func NewEntityStorage(ctx context.Context, cfg StorageConfig, configPath string) (EntityStorage, error) {
+	switch cfg.Type {
+	case "", StorageTypeFilesystem:
+		return NewStorageWithPath(configPath), nil
+	case StorageTypeSQLite:
+		path := cfg.Path
+		if path == "" {
+			path = filepath.Join(configPath, DefaultSQLiteFile)
+		}
+		return NewSQLStorage(ctx, StorageTypeSQLite, path)
+	case StorageTypePostgres:
+		if cfg.DSN == "" {
+			return nil, fmt.Errorf("storage.dsn is required for the postgres storage")
+		}
+		return NewSQLStorage(ctx, StorageTypePostgres, cfg.DSN)
+	default:
+		return nil, fmt.Errorf("unsupported storage type %q", cfg.Type)
+	}
+}
+
+// NewSQLStorage opens the database of the given storage type (StorageTypeSQLite
+// or StorageTypePostgres) and creates the entity table if it does not exist.
+// dsn is the database file for SQLite and the connection string for PostgreSQL.
+func NewSQLStorage(ctx context.Context, storageType, dsn string) (*SQLStorage, error) {
+	dialect, ok := sqlDialects[storageType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported storage type %q", storageType)
+	}
+	db, err := sql.Open(dialect.driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s storage: %w", storageType, err)
+	}
+	s := &SQLStorage{db: db, dialect: dialect}
+	if err := s.init(ctx, storageType); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *SQLStorage) init(ctx context.Context, storageType string) error {
+	if storageType == StorageTypeSQLite {
+		// SQLite allows a single writer; one connection avoids "database is
+		// locked" errors between muster's own goroutines
+		s.db.SetMaxOpenConns(1)
+		for _, pragma := range []string{"PRAGMA journal_mode=WAL", "PRAGMA busy_timeout=5000"} {
+			if _, err := s.db.ExecContext(ctx, pragma); err != nil {
+				return fmt.Errorf("failed to configure sqlite storage: %w", err)
+			}
+		}
+	}
+	if err := s.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to connect to %s storage: %w", storageType, err)
+	}
+	schema := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS muster_entities (
+	entity_type TEXT NOT NULL,
+	name TEXT NOT NULL,
+	data %s NOT NULL,
+	updated_at %s NOT NULL,
+	PRIMARY KEY (entity_type, name)
+)`, s.dialect.blobType, s.dialect.timeType)
+	if _, err := s.db.ExecContext(ctx, schema); err != nil {
+		return fmt.Errorf("failed to create %s storage table: %w", storageType, err)
+	}
+	return nil
+}
+
+// Close closes the database.
+func (s *SQLStorage) Close() error {
+	return s.db.Close()
+}
+
+// Save stores data for the given entity type and name, replacing the
+// previous data.
+func (s *SQLStorage) Save(entityType string, name string, data []byte) error {
+	if err := checkEntityKey(entityType, name); err != nil {
+		return err
+	}
+	if data == nil {
+		data = []byte{}
+	}
+	return s.withTx(func(tx *sql.Tx) error {
+		_, err := tx.Exec(s.dialect.rebind(`INSERT INTO muster_entities (entity_type, name, data, updated_at) VALUES (?, ?, ?, ?)
+ON CONFLICT (entity_type, name) DO UPDATE SET data = excluded.data, updated_at = excluded.updated_at`),
+			entityType, name, data, time.Now().UTC())
+		if err != nil {
+			return fmt.Errorf("failed to save %s/%s: %w", entityType, name, err)
+		}
+		return nil
+	})
+}
+
+// Load retrieves data for the given entity type and name, or an error if
+// not found.
+func (s *SQLStorage) Load(entityType string, name string) ([]byte, error) {
+	if err := checkEntityKey(entityType, name); err != nil {
+		return nil, err
+	}
+	var data []byte
+	err := s.db.QueryRow(s.dialect.rebind(`SELECT data FROM muster_entities WHERE entity_type = ? AND name = ?`),
+		entityType, name).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("entity %s/%s not found", entityType, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s/%s: %w", entityType, name, err)
+	}
+	return data, nil
+}
+
+// Delete removes the given entity.
+func (s *SQLStorage) Delete(entityType string, name string) error {
+	if err := checkEntityKey(entityType, name); err != nil {
+		return err
+	}
+	return s.withTx(func(tx *sql.Tx) error {
+		result, err := tx.Exec(s.dialect.rebind(`DELETE FROM muster_entities WHERE entity_type = ? AND name = ?`), entityType, name)
+		if err != nil {
+			return fmt.Errorf("failed to delete %s/%s: %w", entityType, name, err)
+		}
+		if n, err := result.RowsAffected(); err == nil && n == 0 {
+			return fmt.Errorf("entity %s/%s not found", entityType, name)
+		}
+		return nil
+	})
+}
+
+// List returns the names of the entities of the given type, sorted.
+func (s *SQLStorage) List(entityType string) ([]string, error) {
+	if entityType == "" {
+		return nil, fmt.Errorf("entityType cannot be empty")
+	}
+	rows, err := s.db.Query(s.dialect.rebind(`SELECT name FROM muster_entities WHERE entity_type = ? ORDER BY name`), entityType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", entityType, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", entityType, err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", entityType, err)
+	}
+	return names, nil
+}
+
+// withTx runs fn in a transaction, committing it if fn succeeds.
+func (s *SQLStorage) withTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func checkEntityKey(entityType, name string) error {
+	if entityType == "" {
+		return fmt.Errorf("entityType cannot be empty")
+	}
+	if name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	return nil
+}
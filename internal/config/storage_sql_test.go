@@ -0,0 +1,107 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLStorage(t *testing.T) {
+	storages := map[string]func(t *testing.T) StorageConfig{
+		StorageTypeSQLite: func(t *testing.T) StorageConfig {
+			return StorageConfig{Type: StorageTypeSQLite}
+		},
+		StorageTypePostgres: func(t *testing.T) StorageConfig {
+			dsn := os.Getenv("MUSTER_TEST_POSTGRES_DSN")
+			if dsn == "" {
+				t.Skip("MUSTER_TEST_POSTGRES_DSN is not set")
+			}
+			return StorageConfig{Type: StorageTypePostgres, DSN: dsn}
+		},
+	}
+	for storageType, config := range storages {
+		t.Run(storageType, func(t *testing.T) {
+			dir := t.TempDir()
+			storage, err := NewEntityStorage(context.Background(), config(t), dir)
+			require.NoError(t, err)
+			s := storage.(*SQLStorage)
+			defer func() { _ = s.Close() }()
+
+			// Start from an empty table when reusing a Postgres database
+			_, err = s.db.Exec(`DELETE FROM muster_entities`)
+			require.NoError(t, err)
+
+			require.NoError(t, s.Save("workflow_executions", "b", []byte(`{"id":"b"}`)))
+			require.NoError(t, s.Save("workflow_executions", "a", []byte(`{"id":"a"}`)))
+			require.NoError(t, s.Save("workflow_executions", "a", []byte(`{"id":"a2"}`)))
+			require.NoError(t, s.Save("workflows", "a", []byte("name: a\n")))
+
+			names, err := s.List("workflow_executions")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"a", "b"}, names)
+
+			data, err := s.Load("workflow_executions", "a")
+			require.NoError(t, err)
+			assert.Equal(t, `{"id":"a2"}`, string(data))
+
+			_, err = s.Load("workflow_executions", "c")
+			assert.ErrorContains(t, err, "not found")
+
+			require.NoError(t, s.Delete("workflow_executions", "a"))
+			assert.ErrorContains(t, s.Delete("workflow_executions", "a"), "not found")
+			names, err = s.List("workflow_executions")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"b"}, names)
+
+			assert.Error(t, s.Save("workflows", "", nil))
+			if storageType == StorageTypeSQLite {
+				assert.FileExists(t, filepath.Join(dir, DefaultSQLiteFile))
+			}
+		})
+	}
+}
+
+func TestSQLStorage_Reopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "muster.db")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+
+	s, err := NewSQLStorage(context.Background(), StorageTypeSQLite, path)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, s.Save("workflow_executions", fmt.Sprintf("exec-%03d", i), []byte("{}")))
+	}
+	require.NoError(t, s.Close())
+
+	s, err = NewSQLStorage(context.Background(), StorageTypeSQLite, path)
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+	names, err := s.List("workflow_executions")
+	require.NoError(t, err)
+	assert.Len(t, names, 100)
+	assert.Equal(t, "exec-000", names[0])
+}
+
+func TestNewEntityStorage(t *testing.T) {
+	dir := t.TempDir()
+	storage, err := NewEntityStorage(context.Background(), StorageConfig{}, dir)
+	require.NoError(t, err)
+	assert.IsType(t, &Storage{}, storage)
+
+	_, err = NewEntityStorage(context.Background(), StorageConfig{Type: StorageTypePostgres}, dir)
+	assert.ErrorContains(t, err, "dsn is required")
+
+	_, err = NewEntityStorage(context.Background(), StorageConfig{Type: "mysql"}, dir)
+	assert.ErrorContains(t, err, "unsupported storage type")
+}
+
+func TestSQLDialect_Rebind(t *testing.T) {
+	query := `SELECT data FROM muster_entities WHERE entity_type = ? AND name = ?`
+	assert.Equal(t, query, sqlDialects[StorageTypeSQLite].rebind(query))
+	assert.Equal(t, `SELECT data FROM muster_entities WHERE entity_type = $1 AND name = $2`,
+		sqlDialects[StorageTypePostgres].rebind(query))
+}
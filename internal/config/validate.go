@@ -0,0 +1,325 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IssueSeverity classifies a validation finding.
+type IssueSeverity string
+
+const (
+	// SeverityError marks a finding that prevents muster from loading the file.
+	SeverityError IssueSeverity = "error"
+	// SeverityWarning marks a finding that is tolerated at runtime but likely a mistake.
+	SeverityWarning IssueSeverity = "warning"
+)
+
+// ValidationIssue is a single finding produced by ValidateDirectory.
+// Line and Column are 1-based; zero means the position is unknown.
+type ValidationIssue struct {
+	File     string        `json:"file"`
+	Line     int           `json:"line,omitempty"`
+	Column   int           `json:"column,omitempty"`
+	Path     string        `json:"path,omitempty"`
+	Severity IssueSeverity `json:"severity"`
+	Message  string        `json:"message"`
+}
+
+// String renders the issue in the compiler-style "file:line:col: severity: message"
+// form understood by most editors and CI log viewers.
+func (i ValidationIssue) String() string {
+	var b strings.Builder
+	b.WriteString(i.File)
+	if i.Line > 0 {
+		fmt.Fprintf(&b, ":%d", i.Line)
+		if i.Column > 0 {
+			fmt.Fprintf(&b, ":%d", i.Column)
+		}
+	}
+	fmt.Fprintf(&b, ": %s: ", i.Severity)
+	if i.Path != "" {
+		fmt.Fprintf(&b, "%s: ", i.Path)
+	}
+	b.WriteString(i.Message)
+	return b.String()
+}
+
+// ValidationReport collects every finding for a configuration directory.
+type ValidationReport struct {
+	ConfigPath   string            `json:"configPath"`
+	FilesChecked int               `json:"filesChecked"`
+	Issues       []ValidationIssue `json:"issues"`
+}
+
+// ErrorCount returns the number of error-severity issues.
+func (r *ValidationReport) ErrorCount() int {
+	n := 0
+	for _, i := range r.Issues {
+		if i.Severity == SeverityError {
+			n++
+		}
+	}
+	return n
+}
+
+// WarningCount returns the number of warning-severity issues.
+func (r *ValidationReport) WarningCount() int {
+	return len(r.Issues) - r.ErrorCount()
+}
+
+// Valid reports whether the directory has no error-severity issues.
+func (r *ValidationReport) Valid() bool {
+	return r.ErrorCount() == 0
+}
+
+// issueSink accumulates issues for a single file.
+type issueSink struct {
+	file   string
+	issues []ValidationIssue
+	// overlay is set for profile files, which may leave required fields to
+	// the file they overlay
+	overlay bool
+}
+
+func (s *issueSink) add(sev IssueSeverity, node *yaml.Node, path, format string, args ...interface{}) {
+	issue := ValidationIssue{
+		File:     s.file,
+		Path:     path,
+		Severity: sev,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if node != nil {
+		issue.Line = node.Line
+		issue.Column = node.Column
+	}
+	s.issues = append(s.issues, issue)
+}
+
+func (s *issueSink) errorf(node *yaml.Node, path, format string, args ...interface{}) {
+	s.add(SeverityError, node, path, format, args...)
+}
+
+func (s *issueSink) warnf(node *yaml.Node, path, format string, args ...interface{}) {
+	s.add(SeverityWarning, node, path, format, args...)
+}
+
+// decrypt decrypts the SOPS or age encrypted values in root in place and
+// returns the document to decode. It reports false if the file cannot be
+// decrypted, which is only a warning when no age identity is configured, so
+// the configuration can be validated where its keys are not available.
+func (s *issueSink) decrypt(root *yaml.Node, data []byte) ([]byte, bool) {
+	if !mayHoldSecrets(data) {
+		return data, true
+	}
+	changed, err := decryptDocument(root)
+	if errors.Is(err, ErrNoAgeIdentity) {
+		s.warnf(lookupNode(root), "", "encrypted values are not checked: %v", err)
+		return nil, false
+	}
+	if err != nil {
+		s.errorf(lookupNode(root), "", "cannot decrypt: %v", err)
+		return nil, false
+	}
+	if !changed {
+		return data, true
+	}
+	decrypted, err := yaml.Marshal(root)
+	if err != nil {
+		return data, true
+	}
+	return decrypted, true
+}
+
+// expandEnv expands the environment variable references in root in place,
+// reporting undefined variables at the value referencing them, and returns the
+// document to decode. Decode errors in a document with references refer to the
+// lines of the expanded document.
+func (s *issueSink) expandEnv(root *yaml.Node, data []byte) []byte {
+	undefined := map[string]*yaml.Node{}
+	if !expandEnvNode(root, undefined) {
+		return data
+	}
+	names := make([]string, 0, len(undefined))
+	for name := range undefined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s.errorf(undefined[name], "", "environment variable %s is not set and has no default", name)
+	}
+	expanded, err := yaml.Marshal(root)
+	if err != nil {
+		return data
+	}
+	return expanded
+}
+
+// yamlLinePattern extracts the line number yaml.v3 embeds in its error text.
+var yamlLinePattern = regexp.MustCompile(`line (\d+): `)
+
+// addYAMLError converts a yaml.v3 decode error into one issue per reported
+// problem, preserving the line number when the decoder provides one.
+func (s *issueSink) addYAMLError(err error) {
+	var typeErr *yaml.TypeError
+	messages := []string{err.Error()}
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	}
+	for _, msg := range messages {
+		msg = strings.TrimPrefix(msg, "yaml: ")
+		issue := ValidationIssue{File: s.file, Severity: SeverityError, Message: msg}
+		if m := yamlLinePattern.FindStringSubmatchIndex(msg); m != nil {
+			issue.Line, _ = strconv.Atoi(msg[m[2]:m[3]])
+			issue.Message = msg[:m[0]] + msg[m[1]:]
+		}
+		s.issues = append(s.issues, issue)
+	}
+}
+
+// ValidateDirectory statically checks config.yaml and every entity definition
+// under configPath (mcpservers/, workflows/, and those of namespaces/ and
+// profiles/) without starting any service.
+// It returns an error only when the directory itself cannot be read; problems
+// inside files are reported as issues in the returned report.
+func ValidateDirectory(configPath string) (*ValidationReport, error) {
+	info, err := os.Stat(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot access config directory %s: %w", configPath, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("config path %s is not a directory", configPath)
+	}
+
+	report := &ValidationReport{ConfigPath: configPath, Issues: []ValidationIssue{}}
+
+	configFile := filepath.Join(configPath, configFileName)
+	if data, err := os.ReadFile(configFile); err == nil { //nolint:gosec
+		report.FilesChecked++
+		report.Issues = append(report.Issues, validateMainConfig(configFile, data)...)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read %s: %w", configFile, err)
+	}
+
+	entityDirs, err := namespaceDirs(configPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range entityDirs {
+		for _, kind := range entityValidators {
+			err := forEachYAMLFile(filepath.Join(dir, kind.dir), func(file string, data []byte) {
+				report.FilesChecked++
+				report.Issues = append(report.Issues, validateEntityFile(file, data, kind)...)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Profile files are checked on their own; the merged definitions are
+	// only known once a profile is selected
+	profiles, err := Profiles(configPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		profileDir := ProfilePath(configPath, profile)
+		profileConfig := filepath.Join(profileDir, configFileName)
+		if data, err := os.ReadFile(profileConfig); err == nil { //nolint:gosec
+			report.FilesChecked++
+			report.Issues = append(report.Issues, validateOverlayFile(profileConfig, data, SchemaConfig)...)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read %s: %w", profileConfig, err)
+		}
+		entityDirs, err := namespaceDirs(profileDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, dir := range entityDirs {
+			for _, kind := range entityValidators {
+				err := forEachYAMLFile(filepath.Join(dir, kind.dir), func(file string, data []byte) {
+					report.FilesChecked++
+					report.Issues = append(report.Issues, validateOverlayFile(file, data, kind.schema)...)
+				})
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(report.Issues, func(a, b int) bool {
+		ia, ib := report.Issues[a], report.Issues[b]
+		if ia.File != ib.File {
+			return ia.File < ib.File
+		}
+		return ia.Line < ib.Line
+	})
+	return report, nil
+}
+
+// validateOverlayFile checks a file of a profile: YAML syntax, and the named
+// schema except for required fields, which the file it overlays may set.
+func validateOverlayFile(file string, data []byte, schemaName string) []ValidationIssue {
+	sink := &issueSink{file: file, overlay: true}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		sink.addYAMLError(err)
+		return sink.issues
+	}
+	data, ok := sink.decrypt(&root, data)
+	if !ok {
+		return sink.issues
+	}
+	data = sink.expandEnv(&root, data)
+	if doc := lookupNode(&root); doc != nil && doc.Kind != yaml.MappingNode {
+		sink.errorf(doc, "", "expected a YAML mapping")
+		return sink.issues
+	}
+	sink.checkSchema(schemaName, &root, data, SeverityError)
+	return sink.issues
+}
+
+// lookupNode walks a mapping path inside a parsed YAML document and returns
+// the deepest node it could reach, so issues point as close to the offending
+// key as possible. It returns nil for an empty document.
+func lookupNode(root *yaml.Node, path ...string) *yaml.Node {
+	node := root
+	if node.Kind == yaml.DocumentNode {
+		if len(node.Content) == 0 {
+			return nil
+		}
+		node = node.Content[0]
+	}
+	for _, key := range path {
+		next := mappingValue(node, key)
+		if next == nil {
+			return node
+		}
+		node = next
+	}
+	return node
+}
+
+// mappingValue returns the value node for key in a mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
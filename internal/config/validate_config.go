@@ -0,0 +1,257 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// validateMainConfig checks config.yaml: YAML syntax, the config schema, and
+// the handful of value constraints the aggregator enforces at startup.
+func validateMainConfig(file string, data []byte) []ValidationIssue {
+	sink := &issueSink{file: file}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		sink.addYAMLError(err)
+		return sink.issues
+	}
+	data, ok := sink.decrypt(&root, data)
+	if !ok {
+		return sink.issues
+	}
+	data = sink.expandEnv(&root, data)
+
+	schema := &issueSink{file: file}
+	schema.checkSchema(SchemaConfig, &root, data, SeverityError)
+
+	cfg := GetDefaultConfigWithRoles()
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		// The schema violations pinpoint what the decoder trips over
+		if len(schema.issues) == 0 {
+			sink.addYAMLError(err)
+		}
+		return mergeSchemaIssues(sink.issues, schema.issues)
+	}
+
+	agg := &cfg.Aggregator
+
+	if agg.Port < 0 || agg.Port > 65535 {
+		sink.errorf(lookupNode(&root, "aggregator", "port"), "aggregator.port", "port %d is out of range (1-65535)", agg.Port)
+	}
+	switch agg.Transport {
+	case "", MCPTransportStreamableHTTP, MCPTransportSSE, MCPTransportStdio:
+	default:
+		sink.errorf(lookupNode(&root, "aggregator", "transport"), "aggregator.transport",
+			"unsupported transport %q (supported: %s, %s, %s)", agg.Transport, MCPTransportStreamableHTTP, MCPTransportSSE, MCPTransportStdio)
+	}
+	if agg.Admin.Enabled && (agg.Admin.Port < 0 || agg.Admin.Port > 65535) {
+		sink.errorf(lookupNode(&root, "aggregator", "admin", "port"), "aggregator.admin.port", "port %d is out of range (1-65535)", agg.Admin.Port)
+	}
+	if agg.Gateway.Enabled && (agg.Gateway.Port < 0 || agg.Gateway.Port > 65535) {
+		sink.errorf(lookupNode(&root, "aggregator", "gateway", "port"), "aggregator.gateway.port", "port %d is out of range (1-65535)", agg.Gateway.Port)
+	}
+
+	client := &agg.OAuth.MCPClient
+	if client.Enabled && client.PublicURL == "" && client.ClientID == "" {
+		sink.errorf(lookupNode(&root, "aggregator", "oauth", "mcpClient"), "aggregator.oauth.mcpClient",
+			"publicUrl is required when the OAuth MCP client is enabled")
+	}
+	if client.CallbackPath != "" && client.CallbackPath == DefaultOAuthCallbackPath {
+		sink.errorf(lookupNode(&root, "aggregator", "oauth", "mcpClient", "callbackPath"), "aggregator.oauth.mcpClient.callbackPath",
+			"must differ from the OAuth server callback path %s", DefaultOAuthCallbackPath)
+	}
+
+	server := &agg.OAuth.Server
+	if server.Enabled && server.BaseURL == "" {
+		sink.errorf(lookupNode(&root, "aggregator", "oauth", "server"), "aggregator.oauth.server",
+			"baseUrl is required when the OAuth server is enabled")
+	}
+
+	source := &cfg.Source
+	switch {
+	case source.Type == SourceTypeHTTP && source.HTTP.URL == "":
+		sink.errorf(lookupNode(&root, "source", "http"), "source.http.url", "url is required for the http source")
+	case source.Type == SourceTypeS3 && source.S3.Bucket == "":
+		sink.errorf(lookupNode(&root, "source", "s3"), "source.s3.bucket", "bucket is required for the s3 source")
+	case source.Type == SourceTypeConfigMap && source.ConfigMap.Name == "":
+		sink.errorf(lookupNode(&root, "source", "configMap"), "source.configMap.name", "name is required for the configmap source")
+	}
+
+	storage := &cfg.Storage
+	if storage.Type == StorageTypePostgres && storage.DSN == "" {
+		sink.errorf(lookupNode(&root, "storage"), "storage.dsn", "dsn is required for the postgres storage")
+	}
+	if cfg.Kubernetes && storage.Type != "" && storage.Type != StorageTypeFilesystem {
+		sink.warnf(lookupNode(&root, "storage", "type"), "storage.type",
+			"ignored in Kubernetes mode, where workflow executions are stored as resources")
+	}
+
+	if timeout := cfg.Aggregator.ConnectTimeout; timeout != "" {
+		if d, err := time.ParseDuration(timeout); err != nil || d <= 0 {
+			sink.errorf(lookupNode(&root, "aggregator", "connectTimeout"), "aggregator.connectTimeout",
+				"invalid duration %q, use a positive Go duration such as 30s", timeout)
+		}
+	}
+	liveness := &cfg.Aggregator.Liveness
+	for _, duration := range []struct{ key, value string }{
+		{"interval", liveness.Interval},
+		{"timeout", liveness.Timeout},
+	} {
+		if duration.value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(duration.value); err != nil || d <= 0 {
+			sink.errorf(lookupNode(&root, "aggregator", "liveness", duration.key), "aggregator.liveness."+duration.key,
+				"invalid duration %q, use a positive Go duration such as 30s", duration.value)
+		}
+	}
+	standby := &cfg.Aggregator.Standby
+	if standby.PollInterval != "" {
+		if d, err := time.ParseDuration(standby.PollInterval); err != nil || d <= 0 {
+			sink.errorf(lookupNode(&root, "aggregator", "standby", "pollInterval"), "aggregator.standby.pollInterval",
+				"invalid duration %q, use a positive Go duration such as 1s", standby.PollInterval)
+		}
+	}
+	if standby.Enabled && cfg.Aggregator.Transport == MCPTransportStdio {
+		sink.errorf(lookupNode(&root, "aggregator", "standby", "enabled"), "aggregator.standby.enabled",
+			"requires an HTTP transport, a standby cannot take over the standard I/O of another process")
+	}
+
+	if cfg.Events.MaxAge != "" {
+		if maxAge, err := time.ParseDuration(cfg.Events.MaxAge); err != nil || maxAge <= 0 {
+			sink.errorf(lookupNode(&root, "events", "maxAge"), "events.maxAge",
+				"invalid duration %q, use a positive Go duration such as 168h", cfg.Events.MaxAge)
+		}
+	}
+
+	logFile := &cfg.Logging.File
+	for _, duration := range []struct{ key, value string }{
+		{"rotateInterval", logFile.RotateInterval},
+		{"maxAge", logFile.MaxAge},
+	} {
+		if duration.value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(duration.value); err != nil || d <= 0 {
+			sink.errorf(lookupNode(&root, "logging", "file", duration.key), "logging.file."+duration.key,
+				"invalid duration %q, use a positive Go duration such as 24h", duration.value)
+		}
+	}
+
+	samplingNode := lookupNode(&root, "logging", "sampling")
+	for i, rule := range cfg.Logging.Sampling {
+		node := samplingNode
+		if node != nil && node.Kind == yaml.SequenceNode && i < len(node.Content) {
+			node = node.Content[i]
+		}
+		path := fmt.Sprintf("logging.sampling[%d]", i)
+		if rule.Subsystem == "" && rule.Message == "" {
+			sink.errorf(node, path, "subsystem or message is required, a rule cannot sample all entries")
+		}
+		if rule.Interval != "" {
+			if d, err := time.ParseDuration(rule.Interval); err != nil || d <= 0 {
+				sink.errorf(node, path+".interval", "invalid duration %q, use a positive Go duration such as 1m", rule.Interval)
+			}
+		}
+		if rule.Burst < 0 {
+			sink.errorf(node, path+".burst", "burst must not be negative")
+		}
+	}
+
+	sinkNames := map[string]bool{}
+	sinksNode := lookupNode(&root, "events", "sinks")
+	for i, eventSink := range cfg.Events.Sinks {
+		node := sinksNode
+		if node != nil && node.Kind == yaml.SequenceNode && i < len(node.Content) {
+			node = node.Content[i]
+		}
+		path := fmt.Sprintf("events.sinks[%d]", i)
+		switch {
+		case eventSink.Name == "":
+			sink.errorf(node, path+".name", "name is required")
+		case sinkNames[eventSink.Name]:
+			sink.errorf(node, path+".name", "duplicate sink name %q", eventSink.Name)
+		}
+		sinkNames[eventSink.Name] = true
+
+		schemes := []string{"http", "https"}
+		switch eventSink.Type {
+		case "", EventSinkTypeWebhook, EventSinkTypeSlack, EventSinkTypeCloudEvents:
+		case EventSinkTypeNATS:
+			schemes = []string{"nats", "tls"}
+		default:
+			sink.errorf(node, path+".type", "unsupported sink type %q (supported: %s, %s, %s, %s)",
+				eventSink.Type, EventSinkTypeWebhook, EventSinkTypeSlack, EventSinkTypeCloudEvents, EventSinkTypeNATS)
+		}
+		if u, err := url.Parse(eventSink.URL); err != nil || !slices.Contains(schemes, u.Scheme) || u.Host == "" {
+			sink.errorf(node, path+".url", "url must be an absolute %s URL", strings.Join(schemes, "/"))
+		}
+	}
+
+	routesNode := lookupNode(&root, "notifications", "routes")
+	for i, route := range cfg.Notifications.Routes {
+		node := routesNode
+		if node != nil && node.Kind == yaml.SequenceNode && i < len(node.Content) {
+			node = node.Content[i]
+		}
+		path := fmt.Sprintf("notifications.routes[%d]", i)
+		if route.Name == "" {
+			sink.errorf(node, path+".name", "name is required")
+		}
+		if len(route.Events) == 0 {
+			sink.errorf(node, path+".events", "at least one event is required")
+		}
+		for _, event := range route.Events {
+			if !slices.Contains(NotificationEvents, event) {
+				sink.errorf(node, path+".events", "unsupported event %q (supported: %s)", event, strings.Join(NotificationEvents, ", "))
+			}
+		}
+		if len(route.Channels) == 0 {
+			sink.errorf(node, path+".channels", "at least one channel is required")
+		}
+
+		channelsNode := mappingValue(node, "channels")
+		if channelsNode == nil {
+			channelsNode = node
+		}
+		for j, channel := range route.Channels {
+			channelNode := channelsNode
+			if channelNode != nil && channelNode.Kind == yaml.SequenceNode && j < len(channelNode.Content) {
+				channelNode = channelNode.Content[j]
+			}
+			channelPath := fmt.Sprintf("%s.channels[%d]", path, j)
+			switch channel.Type {
+			case NotificationChannelLog, NotificationChannelMCP:
+			case NotificationChannelSink:
+				if !sinkNames[channel.Sink] {
+					sink.errorf(channelNode, channelPath+".sink", "sink %q is not defined in events.sinks", channel.Sink)
+				}
+			case NotificationChannelWebhook:
+				if u, err := url.Parse(channel.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					sink.errorf(channelNode, channelPath+".url", "url must be an absolute http/https URL")
+				}
+				switch channel.Format {
+				case "", "json", EventSinkTypeSlack, EventSinkTypeCloudEvents:
+				default:
+					sink.errorf(channelNode, channelPath+".format", "unsupported format %q (supported: json, %s, %s)",
+						channel.Format, EventSinkTypeSlack, EventSinkTypeCloudEvents)
+				}
+			default:
+				sink.errorf(channelNode, channelPath+".type", "unsupported channel type %q (supported: %s, %s, %s, %s)",
+					channel.Type, NotificationChannelLog, NotificationChannelSink, NotificationChannelWebhook, NotificationChannelMCP)
+			}
+			switch channel.Level {
+			case "", "info", "warning", "error":
+			default:
+				sink.errorf(channelNode, channelPath+".level", "unsupported level %q (supported: info, warning, error)", channel.Level)
+			}
+		}
+	}
+
+	return mergeSchemaIssues(sink.issues, schema.issues)
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// entityKind describes how to validate one entity subdirectory.
+type entityKind struct {
+	dir      string
+	kind     string
+	schema   string
+	newObj   func() interface{}
+	validate func(sink *issueSink, root *yaml.Node, obj interface{})
+}
+
+// entityValidators lists the entity directories ValidateDirectory inspects.
+var entityValidators = []entityKind{
+	{
+		dir:      "mcpservers",
+		kind:     "MCPServer",
+		schema:   SchemaMCPServer,
+		newObj:   func() interface{} { return &musterv1alpha1.MCPServer{} },
+		validate: validateMCPServerEntity,
+	},
+	{
+		dir:      "workflows",
+		kind:     "Workflow",
+		schema:   SchemaWorkflow,
+		newObj:   func() interface{} { return &musterv1alpha1.Workflow{} },
+		validate: validateWorkflowEntity,
+	},
+}
+
+// validateEntityFile checks a single mcpserver/workflow YAML file.
+func validateEntityFile(file string, data []byte, kind entityKind) []ValidationIssue {
+	sink := &issueSink{file: file}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		sink.addYAMLError(err)
+		return sink.issues
+	}
+	data, ok := sink.decrypt(&root, data)
+	if !ok {
+		return sink.issues
+	}
+	data = sink.expandEnv(&root, data)
+	doc := lookupNode(&root)
+	if doc == nil || doc.Kind != yaml.MappingNode {
+		sink.errorf(doc, "", "expected a %s definition (YAML mapping)", kind.kind)
+		return sink.issues
+	}
+
+	if k := mappingValue(doc, "kind"); k != nil && k.Value != kind.kind {
+		sink.errorf(k, "kind", "expected kind %s in %s/, got %q", kind.kind, kind.dir, k.Value)
+	}
+	if v := mappingValue(doc, "apiVersion"); v != nil && v.Value != musterv1alpha1.GroupVersion.String() {
+		sink.errorf(v, "apiVersion", "unsupported apiVersion %q (expected %s)", v.Value, musterv1alpha1.GroupVersion.String())
+	}
+
+	schema := &issueSink{file: file}
+	schema.checkSchema(kind.schema, &root, data, SeverityError)
+
+	obj := kind.newObj()
+	if err := sigsyaml.Unmarshal(data, obj); err != nil {
+		// The schema violations pinpoint what the decoder trips over
+		if len(schema.issues) == 0 {
+			sink.errorf(doc, "", "cannot decode %s: %v", kind.kind, err)
+		}
+		return mergeSchemaIssues(sink.issues, schema.issues)
+	}
+
+	base := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	if name := mappingValue(mappingValue(doc, "metadata"), "name"); name != nil && name.Value != base {
+		sink.warnf(name, "metadata.name", "name %q does not match file name %q; muster looks resources up by file name", name.Value, base)
+	}
+
+	kind.validate(sink, doc, obj)
+	return mergeSchemaIssues(sink.issues, schema.issues)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"gopkg.in/yaml.v3"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// validateMCPServerEntity applies the same type/command/url rules as the
+// mcpserver API adapter.
+func validateMCPServerEntity(sink *issueSink, doc *yaml.Node, obj interface{}) {
+	server := obj.(*musterv1alpha1.MCPServer)
+	spec := mappingValue(doc, "spec")
+	at := func(key string) *yaml.Node {
+		if n := mappingValue(spec, key); n != nil {
+			return n
+		}
+		if spec != nil {
+			return spec
+		}
+		return doc
+	}
+
+	switch server.Spec.Type {
+	case "":
+		sink.errorf(at("type"), "spec.type", "type is required (%s, %s, or %s)", MCPServerTypeStdio, MCPServerTypeStreamableHTTP, MCPServerTypeSSE)
+	case string(MCPServerTypeStdio):
+		if server.Spec.Command == "" {
+			sink.errorf(at("command"), "spec.command", "command is required for stdio servers")
+		}
+		if server.Spec.Auth != nil && server.Spec.Auth.Type != "" && server.Spec.Auth.Type != "none" {
+			sink.errorf(at("auth"), "spec.auth", "auth is only supported for remote servers (streamable-http or sse)")
+		}
+	case string(MCPServerTypeStreamableHTTP), string(MCPServerTypeSSE):
+		if server.Spec.URL == "" {
+			sink.errorf(at("url"), "spec.url", "url is required for %s servers", server.Spec.Type)
+		}
+		if server.Spec.Command != "" {
+			sink.warnf(at("command"), "spec.command", "command is ignored for %s servers", server.Spec.Type)
+		}
+	default:
+		sink.errorf(at("type"), "spec.type", "unsupported type %q (supported: %s, %s, %s)",
+			server.Spec.Type, MCPServerTypeStdio, MCPServerTypeStreamableHTTP, MCPServerTypeSSE)
+	}
+
+	if server.Spec.Timeout < 0 {
+		sink.errorf(at("timeout"), "spec.timeout", "timeout must not be negative")
+	}
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// namespaceDirs returns the directories of configPath holding entity
+// definitions: configPath itself and the directory of each namespace.
+func namespaceDirs(configPath string) ([]string, error) {
+	namespaces, err := Namespaces(configPath)
+	if err != nil {
+		return nil, err
+	}
+	dirs := []string{configPath}
+	for _, namespace := range namespaces {
+		dirs = append(dirs, filepath.Join(configPath, NamespacesDir, namespace))
+	}
+	return dirs, nil
+}
+
+// forEachYAMLFile calls fn with each YAML file in dir. A missing dir has no
+// files.
+func forEachYAMLFile(dir string, fn func(file string, data []byte)) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		file := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(file) //nolint:gosec
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		fn(file, data)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/giantswarm/muster/internal/template"
+)
+
+// checkTemplates parses every scalar containing "{{" as a Go template so
+// syntax errors surface with the exact line instead of at execution time.
+func checkTemplates(sink *issueSink, node *yaml.Node, path string) {
+	if node == nil {
+		return
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if strings.Contains(node.Value, "{{") {
+			if err := template.CheckSyntax(node.Value); err != nil {
+				sink.errorf(node, path, "%v", err)
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			checkTemplates(sink, node.Content[i+1], joinPath(path, node.Content[i].Value))
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			checkTemplates(sink, item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, dir, rel, content string) {
+	t.Helper()
+	path := filepath.Join(dir, rel)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func findIssue(report *ValidationReport, substr string) *ValidationIssue {
+	for i := range report.Issues {
+		if strings.Contains(report.Issues[i].Message, substr) {
+			return &report.Issues[i]
+		}
+	}
+	return nil
+}
+
+func TestValidateDirectory_Valid(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  port: 8090\n  transport: streamable-http\n")
+	writeConfigFile(t, dir, "mcpservers/kube.yaml", `apiVersion: muster.giantswarm.io/v1alpha1
+kind: MCPServer
+metadata:
+  name: kube
+spec:
+  type: stdio
+  command: mcp-kubernetes
+`)
+	writeConfigFile(t, dir, "workflows/deploy.yaml", `apiVersion: muster.giantswarm.io/v1alpha1
+kind: Workflow
+metadata:
+  name: deploy
+spec:
+  args:
+    app:
+      type: string
+      required: true
+  steps:
+    - id: apply
+      tool: x_kube_apply
+      args:
+        name: "{{ .input.app }}"
+`)
+
+	report, err := ValidateDirectory(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.FilesChecked)
+	assert.Empty(t, report.Issues)
+
+	// The definitions of other namespaces are checked too
+	writeConfigFile(t, dir, "namespaces/team-a/mcpservers/kube.yaml", "apiVersion: muster.giantswarm.io/v1alpha1\nkind: MCPServer\nmetadata:\n  name: kube\nspec:\n  type: stdio\n  command: mcp-kubernetes\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 4, report.FilesChecked)
+	assert.Empty(t, report.Issues)
+	assert.True(t, report.Valid())
+}
+
+func TestValidateDirectory_ConfigErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  port: 8090\n  transprt: sse\n")
+
+	report, err := ValidateDirectory(dir)
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 1)
+	assert.Equal(t, 3, report.Issues[0].Line)
+	assert.Contains(t, report.Issues[0].Message, "transprt")
+
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  transport: grpc\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue := findIssue(report, "unsupported transport")
+	require.NotNil(t, issue)
+	assert.Equal(t, 2, issue.Line)
+
+	writeConfigFile(t, dir, "config.yaml", "events:\n  maxAge: 30d\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue = findIssue(report, "invalid duration")
+	require.NotNil(t, issue)
+	assert.Equal(t, 2, issue.Line)
+
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  connectTimeout: 30\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue = findIssue(report, "invalid duration")
+	require.NotNil(t, issue)
+	assert.Equal(t, "aggregator.connectTimeout", issue.Path)
+
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  liveness:\n    interval: 0s\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue = findIssue(report, "invalid duration")
+	require.NotNil(t, issue)
+	assert.Equal(t, "aggregator.liveness.interval", issue.Path)
+	assert.Equal(t, 3, issue.Line)
+
+	writeConfigFile(t, dir, "config.yaml", "aggregator:\n  transport: stdio\n  standby:\n    enabled: true\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue = findIssue(report, "requires an HTTP transport")
+	require.NotNil(t, issue)
+	assert.Equal(t, "aggregator.standby.enabled", issue.Path)
+	assert.Equal(t, 4, issue.Line)
+
+	writeConfigFile(t, dir, "config.yaml", "events:\n  sinks:\n    - name: pager\n      url: https://hooks.example.com/x\n    - name: pager\n      type: email\n      url: hooks.example.com\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue = findIssue(report, "duplicate sink name")
+	require.NotNil(t, issue)
+	assert.Equal(t, "events.sinks[1].name", issue.Path)
+	assert.Equal(t, 5, issue.Line)
+	assert.NotNil(t, findIssue(report, "unsupported sink type"))
+	assert.NotNil(t, findIssue(report, "absolute http/https URL"))
+	assert.Nil(t, findIssue(report, "name is required"))
+
+	writeConfigFile(t, dir, "config.yaml", "events:\n  sinks:\n    - name: pipeline\n      type: nats\n      url: https://nats.example.com\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue = findIssue(report, "absolute nats/tls URL")
+	require.NotNil(t, issue)
+	assert.Equal(t, "events.sinks[0].url", issue.Path)
+
+	writeConfigFile(t, dir, "config.yaml", "events:\n  sinks:\n    - name: pager\n      url: https://hooks.example.com/x\nnotifications:\n  routes:\n    - name: failures\n      events: [service_failed, disk_full]\n      channels:\n        - type: sink\n          sink: pager\n        - type: sink\n          sink: missing\n        - type: email\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	assert.NotNil(t, findIssue(report, `unsupported event "disk_full"`))
+	issue = findIssue(report, `sink "missing" is not defined`)
+	require.NotNil(t, issue)
+	assert.Equal(t, "notifications.routes[0].channels[1].sink", issue.Path)
+	assert.Equal(t, 12, issue.Line)
+	assert.NotNil(t, findIssue(report, `unsupported channel type "email"`))
+	assert.Nil(t, findIssue(report, `sink "pager"`))
+
+	writeConfigFile(t, dir, "config.yaml", "logging:\n  file:\n    path: muster.log\n    rotateInterval: 1d\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue = findIssue(report, "invalid duration")
+	require.NotNil(t, issue)
+	assert.Equal(t, "logging.file.rotateInterval", issue.Path)
+	assert.Equal(t, 4, issue.Line)
+
+	writeConfigFile(t, dir, "config.yaml", "logging:\n  sampling:\n    - subsystem: Connection\n      interval: 1h\n    - interval: soon\n")
+	report, err = ValidateDirectory(dir)
+	require.NoError(t, err)
+	issue = findIssue(report, "subsystem or message is required")
+	require.NotNil(t, issue)
+	assert.Equal(t, "logging.sampling[1]", issue.Path)
+	issue = findIssue(report, "invalid duration")
+	require.NotNil(t, issue)
+	assert.Equal(t, "logging.sampling[1].interval", issue.Path)
+}
+
+func TestValidateDirectory_EntityErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "mcpservers/remote.yaml", `kind: MCPServer
+metadata:
+  name: remote
+spec:
+  type: streamable-http
+  urll: https://example.com
+`)
+	writeConfigFile(t, dir, "workflows/broken.yaml", `kind: Workflow
+metadata:
+  name: other
+spec:
+  args:
+    count:
+      type: int
+  steps:
+    - id: one
+      tool: x_tool
+      args:
+        value: "{{ .input.count "
+    - id: one
+      condition:
+        template: "{{ true }}"
+        tool: x_check
+`)
+
+	report, err := ValidateDirectory(dir)
+	require.NoError(t, err)
+	assert.False(t, report.Valid())
+
+	cases := []struct {
+		substr string
+		line   int
+	}{
+		{`unknown field "urll"`, 6},
+		{"url is required", 5},
+		{`unknown argument type "int"`, 7},
+		{"invalid template", 12},
+		{`duplicate step id "one"`, 13},
+		{"one of tool, prompt, forEach, or parallel is required", 13},
+		{"condition requires exactly one", 15},
+	}
+	for _, tc := range cases {
+		issue := findIssue(report, tc.substr)
+		if assert.NotNil(t, issue, tc.substr) {
+			assert.Equal(t, tc.line, issue.Line, tc.substr)
+		}
+	}
+
+	warning := findIssue(report, "does not match file name")
+	require.NotNil(t, warning)
+	assert.Equal(t, SeverityWarning, warning.Severity)
+}
+
+func TestValidateDirectory_SyntaxError(t *testing.T) {
+	dir := t.TempDir()
+	writeConfigFile(t, dir, "workflows/bad.yaml", "spec:\n  steps: [unclosed\n")
+
+	report, err := ValidateDirectory(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, report.Issues)
+	assert.Positive(t, report.Issues[0].Line)
+}
+
+func TestValidateDirectory_MissingDir(t *testing.T) {
+	_, err := ValidateDirectory(filepath.Join(t.TempDir(), "nope"))
+	assert.Error(t, err)
+}
+
+func TestValidationIssueString(t *testing.T) {
+	issue := ValidationIssue{File: "a.yaml", Line: 3, Column: 5, Path: "spec.type", Severity: SeverityError, Message: "bad"}
+	assert.Equal(t, "a.yaml:3:5: error: spec.type: bad", issue.String())
+}
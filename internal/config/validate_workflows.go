@@ -0,0 +1,150 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// validArgTypes mirrors api.ArgType; the api package imports config, so the
+// set is duplicated here rather than imported.
+var validArgTypes = map[string]bool{
+	"string": true, "integer": true, "number": true, "boolean": true, "object": true, "array": true,
+}
+
+// validateWorkflowEntity applies the structural rules the workflow adapter
+// enforces on create, plus a syntax check of every embedded Go template.
+func validateWorkflowEntity(sink *issueSink, doc *yaml.Node, obj interface{}) {
+	wf := obj.(*musterv1alpha1.Workflow)
+	spec := mappingValue(doc, "spec")
+	if spec == nil {
+		sink.errorf(doc, "spec", "spec is required")
+		return
+	}
+
+	for name, arg := range wf.Spec.Args {
+		argNode := mappingValue(mappingValue(spec, "args"), name)
+		if arg.Type == "" {
+			sink.errorf(argNode, "spec.args."+name, "type is required")
+		} else if !validArgTypes[arg.Type] {
+			sink.errorf(mappingValue(argNode, "type"), "spec.args."+name+".type", "unknown argument type %q", arg.Type)
+		}
+	}
+
+	steps := mappingValue(spec, "steps")
+	if len(wf.Spec.Steps) == 0 {
+		sink.errorf(spec, "spec.steps", "workflow must have at least one step")
+	}
+	ids := make(map[string]bool, len(wf.Spec.Steps))
+	for i, step := range wf.Spec.Steps {
+		path := fmt.Sprintf("spec.steps[%d]", i)
+		var node *yaml.Node
+		if steps != nil && i < len(steps.Content) {
+			node = steps.Content[i]
+		}
+		switch {
+		case step.ID == "":
+			sink.errorf(node, path, "step id cannot be empty")
+		case ids[step.ID]:
+			sink.errorf(node, path, "duplicate step id %q", step.ID)
+		}
+		ids[step.ID] = true
+
+		composite := step.ForEach != nil || len(step.Parallel) > 0
+		switch {
+		case step.Tool == "" && step.Prompt == "" && !composite:
+			sink.errorf(node, path, "one of tool, prompt, forEach, or parallel is required")
+		case step.Tool != "" && step.Prompt != "":
+			sink.errorf(node, path, "tool and prompt are mutually exclusive")
+		case step.Tool != "" && composite:
+			sink.errorf(node, path, "tool is mutually exclusive with forEach/parallel")
+		case step.Prompt != "" && composite:
+			sink.errorf(node, path, "prompt is mutually exclusive with forEach/parallel")
+		case step.ForEach != nil && len(step.Parallel) > 0:
+			sink.errorf(node, path, "forEach and parallel are mutually exclusive")
+		}
+		checkCondition(sink, mappingValue(node, "condition"), path+".condition", step.Condition)
+
+		if step.ForEach != nil {
+			forEach := mappingValue(node, "forEach")
+			if step.ForEach.Items == "" {
+				sink.errorf(forEach, path+".forEach.items", "items is required")
+			}
+			if len(step.ForEach.Steps) == 0 {
+				sink.errorf(forEach, path+".forEach.steps", "must contain at least one sub-step")
+			}
+			checkSubSteps(sink, mappingValue(forEach, "steps"), path+".forEach.steps", step.ForEach.Steps)
+		}
+		checkSubSteps(sink, mappingValue(node, "parallel"), path+".parallel", step.Parallel)
+	}
+	checkSubSteps(sink, mappingValue(spec, "onFailure"), "spec.onFailure", wf.Spec.OnFailure)
+	checkConcurrency(sink, mappingValue(spec, "concurrency"), "spec.concurrency", wf.Spec.Concurrency)
+
+	checkTemplates(sink, spec, "spec")
+}
+
+// checkCondition enforces that a condition selects exactly one of template,
+// tool, or fromStep.
+func checkCondition(sink *issueSink, node *yaml.Node, path string, c *musterv1alpha1.WorkflowCondition) {
+	if c == nil {
+		return
+	}
+	set := 0
+	for _, v := range []string{c.Template, c.Tool, c.FromStep} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		sink.errorf(node, path, "condition requires exactly one of template, tool, or fromStep")
+	}
+}
+
+// checkConcurrency enforces distinct, non-empty mutex names.
+func checkConcurrency(sink *issueSink, node *yaml.Node, path string, c *musterv1alpha1.WorkflowConcurrency) {
+	if c == nil {
+		return
+	}
+	mutexes := mappingValue(node, "mutexes")
+	seen := make(map[string]bool, len(c.Mutexes))
+	for i, mutex := range c.Mutexes {
+		var mutexNode *yaml.Node
+		if mutexes != nil && i < len(mutexes.Content) {
+			mutexNode = mutexes.Content[i]
+		}
+		mutexPath := fmt.Sprintf("%s.mutexes[%d]", path, i)
+		switch {
+		case strings.TrimSpace(mutex) == "":
+			sink.errorf(mutexNode, mutexPath, "mutex name cannot be empty")
+		case seen[mutex]:
+			sink.errorf(mutexNode, mutexPath, "duplicate mutex %q", mutex)
+		}
+		seen[mutex] = true
+	}
+}
+
+// checkSubSteps validates forEach bodies, parallel groups, and onFailure handlers.
+func checkSubSteps(sink *issueSink, node *yaml.Node, path string, subs []musterv1alpha1.WorkflowSubStep) {
+	ids := make(map[string]bool, len(subs))
+	for j, sub := range subs {
+		subPath := fmt.Sprintf("%s[%d]", path, j)
+		var subNode *yaml.Node
+		if node != nil && j < len(node.Content) {
+			subNode = node.Content[j]
+		}
+		switch {
+		case sub.ID == "":
+			sink.errorf(subNode, subPath, "sub-step id cannot be empty")
+		case ids[sub.ID]:
+			sink.errorf(subNode, subPath, "duplicate sub-step id %q", sub.ID)
+		}
+		ids[sub.ID] = true
+		if sub.Tool == "" {
+			sink.errorf(subNode, subPath, "tool cannot be empty")
+		}
+		checkCondition(sink, mappingValue(subNode, "condition"), subPath+".condition", sub.Condition)
+	}
+}
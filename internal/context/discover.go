@@ -0,0 +1,212 @@
+package context
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Well-known metadata marking the Services and Ingresses of muster
+// installations. The muster Helm chart sets them.
+const (
+	// EndpointAnnotation is the full aggregator endpoint URL of a muster
+	// Service or Ingress. It takes precedence over the derived endpoint and
+	// marks any Service or Ingress as a muster installation.
+	EndpointAnnotation = "muster.giantswarm.io/endpoint"
+
+	// AggregatorPathAnnotation is the path of the aggregator endpoint below
+	// the Service or Ingress, "/mcp" if not set.
+	AggregatorPathAnnotation = "muster.giantswarm.io/aggregator-path"
+
+	// NameLabel is the label whose value "muster" marks a muster installation.
+	NameLabel = "app.kubernetes.io/name"
+)
+
+// defaultAggregatorPath is the aggregator path of the streamable-http transport.
+const defaultAggregatorPath = "/mcp"
+
+// invalidNameChars matches runs of characters not allowed in context names.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// DiscoveredEndpoint is a muster aggregator endpoint found in a Kubernetes
+// cluster.
+type DiscoveredEndpoint struct {
+	// Kind is the kind of the object the endpoint was found on (Ingress or Service)
+	Kind string
+
+	// Namespace and Name identify the object the endpoint was found on
+	Namespace string
+	Name      string
+
+	// Endpoint is the aggregator endpoint URL
+	Endpoint string
+}
+
+// DiscoverEndpoints finds the aggregator endpoints of the muster
+// installations in a cluster, from the Ingresses and Services in all
+// namespaces readable by c. An Ingress or Service is a muster installation if
+// it is labelled app.kubernetes.io/name=muster or has EndpointAnnotation.
+//
+// Ingress endpoints are derived from their rules. Services only yield an
+// endpoint if they are annotated or of type LoadBalancer, as cluster-internal
+// addresses are not reachable from outside the cluster. Endpoints are sorted
+// and deduplicated, Ingresses first.
+func DiscoverEndpoints(ctx context.Context, c client.Reader) ([]DiscoveredEndpoint, error) {
+	var ingresses networkingv1.IngressList
+	if err := c.List(ctx, &ingresses); err != nil {
+		return nil, fmt.Errorf("failed to list ingresses: %w", err)
+	}
+	var services corev1.ServiceList
+	if err := c.List(ctx, &services); err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	var found []DiscoveredEndpoint
+	for i := range ingresses.Items {
+		found = append(found, ingressEndpoints(&ingresses.Items[i])...)
+	}
+	for i := range services.Items {
+		found = append(found, serviceEndpoints(&services.Items[i])...)
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].Kind != found[j].Kind {
+			return found[i].Kind == "Ingress"
+		}
+		if found[i].Namespace != found[j].Namespace {
+			return found[i].Namespace < found[j].Namespace
+		}
+		if found[i].Name != found[j].Name {
+			return found[i].Name < found[j].Name
+		}
+		return found[i].Endpoint < found[j].Endpoint
+	})
+
+	seen := make(map[string]bool)
+	endpoints := found[:0]
+	for _, e := range found {
+		if seen[e.Endpoint] {
+			continue
+		}
+		seen[e.Endpoint] = true
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, nil
+}
+
+// isMusterObject reports whether an object with the given labels and
+// annotations belongs to a muster installation.
+func isMusterObject(labels, annotations map[string]string) bool {
+	return labels[NameLabel] == "muster" || annotations[EndpointAnnotation] != ""
+}
+
+// aggregatorPath returns the aggregator path of an annotated object.
+func aggregatorPath(annotations map[string]string) string {
+	path := annotations[AggregatorPathAnnotation]
+	if path == "" {
+		return defaultAggregatorPath
+	}
+	return "/" + strings.TrimPrefix(path, "/")
+}
+
+// ingressEndpoints returns the endpoints of a muster Ingress, one per host.
+func ingressEndpoints(ing *networkingv1.Ingress) []DiscoveredEndpoint {
+	if !isMusterObject(ing.Labels, ing.Annotations) {
+		return nil
+	}
+	endpoint := func(url string) DiscoveredEndpoint {
+		return DiscoveredEndpoint{Kind: "Ingress", Namespace: ing.Namespace, Name: ing.Name, Endpoint: url}
+	}
+	if url := ing.Annotations[EndpointAnnotation]; url != "" {
+		return []DiscoveredEndpoint{endpoint(url)}
+	}
+
+	tlsHosts := make(map[string]bool)
+	for _, tls := range ing.Spec.TLS {
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+
+	var endpoints []DiscoveredEndpoint
+	for _, rule := range ing.Spec.Rules {
+		// Rules without host or with wildcard hosts have no address to connect to
+		if rule.Host == "" || strings.HasPrefix(rule.Host, "*") {
+			continue
+		}
+		scheme := "http"
+		if tlsHosts[rule.Host] {
+			scheme = "https"
+		}
+		prefix := ""
+		if rule.HTTP != nil && len(rule.HTTP.Paths) > 0 {
+			prefix = strings.TrimSuffix(rule.HTTP.Paths[0].Path, "/")
+		}
+		endpoints = append(endpoints, endpoint(scheme+"://"+rule.Host+prefix+aggregatorPath(ing.Annotations)))
+	}
+	return endpoints
+}
+
+// serviceEndpoints returns the endpoints of a muster Service: the annotated
+// endpoint, or the load balancer addresses of a LoadBalancer Service.
+func serviceEndpoints(svc *corev1.Service) []DiscoveredEndpoint {
+	if !isMusterObject(svc.Labels, svc.Annotations) {
+		return nil
+	}
+	endpoint := func(url string) DiscoveredEndpoint {
+		return DiscoveredEndpoint{Kind: "Service", Namespace: svc.Namespace, Name: svc.Name, Endpoint: url}
+	}
+	if url := svc.Annotations[EndpointAnnotation]; url != "" {
+		return []DiscoveredEndpoint{endpoint(url)}
+	}
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer || len(svc.Spec.Ports) == 0 {
+		return nil
+	}
+
+	port := svc.Spec.Ports[0].Port
+	for _, p := range svc.Spec.Ports {
+		if p.Name == "http" {
+			port = p.Port
+			break
+		}
+	}
+
+	var endpoints []DiscoveredEndpoint
+	for _, lb := range svc.Status.LoadBalancer.Ingress {
+		host := lb.Hostname
+		if host == "" {
+			host = lb.IP
+		}
+		if host == "" {
+			continue
+		}
+		hostPort := net.JoinHostPort(host, strconv.Itoa(int(port)))
+		endpoints = append(endpoints, endpoint("http://"+hostPort+aggregatorPath(svc.Annotations)))
+	}
+	return endpoints
+}
+
+// DiscoveredContextName returns the name of the context for an endpoint
+// discovered in the cluster of a kubeconfig context. The first endpoint of a
+// cluster is named after the kubeconfig context; further ones get the
+// namespace and name of their object appended. The name is made valid for
+// ValidateContextName.
+func DiscoveredContextName(kubeContext string, e DiscoveredEndpoint, first bool) string {
+	name := kubeContext
+	if !first {
+		name = kubeContext + "-" + e.Namespace + "-" + e.Name
+	}
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	if len(name) > maxContextNameLength {
+		name = name[:maxContextNameLength]
+	}
+	return strings.Trim(name, "-")
+}
@@ -0,0 +1,120 @@
+package context
+
+import (
+	stdcontext "context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDiscoverEndpoints(t *testing.T) {
+	musterLabels := map[string]string{NameLabel: "muster"}
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		// Ingress of the Helm chart, with TLS for one host
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "muster", Namespace: "muster", Labels: musterLabels},
+			Spec: networkingv1.IngressSpec{
+				TLS: []networkingv1.IngressTLS{{Hosts: []string{"muster.example.com"}}},
+				Rules: []networkingv1.IngressRule{
+					{Host: "muster.example.com", IngressRuleValue: ingressPaths("/")},
+					{Host: "muster.internal", IngressRuleValue: ingressPaths("/muster/")},
+					{Host: "*.example.com", IngressRuleValue: ingressPaths("/")},
+				},
+			},
+		},
+		// Ingress with an explicit endpoint
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "gateway", Namespace: "platform",
+				Annotations: map[string]string{EndpointAnnotation: "https://gateway.example.com/muster/mcp"},
+			},
+		},
+		// Ingress of another application
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "grafana", Namespace: "monitoring"},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{Host: "grafana.example.com"}},
+			},
+		},
+		// ClusterIP Service of the Helm chart, not reachable from outside
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "muster", Namespace: "muster", Labels: musterLabels},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "http", Port: 8090}}},
+		},
+		// LoadBalancer Service with the SSE transport
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "muster-lb", Namespace: "muster", Labels: musterLabels,
+				Annotations: map[string]string{AggregatorPathAnnotation: "sse"},
+			},
+			Spec: corev1.ServiceSpec{
+				Type:  corev1.ServiceTypeLoadBalancer,
+				Ports: []corev1.ServicePort{{Name: "metrics", Port: 9090}, {Name: "http", Port: 8090}},
+			},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{IP: "203.0.113.10"}},
+			}},
+		},
+		// Service with the same endpoint as the Ingress
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "muster-alias", Namespace: "muster",
+				Annotations: map[string]string{EndpointAnnotation: "https://muster.example.com/mcp"},
+			},
+		},
+	).Build()
+
+	got, err := DiscoverEndpoints(stdcontext.Background(), c)
+	if err != nil {
+		t.Fatalf("DiscoverEndpoints failed: %v", err)
+	}
+
+	want := []DiscoveredEndpoint{
+		{Kind: "Ingress", Namespace: "muster", Name: "muster", Endpoint: "http://muster.internal/muster/mcp"},
+		{Kind: "Ingress", Namespace: "muster", Name: "muster", Endpoint: "https://muster.example.com/mcp"},
+		{Kind: "Ingress", Namespace: "platform", Name: "gateway", Endpoint: "https://gateway.example.com/muster/mcp"},
+		{Kind: "Service", Namespace: "muster", Name: "muster-lb", Endpoint: "http://203.0.113.10:8090/sse"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiscoverEndpoints() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func ingressPaths(path string) networkingv1.IngressRuleValue {
+	return networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+		Paths: []networkingv1.HTTPIngressPath{{Path: path}},
+	}}
+}
+
+func TestDiscoveredContextName(t *testing.T) {
+	endpoint := DiscoveredEndpoint{Kind: "Ingress", Namespace: "muster", Name: "muster-lb"}
+
+	tests := []struct {
+		name        string
+		kubeContext string
+		first       bool
+		want        string
+	}{
+		{name: "first endpoint is named after the kube context", kubeContext: "prod-eu", first: true, want: "prod-eu"},
+		{name: "further endpoints get their object appended", kubeContext: "prod-eu", first: false, want: "prod-eu-muster-muster-lb"},
+		{name: "invalid characters are replaced", kubeContext: "arn:aws:eks:eu-west-1:123:cluster/Prod", first: true, want: "arn-aws-eks-eu-west-1-123-cluster-prod"},
+		{name: "teleport-style names are cleaned up", kubeContext: "@teleport.example.com-prod@", first: true, want: "teleport-example-com-prod"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiscoveredContextName(tt.kubeContext, endpoint, tt.first)
+			if got != tt.want {
+				t.Errorf("DiscoveredContextName() = %q, want %q", got, tt.want)
+			}
+			if err := ValidateContextName(got); err != nil {
+				t.Errorf("name %q is invalid: %v", got, err)
+			}
+		})
+	}
+}
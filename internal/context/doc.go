@@ -15,8 +15,21 @@
 //	    endpoint: http://localhost:8090/mcp
 //	  - name: production
 //	    endpoint: https://muster.example.com/mcp
+//	    namespace: team-a
 //	    settings:
 //	      output: table
+//	    auth:
+//	      token-dir: ~/.config/muster/tokens-production
+//	      client-id: https://example.com/muster-agent.json
+//	      idp: github
+//
+// The optional namespace is the default Kubernetes namespace of the
+// operations using the context; the -n flag overrides it.
+//
+// The optional auth section references a context's authentication material:
+// the directory its OAuth tokens are stored in, the OAuth client ID, and the
+// preferred identity provider. Contexts with separate token directories never
+// share or overwrite each other's tokens.
 //
 // # Usage
 //
@@ -26,6 +39,16 @@
 //   - List contexts with ListContexts
 //   - Get current context with GetCurrentContext
 //   - Switch contexts with SetCurrentContext
+//   - Set a context's authentication material with SetContextAuth
+//   - Set a context's default namespace with SetContextNamespace
+//
+// # Discovery
+//
+// DiscoverEndpoints finds the aggregator endpoints of the muster installations
+// in a Kubernetes cluster, from Ingresses and Services labelled
+// app.kubernetes.io/name=muster or annotated with EndpointAnnotation, and
+// DiscoveredContextName names their contexts. `muster context discover` uses
+// them to create contexts for the clusters of a kubeconfig.
 //
 // # Precedence
 //
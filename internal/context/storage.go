@@ -187,19 +187,73 @@ func (s *Storage) UpdateContext(name, endpoint string, settings *ContextSettings
 		return err
 	}
 
-	if !config.HasContext(name) {
+	existing := config.GetContext(name)
+	if existing == nil {
 		return &ContextNotFoundError{Name: name}
 	}
 
 	config.AddOrUpdateContext(Context{
-		Name:     name,
-		Endpoint: endpoint,
-		Settings: settings,
+		Name:      name,
+		Endpoint:  endpoint,
+		Namespace: existing.Namespace,
+		Settings:  settings,
+		Auth:      existing.Auth,
 	})
 
 	return s.saveLocked(config)
 }
 
+// SetContextAuth sets the authentication material of an existing context.
+// An empty auth removes it. Returns an error if the context doesn't exist.
+func (s *Storage) SetContextAuth(name string, auth *ContextAuth) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	config, err := s.loadLocked()
+	if err != nil {
+		return err
+	}
+
+	ctx := config.GetContext(name)
+	if ctx == nil {
+		return &ContextNotFoundError{Name: name}
+	}
+
+	if auth.IsEmpty() {
+		ctx.Auth = nil
+	} else {
+		ctx.Auth = auth
+	}
+
+	return s.saveLocked(config)
+}
+
+// SetContextNamespace sets the default namespace of an existing context.
+// An empty namespace removes it. Returns an error if the context doesn't exist
+// or the namespace is not a valid Kubernetes namespace name.
+func (s *Storage) SetContextNamespace(name, namespace string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	config, err := s.loadLocked()
+	if err != nil {
+		return err
+	}
+
+	ctx := config.GetContext(name)
+	if ctx == nil {
+		return &ContextNotFoundError{Name: name}
+	}
+	if namespace != "" {
+		if err := ValidateNamespace(namespace); err != nil {
+			return err
+		}
+	}
+	ctx.Namespace = namespace
+
+	return s.saveLocked(config)
+}
+
 // DeleteContext removes a context by name.
 // Returns an error if the context doesn't exist.
 func (s *Storage) DeleteContext(name string) error {
@@ -247,9 +301,11 @@ func (s *Storage) RenameContext(oldName, newName string) error {
 
 	// Create new context with the new name
 	newCtx := Context{
-		Name:     newName,
-		Endpoint: oldCtx.Endpoint,
-		Settings: oldCtx.Settings,
+		Name:      newName,
+		Endpoint:  oldCtx.Endpoint,
+		Namespace: oldCtx.Namespace,
+		Settings:  oldCtx.Settings,
+		Auth:      oldCtx.Auth,
 	}
 
 	// Remove old and add new
@@ -0,0 +1,131 @@
+package context
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestStorage(t *testing.T) *Storage {
+	t.Helper()
+	return &Storage{configPath: t.TempDir()}
+}
+
+func TestStorage_SetContextAuth(t *testing.T) {
+	storage := newTestStorage(t)
+	if err := storage.AddContext("prod", "https://prod.example.com/mcp", nil); err != nil {
+		t.Fatalf("AddContext failed: %v", err)
+	}
+
+	auth := &ContextAuth{TokenDir: "/tmp/prod-tokens", ClientID: "muster-prod", IdP: "github"}
+	if err := storage.SetContextAuth("prod", auth); err != nil {
+		t.Fatalf("SetContextAuth failed: %v", err)
+	}
+
+	ctx, err := storage.GetContext("prod")
+	if err != nil {
+		t.Fatalf("GetContext failed: %v", err)
+	}
+	if ctx.Auth == nil || *ctx.Auth != *auth {
+		t.Errorf("got auth %+v, want %+v", ctx.Auth, auth)
+	}
+
+	data, err := os.ReadFile(filepath.Join(storage.configPath, contextsFileName))
+	if err != nil {
+		t.Fatalf("failed to read contexts file: %v", err)
+	}
+	for _, key := range []string{"token-dir: /tmp/prod-tokens", "client-id: muster-prod", "idp: github"} {
+		if !strings.Contains(string(data), key) {
+			t.Errorf("contexts file does not contain %q:\n%s", key, data)
+		}
+	}
+
+	// An empty auth removes the section.
+	if err := storage.SetContextAuth("prod", &ContextAuth{}); err != nil {
+		t.Fatalf("SetContextAuth failed: %v", err)
+	}
+	ctx, _ = storage.GetContext("prod")
+	if ctx.Auth != nil {
+		t.Errorf("expected auth to be removed, got %+v", ctx.Auth)
+	}
+}
+
+func TestStorage_SetContextAuth_NotFound(t *testing.T) {
+	storage := newTestStorage(t)
+
+	err := storage.SetContextAuth("missing", &ContextAuth{ClientID: "x"})
+	if _, ok := err.(*ContextNotFoundError); !ok {
+		t.Errorf("expected ContextNotFoundError, got %v", err)
+	}
+}
+
+func TestStorage_AuthSurvivesUpdateAndRename(t *testing.T) {
+	storage := newTestStorage(t)
+	if err := storage.AddContext("dev", "https://dev.example.com/mcp", nil); err != nil {
+		t.Fatalf("AddContext failed: %v", err)
+	}
+	auth := &ContextAuth{TokenDir: "/tmp/dev-tokens"}
+	if err := storage.SetContextAuth("dev", auth); err != nil {
+		t.Fatalf("SetContextAuth failed: %v", err)
+	}
+
+	if err := storage.UpdateContext("dev", "https://dev2.example.com/mcp", nil); err != nil {
+		t.Fatalf("UpdateContext failed: %v", err)
+	}
+	if err := storage.RenameContext("dev", "development"); err != nil {
+		t.Fatalf("RenameContext failed: %v", err)
+	}
+
+	ctx, err := storage.GetContext("development")
+	if err != nil {
+		t.Fatalf("GetContext failed: %v", err)
+	}
+	if ctx.Endpoint != "https://dev2.example.com/mcp" {
+		t.Errorf("got endpoint %q, want %q", ctx.Endpoint, "https://dev2.example.com/mcp")
+	}
+	if ctx.Auth == nil || ctx.Auth.TokenDir != "/tmp/dev-tokens" {
+		t.Errorf("expected auth to survive update and rename, got %+v", ctx.Auth)
+	}
+}
+
+func TestStorage_SetContextNamespace(t *testing.T) {
+	storage := newTestStorage(t)
+	if err := storage.AddContext("team-a", "https://muster.example.com/mcp", nil); err != nil {
+		t.Fatalf("AddContext failed: %v", err)
+	}
+	if err := storage.SetContextNamespace("team-a", "team-a"); err != nil {
+		t.Fatalf("SetContextNamespace failed: %v", err)
+	}
+
+	// The namespace survives endpoint updates and renames
+	if err := storage.UpdateContext("team-a", "https://muster2.example.com/mcp", nil); err != nil {
+		t.Fatalf("UpdateContext failed: %v", err)
+	}
+	if err := storage.RenameContext("team-a", "a"); err != nil {
+		t.Fatalf("RenameContext failed: %v", err)
+	}
+	ctx, err := storage.GetContext("a")
+	if err != nil {
+		t.Fatalf("GetContext failed: %v", err)
+	}
+	if ctx.Namespace != "team-a" {
+		t.Errorf("got namespace %q, want %q", ctx.Namespace, "team-a")
+	}
+
+	if err := storage.SetContextNamespace("a", ""); err != nil {
+		t.Fatalf("SetContextNamespace failed: %v", err)
+	}
+	ctx, _ = storage.GetContext("a")
+	if ctx.Namespace != "" {
+		t.Errorf("expected namespace to be removed, got %q", ctx.Namespace)
+	}
+
+	if err := storage.SetContextNamespace("a", "Team_A"); err == nil {
+		t.Error("expected an error for an invalid namespace")
+	}
+
+	if _, ok := storage.SetContextNamespace("missing", "x").(*ContextNotFoundError); !ok {
+		t.Error("expected ContextNotFoundError for a missing context")
+	}
+}
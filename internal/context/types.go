@@ -3,6 +3,9 @@ package context
 import (
 	"fmt"
 	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // ContextEnvVar is the environment variable name for overriding the current context.
@@ -23,6 +26,25 @@ type ContextSettings struct {
 	Output string `yaml:"output,omitempty"`
 }
 
+// ContextAuth references the authentication material of a context, so contexts
+// for different endpoints or identities keep separate credentials.
+type ContextAuth struct {
+	// TokenDir is the directory the context's OAuth tokens are stored in.
+	// Defaults to the shared ~/.config/muster/tokens directory.
+	TokenDir string `yaml:"token-dir,omitempty"`
+	// ClientID is the OAuth client ID to log in with.
+	// Defaults to muster agent's client ID metadata document URL.
+	ClientID string `yaml:"client-id,omitempty"`
+	// IdP is the identity provider to log in with, passed to Dex as its
+	// connector ID so the login skips the connector selection.
+	IdP string `yaml:"idp,omitempty"`
+}
+
+// IsEmpty returns true if no authentication material is referenced.
+func (a *ContextAuth) IsEmpty() bool {
+	return a == nil || (a.TokenDir == "" && a.ClientID == "" && a.IdP == "")
+}
+
 // Context represents a named muster endpoint configuration.
 // Each context provides a convenient alias for a muster aggregator URL.
 type Context struct {
@@ -30,8 +52,12 @@ type Context struct {
 	Name string `yaml:"name"`
 	// Endpoint is the full URL to the muster aggregator (e.g., http://localhost:8090/mcp)
 	Endpoint string `yaml:"endpoint"`
+	// Namespace is the default Kubernetes namespace of operations using this context
+	Namespace string `yaml:"namespace,omitempty"`
 	// Settings contains optional context-specific settings
 	Settings *ContextSettings `yaml:"settings,omitempty"`
+	// Auth references the context's authentication material
+	Auth *ContextAuth `yaml:"auth,omitempty"`
 }
 
 // ContextConfig represents the complete contexts configuration file.
@@ -75,6 +101,14 @@ func ValidateContextName(name string) error {
 	return nil
 }
 
+// ValidateNamespace checks that namespace is a valid Kubernetes namespace name.
+func ValidateNamespace(namespace string) error {
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return fmt.Errorf("invalid namespace %q: %s", namespace, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
 // GetContext returns the context with the given name, or nil if not found.
 func (c *ContextConfig) GetContext(name string) *Context {
 	for i := range c.Contexts {
@@ -0,0 +1,9 @@
+// Package conversion serves the Kubernetes CRD conversion webhook of the muster
+// API group.
+//
+// MCPServer and Workflow are served as v1alpha1, the storage version and
+// conversion hub, and v1beta1, which converts to and from the hub (see
+// pkg/apis/muster/v1beta1). When a CRD's spec.conversion points at muster's
+// /convert endpoint, the API server sends each ConversionReview there and
+// stores and serves every object in the version the client asked for.
+package conversion
@@ -0,0 +1,31 @@
+package conversion
+
+import (
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+	musterv1beta1 "github.com/giantswarm/muster/pkg/apis/muster/v1beta1"
+)
+
+// Path is the HTTP path the conversion webhook is served on.
+const Path = "/convert"
+
+// NewScheme returns a scheme with every served version of the muster API
+// group registered.
+func NewScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(musterv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(musterv1beta1.AddToScheme(scheme))
+	return scheme
+}
+
+// NewHandler returns the HTTP handler of the conversion webhook. It answers
+// apiextensions.k8s.io/v1 ConversionReview requests, converting through the
+// v1alpha1 hub.
+func NewHandler() http.Handler {
+	return conversion.NewWebhookHandler(NewScheme(), conversion.NewRegistry())
+}
@@ -0,0 +1,114 @@
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apix "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+	musterv1beta1 "github.com/giantswarm/muster/pkg/apis/muster/v1beta1"
+)
+
+func review(t *testing.T, desiredAPIVersion string, objs ...any) *apix.ConversionResponse {
+	t.Helper()
+
+	req := &apix.ConversionRequest{UID: types.UID("uid-1"), DesiredAPIVersion: desiredAPIVersion}
+	for _, obj := range objs {
+		raw, err := json.Marshal(obj)
+		require.NoError(t, err)
+		req.Objects = append(req.Objects, runtime.RawExtension{Raw: raw})
+	}
+	body, err := json.Marshal(&apix.ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+		Request:  req,
+	})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp apix.ConversionReview
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Response)
+	assert.Equal(t, req.UID, resp.Response.UID)
+	return resp.Response
+}
+
+func TestHandler_ConvertsMCPServerBetweenVersions(t *testing.T) {
+	server := &musterv1alpha1.MCPServer{
+		TypeMeta:   metav1.TypeMeta{APIVersion: musterv1alpha1.GroupVersion.String(), Kind: "MCPServer"},
+		ObjectMeta: metav1.ObjectMeta{Name: "git", Namespace: "default"},
+		Spec: musterv1alpha1.MCPServerSpec{
+			Type:    "stdio",
+			Command: "npx",
+			Args:    []string{"@modelcontextprotocol/server-git"},
+			Env:     map[string]string{"GIT_ROOT": "/workspace"},
+		},
+		Status: musterv1alpha1.MCPServerStatus{State: musterv1alpha1.MCPServerStateRunning},
+	}
+
+	resp := review(t, musterv1beta1.GroupVersion.String(), server)
+	require.Equal(t, metav1.StatusSuccess, resp.Result.Status, resp.Result.Message)
+	require.Len(t, resp.ConvertedObjects, 1)
+
+	var converted musterv1beta1.MCPServer
+	require.NoError(t, json.Unmarshal(resp.ConvertedObjects[0].Raw, &converted))
+	assert.Equal(t, musterv1beta1.GroupVersion.String(), converted.APIVersion)
+	assert.Equal(t, "MCPServer", converted.Kind)
+	assert.Equal(t, "git", converted.Name)
+	assert.Equal(t, []string{"@modelcontextprotocol/server-git"}, converted.Spec.Args)
+	assert.Equal(t, map[string]string{"GIT_ROOT": "/workspace"}, converted.Spec.Env)
+	assert.Equal(t, musterv1beta1.MCPServerStateRunning, converted.Status.State)
+
+	// Converting back yields the original object.
+	resp = review(t, musterv1alpha1.GroupVersion.String(), &converted)
+	require.Equal(t, metav1.StatusSuccess, resp.Result.Status, resp.Result.Message)
+	var back musterv1alpha1.MCPServer
+	require.NoError(t, json.Unmarshal(resp.ConvertedObjects[0].Raw, &back))
+	assert.Equal(t, server, &back)
+}
+
+func TestHandler_ConvertsWorkflows(t *testing.T) {
+	workflow := &musterv1beta1.Workflow{
+		TypeMeta:   metav1.TypeMeta{APIVersion: musterv1beta1.GroupVersion.String(), Kind: "Workflow"},
+		ObjectMeta: metav1.ObjectMeta{Name: "deploy", Namespace: "default"},
+		Spec: musterv1beta1.WorkflowSpec{
+			Steps: []musterv1beta1.WorkflowStep{{
+				ID:   "apply",
+				Tool: "x_kubernetes_apply",
+				Args: map[string]apix.JSON{"replicas": {Raw: []byte("3")}},
+			}},
+		},
+	}
+
+	resp := review(t, musterv1alpha1.GroupVersion.String(), workflow)
+	require.Equal(t, metav1.StatusSuccess, resp.Result.Status, resp.Result.Message)
+
+	var converted musterv1alpha1.Workflow
+	require.NoError(t, json.Unmarshal(resp.ConvertedObjects[0].Raw, &converted))
+	assert.Equal(t, musterv1alpha1.GroupVersion.String(), converted.APIVersion)
+	require.Len(t, converted.Spec.Steps, 1)
+	assert.Equal(t, "x_kubernetes_apply", converted.Spec.Steps[0].Tool)
+	assert.JSONEq(t, "3", string(converted.Spec.Steps[0].Args["replicas"].Raw))
+}
+
+func TestHandler_RejectsUnknownVersion(t *testing.T) {
+	server := &musterv1alpha1.MCPServer{
+		TypeMeta:   metav1.TypeMeta{APIVersion: musterv1alpha1.GroupVersion.String(), Kind: "MCPServer"},
+		ObjectMeta: metav1.ObjectMeta{Name: "git"},
+	}
+
+	resp := review(t, "muster.giantswarm.io/v2", server)
+	assert.Equal(t, metav1.StatusFailure, resp.Result.Status)
+	assert.Empty(t, resp.ConvertedObjects)
+}
@@ -18,6 +18,8 @@ import (
 type Adapter struct {
 	generator *EventGenerator
 	namespace string
+	history   *busHistory
+	router    notificationRouter
 }
 
 // NewAdapter creates a new events adapter using the provided MusterClient.
@@ -27,24 +29,79 @@ func NewAdapter(musterClient client.MusterClient, namespace string) *Adapter {
 	return &Adapter{
 		generator: NewEventGenerator(musterClient),
 		namespace: namespace,
+		history:   &busHistory{},
 	}
 }
 
 // Register registers this adapter with the API service locator.
 // This method follows the standard pattern used by all service adapters.
+// It also subscribes to permanent reconcile failures on the event bus and
+// records them as events, so they reach the sinks, keeps the recent
+// reconcile, service state, and auth events for resource timelines, and routes
+// the events matching the notification routes to their channels.
 func (a *Adapter) Register() {
 	api.RegisterEventManager(a)
+	api.SubscribeEvents(api.EventFilter{
+		Kinds: []api.EventKind{api.EventKindReconcile},
+		Types: []string{reconcileStateFailed},
+	}, a.handleReconcileFailed)
+	api.SubscribeEvents(api.EventFilter{
+		Kinds: []api.EventKind{api.EventKindReconcile, api.EventKindServiceState, api.EventKindAuth},
+	}, a.history.add)
+	if len(a.router.routes) > 0 {
+		api.SubscribeEvents(api.EventFilter{
+			Kinds: []api.EventKind{api.EventKindServiceState, api.EventKindReconcile, api.EventKindAuth, api.EventKindWorkflow},
+		}, a.router.handle)
+	}
 	logging.Debug("events", "Event manager adapter registered with API")
 }
 
+// AddSink sends the events passing filter to sink.
+func (a *Adapter) AddSink(sink Sink, filter SinkFilter) {
+	a.generator.AddSink(sink, filter)
+}
+
+// AddNotificationRoute sends the internal events matching route to its
+// channels. Routes must be added before Register.
+func (a *Adapter) AddNotificationRoute(route NotificationRoute) {
+	a.router.add(route)
+}
+
+// handleReconcileFailed records a permanent reconcile failure as an event of
+// the reconciled resource.
+func (a *Adapter) handleReconcileFailed(event api.Event) {
+	reconcile, ok := event.Payload.(api.ReconcileEvent)
+	if !ok {
+		return
+	}
+
+	var reason EventReason
+	switch reconcile.ResourceType {
+	case "MCPServer":
+		reason = ReasonMCPServerReconcileFailed
+	case "Workflow":
+		reason = ReasonWorkflowReconcileFailed
+	default:
+		return
+	}
+
+	namespace := reconcile.Namespace
+	if namespace == "" {
+		namespace = a.namespace
+	}
+	if err := a.generator.CRDEvent(context.Background(), reconcile.ResourceType, reconcile.Name, namespace, reason, EventData{Error: reconcile.Error}); err != nil {
+		logging.Debug("events", "Failed to record reconcile failure of %s %s: %v", reconcile.ResourceType, reconcile.Name, err)
+	}
+}
+
 // CreateEventWithData creates an event for a specific object reference, carrying
 // structured EventData so the message template renders contextual detail.
 // Implements EventManagerHandler.CreateEventWithData.
 func (a *Adapter) CreateEventWithData(ctx context.Context, objectRef api.ObjectReference, reason string, data api.EventData) error {
-	logging.Debug("events", "Creating event for %s %s/%s: %s",
+	logging.DebugCtx(ctx, "events", "Creating event for %s %s/%s: %s",
 		objectRef.Kind, objectRef.Namespace, objectRef.Name, reason)
 
-	return a.generator.CRDEvent(objectRef.Kind, objectRef.Name, objectRef.Namespace, EventReason(reason), eventDataFromAPI(data))
+	return a.generator.CRDEvent(ctx, objectRef.Kind, objectRef.Name, objectRef.Namespace, EventReason(reason), eventDataFromAPI(data))
 }
 
 // DefaultNamespace returns the muster CRD namespace.
@@ -73,8 +130,8 @@ func eventDataFromAPI(d api.EventData) EventData {
 // QueryEvents retrieves events based on filtering options.
 // Implements EventManagerHandler.QueryEvents.
 func (a *Adapter) QueryEvents(ctx context.Context, options api.EventQueryOptions) (*api.EventQueryResult, error) {
-	logging.Debug("events", "Querying events with options: resourceType=%s, resourceName=%s, namespace=%s, eventType=%s, limit=%d",
-		options.ResourceType, options.ResourceName, options.Namespace, options.EventType, options.Limit)
+	logging.Debug("events", "Querying events with options: resourceType=%s, resourceName=%s, namespace=%s, eventType=%s, reason=%s, limit=%d",
+		options.ResourceType, options.ResourceName, options.Namespace, options.EventType, options.Reason, options.Limit)
 
 	// Delegate to the underlying MusterClient
 	result, err := a.generator.client.QueryEvents(ctx, options)
@@ -143,6 +200,12 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Required:    false,
 					Description: "Filter by event type (Normal, Warning)",
 				},
+				{
+					Name:        "reason",
+					Type:        api.ArgTypeString,
+					Required:    false,
+					Description: "Filter by event reason (e.g. MCPServerFailed)",
+				},
 				{
 					Name:        "since",
 					Type:        api.ArgTypeString,
@@ -171,6 +234,37 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 				},
 			},
 		},
+		{
+			Name:        "resource_events",
+			Description: "Show the timeline of a resource: its events, reconcile state transitions, service state changes, and auth outcomes, oldest first",
+			Args: []api.ArgMetadata{
+				{
+					Name:        "name",
+					Type:        api.ArgTypeString,
+					Required:    true,
+					Description: "Name of the resource",
+				},
+				{
+					Name:        "resourceType",
+					Type:        api.ArgTypeString,
+					Required:    false,
+					Description: "Restrict the timeline to a resource type (MCPServer, Workflow)",
+				},
+				{
+					Name:        "since",
+					Type:        api.ArgTypeString,
+					Required:    false,
+					Description: "Show entries after this time (duration like '1h' or RFC3339 timestamp)",
+				},
+				{
+					Name:        "limit",
+					Type:        api.ArgTypeNumber,
+					Required:    false,
+					Description: "Maximum number of most recent entries to return",
+					Default:     defaultTimelineLimit,
+				},
+			},
+		},
 	}
 }
 
@@ -180,6 +274,8 @@ func (a *Adapter) ExecuteTool(ctx context.Context, toolName string, args map[str
 	switch toolName {
 	case "events":
 		return a.handleEventsQuery(ctx, args)
+	case "resource_events":
+		return a.handleResourceEvents(ctx, args)
 	default:
 		return nil, fmt.Errorf("unknown tool: %s", toolName)
 	}
@@ -196,9 +292,15 @@ func formatEventForDisplay(event api.EventResult) map[string]interface{} {
 		"message":       event.Message,
 		"type":          event.Type,
 	}
-	// Only include count if it's greater than 1 (useful for Kubernetes mode)
+	// Only include count and first occurrence of aggregated events
 	if event.Count > 1 {
 		eventMap["count"] = event.Count
+		if !event.FirstTimestamp.IsZero() {
+			eventMap["first_timestamp"] = event.FirstTimestamp.Format("2006-01-02 15:04:05")
+		}
+	}
+	if event.CorrelationID != "" {
+		eventMap["correlation_id"] = event.CorrelationID
 	}
 	return eventMap
 }
@@ -224,13 +326,14 @@ func (a *Adapter) handleEventsQuery(ctx context.Context, args map[string]interfa
 		options.EventType = eventType
 	}
 
+	if reason, ok := args["reason"].(string); ok && reason != "" {
+		options.Reason = reason
+	}
+
 	if since, ok := args["since"].(string); ok && since != "" {
 		sinceTime, err := cli.ParseTimeFilter(since)
 		if err != nil {
-			return &api.CallToolResult{
-				IsError: true,
-				Content: []interface{}{fmt.Sprintf("Invalid 'since' time format: %v", err)},
-			}, nil
+			return api.HandleErrorWithPrefix(&api.ValidationFailedError{Err: err}, "Invalid 'since' time format"), nil
 		}
 		options.Since = &sinceTime
 	}
@@ -238,10 +341,7 @@ func (a *Adapter) handleEventsQuery(ctx context.Context, args map[string]interfa
 	if until, ok := args["until"].(string); ok && until != "" {
 		untilTime, err := cli.ParseTimeFilter(until)
 		if err != nil {
-			return &api.CallToolResult{
-				IsError: true,
-				Content: []interface{}{fmt.Sprintf("Invalid 'until' time format: %v", err)},
-			}, nil
+			return api.HandleErrorWithPrefix(&api.ValidationFailedError{Err: err}, "Invalid 'until' time format"), nil
 		}
 		options.Until = &untilTime
 	}
@@ -261,10 +361,7 @@ func (a *Adapter) handleEventsQuery(ctx context.Context, args map[string]interfa
 	// Execute the query
 	result, err := a.QueryEvents(ctx, options)
 	if err != nil {
-		return &api.CallToolResult{
-			IsError: true,
-			Content: []interface{}{fmt.Sprintf("Failed to query events: %v", err)},
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to query events"), nil
 	}
 
 	// Convert events to a format suitable for CLI table display
@@ -284,10 +381,7 @@ func (a *Adapter) handleEventsQuery(ctx context.Context, args map[string]interfa
 	// Marshal events to JSON string for CLI consumption
 	eventsJSON, err := json.Marshal(events)
 	if err != nil {
-		return &api.CallToolResult{
-			IsError: true,
-			Content: []interface{}{fmt.Sprintf("Failed to marshal events to JSON: %v", err)},
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to marshal events to JSON"), nil
 	}
 
 	return &api.CallToolResult{
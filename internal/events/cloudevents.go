@@ -0,0 +1,178 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CloudEventSource is the source attribute of the CloudEvents muster
+	// emits.
+	CloudEventSource = "muster"
+
+	// CloudEventTypePrefix prefixes the event reason to form the type
+	// attribute, e.g. "io.giantswarm.muster.MCPServerFailed".
+	CloudEventTypePrefix = "io.giantswarm.muster."
+
+	// cloudEventsContentType is the content type of CloudEvents in the
+	// structured JSON format.
+	cloudEventsContentType = "application/cloudevents+json"
+
+	// DefaultNATSSubject is the subject events are published to when a NATS
+	// sink has none configured.
+	DefaultNATSSubject = "muster.events"
+)
+
+// CloudEvent is an event in the CloudEvents v1.0 structured JSON format.
+type CloudEvent struct {
+	SpecVersion     string       `json:"specversion"`
+	ID              string       `json:"id"`
+	Source          string       `json:"source"`
+	Type            string       `json:"type"`
+	Subject         string       `json:"subject,omitempty"`
+	Time            time.Time    `json:"time"`
+	DataContentType string       `json:"datacontenttype"`
+	Data            Notification `json:"data"`
+}
+
+// NewCloudEvent wraps notification in a CloudEvent. The subject is the
+// object the event is about, as kind/namespace/name or kind/name.
+func NewCloudEvent(notification Notification) CloudEvent {
+	subject := notification.Kind + "/" + notification.Name
+	if notification.Namespace != "" {
+		subject = notification.Kind + "/" + notification.Namespace + "/" + notification.Name
+	}
+	return CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              uuid.New().String(),
+		Source:          CloudEventSource,
+		Type:            CloudEventTypePrefix + notification.Reason,
+		Subject:         subject,
+		Time:            notification.Timestamp,
+		DataContentType: "application/json",
+		Data:            notification,
+	}
+}
+
+// NATSSink publishes events as CloudEvents to a NATS subject. It connects for
+// each event and waits for the server to acknowledge the publish, so no
+// connection is kept open between events.
+type NATSSink struct {
+	name    string
+	url     *url.URL
+	subject string
+}
+
+// NewNATSSink creates a sink that publishes events to subject on the NATS
+// server at serverURL, nats://[user:password@]host[:port] or tls://... for
+// TLS. A user without a password is sent as a token.
+func NewNATSSink(name, serverURL, subject string) (*NATSSink, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid NATS URL: %w", err)
+	}
+	if u.Scheme != "nats" && u.Scheme != "tls" {
+		return nil, fmt.Errorf("unsupported NATS URL scheme %q (supported: nats, tls)", u.Scheme)
+	}
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), "4222")
+	}
+	if subject == "" {
+		subject = DefaultNATSSubject
+	}
+	return &NATSSink{name: name, url: u, subject: subject}, nil
+}
+
+// Name implements Sink.
+func (s *NATSSink) Name() string {
+	return s.name
+}
+
+// natsInfo holds the fields of the server's INFO message muster uses.
+type natsInfo struct {
+	TLSRequired bool `json:"tls_required"`
+}
+
+// Send implements Sink.
+func (s *NATSSink) Send(ctx context.Context, notification Notification) error {
+	payload, err := json.Marshal(NewCloudEvent(notification))
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", s.url.Host)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	reader := bufio.NewReader(conn)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read NATS INFO: %w", err)
+	}
+	infoJSON, ok := strings.CutPrefix(strings.TrimSpace(line), "INFO ")
+	if !ok {
+		return fmt.Errorf("unexpected NATS greeting %q", strings.TrimSpace(line))
+	}
+	var info natsInfo
+	if err := json.Unmarshal([]byte(infoJSON), &info); err != nil {
+		return fmt.Errorf("invalid NATS INFO: %w", err)
+	}
+
+	if s.url.Scheme == "tls" || info.TLSRequired {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: s.url.Hostname(), MinVersion: tls.VersionTLS12})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return fmt.Errorf("NATS TLS handshake failed: %w", err)
+		}
+		conn = tlsConn
+		reader = bufio.NewReader(conn)
+	}
+
+	connectOptions := map[string]interface{}{"verbose": false, "pedantic": false, "name": "muster"}
+	if user := s.url.User; user != nil {
+		if password, ok := user.Password(); ok {
+			connectOptions["user"] = user.Username()
+			connectOptions["pass"] = password
+		} else {
+			connectOptions["auth_token"] = user.Username()
+		}
+	}
+	connectJSON, err := json.Marshal(connectOptions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal NATS CONNECT: %w", err)
+	}
+
+	// PING after the publish makes the server answer PONG once it processed
+	// both, or -ERR if it rejected them
+	message := fmt.Sprintf("CONNECT %s\r\nPUB %s %d\r\n%s\r\nPING\r\n", connectJSON, s.subject, len(payload), payload)
+	if _, err := conn.Write([]byte(message)); err != nil {
+		return fmt.Errorf("failed to publish to NATS: %w", err)
+	}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read NATS response: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("NATS rejected the event: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
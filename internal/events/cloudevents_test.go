@@ -0,0 +1,192 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testNotification() Notification {
+	return Notification{
+		Reason:    string(ReasonMCPServerFailed),
+		Type:      string(EventTypeWarning),
+		Message:   "MCPServer github operation failed: exit status 1",
+		Kind:      "MCPServer",
+		Name:      "github",
+		Namespace: "default",
+		Timestamp: time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewCloudEvent(t *testing.T) {
+	event := NewCloudEvent(testNotification())
+
+	if event.SpecVersion != "1.0" || event.Source != CloudEventSource || event.ID == "" {
+		t.Errorf("event = %+v", event)
+	}
+	if event.Type != "io.giantswarm.muster.MCPServerFailed" {
+		t.Errorf("Type = %q", event.Type)
+	}
+	if event.Subject != "MCPServer/default/github" {
+		t.Errorf("Subject = %q", event.Subject)
+	}
+	if !event.Time.Equal(testNotification().Timestamp) || event.Data.Message != testNotification().Message {
+		t.Errorf("event = %+v", event)
+	}
+	if other := NewCloudEvent(testNotification()); other.ID == event.ID {
+		t.Errorf("IDs are not unique: %s", event.ID)
+	}
+}
+
+func TestWebhookSink_SendCloudEvent(t *testing.T) {
+	var gotContentType string
+	var gotEvent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		if err := json.NewDecoder(r.Body).Decode(&gotEvent); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink("pipeline", server.URL, WebhookFormatCloudEvents, nil)
+	if err := sink.Send(context.Background(), testNotification()); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if gotContentType != "application/cloudevents+json" {
+		t.Errorf("Content-Type = %q", gotContentType)
+	}
+	if gotEvent["specversion"] != "1.0" || gotEvent["time"] != "2026-10-16T09:00:00Z" {
+		t.Errorf("event = %v", gotEvent)
+	}
+	if data, _ := gotEvent["data"].(map[string]interface{}); data["reason"] != "MCPServerFailed" {
+		t.Errorf("data = %v", gotEvent["data"])
+	}
+}
+
+// fakeNATSServer accepts one connection, records the CONNECT options and the
+// published message, and answers the PING with reply.
+type fakeNATSServer struct {
+	listener net.Listener
+	connect  chan map[string]interface{}
+	publish  chan string
+}
+
+func newFakeNATSServer(t *testing.T, reply string) *fakeNATSServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	s := &fakeNATSServer{
+		listener: listener,
+		connect:  make(chan map[string]interface{}, 1),
+		publish:  make(chan string, 1),
+	}
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_, _ = conn.Write([]byte(`INFO {"server_id":"test","max_payload":1048576}` + "\r\n"))
+
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimSpace(line)
+			switch {
+			case strings.HasPrefix(line, "CONNECT "):
+				var options map[string]interface{}
+				_ = json.Unmarshal([]byte(strings.TrimPrefix(line, "CONNECT ")), &options)
+				s.connect <- options
+			case strings.HasPrefix(line, "PUB "):
+				fields := strings.Fields(line)
+				size, _ := strconv.Atoi(fields[len(fields)-1])
+				payload := make([]byte, size+2)
+				if _, err := io.ReadFull(reader, payload); err != nil {
+					return
+				}
+				s.publish <- fields[1] + " " + string(payload[:size])
+			case line == "PING":
+				_, _ = conn.Write([]byte(reply + "\r\n"))
+			}
+		}
+	}()
+	return s
+}
+
+func TestNATSSink_Send(t *testing.T) {
+	server := newFakeNATSServer(t, "PONG")
+
+	sink, err := NewNATSSink("pipeline", "nats://muster:secret@"+server.listener.Addr().String(), "")
+	if err != nil {
+		t.Fatalf("NewNATSSink() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := sink.Send(ctx, testNotification()); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	options := <-server.connect
+	if options["user"] != "muster" || options["pass"] != "secret" {
+		t.Errorf("CONNECT options = %v", options)
+	}
+	subject, payload, _ := strings.Cut(<-server.publish, " ")
+	if subject != DefaultNATSSubject {
+		t.Errorf("subject = %q, want %q", subject, DefaultNATSSubject)
+	}
+	var event CloudEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		t.Fatalf("payload is not a CloudEvent: %v", err)
+	}
+	if event.Type != "io.giantswarm.muster.MCPServerFailed" || event.Data.Name != "github" {
+		t.Errorf("event = %+v", event)
+	}
+}
+
+func TestNATSSink_SendRejected(t *testing.T) {
+	server := newFakeNATSServer(t, "-ERR 'Permissions Violation for Publish to muster.events'")
+
+	sink, err := NewNATSSink("pipeline", "nats://token@"+server.listener.Addr().String(), "")
+	if err != nil {
+		t.Fatalf("NewNATSSink() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = sink.Send(ctx, testNotification())
+	if err == nil || !strings.Contains(err.Error(), "Permissions Violation") {
+		t.Errorf("Send() error = %v, want permissions violation", err)
+	}
+	if options := <-server.connect; options["auth_token"] != "token" {
+		t.Errorf("CONNECT options = %v", options)
+	}
+}
+
+func TestNewNATSSink(t *testing.T) {
+	sink, err := NewNATSSink("pipeline", "nats://nats.example.com", "ops.muster")
+	if err != nil {
+		t.Fatalf("NewNATSSink() error = %v", err)
+	}
+	if sink.url.Host != "nats.example.com:4222" || sink.subject != "ops.muster" {
+		t.Errorf("sink = %+v", sink)
+	}
+
+	if _, err := NewNATSSink("pipeline", "https://nats.example.com", ""); err == nil {
+		t.Error("NewNATSSink() accepted an https URL")
+	}
+}
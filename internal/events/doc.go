@@ -13,12 +13,19 @@
 //   - EventGenerator: Core event generation utilities using MusterClient
 //   - MessageTemplateEngine: Dynamic message templating system
 //   - Event type definitions and constants
+//   - Sinks: WebhookSink sends generated events to HTTP endpoints as JSON,
+//     Slack-compatible messages, or CloudEvents, and NATSSink publishes them
+//     as CloudEvents to NATS, in the background
+//   - Notification routes: send internal events on the API event bus, such as
+//     a failed service or an expired token, to sinks, including LogSink and
+//     SessionSink, which notifies the connected MCP sessions
 //   - API integration following service locator pattern
 //
 // Backend Support:
 //
 //   - Kubernetes: Creates actual Kubernetes Events API objects
-//   - Filesystem: Logs events to console and events.log file
+//   - Filesystem: Logs events to console and stores them in daily JSON files of
+//     the events directory, pruned by age and count (see filesystem.EventRetention)
 //
 // The package automatically adapts to the current client mode (Kubernetes vs filesystem)
 // through the unified MusterClient interface, ensuring consistent behavior across
@@ -42,5 +49,5 @@
 // Direct usage of EventGenerator is also supported for advanced scenarios:
 //
 //	generator := events.NewEventGenerator(musterClient)
-//	err := generator.MCPServerEvent(server, events.ReasonMCPServerCreated, events.EventData{})
+//	err := generator.MCPServerEvent(ctx, server, events.ReasonMCPServerCreated, events.EventData{})
 package events
@@ -2,6 +2,8 @@ package events
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
 
@@ -11,10 +13,14 @@ import (
 
 // EventGenerator provides event generation utilities using the unified MusterClient.
 // It automatically adapts to the current client mode (Kubernetes vs filesystem)
-// through the MusterClient interface.
+// through the MusterClient interface. Generated events are also sent to the
+// sinks added with AddSink.
 type EventGenerator struct {
 	client    client.MusterClient
 	templates *MessageTemplateEngine
+
+	sinksMu sync.RWMutex
+	sinks   []*sinkWorker
 }
 
 // NewEventGenerator creates a new EventGenerator using the provided MusterClient.
@@ -25,8 +31,9 @@ func NewEventGenerator(musterClient client.MusterClient) *EventGenerator {
 	}
 }
 
-// MCPServerEvent generates an event for an MCPServer CRD.
-func (g *EventGenerator) MCPServerEvent(server *musterv1alpha1.MCPServer, reason EventReason, data EventData) error {
+// MCPServerEvent generates an event for an MCPServer CRD. The correlation ID
+// of ctx, if any, is recorded with the event.
+func (g *EventGenerator) MCPServerEvent(ctx context.Context, server *musterv1alpha1.MCPServer, reason EventReason, data EventData) error {
 	// Populate event data with server information
 	data.Name = server.Name
 	data.Namespace = server.Namespace
@@ -34,14 +41,16 @@ func (g *EventGenerator) MCPServerEvent(server *musterv1alpha1.MCPServer, reason
 	message := g.templates.Render(reason, data)
 	eventType := string(getEventType(reason))
 
-	logging.Debug("events", "Generating MCPServer event: reason=%s, message=%s, type=%s",
+	logging.DebugCtx(ctx, "events", "Generating MCPServer event: reason=%s, message=%s, type=%s",
 		string(reason), message, eventType)
 
-	return g.client.CreateEvent(context.Background(), server, string(reason), message, eventType)
+	g.notify(ctx, "MCPServer", server.Name, server.Namespace, reason, message, eventType)
+	return g.client.CreateEvent(ctx, server, string(reason), message, eventType)
 }
 
-// WorkflowEvent generates an event for a Workflow CRD.
-func (g *EventGenerator) WorkflowEvent(workflow *musterv1alpha1.Workflow, reason EventReason, data EventData) error {
+// WorkflowEvent generates an event for a Workflow CRD. The correlation ID of
+// ctx, if any, is recorded with the event.
+func (g *EventGenerator) WorkflowEvent(ctx context.Context, workflow *musterv1alpha1.Workflow, reason EventReason, data EventData) error {
 	// Populate event data with Workflow information
 	data.Name = workflow.Name
 	data.Namespace = workflow.Namespace
@@ -49,15 +58,17 @@ func (g *EventGenerator) WorkflowEvent(workflow *musterv1alpha1.Workflow, reason
 	message := g.templates.Render(reason, data)
 	eventType := string(getEventType(reason))
 
-	logging.Debug("events", "Generating Workflow event: reason=%s, message=%s, type=%s",
+	logging.DebugCtx(ctx, "events", "Generating Workflow event: reason=%s, message=%s, type=%s",
 		string(reason), message, eventType)
 
-	return g.client.CreateEvent(context.Background(), workflow, string(reason), message, eventType)
+	g.notify(ctx, "Workflow", workflow.Name, workflow.Namespace, reason, message, eventType)
+	return g.client.CreateEvent(ctx, workflow, string(reason), message, eventType)
 }
 
 // CRDEvent generates an event for a CRD by type, name, and namespace.
 // This is useful when you don't have the actual CRD object but know its details.
-func (g *EventGenerator) CRDEvent(crdType, name, namespace string, reason EventReason, data EventData) error {
+// The correlation ID of ctx, if any, is recorded with the event.
+func (g *EventGenerator) CRDEvent(ctx context.Context, crdType, name, namespace string, reason EventReason, data EventData) error {
 	// Populate event data with CRD information
 	data.Name = name
 	data.Namespace = namespace
@@ -65,10 +76,42 @@ func (g *EventGenerator) CRDEvent(crdType, name, namespace string, reason EventR
 	message := g.templates.Render(reason, data)
 	eventType := string(getEventType(reason))
 
-	logging.Debug("events", "Generating CRD event: type=%s, reason=%s, message=%s, eventType=%s",
+	logging.DebugCtx(ctx, "events", "Generating CRD event: type=%s, reason=%s, message=%s, eventType=%s",
 		crdType, string(reason), message, eventType)
 
-	return g.client.CreateEventForCRD(context.Background(), crdType, name, namespace, string(reason), message, eventType)
+	g.notify(ctx, crdType, name, namespace, reason, message, eventType)
+	return g.client.CreateEventForCRD(ctx, crdType, name, namespace, string(reason), message, eventType)
+}
+
+// AddSink sends the generated events passing filter to sink. Events are
+// queued and sent in the background, so a slow sink does not delay event
+// generation.
+func (g *EventGenerator) AddSink(sink Sink, filter SinkFilter) {
+	g.sinksMu.Lock()
+	defer g.sinksMu.Unlock()
+	g.sinks = append(g.sinks, newSinkWorker(sink, filter))
+}
+
+// notify queues an event for the sinks.
+func (g *EventGenerator) notify(ctx context.Context, kind, name, namespace string, reason EventReason, message, eventType string) {
+	g.sinksMu.RLock()
+	defer g.sinksMu.RUnlock()
+	if len(g.sinks) == 0 {
+		return
+	}
+	notification := Notification{
+		Reason:        string(reason),
+		Type:          eventType,
+		Message:       message,
+		Kind:          kind,
+		Name:          name,
+		Namespace:     namespace,
+		Timestamp:     time.Now(),
+		CorrelationID: logging.CorrelationIDFromContext(ctx),
+	}
+	for _, sink := range g.sinks {
+		sink.enqueue(notification)
+	}
 }
 
 // SetTemplate allows customizing the message template for a specific event reason.
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
@@ -115,6 +116,10 @@ func (m *mockMusterClient) DeleteAllOf(ctx context.Context, obj ctrlclient.Objec
 	return nil
 }
 
+func (m *mockMusterClient) Watch(ctx context.Context, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) (watch.Interface, error) {
+	return watch.NewEmptyWatch(), nil
+}
+
 func (m *mockMusterClient) Apply(ctx context.Context, applyConfig runtime.ApplyConfiguration, opts ...ctrlclient.ApplyOption) error {
 	return nil
 }
@@ -208,7 +213,7 @@ func TestEventGenerator_MCPServerEvent(t *testing.T) {
 		Operation: "create",
 	}
 
-	err := generator.MCPServerEvent(server, ReasonMCPServerCreated, data)
+	err := generator.MCPServerEvent(context.Background(), server, ReasonMCPServerCreated, data)
 	if err != nil {
 		t.Fatalf("MCPServerEvent failed: %v", err)
 	}
@@ -240,7 +245,7 @@ func TestEventGenerator_CRDEvent(t *testing.T) {
 		Error: "connection failed",
 	}
 
-	err := generator.CRDEvent("MCPServer", "test-server", "default", ReasonMCPServerFailed, data)
+	err := generator.CRDEvent(context.Background(), "MCPServer", "test-server", "default", ReasonMCPServerFailed, data)
 	if err != nil {
 		t.Fatalf("CRDEvent failed: %v", err)
 	}
@@ -386,3 +391,32 @@ func TestEventGenerator_IsKubernetesMode(t *testing.T) {
 		t.Error("Expected Kubernetes mode to be false")
 	}
 }
+
+func TestFormatEventForDisplay_Aggregated(t *testing.T) {
+	first := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	event := api.EventResult{
+		Timestamp:      first.Add(5 * time.Minute),
+		FirstTimestamp: first,
+		Reason:         string(ReasonMCPServerFailed),
+		Count:          1,
+	}
+
+	display := formatEventForDisplay(event)
+	if _, ok := display["count"]; ok {
+		t.Errorf("count shown for a single event: %v", display)
+	}
+
+	event.Count = 7
+	display = formatEventForDisplay(event)
+	if display["count"] != int32(7) || display["first_timestamp"] != "2026-10-16 09:00:00" {
+		t.Errorf("display = %v", display)
+	}
+	if _, ok := display["correlation_id"]; ok {
+		t.Errorf("correlation_id shown without an ID: %v", display)
+	}
+
+	event.CorrelationID = "req-1"
+	if display = formatEventForDisplay(event); display["correlation_id"] != "req-1" {
+		t.Errorf("display = %v", display)
+	}
+}
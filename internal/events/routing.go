@@ -0,0 +1,253 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// Notification events, the internal events notification routes match.
+const (
+	// NotificationServiceFailed is a service, such as an MCP server, entering
+	// the failed state.
+	NotificationServiceFailed = "service_failed"
+
+	// NotificationReconcileError is a failed reconciliation, whether it is
+	// retried or not.
+	NotificationReconcileError = "reconcile_error"
+
+	// NotificationReconcileFailed is a reconciliation that failed
+	// permanently.
+	NotificationReconcileFailed = "reconcile_failed"
+
+	// NotificationAuthFailed is a failed authentication action, such as a
+	// login or token exchange.
+	NotificationAuthFailed = "auth_failed"
+
+	// NotificationAuthExpired is a token an MCP server rejected as expired
+	// or invalid.
+	NotificationAuthExpired = "auth_expired"
+
+	// NotificationWorkflowFailed is a failed workflow execution.
+	NotificationWorkflowFailed = "workflow_failed"
+)
+
+// Reconcile states of the reconcile events on the event bus.
+const (
+	reconcileStateError = "Error"
+
+	// reconcileStateFailed is the reconcile state of resources whose
+	// reconciliation failed permanently.
+	reconcileStateFailed = "Failed"
+)
+
+// authActionTokenExpired is the auth event action of a token an MCP server
+// rejected.
+const authActionTokenExpired = "token_expired"
+
+// NotificationRoute sends the internal events it matches to channels.
+type NotificationRoute struct {
+	// Name identifies the route in logs.
+	Name string
+
+	// Events are the notification events the route matches, e.g.
+	// NotificationServiceFailed.
+	Events []string
+
+	// Sources restricts the route to events about these resources, e.g. the
+	// MCP server or workflow name. Empty matches all resources.
+	Sources []string
+
+	// Channels are the sinks the matching events are sent to.
+	Channels []Sink
+}
+
+// notificationRouter sends the internal events on the event bus to the
+// channels of the routes they match.
+type notificationRouter struct {
+	routes []*notificationRoute
+}
+
+// notificationRoute is a route with a worker per channel, so a slow channel
+// delays neither the others nor the event bus.
+type notificationRoute struct {
+	NotificationRoute
+	workers []*sinkWorker
+}
+
+func (r *notificationRouter) add(route NotificationRoute) {
+	workers := make([]*sinkWorker, 0, len(route.Channels))
+	for _, channel := range route.Channels {
+		workers = append(workers, newSinkWorker(channel, SinkFilter{}))
+	}
+	r.routes = append(r.routes, &notificationRoute{NotificationRoute: route, workers: workers})
+}
+
+// handle sends event to the channels of every route it matches. Each
+// channel receives an event once, even if several of a route's events match.
+func (r *notificationRouter) handle(event api.Event) {
+	names, notification, ok := classifyNotification(event)
+	if !ok {
+		return
+	}
+	for _, route := range r.routes {
+		if len(route.Sources) > 0 && !slices.Contains(route.Sources, event.Source) {
+			continue
+		}
+		if !slices.ContainsFunc(names, func(name string) bool { return slices.Contains(route.Events, name) }) {
+			continue
+		}
+		logging.Debug("events", "Notification route %s matched %s %s %s", route.Name, notification.Reason, notification.Kind, notification.Name)
+		for _, worker := range route.workers {
+			worker.enqueue(notification)
+		}
+	}
+}
+
+// classifyNotification returns the notification events event is, along with
+// the notification sent for it. A permanent reconcile failure is both a
+// reconcile error and a reconcile failure. It reports false for events no
+// route can match.
+func classifyNotification(event api.Event) ([]string, Notification, bool) {
+	notification := Notification{
+		Type:      string(EventTypeWarning),
+		Name:      event.Source,
+		Timestamp: event.Timestamp,
+	}
+
+	switch payload := event.Payload.(type) {
+	case api.ServiceStateChangedEvent:
+		if payload.NewState != string(api.StateFailed) {
+			return nil, notification, false
+		}
+		notification.Kind = payload.ServiceType
+		notification.Reason = payload.ServiceType + "Failed"
+		notification.Message = fmt.Sprintf("%s %s failed", payload.ServiceType, payload.Name)
+		if payload.Error != nil {
+			notification.Message += ": " + payload.Error.Error()
+		}
+		return []string{NotificationServiceFailed}, notification, true
+
+	case api.ReconcileEvent:
+		notification.Kind = payload.ResourceType
+		notification.Namespace = payload.Namespace
+		switch payload.State {
+		case reconcileStateError:
+			notification.Reason = payload.ResourceType + "ReconcileError"
+			notification.Message = fmt.Sprintf("Reconciling %s %s failed (attempt %d), retrying: %s",
+				payload.ResourceType, payload.Name, payload.RetryCount, payload.Error)
+			return []string{NotificationReconcileError}, notification, true
+		case reconcileStateFailed:
+			notification.Reason = payload.ResourceType + "ReconcileFailed"
+			notification.Message = fmt.Sprintf("Reconciling %s %s failed permanently after %d attempts: %s",
+				payload.ResourceType, payload.Name, payload.RetryCount, payload.Error)
+			return []string{NotificationReconcileError, NotificationReconcileFailed}, notification, true
+		}
+
+	case api.AuthEvent:
+		notification.Kind = "MCPServer"
+		switch {
+		case payload.Action == authActionTokenExpired:
+			notification.Reason = "MCPServerTokenExpired"
+			notification.Message = fmt.Sprintf("MCP server %s rejected a token as expired or invalid, the user must log in again", payload.ServerName)
+			return []string{NotificationAuthExpired}, notification, true
+		case payload.Outcome == "failure":
+			notification.Reason = "MCPServerAuthFailed"
+			notification.Message = fmt.Sprintf("Authentication (%s) to MCP server %s failed: %s", payload.Action, payload.ServerName, payload.Error)
+			return []string{NotificationAuthFailed}, notification, true
+		}
+
+	case api.WorkflowLifecycleEvent:
+		if payload.Reason != string(ReasonWorkflowExecutionFailed) {
+			return nil, notification, false
+		}
+		notification.Kind = "Workflow"
+		notification.Reason = payload.Reason
+		notification.Message = fmt.Sprintf("Execution %s of workflow %s failed: %s", payload.ExecutionID, payload.Workflow, payload.Error)
+		return []string{NotificationWorkflowFailed}, notification, true
+	}
+	return nil, notification, false
+}
+
+// Notification channel levels, of log entries and MCP notifications.
+const (
+	NotificationLevelInfo    = "info"
+	NotificationLevelWarning = "warning"
+	NotificationLevelError   = "error"
+)
+
+// LogSink writes events to the muster log.
+type LogSink struct {
+	level string
+}
+
+// NewLogSink creates a sink that logs events at level, NotificationLevelInfo,
+// NotificationLevelWarning (the default), or NotificationLevelError.
+func NewLogSink(level string) *LogSink {
+	if level == "" {
+		level = NotificationLevelWarning
+	}
+	return &LogSink{level: level}
+}
+
+// Name implements Sink.
+func (s *LogSink) Name() string {
+	return "log"
+}
+
+// Send implements Sink.
+func (s *LogSink) Send(_ context.Context, notification Notification) error {
+	switch s.level {
+	case NotificationLevelInfo:
+		logging.Info("notifications", "%s", notificationText(notification))
+	case NotificationLevelError:
+		logging.Error("notifications", nil, "%s", notificationText(notification))
+	default:
+		logging.Warn("notifications", "%s", notificationText(notification))
+	}
+	return nil
+}
+
+// SessionSink sends events as MCP logging notifications to every MCP session
+// connected to the aggregator.
+type SessionSink struct {
+	level string
+}
+
+// NewSessionSink creates a sink that notifies the connected MCP sessions at
+// level, NotificationLevelInfo, NotificationLevelWarning (the default), or
+// NotificationLevelError.
+func NewSessionSink(level string) *SessionSink {
+	if level == "" {
+		level = NotificationLevelWarning
+	}
+	return &SessionSink{level: level}
+}
+
+// Name implements Sink.
+func (s *SessionSink) Name() string {
+	return "mcp"
+}
+
+// Send implements Sink. It fails while the aggregator is not available, so
+// events are retried until it started.
+func (s *SessionSink) Send(_ context.Context, notification Notification) error {
+	notifier, ok := api.GetAggregator().(api.SessionNotifier)
+	if !ok {
+		return fmt.Errorf("MCP sessions are not available")
+	}
+	notifier.NotifySessions(s.level, "muster", notification)
+	return nil
+}
+
+// notificationText renders notification as a single line.
+func notificationText(n Notification) string {
+	object := n.Kind + " " + n.Name
+	if n.Namespace != "" {
+		object = n.Kind + " " + n.Namespace + "/" + n.Name
+	}
+	return fmt.Sprintf("%s %s: %s", n.Reason, object, n.Message)
+}
@@ -0,0 +1,171 @@
+package events
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+func TestClassifyNotification(t *testing.T) {
+	tests := []struct {
+		name       string
+		event      api.Event
+		wantEvents []string
+		wantReason string
+	}{
+		{
+			name: "service failed",
+			event: api.Event{Kind: api.EventKindServiceState, Source: "github", Payload: api.ServiceStateChangedEvent{
+				Name: "github", ServiceType: "MCPServer", NewState: "failed", Error: errors.New("exit status 1"),
+			}},
+			wantEvents: []string{NotificationServiceFailed},
+			wantReason: "MCPServerFailed",
+		},
+		{
+			name: "service running",
+			event: api.Event{Kind: api.EventKindServiceState, Source: "github", Payload: api.ServiceStateChangedEvent{
+				Name: "github", ServiceType: "MCPServer", NewState: "running",
+			}},
+		},
+		{
+			name: "reconcile error",
+			event: api.Event{Kind: api.EventKindReconcile, Source: "deploy", Payload: api.ReconcileEvent{
+				ResourceType: "Workflow", Name: "deploy", State: "Error", RetryCount: 1,
+			}},
+			wantEvents: []string{NotificationReconcileError},
+			wantReason: "WorkflowReconcileError",
+		},
+		{
+			name: "reconcile failed",
+			event: api.Event{Kind: api.EventKindReconcile, Source: "deploy", Payload: api.ReconcileEvent{
+				ResourceType: "Workflow", Name: "deploy", State: "Failed", RetryCount: 5,
+			}},
+			wantEvents: []string{NotificationReconcileError, NotificationReconcileFailed},
+			wantReason: "WorkflowReconcileFailed",
+		},
+		{
+			name: "auth failed",
+			event: api.Event{Kind: api.EventKindAuth, Source: "github", Payload: api.AuthEvent{
+				Action: "token_exchange", Outcome: "failure", ServerName: "github", Error: "invalid_grant",
+			}},
+			wantEvents: []string{NotificationAuthFailed},
+			wantReason: "MCPServerAuthFailed",
+		},
+		{
+			name: "auth expired",
+			event: api.Event{Kind: api.EventKindAuth, Source: "github", Payload: api.AuthEvent{
+				Action: "token_expired", Outcome: "failure", ServerName: "github",
+			}},
+			wantEvents: []string{NotificationAuthExpired},
+			wantReason: "MCPServerTokenExpired",
+		},
+		{
+			name: "auth succeeded",
+			event: api.Event{Kind: api.EventKindAuth, Source: "github", Payload: api.AuthEvent{
+				Action: "login", Outcome: "success", ServerName: "github",
+			}},
+		},
+		{
+			name: "workflow failed",
+			event: api.Event{Kind: api.EventKindWorkflow, Source: "deploy", Payload: api.WorkflowLifecycleEvent{
+				Workflow: "deploy", Reason: "WorkflowExecutionFailed", ExecutionID: "exec-1", Error: "step apply failed",
+			}},
+			wantEvents: []string{NotificationWorkflowFailed},
+			wantReason: "WorkflowExecutionFailed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, notification, ok := classifyNotification(tt.event)
+			if ok != (tt.wantEvents != nil) {
+				t.Fatalf("classifyNotification() ok = %v, want %v", ok, tt.wantEvents != nil)
+			}
+			if !ok {
+				return
+			}
+			if !reflect.DeepEqual(events, tt.wantEvents) {
+				t.Errorf("events = %v, want %v", events, tt.wantEvents)
+			}
+			if notification.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", notification.Reason, tt.wantReason)
+			}
+			if notification.Type != string(EventTypeWarning) || notification.Name != tt.event.Source || notification.Message == "" {
+				t.Errorf("unexpected notification %+v", notification)
+			}
+		})
+	}
+}
+
+func TestNotificationRouter(t *testing.T) {
+	failures := &recordingSink{notifications: make(chan Notification, 10)}
+	github := &recordingSink{notifications: make(chan Notification, 10)}
+
+	var router notificationRouter
+	router.add(NotificationRoute{
+		Name:     "failures",
+		Events:   []string{NotificationReconcileError, NotificationReconcileFailed, NotificationWorkflowFailed},
+		Channels: []Sink{failures},
+	})
+	router.add(NotificationRoute{
+		Name:     "github",
+		Events:   []string{NotificationServiceFailed, NotificationAuthExpired},
+		Sources:  []string{"github"},
+		Channels: []Sink{github},
+	})
+
+	router.handle(api.Event{Kind: api.EventKindReconcile, Source: "deploy", Payload: api.ReconcileEvent{
+		ResourceType: "Workflow", Name: "deploy", State: "Failed",
+	}})
+	router.handle(api.Event{Kind: api.EventKindServiceState, Source: "gitlab", Payload: api.ServiceStateChangedEvent{
+		Name: "gitlab", ServiceType: "MCPServer", NewState: "failed",
+	}})
+	router.handle(api.Event{Kind: api.EventKindAuth, Source: "github", Payload: api.AuthEvent{
+		Action: "token_expired", Outcome: "failure", ServerName: "github",
+	}})
+
+	if got := receiveNotification(t, failures); got.Reason != "WorkflowReconcileFailed" {
+		t.Errorf("failures received %s, want WorkflowReconcileFailed", got.Reason)
+	}
+	if got := receiveNotification(t, github); got.Reason != "MCPServerTokenExpired" {
+		t.Errorf("github received %s, want MCPServerTokenExpired", got.Reason)
+	}
+
+	// A route matching several events of an event sends it once, and
+	// sources restrict the events of a route
+	select {
+	case got := <-failures.notifications:
+		t.Errorf("failures received unexpected %s", got.Reason)
+	case got := <-github.notifications:
+		t.Errorf("github received unexpected %s", got.Reason)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSessionSink_Send(t *testing.T) {
+	notifier := &recordingNotifier{}
+	api.RegisterAggregator(notifier)
+	defer api.RegisterAggregator(nil)
+
+	notification := Notification{Reason: "MCPServerFailed", Kind: "MCPServer", Name: "github"}
+	if err := NewSessionSink("").Send(t.Context(), notification); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if notifier.level != NotificationLevelWarning || notifier.logger != "muster" || notifier.data != notification {
+		t.Errorf("NotifySessions(%q, %q, %v), want warning, muster, and the notification", notifier.level, notifier.logger, notifier.data)
+	}
+}
+
+// recordingNotifier is an AggregatorHandler that records the last session
+// notification.
+type recordingNotifier struct {
+	api.AggregatorHandler
+	level, logger string
+	data          any
+}
+
+func (n *recordingNotifier) NotifySessions(level, logger string, data any) {
+	n.level, n.logger, n.data = level, logger, data
+}
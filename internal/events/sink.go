@@ -0,0 +1,240 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+const (
+	// sinkQueueSize is the number of events buffered per sink. Events
+	// generated while the queue is full are dropped, so a slow endpoint never
+	// blocks event generation.
+	sinkQueueSize = 100
+
+	// sinkAttempts is the number of times an event is sent before it is
+	// dropped.
+	sinkAttempts = 3
+
+	// sinkRetryDelay is the delay before the second attempt; it doubles for
+	// each further attempt.
+	sinkRetryDelay = time.Second
+
+	// sinkTimeout bounds each request to a sink.
+	sinkTimeout = 10 * time.Second
+)
+
+// Notification is an event as sent to sinks.
+type Notification struct {
+	// Reason is the event reason, e.g. "MCPServerFailed".
+	Reason string `json:"reason"`
+
+	// Type is the event type, "Normal" or "Warning".
+	Type string `json:"type"`
+
+	// Message is the rendered event message.
+	Message string `json:"message"`
+
+	// Kind is the kind of the object the event is about, e.g. "MCPServer".
+	Kind string `json:"kind"`
+
+	// Name is the name of the object the event is about.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the object the event is about.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Timestamp records when the event was generated.
+	Timestamp time.Time `json:"timestamp"`
+
+	// CorrelationID identifies the request the event was generated for, as
+	// in the correlationID of its log entries.
+	CorrelationID string `json:"correlationID,omitempty"`
+}
+
+// Sink delivers events to an external system, such as a webhook that pages
+// on failures.
+type Sink interface {
+	// Name identifies the sink in logs.
+	Name() string
+
+	// Send delivers a single event. Errors are retried.
+	Send(ctx context.Context, notification Notification) error
+}
+
+// SinkFilter selects the events sent to a sink. Each non-empty field
+// restricts the events to those matching one of its values.
+type SinkFilter struct {
+	// Types restricts the event types, "Normal" or "Warning".
+	Types []string
+
+	// Reasons restricts the event reasons.
+	Reasons []string
+}
+
+// Matches reports whether notification passes the filter.
+func (f SinkFilter) Matches(notification Notification) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, notification.Type) {
+		return false
+	}
+	if len(f.Reasons) > 0 && !slices.Contains(f.Reasons, notification.Reason) {
+		return false
+	}
+	return true
+}
+
+// sinkWorker queues the events of a sink and sends them in the background.
+type sinkWorker struct {
+	sink   Sink
+	filter SinkFilter
+	queue  chan Notification
+}
+
+func newSinkWorker(sink Sink, filter SinkFilter) *sinkWorker {
+	w := &sinkWorker{
+		sink:   sink,
+		filter: filter,
+		queue:  make(chan Notification, sinkQueueSize),
+	}
+	go w.run()
+	return w
+}
+
+// enqueue queues notification if it passes the filter, dropping it when the
+// queue is full.
+func (w *sinkWorker) enqueue(notification Notification) {
+	if !w.filter.Matches(notification) {
+		return
+	}
+	select {
+	case w.queue <- notification:
+	default:
+		logging.Warn("events", "Event sink %s is not keeping up, dropping %s event for %s %s",
+			w.sink.Name(), notification.Reason, notification.Kind, notification.Name)
+	}
+}
+
+func (w *sinkWorker) run() {
+	for notification := range w.queue {
+		w.send(notification)
+	}
+}
+
+// send delivers notification, retrying with exponential backoff.
+func (w *sinkWorker) send(notification Notification) {
+	delay := sinkRetryDelay
+	var err error
+	for attempt := 1; attempt <= sinkAttempts; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), sinkTimeout)
+		err = w.sink.Send(ctx, notification)
+		cancel()
+		if err == nil {
+			return
+		}
+		if attempt < sinkAttempts {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+	logging.Warn("events", "Failed to send %s event for %s %s to sink %s after %d attempts: %v",
+		notification.Reason, notification.Kind, notification.Name, w.sink.Name(), sinkAttempts, err)
+}
+
+// Webhook payload formats.
+const (
+	// WebhookFormatJSON sends the Notification as JSON.
+	WebhookFormatJSON = "json"
+
+	// WebhookFormatSlack sends a Slack-compatible {"text": ...} message, as
+	// accepted by Slack, Mattermost, and Rocket.Chat incoming webhooks.
+	WebhookFormatSlack = "slack"
+
+	// WebhookFormatCloudEvents sends a CloudEvents v1.0 event in the
+	// structured JSON format.
+	WebhookFormatCloudEvents = "cloudevents"
+)
+
+// WebhookSink POSTs events to an HTTP endpoint.
+type WebhookSink struct {
+	name    string
+	url     string
+	format  string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewWebhookSink creates a sink that POSTs events to url in format,
+// WebhookFormatJSON, WebhookFormatSlack, or WebhookFormatCloudEvents, with
+// headers added to each request.
+func NewWebhookSink(name, url, format string, headers map[string]string) *WebhookSink {
+	return &WebhookSink{
+		name:    name,
+		url:     url,
+		format:  format,
+		headers: headers,
+		client:  &http.Client{},
+	}
+}
+
+// Name implements Sink.
+func (s *WebhookSink) Name() string {
+	return s.name
+}
+
+// Send implements Sink. Responses other than 2xx are errors.
+func (s *WebhookSink) Send(ctx context.Context, notification Notification) error {
+	var payload interface{} = notification
+	contentType := "application/json"
+	switch s.format {
+	case WebhookFormatSlack:
+		payload = map[string]string{"text": slackText(notification)}
+	case WebhookFormatCloudEvents:
+		payload = NewCloudEvent(notification)
+		contentType = cloudEventsContentType
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	for key, value := range s.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sink responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// slackText renders notification as a single line of Slack mrkdwn.
+func slackText(n Notification) string {
+	icon := ":information_source:"
+	if n.Type == string(EventTypeWarning) {
+		icon = ":warning:"
+	}
+	object := n.Kind + " " + n.Name
+	if n.Namespace != "" {
+		object = n.Kind + " " + n.Namespace + "/" + n.Name
+	}
+	return fmt.Sprintf("%s *%s* %s: %s", icon, n.Reason, object, n.Message)
+}
@@ -0,0 +1,183 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// recordingSink sends the notifications it receives to a channel.
+type recordingSink struct {
+	notifications chan Notification
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Send(ctx context.Context, notification Notification) error {
+	s.notifications <- notification
+	return nil
+}
+
+func receiveNotification(t *testing.T, sink *recordingSink) Notification {
+	t.Helper()
+	select {
+	case notification := <-sink.notifications:
+		return notification
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for notification")
+		return Notification{}
+	}
+}
+
+func TestSinkFilter_Matches(t *testing.T) {
+	warning := Notification{Reason: string(ReasonMCPServerFailed), Type: string(EventTypeWarning)}
+	normal := Notification{Reason: string(ReasonMCPServerStarted), Type: string(EventTypeNormal)}
+
+	tests := []struct {
+		name        string
+		filter      SinkFilter
+		wantWarning bool
+		wantNormal  bool
+	}{
+		{name: "empty filter", filter: SinkFilter{}, wantWarning: true, wantNormal: true},
+		{name: "types", filter: SinkFilter{Types: []string{"Warning"}}, wantWarning: true},
+		{name: "reasons", filter: SinkFilter{Reasons: []string{"MCPServerStarted"}}, wantNormal: true},
+		{name: "types and reasons", filter: SinkFilter{Types: []string{"Normal"}, Reasons: []string{"MCPServerFailed"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(warning); got != tt.wantWarning {
+				t.Errorf("Matches(warning) = %v, want %v", got, tt.wantWarning)
+			}
+			if got := tt.filter.Matches(normal); got != tt.wantNormal {
+				t.Errorf("Matches(normal) = %v, want %v", got, tt.wantNormal)
+			}
+		})
+	}
+}
+
+func TestWebhookSink_Send(t *testing.T) {
+	var gotBody map[string]interface{}
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	notification := Notification{
+		Reason:  string(ReasonMCPServerFailed),
+		Type:    string(EventTypeWarning),
+		Message: "MCPServer github operation failed: exit status 1",
+		Kind:    "MCPServer",
+		Name:    "github",
+	}
+
+	sink := NewWebhookSink("pager", server.URL, WebhookFormatJSON, map[string]string{"Authorization": "Bearer secret"})
+	if err := sink.Send(context.Background(), notification); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if gotAuth != "Bearer secret" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "Bearer secret")
+	}
+	if gotBody["reason"] != "MCPServerFailed" || gotBody["name"] != "github" || gotBody["type"] != "Warning" {
+		t.Errorf("body = %v", gotBody)
+	}
+
+	gotBody = nil
+	sink = NewWebhookSink("slack", server.URL, WebhookFormatSlack, nil)
+	if err := sink.Send(context.Background(), notification); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	text, _ := gotBody["text"].(string)
+	if len(gotBody) != 1 || !strings.Contains(text, "*MCPServerFailed* MCPServer github: MCPServer github operation failed") {
+		t.Errorf("slack body = %v", gotBody)
+	}
+}
+
+func TestWebhookSink_SendErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink("pager", server.URL, WebhookFormatJSON, nil)
+	if err := sink.Send(context.Background(), Notification{}); err == nil || !strings.Contains(err.Error(), "502") {
+		t.Errorf("Send() error = %v, want status 502", err)
+	}
+}
+
+func TestEventGenerator_AddSink(t *testing.T) {
+	generator := NewEventGenerator(&mockMusterClient{})
+	sink := &recordingSink{notifications: make(chan Notification, 10)}
+	generator.AddSink(sink, SinkFilter{Types: []string{string(EventTypeWarning)}})
+
+	if err := generator.CRDEvent(context.Background(), "MCPServer", "github", "default", ReasonMCPServerStarted, EventData{}); err != nil {
+		t.Fatalf("CRDEvent() error = %v", err)
+	}
+	if err := generator.CRDEvent(context.Background(), "MCPServer", "github", "default", ReasonMCPServerFailed, EventData{Error: "exit status 1"}); err != nil {
+		t.Fatalf("CRDEvent() error = %v", err)
+	}
+
+	got := receiveNotification(t, sink)
+	if got.Reason != string(ReasonMCPServerFailed) || got.Kind != "MCPServer" || got.Name != "github" || got.Namespace != "default" {
+		t.Errorf("notification = %+v", got)
+	}
+	if got.Message != "MCPServer github operation failed: exit status 1" {
+		t.Errorf("Message = %q", got.Message)
+	}
+	select {
+	case extra := <-sink.notifications:
+		t.Errorf("unexpected notification %+v", extra)
+	default:
+	}
+}
+
+func TestEventGenerator_NotificationCorrelationID(t *testing.T) {
+	generator := NewEventGenerator(&mockMusterClient{})
+	sink := &recordingSink{notifications: make(chan Notification, 10)}
+	generator.AddSink(sink, SinkFilter{})
+
+	ctx := logging.WithCorrelationID(context.Background(), "req-1")
+	if err := generator.CRDEvent(ctx, "Workflow", "deploy", "default", ReasonWorkflowExecutionFailed, EventData{}); err != nil {
+		t.Fatalf("CRDEvent() error = %v", err)
+	}
+	if got := receiveNotification(t, sink); got.CorrelationID != "req-1" {
+		t.Errorf("CorrelationID = %q, want req-1", got.CorrelationID)
+	}
+}
+
+func TestAdapter_HandleReconcileFailed(t *testing.T) {
+	mockClient := &mockMusterClient{}
+	adapter := NewAdapter(mockClient, "muster")
+	sink := &recordingSink{notifications: make(chan Notification, 10)}
+	adapter.AddSink(sink, SinkFilter{})
+
+	adapter.handleReconcileFailed(api.Event{Payload: api.ReconcileEvent{
+		ResourceType: "MCPServer",
+		Name:         "github",
+		State:        "Failed",
+		Error:        "connection refused",
+	}})
+	adapter.handleReconcileFailed(api.Event{Payload: api.ReconcileEvent{ResourceType: "ServiceClass", Name: "x"}})
+
+	if len(mockClient.eventForCRDCalls) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(mockClient.eventForCRDCalls))
+	}
+	call := mockClient.eventForCRDCalls[0]
+	if call.reason != string(ReasonMCPServerReconcileFailed) || call.namespace != "muster" || call.eventType != string(EventTypeWarning) {
+		t.Errorf("event = %+v", call)
+	}
+	if got := receiveNotification(t, sink); got.Message != "MCPServer github reconciliation failed after retries: connection refused" {
+		t.Errorf("Message = %q", got.Message)
+	}
+}
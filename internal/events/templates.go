@@ -44,6 +44,7 @@ func (e *MessageTemplateEngine) loadDefaultTemplates() {
 	e.templates[ReasonMCPServerRecoveryStarted] = "MCPServer {{.Name}} automatic recovery process started"
 	e.templates[ReasonMCPServerRecoverySucceeded] = "MCPServer {{.Name}} automatic recovery completed successfully"
 	e.templates[ReasonMCPServerRecoveryFailed] = "MCPServer {{.Name}} automatic recovery failed{{if .Error}}: {{.Error}}{{end}}"
+	e.templates[ReasonMCPServerReconcileFailed] = "MCPServer {{.Name}} reconciliation failed after retries{{if .Error}}: {{.Error}}{{end}}"
 	e.templates[ReasonMCPServerAuthRequired] = "MCPServer {{.Name}} requires OAuth authentication to connect"
 	e.templates[ReasonMCPServerTokenForwarded] = "MCPServer {{.Name}}: ID token successfully forwarded for SSO authentication"
 	e.templates[ReasonMCPServerTokenForwardingFailed] = "MCPServer {{.Name}}: ID token forwarding failed{{if .Error}}: {{.Error}}{{end}}"
@@ -57,6 +58,7 @@ func (e *MessageTemplateEngine) loadDefaultTemplates() {
 	e.templates[ReasonWorkflowDeleted] = "Workflow {{.Name}} successfully deleted from namespace {{.Namespace}}"
 	e.templates[ReasonWorkflowValidationFailed] = "Workflow {{.Name}} validation failed{{if .Error}}: {{.Error}}{{end}}"
 	e.templates[ReasonWorkflowValidationSucceeded] = "Workflow {{.Name}} validation completed successfully"
+	e.templates[ReasonWorkflowReconcileFailed] = "Workflow {{.Name}} reconciliation failed after retries{{if .Error}}: {{.Error}}{{end}}"
 
 	// Execution Lifecycle Events
 	e.templates[ReasonWorkflowExecutionStarted] = "Workflow {{.Name}} execution started{{if .ExecutionID}} (execution: {{.ExecutionID}}){{end}}"
@@ -0,0 +1,256 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/cli"
+)
+
+const (
+	// timelineHistorySize is the number of event bus events kept for
+	// timelines, across all resources.
+	timelineHistorySize = 1000
+
+	// defaultTimelineLimit is the number of timeline entries returned when
+	// no limit is given.
+	defaultTimelineLimit = 50
+)
+
+// Timeline entry categories.
+const (
+	// TimelineCategoryEvent entries are stored events, as listed by
+	// core_events.
+	TimelineCategoryEvent = "event"
+
+	// TimelineCategoryReconcile entries are reconcile state transitions.
+	TimelineCategoryReconcile = "reconcile"
+
+	// TimelineCategoryState entries are service state changes.
+	TimelineCategoryState = "state"
+
+	// TimelineCategoryAuth entries are authentication outcomes.
+	TimelineCategoryAuth = "auth"
+)
+
+// TimelineEntry is a single entry of a resource's timeline.
+type TimelineEntry struct {
+	// Timestamp is when the entry occurred; for aggregated events, the last
+	// occurrence.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Category is the source of the entry: event, reconcile, state, or auth.
+	Category string `json:"category"`
+
+	// Type is the severity, Normal or Warning.
+	Type string `json:"type"`
+
+	// Reason is a short, machine-readable code, e.g. the event reason or
+	// "ReconcileFailed".
+	Reason string `json:"reason"`
+
+	// Message describes the entry.
+	Message string `json:"message"`
+
+	// Count is the number of occurrences of aggregated events.
+	Count int32 `json:"count,omitempty"`
+}
+
+// ResourceTimeline is the merged timeline of a resource, oldest entry first.
+type ResourceTimeline struct {
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// ResourceType is the resource type the timeline was restricted to, if
+	// any.
+	ResourceType string `json:"resourceType,omitempty"`
+
+	// Entries are the most recent entries, oldest first.
+	Entries []TimelineEntry `json:"entries"`
+
+	// Truncated reports whether older entries were left out by the limit.
+	Truncated bool `json:"truncated"`
+}
+
+// busHistory keeps the most recent reconcile, service state, and auth events
+// of the event bus, which are not stored, for resource timelines.
+type busHistory struct {
+	mu     sync.Mutex
+	events []api.Event
+	next   int
+}
+
+// add records event, replacing the oldest once the history is full.
+func (h *busHistory) add(event api.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.events) < timelineHistorySize {
+		h.events = append(h.events, event)
+		return
+	}
+	h.events[h.next] = event
+	h.next = (h.next + 1) % timelineHistorySize
+}
+
+// about returns the recorded events whose source is name.
+func (h *busHistory) about(name string) []api.Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var matching []api.Event
+	for _, event := range h.events {
+		if event.Source == name {
+			matching = append(matching, event)
+		}
+	}
+	return matching
+}
+
+// ResourceTimeline returns the timeline of the named resource: its stored
+// events merged with the reconcile transitions, service state changes, and
+// auth outcomes published since muster started. Auth outcomes of other users
+// are left out for authenticated callers.
+func (a *Adapter) ResourceTimeline(ctx context.Context, name, resourceType string, since *time.Time, limit int) (*ResourceTimeline, error) {
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	}
+
+	// Query all stored events of the resource; the limit applies to the
+	// merged timeline
+	stored, err := a.QueryEvents(ctx, api.EventQueryOptions{
+		ResourceType: resourceType,
+		ResourceName: name,
+		Since:        since,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query events: %w", err)
+	}
+
+	var entries []TimelineEntry
+	for _, event := range stored.Events {
+		entries = append(entries, TimelineEntry{
+			Timestamp: event.Timestamp,
+			Category:  TimelineCategoryEvent,
+			Type:      event.Type,
+			Reason:    event.Reason,
+			Message:   event.Message,
+			Count:     event.Count,
+		})
+	}
+
+	caller := api.IdentityFromContext(ctx)
+	for _, event := range a.history.about(name) {
+		if since != nil && event.Timestamp.Before(*since) {
+			continue
+		}
+		if entry, ok := timelineEntryFromBus(event, resourceType, caller); ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+
+	timeline := &ResourceTimeline{Name: name, ResourceType: resourceType, Entries: entries}
+	if len(entries) > limit {
+		timeline.Entries = entries[len(entries)-limit:]
+		timeline.Truncated = true
+	}
+	if timeline.Entries == nil {
+		timeline.Entries = []TimelineEntry{}
+	}
+	return timeline, nil
+}
+
+// timelineEntryFromBus converts an event bus event to a timeline entry. It
+// returns false for events outside the timeline: those of another resource
+// type and auth outcomes of users other than an authenticated caller.
+func timelineEntryFromBus(event api.Event, resourceType string, caller api.Identity) (TimelineEntry, bool) {
+	entry := TimelineEntry{Timestamp: event.Timestamp, Type: string(EventTypeNormal)}
+
+	switch payload := event.Payload.(type) {
+	case api.ReconcileEvent:
+		if resourceType != "" && payload.ResourceType != resourceType {
+			return entry, false
+		}
+		entry.Category = TimelineCategoryReconcile
+		entry.Reason = "Reconcile" + payload.State
+		entry.Message = fmt.Sprintf("%s %s reconcile state %s", payload.ResourceType, payload.Name, payload.State)
+		if payload.Error != "" {
+			entry.Type = string(EventTypeWarning)
+			entry.Message += fmt.Sprintf(" (retry %d): %s", payload.RetryCount, payload.Error)
+		}
+
+	case api.ServiceStateChangedEvent:
+		if resourceType != "" && payload.ServiceType != resourceType {
+			return entry, false
+		}
+		entry.Category = TimelineCategoryState
+		entry.Reason = "StateChanged"
+		entry.Message = fmt.Sprintf("State changed from %s to %s", payload.OldState, payload.NewState)
+		if payload.Health != "" {
+			entry.Message += fmt.Sprintf(" (health: %s)", payload.Health)
+		}
+		if payload.Error != nil {
+			entry.Type = string(EventTypeWarning)
+			entry.Message += ": " + payload.Error.Error()
+		}
+
+	case api.AuthEvent:
+		if resourceType != "" && resourceType != "MCPServer" {
+			return entry, false
+		}
+		if caller.IsAuthenticated() && payload.Subject != caller.Subject {
+			return entry, false
+		}
+		entry.Category = TimelineCategoryAuth
+		entry.Reason = "AuthSucceeded"
+		entry.Message = fmt.Sprintf("%s %s", payload.Action, payload.Outcome)
+		if payload.Outcome == "failure" {
+			entry.Type = string(EventTypeWarning)
+			entry.Reason = "AuthFailed"
+		}
+		if payload.Error != "" {
+			entry.Message += ": " + payload.Error
+		}
+
+	default:
+		return entry, false
+	}
+	return entry, true
+}
+
+// handleResourceEvents handles the resource_events tool execution.
+func (a *Adapter) handleResourceEvents(ctx context.Context, args map[string]interface{}) (*api.CallToolResult, error) {
+	name, _ := args["name"].(string)
+	if name == "" {
+		return api.HandleError(api.NewValidationFailedError("name is required")), nil
+	}
+	resourceType, _ := args["resourceType"].(string)
+
+	var since *time.Time
+	if value, ok := args["since"].(string); ok && value != "" {
+		sinceTime, err := cli.ParseTimeFilter(value)
+		if err != nil {
+			return api.HandleErrorWithPrefix(&api.ValidationFailedError{Err: err}, "Invalid 'since' time format"), nil
+		}
+		since = &sinceTime
+	}
+
+	limit := 0
+	if value, ok := args["limit"].(float64); ok {
+		limit = int(value)
+	} else if value, ok := args["limit"].(int); ok {
+		limit = value
+	}
+
+	timeline, err := a.ResourceTimeline(ctx, name, resourceType, since, limit)
+	if err != nil {
+		return api.HandleErrorWithPrefix(err, "Failed to build resource timeline"), nil
+	}
+	return &api.CallToolResult{Content: []interface{}{timeline}}, nil
+}
@@ -0,0 +1,121 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// timelineClient returns fixed stored events from QueryEvents.
+type timelineClient struct {
+	mockMusterClient
+	stored  []api.EventResult
+	options api.EventQueryOptions
+}
+
+func (c *timelineClient) QueryEvents(ctx context.Context, options api.EventQueryOptions) (*api.EventQueryResult, error) {
+	c.options = options
+	return &api.EventQueryResult{Events: c.stored, TotalCount: len(c.stored)}, nil
+}
+
+func TestAdapter_ResourceTimeline(t *testing.T) {
+	base := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	client := &timelineClient{stored: []api.EventResult{
+		{Timestamp: base.Add(3 * time.Minute), Reason: string(ReasonMCPServerFailed), Type: "Warning", Message: "MCPServer github operation failed", Count: 4},
+		{Timestamp: base, Reason: string(ReasonMCPServerStarting), Type: "Normal", Message: "MCPServer github service is starting up"},
+	}}
+	adapter := NewAdapter(client, "default")
+
+	adapter.history.add(api.Event{Source: "github", Timestamp: base.Add(time.Minute), Payload: api.ServiceStateChangedEvent{
+		Name: "github", ServiceType: "MCPServer", OldState: "starting", NewState: "failed", Error: errors.New("exit status 1"),
+	}})
+	adapter.history.add(api.Event{Source: "github", Timestamp: base.Add(2 * time.Minute), Payload: api.ReconcileEvent{
+		ResourceType: "MCPServer", Name: "github", State: "Error", RetryCount: 2, Error: "connection refused",
+	}})
+	adapter.history.add(api.Event{Source: "github", Timestamp: base.Add(4 * time.Minute), Payload: api.AuthEvent{
+		Action: "login", Outcome: "failure", ServerName: "github", Subject: "alice", Error: "invalid_grant",
+	}})
+	adapter.history.add(api.Event{Source: "github", Timestamp: base.Add(5 * time.Minute), Payload: api.AuthEvent{
+		Action: "login", Outcome: "success", ServerName: "github", Subject: "bob",
+	}})
+	adapter.history.add(api.Event{Source: "gitlab", Timestamp: base.Add(time.Minute), Payload: api.ReconcileEvent{
+		ResourceType: "MCPServer", Name: "gitlab", State: "Synced",
+	}})
+
+	ctx := api.WithIdentity(context.Background(), api.Identity{Subject: "alice"})
+	timeline, err := adapter.ResourceTimeline(ctx, "github", "", nil, 0)
+	if err != nil {
+		t.Fatalf("ResourceTimeline() error = %v", err)
+	}
+	if client.options.ResourceName != "github" || client.options.Limit != 0 {
+		t.Errorf("query options = %+v", client.options)
+	}
+
+	wantReasons := []string{"MCPServerStarting", "StateChanged", "ReconcileError", "MCPServerFailed", "AuthFailed"}
+	if len(timeline.Entries) != len(wantReasons) {
+		t.Fatalf("entries = %+v", timeline.Entries)
+	}
+	for i, want := range wantReasons {
+		if timeline.Entries[i].Reason != want {
+			t.Errorf("entry %d reason = %q, want %q", i, timeline.Entries[i].Reason, want)
+		}
+	}
+	if entry := timeline.Entries[2]; entry.Category != TimelineCategoryReconcile || entry.Type != "Warning" ||
+		entry.Message != "MCPServer github reconcile state Error (retry 2): connection refused" {
+		t.Errorf("reconcile entry = %+v", entry)
+	}
+	if entry := timeline.Entries[3]; entry.Category != TimelineCategoryEvent || entry.Count != 4 {
+		t.Errorf("event entry = %+v", entry)
+	}
+
+	// Unauthenticated callers see all auth outcomes; the limit keeps the
+	// most recent entries
+	timeline, err = adapter.ResourceTimeline(context.Background(), "github", "", nil, 2)
+	if err != nil {
+		t.Fatalf("ResourceTimeline() error = %v", err)
+	}
+	if len(timeline.Entries) != 2 || !timeline.Truncated || timeline.Entries[1].Reason != "AuthSucceeded" {
+		t.Errorf("limited timeline = %+v", timeline)
+	}
+
+	timeline, err = adapter.ResourceTimeline(context.Background(), "github", "Workflow", nil, 0)
+	if err != nil {
+		t.Fatalf("ResourceTimeline() error = %v", err)
+	}
+	for _, entry := range timeline.Entries {
+		if entry.Category != TimelineCategoryEvent {
+			t.Errorf("entry %+v is not of type Workflow", entry)
+		}
+	}
+}
+
+func TestBusHistory_Bounded(t *testing.T) {
+	history := &busHistory{}
+	for i := 0; i < timelineHistorySize+10; i++ {
+		history.add(api.Event{Source: "github", Payload: api.ReconcileEvent{RetryCount: i}})
+	}
+
+	events := history.about("github")
+	if len(events) != timelineHistorySize {
+		t.Fatalf("len = %d, want %d", len(events), timelineHistorySize)
+	}
+	for _, event := range events {
+		if event.Payload.(api.ReconcileEvent).RetryCount < 10 {
+			t.Fatalf("oldest events were not replaced")
+		}
+	}
+}
+
+func TestAdapter_HandleResourceEventsRequiresName(t *testing.T) {
+	adapter := NewAdapter(&timelineClient{}, "default")
+	result, err := adapter.ExecuteTool(context.Background(), "resource_events", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("ExecuteTool() error = %v", err)
+	}
+	if !result.IsError || api.ErrorCodeOf(api.ErrorFromResult(result)) != api.ErrorCodeValidationFailed {
+		t.Errorf("result = %+v, want validation_failed", result)
+	}
+}
@@ -68,6 +68,10 @@ const (
 	// ReasonMCPServerRecoveryFailed indicates automatic recovery failed for an MCPServer.
 	ReasonMCPServerRecoveryFailed EventReason = "MCPServerRecoveryFailed"
 
+	// ReasonMCPServerReconcileFailed indicates reconciliation of an MCPServer
+	// failed permanently after exhausting its retries.
+	ReasonMCPServerReconcileFailed EventReason = "MCPServerReconcileFailed"
+
 	// ReasonMCPServerAuthRequired indicates an MCPServer requires OAuth authentication.
 	ReasonMCPServerAuthRequired EventReason = "MCPServerAuthRequired"
 
@@ -108,6 +112,10 @@ const (
 	// ReasonWorkflowValidationSucceeded indicates workflow definition validation passed.
 	ReasonWorkflowValidationSucceeded EventReason = "WorkflowValidationSucceeded"
 
+	// ReasonWorkflowReconcileFailed indicates reconciliation of a Workflow
+	// failed permanently after exhausting its retries.
+	ReasonWorkflowReconcileFailed EventReason = "WorkflowReconcileFailed"
+
 	// Execution Lifecycle Events
 	// ReasonWorkflowExecutionStarted indicates workflow execution has begun.
 	ReasonWorkflowExecutionStarted EventReason = "WorkflowExecutionStarted"
@@ -236,6 +244,10 @@ func getEventType(reason EventReason) EventType {
 		ReasonMCPServerToolsUnavailable,
 		ReasonMCPServerHealthCheckFailed,
 		ReasonMCPServerRecoveryFailed,
+		ReasonMCPServerReconcileFailed,
+		ReasonMCPServerTokenForwardingFailed,
+		ReasonMCPServerTokenExchangeFailed,
+		ReasonWorkflowReconcileFailed,
 		ReasonWorkflowExecutionFailed,
 		ReasonWorkflowValidationFailed,
 		ReasonWorkflowUnavailable,
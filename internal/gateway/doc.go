@@ -0,0 +1,14 @@
+// Package gateway provides an optional HTTP REST gateway for the core muster
+// operations — services, MCP servers, workflows, workflow executions and auth
+// status — for consumers that do not speak MCP, such as dashboards and
+// scripts. It runs on a dedicated HTTP listener (configured via
+// AggregatorConfig.Gateway) without authentication; callers are expected to
+// bind it to a loopback address and reach it via kubectl port-forward or
+// localhost.
+//
+// Every route calls a tool of the API-layer handlers through api.InvokeTool,
+// so REST calls behave exactly like the equivalent core_* MCP tool calls and
+// pass through the same handler middleware. The OpenAPI document served at
+// /api/v1/openapi.json is generated from the route table and the argument
+// metadata of those tools, so it stays in sync with the handlers.
+package gateway
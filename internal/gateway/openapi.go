@@ -0,0 +1,206 @@
+package gateway
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/pkg/project"
+)
+
+// pathParamPattern matches the parameters in a route path.
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// handleOpenAPI serves the OpenAPI document of the gateway.
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, OpenAPISpec())
+}
+
+// OpenAPISpec generates the OpenAPI 3.0 document of the gateway from the
+// route table and the argument metadata of the tools the routes call. Routes
+// of handlers that are not registered are documented without arguments.
+func OpenAPISpec() map[string]any {
+	paths := map[string]any{}
+	for _, rt := range toolRoutes {
+		item, _ := paths[rt.path].(map[string]any)
+		if item == nil {
+			item = map[string]any{}
+			paths[rt.path] = item
+		}
+		item[strings.ToLower(rt.method)] = rt.operation()
+	}
+	paths[authStatusPath] = map[string]any{
+		"get": map[string]any{
+			"operationId": "getAuthStatus",
+			"summary":     "Get the authentication status of the MCP servers",
+			"tags":        []string{"auth"},
+			"responses":   responses(),
+		},
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "muster REST gateway",
+			"description": "REST access to the core muster operations. Every operation calls the core tool named in its description.",
+			"version":     project.Version(),
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": map[string]any{
+				"Error": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"error": map[string]any{
+							"type":     "object",
+							"required": []string{"code", "message"},
+							"properties": map[string]any{
+								"code": map[string]any{
+									"type": "string",
+									"enum": []api.ErrorCode{
+										api.ErrorCodeNotFound, api.ErrorCodeConflict, api.ErrorCodeValidationFailed,
+										api.ErrorCodeUnavailable, api.ErrorCodeUnauthorized, api.ErrorCodeInternal,
+									},
+								},
+								"message": map[string]any{"type": "string"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// operation returns the OpenAPI operation object of rt.
+func (rt toolRoute) operation() map[string]any {
+	op := map[string]any{
+		"operationId": rt.operationID,
+		"summary":     rt.summary,
+		"description": "Calls core_" + rt.tool + ".",
+		"tags":        []string{rt.handler},
+		"responses":   responses(),
+	}
+
+	var params []any
+	for _, match := range pathParamPattern.FindAllStringSubmatch(rt.path, -1) {
+		params = append(params, map[string]any{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]any{"type": "string"},
+		})
+	}
+
+	var tool *api.ToolMetadata
+	if !strings.Contains(rt.tool, "{") {
+		tool = rt.toolMetadata(rt.provider())
+	}
+	switch {
+	case rt.description != "":
+		op["description"] = rt.description
+	case tool != nil && tool.Description != "":
+		op["description"] = strings.TrimSuffix(tool.Description, ".") + ". Calls core_" + rt.tool + "."
+	}
+
+	pathArgNames := map[string]bool{}
+	for _, arg := range rt.pathArgs {
+		pathArgNames[arg] = true
+	}
+	var args []api.ArgMetadata
+	if tool != nil {
+		for _, arg := range tool.Args {
+			if !pathArgNames[arg.Name] {
+				args = append(args, arg)
+			}
+		}
+	}
+	sort.Slice(args, func(i, j int) bool { return args[i].Name < args[j].Name })
+
+	if rt.method == http.MethodGet {
+		for _, arg := range args {
+			params = append(params, map[string]any{
+				"name":        arg.Name,
+				"in":          "query",
+				"required":    arg.Required,
+				"description": arg.Description,
+				"schema":      argSchema(arg),
+			})
+		}
+	} else {
+		body := map[string]any{"type": "object"}
+		if len(args) > 0 {
+			properties := map[string]any{}
+			var required []string
+			for _, arg := range args {
+				schema := argSchema(arg)
+				schema["description"] = arg.Description
+				properties[arg.Name] = schema
+				if arg.Required {
+					required = append(required, arg.Name)
+				}
+			}
+			body["properties"] = properties
+			if len(required) > 0 {
+				body["required"] = required
+			}
+		} else {
+			body["additionalProperties"] = true
+		}
+		op["requestBody"] = map[string]any{
+			"required": false,
+			"content": map[string]any{
+				"application/json": map[string]any{"schema": body},
+			},
+		}
+	}
+
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	return op
+}
+
+// argSchema returns the JSON schema of a tool argument.
+func argSchema(arg api.ArgMetadata) map[string]any {
+	schema := map[string]any{}
+	for key, value := range arg.Schema {
+		schema[key] = value
+	}
+	if arg.Type != "" {
+		schema["type"] = string(arg.Type)
+	}
+	if arg.Default != nil {
+		schema["default"] = arg.Default
+	}
+	return schema
+}
+
+// responses returns the responses object shared by all operations.
+func responses() map[string]any {
+	errorResponse := func(description string) map[string]any {
+		return map[string]any{
+			"description": description,
+			"content": map[string]any{
+				"application/json": map[string]any{
+					"schema": map[string]any{"$ref": "#/components/schemas/Error"},
+				},
+			},
+		}
+	}
+	return map[string]any{
+		"200": map[string]any{
+			"description": "The result of the operation",
+			"content": map[string]any{
+				"application/json": map[string]any{"schema": map[string]any{}},
+			},
+		},
+		"400":     errorResponse("The request is invalid (validation_failed)"),
+		"404":     errorResponse("The resource does not exist (not_found)"),
+		"409":     errorResponse("The request conflicts with the current state (conflict)"),
+		"503":     errorResponse("A component needed for the request is not available (unavailable)"),
+		"default": errorResponse("Any other error"),
+	}
+}
@@ -0,0 +1,387 @@
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+const (
+	// apiPrefix is the path prefix of all gateway routes.
+	apiPrefix = "/api/v1"
+
+	authStatusPath = apiPrefix + "/auth/status"
+	openAPIPath    = apiPrefix + "/openapi.json"
+
+	// maxBodyBytes caps the size of request bodies.
+	maxBodyBytes = 1 << 20
+)
+
+// toolRoute maps a REST route to a tool of an API-layer handler.
+type toolRoute struct {
+	method string
+	path   string
+
+	// operationID, summary and description describe the route in the
+	// OpenAPI document. The description defaults to the tool's description.
+	operationID string
+	summary     string
+	description string
+
+	// handler is the handler name passed to api.InvokeTool.
+	handler string
+
+	// tool is the name of the tool to call. A "{param}" placeholder is
+	// replaced by the path parameter of the same name.
+	tool string
+
+	// pathArgs maps path parameters to tool arguments.
+	pathArgs map[string]string
+
+	// provider returns the tool provider of the handler, or nil when the
+	// handler is not registered.
+	provider func() api.ToolProvider
+}
+
+// toolRoutes is the route table of the gateway.
+var toolRoutes = []toolRoute{
+	{
+		method:      http.MethodGet,
+		path:        apiPrefix + "/services",
+		operationID: "listServices",
+		summary:     "List services",
+		handler:     "service",
+		tool:        "service_list",
+		provider:    serviceProvider,
+	},
+	{
+		method:      http.MethodGet,
+		path:        apiPrefix + "/services/{name}",
+		operationID: "getService",
+		summary:     "Get the status of a service",
+		handler:     "service",
+		tool:        "service_status",
+		provider:    serviceProvider,
+		pathArgs:    map[string]string{"name": api.FieldName},
+	},
+	{
+		method:      http.MethodPost,
+		path:        apiPrefix + "/services/{name}/start",
+		operationID: "startService",
+		summary:     "Start a service",
+		handler:     "service",
+		tool:        "service_start",
+		provider:    serviceProvider,
+		pathArgs:    map[string]string{"name": api.FieldName},
+	},
+	{
+		method:      http.MethodPost,
+		path:        apiPrefix + "/services/{name}/stop",
+		operationID: "stopService",
+		summary:     "Stop a service",
+		handler:     "service",
+		tool:        "service_stop",
+		provider:    serviceProvider,
+		pathArgs:    map[string]string{"name": api.FieldName},
+	},
+	{
+		method:      http.MethodPost,
+		path:        apiPrefix + "/services/{name}/restart",
+		operationID: "restartService",
+		summary:     "Restart a service",
+		handler:     "service",
+		tool:        "service_restart",
+		provider:    serviceProvider,
+		pathArgs:    map[string]string{"name": api.FieldName},
+	},
+	{
+		method:      http.MethodGet,
+		path:        apiPrefix + "/mcpservers",
+		operationID: "listMCPServers",
+		summary:     "List MCP servers",
+		handler:     "mcpserver",
+		tool:        "mcpserver_list",
+		provider:    mcpServerProvider,
+	},
+	{
+		method:      http.MethodGet,
+		path:        apiPrefix + "/mcpservers/{name}",
+		operationID: "getMCPServer",
+		summary:     "Get an MCP server",
+		handler:     "mcpserver",
+		tool:        "mcpserver_get",
+		provider:    mcpServerProvider,
+		pathArgs:    map[string]string{"name": api.FieldName},
+	},
+	{
+		method:      http.MethodGet,
+		path:        apiPrefix + "/workflows",
+		operationID: "listWorkflows",
+		summary:     "List workflows",
+		handler:     "workflow",
+		tool:        "workflow_list",
+		provider:    workflowProvider,
+	},
+	{
+		method:      http.MethodGet,
+		path:        apiPrefix + "/workflows/{name}",
+		operationID: "getWorkflow",
+		summary:     "Get a workflow definition",
+		handler:     "workflow",
+		tool:        "workflow_get",
+		provider:    workflowProvider,
+		pathArgs:    map[string]string{"name": api.FieldName},
+	},
+	{
+		method:      http.MethodPost,
+		path:        apiPrefix + "/workflows/{name}/executions",
+		operationID: "executeWorkflow",
+		summary:     "Execute a workflow",
+		description: "Executes the workflow with the arguments in the request body, like calling the workflow's MCP tool. The arguments are described by getWorkflow.",
+		handler:     "workflow",
+		tool:        "action_{name}",
+		provider:    workflowProvider,
+	},
+	{
+		method:      http.MethodGet,
+		path:        apiPrefix + "/executions",
+		operationID: "listExecutions",
+		summary:     "List workflow executions",
+		handler:     "workflow",
+		tool:        "workflow_execution_list",
+		provider:    workflowProvider,
+	},
+	{
+		method:      http.MethodGet,
+		path:        apiPrefix + "/executions/{id}",
+		operationID: "getExecution",
+		summary:     "Get a workflow execution",
+		handler:     "workflow",
+		tool:        "workflow_execution_get",
+		provider:    workflowProvider,
+		pathArgs:    map[string]string{"id": api.FieldExecutionID},
+	},
+}
+
+func serviceProvider() api.ToolProvider {
+	if h := api.GetServiceManager(); h != nil {
+		return h
+	}
+	return nil
+}
+
+func mcpServerProvider() api.ToolProvider {
+	if h := api.GetMCPServerManager(); h != nil {
+		return h
+	}
+	return nil
+}
+
+func workflowProvider() api.ToolProvider {
+	if provider, ok := api.GetWorkflow().(api.ToolProvider); ok {
+		return provider
+	}
+	return nil
+}
+
+// toolName returns the name of the tool called for r.
+func (rt toolRoute) toolName(r *http.Request) string {
+	name := rt.tool
+	for {
+		start := strings.Index(name, "{")
+		end := strings.Index(name, "}")
+		if start < 0 || end < start {
+			return name
+		}
+		name = name[:start] + r.PathValue(name[start+1:end]) + name[end+1:]
+	}
+}
+
+// toolMetadata returns the metadata of the route's tool, or nil when the
+// provider does not list it (such as the per-workflow action tools).
+func (rt toolRoute) toolMetadata(provider api.ToolProvider) *api.ToolMetadata {
+	if provider == nil {
+		return nil
+	}
+	for _, tool := range provider.GetTools() {
+		if tool.Name == rt.tool {
+			return &tool
+		}
+	}
+	return nil
+}
+
+// handleToolRoute returns the HTTP handler calling the tool of rt.
+func (s *Server) handleToolRoute(rt toolRoute) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if api.IsMutatingTool(rt.tool) && s.deps.ReadOnly() {
+			writeError(w, api.NewReadOnlyError(rt.tool))
+			return
+		}
+		provider := rt.provider()
+		if provider == nil {
+			writeError(w, api.NewUnavailableError(rt.handler+" handler", nil))
+			return
+		}
+
+		args, err := rt.requestArgs(r, rt.toolMetadata(provider))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		result, err := api.InvokeTool(r.Context(), rt.handler, provider.ExecuteTool, rt.toolName(r), args)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeToolResult(w, result)
+	}
+}
+
+// requestArgs builds the tool arguments of r: query parameters for GET
+// requests, the JSON object in the body for other methods, and the path
+// parameters. Query parameters are converted to the types in tool; when the
+// tool is unknown they are passed as strings.
+func (rt toolRoute) requestArgs(r *http.Request, tool *api.ToolMetadata) (map[string]any, error) {
+	args := map[string]any{}
+
+	if r.Method == http.MethodGet {
+		argTypes := map[string]api.ArgType{}
+		if tool != nil {
+			for _, arg := range tool.Args {
+				argTypes[arg.Name] = arg.Type
+			}
+		}
+		for name, values := range r.URL.Query() {
+			argType, known := argTypes[name]
+			if tool != nil && !known {
+				return nil, api.NewValidationFailedError("unknown query parameter %q", name)
+			}
+			value, err := convertQueryValue(values[len(values)-1], argType)
+			if err != nil {
+				return nil, api.NewValidationFailedError("invalid query parameter %q: %v", name, err)
+			}
+			args[name] = value
+		}
+	} else {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes))
+		if err != nil {
+			return nil, api.NewValidationFailedError("failed to read request body: %v", err)
+		}
+		if len(strings.TrimSpace(string(body))) > 0 {
+			if err := json.Unmarshal(body, &args); err != nil {
+				return nil, api.NewValidationFailedError("request body must be a JSON object: %v", err)
+			}
+		}
+	}
+
+	for param, arg := range rt.pathArgs {
+		args[arg] = r.PathValue(param)
+	}
+	return args, nil
+}
+
+// convertQueryValue converts a query parameter value to argType, matching the
+// types JSON arguments of MCP tool calls decode to.
+func convertQueryValue(value string, argType api.ArgType) (any, error) {
+	switch argType {
+	case api.ArgTypeNumber, api.ArgTypeInteger:
+		return strconv.ParseFloat(value, 64)
+	case api.ArgTypeBoolean:
+		return strconv.ParseBool(value)
+	case api.ArgTypeObject, api.ArgTypeArray:
+		var decoded any
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	default:
+		return value, nil
+	}
+}
+
+// handleAuthStatus serves the authentication status of the MCP servers.
+func (s *Server) handleAuthStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := s.deps.AuthStatus(r.Context())
+	if err != nil {
+		writeError(w, fmt.Errorf("get auth status: %w", err))
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+// errorBody is the JSON body of error responses.
+type errorBody struct {
+	Error api.ErrorInfo `json:"error"`
+}
+
+// statusForCode maps error codes to HTTP status codes.
+func statusForCode(code api.ErrorCode) int {
+	switch code {
+	case api.ErrorCodeNotFound:
+		return http.StatusNotFound
+	case api.ErrorCodeConflict:
+		return http.StatusConflict
+	case api.ErrorCodeValidationFailed:
+		return http.StatusBadRequest
+	case api.ErrorCodeUnavailable:
+		return http.StatusServiceUnavailable
+	case api.ErrorCodeUnauthorized:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeError writes err as an error response with the status of its code.
+func writeError(w http.ResponseWriter, err error) {
+	code := api.ErrorCodeOf(err)
+	writeJSON(w, statusForCode(code), errorBody{Error: api.ErrorInfo{Code: code, Message: err.Error()}})
+}
+
+// writeToolResult writes a tool result. Error results are written as error
+// responses with the status of their code. A single content item is written
+// as JSON, or as plain text when it is a string that is not JSON (such as the
+// YAML of workflow_get); several items are written as a JSON array.
+func writeToolResult(w http.ResponseWriter, result *api.CallToolResult) {
+	if result == nil {
+		writeError(w, errors.New("tool returned no result"))
+		return
+	}
+
+	if err := api.ErrorFromResult(result); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if len(result.Content) != 1 {
+		writeJSON(w, http.StatusOK, result.Content)
+		return
+	}
+	if text, ok := result.Content[0].(string); ok {
+		if json.Valid([]byte(text)) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = io.WriteString(w, text)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, text)
+		return
+	}
+	writeJSON(w, http.StatusOK, result.Content[0])
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
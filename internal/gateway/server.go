@@ -0,0 +1,94 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Deps is the surface the gateway needs from the rest of muster beyond the
+// API-layer handlers. The aggregator package wires these callbacks up; tests
+// inject fakes directly.
+type Deps struct {
+	// AuthStatus returns the authentication status of the MCP servers, as
+	// served by the auth://status MCP resource.
+	AuthStatus func(ctx context.Context) (any, error)
+
+	// ReadOnly reports whether muster runs in read-only mode, in which the
+	// routes of mutating tools are rejected.
+	ReadOnly func() bool
+}
+
+// Config configures the gateway listener.
+type Config struct {
+	BindAddress string // default "127.0.0.1"
+	Port        int    // default 9998
+}
+
+// Server owns the gateway HTTP listener.
+type Server struct {
+	cfg  Config
+	deps Deps
+	http *http.Server
+}
+
+// NewServer constructs a gateway server. Call Start to begin serving.
+func NewServer(cfg Config, deps Deps) (*Server, error) {
+	if deps.AuthStatus == nil || deps.ReadOnly == nil {
+		return nil, errors.New("gateway.NewServer: all Deps callbacks are required")
+	}
+	if cfg.BindAddress == "" {
+		cfg.BindAddress = "127.0.0.1"
+	}
+	if cfg.Port == 0 {
+		cfg.Port = 9998
+	}
+
+	s := &Server{cfg: cfg, deps: deps}
+	s.http = &http.Server{
+		Addr:              net.JoinHostPort(cfg.BindAddress, fmt.Sprintf("%d", cfg.Port)),
+		Handler:           s.routes(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	return s, nil
+}
+
+// Addr reports the listener address, useful in tests.
+func (s *Server) Addr() string { return s.http.Addr }
+
+// Start begins serving in a goroutine and returns immediately. It returns an
+// error only if the listener cannot be bound.
+func (s *Server) Start() error {
+	ln, err := net.Listen("tcp", s.http.Addr)
+	if err != nil {
+		return fmt.Errorf("gateway listen %s: %w", s.http.Addr, err)
+	}
+	go func() {
+		_ = s.http.Serve(ln)
+	}()
+	return nil
+}
+
+// Stop shuts down the gateway listener with a brief grace period.
+func (s *Server) Stop(ctx context.Context) error {
+	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	return s.http.Shutdown(shutdownCtx)
+}
+
+// routes builds the request router from the route table, plus the auth status
+// and OpenAPI document endpoints.
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	for _, rt := range toolRoutes {
+		mux.HandleFunc(rt.method+" "+rt.path, s.handleToolRoute(rt))
+	}
+	mux.HandleFunc("GET "+authStatusPath, s.handleAuthStatus)
+	mux.HandleFunc("GET "+openAPIPath, s.handleOpenAPI)
+
+	return mux
+}
@@ -0,0 +1,273 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// fakeServiceManager is a ServiceManagerHandler whose tools record their
+// arguments.
+type fakeServiceManager struct {
+	lastTool string
+	lastArgs map[string]any
+}
+
+func (f *fakeServiceManager) StartService(string) error   { return nil }
+func (f *fakeServiceManager) StopService(string) error    { return nil }
+func (f *fakeServiceManager) RestartService(string) error { return nil }
+func (f *fakeServiceManager) GetServiceStatus(string) (*api.ServiceStatus, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeServiceManager) GetAllServices() []api.ServiceStatus { return nil }
+func (f *fakeServiceManager) SubscribeToStateChanges() <-chan api.ServiceStateChangedEvent {
+	return nil
+}
+
+func (f *fakeServiceManager) GetTools() []api.ToolMetadata {
+	return []api.ToolMetadata{
+		{
+			Name:        "service_list",
+			Description: "List all services with their current status",
+			Args:        api.ListArgs("Services carry the labels type, state and health."),
+		},
+		{
+			Name:        "service_status",
+			Description: "Get status of a specific service",
+			Args:        []api.ArgMetadata{{Name: "name", Type: api.ArgTypeString, Required: true}},
+		},
+		{
+			Name:        "service_start",
+			Description: "Start a specific service",
+			Args:        []api.ArgMetadata{{Name: "name", Type: api.ArgTypeString, Required: true}},
+		},
+	}
+}
+
+func (f *fakeServiceManager) ExecuteTool(_ context.Context, toolName string, args map[string]any) (*api.CallToolResult, error) {
+	f.lastTool, f.lastArgs = toolName, args
+	switch toolName {
+	case "service_list":
+		return &api.CallToolResult{Content: []any{map[string]any{"services": []any{}, "total": 0}}}, nil
+	case "service_status":
+		return api.HandleError(api.NewServiceNotFoundError(args["name"].(string))), nil
+	case "service_start":
+		return &api.CallToolResult{Content: []any{"Service started"}}, nil
+	default:
+		return nil, errors.New("unknown tool")
+	}
+}
+
+// newTestServer builds a gateway.Server with a fake service manager and
+// returns an httptest server that exercises the real router.
+func newTestServer(t *testing.T) (*httptest.Server, *fakeServiceManager) {
+	t.Helper()
+	manager := &fakeServiceManager{}
+	api.RegisterServiceManager(manager)
+	t.Cleanup(func() { api.RegisterServiceManager(nil) })
+
+	srv, err := NewServer(Config{BindAddress: "127.0.0.1", Port: 1}, Deps{
+		AuthStatus: func(context.Context) (any, error) {
+			return map[string]any{"servers": []any{map[string]any{"name": "github", "status": "auth_required"}}}, nil
+		},
+		ReadOnly: func() bool { return false },
+	})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	ts := httptest.NewServer(srv.routes())
+	t.Cleanup(ts.Close)
+	return ts, manager
+}
+
+func doRequest(t *testing.T, method, url, body string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(data)
+}
+
+func TestNewServer_requiresDeps(t *testing.T) {
+	if _, err := NewServer(Config{}, Deps{}); err == nil {
+		t.Fatal("expected error for missing Deps callbacks")
+	}
+}
+
+func TestToolRoute_list(t *testing.T) {
+	ts, manager := newTestServer(t)
+
+	status, body := doRequest(t, http.MethodGet, ts.URL+"/api/v1/services?limit=5&filter=web-*", "")
+	if status != http.StatusOK {
+		t.Fatalf("status=%d body=%s", status, body)
+	}
+	if manager.lastTool != "service_list" {
+		t.Fatalf("expected service_list to be called, got %q", manager.lastTool)
+	}
+	// Query parameters are converted to the argument types of the tool
+	if manager.lastArgs["limit"] != float64(5) || manager.lastArgs["filter"] != "web-*" {
+		t.Fatalf("unexpected args: %#v", manager.lastArgs)
+	}
+	if !strings.Contains(body, `"total":0`) {
+		t.Fatalf("expected the tool result as JSON, got %s", body)
+	}
+
+	status, body = doRequest(t, http.MethodGet, ts.URL+"/api/v1/services?limit=many", "")
+	if status != http.StatusBadRequest || !strings.Contains(body, `"validation_failed"`) {
+		t.Fatalf("expected validation error for an invalid number, got status=%d body=%s", status, body)
+	}
+
+	status, body = doRequest(t, http.MethodGet, ts.URL+"/api/v1/services?unknown=1", "")
+	if status != http.StatusBadRequest || !strings.Contains(body, "unknown query parameter") {
+		t.Fatalf("expected validation error for an unknown parameter, got status=%d body=%s", status, body)
+	}
+}
+
+func TestToolRoute_pathArgsAndErrors(t *testing.T) {
+	ts, manager := newTestServer(t)
+
+	status, body := doRequest(t, http.MethodGet, ts.URL+"/api/v1/services/missing", "")
+	if status != http.StatusNotFound {
+		t.Fatalf("expected 404 for a not_found result, got status=%d body=%s", status, body)
+	}
+	var errResp errorBody
+	if err := json.Unmarshal([]byte(body), &errResp); err != nil {
+		t.Fatalf("invalid error body %s: %v", body, err)
+	}
+	if errResp.Error.Code != api.ErrorCodeNotFound || !strings.Contains(errResp.Error.Message, "missing") {
+		t.Fatalf("unexpected error body: %+v", errResp)
+	}
+	if manager.lastArgs["name"] != "missing" {
+		t.Fatalf("expected the path parameter as name argument, got %#v", manager.lastArgs)
+	}
+
+	status, body = doRequest(t, http.MethodPost, ts.URL+"/api/v1/services/web/start", "")
+	if status != http.StatusOK || body != "Service started" {
+		t.Fatalf("expected plain text result, got status=%d body=%s", status, body)
+	}
+
+	status, body = doRequest(t, http.MethodPost, ts.URL+"/api/v1/services/web/start", "not json")
+	if status != http.StatusBadRequest {
+		t.Fatalf("expected 400 for an invalid body, got status=%d body=%s", status, body)
+	}
+
+	// The workflow handler is not registered
+	status, body = doRequest(t, http.MethodGet, ts.URL+"/api/v1/workflows", "")
+	if status != http.StatusServiceUnavailable || !strings.Contains(body, `"unavailable"`) {
+		t.Fatalf("expected 503 for an unregistered handler, got status=%d body=%s", status, body)
+	}
+}
+
+func TestToolRoute_readOnly(t *testing.T) {
+	manager := &fakeServiceManager{}
+	api.RegisterServiceManager(manager)
+	t.Cleanup(func() { api.RegisterServiceManager(nil) })
+
+	srv, err := NewServer(Config{}, Deps{
+		AuthStatus: func(context.Context) (any, error) { return nil, nil },
+		ReadOnly:   func() bool { return true },
+	})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	ts := httptest.NewServer(srv.routes())
+	t.Cleanup(ts.Close)
+
+	status, body := doRequest(t, http.MethodPost, ts.URL+"/api/v1/services/web/start", "")
+	if status != http.StatusUnauthorized || !strings.Contains(body, "read-only mode") {
+		t.Fatalf("expected 401 for a mutating tool in read-only mode, got status=%d body=%s", status, body)
+	}
+	if manager.lastTool != "" {
+		t.Fatalf("expected the tool not to be called, got %s", manager.lastTool)
+	}
+
+	if status, body := doRequest(t, http.MethodGet, ts.URL+"/api/v1/services", ""); status != http.StatusOK {
+		t.Fatalf("expected read tools to stay available, got status=%d body=%s", status, body)
+	}
+}
+
+func TestAuthStatus(t *testing.T) {
+	ts, _ := newTestServer(t)
+
+	status, body := doRequest(t, http.MethodGet, ts.URL+"/api/v1/auth/status", "")
+	if status != http.StatusOK || !strings.Contains(body, "auth_required") {
+		t.Fatalf("status=%d body=%s", status, body)
+	}
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	ts, _ := newTestServer(t)
+
+	status, body := doRequest(t, http.MethodGet, ts.URL+"/api/v1/openapi.json", "")
+	if status != http.StatusOK {
+		t.Fatalf("status=%d body=%s", status, body)
+	}
+
+	var spec struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Description string `json:"description"`
+			Parameters  []struct {
+				Name   string         `json:"name"`
+				In     string         `json:"in"`
+				Schema map[string]any `json:"schema"`
+			} `json:"parameters"`
+			RequestBody map[string]any `json:"requestBody"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal([]byte(body), &spec); err != nil {
+		t.Fatalf("invalid spec: %v", err)
+	}
+	if spec.OpenAPI != "3.0.3" {
+		t.Fatalf("unexpected openapi version %q", spec.OpenAPI)
+	}
+
+	for _, rt := range toolRoutes {
+		op, ok := spec.Paths[rt.path][strings.ToLower(rt.method)]
+		if !ok || op.OperationID != rt.operationID {
+			t.Errorf("missing operation %s %s", rt.method, rt.path)
+		}
+	}
+	if _, ok := spec.Paths[authStatusPath]["get"]; !ok {
+		t.Error("missing auth status operation")
+	}
+
+	// Query parameters are generated from the argument metadata of the tool
+	list := spec.Paths["/api/v1/services"]["get"]
+	params := map[string]string{}
+	for _, p := range list.Parameters {
+		params[p.Name] = p.In
+		if p.Name == "limit" && p.Schema["type"] != "number" {
+			t.Errorf("expected limit to be a number, got %v", p.Schema["type"])
+		}
+	}
+	for _, name := range []string{"limit", "cursor", "filter", "label_selector"} {
+		if params[name] != "query" {
+			t.Errorf("expected query parameter %q, got %v", name, params)
+		}
+	}
+	if !strings.Contains(list.Description, "core_service_list") {
+		t.Errorf("expected description to name the core tool, got %q", list.Description)
+	}
+
+	// Path arguments are not repeated in the request body
+	start := spec.Paths["/api/v1/services/{name}/start"]["post"]
+	if len(start.Parameters) != 1 || start.Parameters[0].In != "path" || start.RequestBody == nil {
+		t.Errorf("unexpected start operation: %+v", start)
+	}
+}
@@ -100,9 +100,14 @@ func (a *Adapter) Close() error {
 
 // ListMCPServers returns all MCP server definitions
 func (a *Adapter) ListMCPServers() []api.MCPServerInfo {
+	return a.listMCPServers(a.namespace)
+}
+
+// listMCPServers returns the MCP server definitions of namespace.
+func (a *Adapter) listMCPServers(namespace string) []api.MCPServerInfo {
 	ctx := context.Background()
 
-	servers, err := a.client.ListMCPServers(ctx, a.namespace)
+	servers, err := a.client.ListMCPServers(ctx, namespace)
 	if err != nil {
 		// Log error and return empty list
 		logging.Warn("MCPServer", "Failed to list MCPServers: %v", err)
@@ -119,9 +124,14 @@ func (a *Adapter) ListMCPServers() []api.MCPServerInfo {
 
 // GetMCPServer returns information about a specific MCP server
 func (a *Adapter) GetMCPServer(name string) (*api.MCPServerInfo, error) {
+	return a.getMCPServer(name, a.namespace)
+}
+
+// getMCPServer returns information about an MCP server of namespace.
+func (a *Adapter) getMCPServer(name, namespace string) (*api.MCPServerInfo, error) {
 	ctx := context.Background()
 
-	server, err := a.client.GetMCPServer(ctx, name, a.namespace)
+	server, err := a.client.GetMCPServer(ctx, name, namespace)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, api.NewMCPServerNotFoundError(name)
@@ -256,6 +266,11 @@ func generateFailedMessage(errorMsg, serverName string) string {
 
 // convertRequestToCRD converts a request to a MCPServer CRD using the flat structure
 func (a *Adapter) convertRequestToCRD(req *api.MCPServerCreateRequest) *musterv1alpha1.MCPServer {
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = a.namespace
+	}
+
 	crd := &musterv1alpha1.MCPServer{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "muster.giantswarm.io/v1alpha1",
@@ -263,7 +278,7 @@ func (a *Adapter) convertRequestToCRD(req *api.MCPServerCreateRequest) *musterv1
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      req.Name,
-			Namespace: a.namespace,
+			Namespace: namespace,
 		},
 		Spec: musterv1alpha1.MCPServerSpec{
 			Type:        req.Type,
@@ -319,6 +334,7 @@ func (a *Adapter) convertRequestToCRD(req *api.MCPServerCreateRequest) *musterv1
 func mcpServerArgs(typeRequired bool) []api.ArgMetadata {
 	return []api.ArgMetadata{
 		{Name: "name", Type: api.ArgTypeString, Required: true, Description: "MCP server name"},
+		api.NamespaceArgMetadata(),
 		{Name: "type", Type: api.ArgTypeString, Required: typeRequired, Description: "MCP server type (stdio, streamable-http, or sse)"},
 		{Name: "toolPrefix", Type: api.ArgTypeString, Required: false, Description: "Tool prefix for namespacing"},
 		{Name: "family", Type: api.ArgTypeObject, Required: false, Description: "Family that this MCP server instance belongs to (groups equivalent servers under a single tool name)", Schema: map[string]interface{}{
@@ -385,16 +401,18 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 		{
 			Name:        "mcpserver_list",
 			Description: "List all MCP server definitions with their status. By default, unreachable servers are hidden.",
-			Args: []api.ArgMetadata{
+			Args: append([]api.ArgMetadata{
 				{Name: "showAll", Type: api.ArgTypeBoolean, Required: false, Description: "Show all servers including unreachable ones (default: false)"},
 				{Name: "verbose", Type: api.ArgTypeBoolean, Required: false, Description: "Show detailed error information for failed/unreachable servers (default: false)"},
-			},
+				api.NamespaceArgMetadata(),
+			}, api.ListArgs("MCP servers carry the labels type and state.")...),
 		},
 		{
 			Name:        "mcpserver_get",
 			Description: "Get detailed information about a specific MCP server definition",
 			Args: []api.ArgMetadata{
 				{Name: "name", Type: api.ArgTypeString, Required: true, Description: "Name of the MCP server to retrieve"},
+				api.NamespaceArgMetadata(),
 			},
 		},
 		{
@@ -417,8 +435,14 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 			Description: "Delete an MCP server definition",
 			Args: []api.ArgMetadata{
 				{Name: "name", Type: api.ArgTypeString, Required: true, Description: "Name of the MCP server to delete"},
+				api.NamespaceArgMetadata(),
 			},
 		},
+		{
+			Name:        "mcpserver_batch",
+			Description: "Create, update and delete several MCP server definitions in one call, optionally atomically",
+			Args:        api.BatchArgs("mcpserver"),
+		},
 	}
 }
 
@@ -437,6 +461,8 @@ func (a *Adapter) ExecuteTool(ctx context.Context, toolName string, args map[str
 		return a.handleMCPServerUpdate(args)
 	case "mcpserver_delete":
 		return a.handleMCPServerDelete(args)
+	case "mcpserver_batch":
+		return a.handleMCPServerBatch(ctx, args)
 	default:
 		return nil, fmt.Errorf("unknown tool: %s", toolName)
 	}
@@ -445,7 +471,12 @@ func (a *Adapter) ExecuteTool(ctx context.Context, toolName string, args map[str
 // Tool handlers
 
 func (a *Adapter) handleMCPServerList(args map[string]interface{}) (*api.CallToolResult, error) {
-	allServers := a.ListMCPServers()
+	opts, err := api.ParseListOptions(args)
+	if err != nil {
+		return api.HandleError(err), nil
+	}
+
+	allServers := a.listMCPServers(api.NamespaceFrom(args, a.namespace))
 
 	// Check showAll parameter (default: false)
 	showAll := false
@@ -464,6 +495,12 @@ func (a *Adapter) handleMCPServerList(args map[string]interface{}) (*api.CallToo
 	var filteredServers []api.MCPServerInfo
 	failedCount := 0
 	for _, server := range allServers {
+		// Servers excluded by the filter or label selector are neither
+		// listed nor counted as hidden
+		if !opts.Matches(server.Name, mcpServerLabels(server)) {
+			continue
+		}
+
 		// Adjust server for display (hide raw errors in non-verbose mode)
 		server = adjustServerForDisplay(server, verbose)
 
@@ -477,11 +514,18 @@ func (a *Adapter) handleMCPServerList(args map[string]interface{}) (*api.CallToo
 		}
 	}
 
+	page := api.PaginateList(filteredServers, opts, func(server api.MCPServerInfo) (string, map[string]string) {
+		return server.Name, mcpServerLabels(server)
+	})
+
 	result := map[string]interface{}{
-		"mcpServers": filteredServers,
-		"total":      len(filteredServers),
+		"mcpServers": page.Items,
+		"total":      page.Total,
 		"mode":       getClientMode(a.client),
 	}
+	if page.NextCursor != "" {
+		result[api.ListKeyNextCursor] = page.NextCursor
+	}
 
 	// Add failed count if any servers were hidden
 	if failedCount > 0 && !showAll {
@@ -495,6 +539,15 @@ func (a *Adapter) handleMCPServerList(args map[string]interface{}) (*api.CallToo
 	}, nil
 }
 
+// mcpServerLabels returns the labels a label selector of mcpserver_list is
+// matched against.
+func mcpServerLabels(server api.MCPServerInfo) map[string]string {
+	return map[string]string{
+		"type":  server.Type,
+		"state": server.State,
+	}
+}
+
 // adjustServerForDisplay adjusts server fields for user-friendly display.
 // In non-verbose mode, hide raw error messages (statusMessage provides user-friendly version).
 func adjustServerForDisplay(server api.MCPServerInfo, verbose bool) api.MCPServerInfo {
@@ -510,13 +563,10 @@ func adjustServerForDisplay(server api.MCPServerInfo, verbose bool) api.MCPServe
 func (a *Adapter) handleMCPServerGet(args map[string]interface{}) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok {
-		return &api.CallToolResult{
-			Content: []interface{}{"name argument is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("name argument is required")), nil
 	}
 
-	mcpServer, err := a.GetMCPServer(name)
+	mcpServer, err := a.getMCPServer(name, api.NamespaceFrom(args, a.namespace))
 	if err != nil {
 		return api.HandleErrorWithPrefix(err, "Failed to get MCP server"), nil
 	}
@@ -531,15 +581,13 @@ func (a *Adapter) handleMCPServerGet(args map[string]interface{}) (*api.CallTool
 func (a *Adapter) handleMCPServerValidate(args map[string]interface{}) (*api.CallToolResult, error) {
 	var req api.MCPServerValidateRequest
 	if err := api.ParseRequest(args, &req); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{err.Error()},
-			IsError: true,
-		}, nil
+		return api.HandleError(err), nil
 	}
 
 	// Create MCPServer CRD for validation
 	server := a.convertRequestToCRD(&api.MCPServerCreateRequest{
 		Name:        req.Name,
+		Namespace:   req.Namespace,
 		Type:        req.Type,
 		ToolPrefix:  req.ToolPrefix,
 		Family:      req.Family,
@@ -556,10 +604,7 @@ func (a *Adapter) handleMCPServerValidate(args map[string]interface{}) (*api.Cal
 
 	// Basic validation (more comprehensive validation would be done by the CRD schema)
 	if err := a.validateMCPServer(server); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Validation failed: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(&api.ValidationFailedError{Err: err}, "Validation failed"), nil
 	}
 
 	return &api.CallToolResult{
@@ -571,10 +616,11 @@ func (a *Adapter) handleMCPServerValidate(args map[string]interface{}) (*api.Cal
 func (a *Adapter) handleMCPServerCreate(args map[string]interface{}) (*api.CallToolResult, error) {
 	var req api.MCPServerCreateRequest
 	if err := api.ParseRequest(args, &req); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{err.Error()},
-			IsError: true,
-		}, nil
+		return api.HandleError(err), nil
+	}
+
+	if req.Namespace == "" {
+		req.Namespace = a.namespace
 	}
 
 	// Convert request to CRD once for reuse
@@ -582,25 +628,27 @@ func (a *Adapter) handleMCPServerCreate(args map[string]interface{}) (*api.CallT
 
 	// Validate the definition
 	if err := a.validateMCPServer(serverCRD); err != nil {
-		return simpleError(fmt.Sprintf("Invalid MCP server definition: %v", err))
+		return api.HandleErrorWithPrefix(&api.ValidationFailedError{Err: err}, "Invalid MCP server definition"), nil
 	}
 
 	// Create the new MCP server using the unified client
 	ctx := context.Background()
 	if err := a.client.CreateMCPServer(ctx, serverCRD); err != nil {
 		if errors.IsAlreadyExists(err) {
-			return simpleError(fmt.Sprintf("MCP server '%s' already exists", req.Name))
+			return api.HandleError(&api.ConflictError{Message: fmt.Sprintf("MCP server '%s' already exists", req.Name)}), nil
 		}
 		// Generate failure event
 		a.generateCRDEvent(req.Name, events.ReasonMCPServerFailed, events.EventData{
+			Namespace: req.Namespace,
 			Error:     err.Error(),
 			Operation: "create",
 		})
-		return simpleError(fmt.Sprintf("Failed to create MCP server: %v", err))
+		return api.HandleErrorWithPrefix(err, "Failed to create MCP server"), nil
 	}
 
 	// Generate success event for CRD creation
 	a.generateCRDEvent(req.Name, events.ReasonMCPServerCreated, events.EventData{
+		Namespace: req.Namespace,
 		Operation: "create",
 	})
 
@@ -610,23 +658,52 @@ func (a *Adapter) handleMCPServerCreate(args map[string]interface{}) (*api.CallT
 func (a *Adapter) handleMCPServerUpdate(args map[string]interface{}) (*api.CallToolResult, error) {
 	var req api.MCPServerUpdateRequest
 	if err := api.ParseRequest(args, &req); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{err.Error()},
-			IsError: true,
-		}, nil
+		return api.HandleError(err), nil
+	}
+
+	if req.Namespace == "" {
+		req.Namespace = a.namespace
 	}
 
 	// Get existing server first
 	ctx := context.Background()
-	existing, err := a.client.GetMCPServer(ctx, req.Name, a.namespace)
+	existing, err := a.client.GetMCPServer(ctx, req.Name, req.Namespace)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return api.HandleErrorWithPrefix(api.NewMCPServerNotFoundError(req.Name), "Failed to update MCP server"), nil
 		}
-		return simpleError(fmt.Sprintf("Failed to get existing MCP server: %v", err))
+		return api.HandleErrorWithPrefix(err, "Failed to get existing MCP server"), nil
 	}
 
-	// Update common fields from request
+	mergeUpdateRequest(existing, &req)
+
+	// Validate the updated definition (reuse existing CRD object)
+	if err := a.validateMCPServer(existing); err != nil {
+		return api.HandleErrorWithPrefix(&api.ValidationFailedError{Err: err}, "Invalid MCP server definition"), nil
+	}
+
+	// Update the MCP server using the unified client
+	if err := a.client.UpdateMCPServer(ctx, existing); err != nil {
+		// Generate failure event
+		a.generateCRDEvent(req.Name, events.ReasonMCPServerFailed, events.EventData{
+			Namespace: req.Namespace,
+			Error:     err.Error(),
+			Operation: "update",
+		})
+		return api.HandleErrorWithPrefix(err, "Failed to update MCP server"), nil
+	}
+
+	// Generate success event for CRD update
+	a.generateCRDEvent(req.Name, events.ReasonMCPServerUpdated, events.EventData{
+		Namespace: req.Namespace,
+		Operation: "update",
+	})
+
+	return simpleOK(fmt.Sprintf("MCP server '%s' updated successfully", req.Name))
+}
+
+// mergeUpdateRequest applies the fields set in req to the spec of existing.
+func mergeUpdateRequest(existing *musterv1alpha1.MCPServer, req *api.MCPServerUpdateRequest) {
 	if req.Type != "" {
 		existing.Spec.Type = req.Type
 	}
@@ -682,44 +759,25 @@ func (a *Adapter) handleMCPServerUpdate(args map[string]interface{}) (*api.CallT
 			}
 		}
 	}
-
-	// Validate the updated definition (reuse existing CRD object)
-	if err := a.validateMCPServer(existing); err != nil {
-		return simpleError(fmt.Sprintf("Invalid MCP server definition: %v", err))
-	}
-
-	// Update the MCP server using the unified client
-	if err := a.client.UpdateMCPServer(ctx, existing); err != nil {
-		// Generate failure event
-		a.generateCRDEvent(req.Name, events.ReasonMCPServerFailed, events.EventData{
-			Error:     err.Error(),
-			Operation: "update",
-		})
-		return api.HandleErrorWithPrefix(err, "Failed to update MCP server"), nil
-	}
-
-	// Generate success event for CRD update
-	a.generateCRDEvent(req.Name, events.ReasonMCPServerUpdated, events.EventData{
-		Operation: "update",
-	})
-
-	return simpleOK(fmt.Sprintf("MCP server '%s' updated successfully", req.Name))
 }
 
 func (a *Adapter) handleMCPServerDelete(args map[string]interface{}) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok || name == "" {
-		return simpleError("name argument is required")
+		return api.HandleError(api.NewValidationFailedError("name argument is required")), nil
 	}
 
+	namespace := api.NamespaceFrom(args, a.namespace)
+
 	// Delete the MCP server using the unified client
 	ctx := context.Background()
-	if err := a.client.DeleteMCPServer(ctx, name, a.namespace); err != nil {
+	if err := a.client.DeleteMCPServer(ctx, name, namespace); err != nil {
 		if errors.IsNotFound(err) {
 			return api.HandleErrorWithPrefix(api.NewMCPServerNotFoundError(name), "Failed to delete MCP server"), nil
 		}
 		// Generate failure event
 		a.generateCRDEvent(name, events.ReasonMCPServerFailed, events.EventData{
+			Namespace: namespace,
 			Error:     err.Error(),
 			Operation: "delete",
 		})
@@ -728,6 +786,7 @@ func (a *Adapter) handleMCPServerDelete(args map[string]interface{}) (*api.CallT
 
 	// Generate success event for CRD deletion
 	a.generateCRDEvent(name, events.ReasonMCPServerDeleted, events.EventData{
+		Namespace: namespace,
 		Operation: "delete",
 	})
 
@@ -766,11 +825,6 @@ func (a *Adapter) validateMCPServer(server *musterv1alpha1.MCPServer) error {
 	return nil
 }
 
-// helper to create simple error CallToolResult
-func simpleError(msg string) (*api.CallToolResult, error) {
-	return &api.CallToolResult{Content: []interface{}{msg}, IsError: true}, nil
-}
-
 func simpleOK(msg string) (*api.CallToolResult, error) {
 	return &api.CallToolResult{Content: []interface{}{msg}, IsError: false}, nil
 }
@@ -791,19 +845,19 @@ func (a *Adapter) generateCRDEvent(name string, reason events.EventReason, data
 		return
 	}
 
-	// Create an object reference for the MCPServer CRD
-	objectRef := api.ObjectReference{
-		Kind:      "MCPServer",
-		Name:      name,
-		Namespace: a.namespace,
-	}
-
 	// Populate event data
 	data.Name = name
 	if data.Namespace == "" {
 		data.Namespace = a.namespace
 	}
 
+	// Create an object reference for the MCPServer CRD
+	objectRef := api.ObjectReference{
+		Kind:      "MCPServer",
+		Name:      name,
+		Namespace: data.Namespace,
+	}
+
 	err := eventManager.CreateEventWithData(context.Background(), objectRef, string(reason), data.ToAPI())
 	if err != nil {
 		// Log error but don't fail the operation
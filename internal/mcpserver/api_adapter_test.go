@@ -0,0 +1,96 @@
+package mcpserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/giantswarm/muster/internal/client"
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// namespacedClient is a MusterClient holding MCPServers per namespace. Only
+// the MCPServer methods are implemented.
+type namespacedClient struct {
+	client.MusterClient
+	servers map[string]map[string]*musterv1alpha1.MCPServer
+}
+
+func newNamespacedClient() *namespacedClient {
+	return &namespacedClient{servers: make(map[string]map[string]*musterv1alpha1.MCPServer)}
+}
+
+func (c *namespacedClient) GetMCPServer(_ context.Context, name, namespace string) (*musterv1alpha1.MCPServer, error) {
+	server, ok := c.servers[namespace][name]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "mcpservers"}, name)
+	}
+	return server.DeepCopy(), nil
+}
+
+func (c *namespacedClient) ListMCPServers(_ context.Context, namespace string) ([]musterv1alpha1.MCPServer, error) {
+	var list []musterv1alpha1.MCPServer
+	for _, server := range c.servers[namespace] {
+		list = append(list, *server.DeepCopy())
+	}
+	return list, nil
+}
+
+func (c *namespacedClient) CreateMCPServer(_ context.Context, server *musterv1alpha1.MCPServer) error {
+	if c.servers[server.Namespace] == nil {
+		c.servers[server.Namespace] = make(map[string]*musterv1alpha1.MCPServer)
+	}
+	c.servers[server.Namespace][server.Name] = server.DeepCopy()
+	return nil
+}
+
+func (c *namespacedClient) DeleteMCPServer(_ context.Context, name, namespace string) error {
+	if _, ok := c.servers[namespace][name]; !ok {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "mcpservers"}, name)
+	}
+	delete(c.servers[namespace], name)
+	return nil
+}
+
+func TestAdapter_NamespaceArg(t *testing.T) {
+	c := newNamespacedClient()
+	adapter := NewAdapterWithClient(c, "muster")
+
+	createArgs := func(namespace string) map[string]interface{} {
+		args := map[string]interface{}{"name": "git", "type": "stdio", "command": "mcp-git"}
+		if namespace != "" {
+			args["namespace"] = namespace
+		}
+		return args
+	}
+
+	// Without a namespace argument the adapter's namespace is used
+	result, err := adapter.handleMCPServerCreate(createArgs(""))
+	require.NoError(t, err)
+	require.False(t, result.IsError, "%v", result.Content)
+	assert.Contains(t, c.servers["muster"], "git")
+
+	result, err = adapter.handleMCPServerCreate(createArgs("team-a"))
+	require.NoError(t, err)
+	require.False(t, result.IsError, "%v", result.Content)
+	assert.Contains(t, c.servers["team-a"], "git")
+
+	_, err = adapter.getMCPServer("git", "team-a")
+	require.NoError(t, err)
+	assert.Len(t, adapter.listMCPServers("team-a"), 1)
+	assert.Empty(t, adapter.listMCPServers("team-b"))
+
+	result, err = adapter.handleMCPServerGet(map[string]interface{}{"name": "git", "namespace": "team-b"})
+	require.NoError(t, err)
+	assert.True(t, result.IsError, "git does not exist in team-b")
+
+	result, err = adapter.handleMCPServerDelete(map[string]interface{}{"name": "git", "namespace": "team-a"})
+	require.NoError(t, err)
+	require.False(t, result.IsError, "%v", result.Content)
+	assert.NotContains(t, c.servers["team-a"], "git")
+	assert.Contains(t, c.servers["muster"], "git", "the server in the adapter's namespace is left alone")
+}
@@ -0,0 +1,127 @@
+package mcpserver
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+var _ api.BatchTarget = (*Adapter)(nil)
+
+// handleMCPServerBatch applies several create, update and delete operations
+// in one call.
+func (a *Adapter) handleMCPServerBatch(ctx context.Context, args map[string]interface{}) (*api.CallToolResult, error) {
+	req, err := api.ParseBatchRequest(args)
+	if err != nil {
+		return api.HandleError(err), nil
+	}
+	return api.BatchToolResult(api.ExecuteBatch(ctx, req, a)), nil
+}
+
+// ValidateBatchItem checks the arguments of creates and updates and the
+// definitions they result in. Conflicts with the current state, such as
+// creating a server that exists, are detected when the item is applied, as
+// earlier items of the batch may resolve them.
+func (a *Adapter) ValidateBatchItem(ctx context.Context, item api.BatchItem) error {
+	switch item.Operation {
+	case api.BatchOperationCreate:
+		var req api.MCPServerCreateRequest
+		if err := api.ParseRequest(item.Args, &req); err != nil {
+			return err
+		}
+		if err := a.validateMCPServer(a.convertRequestToCRD(&req)); err != nil {
+			return &api.ValidationFailedError{Message: "invalid MCP server definition", Err: err}
+		}
+	case api.BatchOperationUpdate:
+		var req api.MCPServerUpdateRequest
+		if err := api.ParseRequest(item.Args, &req); err != nil {
+			return err
+		}
+		existing, err := a.client.GetMCPServer(ctx, req.Name, api.NamespaceFrom(item.Args, a.namespace))
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get MCPServer %s: %w", req.Name, err)
+		}
+		mergeUpdateRequest(existing, &req)
+		if err := a.validateMCPServer(existing); err != nil {
+			return &api.ValidationFailedError{Message: "invalid MCP server definition", Err: err}
+		}
+	}
+	return nil
+}
+
+// ApplyBatchItem applies an item through the handler of the matching
+// single-resource tool. The returned function restores the server as it was
+// before the item was applied.
+func (a *Adapter) ApplyBatchItem(ctx context.Context, item api.BatchItem) (func(context.Context) error, error) {
+	name := item.Name()
+	namespace := api.NamespaceFrom(item.Args, a.namespace)
+
+	var previous *musterv1alpha1.MCPServer
+	if item.Operation != api.BatchOperationCreate {
+		existing, err := a.client.GetMCPServer(ctx, name, namespace)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil, api.NewMCPServerNotFoundError(name)
+			}
+			return nil, fmt.Errorf("failed to get MCPServer %s: %w", name, err)
+		}
+		previous = existing.DeepCopy()
+	}
+
+	var result *api.CallToolResult
+	var err error
+	switch item.Operation {
+	case api.BatchOperationCreate:
+		result, err = a.handleMCPServerCreate(item.Args)
+	case api.BatchOperationUpdate:
+		result, err = a.handleMCPServerUpdate(item.Args)
+	case api.BatchOperationDelete:
+		result, err = a.handleMCPServerDelete(item.Args)
+	default:
+		return nil, api.NewValidationFailedError("unsupported operation %q", item.Operation)
+	}
+	if err == nil {
+		err = api.ErrorFromResult(result)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch item.Operation {
+	case api.BatchOperationCreate:
+		return func(ctx context.Context) error {
+			return a.client.DeleteMCPServer(ctx, name, namespace)
+		}, nil
+	case api.BatchOperationUpdate:
+		return func(ctx context.Context) error {
+			current, err := a.client.GetMCPServer(ctx, name, namespace)
+			if err != nil {
+				return fmt.Errorf("failed to get MCPServer %s: %w", name, err)
+			}
+			current.Spec = previous.Spec
+			return a.client.UpdateMCPServer(ctx, current)
+		}, nil
+	default:
+		return func(ctx context.Context) error {
+			return a.client.CreateMCPServer(ctx, &musterv1alpha1.MCPServer{
+				TypeMeta: previous.TypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        previous.Name,
+					Namespace:   previous.Namespace,
+					Labels:      previous.Labels,
+					Annotations: previous.Annotations,
+				},
+				Spec: previous.Spec,
+			})
+		}, nil
+	}
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"os/exec"
+	"strconv"
 	"time"
 
 	"github.com/giantswarm/muster/pkg/logging"
 	"github.com/giantswarm/muster/pkg/observability"
 
 	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpotel "github.com/mark3labs/mcp-go/otel"
 	"go.opentelemetry.io/otel"
@@ -19,6 +23,11 @@ import (
 // This covers the time needed to start the subprocess and complete the MCP handshake.
 const DefaultStdioInitTimeout = 10 * time.Second
 
+// StdioOwnerPIDEnv is the environment variable carrying the PID of the muster
+// process that started a stdio server. The janitor uses it to find server
+// processes that outlived muster or their MCP server.
+const StdioOwnerPIDEnv = "MUSTER_OWNER_PID"
+
 // StdioClient implements the MCPClient interface using stdio transport.
 // It manages a local subprocess that communicates via stdin/stdout.
 type StdioClient struct {
@@ -26,6 +35,7 @@ type StdioClient struct {
 	command string
 	args    []string
 	env     map[string]string
+	cmd     *exec.Cmd // subprocess handle, retained so PID can be reported
 }
 
 // NewStdioClientWithEnv creates a new stdio-based MCP client with environment variables
@@ -54,8 +64,16 @@ func (c *StdioClient) Initialize(ctx context.Context) error {
 		envStrings = append(envStrings, fmt.Sprintf("%s=%s", k, v))
 	}
 
-	// Create stdio client - it will start the process
-	mcpClient, err := client.NewStdioMCPClient(c.command, envStrings, c.args...)
+	// Create stdio client - it will start the process. The command func
+	// mirrors mcp-go's default and only exists to keep the *exec.Cmd.
+	var cmd *exec.Cmd
+	mcpClient, err := client.NewStdioMCPClientWithOptions(c.command, envStrings, c.args,
+		transport.WithCommandFunc(func(ctx context.Context, command string, env []string, args []string) (*exec.Cmd, error) {
+			cmd = exec.CommandContext(ctx, command, args...) //nolint:gosec
+			cmd.Env = append(os.Environ(), env...)
+			cmd.Env = append(cmd.Env, StdioOwnerPIDEnv+"="+strconv.Itoa(os.Getpid()))
+			return cmd, nil
+		}))
 	if err != nil {
 		return fmt.Errorf("failed to create stdio client: %w", err)
 	}
@@ -98,6 +116,7 @@ func (c *StdioClient) Initialize(ctx context.Context) error {
 	logging.Debug("StdioClient", "MCP protocol initialized successfully for %s", c.command)
 
 	c.client = mcpClient
+	c.cmd = cmd
 	c.connected = true
 	c.wireNotificationHandler()
 
@@ -160,6 +179,18 @@ func (c *StdioClient) OnNotification(handler func(mcp.JSONRPCNotification)) {
 	c.onNotification(handler)
 }
 
+// PID returns the process ID of the server subprocess, or 0 if the client
+// is not connected.
+func (c *StdioClient) PID() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.connected || c.cmd == nil || c.cmd.Process == nil {
+		return 0
+	}
+	return c.cmd.Process.Pid
+}
+
 // GetStderr returns a reader for the stderr output of the subprocess
 func (c *StdioClient) GetStderr() (io.Reader, bool) {
 	c.mu.RLock()
@@ -224,3 +224,16 @@ func (a *Adapter) ListServersRequiringAuth(ctx context.Context) []api.ServerAuth
 
 	return provider.ListServersRequiringAuth(ctx)
 }
+
+// CapabilityOrigins returns the MCP servers providing the tools, resources,
+// and prompts available to the current session. This is used by the
+// export_catalog handler to group the catalog by server.
+func (a *Adapter) CapabilityOrigins(ctx context.Context) api.CapabilityOrigins {
+	provider, err := a.getDataProvider()
+	if err != nil {
+		logging.Warn("metatools", "CapabilityOrigins: %v", err)
+		return api.CapabilityOrigins{}
+	}
+
+	return provider.CapabilityOriginsForContext(ctx)
+}
@@ -27,6 +27,13 @@
 //
 // Execution tools:
 //   - call_tool: Execute any tool by name with arguments
+//   - call_tools: Execute a batch of tools concurrently, with per-call results
+//
+// Statistics tools:
+//   - tool_stats: Report tool call counts, error rates, and latency
+//
+// Catalog tools:
+//   - export_catalog: Export the complete catalog grouped by server
 //
 // Resource tools:
 //   - list_resources: List all available resources
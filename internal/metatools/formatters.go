@@ -231,14 +231,21 @@ func (f *Formatters) FormatPromptsListJSON(prompts []mcp.Prompt) (string, error)
 //	{
 //	  api.FieldName: "tool_name",
 //	  api.SchemaKeyDescription: "Tool description",
-//	  api.FieldInputSchema: { ... }
+//	  api.FieldInputSchema: { ... },
+//	  api.FieldAnnotations: { "readOnlyHint": true }
 //	}
+//
+// The annotations are only included if the tool has any, e.g. the
+// readOnlyHint of the core tools.
 func (f *Formatters) FormatToolDetailJSON(tool mcp.Tool) (string, error) {
 	toolInfo := map[string]interface{}{
 		api.FieldName:            tool.Name,
 		api.SchemaKeyDescription: tool.Description,
 		api.FieldInputSchema:     tool.InputSchema,
 	}
+	if tool.Annotations != (mcp.ToolAnnotation{}) {
+		toolInfo[api.FieldAnnotations] = tool.Annotations
+	}
 
 	jsonData, err := json.MarshalIndent(toolInfo, "", "  ")
 	if err != nil {
@@ -144,6 +144,14 @@ func TestFormatters_FormatToolDetailJSON(t *testing.T) {
 	assert.Equal(t, "test_tool", parsed["name"])
 	assert.Equal(t, "A test tool", parsed["description"])
 	assert.NotNil(t, parsed["inputSchema"])
+	assert.NotContains(t, parsed, "annotations")
+
+	readOnly := true
+	tool.Annotations = mcp.ToolAnnotation{ReadOnlyHint: &readOnly}
+	result, err = formatters.FormatToolDetailJSON(tool)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(result), &parsed))
+	assert.Equal(t, map[string]interface{}{"readOnlyHint": true}, parsed["annotations"])
 }
 
 func TestFormatters_FormatResourceDetailJSON(t *testing.T) {
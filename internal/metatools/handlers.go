@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"math"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/pkg/logging"
@@ -61,6 +65,12 @@ func (p *Provider) ExecuteTool(ctx context.Context, toolName string, args map[st
 		return p.handleFilterTools(ctx, args)
 	case "call_tool":
 		return p.handleCallTool(ctx, args)
+	case "call_tools":
+		return p.handleCallTools(ctx, args)
+	case "tool_stats":
+		return p.handleToolStats(ctx, args)
+	case "export_catalog":
+		return p.handleExportCatalog(ctx, args)
 	case "list_resources":
 		return p.handleListResources(ctx, args)
 	case "describe_resource":
@@ -474,7 +484,7 @@ func toInt(v any) (int, error) {
 func (p *Provider) handleCallTool(ctx context.Context, args map[string]any) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok || name == "" {
-		return errorResult("name argument is required"), nil
+		return api.HandleError(api.NewValidationFailedError("name argument is required")), nil
 	}
 
 	// Get arguments if provided
@@ -483,7 +493,7 @@ func (p *Provider) handleCallTool(ctx context.Context, args map[string]any) (*ap
 		var ok bool
 		toolArgs, ok = argsRaw.(map[string]any)
 		if !ok {
-			return errorResult("arguments must be a JSON object"), nil
+			return api.HandleError(api.NewValidationFailedError("arguments must be a JSON object")), nil
 		}
 	}
 
@@ -495,7 +505,7 @@ func (p *Provider) handleCallTool(ctx context.Context, args map[string]any) (*ap
 	// Execute the tool via the handler
 	result, err := handler.CallTool(ctx, name, toolArgs)
 	if err != nil {
-		return errorResult(fmt.Sprintf("Tool execution failed: %v", err)), nil
+		return api.HandleErrorWithPrefix(err, "Tool execution failed"), nil
 	}
 
 	// CRITICAL: Return result as structured JSON to preserve CallToolResult structure.
@@ -527,6 +537,266 @@ func (p *Provider) handleCallTool(ctx context.Context, args map[string]any) (*ap
 	}, nil
 }
 
+// maxBatchCalls caps the number of calls of one call_tools request, so a
+// single request cannot fan out without bound through the aggregator.
+const maxBatchCalls = 50
+
+// defaultBatchConcurrency and maxBatchConcurrency bound how many calls of a
+// call_tools request are in flight at once.
+const (
+	defaultBatchConcurrency = 4
+	maxBatchConcurrency     = 16
+)
+
+// batchCall is one validated entry of a call_tools request.
+type batchCall struct {
+	tool string
+	args map[string]any
+}
+
+// handleCallTools handles the call_tools meta-tool.
+// This handler executes a batch of tools with bounded concurrency and returns
+// the result of each call in request order. A failing call does not stop the
+// others; the batch is only an error when every call failed.
+func (p *Provider) handleCallTools(ctx context.Context, args map[string]any) (*api.CallToolResult, error) {
+	rawCalls, ok := args["calls"].([]any)
+	if !ok || len(rawCalls) == 0 {
+		return api.HandleError(api.NewValidationFailedError("calls argument is required and must be a non-empty array")), nil
+	}
+	if len(rawCalls) > maxBatchCalls {
+		return api.HandleError(api.NewValidationFailedError(
+			"too many calls: %d (at most %d)", len(rawCalls), maxBatchCalls)), nil
+	}
+
+	concurrency := defaultBatchConcurrency
+	if concurrencyVal, ok := args["concurrency"]; ok {
+		n, err := toInt(concurrencyVal)
+		if err != nil {
+			return api.HandleError(api.NewValidationFailedError("concurrency must be a number")), nil
+		}
+		if n < 1 || n > maxBatchConcurrency {
+			return api.HandleError(api.NewValidationFailedError(
+				"concurrency must be between 1 and %d", maxBatchConcurrency)), nil
+		}
+		concurrency = n
+	}
+
+	calls := make([]batchCall, len(rawCalls))
+	for i, raw := range rawCalls {
+		entry, ok := raw.(map[string]any)
+		if !ok {
+			return api.HandleError(api.NewValidationFailedError("calls[%d] must be a JSON object", i)), nil
+		}
+		tool, ok := entry["tool"].(string)
+		if !ok || tool == "" {
+			return api.HandleError(api.NewValidationFailedError("calls[%d].tool is required", i)), nil
+		}
+		if tool == ToolCallTools {
+			return api.HandleError(api.NewValidationFailedError("calls[%d]: %s cannot be nested", i, ToolCallTools)), nil
+		}
+		calls[i].tool = tool
+		if argsRaw := entry["args"]; argsRaw != nil {
+			toolArgs, ok := argsRaw.(map[string]any)
+			if !ok {
+				return api.HandleError(api.NewValidationFailedError("calls[%d].args must be a JSON object", i)), nil
+			}
+			calls[i].args = toolArgs
+		}
+	}
+
+	handler, errResult := p.getHandler()
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	results := make([]CallToolsResult, len(calls))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, call := range calls {
+		wg.Add(1)
+		go func(i int, call batchCall) {
+			defer wg.Done()
+			results[i] = CallToolsResult{Index: i, Tool: call.tool}
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				results[i].IsError = true
+				results[i].Error = ctx.Err().Error()
+				return
+			}
+
+			result, err := handler.CallTool(ctx, call.tool, call.args)
+			if err != nil {
+				results[i].IsError = true
+				results[i].Error = err.Error()
+				return
+			}
+			results[i].IsError = result.IsError
+			results[i].Content = SerializeContent(result.Content)
+			results[i].StructuredContent = result.StructuredContent
+		}(i, call)
+	}
+	wg.Wait()
+
+	response := CallToolsResponse{Results: results}
+	for _, result := range results {
+		if result.IsError {
+			response.Failed++
+		} else {
+			response.Succeeded++
+		}
+	}
+
+	jsonData, err := json.Marshal(response)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Failed to serialize results: %v", err)), nil
+	}
+	return &api.CallToolResult{
+		Content: []any{string(jsonData)},
+		IsError: response.Succeeded == 0,
+	}, nil
+}
+
+// handleToolStats handles the tool_stats meta-tool.
+// This handler reports the usage of the tools called since muster started,
+// and lists the tools available to the session that were never called.
+func (p *Provider) handleToolStats(ctx context.Context, args map[string]any) (*api.CallToolResult, error) {
+	sortBy := api.ToolStatsSortCalls
+	if sortVal, ok := args["sort_by"].(string); ok && sortVal != "" {
+		if !slices.Contains(api.ToolStatsSortOrders, sortVal) {
+			return errorResult(fmt.Sprintf("sort_by must be one of: %s", strings.Join(api.ToolStatsSortOrders, ", "))), nil
+		}
+		sortBy = sortVal
+	}
+	pattern, _ := args["pattern"].(string)
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return errorResult(fmt.Sprintf("Invalid pattern %q: %v", pattern, err)), nil
+	}
+
+	stats := api.GetToolCallStatsProvider()
+	if stats == nil {
+		return errorResult("Tool statistics not available"), nil
+	}
+	handler, errResult := p.getHandler()
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	tools, err := handler.ListTools(ctx)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Failed to list tools: %v", err)), nil
+	}
+	available := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		if matchesPattern(tool.Name, pattern, false) {
+			available = append(available, tool.Name)
+		}
+	}
+	var usage []api.ToolUsageStats
+	for _, u := range stats.ToolUsage() {
+		if matchesPattern(u.Tool, pattern, false) {
+			usage = append(usage, u)
+		}
+	}
+
+	jsonData, err := json.Marshal(api.NewToolStatsReport(usage, available, sortBy))
+	if err != nil {
+		return errorResult(fmt.Sprintf("Failed to serialize tool stats: %v", err)), nil
+	}
+	return textResult(string(jsonData)), nil
+}
+
+// handleExportCatalog handles the export_catalog meta-tool.
+// This handler returns the tools, resources, and prompts of the session grouped
+// by the server providing them, for documentation generation and offline
+// analysis. Unlike list_tools, tools keep their full descriptions and schemas.
+func (p *Provider) handleExportCatalog(ctx context.Context, args map[string]any) (*api.CallToolResult, error) {
+	serverFilter, _ := args["server"].(string)
+
+	handler, errResult := p.getHandler()
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	tools, err := handler.ListTools(ctx)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Failed to list tools: %v", err)), nil
+	}
+	resources, err := handler.ListResources(ctx)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Failed to list resources: %v", err)), nil
+	}
+	prompts, err := handler.ListPrompts(ctx)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Failed to list prompts: %v", err)), nil
+	}
+	origins := handler.CapabilityOrigins(ctx)
+
+	servers := map[string]*CatalogServer{}
+	server := func(name string) *CatalogServer {
+		s, ok := servers[name]
+		if !ok {
+			s = &CatalogServer{Name: name, Tools: []mcp.Tool{}, Resources: []mcp.Resource{}, Prompts: []mcp.Prompt{}}
+			servers[name] = s
+		}
+		return s
+	}
+	selected := func(name string) bool { return serverFilter == "" || name == serverFilter }
+
+	var totals CatalogTotals
+	for _, tool := range tools {
+		names := origins.Tools[tool.Name]
+		if len(names) == 0 {
+			names = []string{CatalogCoreServer}
+		}
+		counted := false
+		for _, name := range names {
+			if selected(name) {
+				server(name).Tools = append(server(name).Tools, tool)
+				counted = true
+			}
+		}
+		if counted {
+			totals.Tools++
+		}
+	}
+	for _, resource := range resources {
+		if name := origins.Resources[resource.URI]; name != "" && selected(name) {
+			server(name).Resources = append(server(name).Resources, resource)
+			totals.Resources++
+		}
+	}
+	for _, prompt := range prompts {
+		if name := origins.Prompts[prompt.Name]; name != "" && selected(name) {
+			server(name).Prompts = append(server(name).Prompts, prompt)
+			totals.Prompts++
+		}
+	}
+
+	if serverFilter != "" && len(servers) == 0 {
+		return errorResult(fmt.Sprintf("Server not found in catalog: %s", serverFilter)), nil
+	}
+
+	export := CatalogExport{GeneratedAt: time.Now().UTC(), Servers: make([]CatalogServer, 0, len(servers))}
+	for _, s := range servers {
+		sort.Slice(s.Tools, func(i, j int) bool { return s.Tools[i].Name < s.Tools[j].Name })
+		sort.Slice(s.Resources, func(i, j int) bool { return s.Resources[i].URI < s.Resources[j].URI })
+		sort.Slice(s.Prompts, func(i, j int) bool { return s.Prompts[i].Name < s.Prompts[j].Name })
+		export.Servers = append(export.Servers, *s)
+	}
+	sort.Slice(export.Servers, func(i, j int) bool { return export.Servers[i].Name < export.Servers[j].Name })
+	totals.Servers = len(export.Servers)
+	export.Totals = totals
+
+	jsonData, err := json.Marshal(export)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Failed to serialize catalog: %v", err)), nil
+	}
+	return textResult(string(jsonData)), nil
+}
+
 // handleListResources handles the list_resources meta-tool.
 // This handler returns a list of all available resources.
 func (p *Provider) handleListResources(ctx context.Context, _ map[string]any) (*api.CallToolResult, error) {
@@ -667,7 +937,7 @@ func (p *Provider) handleDescribePrompt(ctx context.Context, args map[string]any
 func (p *Provider) handleGetPrompt(ctx context.Context, args map[string]any) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok || name == "" {
-		return errorResult("name argument is required"), nil
+		return api.HandleError(api.NewValidationFailedError("name argument is required")), nil
 	}
 
 	// Get arguments if provided and convert to string map
@@ -675,7 +945,7 @@ func (p *Provider) handleGetPrompt(ctx context.Context, args map[string]any) (*a
 	if argsRaw := args["arguments"]; argsRaw != nil {
 		argsMap, ok := argsRaw.(map[string]any)
 		if !ok {
-			return errorResult("arguments must be a JSON object"), nil
+			return api.HandleError(api.NewValidationFailedError("arguments must be a JSON object")), nil
 		}
 
 		for k, v := range argsMap {
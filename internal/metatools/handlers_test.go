@@ -3,6 +3,8 @@ package metatools
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"sync/atomic"
 	"testing"
 
 	"github.com/giantswarm/muster/internal/api"
@@ -20,12 +22,16 @@ type mockMetaToolsHandler struct {
 
 	callToolResult *mcp.CallToolResult
 	callToolError  error
+	// callToolFunc, when set, replaces callToolResult and callToolError
+	callToolFunc func(name string, args map[string]any) (*mcp.CallToolResult, error)
 
 	getResourceResult *mcp.ReadResourceResult
 	getResourceError  error
 
 	getPromptResult *mcp.GetPromptResult
 	getPromptError  error
+
+	origins api.CapabilityOrigins
 }
 
 func (m *mockMetaToolsHandler) ListTools(ctx context.Context) ([]mcp.Tool, error) {
@@ -33,6 +39,9 @@ func (m *mockMetaToolsHandler) ListTools(ctx context.Context) ([]mcp.Tool, error
 }
 
 func (m *mockMetaToolsHandler) CallTool(ctx context.Context, name string, args map[string]any) (*mcp.CallToolResult, error) {
+	if m.callToolFunc != nil {
+		return m.callToolFunc(name, args)
+	}
 	if m.callToolError != nil {
 		return nil, m.callToolError
 	}
@@ -65,6 +74,10 @@ func (m *mockMetaToolsHandler) ListServersRequiringAuth(ctx context.Context) []a
 	return []api.ServerAuthInfo{}
 }
 
+func (m *mockMetaToolsHandler) CapabilityOrigins(ctx context.Context) api.CapabilityOrigins {
+	return m.origins
+}
+
 // registerMockHandler registers a mock handler for testing
 func registerMockHandler(mock *mockMetaToolsHandler) func() {
 	api.RegisterMetaTools(mock)
@@ -376,6 +389,294 @@ func TestProvider_HandleCallTool(t *testing.T) {
 	})
 }
 
+func TestProvider_HandleCallTools(t *testing.T) {
+	provider := NewProvider()
+	ctx := context.Background()
+
+	var inFlight, maxInFlight atomic.Int32
+	mock := &mockMetaToolsHandler{
+		callToolFunc: func(name string, args map[string]any) (*mcp.CallToolResult, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			switch name {
+			case "broken_tool":
+				return nil, errors.New("server unavailable")
+			case "failing_tool":
+				return &mcp.CallToolResult{
+					Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "bad input"}},
+					IsError: true,
+				}, nil
+			default:
+				return &mcp.CallToolResult{
+					Content: []mcp.Content{mcp.TextContent{Type: "text", Text: name + ":" + args["x"].(string)}},
+				}, nil
+			}
+		},
+	}
+	cleanup := registerMockHandler(mock)
+	defer cleanup()
+
+	parse := func(t *testing.T, result *api.CallToolResult) CallToolsResponse {
+		t.Helper()
+		var response CallToolsResponse
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(string)), &response))
+		return response
+	}
+
+	t.Run("returns per-call results in request order", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "call_tools", map[string]any{
+			"calls": []any{
+				map[string]any{"tool": "tool_a", "args": map[string]any{"x": "1"}},
+				map[string]any{"tool": "failing_tool"},
+				map[string]any{"tool": "broken_tool"},
+				map[string]any{"tool": "tool_b", "args": map[string]any{"x": "2"}},
+			},
+		})
+		require.NoError(t, err)
+		assert.False(t, result.IsError, "a partially failed batch is not an error")
+
+		response := parse(t, result)
+		require.Len(t, response.Results, 4)
+		assert.Equal(t, 2, response.Succeeded)
+		assert.Equal(t, 2, response.Failed)
+
+		assert.Equal(t, 0, response.Results[0].Index)
+		assert.Equal(t, "tool_a", response.Results[0].Tool)
+		assert.False(t, response.Results[0].IsError)
+		assert.Equal(t, "tool_a:1", response.Results[0].Content[0].(map[string]any)["text"])
+
+		assert.True(t, response.Results[1].IsError)
+		assert.Empty(t, response.Results[1].Error)
+		assert.Equal(t, "bad input", response.Results[1].Content[0].(map[string]any)["text"])
+
+		assert.True(t, response.Results[2].IsError)
+		assert.Equal(t, "server unavailable", response.Results[2].Error)
+
+		assert.Equal(t, "tool_b:2", response.Results[3].Content[0].(map[string]any)["text"])
+	})
+
+	t.Run("is an error when every call failed", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "call_tools", map[string]any{
+			"calls": []any{
+				map[string]any{"tool": "broken_tool"},
+				map[string]any{"tool": "failing_tool"},
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Equal(t, 2, parse(t, result).Failed)
+	})
+
+	t.Run("bounds concurrency", func(t *testing.T) {
+		maxInFlight.Store(0)
+		calls := make([]any, 20)
+		for i := range calls {
+			calls[i] = map[string]any{"tool": "tool_a", "args": map[string]any{"x": "1"}}
+		}
+		result, err := provider.ExecuteTool(ctx, "call_tools", map[string]any{
+			"calls":       calls,
+			"concurrency": float64(2),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 20, parse(t, result).Succeeded)
+		assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+	})
+
+	t.Run("validates arguments", func(t *testing.T) {
+		tooMany := make([]any, maxBatchCalls+1)
+		for i := range tooMany {
+			tooMany[i] = map[string]any{"tool": "tool_a"}
+		}
+		tests := []struct {
+			name string
+			args map[string]any
+			want string
+		}{
+			{"missing calls", nil, "calls argument is required"},
+			{"empty calls", map[string]any{"calls": []any{}}, "calls argument is required"},
+			{"too many calls", map[string]any{"calls": tooMany}, "too many calls"},
+			{"missing tool", map[string]any{"calls": []any{map[string]any{}}}, "calls[0].tool is required"},
+			{"invalid args", map[string]any{"calls": []any{map[string]any{"tool": "t", "args": "x"}}}, "calls[0].args must be a JSON object"},
+			{"nested batch", map[string]any{"calls": []any{map[string]any{"tool": "call_tools"}}}, "cannot be nested"},
+			{"invalid concurrency", map[string]any{"calls": []any{map[string]any{"tool": "t"}}, "concurrency": float64(0)}, "concurrency must be between"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := provider.ExecuteTool(ctx, "call_tools", tt.args)
+				require.NoError(t, err)
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(string), tt.want)
+			})
+		}
+	})
+}
+
+type fakeToolCallStats struct{ usage []api.ToolUsageStats }
+
+func (f *fakeToolCallStats) ToolCallTotals() api.ToolCallTotals { return api.ToolCallTotals{} }
+
+func (f *fakeToolCallStats) ToolUsage() []api.ToolUsageStats { return f.usage }
+
+func TestProvider_HandleToolStats(t *testing.T) {
+	provider := NewProvider()
+	ctx := context.Background()
+
+	mock := &mockMetaToolsHandler{
+		tools: []mcp.Tool{
+			{Name: "x_kubernetes_get"},
+			{Name: "x_kubernetes_delete"},
+			{Name: "x_github_search"},
+			{Name: "core_workflow_list"},
+		},
+	}
+	cleanup := registerMockHandler(mock)
+	defer cleanup()
+
+	t.Run("error when statistics are not available", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "tool_stats", nil)
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(string), "Tool statistics not available")
+	})
+
+	api.RegisterToolCallStatsProvider(&fakeToolCallStats{usage: []api.ToolUsageStats{
+		{Tool: "x_kubernetes_get", Calls: 10, Errors: 1, ErrorRate: 0.1},
+		{Tool: "x_github_search", Calls: 2, Errors: 2, ErrorRate: 1, LastError: "rate limited"},
+	}})
+	defer api.RegisterToolCallStatsProvider(nil)
+
+	parse := func(t *testing.T, result *api.CallToolResult) api.ToolStatsReport {
+		t.Helper()
+		require.False(t, result.IsError, result.Content)
+		var report api.ToolStatsReport
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(string)), &report))
+		return report
+	}
+	toolNames := func(report api.ToolStatsReport) []string {
+		var names []string
+		for _, u := range report.Tools {
+			names = append(names, u.Tool)
+		}
+		return names
+	}
+
+	t.Run("reports usage by calls and unused tools", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "tool_stats", nil)
+		require.NoError(t, err)
+		report := parse(t, result)
+		assert.Equal(t, []string{"x_kubernetes_get", "x_github_search"}, toolNames(report))
+		assert.Equal(t, "rate limited", report.Tools[1].LastError)
+		assert.Equal(t, []string{"core_workflow_list", "x_kubernetes_delete"}, report.Unused)
+	})
+
+	t.Run("sorts by error rate", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "tool_stats", map[string]any{"sort_by": "error_rate"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"x_github_search", "x_kubernetes_get"}, toolNames(parse(t, result)))
+	})
+
+	t.Run("filters by pattern", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "tool_stats", map[string]any{"pattern": "x_kubernetes_*"})
+		require.NoError(t, err)
+		report := parse(t, result)
+		assert.Equal(t, []string{"x_kubernetes_get"}, toolNames(report))
+		assert.Equal(t, []string{"x_kubernetes_delete"}, report.Unused)
+	})
+
+	t.Run("error for invalid sort order", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "tool_stats", map[string]any{"sort_by": "popularity"})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(string), "sort_by must be one of")
+	})
+}
+
+func TestProvider_HandleExportCatalog(t *testing.T) {
+	provider := NewProvider()
+	ctx := context.Background()
+
+	mock := &mockMetaToolsHandler{
+		tools: []mcp.Tool{
+			mcp.NewTool("x_k8s_get", mcp.WithDescription("Get a resource"), mcp.WithString("name", mcp.Required())),
+			mcp.NewTool("x_k8s_apply"),
+			mcp.NewTool("x_clusters_list"),
+			mcp.NewTool("core_workflow_list"),
+		},
+		resources: []mcp.Resource{mcp.NewResource("x_k8s://pods", "Pods")},
+		prompts:   []mcp.Prompt{mcp.NewPrompt("x_k8s_debug")},
+		origins: api.CapabilityOrigins{
+			Tools: map[string][]string{
+				"x_k8s_get":       {"k8s"},
+				"x_k8s_apply":     {"k8s"},
+				"x_clusters_list": {"cluster-a", "cluster-b"},
+			},
+			Resources: map[string]string{"x_k8s://pods": "k8s"},
+			Prompts:   map[string]string{"x_k8s_debug": "k8s"},
+		},
+	}
+	cleanup := registerMockHandler(mock)
+	defer cleanup()
+
+	parse := func(t *testing.T, result *api.CallToolResult) map[string]any {
+		t.Helper()
+		require.False(t, result.IsError, result.Content)
+		var export map[string]any
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(string)), &export))
+		return export
+	}
+	serverNames := func(export map[string]any) []string {
+		var names []string
+		for _, s := range export["servers"].([]any) {
+			names = append(names, s.(map[string]any)["name"].(string))
+		}
+		return names
+	}
+
+	t.Run("groups the catalog by server", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "export_catalog", nil)
+		require.NoError(t, err)
+		export := parse(t, result)
+
+		assert.Equal(t, []string{"cluster-a", "cluster-b", "k8s", "muster"}, serverNames(export))
+		assert.Equal(t, map[string]any{"servers": 4.0, "tools": 4.0, "resources": 1.0, "prompts": 1.0}, export["totals"])
+
+		k8s := export["servers"].([]any)[2].(map[string]any)
+		tools := k8s["tools"].([]any)
+		require.Len(t, tools, 2)
+		assert.Equal(t, "x_k8s_apply", tools[0].(map[string]any)["name"])
+		get := tools[1].(map[string]any)
+		assert.Equal(t, "Get a resource", get["description"])
+		assert.Contains(t, get["inputSchema"].(map[string]any)["properties"], "name")
+		assert.Len(t, k8s["resources"], 1)
+		assert.Len(t, k8s["prompts"], 1)
+
+		muster := export["servers"].([]any)[3].(map[string]any)
+		assert.Equal(t, "core_workflow_list", muster["tools"].([]any)[0].(map[string]any)["name"])
+		assert.Empty(t, muster["resources"])
+	})
+
+	t.Run("filters by server", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "export_catalog", map[string]any{"server": "cluster-b"})
+		require.NoError(t, err)
+		export := parse(t, result)
+		assert.Equal(t, []string{"cluster-b"}, serverNames(export))
+		assert.Equal(t, 1.0, export["totals"].(map[string]any)["tools"])
+	})
+
+	t.Run("error for unknown server", func(t *testing.T) {
+		result, err := provider.ExecuteTool(ctx, "export_catalog", map[string]any{"server": "nope"})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(string), "Server not found in catalog: nope")
+	})
+}
+
 func TestProvider_HandleListResources(t *testing.T) {
 	provider := NewProvider()
 	ctx := context.Background()
@@ -1,6 +1,8 @@
 package metatools
 
 import (
+	"fmt"
+
 	"github.com/giantswarm/muster/internal/api"
 )
 
@@ -149,6 +151,73 @@ func (p *Provider) GetTools() []api.ToolMetadata {
 				},
 			},
 		},
+		{
+			Name:        "call_tools",
+			Description: "Execute several tools concurrently and return the result of each call",
+			Args: []api.ArgMetadata{
+				{
+					Name:     "calls",
+					Type:     api.ArgTypeArray,
+					Required: true,
+					Description: fmt.Sprintf("Tool calls to execute (at most %d). Each call has the tool name and "+
+						"its args (as JSON object)", maxBatchCalls),
+					Schema: map[string]interface{}{
+						"type": "array",
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"tool": map[string]interface{}{"type": "string"},
+								"args": map[string]interface{}{"type": "object"},
+							},
+							"required": []string{"tool"},
+						},
+					},
+				},
+				{
+					Name:     "concurrency",
+					Type:     api.ArgTypeNumber,
+					Required: false,
+					Description: fmt.Sprintf("Maximum number of calls executed at once (default: %d, at most %d)",
+						defaultBatchConcurrency, maxBatchConcurrency),
+					Default: defaultBatchConcurrency,
+				},
+			},
+		},
+
+		// Statistics tool
+		{
+			Name:        "tool_stats",
+			Description: "Report call counts, error rates, and latency of each tool since muster started, and the available tools that were never called",
+			Args: []api.ArgMetadata{
+				{
+					Name:        "sort_by",
+					Type:        api.ArgTypeString,
+					Required:    false,
+					Description: "Order of the tools, highest first: calls, errors, error_rate, latency, or name (default: calls)",
+					Default:     api.ToolStatsSortCalls,
+				},
+				{
+					Name:        "pattern",
+					Type:        api.ArgTypeString,
+					Required:    false,
+					Description: "Pattern to match against tool names (supports wildcards like *)",
+				},
+			},
+		},
+
+		// Catalog export tool
+		{
+			Name:        "export_catalog",
+			Description: "Export the complete catalog of tools with their input schemas, resources, and prompts, grouped by server, as a single JSON document",
+			Args: []api.ArgMetadata{
+				{
+					Name:        "server",
+					Type:        api.ArgTypeString,
+					Required:    false,
+					Description: fmt.Sprintf("Only export the catalog of this server (%q for the core tools)", CatalogCoreServer),
+				},
+			},
+		},
 
 		// Resource tools
 		{
@@ -17,8 +17,8 @@ func TestProvider_GetTools(t *testing.T) {
 	provider := NewProvider()
 	tools := provider.GetTools()
 
-	// Verify we have all 11 meta-tools
-	assert.Len(t, tools, 11, "Expected 11 meta-tools")
+	// Verify we have all 14 meta-tools
+	assert.Len(t, tools, 14, "Expected 14 meta-tools")
 
 	// Create a map for easy lookup
 	toolMap := make(map[string]bool)
@@ -33,6 +33,9 @@ func TestProvider_GetTools(t *testing.T) {
 		"list_core_tools",
 		"filter_tools",
 		"call_tool",
+		"call_tools",
+		"tool_stats",
+		"export_catalog",
 		"list_resources",
 		"describe_resource",
 		"get_resource",
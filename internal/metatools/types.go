@@ -1,5 +1,11 @@
 package metatools
 
+import (
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
 // Meta-tool name constants.
 // These are the meta-tools exposed by the aggregator that wrap actual tool access.
 const (
@@ -18,6 +24,15 @@ const (
 	// ToolCallTool executes any tool by name.
 	ToolCallTool = "call_tool"
 
+	// ToolCallTools executes a batch of tools concurrently.
+	ToolCallTools = "call_tools"
+
+	// ToolToolStats reports tool call counts, error rates, and latency.
+	ToolToolStats = "tool_stats"
+
+	// ToolExportCatalog exports the complete catalog grouped by server.
+	ToolExportCatalog = "export_catalog"
+
 	// ToolListResources lists available MCP resources.
 	ToolListResources = "list_resources"
 
@@ -71,6 +86,57 @@ type ListToolsResponse struct {
 	ServersRequiringAuth []ServerRequiringAuth `json:"servers_requiring_auth,omitempty"`
 }
 
+// CallToolsResponse is the response structure from the call_tools meta-tool.
+// Results are in the order of the requested calls.
+type CallToolsResponse struct {
+	Results   []CallToolsResult `json:"results"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+}
+
+// CallToolsResult is the outcome of one call of a call_tools batch. Content
+// and StructuredContent mirror the call_tool envelope; Error is set instead
+// when the call could not be executed at all.
+type CallToolsResult struct {
+	Index             int    `json:"index"`
+	Tool              string `json:"tool"`
+	IsError           bool   `json:"isError"`
+	Content           []any  `json:"content,omitempty"`
+	StructuredContent any    `json:"structuredContent,omitempty"`
+	Error             string `json:"error,omitempty"`
+}
+
+// CatalogCoreServer is the server group of muster's own core tools in the
+// export_catalog response.
+const CatalogCoreServer = "muster"
+
+// CatalogExport is the response structure from the export_catalog meta-tool:
+// the complete catalog of the session as a single document. A tool of a
+// server family is listed under every server of the family; Totals count it
+// once.
+type CatalogExport struct {
+	GeneratedAt time.Time       `json:"generatedAt"`
+	Servers     []CatalogServer `json:"servers"`
+	Totals      CatalogTotals   `json:"totals"`
+}
+
+// CatalogServer holds the tools, resources, and prompts of one server in an
+// export_catalog response, sorted by name and URI.
+type CatalogServer struct {
+	Name      string         `json:"name"`
+	Tools     []mcp.Tool     `json:"tools"`
+	Resources []mcp.Resource `json:"resources"`
+	Prompts   []mcp.Prompt   `json:"prompts"`
+}
+
+// CatalogTotals counts the distinct items of an export_catalog response.
+type CatalogTotals struct {
+	Servers   int `json:"servers"`
+	Tools     int `json:"tools"`
+	Resources int `json:"resources"`
+	Prompts   int `json:"prompts"`
+}
+
 // ServerRequiringAuth describes an MCP server that requires authentication.
 type ServerRequiringAuth struct {
 	Name     string `json:"name"`
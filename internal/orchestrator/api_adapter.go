@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/internal/mcpserver"
@@ -19,17 +18,14 @@ import (
 func formatOAuthAuthenticationError(name string, err error) *api.CallToolResult {
 	var authErr *mcpserver.AuthRequiredError
 	if errors.As(err, &authErr) {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf(
-				"Service '%s' requires OAuth authentication.\n\n"+
-					"To connect to this server, use the core_auth_login tool:\n"+
-					"  core_auth_login(server=\"%s\")\n\n"+
-					"The service start/restart command cannot be used for OAuth-protected servers "+
-					"because authentication is session-scoped.",
-				name, name,
-			)},
-			IsError: true,
-		}
+		return api.NewErrorResult(fmt.Sprintf(
+			"Service '%s' requires OAuth authentication.\n\n"+
+				"To connect to this server, use the core_auth_login tool:\n"+
+				"  core_auth_login(server=\"%s\")\n\n"+
+				"The service start/restart command cannot be used for OAuth-protected servers "+
+				"because authentication is session-scoped.",
+			name, name,
+		), err)
 	}
 	return nil
 }
@@ -51,6 +47,11 @@ func (a *Adapter) Register() {
 	api.RegisterServiceManager(a)
 }
 
+// CollectGarbage implements api.GarbageCollector.
+func (a *Adapter) CollectGarbage(ctx context.Context, dryRun bool) api.GCReport {
+	return a.orchestrator.CollectGarbage(ctx, dryRun)
+}
+
 // Service lifecycle management.
 func (a *Adapter) StartService(name string) error {
 	return a.orchestrator.StartService(name)
@@ -64,20 +65,16 @@ func (a *Adapter) RestartService(name string) error {
 	return a.orchestrator.RestartService(name)
 }
 
+// SubscribeToStateChanges returns a channel receiving the service state
+// changes published on the API event bus.
 func (a *Adapter) SubscribeToStateChanges() <-chan api.ServiceStateChangedEvent {
-	internalChan := a.orchestrator.SubscribeToStateChanges()
+	events, _ := api.SubscribeEventsChan(api.EventFilter{Kinds: []api.EventKind{api.EventKindServiceState}}, 100)
 	apiChan := make(chan api.ServiceStateChangedEvent, 100)
 
 	go func() {
-		for event := range internalChan {
-			apiChan <- api.ServiceStateChangedEvent{
-				Name:        event.Name,
-				ServiceType: event.ServiceType,
-				OldState:    event.OldState,
-				NewState:    event.NewState,
-				Health:      event.Health,
-				Error:       event.Error,
-				Timestamp:   time.Now(),
+		for event := range events {
+			if change, ok := event.Payload.(api.ServiceStateChangedEvent); ok {
+				apiChan <- change
 			}
 		}
 		close(apiChan)
@@ -90,7 +87,7 @@ func (a *Adapter) SubscribeToStateChanges() <-chan api.ServiceStateChangedEvent
 func (a *Adapter) GetServiceStatus(name string) (*api.ServiceStatus, error) {
 	service, exists := a.orchestrator.registry.Get(name)
 	if !exists {
-		return nil, fmt.Errorf("service %s not found", name)
+		return nil, api.NewServiceNotFoundError(name)
 	}
 
 	status := &api.ServiceStatus{
@@ -148,6 +145,7 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 		{
 			Name:        "service_list",
 			Description: "List all services with their current status",
+			Args:        api.ListArgs("Services carry the labels type, state and health."),
 		},
 		{
 			Name:        "service_start",
@@ -177,6 +175,10 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 				{Name: "name", Type: api.ArgTypeString, Required: true, Description: "Service name to get status for"},
 			},
 		},
+		{
+			Name:        "service_stats",
+			Description: "Get CPU and memory usage of MCP server processes and aggregator tool call rates",
+		},
 	}
 }
 
@@ -184,7 +186,7 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 func (a *Adapter) ExecuteTool(ctx context.Context, toolName string, args map[string]interface{}) (*api.CallToolResult, error) {
 	switch toolName {
 	case "service_list":
-		return a.handleServiceList()
+		return a.handleServiceList(args)
 	case "service_start":
 		return a.handleServiceStart(args)
 	case "service_stop":
@@ -193,17 +195,33 @@ func (a *Adapter) ExecuteTool(ctx context.Context, toolName string, args map[str
 		return a.handleServiceRestart(args)
 	case "service_status":
 		return a.handleServiceStatus(args)
+	case "service_stats":
+		return a.handleServiceStats()
 	default:
 		return nil, fmt.Errorf("unknown tool: %s", toolName)
 	}
 }
 
-func (a *Adapter) handleServiceList() (*api.CallToolResult, error) {
-	svcs := a.GetAllServices()
+func (a *Adapter) handleServiceList(args map[string]interface{}) (*api.CallToolResult, error) {
+	opts, err := api.ParseListOptions(args)
+	if err != nil {
+		return api.HandleError(err), nil
+	}
+
+	page := api.PaginateList(a.GetAllServices(), opts, func(svc api.ServiceStatus) (string, map[string]string) {
+		return svc.Name, map[string]string{
+			"type":   svc.ServiceType,
+			"state":  string(svc.State),
+			"health": string(svc.Health),
+		}
+	})
 
 	result := map[string]interface{}{
-		"services": svcs,
-		"total":    len(svcs),
+		"services": page.Items,
+		"total":    page.Total,
+	}
+	if page.NextCursor != "" {
+		result[api.ListKeyNextCursor] = page.NextCursor
 	}
 
 	return &api.CallToolResult{
@@ -215,18 +233,12 @@ func (a *Adapter) handleServiceList() (*api.CallToolResult, error) {
 func (a *Adapter) handleServiceStart(args map[string]interface{}) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok {
-		return &api.CallToolResult{
-			Content: []interface{}{"name is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("name is required")), nil
 	}
 
 	status, err := a.GetServiceStatus(name)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to start service: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to start service"), nil
 	}
 
 	if status.State == "running" {
@@ -240,10 +252,7 @@ func (a *Adapter) handleServiceStart(args map[string]interface{}) (*api.CallTool
 		if authResult := formatOAuthAuthenticationError(name, err); authResult != nil {
 			return authResult, nil
 		}
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to start service: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to start service"), nil
 	}
 
 	return &api.CallToolResult{
@@ -255,18 +264,12 @@ func (a *Adapter) handleServiceStart(args map[string]interface{}) (*api.CallTool
 func (a *Adapter) handleServiceStop(args map[string]interface{}) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok {
-		return &api.CallToolResult{
-			Content: []interface{}{"name is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("name is required")), nil
 	}
 
 	status, err := a.GetServiceStatus(name)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to stop service: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to stop service"), nil
 	}
 
 	if status.State == "stopped" {
@@ -277,10 +280,7 @@ func (a *Adapter) handleServiceStop(args map[string]interface{}) (*api.CallToolR
 	}
 
 	if err := a.StopService(name); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to stop service: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to stop service"), nil
 	}
 
 	return &api.CallToolResult{
@@ -292,20 +292,14 @@ func (a *Adapter) handleServiceStop(args map[string]interface{}) (*api.CallToolR
 func (a *Adapter) handleServiceRestart(args map[string]interface{}) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok {
-		return &api.CallToolResult{
-			Content: []interface{}{"name is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("name is required")), nil
 	}
 
 	if err := a.RestartService(name); err != nil {
 		if authResult := formatOAuthAuthenticationError(name, err); authResult != nil {
 			return authResult, nil
 		}
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to restart service: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to restart service"), nil
 	}
 
 	return &api.CallToolResult{
@@ -317,18 +311,12 @@ func (a *Adapter) handleServiceRestart(args map[string]interface{}) (*api.CallTo
 func (a *Adapter) handleServiceStatus(args map[string]interface{}) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok {
-		return &api.CallToolResult{
-			Content: []interface{}{"name is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("name is required")), nil
 	}
 
 	status, err := a.GetServiceStatus(name)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to get service status: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to get service status"), nil
 	}
 
 	return &api.CallToolResult{
@@ -336,3 +324,10 @@ func (a *Adapter) handleServiceStatus(args map[string]interface{}) (*api.CallToo
 		IsError: false,
 	}, nil
 }
+
+func (a *Adapter) handleServiceStats() (*api.CallToolResult, error) {
+	return &api.CallToolResult{
+		Content: []interface{}{a.orchestrator.RuntimeStats()},
+		IsError: false,
+	}, nil
+}
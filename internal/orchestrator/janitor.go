@@ -0,0 +1,203 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/services"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// JanitorInterval is the interval at which the janitor collects orphaned
+// runtime artifacts.
+const JanitorInterval = 10 * time.Minute
+
+// orphanGracePeriod is the minimum age of a stdio server process before the
+// janitor considers it orphaned. It covers servers that are still completing
+// the MCP handshake, before their service knows their PID.
+const orphanGracePeriod = time.Minute
+
+// markedProcess is a process started as a stdio MCP server by a muster
+// instance, as marked by mcpserver.StdioOwnerPIDEnv.
+type markedProcess struct {
+	pid     int
+	ppid    int
+	owner   int
+	command string
+	started time.Time
+}
+
+// janitor detects and cleans up orphaned runtime artifacts: MCPServer
+// services whose definition was deleted, and stdio server processes that
+// outlived the muster instance or the service that started them.
+type janitor struct {
+	registry services.ServiceRegistry
+	self     int
+
+	// Process access, replaced in tests.
+	listProcesses func() ([]markedProcess, error)
+	processAlive  func(pid int) bool
+	killProcess   func(pid int) error
+	now           func() time.Time
+}
+
+func newJanitor(registry services.ServiceRegistry, self int) *janitor {
+	return &janitor{
+		registry:      registry,
+		self:          self,
+		listProcesses: listMarkedProcesses,
+		processAlive:  processAlive,
+		killProcess:   killProcess,
+		now:           time.Now,
+	}
+}
+
+// run collects garbage every JanitorInterval until ctx is cancelled. The
+// first run is after orphanGracePeriod, so processes left behind by a
+// previous instance are cleaned up soon after startup.
+func (j *janitor) run(ctx context.Context) {
+	timer := time.NewTimer(orphanGracePeriod)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logging.Debug("Orchestrator", "Stopping janitor")
+			return
+		case <-timer.C:
+			report := j.collect(ctx, false)
+			if len(report.Orphans) > 0 {
+				logging.Info("Orchestrator", "Janitor collected %d orphaned artifacts", len(report.Orphans))
+			}
+			timer.Reset(JanitorInterval)
+		}
+	}
+}
+
+// collect detects the orphaned artifacts and, unless dryRun is set, removes
+// them.
+func (j *janitor) collect(ctx context.Context, dryRun bool) api.GCReport {
+	report := api.GCReport{CollectedAt: j.now(), DryRun: dryRun, Orphans: []api.OrphanedArtifact{}}
+	j.collectServices(ctx, dryRun, &report)
+	j.collectProcesses(dryRun, &report)
+	return report
+}
+
+// collectServices removes the MCPServer services whose definition no longer
+// exists. Deleting a definition only stops its service, so without the
+// janitor the service stays registered.
+func (j *janitor) collectServices(ctx context.Context, dryRun bool, report *api.GCReport) {
+	manager := api.GetMCPServerManager()
+	if manager == nil {
+		skip(report, api.OrphanKindService, "MCPServer manager not available")
+		return
+	}
+
+	for _, svc := range j.registry.GetByType(services.TypeMCPServer) {
+		name := svc.GetName()
+		if _, err := manager.GetMCPServer(name); !api.IsNotFound(err) {
+			if err != nil {
+				logging.Debug("Orchestrator", "Janitor cannot check MCPServer %s: %v", name, err)
+			}
+			continue
+		}
+
+		orphan := api.OrphanedArtifact{
+			Kind:   api.OrphanKindService,
+			Name:   name,
+			Reason: "MCPServer definition no longer exists",
+		}
+		if !dryRun {
+			orphan.Removed, orphan.Error = j.removeService(ctx, svc)
+		}
+		report.Orphans = append(report.Orphans, orphan)
+	}
+}
+
+// removeService stops svc unless it is stopped and unregisters it.
+func (j *janitor) removeService(ctx context.Context, svc services.Service) (bool, string) {
+	if svc.GetState() != services.StateStopped {
+		if err := svc.Stop(ctx); err != nil {
+			return false, fmt.Sprintf("failed to stop service: %v", err)
+		}
+	}
+	if err := j.registry.Unregister(svc.GetName()); err != nil {
+		return false, fmt.Sprintf("failed to unregister service: %v", err)
+	}
+	logging.Info("Orchestrator", "Janitor removed orphaned MCPServer service %s", svc.GetName())
+	return true, ""
+}
+
+// collectProcesses kills the stdio server processes whose muster instance
+// exited, and the direct children of this instance no MCPServer service
+// manages anymore. Processes of other running muster instances are left
+// alone.
+func (j *janitor) collectProcesses(dryRun bool, report *api.GCReport) {
+	procs, err := j.listProcesses()
+	if err != nil {
+		skip(report, api.OrphanKindProcess, err.Error())
+		return
+	}
+
+	managed := j.managedPIDs()
+	now := j.now()
+	for _, proc := range procs {
+		if now.Sub(proc.started) < orphanGracePeriod {
+			continue
+		}
+
+		var reason string
+		switch {
+		case proc.owner != j.self && !j.processAlive(proc.owner):
+			reason = fmt.Sprintf("muster process %d that started it exited", proc.owner)
+		case proc.owner == j.self && proc.ppid == j.self && !managed[proc.pid]:
+			reason = "no MCPServer service manages it"
+		default:
+			continue
+		}
+
+		orphan := api.OrphanedArtifact{
+			Kind:   api.OrphanKindProcess,
+			Name:   proc.command,
+			PID:    proc.pid,
+			Reason: reason,
+		}
+		if !dryRun {
+			if err := j.killProcess(proc.pid); err != nil {
+				orphan.Error = fmt.Sprintf("failed to kill process: %v", err)
+			} else {
+				orphan.Removed = true
+				logging.Info("Orchestrator", "Janitor killed orphaned stdio server process %d (%s): %s", proc.pid, proc.command, reason)
+			}
+		}
+		report.Orphans = append(report.Orphans, orphan)
+	}
+}
+
+// managedPIDs returns the PIDs of the stdio server processes of the
+// registered MCPServer services.
+func (j *janitor) managedPIDs() map[int]bool {
+	pids := make(map[int]bool)
+	for _, svc := range j.registry.GetByType(services.TypeMCPServer) {
+		provider, ok := svc.(services.ServiceDataProvider)
+		if !ok {
+			continue
+		}
+		if client, ok := provider.GetServiceData()["client"].(pidProvider); ok && client != nil {
+			if pid := client.PID(); pid != 0 {
+				pids[pid] = true
+			}
+		}
+	}
+	return pids
+}
+
+// skip records that the artifacts of kind could not be checked.
+func skip(report *api.GCReport, kind, reason string) {
+	if report.Skipped == nil {
+		report.Skipped = make(map[string]string)
+	}
+	report.Skipped[kind] = reason
+}
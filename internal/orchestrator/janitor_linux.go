@@ -0,0 +1,127 @@
+package orchestrator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/giantswarm/muster/internal/mcpserver"
+)
+
+// listMarkedProcesses scans /proc for the stdio server processes started by
+// muster instances. Processes of other users, whose environment cannot be
+// read, are skipped.
+func listMarkedProcesses() ([]markedProcess, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+	bootTime, err := readBootTime()
+	if err != nil {
+		return nil, err
+	}
+
+	marker := []byte(mcpserver.StdioOwnerPIDEnv + "=")
+	var procs []markedProcess
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		environ, err := os.ReadFile(fmt.Sprintf("/proc/%d/environ", pid))
+		if err != nil {
+			continue
+		}
+		var owner int
+		for _, env := range bytes.Split(environ, []byte{0}) {
+			if value, ok := bytes.CutPrefix(env, marker); ok {
+				owner, _ = strconv.Atoi(string(value))
+				break
+			}
+		}
+		if owner == 0 {
+			continue
+		}
+		proc, err := readProcessStat(pid, bootTime)
+		if err != nil {
+			continue
+		}
+		proc.owner = owner
+		procs = append(procs, proc)
+	}
+	return procs, nil
+}
+
+// readProcessStat reads the command, parent, and start time of pid from
+// /proc/<pid>/stat.
+func readProcessStat(pid int, bootTime time.Time) (markedProcess, error) {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return markedProcess{}, err
+	}
+	// The command name (field 2) is parenthesised and may contain spaces;
+	// the remaining fields start after the last ')'.
+	start := strings.IndexByte(string(stat), '(')
+	end := strings.LastIndexByte(string(stat), ')')
+	if start < 0 || end < start {
+		return markedProcess{}, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	fields := strings.Fields(string(stat)[end+1:])
+	// ppid and starttime are fields 4 and 22 overall, 2 and 20 after the name.
+	if len(fields) < 20 {
+		return markedProcess{}, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return markedProcess{}, fmt.Errorf("parse ppid: %w", err)
+	}
+	startTicks, err := strconv.ParseUint(fields[19], 10, 64)
+	if err != nil {
+		return markedProcess{}, fmt.Errorf("parse starttime: %w", err)
+	}
+
+	return markedProcess{
+		pid:     pid,
+		ppid:    ppid,
+		command: string(stat)[start+1 : end],
+		started: bootTime.Add(time.Duration(startTicks) * time.Second / clockTicksPerSecond),
+	}, nil
+}
+
+// readBootTime reads the boot time from the btime line of /proc/stat.
+func readBootTime() (time.Time, error) {
+	stat, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, line := range strings.Split(string(stat), "\n") {
+		if value, ok := strings.CutPrefix(line, "btime "); ok {
+			seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("parse btime: %w", err)
+			}
+			return time.Unix(seconds, 0), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no btime in /proc/stat")
+}
+
+// processAlive reports whether a process with pid exists.
+func processAlive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
+
+// killProcess terminates pid. A process that already exited counts as
+// killed.
+func killProcess(pid int) error {
+	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return err
+	}
+	return nil
+}
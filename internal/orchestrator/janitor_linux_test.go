@@ -0,0 +1,42 @@
+package orchestrator
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/mcpserver"
+)
+
+func TestListMarkedProcesses(t *testing.T) {
+	cmd := exec.Command("sleep", "30")
+	cmd.Env = append(os.Environ(), mcpserver.StdioOwnerPIDEnv+"="+strconv.Itoa(os.Getpid()))
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	procs, err := listMarkedProcesses()
+	require.NoError(t, err)
+
+	var found *markedProcess
+	for i := range procs {
+		if procs[i].pid == cmd.Process.Pid {
+			found = &procs[i]
+		}
+	}
+	require.NotNil(t, found, "the marked process is listed")
+	assert.Equal(t, os.Getpid(), found.owner)
+	assert.Equal(t, os.Getpid(), found.ppid)
+	assert.Equal(t, "sleep", found.command)
+	assert.WithinDuration(t, time.Now(), found.started, time.Minute)
+
+	assert.True(t, processAlive(cmd.Process.Pid))
+	require.NoError(t, killProcess(cmd.Process.Pid))
+}
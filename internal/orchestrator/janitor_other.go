@@ -0,0 +1,24 @@
+//go:build !linux
+
+package orchestrator
+
+import "errors"
+
+// errProcessScanUnsupported is reported for orphaned processes on platforms
+// without /proc, where the environment of other processes cannot be read.
+var errProcessScanUnsupported = errors.New("finding stdio server processes requires /proc, which is only available on Linux")
+
+// listMarkedProcesses is only supported on Linux.
+func listMarkedProcesses() ([]markedProcess, error) {
+	return nil, errProcessScanUnsupported
+}
+
+// processAlive is only supported on Linux.
+func processAlive(int) bool {
+	return true
+}
+
+// killProcess is only supported on Linux.
+func killProcess(int) error {
+	return errProcessScanUnsupported
+}
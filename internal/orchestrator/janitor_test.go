@@ -0,0 +1,104 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/services"
+)
+
+// fakeMCPServerManager knows the MCPServer definitions in servers.
+type fakeMCPServerManager struct {
+	api.MCPServerManagerHandler
+	servers map[string]bool
+}
+
+func (f *fakeMCPServerManager) GetMCPServer(name string) (*api.MCPServerInfo, error) {
+	if !f.servers[name] {
+		return nil, api.NewMCPServerNotFoundError(name)
+	}
+	return &api.MCPServerInfo{Name: name}, nil
+}
+
+func TestJanitor_CollectServices(t *testing.T) {
+	registry := services.NewRegistry()
+	require.NoError(t, registry.Register(&mockService{name: "kept", state: services.StateRunning}))
+	require.NoError(t, registry.Register(&mockService{name: "deleted", state: services.StateStopped}))
+
+	api.RegisterMCPServerManager(&fakeMCPServerManager{servers: map[string]bool{"kept": true}})
+	t.Cleanup(func() { api.RegisterMCPServerManager(nil) })
+
+	j := newJanitor(registry, 1000)
+	j.listProcesses = func() ([]markedProcess, error) { return nil, nil }
+
+	report := j.collect(context.Background(), true)
+	require.Len(t, report.Orphans, 1)
+	assert.Equal(t, api.OrphanKindService, report.Orphans[0].Kind)
+	assert.Equal(t, "deleted", report.Orphans[0].Name)
+	assert.False(t, report.Orphans[0].Removed)
+	_, exists := registry.Get("deleted")
+	assert.True(t, exists, "a dry run must not unregister the service")
+
+	report = j.collect(context.Background(), false)
+	require.Len(t, report.Orphans, 1)
+	assert.True(t, report.Orphans[0].Removed)
+	_, exists = registry.Get("deleted")
+	assert.False(t, exists)
+	_, exists = registry.Get("kept")
+	assert.True(t, exists)
+}
+
+func TestJanitor_CollectProcesses(t *testing.T) {
+	const self = 1000
+	registry := services.NewRegistry()
+	require.NoError(t, registry.Register(&mockServiceWithData{
+		mockService: mockService{name: "local", state: services.StateRunning},
+		serviceData: map[string]interface{}{"client": fakePIDClient{pid: 10}},
+	}))
+
+	now := time.Now()
+	old := now.Add(-time.Hour)
+	j := newJanitor(registry, self)
+	j.now = func() time.Time { return now }
+	j.listProcesses = func() ([]markedProcess, error) {
+		return []markedProcess{
+			{pid: 10, ppid: self, owner: self, command: "managed", started: old},
+			{pid: 11, ppid: self, owner: self, command: "leaked", started: old},
+			{pid: 12, ppid: self, owner: self, command: "starting", started: now},
+			{pid: 13, ppid: 10, owner: self, command: "grandchild", started: old},
+			{pid: 20, ppid: 1, owner: 2000, command: "crashed", started: old},
+			{pid: 30, ppid: 3000, owner: 3000, command: "other", started: old},
+		}, nil
+	}
+	j.processAlive = func(pid int) bool { return pid == 3000 }
+	var killed []int
+	j.killProcess = func(pid int) error {
+		killed = append(killed, pid)
+		return nil
+	}
+
+	report := j.collect(context.Background(), false)
+	var names []string
+	for _, orphan := range report.Orphans {
+		assert.Equal(t, api.OrphanKindProcess, orphan.Kind)
+		assert.True(t, orphan.Removed)
+		names = append(names, orphan.Name)
+	}
+	assert.Equal(t, []string{"leaked", "crashed"}, names)
+	assert.Equal(t, []int{11, 20}, killed)
+}
+
+func TestJanitor_ProcessScanUnsupported(t *testing.T) {
+	j := newJanitor(services.NewRegistry(), 1000)
+	j.listProcesses = func() ([]markedProcess, error) { return nil, assert.AnError }
+
+	report := j.collect(context.Background(), false)
+	assert.Empty(t, report.Orphans)
+	assert.Equal(t, assert.AnError.Error(), report.Skipped[api.OrphanKindProcess])
+	assert.Contains(t, report.Skipped, api.OrphanKindService, "without an MCPServer manager services are skipped")
+}
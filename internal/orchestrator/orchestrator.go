@@ -3,6 +3,7 @@ package orchestrator
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
@@ -43,9 +44,6 @@ type Orchestrator struct {
 	// Service tracking
 	stopReasons map[string]StopReason
 
-	// State change event subscribers
-	stateChangeSubscribers []chan<- ServiceStateChangedEvent
-
 	// Context for cancellation
 	ctx        context.Context
 	cancelFunc context.CancelFunc
@@ -53,6 +51,12 @@ type Orchestrator struct {
 	// WaitGroup for tracking in-flight retry goroutines
 	retryWg sync.WaitGroup
 
+	// Runtime resource usage and call rate sampling
+	stats *statsCollector
+
+	// Periodic cleanup of orphaned services and stdio server processes
+	janitor *janitor
+
 	mu sync.RWMutex
 }
 
@@ -67,11 +71,12 @@ func New(cfg Config) *Orchestrator {
 	registry := services.NewRegistry()
 
 	return &Orchestrator{
-		registry:               registry,
-		aggregator:             cfg.Aggregator,
-		yolo:                   cfg.Yolo,
-		stopReasons:            make(map[string]StopReason),
-		stateChangeSubscribers: make([]chan<- ServiceStateChangedEvent, 0),
+		registry:    registry,
+		aggregator:  cfg.Aggregator,
+		yolo:        cfg.Yolo,
+		stopReasons: make(map[string]StopReason),
+		stats:       newStatsCollector(registry),
+		janitor:     newJanitor(registry, os.Getpid()),
 	}
 }
 
@@ -99,6 +104,8 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 	}
 
 	go o.retryFailedMCPServers()
+	go o.stats.run(o.ctx)
+	go o.janitor.run(o.ctx)
 
 	logging.Info("Orchestrator", "Started orchestrator with %d static services", len(staticServices))
 	return nil
@@ -234,7 +241,7 @@ func (o *Orchestrator) createStateChangeCallback() services.StateChangeCallback
 	}
 }
 
-// publishStateChangeEvent publishes a state change event to all subscribers.
+// publishStateChangeEvent publishes a state change event on the API event bus.
 func (o *Orchestrator) publishStateChangeEvent(name string, oldState, newState services.ServiceState, health services.HealthStatus, err error) {
 	service, exists := o.registry.Get(name)
 	if !exists {
@@ -243,28 +250,15 @@ func (o *Orchestrator) publishStateChangeEvent(name string, oldState, newState s
 
 	logging.Debug("Orchestrator", "Service %s state changed: %s -> %s (health: %s)", name, oldState, newState, health)
 
-	event := ServiceStateChangedEvent{
+	api.PublishServiceStateEvent(api.ServiceStateChangedEvent{
 		Name:        name,
 		ServiceType: string(service.GetType()),
 		OldState:    string(oldState),
 		NewState:    string(newState),
 		Health:      string(health),
 		Error:       err,
-		Timestamp:   time.Now().Unix(),
-	}
-
-	o.mu.RLock()
-	subscribers := make([]chan<- ServiceStateChangedEvent, len(o.stateChangeSubscribers))
-	copy(subscribers, o.stateChangeSubscribers)
-	o.mu.RUnlock()
-
-	for _, subscriber := range subscribers {
-		select {
-		case subscriber <- event:
-		default:
-			logging.Debug("Orchestrator", "Subscriber blocked, skipping event for service %s", name)
-		}
-	}
+		Timestamp:   time.Now(),
+	})
 }
 
 // Stop gracefully stops all services.
@@ -275,6 +269,18 @@ func (o *Orchestrator) Stop() error {
 	return nil
 }
 
+// RuntimeStats returns the latest sample of MCP server resource usage and
+// aggregator call rates.
+func (o *Orchestrator) RuntimeStats() api.RuntimeStats {
+	return o.stats.snapshot()
+}
+
+// CollectGarbage detects the orphaned MCPServer services and stdio server
+// processes and, unless dryRun is set, removes them.
+func (o *Orchestrator) CollectGarbage(ctx context.Context, dryRun bool) api.GCReport {
+	return o.janitor.collect(ctx, dryRun)
+}
+
 // retryFailedMCPServers runs a periodic background task that attempts to reconnect
 // MCPServers that have failed due to transient connectivity issues.
 // It respects the exponential backoff calculated by the service.
@@ -464,26 +470,6 @@ func (o *Orchestrator) GetServiceRegistry() services.ServiceRegistry {
 	return o.registry
 }
 
-// SubscribeToStateChanges returns a channel for state change events.
-func (o *Orchestrator) SubscribeToStateChanges() <-chan ServiceStateChangedEvent {
-	eventChan := make(chan ServiceStateChangedEvent, 100)
-	o.mu.Lock()
-	o.stateChangeSubscribers = append(o.stateChangeSubscribers, eventChan)
-	o.mu.Unlock()
-	return eventChan
-}
-
-// ServiceStateChangedEvent represents a service state change event.
-type ServiceStateChangedEvent struct {
-	Name        string
-	ServiceType string
-	OldState    string
-	NewState    string
-	Health      string
-	Error       error
-	Timestamp   int64
-}
-
 // GetServiceStatus returns the status of a specific service.
 func (o *Orchestrator) GetServiceStatus(name string) (*ServiceStatus, error) {
 	service, exists := o.registry.Get(name)
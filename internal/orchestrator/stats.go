@@ -0,0 +1,184 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/services"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+// StatsInterval is the interval at which the runtime stats collector samples
+// MCP server processes and aggregator call counters. CPU usage and call rates
+// are averaged over this window.
+const StatsInterval = 5 * time.Second
+
+// maxTopTools caps the per-tool breakdown in AggregatorCallStats.
+const maxTopTools = 10
+
+// processSample is a cumulative CPU time and current RSS reading for a process.
+type processSample struct {
+	cpuTime time.Duration
+	rss     uint64
+	at      time.Time
+}
+
+// processSampler reads the current resource usage of a process.
+type processSampler func(pid int) (processSample, error)
+
+// pidProvider is implemented by MCP clients that manage a local subprocess.
+type pidProvider interface {
+	PID() int
+}
+
+// statsCollector periodically samples the resource usage of managed MCP
+// server processes and the aggregator's tool call counters. It keeps only the
+// previous sample, so its cost is a few file reads per stdio server per tick.
+type statsCollector struct {
+	registry services.ServiceRegistry
+	sample   processSampler
+
+	mu        sync.RWMutex
+	prevProcs map[int]processSample
+	prevCalls *api.ToolCallTotals
+	prevAt    time.Time
+	latest    api.RuntimeStats
+}
+
+func newStatsCollector(registry services.ServiceRegistry) *statsCollector {
+	return &statsCollector{
+		registry:  registry,
+		sample:    sampleProcess,
+		prevProcs: make(map[int]processSample),
+	}
+}
+
+// run samples every StatsInterval until ctx is cancelled.
+func (c *statsCollector) run(ctx context.Context) {
+	ticker := time.NewTicker(StatsInterval)
+	defer ticker.Stop()
+
+	c.collect(time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			logging.Debug("Orchestrator", "Stopping runtime stats collector")
+			return
+		case now := <-ticker.C:
+			c.collect(now)
+		}
+	}
+}
+
+// snapshot returns the most recent stats.
+func (c *statsCollector) snapshot() api.RuntimeStats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.latest
+}
+
+// collect takes one sample and derives usage from the difference to the
+// previous one.
+func (c *statsCollector) collect(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := api.RuntimeStats{CollectedAt: now, Servers: []api.ProcessStats{}}
+	if !c.prevAt.IsZero() {
+		stats.IntervalSeconds = now.Sub(c.prevAt).Seconds()
+	}
+
+	procs := make(map[int]processSample)
+	for _, svc := range c.registry.GetByType(services.TypeMCPServer) {
+		ps := c.serverStats(svc, procs)
+		stats.Servers = append(stats.Servers, ps)
+	}
+	sort.Slice(stats.Servers, func(i, j int) bool { return stats.Servers[i].Name < stats.Servers[j].Name })
+
+	if provider := api.GetToolCallStatsProvider(); provider != nil {
+		totals := provider.ToolCallTotals()
+		stats.Aggregator = callRates(totals, c.prevCalls, stats.IntervalSeconds)
+		c.prevCalls = &totals
+	}
+
+	c.prevProcs = procs
+	c.prevAt = now
+	c.latest = stats
+}
+
+// serverStats builds the ProcessStats for one MCP server service, recording
+// any new process sample in procs.
+func (c *statsCollector) serverStats(svc services.Service, procs map[int]processSample) api.ProcessStats {
+	ps := api.ProcessStats{Name: svc.GetName(), State: string(svc.GetState())}
+
+	var data map[string]interface{}
+	if provider, ok := svc.(services.ServiceDataProvider); ok {
+		data = provider.GetServiceData()
+	}
+	if t, ok := data["type"]; ok {
+		ps.Type = fmt.Sprint(t)
+	}
+
+	if ps.Type != "" && ps.Type != string(api.MCPServerTypeStdio) {
+		ps.Unavailable = "remote server, process not managed by muster"
+		return ps
+	}
+	client, _ := data["client"].(pidProvider)
+	if client == nil || client.PID() == 0 {
+		ps.Unavailable = "not running"
+		return ps
+	}
+
+	ps.PID = client.PID()
+	cur, err := c.sample(ps.PID)
+	if err != nil {
+		ps.Unavailable = fmt.Sprintf("cannot read process stats: %v", err)
+		return ps
+	}
+	procs[ps.PID] = cur
+	ps.MemoryBytes = cur.rss
+	if prev, ok := c.prevProcs[ps.PID]; ok {
+		if wall := cur.at.Sub(prev.at); wall > 0 && cur.cpuTime >= prev.cpuTime {
+			ps.CPUPercent = float64(cur.cpuTime-prev.cpuTime) / float64(wall) * 100
+		}
+	}
+	return ps
+}
+
+// callRates derives per-second rates from two cumulative counter snapshots.
+// With no previous snapshot, or after the counters were reset by an
+// aggregator restart, only the totals are reported.
+func callRates(cur api.ToolCallTotals, prev *api.ToolCallTotals, seconds float64) *api.AggregatorCallStats {
+	stats := &api.AggregatorCallStats{TotalCalls: cur.Calls, TotalErrors: cur.Errors}
+	if prev == nil || seconds <= 0 || cur.Calls < prev.Calls || cur.Errors < prev.Errors {
+		return stats
+	}
+
+	stats.CallsPerSec = float64(cur.Calls-prev.Calls) / seconds
+	stats.ErrorsPerSec = float64(cur.Errors-prev.Errors) / seconds
+	for tool, total := range cur.PerTool {
+		before := prev.PerTool[tool]
+		if total <= before {
+			continue
+		}
+		stats.TopTools = append(stats.TopTools, api.ToolCallRate{
+			Tool:        tool,
+			CallsPerSec: float64(total-before) / seconds,
+			TotalCalls:  total,
+		})
+	}
+	sort.Slice(stats.TopTools, func(i, j int) bool {
+		if stats.TopTools[i].CallsPerSec != stats.TopTools[j].CallsPerSec {
+			return stats.TopTools[i].CallsPerSec > stats.TopTools[j].CallsPerSec
+		}
+		return stats.TopTools[i].Tool < stats.TopTools[j].Tool
+	})
+	if len(stats.TopTools) > maxTopTools {
+		stats.TopTools = stats.TopTools[:maxTopTools]
+	}
+	return stats
+}
@@ -0,0 +1,62 @@
+package orchestrator
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockTicksPerSecond is USER_HZ, which is 100 on all mainstream Linux
+// architectures; /proc reports CPU times in these units.
+const clockTicksPerSecond = 100
+
+// sampleProcess reads CPU time from /proc/<pid>/stat and resident memory
+// from /proc/<pid>/statm.
+func sampleProcess(pid int) (processSample, error) {
+	now := time.Now()
+
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return processSample{}, err
+	}
+	// The command name (field 2) is parenthesised and may contain spaces;
+	// the remaining fields start after the last ')'.
+	end := strings.LastIndexByte(string(stat), ')')
+	if end < 0 {
+		return processSample{}, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	fields := strings.Fields(string(stat)[end+1:])
+	// utime and stime are fields 14 and 15 overall, 12 and 13 after the name.
+	if len(fields) < 13 {
+		return processSample{}, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	utime, err := strconv.ParseUint(fields[11], 10, 64)
+	if err != nil {
+		return processSample{}, fmt.Errorf("parse utime: %w", err)
+	}
+	stime, err := strconv.ParseUint(fields[12], 10, 64)
+	if err != nil {
+		return processSample{}, fmt.Errorf("parse stime: %w", err)
+	}
+
+	statm, err := os.ReadFile(fmt.Sprintf("/proc/%d/statm", pid))
+	if err != nil {
+		return processSample{}, err
+	}
+	memFields := strings.Fields(string(statm))
+	if len(memFields) < 2 {
+		return processSample{}, fmt.Errorf("malformed /proc/%d/statm", pid)
+	}
+	residentPages, err := strconv.ParseUint(memFields[1], 10, 64)
+	if err != nil {
+		return processSample{}, fmt.Errorf("parse resident pages: %w", err)
+	}
+
+	return processSample{
+		cpuTime: time.Duration(utime+stime) * time.Second / clockTicksPerSecond,
+		rss:     residentPages * uint64(os.Getpagesize()),
+		at:      now,
+	}, nil
+}
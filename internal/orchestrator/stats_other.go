@@ -0,0 +1,64 @@
+//go:build !linux
+
+package orchestrator
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sampleProcess reads CPU time and resident memory via ps(1), which is
+// available on macOS and the BSDs. On platforms without ps (Windows) the
+// error is surfaced as unavailable stats.
+func sampleProcess(pid int) (processSample, error) {
+	now := time.Now()
+	out, err := exec.Command("ps", "-o", "time=,rss=", "-p", strconv.Itoa(pid)).Output() //nolint:gosec
+	if err != nil {
+		return processSample{}, fmt.Errorf("ps: %w", err)
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) < 2 {
+		return processSample{}, fmt.Errorf("unexpected ps output %q", strings.TrimSpace(string(out)))
+	}
+	cpu, err := parsePSTime(fields[0])
+	if err != nil {
+		return processSample{}, err
+	}
+	rssKiB, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return processSample{}, fmt.Errorf("parse rss: %w", err)
+	}
+	return processSample{cpuTime: cpu, rss: rssKiB * 1024, at: now}, nil
+}
+
+// parsePSTime parses the ps TIME column, "[[dd-]hh:]mm:ss[.cc]".
+func parsePSTime(s string) (time.Duration, error) {
+	var total time.Duration
+	if days, rest, ok := strings.Cut(s, "-"); ok {
+		d, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("parse cpu time %q: %w", s, err)
+		}
+		total += time.Duration(d) * 24 * time.Hour
+		s = rest
+	}
+	parts := strings.Split(s, ":")
+	secs, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse cpu time %q: %w", s, err)
+	}
+	total += time.Duration(secs * float64(time.Second))
+	unit := time.Minute
+	for i := len(parts) - 2; i >= 0; i-- {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return 0, fmt.Errorf("parse cpu time %q: %w", s, err)
+		}
+		total += time.Duration(n) * unit
+		unit *= 60
+	}
+	return total, nil
+}
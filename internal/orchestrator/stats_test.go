@@ -0,0 +1,100 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/services"
+)
+
+type fakePIDClient struct{ pid int }
+
+func (f fakePIDClient) PID() int { return f.pid }
+
+type fakeCallStats struct{ totals api.ToolCallTotals }
+
+func (f *fakeCallStats) ToolCallTotals() api.ToolCallTotals { return f.totals }
+
+func (f *fakeCallStats) ToolUsage() []api.ToolUsageStats { return nil }
+
+func TestStatsCollector_Collect(t *testing.T) {
+	registry := services.NewRegistry()
+	require.NoError(t, registry.Register(&mockServiceWithData{
+		mockService: mockService{name: "local", state: services.StateRunning},
+		serviceData: map[string]interface{}{
+			"type":   api.MCPServerTypeStdio,
+			"client": fakePIDClient{pid: 42},
+		},
+	}))
+	require.NoError(t, registry.Register(&mockServiceWithData{
+		mockService: mockService{name: "remote", state: services.StateRunning},
+		serviceData: map[string]interface{}{"type": api.MCPServerTypeStreamableHTTP},
+	}))
+	require.NoError(t, registry.Register(&mockServiceWithData{
+		mockService: mockService{name: "stopped", state: services.StateStopped},
+		serviceData: map[string]interface{}{"type": api.MCPServerTypeStdio},
+	}))
+
+	calls := &fakeCallStats{totals: api.ToolCallTotals{Calls: 10, PerTool: map[string]uint64{"x_a": 10}}}
+	api.RegisterToolCallStatsProvider(calls)
+	t.Cleanup(func() { api.RegisterToolCallStatsProvider(nil) })
+
+	start := time.Now()
+	cpu := time.Second
+	c := newStatsCollector(registry)
+	c.sample = func(pid int) (processSample, error) {
+		assert.Equal(t, 42, pid)
+		return processSample{cpuTime: cpu, rss: 2048, at: start.Add(cpu)}, nil
+	}
+
+	c.collect(start)
+	first := c.snapshot()
+	require.Len(t, first.Servers, 3)
+	assert.Zero(t, first.IntervalSeconds)
+	assert.Zero(t, first.Servers[0].CPUPercent)
+	require.NotNil(t, first.Aggregator)
+	assert.Equal(t, uint64(10), first.Aggregator.TotalCalls)
+	assert.Zero(t, first.Aggregator.CallsPerSec)
+
+	// One more second of wall time with half a second of CPU is 50%.
+	cpu = 1500 * time.Millisecond
+	c.sample = func(pid int) (processSample, error) {
+		return processSample{cpuTime: cpu, rss: 4096, at: start.Add(2 * time.Second)}, nil
+	}
+	calls.totals = api.ToolCallTotals{Calls: 20, Errors: 2, PerTool: map[string]uint64{"x_a": 15, "x_b": 5}}
+	c.collect(start.Add(5 * time.Second))
+
+	stats := c.snapshot()
+	assert.Equal(t, 5.0, stats.IntervalSeconds)
+
+	local := stats.Servers[0]
+	assert.Equal(t, "local", local.Name)
+	assert.Equal(t, 42, local.PID)
+	assert.InDelta(t, 50.0, local.CPUPercent, 0.001)
+	assert.Equal(t, uint64(4096), local.MemoryBytes)
+	assert.Empty(t, local.Unavailable)
+
+	assert.Equal(t, "remote", stats.Servers[1].Name)
+	assert.NotEmpty(t, stats.Servers[1].Unavailable)
+	assert.Equal(t, "stopped", stats.Servers[2].Name)
+	assert.Equal(t, "not running", stats.Servers[2].Unavailable)
+
+	agg := stats.Aggregator
+	assert.InDelta(t, 2.0, agg.CallsPerSec, 0.001)
+	assert.InDelta(t, 0.4, agg.ErrorsPerSec, 0.001)
+	require.Len(t, agg.TopTools, 2)
+	assert.Equal(t, "x_a", agg.TopTools[0].Tool)
+	assert.Equal(t, "x_b", agg.TopTools[1].Tool)
+}
+
+func TestCallRates_CounterReset(t *testing.T) {
+	prev := &api.ToolCallTotals{Calls: 100}
+	stats := callRates(api.ToolCallTotals{Calls: 3}, prev, 5)
+	assert.Equal(t, uint64(3), stats.TotalCalls)
+	assert.Zero(t, stats.CallsPerSec)
+	assert.Empty(t, stats.TopTools)
+}
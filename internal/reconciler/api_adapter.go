@@ -1,6 +1,8 @@
 package reconciler
 
 import (
+	"fmt"
+
 	"github.com/giantswarm/muster/internal/api"
 )
 
@@ -70,6 +72,16 @@ func (a *Adapter) IsRunning() bool {
 	return a.manager.IsRunning()
 }
 
+// CheckHealth reports the reconciliation manager as unhealthy while it is not
+// running, since changes to MCPServers and Workflows are then not applied.
+// Implements api.HealthCheckedHandler interface.
+func (a *Adapter) CheckHealth() error {
+	if !a.manager.IsRunning() {
+		return fmt.Errorf("reconcile manager is not running")
+	}
+	return nil
+}
+
 // GetQueueLength returns the current reconciliation queue length.
 // Implements api.ReconcileManagerHandler interface.
 func (a *Adapter) GetQueueLength() int {
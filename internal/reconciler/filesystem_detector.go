@@ -10,6 +10,7 @@ import (
 
 	"github.com/fsnotify/fsnotify"
 
+	"github.com/giantswarm/muster/internal/config"
 	"github.com/giantswarm/muster/pkg/logging"
 )
 
@@ -29,6 +30,10 @@ type FilesystemDetector struct {
 	// basePath is the root directory for configuration files
 	basePath string
 
+	// profile is the profile whose directories below basePath are watched
+	// too, as their files overlay the resources
+	profile string
+
 	// watcher is the fsnotify watcher instance
 	watcher *fsnotify.Watcher
 
@@ -145,8 +150,18 @@ func (d *FilesystemDetector) addWatchForType(resourceType ResourceType) error {
 	if err := d.watcher.Add(watchPath); err != nil {
 		return err
 	}
-
 	logging.Debug("FilesystemDetector", "Watching directory: %s", watchPath)
+
+	// Profile directories are optional, so they are only watched if present
+	if d.profile != "" {
+		profilePath := filepath.Join(config.ProfilePath(d.basePath, d.profile), dirName)
+		if info, err := os.Stat(profilePath); err == nil && info.IsDir() {
+			if err := d.watcher.Add(profilePath); err != nil {
+				return err
+			}
+			logging.Debug("FilesystemDetector", "Watching directory: %s", profilePath)
+		}
+	}
 	return nil
 }
 
@@ -310,6 +325,10 @@ func (d *FilesystemDetector) parseFilePath(path string) (ResourceType, string) {
 
 	// Split into components
 	parts := strings.Split(relPath, string(filepath.Separator))
+	if d.profile != "" && len(parts) > 2 && parts[0] == config.ProfilesDir && parts[1] == d.profile {
+		// A profile file changes the resource it overlays
+		parts = parts[2:]
+	}
 	if len(parts) < 2 {
 		return "", ""
 	}
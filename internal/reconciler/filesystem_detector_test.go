@@ -10,6 +10,7 @@ import (
 
 func TestFilesystemDetector_ParseFilePath(t *testing.T) {
 	detector := NewFilesystemDetector("/tmp/muster", 100*time.Millisecond)
+	detector.profile = "prod"
 
 	tests := []struct {
 		name          string
@@ -36,6 +37,17 @@ func TestFilesystemDetector_ParseFilePath(t *testing.T) {
 			expectedType: ResourceTypeMCPServer,
 			expectedName: "test",
 		},
+		{
+			name:         "Profile overlay",
+			path:         "/tmp/muster/profiles/prod/workflows/deploy-app.yaml",
+			expectedType: ResourceTypeWorkflow,
+			expectedName: "deploy-app",
+		},
+		{
+			name:          "Other profile",
+			path:          "/tmp/muster/profiles/dev/workflows/deploy-app.yaml",
+			shouldBeEmpty: true,
+		},
 		{
 			name:          "Unknown directory",
 			path:          "/tmp/muster/unknown/test.yaml",
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/pkg/logging"
 )
 
@@ -167,7 +168,9 @@ func (m *Manager) setupChangeDetector() error {
 		if m.config.FilesystemPath == "" {
 			return fmt.Errorf("filesystem path required for filesystem mode")
 		}
-		m.changeDetector = NewFilesystemDetector(m.config.FilesystemPath, m.config.DebounceInterval)
+		detector := NewFilesystemDetector(m.config.FilesystemPath, m.config.DebounceInterval)
+		detector.profile = m.config.Profile
+		m.changeDetector = detector
 
 	case WatchModeKubernetes:
 		restConfig, err := GetRestConfig()
@@ -403,6 +406,15 @@ func (m *Manager) updateStatus(resourceType ResourceType, name, namespace string
 	case StateError:
 		status.RetryCount++
 	}
+
+	api.PublishReconcileEvent(api.ReconcileEvent{
+		ResourceType: string(resourceType),
+		Name:         name,
+		Namespace:    namespace,
+		State:        string(state),
+		RetryCount:   status.RetryCount,
+		Error:        errMsg,
+	})
 }
 
 // statusKey generates a unique key for status tracking.
@@ -188,6 +188,8 @@ func (r *MCPServerReconciler) applyStatusFromService(server *musterv1alpha1.MCPS
 		// Set State based on infrastructure state and server type
 		// State terminology differs based on server type (stdio vs remote)
 		server.Status.State = r.determineState(state, server.Spec.Type)
+		server.Status.Health = determineHealth(service.GetHealth())
+		server.Status.Auth = determineAuth(server.Spec.Auth, server.Status.State)
 
 		if service.GetLastError() != nil {
 			// Sanitize error message to remove sensitive data before CRD exposure
@@ -211,6 +213,8 @@ func (r *MCPServerReconciler) applyStatusFromService(server *musterv1alpha1.MCPS
 		} else {
 			server.Status.State = musterv1alpha1.MCPServerStateStopped
 		}
+		server.Status.Health = musterv1alpha1.MCPServerHealthUnknown
+		server.Status.Auth = determineAuth(server.Spec.Auth, server.Status.State)
 		if reconcileErr != nil {
 			// Sanitize error message to remove sensitive data before CRD exposure
 			server.Status.LastError = SanitizeErrorMessage(reconcileErr.Error())
@@ -293,6 +297,40 @@ func (r *MCPServerReconciler) determineState(state api.ServiceState, serverType
 	}
 }
 
+// determineHealth converts a service's health to the MCPServer Health. A health
+// check in progress reports the health as Unknown.
+func determineHealth(health api.HealthStatus) musterv1alpha1.MCPServerHealthValue {
+	switch health {
+	case api.HealthHealthy:
+		return musterv1alpha1.MCPServerHealthHealthy
+	case api.HealthDegraded:
+		return musterv1alpha1.MCPServerHealthDegraded
+	case api.HealthUnhealthy:
+		return musterv1alpha1.MCPServerHealthUnhealthy
+	default:
+		return musterv1alpha1.MCPServerHealthUnknown
+	}
+}
+
+// determineAuth returns how muster authenticates to an MCPServer with the given
+// auth configuration and state. Token exchange and token forwarding take
+// precedence over OAuth, as they replace the per-user OAuth flow. A server
+// without auth configuration that answers with 401 Unauthorized uses OAuth.
+func determineAuth(auth *musterv1alpha1.MCPServerAuth, state musterv1alpha1.MCPServerStateValue) musterv1alpha1.MCPServerAuthValue {
+	switch {
+	case auth != nil && auth.TokenExchange != nil && auth.TokenExchange.Enabled:
+		return musterv1alpha1.MCPServerAuthTokenExchange
+	case auth != nil && auth.ForwardToken:
+		return musterv1alpha1.MCPServerAuthForwardToken
+	case auth != nil && auth.Type == "oauth":
+		return musterv1alpha1.MCPServerAuthOAuth
+	case state == musterv1alpha1.MCPServerStateAuthRequired:
+		return musterv1alpha1.MCPServerAuthOAuth
+	default:
+		return musterv1alpha1.MCPServerAuthNone
+	}
+}
+
 // reconcileCreate handles creating a new MCPServer service.
 func (r *MCPServerReconciler) reconcileCreate(ctx context.Context, req ReconcileRequest, info *api.MCPServerInfo) ReconcileResult {
 	logging.Info("MCPServerReconciler", "Creating MCPServer service: %s", req.Name)
@@ -578,6 +578,12 @@ func TestMCPServerReconciler_SyncStatus_RunningService(t *testing.T) {
 	if statusUpdater.LastUpdatedMCPServer.Status.LastConnected == nil {
 		t.Error("expected LastConnected to be set for running service")
 	}
+	if statusUpdater.LastUpdatedMCPServer.Status.Health != musterv1alpha1.MCPServerHealthHealthy {
+		t.Errorf("expected health 'Healthy', got '%s'", statusUpdater.LastUpdatedMCPServer.Status.Health)
+	}
+	if statusUpdater.LastUpdatedMCPServer.Status.Auth != musterv1alpha1.MCPServerAuthNone {
+		t.Errorf("expected auth 'None', got '%s'", statusUpdater.LastUpdatedMCPServer.Status.Auth)
+	}
 }
 
 func TestMCPServerReconciler_SyncStatus_ServiceNotFound(t *testing.T) {
@@ -664,6 +670,44 @@ func TestMCPServerReconciler_SyncStatus_WithError(t *testing.T) {
 	if statusUpdater.LastUpdatedMCPServer.Status.LastError == "" {
 		t.Error("expected LastError to be set")
 	}
+	if statusUpdater.LastUpdatedMCPServer.Status.Health != musterv1alpha1.MCPServerHealthUnhealthy {
+		t.Errorf("expected health 'Unhealthy', got '%s'", statusUpdater.LastUpdatedMCPServer.Status.Health)
+	}
+}
+
+func TestDetermineAuth(t *testing.T) {
+	tests := []struct {
+		name  string
+		auth  *musterv1alpha1.MCPServerAuth
+		state musterv1alpha1.MCPServerStateValue
+		want  musterv1alpha1.MCPServerAuthValue
+	}{
+		{"no auth", nil, musterv1alpha1.MCPServerStateConnected, musterv1alpha1.MCPServerAuthNone},
+		{"no auth but 401", nil, musterv1alpha1.MCPServerStateAuthRequired, musterv1alpha1.MCPServerAuthOAuth},
+		{"type none", &musterv1alpha1.MCPServerAuth{Type: "none"}, musterv1alpha1.MCPServerStateConnected, musterv1alpha1.MCPServerAuthNone},
+		{"type oauth", &musterv1alpha1.MCPServerAuth{Type: "oauth"}, musterv1alpha1.MCPServerStateConnected, musterv1alpha1.MCPServerAuthOAuth},
+		{"forward token", &musterv1alpha1.MCPServerAuth{Type: "oauth", ForwardToken: true}, musterv1alpha1.MCPServerStateAuthRequired, musterv1alpha1.MCPServerAuthForwardToken},
+		{
+			"token exchange",
+			&musterv1alpha1.MCPServerAuth{Type: "oauth", TokenExchange: &musterv1alpha1.TokenExchangeConfig{Enabled: true}},
+			musterv1alpha1.MCPServerStateConnected,
+			musterv1alpha1.MCPServerAuthTokenExchange,
+		},
+		{
+			"disabled token exchange",
+			&musterv1alpha1.MCPServerAuth{Type: "oauth", TokenExchange: &musterv1alpha1.TokenExchangeConfig{}},
+			musterv1alpha1.MCPServerStateConnected,
+			musterv1alpha1.MCPServerAuthOAuth,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := determineAuth(tt.auth, tt.state); got != tt.want {
+				t.Errorf("determineAuth() = %q, want %q", got, tt.want)
+			}
+		})
+	}
 }
 
 func TestMCPServerReconciler_SyncStatus_NoUpdaterConfigured(t *testing.T) {
@@ -199,6 +199,11 @@ type ManagerConfig struct {
 	// Only used when Mode is WatchModeFilesystem.
 	FilesystemPath string
 
+	// Profile is the profile whose files in FilesystemPath overlay the
+	// resources. Its directories are watched too.
+	// Only used when Mode is WatchModeFilesystem.
+	Profile string
+
 	// Namespace is the Kubernetes namespace to watch.
 	// Only used when Mode is WatchModeKubernetes.
 	Namespace string
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
 	"k8s.io/client-go/util/retry"
 
@@ -267,13 +268,18 @@ func (r *WorkflowReconciler) validateWorkflow(wf *api.Workflow) error {
 
 		// A step is a container when it carries a forEach loop or a parallel
 		// group. Container steps legitimately have no top-level tool; their
-		// sub-steps carry the tools. A leaf step must specify exactly one tool.
+		// sub-steps carry the tools. A leaf step must specify exactly one tool
+		// or prompt.
 		composite := step.ForEach != nil || len(step.Parallel) > 0
 		switch {
-		case step.Tool == "" && !composite:
-			return fmt.Errorf("step '%s': one of tool, forEach, or parallel is required", step.ID)
+		case step.Tool == "" && step.Prompt == "" && !composite:
+			return fmt.Errorf("step '%s': one of tool, prompt, forEach, or parallel is required", step.ID)
+		case step.Tool != "" && step.Prompt != "":
+			return fmt.Errorf("step '%s': tool and prompt are mutually exclusive", step.ID)
 		case step.Tool != "" && composite:
 			return fmt.Errorf("step '%s': tool is mutually exclusive with forEach/parallel", step.ID)
+		case step.Prompt != "" && composite:
+			return fmt.Errorf("step '%s': prompt is mutually exclusive with forEach/parallel", step.ID)
 		case step.ForEach != nil && len(step.Parallel) > 0:
 			return fmt.Errorf("step '%s': forEach and parallel are mutually exclusive", step.ID)
 		}
@@ -300,5 +306,16 @@ func (r *WorkflowReconciler) validateWorkflow(wf *api.Workflow) error {
 		}
 	}
 
+	if c := wf.Concurrency; c != nil {
+		if c.MaxConcurrent < 0 {
+			return fmt.Errorf("concurrency.maxConcurrent must not be negative")
+		}
+		for i, mutex := range c.Mutexes {
+			if strings.TrimSpace(mutex) == "" {
+				return fmt.Errorf("concurrency.mutexes[%d] cannot be empty", i)
+			}
+		}
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/giantswarm/muster/internal/aggregator"
 	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/config"
 
 	"github.com/mark3labs/mcp-go/mcp"
 )
@@ -187,6 +188,26 @@ func (a *APIAdapter) UpdateCapabilities() {
 	server.UpdateCapabilities()
 }
 
+// NotifySessions sends a logging notification to every connected MCP session.
+// Implements api.SessionNotifier.
+func (a *APIAdapter) NotifySessions(level, logger string, data any) {
+	if a.service == nil {
+		return
+	}
+
+	manager := a.service.GetManager()
+	if manager == nil {
+		return
+	}
+
+	server := manager.GetAggregatorServer()
+	if server == nil {
+		return
+	}
+
+	server.NotifySessions(level, logger, data)
+}
+
 // RegisterServerPendingAuth registers a server that requires OAuth authentication.
 func (a *APIAdapter) RegisterServerPendingAuth(registration api.PendingAuthRegistration) error {
 	if a.service == nil {
@@ -219,6 +240,14 @@ func (a *APIAdapter) RegisterServerPendingAuth(registration api.PendingAuthRegis
 	})
 }
 
+// ApplyConfig applies a reloaded aggregator configuration to the running aggregator
+func (a *APIAdapter) ApplyConfig(ctx context.Context, cfg config.AggregatorConfig) (*api.AggregatorConfigChanges, error) {
+	if a.service == nil {
+		return nil, fmt.Errorf("aggregator service not available")
+	}
+	return a.service.ReloadConfig(ctx, cfg)
+}
+
 // Register registers this adapter with the API package
 func (a *APIAdapter) Register() {
 	api.RegisterAggregator(a)
@@ -3,11 +3,14 @@ package aggregator
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/giantswarm/muster/internal/aggregator"
 	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/config"
 	"github.com/giantswarm/muster/internal/services"
 	"github.com/giantswarm/muster/pkg/logging"
 )
@@ -22,6 +25,13 @@ type AggregatorService struct {
 	orchestratorAPI api.OrchestratorAPI
 	serviceRegistry api.ServiceRegistryHandler
 	manager         *aggregator.AggregatorManager
+
+	// buildConfig turns the aggregator section of a reloaded config.yaml into
+	// the aggregator configuration, applying command line overrides
+	buildConfig func(config.AggregatorConfig) aggregator.AggregatorConfig
+
+	// onHandover is handed to each manager, see SetHandoverCallback
+	onHandover func()
 }
 
 // NewAggregatorService creates a new aggregator service
@@ -57,6 +67,9 @@ func (s *AggregatorService) Start(ctx context.Context) error {
 
 	// Create the manager with APIs
 	s.manager = aggregator.NewAggregatorManager(s.config, s.orchestratorAPI, s.serviceRegistry, s.onManagerErrorCallback)
+	if s.onHandover != nil {
+		s.manager.SetHandoverCallback(s.onHandover)
+	}
 
 	// Start the manager
 	if err := s.manager.Start(ctx); err != nil {
@@ -122,6 +135,111 @@ func (s *AggregatorService) Restart(ctx context.Context) error {
 	return s.Start(ctx)
 }
 
+// SetConfigBuilder sets the function ReloadConfig uses to turn the aggregator
+// section of config.yaml into the aggregator configuration.
+func (s *AggregatorService) SetConfigBuilder(build func(config.AggregatorConfig) aggregator.AggregatorConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buildConfig = build
+}
+
+// SetHandoverCallback sets the function called once a standby aggregator
+// holds the standby lock and is about to serve.
+func (s *AggregatorService) SetHandoverCallback(fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onHandover = fn
+}
+
+// ReloadConfig applies the aggregator section of a reloaded config.yaml to the
+// running aggregator and reports the settings that changed.
+func (s *AggregatorService) ReloadConfig(ctx context.Context, cfg config.AggregatorConfig) (*api.AggregatorConfigChanges, error) {
+	s.mu.RLock()
+	build := s.buildConfig
+	s.mu.RUnlock()
+
+	if build == nil {
+		return nil, fmt.Errorf("aggregator configuration cannot be reloaded")
+	}
+	return s.applyConfig(build(cfg))
+}
+
+// applyConfig applies newConfig to the aggregator. The muster prefix, yolo
+// mode, and read-only mode are changed on the running server. Changes to the
+// listeners, OAuth, or the liveness pings restart the service in the
+// background, since the reload usually arrives as a tool call served by the
+// aggregator itself. The port and the standby settings are kept, as clients
+// would lose the aggregator or another instance would take over.
+func (s *AggregatorService) applyConfig(newConfig aggregator.AggregatorConfig) (*api.AggregatorConfigChanges, error) {
+	s.mu.Lock()
+	old := s.config
+	changes := &api.AggregatorConfigChanges{}
+
+	if newConfig.Port != old.Port {
+		changes.RestartRequired = append(changes.RestartRequired, "port")
+		newConfig.Port = old.Port
+	}
+	if newConfig.ReusePort != old.ReusePort {
+		changes.RestartRequired = append(changes.RestartRequired, "reusePort")
+		newConfig.ReusePort = old.ReusePort
+	}
+	if newConfig.Standby != old.Standby {
+		changes.RestartRequired = append(changes.RestartRequired, "standby")
+		newConfig.Standby = old.Standby
+	}
+	if newConfig.MusterPrefix != old.MusterPrefix {
+		changes.Applied = append(changes.Applied, "musterPrefix")
+	}
+	if newConfig.Yolo != old.Yolo {
+		changes.Applied = append(changes.Applied, "yolo")
+	}
+	if newConfig.ReadOnly != old.ReadOnly {
+		changes.Applied = append(changes.Applied, "readOnly")
+	}
+	for _, setting := range []struct {
+		name    string
+		changed bool
+	}{
+		{"host", newConfig.Host != old.Host},
+		{"transport", newConfig.Transport != old.Transport},
+		{"oauth.mcpClient", !reflect.DeepEqual(newConfig.OAuth, old.OAuth)},
+		{"oauth.server", !reflect.DeepEqual(newConfig.OAuthServer, old.OAuthServer)},
+		{"admin", newConfig.Admin != old.Admin},
+		{"gateway", newConfig.Gateway != old.Gateway},
+		{"liveness", newConfig.Liveness != old.Liveness},
+	} {
+		if setting.changed {
+			changes.Restarted = append(changes.Restarted, setting.name)
+		}
+	}
+
+	s.config = newConfig
+	manager := s.manager
+	running := s.GetState() == services.StateRunning
+	s.mu.Unlock()
+
+	// A stopped service picks the configuration up when it is started
+	if !running || manager == nil {
+		return changes, nil
+	}
+
+	if len(changes.Restarted) > 0 {
+		logging.Info("Aggregator-Service", "Restarting MCP aggregator service to apply %s", strings.Join(changes.Restarted, ", "))
+		go func() {
+			if err := s.Restart(context.Background()); err != nil {
+				logging.Error("Aggregator-Service", err, "Failed to restart MCP aggregator service")
+			}
+		}()
+		return changes, nil
+	}
+
+	if len(changes.Applied) > 0 {
+		manager.ApplySettings(newConfig)
+		logging.Info("Aggregator-Service", "Applied %s to MCP aggregator service", strings.Join(changes.Applied, ", "))
+	}
+	return changes, nil
+}
+
 // GetServiceData implements ServiceDataProvider
 func (s *AggregatorService) GetServiceData() map[string]interface{} {
 	s.mu.RLock()
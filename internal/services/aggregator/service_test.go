@@ -1,11 +1,14 @@
 package aggregator
 
 import (
+	"context"
 	"testing"
 
 	"github.com/giantswarm/muster/internal/aggregator"
+	"github.com/giantswarm/muster/internal/config"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewAggregatorService(t *testing.T) {
@@ -20,3 +23,42 @@ func TestNewAggregatorService(t *testing.T) {
 	assert.Equal(t, "mcp-aggregator", service.GetName())
 	assert.Equal(t, 0, len(service.GetDependencies()), "Should have no dependencies by default")
 }
+
+func TestAggregatorService_ApplyConfig(t *testing.T) {
+	cfg := aggregator.AggregatorConfig{
+		Host:         "localhost",
+		Port:         8090,
+		Transport:    "streamable-http",
+		MusterPrefix: "x",
+	}
+	service := NewAggregatorService(cfg, nil, nil)
+
+	// Unchanged configuration
+	changes, err := service.applyConfig(cfg)
+	require.NoError(t, err)
+	assert.False(t, changes.HasChanges())
+
+	newConfig := cfg
+	newConfig.Port = 9000
+	newConfig.MusterPrefix = "m"
+	newConfig.Yolo = true
+	newConfig.ReadOnly = true
+	newConfig.Host = "0.0.0.0"
+	newConfig.Admin = aggregator.AdminConfig{Enabled: true, Port: 9999}
+	newConfig.Gateway = aggregator.GatewayConfig{Enabled: true, Port: 9998}
+	newConfig.Standby = aggregator.StandbyConfig{Enabled: true}
+	changes, err = service.applyConfig(newConfig)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"musterPrefix", "yolo", "readOnly"}, changes.Applied)
+	assert.Equal(t, []string{"host", "admin", "gateway"}, changes.Restarted)
+	assert.Equal(t, []string{"port", "standby"}, changes.RestartRequired)
+
+	// The port and the standby settings are kept until muster is restarted
+	assert.Equal(t, 8090, service.config.Port)
+	assert.False(t, service.config.Standby.Enabled)
+	assert.Equal(t, "m", service.config.MusterPrefix)
+	assert.True(t, service.config.Yolo)
+
+	_, err = service.ReloadConfig(context.Background(), config.AggregatorConfig{})
+	assert.Error(t, err, "reloading requires a config builder")
+}
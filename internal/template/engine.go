@@ -7,23 +7,111 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 	"text/template/parse"
 
 	"github.com/Masterminds/sprig/v3"
 )
 
+// maxCachedTemplates bounds the parsed templates an Engine keeps. Templates
+// are cached by their source text, so the templates of changed definitions are
+// never used again; the cache is emptied when it is full to drop them.
+const maxCachedTemplates = 4096
+
+// captureFunc is the function renderCapturedPipe pipes a value into. The
+// cached templates are parsed with a placeholder and executed with a clone
+// bound to the capturing function.
+const captureFunc = "__capture"
+
 // Engine handles arg templating for service operations
 type Engine struct {
 	// Pattern to match template variables like {{ variableName }} or {{ variable.property.subproperty }}
 	templatePattern *regexp.Regexp
+
+	// Parsed templates by source text, so templates are parsed once rather
+	// than on every execution
+	mu     sync.RWMutex
+	parsed map[string]*template.Template
 }
 
 // New creates a new template engine
 func New() *Engine {
 	return &Engine{
 		templatePattern: regexp.MustCompile(`\{\{\s*\.?([a-zA-Z_][a-zA-Z0-9_.-]*)\s*\}\}`),
+		parsed:          make(map[string]*template.Template),
+	}
+}
+
+// Compile parses every template in value, a string or a map or slice of
+// them, and caches the parsed templates for the renderers. It returns the
+// first syntax error with the path of the template in value, e.g.
+// "steps[0].args.name", so definitions can be rejected when they are created
+// and their templates are not parsed again when they are executed.
+func (e *Engine) Compile(value interface{}) error {
+	return e.compileAt(value, "")
+}
+
+// compileAt is Compile for value found at path.
+func (e *Engine) compileAt(value interface{}, path string) error {
+	switch v := value.(type) {
+	case string:
+		if strings.Contains(v, "{{") {
+			if _, err := e.parse(v); err != nil {
+				if path == "" {
+					return err
+				}
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	case map[string]interface{}:
+		for key, val := range v {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			if err := e.compileAt(val, keyPath); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, val := range v {
+			if err := e.compileAt(val, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
 	}
+	return nil
+}
+
+// parse returns the parsed template of templateStr, from the cache if it was
+// parsed before.
+func (e *Engine) parse(templateStr string) (*template.Template, error) {
+	return e.parseWith(templateStr, templateStr, sprig.TxtFuncMap())
+}
+
+// parseWith returns the template of templateStr parsed with funcs, cached
+// under key.
+func (e *Engine) parseWith(key, templateStr string, funcs template.FuncMap) (*template.Template, error) {
+	e.mu.RLock()
+	tmpl, ok := e.parsed[key]
+	e.mu.RUnlock()
+	if ok {
+		return tmpl, nil
+	}
+
+	tmpl, err := template.New("template").Funcs(funcs).Option("missingkey=error").Parse(templateStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.parsed == nil || len(e.parsed) >= maxCachedTemplates {
+		e.parsed = make(map[string]*template.Template)
+	}
+	e.parsed[key] = tmpl
+	return tmpl, nil
 }
 
 // Replace replaces all template variables in a value with actual values from the context
@@ -272,12 +360,22 @@ func parsePathSegment(segment, path string) (string, []int, error) {
 	return name, indices, nil
 }
 
+// CheckSyntax parses templateStr with the same function map and options the
+// renderers use, without executing it. It lets callers such as
+// `muster config validate` reject malformed templates before runtime.
+func CheckSyntax(templateStr string) error {
+	if _, err := template.New("template").Funcs(sprig.TxtFuncMap()).Option("missingkey=error").Parse(templateStr); err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	return nil
+}
+
 // RenderGoTemplate renders a full Go template with Sprig template functions
 // This is used for complex expressions like {{ eq .input.var "value" }}
 func (e *Engine) RenderGoTemplate(templateStr string, context map[string]interface{}) (interface{}, error) {
-	tmpl, err := template.New("template").Funcs(sprig.TxtFuncMap()).Option("missingkey=error").Parse(templateStr)
+	tmpl, err := e.parse(templateStr)
 	if err != nil {
-		return nil, fmt.Errorf("invalid template: %w", err)
+		return nil, err
 	}
 
 	var buf bytes.Buffer
@@ -315,13 +413,13 @@ func (e *Engine) RenderGoTemplate(templateStr string, context map[string]interfa
 // preserved exactly instead of being silently coerced to a number. That guess
 // was the source of lossy numeric coercion in earlier versions.
 func (e *Engine) RenderGoTemplateTyped(templateStr string, context map[string]interface{}) (interface{}, error) {
-	probe, err := template.New("template").Funcs(sprig.TxtFuncMap()).Option("missingkey=error").Parse(templateStr)
+	probe, err := e.parse(templateStr)
 	if err != nil {
-		return nil, fmt.Errorf("invalid template: %w", err)
+		return nil, err
 	}
 
 	if pipe := singleActionPipe(probe); pipe != nil {
-		return renderCapturedPipe(pipe.String(), context)
+		return e.renderCapturedPipe(pipe.String(), context)
 	}
 
 	var buf bytes.Buffer
@@ -349,17 +447,28 @@ func singleActionPipe(t *template.Template) *parse.PipeNode {
 // renderCapturedPipe evaluates a single pipeline and returns the typed value it
 // produced, by piping it into a capture function whose argument keeps its
 // concrete Go type. The rendered text itself is discarded.
-func renderCapturedPipe(pipeText string, context map[string]interface{}) (interface{}, error) {
-	var captured interface{}
+func (e *Engine) renderCapturedPipe(pipeText string, context map[string]interface{}) (interface{}, error) {
 	funcs := sprig.TxtFuncMap()
-	funcs["__capture"] = func(v interface{}) string {
-		captured = v
-		return ""
+	funcs[captureFunc] = func(interface{}) string { return "" }
+	captureText := "{{ " + pipeText + " | " + captureFunc + " }}"
+	// The key cannot collide with a template source, which is never wrapped
+	// in a NUL byte
+	cached, err := e.parseWith("\x00"+captureText, captureText, funcs)
+	if err != nil {
+		return nil, err
 	}
-	tmpl, err := template.New("template").Funcs(funcs).Option("missingkey=error").Parse("{{ " + pipeText + " | __capture }}")
+
+	// Bind the capturing function to a clone, as the cached template is
+	// shared by concurrent executions
+	tmpl, err := cached.Clone()
 	if err != nil {
-		return nil, fmt.Errorf("invalid template: %w", err)
+		return nil, fmt.Errorf("template execution failed: %w", err)
 	}
+	var captured interface{}
+	tmpl.Funcs(template.FuncMap{captureFunc: func(v interface{}) string {
+		captured = v
+		return ""
+	}})
 	if err := tmpl.Execute(io.Discard, context); err != nil {
 		return nil, fmt.Errorf("template execution failed: %w", err)
 	}
@@ -1,6 +1,7 @@
 package template
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -96,3 +97,53 @@ func TestEngineRenderGoTemplateTyped(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckSyntax(t *testing.T) {
+	assert.NoError(t, CheckSyntax("{{ .input.name }}"))
+	assert.NoError(t, CheckSyntax(`{{ eq .input.env "prod" | not }}`))
+	assert.NoError(t, CheckSyntax("plain text"))
+	assert.Error(t, CheckSyntax("{{ .input.name "))
+	assert.Error(t, CheckSyntax("{{ unknownFunc .input.name }}"))
+}
+
+func TestEngineCompile(t *testing.T) {
+	e := New()
+	definition := map[string]interface{}{
+		"steps": []interface{}{
+			map[string]interface{}{"args": map[string]interface{}{"name": "{{ .input.name }}", "count": float64(3)}},
+			map[string]interface{}{"args": map[string]interface{}{"name": "{{ .input.name "}},
+		},
+	}
+
+	err := e.Compile(definition)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "steps[1].args.name: invalid template")
+
+	// The valid template was parsed once and is rendered from the cache
+	require.Contains(t, e.parsed, "{{ .input.name }}")
+	cached := e.parsed["{{ .input.name }}"]
+	got, err := e.RenderGoTemplate("{{ .input.name }}", map[string]interface{}{"input": map[string]interface{}{"name": "muster"}})
+	require.NoError(t, err)
+	assert.Equal(t, "muster", got)
+	assert.Same(t, cached, e.parsed["{{ .input.name }}"])
+
+	assert.NoError(t, e.Compile("plain text"))
+	assert.NoError(t, e.Compile([]interface{}{"{{ len .items }}", true}))
+}
+
+func TestEngineRenderGoTemplateTyped_Concurrent(t *testing.T) {
+	e := New()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// The cached capture template is shared; each render captures
+			// its own value
+			got, err := e.RenderGoTemplateTyped("{{ .n }}", map[string]interface{}{"n": i})
+			assert.NoError(t, err)
+			assert.Equal(t, i, got)
+		}(i)
+	}
+	wg.Wait()
+}
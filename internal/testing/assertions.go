@@ -0,0 +1,598 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Assertion types accepted by Assertion.Type.
+const (
+	AssertionTypeString  = "string"
+	AssertionTypeNumber  = "number"
+	AssertionTypeBoolean = "boolean"
+	AssertionTypeArray   = "array"
+	AssertionTypeObject  = "object"
+	AssertionTypeNull    = "null"
+)
+
+// errPathNotFound reports that a definite path selected nothing.
+var errPathNotFound = errors.New("path not found")
+
+// Assertion is a single check on the values a JSONPath selects from the step
+// response. All checks set on an assertion must hold; Not inverts the result.
+//
+// A definite path (only names and indexes) selects a single value. Wildcards,
+// recursive descent, and filters select a list of values, which is checked as
+// an array: length counts the matches and contains looks for one of them.
+// Matches and the numeric comparisons apply to every element of an array.
+type Assertion struct {
+	// Path is a JSONPath selector such as $.services[*].name; defaults to $,
+	// the whole response
+	Path string `yaml:"path,omitempty"`
+	// Not inverts the assertion; a missing definite path still fails
+	Not bool `yaml:"not,omitempty"`
+	// Exists checks whether the path selects anything
+	Exists *bool `yaml:"exists,omitempty"`
+	// Equals compares the selected value; numbers compare by value and objects
+	// match if the expected keys match
+	Equals interface{} `yaml:"equals,omitempty"`
+	// Matches is a regular expression the value must match
+	Matches string `yaml:"matches,omitempty"`
+	// Contains checks for an array element, a substring, or an object key
+	Contains interface{} `yaml:"contains,omitempty"`
+	// GreaterThan, GreaterOrEqual, LessThan, and LessOrEqual compare numbers
+	GreaterThan    *float64 `yaml:"gt,omitempty"`
+	GreaterOrEqual *float64 `yaml:"gte,omitempty"`
+	LessThan       *float64 `yaml:"lt,omitempty"`
+	LessOrEqual    *float64 `yaml:"lte,omitempty"`
+	// Length is the length of a string, array, or object
+	Length *int `yaml:"length,omitempty"`
+	// Type is the JSON type of the value: string, number, boolean, array,
+	// object, or null
+	Type string `yaml:"type,omitempty"`
+}
+
+// Validate checks that the assertion is well-formed.
+func (a Assertion) Validate() error {
+	if _, err := parseJSONPath(a.Path); err != nil {
+		return err
+	}
+	if a.Exists == nil && a.Equals == nil && a.Matches == "" && a.Contains == nil && a.Length == nil && a.Type == "" &&
+		a.GreaterThan == nil && a.GreaterOrEqual == nil && a.LessThan == nil && a.LessOrEqual == nil {
+		return fmt.Errorf("assertion on %s has no checks", a.path())
+	}
+	if a.Matches != "" {
+		if _, err := regexp.Compile(a.Matches); err != nil {
+			return fmt.Errorf("invalid matches pattern %q: %w", a.Matches, err)
+		}
+	}
+	switch a.Type {
+	case "", AssertionTypeString, AssertionTypeNumber, AssertionTypeBoolean, AssertionTypeArray, AssertionTypeObject, AssertionTypeNull:
+	default:
+		return fmt.Errorf("invalid type %q, must be one of string, number, boolean, array, object, null", a.Type)
+	}
+	if a.Length != nil && *a.Length < 0 {
+		return fmt.Errorf("length cannot be negative")
+	}
+	return nil
+}
+
+// Evaluate checks the assertion against a decoded response and describes the
+// first failed check.
+func (a Assertion) Evaluate(doc interface{}) error {
+	segments, err := parseJSONPath(a.Path)
+	if err != nil {
+		return err
+	}
+	values, definite := selectJSONPath(doc, segments)
+
+	failure := a.check(values, definite)
+	switch {
+	case !a.Not:
+		return failure
+	case failure == nil:
+		return fmt.Errorf("%s: expected checks to fail (not: true), but all passed", a.path())
+	case errors.Is(failure, errPathNotFound) && a.Exists == nil:
+		return failure
+	default:
+		return nil
+	}
+}
+
+func (a Assertion) path() string {
+	if strings.TrimSpace(a.Path) == "" {
+		return "$"
+	}
+	return a.Path
+}
+
+// check runs the checks without negation.
+func (a Assertion) check(values []interface{}, definite bool) error {
+	if a.Exists != nil {
+		if found := len(values) > 0; found != *a.Exists {
+			return fmt.Errorf("%s: expected exists=%t", a.path(), *a.Exists)
+		}
+		if !*a.Exists {
+			return nil
+		}
+	}
+
+	var value interface{} = values
+	if definite {
+		if len(values) == 0 {
+			return fmt.Errorf("%s: %w", a.path(), errPathNotFound)
+		}
+		value = values[0]
+	}
+
+	if a.Type != "" {
+		if actual := assertionType(value); actual != a.Type {
+			return fmt.Errorf("%s: expected type %s, got %s", a.path(), a.Type, actual)
+		}
+	}
+	if a.Equals != nil && !assertionValuesEqual(value, a.Equals) {
+		return fmt.Errorf("%s: expected %v, got %v", a.path(), a.Equals, value)
+	}
+	if a.Length != nil {
+		n, ok := assertionLength(value)
+		if !ok {
+			return fmt.Errorf("%s: %s value has no length", a.path(), assertionType(value))
+		}
+		if n != *a.Length {
+			return fmt.Errorf("%s: expected length %d, got %d", a.path(), *a.Length, n)
+		}
+	}
+	if a.Contains != nil {
+		if err := assertionContains(value, a.Contains); err != nil {
+			return fmt.Errorf("%s: %w", a.path(), err)
+		}
+	}
+	if a.Matches != "" {
+		re, err := regexp.Compile(a.Matches)
+		if err != nil {
+			return fmt.Errorf("invalid matches pattern %q: %w", a.Matches, err)
+		}
+		err = forEachAssertionValue(value, func(v interface{}) error {
+			switch v.(type) {
+			case map[string]interface{}, []interface{}:
+				return fmt.Errorf("cannot match %s value against %q", assertionType(v), a.Matches)
+			}
+			if s := fmt.Sprint(v); !re.MatchString(s) {
+				return fmt.Errorf("%q does not match %q", s, a.Matches)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", a.path(), err)
+		}
+	}
+
+	comparisons := []struct {
+		op    string
+		bound *float64
+		ok    func(n, bound float64) bool
+	}{
+		{">", a.GreaterThan, func(n, b float64) bool { return n > b }},
+		{">=", a.GreaterOrEqual, func(n, b float64) bool { return n >= b }},
+		{"<", a.LessThan, func(n, b float64) bool { return n < b }},
+		{"<=", a.LessOrEqual, func(n, b float64) bool { return n <= b }},
+	}
+	for _, c := range comparisons {
+		if c.bound == nil {
+			continue
+		}
+		err := forEachAssertionValue(value, func(v interface{}) error {
+			n, ok := assertionNumber(v)
+			if !ok {
+				return fmt.Errorf("expected a number, got %s %v", assertionType(v), v)
+			}
+			if !c.ok(n, *c.bound) {
+				return fmt.Errorf("expected %v %s %v", n, c.op, *c.bound)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", a.path(), err)
+		}
+	}
+	return nil
+}
+
+// forEachAssertionValue applies fn to every element of a non-empty array, or
+// to the value itself.
+func forEachAssertionValue(value interface{}, fn func(interface{}) error) error {
+	list, ok := value.([]interface{})
+	if !ok {
+		return fn(value)
+	}
+	if len(list) == 0 {
+		return fmt.Errorf("selected no values")
+	}
+	for _, v := range list {
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assertionContains checks for an array element, substring, or object key.
+func assertionContains(value, expected interface{}) error {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if assertionValuesEqual(item, expected) {
+				return nil
+			}
+		}
+		return fmt.Errorf("array does not contain %v", expected)
+	case string:
+		if !strings.Contains(v, fmt.Sprint(expected)) {
+			return fmt.Errorf("%q does not contain %q", v, fmt.Sprint(expected))
+		}
+		return nil
+	case map[string]interface{}:
+		if _, ok := v[fmt.Sprint(expected)]; !ok {
+			return fmt.Errorf("object has no key %q", fmt.Sprint(expected))
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot check whether %s value contains %v", assertionType(value), expected)
+	}
+}
+
+// assertionValuesEqual compares decoded JSON and YAML values. Numbers are
+// compared by value and expected objects match if all their keys match, so
+// an expected element can pick out an array item by a few fields.
+func assertionValuesEqual(actual, expected interface{}) bool {
+	if a, ok := assertionNumber(actual); ok {
+		e, ok := assertionNumber(expected)
+		return ok && a == e
+	}
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, ev := range e {
+			av, exists := a[key]
+			if !exists || !assertionValuesEqual(av, ev) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !assertionValuesEqual(a[i], e[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(actual, expected)
+}
+
+// assertionNumber converts the numeric types produced by JSON and YAML
+// decoding to float64.
+func assertionNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// assertionType returns the JSON type name of a decoded value.
+func assertionType(v interface{}) string {
+	if _, ok := assertionNumber(v); ok {
+		return AssertionTypeNumber
+	}
+	switch v.(type) {
+	case nil:
+		return AssertionTypeNull
+	case string:
+		return AssertionTypeString
+	case bool:
+		return AssertionTypeBoolean
+	case []interface{}:
+		return AssertionTypeArray
+	case map[string]interface{}:
+		return AssertionTypeObject
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func assertionLength(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case string:
+		return utf8.RuneCountInString(v), true
+	case []interface{}:
+		return len(v), true
+	case map[string]interface{}:
+		return len(v), true
+	}
+	return 0, false
+}
+
+// pathSegmentKind identifies the kind of a JSONPath segment.
+type pathSegmentKind int
+
+const (
+	segmentKey pathSegmentKind = iota
+	segmentIndex
+	segmentWildcard
+	segmentRecursive
+	segmentFilter
+)
+
+// pathSegment is one step of a parsed JSONPath.
+type pathSegment struct {
+	kind  pathSegmentKind
+	key   string
+	index int
+	// filter holds [?(@.field op value)] conditions
+	filter *pathFilter
+}
+
+// pathFilter selects array elements by a field: with an empty op the field
+// must exist, otherwise it is compared with == or != to value.
+type pathFilter struct {
+	field []pathSegment
+	op    string
+	value interface{}
+}
+
+// parseJSONPath parses the supported JSONPath subset: $, .name, ['name'],
+// [n] (negative from the end), [*] and .*, ..name for recursive descent, and
+// [?(@.field == value)] filters with == and != or a bare field for existence.
+// The leading $ is optional and numeric dot segments index arrays, so the
+// paths used by json_path expectations work unchanged.
+func parseJSONPath(path string) ([]pathSegment, error) {
+	p := strings.TrimPrefix(strings.TrimSpace(path), "$")
+	if p != "" && p[0] != '.' && p[0] != '[' {
+		p = "." + p
+	}
+
+	var segments []pathSegment
+	for i := 0; i < len(p); {
+		switch {
+		case strings.HasPrefix(p[i:], ".."):
+			name, next := readPathName(p, i+2)
+			if name == "" {
+				return nil, fmt.Errorf("invalid path %q: .. must be followed by a name or *", path)
+			}
+			segments = append(segments, pathSegment{kind: segmentRecursive, key: name})
+			i = next
+		case p[i] == '.':
+			name, next := readPathName(p, i+1)
+			if name == "" {
+				return nil, fmt.Errorf("invalid path %q: empty name at offset %d", path, i)
+			}
+			if name == "*" {
+				segments = append(segments, pathSegment{kind: segmentWildcard})
+			} else {
+				segments = append(segments, pathSegment{kind: segmentKey, key: name})
+			}
+			i = next
+		case p[i] == '[':
+			end := closingBracket(p, i)
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unclosed [", path)
+			}
+			segment, err := parseBracketSegment(strings.TrimSpace(p[i+1 : end]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: %w", path, err)
+			}
+			segments = append(segments, segment)
+			i = end + 1
+		default:
+			return nil, fmt.Errorf("invalid path %q: unexpected %q at offset %d", path, p[i], i)
+		}
+	}
+	return segments, nil
+}
+
+// readPathName reads a dot segment name up to the next . or [.
+func readPathName(p string, start int) (string, int) {
+	end := start
+	for end < len(p) && p[end] != '.' && p[end] != '[' {
+		end++
+	}
+	return p[start:end], end
+}
+
+// closingBracket returns the index of the ] matching the [ at start,
+// skipping quoted strings.
+func closingBracket(p string, start int) int {
+	var quote byte
+	for i := start + 1; i < len(p); i++ {
+		switch c := p[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func parseBracketSegment(content string) (pathSegment, error) {
+	switch {
+	case content == "*":
+		return pathSegment{kind: segmentWildcard}, nil
+	case len(content) >= 2 && (content[0] == '\'' || content[0] == '"') && content[len(content)-1] == content[0]:
+		return pathSegment{kind: segmentKey, key: content[1 : len(content)-1]}, nil
+	case strings.HasPrefix(content, "?(") && strings.HasSuffix(content, ")"):
+		filter, err := parsePathFilter(strings.TrimSpace(content[2 : len(content)-1]))
+		if err != nil {
+			return pathSegment{}, err
+		}
+		return pathSegment{kind: segmentFilter, filter: filter}, nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return pathSegment{}, fmt.Errorf("invalid selector [%s]", content)
+	}
+	return pathSegment{kind: segmentIndex, index: index}, nil
+}
+
+func parsePathFilter(expr string) (*pathFilter, error) {
+	filter := &pathFilter{}
+	left := expr
+	for _, op := range []string{"==", "!="} {
+		if i := strings.Index(expr, op); i >= 0 {
+			filter.op = op
+			left = strings.TrimSpace(expr[:i])
+			right := strings.TrimSpace(expr[i+len(op):])
+			if len(right) >= 2 && (right[0] == '\'' || right[0] == '"') && right[len(right)-1] == right[0] {
+				filter.value = right[1 : len(right)-1]
+			} else if err := yaml.Unmarshal([]byte(right), &filter.value); err != nil || right == "" {
+				return nil, fmt.Errorf("invalid filter value %q", right)
+			}
+			break
+		}
+	}
+	if !strings.HasPrefix(left, "@") {
+		return nil, fmt.Errorf("filter %q must start with @", expr)
+	}
+	field, err := parseJSONPath(left[1:])
+	if err != nil {
+		return nil, err
+	}
+	filter.field = field
+	return filter, nil
+}
+
+// selectJSONPath returns the values the path selects from doc and whether the
+// path is definite, i.e. selects at most one value.
+func selectJSONPath(doc interface{}, segments []pathSegment) ([]interface{}, bool) {
+	nodes := []interface{}{doc}
+	definite := true
+	for _, segment := range segments {
+		if segment.kind != segmentKey && segment.kind != segmentIndex {
+			definite = false
+		}
+		var next []interface{}
+		for _, node := range nodes {
+			next = append(next, selectPathSegment(node, segment)...)
+		}
+		nodes = next
+	}
+	return nodes, definite
+}
+
+func selectPathSegment(node interface{}, segment pathSegment) []interface{} {
+	switch segment.kind {
+	case segmentKey:
+		switch n := node.(type) {
+		case map[string]interface{}:
+			if v, ok := n[segment.key]; ok {
+				return []interface{}{v}
+			}
+		case []interface{}:
+			if index, err := strconv.Atoi(segment.key); err == nil {
+				return selectPathSegment(node, pathSegment{kind: segmentIndex, index: index})
+			}
+		}
+	case segmentIndex:
+		if n, ok := node.([]interface{}); ok {
+			index := segment.index
+			if index < 0 {
+				index += len(n)
+			}
+			if index >= 0 && index < len(n) {
+				return []interface{}{n[index]}
+			}
+		}
+	case segmentWildcard:
+		return pathChildren(node)
+	case segmentRecursive:
+		var matches []interface{}
+		for _, descendant := range pathDescendants(node) {
+			if segment.key == "*" {
+				matches = append(matches, pathChildren(descendant)...)
+			} else if m, ok := descendant.(map[string]interface{}); ok {
+				if v, ok := m[segment.key]; ok {
+					matches = append(matches, v)
+				}
+			}
+		}
+		return matches
+	case segmentFilter:
+		var matches []interface{}
+		for _, child := range pathChildren(node) {
+			if segment.filter.matches(child) {
+				matches = append(matches, child)
+			}
+		}
+		return matches
+	}
+	return nil
+}
+
+func (f *pathFilter) matches(node interface{}) bool {
+	values, _ := selectJSONPath(node, f.field)
+	switch f.op {
+	case "==":
+		return len(values) > 0 && assertionValuesEqual(values[0], f.value)
+	case "!=":
+		return len(values) > 0 && !assertionValuesEqual(values[0], f.value)
+	default:
+		return len(values) > 0
+	}
+}
+
+// pathChildren returns the elements of an array or the values of an object
+// in key order.
+func pathChildren(node interface{}) []interface{} {
+	switch n := node.(type) {
+	case []interface{}:
+		return n
+	case map[string]interface{}:
+		keys := make([]string, 0, len(n))
+		for key := range n {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		children := make([]interface{}, len(keys))
+		for i, key := range keys {
+			children[i] = n[key]
+		}
+		return children
+	}
+	return nil
+}
+
+// pathDescendants returns node and all values nested in it, depth first.
+func pathDescendants(node interface{}) []interface{} {
+	descendants := []interface{}{node}
+	for _, child := range pathChildren(node) {
+		descendants = append(descendants, pathDescendants(child)...)
+	}
+	return descendants
+}
@@ -0,0 +1,114 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const assertionResponse = `{
+  "total": 3,
+  "truncated": false,
+  "services": [
+    {"name": "svc-a", "state": "running", "restarts": 0, "tags": ["web"]},
+    {"name": "svc-b", "state": "stopped", "restarts": 2},
+    {"name": "svc-c", "state": "running", "restarts": 5, "health": {"status": "degraded"}}
+  ]
+}`
+
+func TestSelectJSONPath(t *testing.T) {
+	var doc interface{}
+	require.NoError(t, json.Unmarshal([]byte(assertionResponse), &doc))
+
+	tests := []struct {
+		path     string
+		want     []interface{}
+		definite bool
+	}{
+		{"$", []interface{}{doc}, true},
+		{"total", []interface{}{float64(3)}, true},
+		{"$.services[0].name", []interface{}{"svc-a"}, true},
+		{"services.1.name", []interface{}{"svc-b"}, true},
+		{"$.services[-1]['name']", []interface{}{"svc-c"}, true},
+		{"$.services[*].name", []interface{}{"svc-a", "svc-b", "svc-c"}, false},
+		{"$..status", []interface{}{"degraded"}, false},
+		{"$.services[?(@.state == 'running')].name", []interface{}{"svc-a", "svc-c"}, false},
+		{"$.services[?(@.restarts != 0)].name", []interface{}{"svc-b", "svc-c"}, false},
+		{"$.services[?(@.health.status)].name", []interface{}{"svc-c"}, false},
+		{"$.missing", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			segments, err := parseJSONPath(tt.path)
+			require.NoError(t, err)
+			got, definite := selectJSONPath(doc, segments)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.definite, definite)
+		})
+	}
+
+	for _, path := range []string{"$.services[", "$.services[x]", "$..", "$.a..", "$.services[?(state == 1)]"} {
+		_, err := parseJSONPath(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestAssertionEvaluate(t *testing.T) {
+	var doc interface{}
+	require.NoError(t, json.Unmarshal([]byte(assertionResponse), &doc))
+
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{"equals int against float", "path: total\nequals: 3", ""},
+		{"equals mismatch", "path: total\nequals: 4", "expected 4, got 3"},
+		{"partial object", "path: services[0]\nequals: {name: svc-a}", ""},
+		{"regex", "path: $.services[*].name\nmatches: '^svc-[a-c]$'", ""},
+		{"regex mismatch", "path: $.services[0].state\nmatches: '^stop'", `"running" does not match`},
+		{"numeric range", "path: $.services[*].restarts\ngte: 0\nlt: 10", ""},
+		{"numeric failure", "path: $.services[*].restarts\nlt: 5", "expected 5 < 5"},
+		{"array contains", "path: $.services[*].name\ncontains: svc-b", ""},
+		{"array contains object", "path: services\ncontains: {name: svc-c, state: running}", ""},
+		{"array contains missing", "path: $.services[*].name\ncontains: svc-z", "array does not contain svc-z"},
+		{"substring", "path: services.0.name\ncontains: svc", ""},
+		{"object key", "path: services.2\ncontains: health", ""},
+		{"length of selection", "path: $.services[?(@.state == 'running')]\nlength: 2", ""},
+		{"type", "path: truncated\ntype: boolean", ""},
+		{"type mismatch", "path: total\ntype: string", "expected type string, got number"},
+		{"negated", "path: $.services[*].state\ncontains: failed\nnot: true", ""},
+		{"negated passing checks", "path: total\nequals: 3\nnot: true", "expected checks to fail"},
+		{"negation keeps missing path failure", "path: missing\nequals: 1\nnot: true", "path not found"},
+		{"exists", "path: services.2.health\nexists: true", ""},
+		{"not exists", "path: services.0.health\nexists: false", ""},
+		{"exists failure", "path: services.0.health\nexists: true", "expected exists=true"},
+		{"empty filter", "path: $.services[?(@.state == 'crashed')]\nlength: 0", ""},
+		{"empty filter numeric", "path: $.services[?(@.state == 'crashed')].restarts\ngt: 0", "selected no values"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Assertion
+			require.NoError(t, yaml.Unmarshal([]byte(tt.yaml), &a))
+			require.NoError(t, a.Validate())
+			err := a.Evaluate(doc)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+
+	assert.NoError(t, Assertion{Matches: "not found$"}.Evaluate("tool x: not found"))
+}
+
+func TestAssertionValidate(t *testing.T) {
+	assert.ErrorContains(t, Assertion{Path: "services"}.Validate(), "has no checks")
+	assert.ErrorContains(t, Assertion{Matches: "("}.Validate(), "invalid matches pattern")
+	assert.ErrorContains(t, Assertion{Type: "integer"}.Validate(), "invalid type")
+	assert.ErrorContains(t, Assertion{Path: "a[", Type: "string"}.Validate(), "unclosed [")
+}
@@ -0,0 +1,239 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Chaos actions, recorded on ChaosEvent.Action.
+const (
+	chaosKillMockServer = "kill_mock_server"
+	chaosRevokeTokens   = "revoke_tokens"
+)
+
+// defaultChaosMaxDelay is the response delay limit when ChaosConfig.MaxDelay
+// is unset.
+const defaultChaosMaxDelay = 500 * time.Millisecond
+
+// ChaosConfig enables chaos mode: before steps, stdio mock MCP server
+// processes are killed and mock OAuth tokens revoked at random, and every mock
+// tool response is delayed by a random amount, to exercise the orchestrator's
+// recovery and the aggregator's degradation paths.
+//
+// All randomness derives from Seed and the scenario name, so a failing run
+// can be reproduced with the same seed regardless of the execution order.
+type ChaosConfig struct {
+	// Seed seeds the chaos decisions
+	Seed int64 `yaml:"seed"`
+	// Rate is the probability (0.0-1.0) that a chaos action precedes a step
+	Rate float64 `yaml:"rate"`
+	// MaxDelay is the upper bound of the random delay added to mock tool
+	// responses
+	MaxDelay time.Duration `yaml:"max_delay,omitempty"`
+}
+
+// ChaosEvent is a chaos action taken during a scenario.
+type ChaosEvent struct {
+	// Step is the ID of the step the action preceded
+	Step string `json:"step"`
+	// Action is kill_mock_server or revoke_tokens
+	Action string `json:"action"`
+	// Target is the affected mock MCP server or OAuth servers
+	Target string `json:"target,omitempty"`
+	// Error is set if the action could not be carried out
+	Error string `json:"error,omitempty"`
+}
+
+// scenarioSeed derives the chaos seed of a scenario from the run's seed.
+func (c *ChaosConfig) scenarioSeed(scenarioName string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(scenarioName))
+	return c.Seed ^ int64(h.Sum64()) //nolint:gosec // wraparound is fine for a seed
+}
+
+// withChaosDelays returns a copy of config in which every mock tool without
+// faults of its own delays its responses by up to MaxDelay. The delays are
+// seeded per server, so they are reproducible like the other chaos actions.
+func (c *ChaosConfig) withChaosDelays(config *MusterPreConfiguration, scenarioName string) *MusterPreConfiguration {
+	if c == nil || config == nil {
+		return config
+	}
+	maxDelay := c.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultChaosMaxDelay
+	}
+
+	chaotic := *config
+	chaotic.MCPServers = make([]MCPServerConfig, len(config.MCPServers))
+	for i, server := range config.MCPServers {
+		chaotic.MCPServers[i] = server
+		tools, ok := server.Config["tools"].([]interface{})
+		if !ok {
+			continue
+		}
+		seed := c.scenarioSeed(scenarioName + "/" + server.Name)
+		delayed := make([]interface{}, len(tools))
+		for j, tool := range tools {
+			delayed[j] = tool
+			toolConfig, ok := toStringMap(tool)
+			if !ok {
+				continue
+			}
+			if _, hasFaults := toolConfig["faults"]; hasFaults {
+				continue
+			}
+			withFaults := make(map[string]interface{}, len(toolConfig)+1)
+			for k, v := range toolConfig {
+				withFaults[k] = v
+			}
+			withFaults["faults"] = map[string]interface{}{
+				"jitter": maxDelay.String(),
+				"seed":   seed + int64(j),
+			}
+			delayed[j] = withFaults
+		}
+		serverConfig := make(map[string]interface{}, len(server.Config))
+		for k, v := range server.Config {
+			serverConfig[k] = v
+		}
+		serverConfig["tools"] = delayed
+		chaotic.MCPServers[i].Config = serverConfig
+	}
+	return &chaotic
+}
+
+// stdioMockServers returns the names of the mock MCP servers that muster runs
+// as child processes, sorted so chaos picks targets reproducibly.
+func stdioMockServers(config *MusterPreConfiguration) []string {
+	if config == nil {
+		return nil
+	}
+	var names []string
+	for _, server := range config.MCPServers {
+		if _, hasMockTools := server.Config["tools"]; !hasMockTools {
+			continue
+		}
+		if serverType, _ := server.Config["type"].(string); serverType == "sse" || serverType == "streamable-http" {
+			continue
+		}
+		names = append(names, server.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// chaosMonkey takes the chaos actions of one scenario.
+type chaosMonkey struct {
+	config          ChaosConfig
+	rng             *rand.Rand
+	instanceManager MusterInstanceManager
+	instance        *MusterInstance
+	mockServers     []string
+	logger          TestLogger
+}
+
+// newChaosMonkey returns nil when chaos mode is disabled.
+func newChaosMonkey(config *ChaosConfig, scenario TestScenario, instanceManager MusterInstanceManager, instance *MusterInstance, logger TestLogger) *chaosMonkey {
+	if config == nil || instance == nil {
+		return nil
+	}
+	return &chaosMonkey{
+		config:          *config,
+		rng:             rand.New(rand.NewSource(config.scenarioSeed(scenario.Name))), //nolint:gosec // reproducible chaos, not security relevant
+		instanceManager: instanceManager,
+		instance:        instance,
+		mockServers:     stdioMockServers(scenario.PreConfiguration),
+		logger:          logger,
+	}
+}
+
+// beforeStep decides whether to disrupt the instance before a step runs and
+// returns the action taken, if any.
+func (c *chaosMonkey) beforeStep(ctx context.Context, step TestStep) *ChaosEvent {
+	// Always draw all numbers so one decision does not shift the others
+	roll, action, target := c.rng.Float64(), c.rng.Intn(2), c.rng.Int()
+	if roll >= c.config.Rate {
+		return nil
+	}
+
+	event := &ChaosEvent{Step: step.ID}
+	switch action {
+	case 0:
+		if len(c.mockServers) == 0 {
+			return nil
+		}
+		event.Action = chaosKillMockServer
+		event.Target = c.mockServers[target%len(c.mockServers)]
+		// muster restarts the server, but the instance is not reused afterwards
+		if manager, ok := c.instanceManager.(*musterInstanceManager); ok {
+			manager.discardPooledInstance(c.instance.ID)
+		}
+		if err := killMockServerProcess(ctx, c.instance, event.Target); err != nil {
+			event.Error = err.Error()
+		}
+	default:
+		if len(c.instance.MockOAuthServers) == 0 {
+			return nil
+		}
+		event.Action = chaosRevokeTokens
+		var servers []string
+		total := 0
+		for name := range c.instance.MockOAuthServers {
+			if server := c.instanceManager.GetMockOAuthServer(c.instance.ID, name); server != nil {
+				total += server.RevokeAllTokens()
+				servers = append(servers, name)
+			}
+		}
+		sort.Strings(servers)
+		event.Target = strings.Join(servers, ",")
+		if len(servers) == 0 {
+			event.Error = "no running OAuth servers"
+		}
+		c.logger.Debug("🌪️  Revoked %d tokens on %s\n", total, event.Target)
+	}
+
+	if event.Error != "" {
+		c.logger.Info("🌪️  Chaos %s %s before step %s failed: %s\n", event.Action, event.Target, step.ID, event.Error)
+	} else {
+		c.logger.Info("🌪️  Chaos %s %s before step %s\n", event.Action, event.Target, step.ID)
+	}
+	return event
+}
+
+// killMockServerProcess kills the stdio mock MCP server process muster
+// started for the named server, identifying it by its mock config file.
+func killMockServerProcess(ctx context.Context, instance *MusterInstance, serverName string) error {
+	configFile := filepath.Join(instance.ConfigPath, "mocks", serverName+".yaml")
+	output, err := exec.CommandContext(ctx, "ps", "-eo", "pid=,args=").Output()
+	if err != nil {
+		return fmt.Errorf("failed to list processes: %w", err)
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !strings.Contains(line, "--mock-config "+configFile) {
+			continue
+		}
+		pid, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		process, err := os.FindProcess(pid)
+		if err != nil {
+			return fmt.Errorf("failed to find mock server process %d: %w", pid, err)
+		}
+		if err := process.Kill(); err != nil {
+			return fmt.Errorf("failed to kill mock server process %d: %w", pid, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("mock server %s is not running", serverName)
+}
@@ -0,0 +1,70 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func chaosPreConfiguration() *MusterPreConfiguration {
+	return &MusterPreConfiguration{
+		MCPServers: []MCPServerConfig{
+			{Name: "stdio-b", Config: map[string]interface{}{
+				"tools": []interface{}{
+					map[string]interface{}{"name": "plain"},
+					map[string]interface{}{"name": "faulty", "faults": map[string]interface{}{"fail_first": 1}},
+				},
+			}},
+			{Name: "http", Config: map[string]interface{}{"type": "streamable-http", "tools": []interface{}{}}},
+			{Name: "stdio-a", Config: map[string]interface{}{"tools": []interface{}{}}},
+			{Name: "real", Config: map[string]interface{}{"command": "server"}},
+		},
+	}
+}
+
+func TestChaosDelays(t *testing.T) {
+	config := chaosPreConfiguration()
+	chaos := &ChaosConfig{Seed: 42, Rate: 0.5}
+
+	chaotic := chaos.withChaosDelays(config, "scenario")
+	tools := chaotic.MCPServers[0].Config["tools"].([]interface{})
+	faults := tools[0].(map[string]interface{})["faults"].(map[string]interface{})
+	assert.Equal(t, "500ms", faults["jitter"])
+	assert.Equal(t, map[string]interface{}{"fail_first": 1}, tools[1].(map[string]interface{})["faults"])
+
+	// The scenario's own configuration is left untouched
+	_, hasFaults := config.MCPServers[0].Config["tools"].([]interface{})[0].(map[string]interface{})["faults"]
+	assert.False(t, hasFaults)
+	assert.Equal(t, chaotic, chaos.withChaosDelays(config, "scenario"))
+
+	var disabled *ChaosConfig
+	assert.Same(t, config, disabled.withChaosDelays(config, "scenario"))
+	assert.Equal(t, []string{"stdio-a", "stdio-b"}, stdioMockServers(config))
+}
+
+func TestChaosMonkeyIsReproducible(t *testing.T) {
+	scenario := TestScenario{Name: "chaos", PreConfiguration: chaosPreConfiguration()}
+	instance := &MusterInstance{ID: "chaos", ConfigPath: t.TempDir()}
+	run := func(seed int64) []string {
+		monkey := newChaosMonkey(&ChaosConfig{Seed: seed, Rate: 0.5}, scenario, nil, instance, NewSilentLogger(false, false))
+		require.NotNil(t, monkey)
+		var events []string
+		for i := 0; i < 20; i++ {
+			if event := monkey.beforeStep(context.Background(), TestStep{ID: fmt.Sprintf("step-%d", i)}); event != nil {
+				assert.Equal(t, chaosKillMockServer, event.Action)
+				assert.NotEmpty(t, event.Error, "mock servers are not running")
+				events = append(events, event.Step+"/"+event.Target)
+			}
+		}
+		return events
+	}
+
+	events := run(7)
+	assert.NotEmpty(t, events)
+	assert.Equal(t, events, run(7))
+	assert.NotEqual(t, events, run(8))
+	assert.Nil(t, newChaosMonkey(nil, scenario, nil, instance, NewSilentLogger(false, false)))
+}
@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Tool coverage group kinds.
+const (
+	// CoverageKindServer groups tools exposed by a downstream MCP server (x_<server>_*).
+	CoverageKindServer = "server"
+	// CoverageKindCore groups built-in core tools by API area (core_<group>_*).
+	CoverageKindCore = "core"
+	// CoverageKindWorkflow groups the workflow_* tools generated from workflows.
+	CoverageKindWorkflow = "workflow"
+	// CoverageKindOther groups tools that match none of the known prefixes.
+	CoverageKindOther = "other"
+)
+
+// ToolCoverageReport describes which aggregator tools were exercised by a test run.
+type ToolCoverageReport struct {
+	// TotalTools is the number of distinct tools seen across all scenarios
+	TotalTools int `json:"total_tools"`
+	// CoveredTools is the number of distinct tools called by at least one step
+	CoveredTools int `json:"covered_tools"`
+	// Percent is CoveredTools as a percentage of TotalTools
+	Percent float64 `json:"percent"`
+	// Groups breaks coverage down per server and per core tool group
+	Groups []ToolCoverageGroup `json:"groups"`
+}
+
+// ToolCoverageGroup is the coverage of one server or core tool group.
+type ToolCoverageGroup struct {
+	// Name is the group name, e.g. "core_workflow" or the MCP server name
+	Name string `json:"name"`
+	// Kind is one of the CoverageKind* constants
+	Kind string `json:"kind"`
+	// Total is the number of tools in the group
+	Total int `json:"total"`
+	// Covered is the number of tools in the group that were called
+	Covered int `json:"covered"`
+	// Percent is Covered as a percentage of Total
+	Percent float64 `json:"percent"`
+	// Calls maps each covered tool to the number of steps that called it
+	Calls map[string]int `json:"calls,omitempty"`
+	// Uncovered lists the tools in the group that no step called
+	Uncovered []string `json:"uncovered,omitempty"`
+}
+
+// BuildToolCoverage computes tool coverage from scenario results. The set of
+// available tools is the union of the tools each scenario's instance exposed
+// after startup and the tools its steps called, so tools created during a
+// scenario (e.g. workflow_* tools) are counted as well. Test framework tools
+// are not aggregator tools and are ignored.
+func BuildToolCoverage(results []TestScenarioResult) *ToolCoverageReport {
+	available := make(map[string]bool)
+	calls := make(map[string]int)
+
+	for _, sr := range results {
+		for _, tool := range sr.AvailableTools {
+			if !IsTestTool(tool) {
+				available[tool] = true
+			}
+		}
+		for _, step := range sr.StepResults {
+			tool := step.Step.Tool
+			if tool == "" || IsTestTool(tool) || step.Result == ResultSkipped {
+				continue
+			}
+			available[tool] = true
+			calls[tool]++
+		}
+	}
+
+	groups := make(map[string]*ToolCoverageGroup)
+	for tool := range available {
+		name, kind := coverageGroup(tool)
+		g, ok := groups[name]
+		if !ok {
+			g = &ToolCoverageGroup{Name: name, Kind: kind, Calls: make(map[string]int)}
+			groups[name] = g
+		}
+		g.Total++
+		if n := calls[tool]; n > 0 {
+			g.Covered++
+			g.Calls[tool] = n
+		} else {
+			g.Uncovered = append(g.Uncovered, tool)
+		}
+	}
+
+	report := &ToolCoverageReport{Groups: make([]ToolCoverageGroup, 0, len(groups))}
+	for _, g := range groups {
+		sort.Strings(g.Uncovered)
+		g.Percent = coveragePercent(g.Covered, g.Total)
+		report.TotalTools += g.Total
+		report.CoveredTools += g.Covered
+		report.Groups = append(report.Groups, *g)
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		if report.Groups[i].Kind != report.Groups[j].Kind {
+			return report.Groups[i].Kind < report.Groups[j].Kind
+		}
+		return report.Groups[i].Name < report.Groups[j].Name
+	})
+	report.Percent = coveragePercent(report.CoveredTools, report.TotalTools)
+	return report
+}
+
+// coverageGroup maps a tool name to its coverage group. Core tools are grouped
+// by API area (core_mcpserver_list -> core_mcpserver) and server tools by the
+// server name embedded in the default x_<server>_<tool> prefix.
+func coverageGroup(tool string) (name, kind string) {
+	switch {
+	case strings.HasPrefix(tool, "core_"):
+		parts := strings.SplitN(tool, "_", 3)
+		return "core_" + parts[1], CoverageKindCore
+	case strings.HasPrefix(tool, "workflow_"):
+		return "workflows", CoverageKindWorkflow
+	case strings.HasPrefix(tool, "x_"):
+		parts := strings.SplitN(tool, "_", 3)
+		if len(parts) == 3 {
+			return parts[1], CoverageKindServer
+		}
+	}
+	return "other", CoverageKindOther
+}
+
+func coveragePercent(covered, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(covered) / float64(total) * 100
+}
+
+// PrintToolCoverage writes a human-readable coverage summary. With verbose set,
+// the uncovered tools of each group are listed as well.
+func PrintToolCoverage(out io.Writer, report *ToolCoverageReport, verbose bool) {
+	_, _ = fmt.Fprintf(out, "\n🧭 Tool Coverage: %d/%d tools (%.1f%%)\n", report.CoveredTools, report.TotalTools, report.Percent)
+	for _, g := range report.Groups {
+		_, _ = fmt.Fprintf(out, "   %-9s %-30s %3d/%-3d %5.1f%%\n", g.Kind, g.Name, g.Covered, g.Total, g.Percent)
+		if verbose {
+			for _, tool := range g.Uncovered {
+				_, _ = fmt.Fprintf(out, "             ✗ %s\n", tool)
+			}
+		}
+	}
+}
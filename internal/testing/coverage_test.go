@@ -0,0 +1,95 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stepResult(tool string, result TestResult) TestStepResult {
+	return TestStepResult{Step: TestStep{Tool: tool}, Result: result}
+}
+
+func TestBuildToolCoverage(t *testing.T) {
+	results := []TestScenarioResult{
+		{
+			AvailableTools: []string{
+				"core_workflow_list", "core_workflow_create",
+				"core_mcpserver_list",
+				"x_server-alpha_alpha_operation", "x_server-alpha_alpha_other",
+			},
+			StepResults: []TestStepResult{
+				stepResult("core_workflow_create", ResultPassed),
+				stepResult("workflow_deploy", ResultPassed),
+				stepResult(TestToolCreateUser, ResultPassed),
+			},
+		},
+		{
+			AvailableTools: []string{"core_workflow_list", "x_server-beta_beta_operation"},
+			StepResults: []TestStepResult{
+				stepResult("core_workflow_create", ResultFailed),
+				stepResult("x_server-alpha_alpha_operation", ResultPassed),
+				stepResult("core_mcpserver_list", ResultSkipped),
+			},
+		},
+	}
+
+	report := BuildToolCoverage(results)
+
+	assert.Equal(t, 7, report.TotalTools)
+	assert.Equal(t, 3, report.CoveredTools)
+	assert.InDelta(t, 42.86, report.Percent, 0.01)
+
+	groups := make(map[string]ToolCoverageGroup)
+	for _, g := range report.Groups {
+		groups[g.Name] = g
+	}
+	require.Len(t, groups, 5)
+
+	wf := groups["core_workflow"]
+	assert.Equal(t, CoverageKindCore, wf.Kind)
+	assert.Equal(t, 2, wf.Total)
+	assert.Equal(t, map[string]int{"core_workflow_create": 2}, wf.Calls)
+	assert.Equal(t, []string{"core_workflow_list"}, wf.Uncovered)
+
+	mcp := groups["core_mcpserver"]
+	assert.Equal(t, 0, mcp.Covered)
+	assert.Equal(t, []string{"core_mcpserver_list"}, mcp.Uncovered)
+
+	alpha := groups["server-alpha"]
+	assert.Equal(t, CoverageKindServer, alpha.Kind)
+	assert.Equal(t, 1, alpha.Covered)
+	assert.Equal(t, []string{"x_server-alpha_alpha_other"}, alpha.Uncovered)
+
+	assert.Equal(t, 0, groups["server-beta"].Covered)
+
+	workflows := groups["workflows"]
+	assert.Equal(t, CoverageKindWorkflow, workflows.Kind)
+	assert.InDelta(t, 100, workflows.Percent, 0.001)
+}
+
+func TestBuildToolCoverageEmpty(t *testing.T) {
+	report := BuildToolCoverage(nil)
+	assert.Equal(t, 0, report.TotalTools)
+	assert.Zero(t, report.Percent)
+	assert.Empty(t, report.Groups)
+}
+
+func TestCoverageGroup(t *testing.T) {
+	tests := []struct {
+		tool, name, kind string
+	}{
+		{"core_service_list", "core_service", CoverageKindCore},
+		{"core_events", "core_events", CoverageKindCore},
+		{"workflow_deploy-app", "workflows", CoverageKindWorkflow},
+		{"x_kubernetes_get_pods", "kubernetes", CoverageKindServer},
+		{"x_incomplete", "other", CoverageKindOther},
+		{"custom_tool", "other", CoverageKindOther},
+	}
+	for _, tt := range tests {
+		name, kind := coverageGroup(tt.tool)
+		assert.Equal(t, tt.name, name, tt.tool)
+		assert.Equal(t, tt.kind, kind, tt.tool)
+	}
+}
@@ -76,6 +76,28 @@ func NewTestFrameworkForMode(mode ExecutionMode, verbose, debug bool, basePort i
 	}, nil
 }
 
+// EnableKubernetes runs all scenarios against muster in Kubernetes CRD mode,
+// using either an envtest control plane or the current kubeconfig cluster.
+func (tf *TestFramework) EnableKubernetes(mode string) error {
+	manager, ok := tf.InstanceManager.(*musterInstanceManager)
+	if !ok {
+		return fmt.Errorf("kubernetes mode is not supported by this instance manager")
+	}
+	return manager.EnableKubernetes(mode)
+}
+
+// EnableInstanceReuse keeps up to maxIdle muster instances running after
+// their scenario and reuses them for scenarios with identical
+// pre-configuration.
+func (tf *TestFramework) EnableInstanceReuse(maxIdle int) error {
+	manager, ok := tf.InstanceManager.(*musterInstanceManager)
+	if !ok {
+		return fmt.Errorf("instance reuse is not supported by this instance manager")
+	}
+	manager.EnableInstanceReuse(maxIdle)
+	return nil
+}
+
 // Cleanup cleans up resources used by the test framework
 func (tf *TestFramework) Cleanup() error {
 	if manager, ok := tf.InstanceManager.(*musterInstanceManager); ok {
@@ -0,0 +1,175 @@
+package testing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// QuarantineEntry is a scenario that is skipped until it is fixed.
+type QuarantineEntry struct {
+	// Name is the scenario name
+	Name string `yaml:"name"`
+	// Reason explains why the scenario is quarantined, e.g. an issue link
+	Reason string `yaml:"reason,omitempty"`
+}
+
+// quarantineFile is the format of a quarantine list file.
+type quarantineFile struct {
+	Scenarios []QuarantineEntry `yaml:"scenarios"`
+}
+
+// LoadQuarantine reads a quarantine list and returns the reason for each
+// quarantined scenario by name.
+func LoadQuarantine(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to read quarantine list: %w", err)
+	}
+	var file quarantineFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse quarantine list %s: %w", path, err)
+	}
+	quarantine := make(map[string]string, len(file.Scenarios))
+	for i, entry := range file.Scenarios {
+		if entry.Name == "" {
+			return nil, fmt.Errorf("quarantine list %s: entry %d has no name", path, i+1)
+		}
+		quarantine[entry.Name] = entry.Reason
+	}
+	return quarantine, nil
+}
+
+// FlakeRecord accumulates the outcomes of a scenario across test runs.
+type FlakeRecord struct {
+	// Runs is the number of runs the scenario was executed in
+	Runs int `json:"runs"`
+	// Failures is the number of runs whose first attempt did not pass
+	Failures int `json:"failures"`
+	// Flakes is the number of runs that passed only after a retry
+	Flakes int `json:"flakes"`
+	// LastFailure is when the first attempt last did not pass
+	LastFailure time.Time `json:"last_failure,omitempty"`
+	// LastError is the error of the last failed attempt
+	LastError string `json:"last_error,omitempty"`
+}
+
+// FailureRate returns the percentage of runs whose first attempt failed.
+func (r FlakeRecord) FailureRate() float64 {
+	return coveragePercent(r.Failures, r.Runs)
+}
+
+// FlakeDB is a persistent record of scenario failure rates, stored as JSON so
+// CI can cache it between runs.
+type FlakeDB struct {
+	path string
+	// Scenarios holds the record of each scenario by name
+	Scenarios map[string]*FlakeRecord `json:"scenarios"`
+	// UpdatedAt is when the database was last saved
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LoadFlakeDB reads the flake database at path. A missing file yields an
+// empty database that is created on Save.
+func LoadFlakeDB(path string) (*FlakeDB, error) {
+	db := &FlakeDB{path: path, Scenarios: make(map[string]*FlakeRecord)}
+	data, err := os.ReadFile(path) //nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return db, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read flake database: %w", err)
+	}
+	if err := json.Unmarshal(data, db); err != nil {
+		return nil, fmt.Errorf("failed to parse flake database %s: %w", path, err)
+	}
+	if db.Scenarios == nil {
+		db.Scenarios = make(map[string]*FlakeRecord)
+	}
+	return db, nil
+}
+
+// Record adds the outcomes of a test run. Skipped scenarios are not counted.
+func (db *FlakeDB) Record(result *TestSuiteResult) {
+	for _, sr := range result.ScenarioResults {
+		if sr.Result == ResultSkipped {
+			continue
+		}
+		record, ok := db.Scenarios[sr.Scenario.Name]
+		if !ok {
+			record = &FlakeRecord{}
+			db.Scenarios[sr.Scenario.Name] = record
+		}
+		record.Runs++
+		if sr.Result == ResultPassed && sr.Attempts <= 1 {
+			continue
+		}
+		record.Failures++
+		record.LastFailure = sr.EndTime
+		if sr.Flaky {
+			record.Flakes++
+		}
+		if len(sr.FailedAttempts) > 0 {
+			record.LastError = sr.FailedAttempts[len(sr.FailedAttempts)-1]
+		} else {
+			record.LastError = sr.Error
+		}
+	}
+}
+
+// Save writes the database back to its file.
+func (db *FlakeDB) Save() error {
+	db.UpdatedAt = time.Now()
+	data, err := json.MarshalIndent(db, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal flake database: %w", err)
+	}
+	if dir := filepath.Dir(db.path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec
+			return fmt.Errorf("failed to create flake database directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(db.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write flake database: %w", err)
+	}
+	return nil
+}
+
+// PrintFlakeSummary lists the scenarios of a run that have failed before,
+// ordered by failure rate, as candidates for fixing or quarantine.
+func PrintFlakeSummary(out io.Writer, db *FlakeDB, result *TestSuiteResult) {
+	var names []string
+	for _, sr := range result.ScenarioResults {
+		if record, ok := db.Scenarios[sr.Scenario.Name]; ok && record.Failures > 0 {
+			names = append(names, sr.Scenario.Name)
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	sort.Slice(names, func(i, j int) bool {
+		ri, rj := db.Scenarios[names[i]].FailureRate(), db.Scenarios[names[j]].FailureRate()
+		if ri != rj {
+			return ri > rj
+		}
+		return names[i] < names[j]
+	})
+
+	_, _ = fmt.Fprintf(out, "\n🔁 Failure history (%s):\n", db.path)
+	for _, name := range names {
+		record := db.Scenarios[name]
+		_, _ = fmt.Fprintf(out, "   %-50s %5.1f%% failed, %d/%d runs, %d passed on retry\n",
+			name, record.FailureRate(), record.Failures, record.Runs, record.Flakes)
+		if record.LastError != "" {
+			_, _ = fmt.Fprintf(out, "      last error: %s\n", strings.SplitN(record.LastError, "\n", 2)[0])
+		}
+	}
+}
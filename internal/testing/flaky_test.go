@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadQuarantine(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "quarantine.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`scenarios:
+  - name: oauth-token-refresh
+    reason: "https://github.com/giantswarm/muster/issues/1"
+  - name: workflow-timeout
+`), 0o600))
+
+	quarantine, err := LoadQuarantine(file)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"oauth-token-refresh": "https://github.com/giantswarm/muster/issues/1",
+		"workflow-timeout":    "",
+	}, quarantine)
+
+	require.NoError(t, os.WriteFile(file, []byte("scenarios:\n  - reason: missing name\n"), 0o600))
+	_, err = LoadQuarantine(file)
+	assert.ErrorContains(t, err, "entry 1 has no name")
+
+	_, err = LoadQuarantine(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestFlakeDB(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ci", "flakes.json")
+
+	db, err := LoadFlakeDB(file)
+	require.NoError(t, err)
+	assert.Empty(t, db.Scenarios)
+
+	run := &TestSuiteResult{ScenarioResults: []TestScenarioResult{
+		{Scenario: TestScenario{Name: "stable"}, Result: ResultPassed, Attempts: 1},
+		{Scenario: TestScenario{Name: "flaky"}, Result: ResultPassed, Attempts: 2, Flaky: true, FailedAttempts: []string{"step list: timeout\ndetails"}},
+		{Scenario: TestScenario{Name: "broken"}, Result: ResultFailed, Attempts: 3, FailedAttempts: []string{"a", "b"}, Error: "c"},
+		{Scenario: TestScenario{Name: "quarantined"}, Result: ResultSkipped, Quarantined: true},
+	}}
+	db.Record(run)
+	require.NoError(t, db.Save())
+
+	db, err = LoadFlakeDB(file)
+	require.NoError(t, err)
+	db.Record(&TestSuiteResult{ScenarioResults: []TestScenarioResult{
+		{Scenario: TestScenario{Name: "flaky"}, Result: ResultPassed, Attempts: 1},
+	}})
+
+	assert.NotContains(t, db.Scenarios, "quarantined")
+	assert.Equal(t, FlakeRecord{Runs: 1}, *db.Scenarios["stable"])
+	flaky := db.Scenarios["flaky"]
+	assert.Equal(t, 2, flaky.Runs)
+	assert.Equal(t, 1, flaky.Failures)
+	assert.Equal(t, 1, flaky.Flakes)
+	assert.Equal(t, 50.0, flaky.FailureRate())
+	assert.Equal(t, "b", db.Scenarios["broken"].LastError)
+	assert.Equal(t, 0, db.Scenarios["broken"].Flakes)
+
+	var buf bytes.Buffer
+	PrintFlakeSummary(&buf, db, run)
+	out := buf.String()
+	assert.NotContains(t, out, "stable")
+	assert.Less(t, bytes.Index(buf.Bytes(), []byte("broken")), bytes.Index(buf.Bytes(), []byte("flaky")))
+	assert.Contains(t, out, "last error: step list: timeout\n")
+}
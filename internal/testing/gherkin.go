@@ -0,0 +1,506 @@
+package testing
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Gherkin support: .feature files are parsed into TestScenarios so that
+// BDD-style specs run under `muster test` next to the YAML scenarios. Only a
+// constrained subset of Gherkin is understood:
+//
+//	@category:behavioral @concept:workflow
+//	Feature: Workflow execution
+//
+//	  Background:
+//	    Given a workflow "greet":
+//	      """
+//	      name: greet
+//	      steps: ...
+//	      """
+//
+//	  @smoke
+//	  Scenario: Executing a workflow
+//	    When I call "workflow_greet" with:
+//	      | name | world |
+//	    Then it succeeds
+//	    And the response contains "hello world"
+//	    And "status" is "completed"
+//
+// Given steps pre-configure the muster instance (MCP servers, workflows,
+// main config; a doc string holds the YAML definition). Each When step is a
+// tool call, with args from a YAML doc string or a two-column table, and the
+// Then steps that follow it are its expectations. A When step is expected to
+// succeed unless a Then step says otherwise. Feature and scenario tags become
+// the scenario's tags, except @category:<category>, @concept:<concept>, and
+// @skip, which set the fields of the same name.
+
+// Patterns of the supported steps. Quoted strings are Go string literals.
+const gherkinQuoted = `"((?:[^"\\]|\\.)*)"`
+
+var (
+	givenMCPServerPattern  = regexp.MustCompile(`^(?:an? |the )?MCP server ` + gherkinQuoted + `:?$`)
+	givenWorkflowPattern   = regexp.MustCompile(`^(?:an? |the )?workflow ` + gherkinQuoted + `:?$`)
+	givenMainConfigPattern = regexp.MustCompile(`^(?:the )?muster config(?:uration)?:?$`)
+
+	whenCallPattern = regexp.MustCompile(`^I call ` + gherkinQuoted + `(?: as ` + gherkinQuoted + `)?(?: with)?:?$`)
+
+	thenSucceedsPattern       = regexp.MustCompile(`^it succeeds$`)
+	thenFailsPattern          = regexp.MustCompile(`^it fails(?: with ` + gherkinQuoted + `)?$`)
+	thenContainsPattern       = regexp.MustCompile(`^the response contains ` + gherkinQuoted + `$`)
+	thenNotContainsPattern    = regexp.MustCompile(`^the response does not contain ` + gherkinQuoted + `$`)
+	thenJSONPathEqualsPattern = regexp.MustCompile(`^` + gherkinQuoted + ` (?:is|equals) (.+)$`)
+)
+
+// isFeatureFile checks if a file is a Gherkin feature file
+func isFeatureFile(path string) bool {
+	return strings.ToLower(filepath.Ext(path)) == ".feature"
+}
+
+// gherkinStep is a step of a feature file with its doc string or data table.
+type gherkinStep struct {
+	line    int
+	keyword string // Given, When, or Then; And and But take the previous one
+	text    string
+	docText *string
+	table   [][]string
+}
+
+// parseFeature parses the scenarios of a feature file.
+func parseFeature(content []byte) ([]TestScenario, error) {
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+
+	var (
+		featureSeen  bool
+		featureTitle string
+		featureTags  []string
+		pendingTags  []string
+		background   MusterPreConfiguration
+		inBackground bool
+		current      *TestScenario
+		currentLine  int
+		lastCall     *TestStep
+		lastKeyword  string
+		scenarios    []TestScenario
+	)
+
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		if current.Category == "" {
+			return fmt.Errorf("line %d: scenario %q has no @category:<category> tag", currentLine, current.Name)
+		}
+		if current.Concept == "" {
+			return fmt.Errorf("line %d: scenario %q has no @concept:<concept> tag", currentLine, current.Name)
+		}
+		if len(current.Steps) == 0 {
+			return fmt.Errorf("line %d: scenario %q has no When step", currentLine, current.Name)
+		}
+		scenarios = append(scenarios, *current)
+		current = nil
+		return nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		text := strings.TrimSpace(lines[i])
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		keyword, rest, isKeyword := strings.Cut(text, ":")
+		switch {
+		case strings.HasPrefix(text, "@"):
+			pendingTags = append(pendingTags, strings.Fields(text)...)
+			continue
+
+		case isKeyword && keyword == "Feature":
+			if featureSeen {
+				return nil, fmt.Errorf("line %d: a file holds a single Feature", lineNo)
+			}
+			featureSeen = true
+			featureTitle = strings.TrimSpace(rest)
+			featureTags, pendingTags = pendingTags, nil
+			continue
+
+		case isKeyword && keyword == "Background":
+			if !featureSeen || current != nil {
+				return nil, fmt.Errorf("line %d: Background must follow Feature and precede the scenarios", lineNo)
+			}
+			inBackground = true
+			lastKeyword = ""
+			continue
+
+		case isKeyword && (keyword == "Scenario" || keyword == "Example"):
+			if !featureSeen {
+				return nil, fmt.Errorf("line %d: Scenario outside of a Feature", lineNo)
+			}
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			title := strings.TrimSpace(rest)
+			current = &TestScenario{
+				Name:        slugify(title),
+				Description: title,
+			}
+			if featureTitle != "" {
+				current.Description = featureTitle + ": " + title
+			}
+			if hasPreConfiguration(background) {
+				pre := clonePreConfiguration(background)
+				current.PreConfiguration = &pre
+			}
+			if err := applyFeatureTags(current, append(append([]string{}, featureTags...), pendingTags...)); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			currentLine = lineNo
+			inBackground = false
+			pendingTags = nil
+			lastCall = nil
+			lastKeyword = ""
+			continue
+
+		case isKeyword && (keyword == "Scenario Outline" || keyword == "Scenario Template" || keyword == "Examples" || keyword == "Rule"):
+			return nil, fmt.Errorf("line %d: %s is not supported", lineNo, keyword)
+		}
+
+		stepKeyword, stepText, isStep := splitStepKeyword(text)
+		if !isStep {
+			// Free text before the first step describes the Feature or
+			// Scenario
+			if featureSeen && !inBackground && (current == nil || lastKeyword == "") {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: expected a step, got %q", lineNo, text)
+		}
+		if stepKeyword == "And" || stepKeyword == "But" || stepKeyword == "*" {
+			if lastKeyword == "" {
+				return nil, fmt.Errorf("line %d: %s must follow another step", lineNo, stepKeyword)
+			}
+			stepKeyword = lastKeyword
+		}
+		lastKeyword = stepKeyword
+
+		step := gherkinStep{line: lineNo, keyword: stepKeyword, text: stepText}
+		next, err := readStepArgument(lines, i+1, &step)
+		if err != nil {
+			return nil, err
+		}
+		i = next - 1
+
+		switch {
+		case inBackground:
+			if step.keyword != "Given" {
+				return nil, fmt.Errorf("line %d: Background only holds Given steps", lineNo)
+			}
+			if err := applyGiven(&background, step); err != nil {
+				return nil, err
+			}
+		case current == nil:
+			return nil, fmt.Errorf("line %d: step outside of a Scenario", lineNo)
+		case step.keyword == "Given":
+			if current.PreConfiguration == nil {
+				current.PreConfiguration = &MusterPreConfiguration{}
+			}
+			if err := applyGiven(current.PreConfiguration, step); err != nil {
+				return nil, err
+			}
+		case step.keyword == "When":
+			call, err := parseWhen(step, len(current.Steps)+1)
+			if err != nil {
+				return nil, err
+			}
+			current.Steps = append(current.Steps, call)
+			lastCall = &current.Steps[len(current.Steps)-1]
+		case step.keyword == "Then":
+			if lastCall == nil {
+				return nil, fmt.Errorf("line %d: Then must follow a When step", lineNo)
+			}
+			if err := applyThen(&lastCall.Expected, step); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	if !featureSeen {
+		return nil, fmt.Errorf("no Feature found")
+	}
+	if len(scenarios) == 0 {
+		return nil, fmt.Errorf("feature has no scenarios")
+	}
+	return scenarios, nil
+}
+
+// splitStepKeyword splits a step line into its keyword and text.
+func splitStepKeyword(text string) (string, string, bool) {
+	for _, keyword := range []string{"Given", "When", "Then", "And", "But", "*"} {
+		if rest, ok := strings.CutPrefix(text, keyword+" "); ok {
+			return keyword, strings.TrimSpace(rest), true
+		}
+	}
+	return "", "", false
+}
+
+// readStepArgument reads the doc string or data table following a step,
+// starting at line index start, and returns the index of the next line.
+func readStepArgument(lines []string, start int, step *gherkinStep) (int, error) {
+	i := start
+	for i < len(lines) && strings.TrimSpace(lines[i]) == "" {
+		i++
+	}
+	if i >= len(lines) {
+		return start, nil
+	}
+
+	trimmed := strings.TrimSpace(lines[i])
+	switch {
+	case strings.HasPrefix(trimmed, `"""`) || strings.HasPrefix(trimmed, "```"):
+		delimiter := trimmed[:3]
+		indent := len(lines[i]) - len(strings.TrimLeft(lines[i], " \t"))
+		var body []string
+		for j := i + 1; j < len(lines); j++ {
+			if strings.TrimSpace(lines[j]) == delimiter {
+				text := strings.Join(body, "\n")
+				step.docText = &text
+				return j + 1, nil
+			}
+			line := lines[j]
+			// Doc string lines lose the indentation of the opening delimiter
+			if len(line)-len(strings.TrimLeft(line, " \t")) >= indent {
+				line = line[indent:]
+			} else {
+				line = strings.TrimLeft(line, " \t")
+			}
+			body = append(body, line)
+		}
+		return 0, fmt.Errorf("line %d: unterminated doc string", i+1)
+
+	case strings.HasPrefix(trimmed, "|"):
+		for ; i < len(lines); i++ {
+			row := strings.TrimSpace(lines[i])
+			if !strings.HasPrefix(row, "|") {
+				break
+			}
+			if !strings.HasSuffix(row, "|") || len(row) < 2 {
+				return 0, fmt.Errorf("line %d: malformed table row", i+1)
+			}
+			var cells []string
+			for _, cell := range strings.Split(row[1:len(row)-1], "|") {
+				cells = append(cells, strings.TrimSpace(cell))
+			}
+			step.table = append(step.table, cells)
+		}
+		return i, nil
+	}
+	return start, nil
+}
+
+// applyGiven adds the definition of a Given step to pre.
+func applyGiven(pre *MusterPreConfiguration, step gherkinStep) error {
+	definition := func() (map[string]interface{}, error) {
+		if step.docText == nil {
+			return nil, fmt.Errorf("line %d: %q needs a doc string with the YAML definition", step.line, step.text)
+		}
+		var config map[string]interface{}
+		if err := yaml.Unmarshal([]byte(*step.docText), &config); err != nil {
+			return nil, fmt.Errorf("line %d: invalid YAML definition: %w", step.line, err)
+		}
+		return config, nil
+	}
+
+	switch {
+	case givenMCPServerPattern.MatchString(step.text):
+		name, err := unquote(givenMCPServerPattern.FindStringSubmatch(step.text)[1])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", step.line, err)
+		}
+		config, err := definition()
+		if err != nil {
+			return err
+		}
+		pre.MCPServers = append(pre.MCPServers, MCPServerConfig{Name: name, Config: config})
+
+	case givenWorkflowPattern.MatchString(step.text):
+		name, err := unquote(givenWorkflowPattern.FindStringSubmatch(step.text)[1])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", step.line, err)
+		}
+		config, err := definition()
+		if err != nil {
+			return err
+		}
+		pre.Workflows = append(pre.Workflows, WorkflowConfig{Name: name, Config: config})
+
+	case givenMainConfigPattern.MatchString(step.text):
+		config, err := definition()
+		if err != nil {
+			return err
+		}
+		pre.MainConfig = &MainConfig{Config: config}
+
+	default:
+		return fmt.Errorf("line %d: unsupported Given step %q", step.line, step.text)
+	}
+	return nil
+}
+
+// parseWhen parses a When step into the number-th step of a scenario.
+func parseWhen(step gherkinStep, number int) (TestStep, error) {
+	match := whenCallPattern.FindStringSubmatch(step.text)
+	if match == nil {
+		return TestStep{}, fmt.Errorf("line %d: unsupported When step %q, expected I call \"<tool>\"", step.line, step.text)
+	}
+	tool, err := unquote(match[1])
+	if err != nil {
+		return TestStep{}, fmt.Errorf("line %d: %w", step.line, err)
+	}
+	asUser, err := unquote(match[2])
+	if err != nil {
+		return TestStep{}, fmt.Errorf("line %d: %w", step.line, err)
+	}
+
+	args := map[string]interface{}{}
+	switch {
+	case step.docText != nil:
+		if err := yaml.Unmarshal([]byte(*step.docText), &args); err != nil {
+			return TestStep{}, fmt.Errorf("line %d: invalid YAML args: %w", step.line, err)
+		}
+	case step.table != nil:
+		for _, row := range step.table {
+			if len(row) != 2 {
+				return TestStep{}, fmt.Errorf("line %d: args tables have two columns, name and value", step.line)
+			}
+			args[row[0]] = parseScalar(row[1])
+		}
+	}
+
+	return TestStep{
+		ID:          fmt.Sprintf("step-%d-%s", number, slugify(tool)),
+		Description: step.text,
+		Tool:        tool,
+		Args:        args,
+		AsUser:      asUser,
+		Expected:    TestExpectation{Success: true},
+	}, nil
+}
+
+// applyThen adds the expectation of a Then step to expected.
+func applyThen(expected *TestExpectation, step gherkinStep) error {
+	text := step.text
+	var err error
+	switch {
+	case thenSucceedsPattern.MatchString(text):
+		expected.Success = true
+
+	case thenFailsPattern.MatchString(text):
+		expected.Success = false
+		if message := thenFailsPattern.FindStringSubmatch(text)[1]; message != "" {
+			message, err = unquote(message)
+			expected.ErrorContains = append(expected.ErrorContains, message)
+		}
+
+	case thenContainsPattern.MatchString(text):
+		var s string
+		s, err = unquote(thenContainsPattern.FindStringSubmatch(text)[1])
+		expected.Contains = append(expected.Contains, s)
+
+	case thenNotContainsPattern.MatchString(text):
+		var s string
+		s, err = unquote(thenNotContainsPattern.FindStringSubmatch(text)[1])
+		expected.NotContains = append(expected.NotContains, s)
+
+	case thenJSONPathEqualsPattern.MatchString(text):
+		match := thenJSONPathEqualsPattern.FindStringSubmatch(text)
+		var path string
+		path, err = unquote(match[1])
+		if expected.JSONPath == nil {
+			expected.JSONPath = make(map[string]interface{})
+		}
+		expected.JSONPath[path] = parseScalar(match[2])
+
+	default:
+		return fmt.Errorf("line %d: unsupported Then step %q", step.line, text)
+	}
+	if err != nil {
+		return fmt.Errorf("line %d: %w", step.line, err)
+	}
+	return nil
+}
+
+// applyFeatureTags sets the category, concept, skip flag, and tags of a
+// scenario from its tags.
+func applyFeatureTags(scenario *TestScenario, tags []string) error {
+	for _, tag := range tags {
+		tag = strings.TrimPrefix(tag, "@")
+		switch {
+		case strings.HasPrefix(tag, "category:"):
+			scenario.Category = TestCategory(strings.TrimPrefix(tag, "category:"))
+		case strings.HasPrefix(tag, "concept:"):
+			scenario.Concept = TestConcept(strings.TrimPrefix(tag, "concept:"))
+		case tag == "skip":
+			scenario.Skip = true
+		case tag == "":
+			return fmt.Errorf("empty tag")
+		default:
+			scenario.Tags = append(scenario.Tags, tag)
+		}
+	}
+	return nil
+}
+
+// parseScalar parses a table cell or expected value as a YAML scalar, so
+// 3 is a number, true a boolean, and "3" a string.
+func parseScalar(s string) interface{} {
+	var value interface{}
+	if err := yaml.Unmarshal([]byte(s), &value); err != nil || value == nil {
+		return s
+	}
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return s
+	}
+	return value
+}
+
+// unquote unescapes the content of a quoted string of a step.
+func unquote(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	unquoted, err := strconv.Unquote(`"` + s + `"`)
+	if err != nil {
+		return "", fmt.Errorf("invalid quoted string %q: %w", s, err)
+	}
+	return unquoted, nil
+}
+
+// slugifyPattern matches the runs of characters replaced by slugify.
+var slugifyPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify turns a title into a scenario or step name: lower case letters and
+// digits separated by dashes.
+func slugify(s string) string {
+	return strings.Trim(slugifyPattern.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
+
+// hasPreConfiguration reports whether the Given steps of pre define anything.
+func hasPreConfiguration(pre MusterPreConfiguration) bool {
+	return len(pre.MCPServers) > 0 || len(pre.Workflows) > 0 || pre.MainConfig != nil
+}
+
+// clonePreConfiguration copies the definition lists of pre, so a scenario's
+// Given steps do not add to the Background of the other scenarios.
+func clonePreConfiguration(pre MusterPreConfiguration) MusterPreConfiguration {
+	pre.MCPServers = append([]MCPServerConfig(nil), pre.MCPServers...)
+	pre.Workflows = append([]WorkflowConfig(nil), pre.Workflows...)
+	return pre
+}
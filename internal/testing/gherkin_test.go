@@ -0,0 +1,180 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const greetFeature = `# Workflows greet
+@category:behavioral @concept:workflow
+Feature: Workflow execution
+  Workflows run their steps and return the result.
+
+  Background:
+    Given a workflow "greet":
+      """
+      name: greet
+      steps:
+        - id: say
+          tool: core_echo
+      """
+
+  @smoke
+  Scenario: Executing a workflow
+    When I call "workflow_greet" with:
+      | name     | world |
+      | replicas | 3     |
+      | quoted   | "3"   |
+    Then it succeeds
+    And the response contains "hello \"world\""
+    But the response does not contain "error"
+    And "steps[0].status" is "completed"
+    And "count" equals 2
+
+  @skip @category:integration
+  Scenario: Executing a workflow as another user
+    Given an MCP server "git":
+      """
+      type: stdio
+      command: mcp-git
+      """
+    When I call "workflow_greet" as "alice" with:
+      """
+      name: alice
+      """
+    Then it fails with "forbidden"
+    When I call "core_workflow_list"
+`
+
+func TestParseFeature(t *testing.T) {
+	scenarios, err := parseFeature([]byte(greetFeature))
+	require.NoError(t, err)
+	require.Len(t, scenarios, 2)
+
+	first := scenarios[0]
+	assert.Equal(t, "executing-a-workflow", first.Name)
+	assert.Equal(t, "Workflow execution: Executing a workflow", first.Description)
+	assert.Equal(t, CategoryBehavioral, first.Category)
+	assert.Equal(t, ConceptWorkflow, first.Concept)
+	assert.Equal(t, []string{"smoke"}, first.Tags)
+	assert.False(t, first.Skip)
+
+	require.NotNil(t, first.PreConfiguration)
+	require.Len(t, first.PreConfiguration.Workflows, 1)
+	assert.Equal(t, "greet", first.PreConfiguration.Workflows[0].Name)
+	assert.Equal(t, "greet", first.PreConfiguration.Workflows[0].Config["name"])
+
+	require.Len(t, first.Steps, 1)
+	step := first.Steps[0]
+	assert.Equal(t, "step-1-workflow-greet", step.ID)
+	assert.Equal(t, "workflow_greet", step.Tool)
+	assert.Equal(t, map[string]interface{}{"name": "world", "replicas": 3, "quoted": "3"}, step.Args)
+	assert.True(t, step.Expected.Success)
+	assert.Equal(t, []string{`hello "world"`}, step.Expected.Contains)
+	assert.Equal(t, []string{"error"}, step.Expected.NotContains)
+	assert.Equal(t, map[string]interface{}{"steps[0].status": "completed", "count": 2}, step.Expected.JSONPath)
+
+	second := scenarios[1]
+	assert.Equal(t, CategoryIntegration, second.Category, "scenario tags override feature tags")
+	assert.True(t, second.Skip)
+	require.NotNil(t, second.PreConfiguration)
+	assert.Len(t, second.PreConfiguration.Workflows, 1)
+	require.Len(t, second.PreConfiguration.MCPServers, 1)
+	assert.Equal(t, "mcp-git", second.PreConfiguration.MCPServers[0].Config["command"])
+	assert.Empty(t, first.PreConfiguration.MCPServers, "a scenario's Given steps do not leak into the others")
+
+	require.Len(t, second.Steps, 2)
+	assert.Equal(t, "alice", second.Steps[0].AsUser)
+	assert.Equal(t, map[string]interface{}{"name": "alice"}, second.Steps[0].Args)
+	assert.False(t, second.Steps[0].Expected.Success)
+	assert.Equal(t, []string{"forbidden"}, second.Steps[0].Expected.ErrorContains)
+	assert.True(t, second.Steps[1].Expected.Success, "a call is expected to succeed by default")
+}
+
+func TestParseFeature_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		feature string
+		want    string
+	}{
+		{
+			name:    "no feature",
+			feature: "Scenario: x\n  When I call \"a\"\n",
+			want:    "Scenario outside of a Feature",
+		},
+		{
+			name:    "outline",
+			feature: "@category:behavioral @concept:workflow\nFeature: f\n  Scenario Outline: x\n",
+			want:    "line 3: Scenario Outline is not supported",
+		},
+		{
+			name:    "missing category",
+			feature: "@concept:workflow\nFeature: f\n  Scenario: x\n    When I call \"a\"\n",
+			want:    `scenario "x" has no @category:<category> tag`,
+		},
+		{
+			name:    "then without when",
+			feature: "@category:behavioral @concept:workflow\nFeature: f\n  Scenario: x\n    Then it succeeds\n",
+			want:    "line 4: Then must follow a When step",
+		},
+		{
+			name:    "unsupported step",
+			feature: "@category:behavioral @concept:workflow\nFeature: f\n  Scenario: x\n    When I call \"a\"\n    Then the moon is full\n",
+			want:    `line 5: unsupported Then step "the moon is full"`,
+		},
+		{
+			name:    "given without definition",
+			feature: "@category:behavioral @concept:workflow\nFeature: f\n  Scenario: x\n    Given a workflow \"w\"\n    When I call \"a\"\n",
+			want:    "line 4: \"a workflow \\\"w\\\"\" needs a doc string",
+		},
+		{
+			name:    "unterminated doc string",
+			feature: "@category:behavioral @concept:workflow\nFeature: f\n  Scenario: x\n    When I call \"a\" with:\n      \"\"\"\n      x: 1\n",
+			want:    "line 5: unterminated doc string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseFeature([]byte(tt.feature))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestLoadScenarios_FeatureFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "greet.feature"), []byte(greetFeature), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "list.yaml"), []byte(`name: list-workflows
+category: behavioral
+concept: workflow
+steps:
+  - id: list
+    tool: core_workflow_list
+    expected:
+      success: true
+`), 0o600))
+
+	loader := NewTestScenarioLoaderWithLogger(false, NewStdoutLogger(false, false))
+	scenarios, err := loader.LoadScenarios(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, scenario := range scenarios {
+		names = append(names, scenario.Name)
+	}
+	assert.ElementsMatch(t, []string{"executing-a-workflow", "executing-a-workflow-as-another-user", "list-workflows"}, names)
+
+	scenarios, err = loader.LoadScenarios(filepath.Join(dir, "greet.feature"))
+	require.NoError(t, err)
+	assert.Len(t, scenarios, 2)
+
+	checked, issues, err := CheckScenarioFiles(dir)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+	assert.Len(t, checked, 3)
+}
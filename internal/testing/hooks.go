@@ -0,0 +1,176 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+)
+
+// Hook phases, recorded on the results of hook steps.
+const (
+	hookBeforeScenario = "before_scenario"
+	hookAfterScenario  = "after_scenario"
+	hookBeforeEachStep = "before_each_step"
+	hookAfterEachStep  = "after_each_step"
+	hookBefore         = "before"
+	hookAfter          = "after"
+)
+
+// ScenarioHooks are tool calls that run around a scenario and around each of
+// its steps, so state can be set up and reset without repeating steps. After
+// hooks always run, even when the scenario or step failed.
+type ScenarioHooks struct {
+	// BeforeScenario runs before the first step; if it fails, the steps are
+	// skipped
+	BeforeScenario []TestStep `yaml:"before_scenario,omitempty"`
+	// AfterScenario runs after the cleanup steps
+	AfterScenario []TestStep `yaml:"after_scenario,omitempty"`
+	// BeforeEachStep runs before every step; if it fails, the step is skipped
+	BeforeEachStep []TestStep `yaml:"before_each_step,omitempty"`
+	// AfterEachStep runs after every step
+	AfterEachStep []TestStep `yaml:"after_each_step,omitempty"`
+}
+
+// allSteps returns the scenario's steps, cleanup steps, and hook steps.
+func (s TestScenario) allSteps() []TestStep {
+	var steps []TestStep
+	add := func(group []TestStep) {
+		for _, step := range group {
+			steps = append(steps, step)
+			steps = append(steps, step.Before...)
+			steps = append(steps, step.After...)
+		}
+	}
+	add(s.Steps)
+	add(s.Cleanup)
+	if s.Hooks != nil {
+		add(s.Hooks.BeforeScenario)
+		add(s.Hooks.AfterScenario)
+		add(s.Hooks.BeforeEachStep)
+		add(s.Hooks.AfterEachStep)
+	}
+	return steps
+}
+
+// hasStep reports whether the scenario has a step, cleanup step, or hook
+// step with the given ID.
+func (s TestScenario) hasStep(id string) bool {
+	for _, step := range s.allSteps() {
+		if step.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// validateHooks checks the hook steps of a scenario.
+func (l *scenarioLoader) validateHooks(hooks *ScenarioHooks) error {
+	if hooks == nil {
+		return nil
+	}
+	phases := []struct {
+		name  string
+		steps []TestStep
+	}{
+		{hookBeforeScenario, hooks.BeforeScenario},
+		{hookAfterScenario, hooks.AfterScenario},
+		{hookBeforeEachStep, hooks.BeforeEachStep},
+		{hookAfterEachStep, hooks.AfterEachStep},
+	}
+	for _, phase := range phases {
+		if err := l.validateHookSteps(phase.steps); err != nil {
+			return fmt.Errorf("%s hook %w", phase.name, err)
+		}
+	}
+	return nil
+}
+
+// validateHookSteps checks hook steps, which cannot have hooks of their own.
+func (l *scenarioLoader) validateHookSteps(steps []TestStep) error {
+	for i, step := range steps {
+		if len(step.Before) > 0 || len(step.After) > 0 {
+			return fmt.Errorf("%d: hook steps cannot have before or after hooks", i+1)
+		}
+		if err := l.validateStep(step, i); err != nil {
+			return fmt.Errorf("%d: %w", i+1, err)
+		}
+		if step.Expected.Snapshot != nil {
+			return fmt.Errorf("%d: hook steps cannot have snapshots", i+1)
+		}
+	}
+	return nil
+}
+
+// scenarioExecution runs the steps and hooks of one scenario against its
+// client, recording the step results and the first failure on the scenario
+// result.
+type scenarioExecution struct {
+	runner          *testRunner
+	ctx             context.Context
+	scenario        TestScenario
+	config          TestConfiguration
+	client          MCPTestClient
+	scenarioContext *ScenarioContext
+	testTools       *TestToolsHandler
+	chaos           *chaosMonkey
+	logger          TestLogger
+	result          *TestScenarioResult
+}
+
+// run executes a single step, labelled with its hook phase if it is a hook
+// step, and reports whether it passed.
+func (e *scenarioExecution) run(step TestStep, hook string) bool {
+	r := e.runner
+	if hook == "" && e.chaos != nil {
+		if event := e.chaos.beforeStep(e.ctx, step); event != nil {
+			e.result.ChaosEvents = append(e.result.ChaosEvents, *event)
+		}
+	}
+	stepResult := r.runStepWithTestTools(e.ctx, step, e.config, e.client, e.scenarioContext, e.testTools, e.logger)
+	if hook == "" {
+		r.checkStepSnapshot(&stepResult, e.scenario.Name, e.config, e.logger)
+	}
+	stepResult.Hook = hook
+	e.result.StepResults = append(e.result.StepResults, stepResult)
+	r.reporter.ReportStepResult(stepResult)
+
+	if stepResult.Result == ResultFailed || stepResult.Result == ResultError {
+		// Only the first failure determines the scenario result
+		if e.result.Result == ResultPassed {
+			e.result.Result = stepResult.Result
+			e.result.Error = stepResult.Error
+		}
+		return false
+	}
+	return true
+}
+
+// runHooks runs the steps of a hook phase and reports whether they all
+// passed. Before hooks stop at the first failure; after hooks always run in
+// full so their cleanup is guaranteed.
+func (e *scenarioExecution) runHooks(steps []TestStep, hook string, stopOnFailure bool) bool {
+	passed := true
+	for _, step := range steps {
+		if !e.run(step, hook) {
+			passed = false
+			if stopOnFailure {
+				break
+			}
+		}
+	}
+	return passed
+}
+
+// runStep executes a scenario step with its own and the scenario's per-step
+// hooks. The after hooks run even if a before hook or the step failed.
+func (e *scenarioExecution) runStep(step TestStep, hooks ScenarioHooks) bool {
+	label := func(phase string) string {
+		return fmt.Sprintf("%s (%s)", phase, step.ID)
+	}
+
+	passed := e.runHooks(hooks.BeforeEachStep, label(hookBeforeEachStep), true) &&
+		e.runHooks(step.Before, label(hookBefore), true) &&
+		e.run(step, "")
+	passed = e.runHooks(step.After, label(hookAfter), false) && passed
+	passed = e.runHooks(hooks.AfterEachStep, label(hookAfterEachStep), false) && passed
+	return passed
+}
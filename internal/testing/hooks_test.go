@@ -0,0 +1,155 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hookRecordingClient records the tools called on it and fails tools whose
+// name starts with "fail".
+type hookRecordingClient struct {
+	calls []string
+}
+
+func (c *hookRecordingClient) Connect(context.Context, string) error { return nil }
+func (c *hookRecordingClient) ConnectWithAuth(context.Context, string, string) error {
+	return nil
+}
+func (c *hookRecordingClient) CallTool(_ context.Context, toolName string, _ map[string]interface{}) (interface{}, error) {
+	c.calls = append(c.calls, toolName)
+	if strings.HasPrefix(toolName, "fail") {
+		return nil, fmt.Errorf("%s failed", toolName)
+	}
+	return map[string]interface{}{"ok": true}, nil
+}
+func (c *hookRecordingClient) CallToolDirect(context.Context, string, map[string]interface{}) (*mcp.CallToolResult, error) {
+	return &mcp.CallToolResult{}, nil
+}
+func (c *hookRecordingClient) ListTools(context.Context) ([]string, error) { return nil, nil }
+func (c *hookRecordingClient) ListToolsWithSchemas(context.Context) ([]mcp.Tool, error) {
+	return nil, nil
+}
+func (c *hookRecordingClient) ReadResource(context.Context, string) (*mcp.ReadResourceResult, error) {
+	return nil, nil
+}
+func (c *hookRecordingClient) Close() error { return nil }
+
+func hookStep(id, tool string) TestStep {
+	return TestStep{ID: id, Tool: tool, Expected: TestExpectation{Success: true}}
+}
+
+func runHookScenario(t *testing.T, scenario TestScenario) (*TestScenarioResult, []string) {
+	t.Helper()
+	logger := NewSilentLogger(false, false)
+	r := &testRunner{reporter: NewTestReporter(false, false, ""), logger: logger}
+	client := &hookRecordingClient{}
+	result := &TestScenarioResult{Scenario: scenario, Result: ResultPassed}
+	r.executeScenario(context.Background(), scenario, TestConfiguration{}, nil, client, logger, result)
+	return result, client.calls
+}
+
+func TestExecuteScenarioHooks(t *testing.T) {
+	create := hookStep("create", "core_workflow_create")
+	create.Before = []TestStep{hookStep("prepare", "core_prepare")}
+	create.After = []TestStep{hookStep("verify", "core_verify")}
+
+	scenario := TestScenario{
+		Name:    "hooks",
+		Steps:   []TestStep{create, hookStep("run", "core_workflow_run")},
+		Cleanup: []TestStep{hookStep("delete", "core_workflow_delete")},
+		Hooks: &ScenarioHooks{
+			BeforeScenario: []TestStep{hookStep("seed", "core_seed")},
+			AfterScenario:  []TestStep{hookStep("reset", "core_reset")},
+			BeforeEachStep: []TestStep{hookStep("mark", "core_mark")},
+			AfterEachStep:  []TestStep{hookStep("flush", "core_flush")},
+		},
+	}
+
+	result, calls := runHookScenario(t, scenario)
+	assert.Equal(t, ResultPassed, result.Result)
+	assert.Equal(t, []string{
+		"core_seed",
+		"core_mark", "core_prepare", "core_workflow_create", "core_verify", "core_flush",
+		"core_mark", "core_workflow_run", "core_flush",
+		"core_workflow_delete",
+		"core_reset",
+	}, calls)
+	require.Len(t, result.StepResults, len(calls))
+	assert.Equal(t, "before_scenario", result.StepResults[0].Hook)
+	assert.Equal(t, "before (create)", result.StepResults[2].Hook)
+	assert.Empty(t, result.StepResults[3].Hook)
+	assert.Equal(t, "after_each_step (run)", result.StepResults[8].Hook)
+}
+
+func TestExecuteScenarioHooksCleanUpOnFailure(t *testing.T) {
+	scenario := TestScenario{
+		Name:  "hooks",
+		Steps: []TestStep{hookStep("boom", "fail_step"), hookStep("never", "core_never")},
+		Hooks: &ScenarioHooks{
+			BeforeEachStep: []TestStep{hookStep("mark", "core_mark")},
+			AfterEachStep:  []TestStep{hookStep("flush", "fail_flush"), hookStep("reset", "core_reset")},
+			AfterScenario:  []TestStep{hookStep("teardown", "core_teardown")},
+		},
+	}
+
+	result, calls := runHookScenario(t, scenario)
+	assert.Equal(t, ResultError, result.Result)
+	assert.Contains(t, result.Error, "fail_step failed")
+	assert.Equal(t, []string{"core_mark", "fail_step", "fail_flush", "core_reset", "core_teardown"}, calls)
+
+	// A failing before_scenario hook skips the steps but not the teardown.
+	scenario.Hooks = &ScenarioHooks{
+		BeforeScenario: []TestStep{hookStep("seed", "fail_seed"), hookStep("seed-more", "core_seed")},
+		AfterScenario:  []TestStep{hookStep("teardown", "core_teardown")},
+	}
+	result, calls = runHookScenario(t, scenario)
+	assert.Equal(t, ResultError, result.Result)
+	assert.Equal(t, []string{"fail_seed", "core_teardown"}, calls)
+}
+
+func TestLoadScenarioHooks(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "hooks.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`name: hooks
+category: behavioral
+concept: workflow
+hooks:
+  after_each_step:
+    - id: reset
+      tool: core_reset
+      expected:
+        success: true
+steps:
+  - id: create
+    tool: core_workflow_create
+    after:
+      - id: verify
+        tool: core_verify
+        after:
+          - id: nested
+            tool: core_nested
+    expected:
+      success: true
+`), 0o600))
+
+	_, err := NewTestScenarioLoader(false).LoadScenarios(file)
+	assert.ErrorContains(t, err, "hook steps cannot have before or after hooks")
+
+	scenario := TestScenario{
+		Name: "hooks", Category: "behavioral", Concept: "workflow",
+		Steps: []TestStep{hookStep("create", "core_workflow_create")},
+		Hooks: &ScenarioHooks{BeforeScenario: []TestStep{{Tool: "core_seed"}}},
+	}
+	loader := &scenarioLoader{}
+	assert.ErrorContains(t, loader.validateScenario(scenario, file), "before_scenario hook 1: step id is required")
+	assert.True(t, scenario.hasStep("create"))
+	assert.False(t, scenario.hasStep("seed"))
+}
@@ -0,0 +1,247 @@
+package testing
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+//go:embed templates/html_report.html.tmpl
+var htmlReportFS embed.FS
+
+// htmlReportMaxText caps each response and log block so a noisy scenario
+// cannot make the report unusably large. Logs keep their tail, where the
+// failure usually is.
+const htmlReportMaxText = 256 * 1024
+
+// htmlReport is the view model of the HTML test report.
+type htmlReport struct {
+	Generated time.Time
+	Suite     *TestSuiteResult
+	Scenarios []htmlScenario
+}
+
+// htmlScenario is a scenario result prepared for rendering.
+type htmlScenario struct {
+	Name        string
+	Description string
+	Status      string
+	Duration    time.Duration
+	Error       string
+	Note        string
+	Failed      bool
+	Steps       []htmlStep
+	Logs        string
+}
+
+// htmlStep is a step result prepared for rendering, with its position on
+// the scenario timeline as percentages of the scenario duration.
+type htmlStep struct {
+	ID          string
+	Hook        string
+	Tool        string
+	Description string
+	Status      string
+	Duration    time.Duration
+	Offset      float64
+	Width       float64
+	Args        string
+	Response    string
+	Error       string
+	Diff        string
+}
+
+// WriteHTMLReport writes a self-contained HTML report of a test run: a
+// summary, and per scenario a step timeline, each step's arguments and
+// response, failure diffs, and the captured muster instance logs. It needs no
+// external assets, so it can be archived as a single CI artifact.
+func WriteHTMLReport(path string, result *TestSuiteResult) error {
+	tmpl, err := template.New("html_report.html.tmpl").Funcs(template.FuncMap{
+		"lower": strings.ToLower,
+		"pct":   func(v float64) template.CSS { return template.CSS(fmt.Sprintf("%.2f%%", v)) }, //nolint:gosec
+	}).ParseFS(htmlReportFS, "templates/html_report.html.tmpl")
+	if err != nil {
+		return fmt.Errorf("failed to parse HTML report template: %w", err)
+	}
+
+	report := htmlReport{Generated: time.Now(), Suite: result}
+	for _, sr := range result.ScenarioResults {
+		report.Scenarios = append(report.Scenarios, newHTMLScenario(sr))
+	}
+	// Failures first, so CI readers land on what broke
+	sort.SliceStable(report.Scenarios, func(i, j int) bool {
+		return report.Scenarios[i].Failed && !report.Scenarios[j].Failed
+	})
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, report); err != nil {
+		return fmt.Errorf("failed to render HTML report: %w", err)
+	}
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec
+			return fmt.Errorf("failed to create HTML report directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil { //nolint:gosec
+		return fmt.Errorf("failed to write HTML report: %w", err)
+	}
+	return nil
+}
+
+// newHTMLScenario prepares a scenario result for rendering.
+func newHTMLScenario(sr TestScenarioResult) htmlScenario {
+	scenario := htmlScenario{
+		Name:        sr.Scenario.Name,
+		Description: sr.Scenario.Description,
+		Status:      string(sr.Result),
+		Duration:    sr.Duration,
+		Error:       sr.Error,
+		Failed:      sr.Result == ResultFailed || sr.Result == ResultError,
+	}
+	switch {
+	case sr.Quarantined:
+		scenario.Note = "quarantined"
+	case sr.Flaky:
+		scenario.Note = fmt.Sprintf("flaky, passed on attempt %d", sr.Attempts)
+	}
+	if sr.InstanceLogs != nil {
+		logs := sr.InstanceLogs.Combined
+		if logs == "" {
+			logs = strings.TrimSpace(sr.InstanceLogs.Stdout + "\n" + sr.InstanceLogs.Stderr)
+		}
+		if len(logs) > htmlReportMaxText {
+			logs = "... (truncated)\n" + logs[len(logs)-htmlReportMaxText:]
+		}
+		scenario.Logs = logs
+	}
+
+	total := sr.Duration
+	if total <= 0 && len(sr.StepResults) > 0 {
+		total = sr.StepResults[len(sr.StepResults)-1].EndTime.Sub(sr.StartTime)
+	}
+	for _, step := range sr.StepResults {
+		scenario.Steps = append(scenario.Steps, newHTMLStep(step, sr.StartTime, total))
+	}
+	return scenario
+}
+
+// newHTMLStep prepares a step result for rendering and places it on the
+// timeline of a scenario that started at start and ran for total.
+func newHTMLStep(sr TestStepResult, start time.Time, total time.Duration) htmlStep {
+	step := htmlStep{
+		ID:          sr.Step.ID,
+		Hook:        sr.Hook,
+		Tool:        sr.Step.Tool,
+		Description: sr.Step.Description,
+		Status:      string(sr.Result),
+		Duration:    sr.Duration,
+		Error:       sr.Error,
+		Diff:        failureDiff(sr),
+	}
+	if total > 0 {
+		step.Offset = clampPercent(float64(sr.StartTime.Sub(start)) / float64(total) * 100)
+		step.Width = clampPercent(float64(sr.Duration) / float64(total) * 100)
+		// Keep instant steps visible
+		if step.Width < 0.5 {
+			step.Width = 0.5
+		}
+		if step.Offset+step.Width > 100 {
+			step.Offset = 100 - step.Width
+		}
+	}
+	if len(sr.Step.Args) > 0 {
+		step.Args = prettyJSON(sr.Step.Args)
+	}
+	if sr.Response != nil {
+		runner := &testRunner{}
+		step.Response = truncateText(prettyJSON(runner.extractStorableResult(sr.Response, NewSilentLogger(false, false))))
+	}
+	return step
+}
+
+// failureDiff explains why a failed step did not meet its expectations: a
+// diff of the expected and actual json_path values, failed assertions, and
+// missing or unexpected text.
+func failureDiff(sr TestStepResult) string {
+	if sr.Result != ResultFailed || sr.Response == nil {
+		return ""
+	}
+	runner := &testRunner{}
+	logger := NewSilentLogger(false, false)
+	expected := sr.Step.Expected
+	var out strings.Builder
+
+	if len(expected.JSONPath) > 0 {
+		responseMap := runner.extractJSONFromMCPResponse(sr.Response, logger)
+		actual := make(map[string]interface{}, len(expected.JSONPath))
+		for path := range expected.JSONPath {
+			actual[path] = "<missing>"
+			if responseMap != nil {
+				if value, ok := runner.resolveJSONPath(responseMap, path); ok {
+					actual[path] = value
+				}
+			}
+		}
+		diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(prettyJSON(expected.JSONPath) + "\n"),
+			B:        difflib.SplitLines(prettyJSON(actual) + "\n"),
+			FromFile: "expected json_path",
+			ToFile:   "actual",
+			Context:  3,
+		})
+		out.WriteString(diff)
+	}
+
+	doc := runner.extractStorableResult(sr.Response, logger)
+	for _, assertion := range expected.Assertions {
+		if err := assertion.Evaluate(doc); err != nil {
+			fmt.Fprintf(&out, "assertion failed: %v\n", err)
+		}
+	}
+
+	text := responseText(sr.Response)
+	for _, want := range expected.Contains {
+		if !containsText(text, want) {
+			fmt.Fprintf(&out, "missing text: %q\n", want)
+		}
+	}
+	for _, unwanted := range expected.NotContains {
+		if containsText(text, unwanted) {
+			fmt.Fprintf(&out, "unexpected text: %q\n", unwanted)
+		}
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+// prettyJSON formats a value as indented JSON, or as text if it is a string
+// or cannot be encoded.
+func prettyJSON(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := marshalIndentJSON(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// truncateText caps text at htmlReportMaxText.
+func truncateText(text string) string {
+	if len(text) <= htmlReportMaxText {
+		return text
+	}
+	return text[:htmlReportMaxText] + "\n... (truncated)"
+}
+
+func clampPercent(v float64) float64 {
+	return max(0, min(100, v))
+}
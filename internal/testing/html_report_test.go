@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteHTMLReport(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	failing := TestStep{
+		ID:   "check-status",
+		Tool: "core_service_status",
+		Args: map[string]interface{}{"name": "svc-a"},
+		Expected: TestExpectation{
+			Success:  true,
+			Contains: []string{"healthy"},
+			JSONPath: map[string]interface{}{"state": "running", "missing": 1},
+		},
+	}
+	result := &TestSuiteResult{
+		StartTime:       start,
+		Duration:        3 * time.Second,
+		TotalScenarios:  2,
+		PassedScenarios: 1,
+		FailedScenarios: 1,
+		ScenarioResults: []TestScenarioResult{
+			{
+				Scenario:  TestScenario{Name: "passing"},
+				Result:    ResultPassed,
+				StartTime: start,
+				Duration:  time.Second,
+			},
+			{
+				Scenario:  TestScenario{Name: "service-status"},
+				Result:    ResultFailed,
+				StartTime: start,
+				Duration:  2 * time.Second,
+				Error:     "step expectations not met",
+				StepResults: []TestStepResult{
+					{Step: TestStep{ID: "seed", Tool: "core_seed"}, Hook: "before_scenario", Result: ResultPassed, StartTime: start, Duration: 500 * time.Millisecond},
+					{
+						Step:      failing,
+						Result:    ResultFailed,
+						StartTime: start.Add(time.Second),
+						Duration:  time.Second,
+						Response:  mcp.NewToolResultText(`{"state": "stopped"}`),
+						Error:     "step expectations not met",
+					},
+				},
+				InstanceLogs: &InstanceLogs{Combined: "level=error msg=<boom>"},
+			},
+		},
+	}
+
+	file := filepath.Join(t.TempDir(), "reports", "report.html")
+	require.NoError(t, WriteHTMLReport(file, result))
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	html := string(data)
+
+	// Failed scenarios come first and are expanded
+	assert.Less(t, strings.Index(html, "service-status"), strings.Index(html, "passing"))
+	assert.Contains(t, html, `<details class="scenario" open>`)
+	// Timeline places the failing step in the second half
+	assert.Contains(t, html, "left: 50.00%; width: 50.00%")
+	assert.Contains(t, html, "before_scenario:")
+	// Failure diff and escaped instance logs
+	assert.Contains(t, html, `-  &#34;state&#34;: &#34;running&#34;`)
+	assert.Contains(t, html, `&#43;  &#34;state&#34;: &#34;stopped&#34;`)
+	assert.Contains(t, html, `&#34;missing&#34;: &#34;&lt;missing&gt;&#34;`)
+	assert.Contains(t, html, `missing text: &#34;healthy&#34;`)
+	assert.Contains(t, html, "msg=&lt;boom&gt;")
+}
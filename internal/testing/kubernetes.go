@@ -0,0 +1,262 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// Kubernetes modes for running scenarios against muster in CRD mode.
+const (
+	// KubernetesModeEnvtest starts a local kube-apiserver and etcd with envtest
+	// and installs the muster CRDs into it. The binaries are located through
+	// KUBEBUILDER_ASSETS (see setup-envtest).
+	KubernetesModeEnvtest = "envtest"
+	// KubernetesModeCluster uses the cluster of the current kubeconfig context
+	// (e.g. a kind cluster). The muster CRDs must already be installed.
+	KubernetesModeCluster = "cluster"
+)
+
+// crdDirectory is the location of the muster CRDs relative to the source root.
+var crdDirectory = filepath.Join("helm", "muster-crds", "files", "crds")
+
+// kubeResourceDirs are the generated config subdirectories holding CRD
+// resources. In Kubernetes mode their content is applied to the cluster.
+var kubeResourceDirs = []string{"mcpservers", "workflows"}
+
+// kubeTestEnv provides the Kubernetes API server muster instances use in
+// Kubernetes mode. Each instance gets its own namespace so parallel scenarios
+// do not see each other's resources.
+type kubeTestEnv struct {
+	env            *envtest.Environment
+	client         client.Client
+	kubeconfigPath string
+}
+
+// startKubeTestEnv prepares the API server for the given mode. For envtest, a
+// kubeconfig with cluster-admin credentials is written to tempDir so the
+// muster serve processes can reach the local API server.
+func startKubeTestEnv(mode, tempDir string, logger TestLogger) (*kubeTestEnv, error) {
+	k := &kubeTestEnv{}
+
+	var restConfig *rest.Config
+	switch mode {
+	case KubernetesModeEnvtest:
+		crdDir, err := findCRDDirectory()
+		if err != nil {
+			return nil, err
+		}
+		k.env = &envtest.Environment{
+			CRDDirectoryPaths:     []string{crdDir},
+			ErrorIfCRDPathMissing: true,
+		}
+		logger.Info("☸️  Starting envtest control plane with CRDs from %s\n", crdDir)
+		restConfig, err = k.env.Start()
+		if err != nil {
+			return nil, fmt.Errorf("failed to start envtest (is KUBEBUILDER_ASSETS set?): %w", err)
+		}
+		if err := k.writeKubeconfig(tempDir); err != nil {
+			_ = k.stop()
+			return nil, err
+		}
+	case KubernetesModeCluster:
+		var err error
+		restConfig, err = ctrl.GetConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+		}
+		logger.Info("☸️  Using Kubernetes cluster at %s\n", restConfig.Host)
+	default:
+		return nil, fmt.Errorf("unknown Kubernetes mode %q, must be %q or %q", mode, KubernetesModeEnvtest, KubernetesModeCluster)
+	}
+
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		_ = k.stop()
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+	k.client = c
+
+	if err := k.checkCRDs(); err != nil {
+		_ = k.stop()
+		return nil, err
+	}
+	return k, nil
+}
+
+// writeKubeconfig adds a cluster-admin user to the envtest control plane and
+// writes its kubeconfig for the muster serve processes.
+func (k *kubeTestEnv) writeKubeconfig(tempDir string) error {
+	user, err := k.env.AddUser(envtest.User{Name: "muster-test", Groups: []string{"system:masters"}}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create envtest user: %w", err)
+	}
+	kubeconfig, err := user.KubeConfig()
+	if err != nil {
+		return fmt.Errorf("failed to generate envtest kubeconfig: %w", err)
+	}
+	k.kubeconfigPath = filepath.Join(tempDir, "kubeconfig")
+	if err := os.WriteFile(k.kubeconfigPath, kubeconfig, 0600); err != nil {
+		return fmt.Errorf("failed to write envtest kubeconfig: %w", err)
+	}
+	return nil
+}
+
+// checkCRDs verifies that the muster CRDs are served by the API server.
+// muster serve silently falls back to the filesystem backend when they are
+// missing, which would make the scenarios pass without exercising Kubernetes.
+func (k *kubeTestEnv) checkCRDs() error {
+	for _, kind := range []string{"MCPServer", "Workflow"} {
+		gk := schema.GroupKind{Group: musterv1alpha1.GroupVersion.Group, Kind: kind}
+		if _, err := k.client.RESTMapper().RESTMapping(gk, musterv1alpha1.GroupVersion.Version); err != nil {
+			return fmt.Errorf("muster CRD for %s is not installed (install the muster-crds chart): %w", kind, err)
+		}
+	}
+	return nil
+}
+
+// environ returns the environment variables muster serve needs to reach the
+// API server. In cluster mode the inherited environment already does.
+func (k *kubeTestEnv) environ() []string {
+	if k.kubeconfigPath == "" {
+		return nil
+	}
+	return []string{"KUBECONFIG=" + k.kubeconfigPath}
+}
+
+// prepareInstance creates the instance namespace and applies the generated
+// MCPServer and Workflow resources from musterConfigPath to it. The resource
+// files are removed afterwards so muster can only discover them through the
+// API server.
+func (k *kubeTestEnv) prepareInstance(ctx context.Context, instanceID, musterConfigPath string) error {
+	namespace := kubeNamespaceForInstance(instanceID)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+	if err := k.client.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s: %w", namespace, err)
+	}
+
+	for _, dir := range kubeResourceDirs {
+		objects, err := loadKubeResources(filepath.Join(musterConfigPath, dir))
+		if err != nil {
+			return err
+		}
+		for _, obj := range objects {
+			obj.SetNamespace(namespace)
+			if err := k.client.Create(ctx, obj); err != nil {
+				return fmt.Errorf("failed to create %s %s in namespace %s: %w", obj.GetKind(), obj.GetName(), namespace, err)
+			}
+		}
+		if err := os.RemoveAll(filepath.Join(musterConfigPath, dir)); err != nil {
+			return fmt.Errorf("failed to remove %s directory: %w", dir, err)
+		}
+	}
+	return nil
+}
+
+// cleanupInstance deletes the instance namespace. envtest runs no namespace
+// controller, so there the namespace stays terminating until the control
+// plane is stopped; it is never reused because namespaces are per instance.
+func (k *kubeTestEnv) cleanupInstance(ctx context.Context, instanceID string) error {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: kubeNamespaceForInstance(instanceID)}}
+	if err := k.client.Delete(ctx, ns); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete namespace %s: %w", ns.Name, err)
+	}
+	return nil
+}
+
+// stop shuts down the envtest control plane, if one was started.
+func (k *kubeTestEnv) stop() error {
+	if k.env == nil {
+		return nil
+	}
+	if err := k.env.Stop(); err != nil {
+		return fmt.Errorf("failed to stop envtest: %w", err)
+	}
+	return nil
+}
+
+// loadKubeResources reads every YAML file in dir as a Kubernetes object.
+// A missing directory yields no objects.
+func loadKubeResources(dir string) ([]*unstructured.Unstructured, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	var objects []*unstructured.Unstructured
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+		file := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(file) //nolint:gosec
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		obj := &unstructured.Unstructured{}
+		if err := sigsyaml.Unmarshal(data, &obj.Object); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		if obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("%s is missing kind or metadata.name", file)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+var invalidNamespaceChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// kubeNamespaceForInstance derives a valid, unique namespace name (RFC 1123
+// label, at most 63 characters) from an instance ID. Long IDs are truncated
+// and suffixed with a hash of the full ID.
+func kubeNamespaceForInstance(instanceID string) string {
+	name := "muster-" + invalidNamespaceChars.ReplaceAllString(strings.ToLower(instanceID), "-")
+	name = strings.Trim(name, "-")
+	if len(name) <= 63 {
+		return name
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(instanceID))
+	return fmt.Sprintf("%s-%08x", strings.TrimRight(name[:54], "-"), h.Sum32())
+}
+
+// findCRDDirectory looks for the muster CRDs in the current directory and its
+// parents, so envtest mode works from anywhere inside the source tree.
+func findCRDDirectory() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+	for {
+		candidate := filepath.Join(dir, crdDirectory)
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			return candidate, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("muster CRDs not found: run envtest mode from within the muster source tree (looked for %s)", crdDirectory)
+		}
+		dir = parent
+	}
+}
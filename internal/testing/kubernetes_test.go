@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubeNamespaceForInstance(t *testing.T) {
+	assert.Equal(t, "muster-test-workflow-basic-42", kubeNamespaceForInstance("test-workflow_basic-42"))
+
+	label := regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	long := "test-" + strings.Repeat("Very_Long_Scenario_Name-", 5) + "1700000000000000000"
+	other := "test-" + strings.Repeat("Very_Long_Scenario_Name-", 5) + "1700000000000000001"
+
+	ns := kubeNamespaceForInstance(long)
+	assert.LessOrEqual(t, len(ns), 63)
+	assert.Regexp(t, label, ns)
+	assert.NotEqual(t, ns, kubeNamespaceForInstance(other))
+}
+
+func TestLoadKubeResources(t *testing.T) {
+	objects, err := loadKubeResources(filepath.Join(t.TempDir(), "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "echo.yaml"), []byte(`apiVersion: muster.giantswarm.io/v1alpha1
+kind: MCPServer
+metadata:
+  name: echo
+  namespace: default
+spec:
+  type: stdio
+  command: echo
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0600))
+
+	objects, err = loadKubeResources(dir)
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "MCPServer", objects[0].GetKind())
+	assert.Equal(t, "echo", objects[0].GetName())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.yaml"), []byte("spec: {}\n"), 0600))
+	_, err = loadKubeResources(dir)
+	assert.ErrorContains(t, err, "missing kind or metadata.name")
+}
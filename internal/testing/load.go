@@ -0,0 +1,312 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// LoadTestConfig configures a load test of the aggregator.
+type LoadTestConfig struct {
+	// TPS is the target rate of tool calls per second
+	TPS int `json:"tps"`
+	// Duration is how long calls are generated
+	Duration time.Duration `json:"duration"`
+	// Concurrency is the number of workers, each with its own MCP session
+	Concurrency int `json:"concurrency"`
+	// SLO defines the thresholds the run must meet
+	SLO LoadSLO `json:"slo"`
+}
+
+// LoadSLO defines service level objectives for a load test. Zero values
+// disable a check.
+type LoadSLO struct {
+	// P95 is the maximum allowed 95th percentile latency
+	P95 time.Duration `json:"p95,omitempty"`
+	// P99 is the maximum allowed 99th percentile latency
+	P99 time.Duration `json:"p99,omitempty"`
+	// MaxErrorRate is the maximum allowed percentage of failed calls
+	MaxErrorRate float64 `json:"max_error_rate,omitempty"`
+}
+
+// LoadTestReport summarizes a load test run.
+type LoadTestReport struct {
+	Scenario    string           `json:"scenario"`
+	Config      LoadTestConfig   `json:"config"`
+	Elapsed     time.Duration    `json:"elapsed"`
+	Requests    int              `json:"requests"`
+	Errors      int              `json:"errors"`
+	ErrorRate   float64          `json:"error_rate"`
+	AchievedTPS float64          `json:"achieved_tps"`
+	Dropped     int              `json:"dropped"`
+	Latency     LatencySummary   `json:"latency"`
+	Tools       []LoadToolReport `json:"tools"`
+	// SLOViolations lists the thresholds the run did not meet
+	SLOViolations []string `json:"slo_violations,omitempty"`
+}
+
+// LoadToolReport summarizes the calls of a single tool.
+type LoadToolReport struct {
+	Tool      string         `json:"tool"`
+	Requests  int            `json:"requests"`
+	Errors    int            `json:"errors"`
+	ErrorRate float64        `json:"error_rate"`
+	Latency   LatencySummary `json:"latency"`
+}
+
+// LatencySummary holds latency percentiles in milliseconds.
+type LatencySummary struct {
+	Mean float64 `json:"mean_ms"`
+	P50  float64 `json:"p50_ms"`
+	P90  float64 `json:"p90_ms"`
+	P95  float64 `json:"p95_ms"`
+	P99  float64 `json:"p99_ms"`
+	Max  float64 `json:"max_ms"`
+}
+
+// loadSample is the outcome of a single call.
+type loadSample struct {
+	tool    string
+	latency time.Duration
+	failed  bool
+}
+
+// RunLoadTest starts a muster instance for the scenario and calls the
+// scenario's steps round-robin at cfg.TPS for cfg.Duration, spread over
+// cfg.Concurrency workers.
+//
+// A call counts as an error when its outcome differs from the step's expected
+// success, so steps that are expected to fail can be part of the mix. Steps
+// calling test framework tools or using template variables are skipped, since
+// they depend on earlier steps of a sequential run. Ticks for which all
+// workers are busy are reported as dropped: the aggregator could not keep up
+// with the target rate at the configured concurrency.
+func RunLoadTest(ctx context.Context, manager MusterInstanceManager, scenario TestScenario, cfg LoadTestConfig, logger TestLogger, debug bool) (*LoadTestReport, error) {
+	if cfg.TPS <= 0 || cfg.Duration <= 0 || cfg.Concurrency <= 0 {
+		return nil, fmt.Errorf("tps, duration, and concurrency must be positive")
+	}
+
+	steps := loadableSteps(scenario.Steps)
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("scenario %s has no steps usable for load testing (test_* tools and templated args are skipped)", scenario.Name)
+	}
+	if skipped := len(scenario.Steps) - len(steps); skipped > 0 {
+		logger.Info("⚠️  Skipping %d step(s) that use test tools or template variables\n", skipped)
+	}
+
+	instance, err := manager.CreateInstance(ctx, "load-"+scenario.Name, scenario.PreConfiguration, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create muster instance: %w", err)
+	}
+	defer func() {
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		_ = manager.DestroyInstance(cleanupCtx, instance, logger)
+	}()
+	if err := manager.WaitForReady(ctx, instance, logger); err != nil {
+		return nil, fmt.Errorf("muster instance not ready: %w", err)
+	}
+
+	clients := make([]MCPTestClient, 0, cfg.Concurrency)
+	defer func() {
+		for _, c := range clients {
+			_ = c.Close()
+		}
+	}()
+	for i := 0; i < cfg.Concurrency; i++ {
+		c := NewMCPTestClientWithLogger(debug, logger)
+		if instance.MusterOAuthAccessToken != "" {
+			err = c.ConnectWithAuth(ctx, instance.Endpoint, instance.MusterOAuthAccessToken)
+		} else {
+			err = c.Connect(ctx, instance.Endpoint)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect worker %d: %w", i, err)
+		}
+		clients = append(clients, c)
+	}
+
+	logger.Info("🔥 Generating %d calls/s for %s with %d workers against %s\n", cfg.TPS, cfg.Duration, cfg.Concurrency, instance.Endpoint)
+
+	jobs := make(chan TestStep)
+	results := make([][]loadSample, cfg.Concurrency)
+	var wg sync.WaitGroup
+	for i, c := range clients {
+		wg.Add(1)
+		go func(i int, c MCPTestClient) {
+			defer wg.Done()
+			for step := range jobs {
+				start := time.Now()
+				response, err := c.CallTool(ctx, step.Tool, step.Args)
+				latency := time.Since(start)
+				succeeded := err == nil
+				if result, ok := response.(*mcp.CallToolResult); ok && result.IsError {
+					succeeded = false
+				}
+				results[i] = append(results[i], loadSample{tool: step.Tool, latency: latency, failed: succeeded != step.Expected.Success})
+			}
+		}(i, c)
+	}
+
+	dropped := 0
+	start := time.Now()
+	ticker := time.NewTicker(time.Second / time.Duration(cfg.TPS))
+	deadline := time.NewTimer(cfg.Duration)
+	for next := 0; ; {
+		select {
+		case <-ticker.C:
+			select {
+			case jobs <- steps[next%len(steps)]:
+				next++
+			default:
+				dropped++
+			}
+			continue
+		case <-deadline.C:
+		case <-ctx.Done():
+		}
+		break
+	}
+	ticker.Stop()
+	deadline.Stop()
+	close(jobs)
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	var samples []loadSample
+	for _, r := range results {
+		samples = append(samples, r...)
+	}
+	report := buildLoadTestReport(scenario.Name, cfg, samples, elapsed, dropped)
+	return report, ctx.Err()
+}
+
+// loadableSteps returns the steps that can be replayed independently.
+func loadableSteps(steps []TestStep) []TestStep {
+	var loadable []TestStep
+	for _, step := range steps {
+		if IsTestTool(step.Tool) || strings.Contains(fmt.Sprint(step.Args), "{{") {
+			continue
+		}
+		loadable = append(loadable, step)
+	}
+	return loadable
+}
+
+// buildLoadTestReport aggregates the samples and checks the SLOs.
+func buildLoadTestReport(scenario string, cfg LoadTestConfig, samples []loadSample, elapsed time.Duration, dropped int) *LoadTestReport {
+	report := &LoadTestReport{
+		Scenario: scenario,
+		Config:   cfg,
+		Elapsed:  elapsed,
+		Requests: len(samples),
+		Dropped:  dropped,
+	}
+	if elapsed > 0 {
+		report.AchievedTPS = float64(len(samples)) / elapsed.Seconds()
+	}
+
+	byTool := make(map[string][]loadSample)
+	for _, s := range samples {
+		byTool[s.tool] = append(byTool[s.tool], s)
+	}
+	report.Errors, report.Latency = summarizeLoadSamples(samples)
+	report.ErrorRate = coveragePercent(report.Errors, report.Requests)
+
+	for tool, toolSamples := range byTool {
+		errors, latency := summarizeLoadSamples(toolSamples)
+		report.Tools = append(report.Tools, LoadToolReport{
+			Tool:      tool,
+			Requests:  len(toolSamples),
+			Errors:    errors,
+			ErrorRate: coveragePercent(errors, len(toolSamples)),
+			Latency:   latency,
+		})
+	}
+	sort.Slice(report.Tools, func(i, j int) bool { return report.Tools[i].Tool < report.Tools[j].Tool })
+
+	slo := cfg.SLO
+	if slo.P95 > 0 && report.Latency.P95 > milliseconds(slo.P95) {
+		report.SLOViolations = append(report.SLOViolations, fmt.Sprintf("p95 latency %.1fms exceeds %s", report.Latency.P95, slo.P95))
+	}
+	if slo.P99 > 0 && report.Latency.P99 > milliseconds(slo.P99) {
+		report.SLOViolations = append(report.SLOViolations, fmt.Sprintf("p99 latency %.1fms exceeds %s", report.Latency.P99, slo.P99))
+	}
+	if slo.MaxErrorRate > 0 && report.ErrorRate > slo.MaxErrorRate {
+		report.SLOViolations = append(report.SLOViolations, fmt.Sprintf("error rate %.2f%% exceeds %.2f%%", report.ErrorRate, slo.MaxErrorRate))
+	}
+	return report
+}
+
+// summarizeLoadSamples counts failures and computes latency percentiles.
+func summarizeLoadSamples(samples []loadSample) (int, LatencySummary) {
+	if len(samples) == 0 {
+		return 0, LatencySummary{}
+	}
+	errors := 0
+	var total time.Duration
+	latencies := make([]time.Duration, len(samples))
+	for i, s := range samples {
+		latencies[i] = s.latency
+		total += s.latency
+		if s.failed {
+			errors++
+		}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	return errors, LatencySummary{
+		Mean: milliseconds(total / time.Duration(len(latencies))),
+		P50:  milliseconds(percentile(latencies, 50)),
+		P90:  milliseconds(percentile(latencies, 90)),
+		P95:  milliseconds(percentile(latencies, 95)),
+		P99:  milliseconds(percentile(latencies, 99)),
+		Max:  milliseconds(latencies[len(latencies)-1]),
+	}
+}
+
+// percentile returns the nearest-rank percentile of sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// PrintLoadTestReport writes a human-readable load test summary.
+func PrintLoadTestReport(out io.Writer, report *LoadTestReport) {
+	_, _ = fmt.Fprintf(out, "\n🔥 Load test: %s\n", report.Scenario)
+	_, _ = fmt.Fprintf(out, "   Requests: %d in %s (%.1f/s, target %d/s)\n", report.Requests, report.Elapsed.Round(time.Millisecond), report.AchievedTPS, report.Config.TPS)
+	_, _ = fmt.Fprintf(out, "   Errors:   %d (%.2f%%)\n", report.Errors, report.ErrorRate)
+	if report.Dropped > 0 {
+		_, _ = fmt.Fprintf(out, "   Dropped:  %d (all %d workers busy; raise --concurrency)\n", report.Dropped, report.Config.Concurrency)
+	}
+	l := report.Latency
+	_, _ = fmt.Fprintf(out, "   Latency:  mean %.1fms  p50 %.1fms  p90 %.1fms  p95 %.1fms  p99 %.1fms  max %.1fms\n", l.Mean, l.P50, l.P90, l.P95, l.P99, l.Max)
+
+	_, _ = fmt.Fprintf(out, "\n   %-40s %8s %8s %10s %10s\n", "TOOL", "CALLS", "ERRORS", "P95 (ms)", "P99 (ms)")
+	for _, t := range report.Tools {
+		_, _ = fmt.Fprintf(out, "   %-40s %8d %8d %10.1f %10.1f\n", t.Tool, t.Requests, t.Errors, t.Latency.P95, t.Latency.P99)
+	}
+
+	if len(report.SLOViolations) == 0 {
+		_, _ = fmt.Fprintf(out, "\n✅ All SLOs met\n")
+		return
+	}
+	_, _ = fmt.Fprintf(out, "\n❌ SLO violations:\n")
+	for _, v := range report.SLOViolations {
+		_, _ = fmt.Fprintf(out, "   • %s\n", v)
+	}
+}
@@ -0,0 +1,67 @@
+package testing
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadableSteps(t *testing.T) {
+	steps := []TestStep{
+		{ID: "list", Tool: "core_service_list"},
+		{ID: "create-user", Tool: "test_create_user"},
+		{ID: "get", Tool: "core_service_get", Args: map[string]interface{}{"name": "{{ list.name }}"}},
+		{ID: "call", Tool: "x_github_get_issue", Args: map[string]interface{}{"number": 1}},
+	}
+
+	loadable := loadableSteps(steps)
+	require.Len(t, loadable, 2)
+	assert.Equal(t, "list", loadable[0].ID)
+	assert.Equal(t, "call", loadable[1].ID)
+}
+
+func TestBuildLoadTestReport(t *testing.T) {
+	var samples []loadSample
+	for i := 1; i <= 100; i++ {
+		samples = append(samples, loadSample{tool: "core_service_list", latency: time.Duration(i) * time.Millisecond})
+	}
+	samples = append(samples,
+		loadSample{tool: "x_github_get_issue", latency: 500 * time.Millisecond, failed: true},
+		loadSample{tool: "x_github_get_issue", latency: 300 * time.Millisecond},
+	)
+
+	cfg := LoadTestConfig{TPS: 100, Duration: time.Second, Concurrency: 4}
+	report := buildLoadTestReport("load", cfg, samples, 2*time.Second, 3)
+
+	assert.Equal(t, 102, report.Requests)
+	assert.Equal(t, 1, report.Errors)
+	assert.InDelta(t, 0.98, report.ErrorRate, 0.01)
+	assert.InDelta(t, 51, report.AchievedTPS, 0.01)
+	assert.Equal(t, 3, report.Dropped)
+	assert.Equal(t, 500.0, report.Latency.Max)
+	assert.Empty(t, report.SLOViolations)
+
+	require.Len(t, report.Tools, 2)
+	list := report.Tools[0]
+	assert.Equal(t, "core_service_list", list.Tool)
+	assert.Equal(t, 100, list.Requests)
+	assert.Equal(t, 50.0, list.Latency.P50)
+	assert.Equal(t, 95.0, list.Latency.P95)
+	assert.Equal(t, 99.0, list.Latency.P99)
+	assert.Equal(t, 50.5, list.Latency.Mean)
+	assert.Equal(t, 50.0, report.Tools[1].ErrorRate)
+
+	cfg.SLO = LoadSLO{P95: 50 * time.Millisecond, P99: time.Second, MaxErrorRate: 0.5}
+	report = buildLoadTestReport("load", cfg, samples, 2*time.Second, 0)
+	require.Len(t, report.SLOViolations, 2)
+	assert.Contains(t, report.SLOViolations[0], "p95 latency")
+	assert.Contains(t, report.SLOViolations[1], "error rate")
+
+	var buf bytes.Buffer
+	PrintLoadTestReport(&buf, report)
+	assert.Contains(t, buf.String(), "SLO violations")
+	assert.Contains(t, buf.String(), "x_github_get_issue")
+}
@@ -7,6 +7,7 @@
 // - Mock tools with configurable responses
 // - Conditional responses based on input args
 // - Simulated delays and error conditions
+// - Fault injection: latency, jitter, error rates, and connection drops
 // - Template-based response generation
 // - OAuth 2.1 authentication flows for testing protected servers
 //
@@ -100,6 +101,26 @@
 // The responses support Go template syntax and can reference input args.
 // Conditional responses allow different behaviors based on input values.
 //
+// # Fault Injection
+//
+// A tool's faults section injects latency and failures into every call, so
+// scenarios can exercise the aggregator's timeout and retry handling:
+//
+//	tools:
+//	  - name: flaky-tool
+//	    faults:
+//	      latency: "200ms"
+//	      jitter: "50ms"
+//	      fail_first: 2
+//	      error_rate: 0.1
+//	      drop_on_call: 5
+//	    responses:
+//	      - response: "ok"
+//
+// Rate-based faults use a seeded random source (see FaultConfig), so runs are
+// reproducible. A dropped connection closes the call's HTTP connection for
+// streamable-http servers and exits the process for stdio servers.
+//
 // # OAuth Testing
 //
 // For testing OAuth-protected MCP servers, the package provides a complete
@@ -0,0 +1,172 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrConnectionDropped is returned by ToolHandler.HandleCallContext when fault
+// injection decided to drop the connection for a call. Transports react by
+// closing the underlying connection instead of sending a response.
+var ErrConnectionDropped = errors.New("connection dropped by fault injection")
+
+// FaultConfig injects latency and failures into a mock tool so scenarios can
+// exercise the aggregator's timeout, retry, and circuit-breaker handling.
+//
+// Rate-based faults draw from a random source seeded with Seed (1 when unset),
+// so a given configuration produces the same sequence of faults on every run.
+// FailFirst and DropOnCall are fully deterministic and independent of the seed.
+type FaultConfig struct {
+	// Latency is added to every call before the response is returned (e.g. "200ms")
+	Latency string `yaml:"latency,omitempty"`
+	// Jitter adds a random extra delay between 0 and Jitter to every call
+	Jitter string `yaml:"jitter,omitempty"`
+	// ErrorRate is the fraction of calls (0.0-1.0) that fail with ErrorMessage
+	ErrorRate float64 `yaml:"error_rate,omitempty"`
+	// FailFirst makes the first N calls fail with ErrorMessage
+	FailFirst int `yaml:"fail_first,omitempty"`
+	// ErrorMessage is the error returned by injected failures
+	ErrorMessage string `yaml:"error_message,omitempty"`
+	// DropRate is the fraction of calls (0.0-1.0) whose connection is dropped
+	DropRate float64 `yaml:"drop_rate,omitempty"`
+	// DropOnCall drops the connection on the Nth call (1-based)
+	DropOnCall int `yaml:"drop_on_call,omitempty"`
+	// Seed seeds the random source for Jitter, ErrorRate, and DropRate
+	Seed int64 `yaml:"seed,omitempty"`
+}
+
+// defaultFaultErrorMessage is used when FaultConfig.ErrorMessage is empty.
+const defaultFaultErrorMessage = "injected fault"
+
+// Validate checks that durations parse and rates are within [0, 1].
+func (c *FaultConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for field, value := range map[string]string{"latency": c.Latency, "jitter": c.Jitter} {
+		if value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(value); err != nil || d < 0 {
+			return fmt.Errorf("faults.%s: invalid duration %q", field, value)
+		}
+	}
+	for field, value := range map[string]float64{"error_rate": c.ErrorRate, "drop_rate": c.DropRate} {
+		if value < 0 || value > 1 {
+			return fmt.Errorf("faults.%s must be between 0 and 1, got %g", field, value)
+		}
+	}
+	if c.FailFirst < 0 {
+		return fmt.Errorf("faults.fail_first cannot be negative")
+	}
+	if c.DropOnCall < 0 {
+		return fmt.Errorf("faults.drop_on_call cannot be negative")
+	}
+	return nil
+}
+
+// faultKind is the outcome fault injection picked for a single call.
+type faultKind int
+
+const (
+	faultNone faultKind = iota
+	faultError
+	faultDrop
+)
+
+// faultInjector decides per call which delay and fault to apply.
+type faultInjector struct {
+	config  FaultConfig
+	latency time.Duration
+	jitter  time.Duration
+
+	mu    sync.Mutex
+	rng   *rand.Rand
+	calls int
+}
+
+// newFaultInjector returns nil when no faults are configured. Durations that
+// fail to parse are ignored; NewServerFromFile rejects them up front.
+func newFaultInjector(config *FaultConfig) *faultInjector {
+	if config == nil {
+		return nil
+	}
+	seed := config.Seed
+	if seed == 0 {
+		seed = 1
+	}
+	f := &faultInjector{
+		config: *config,
+		rng:    rand.New(rand.NewSource(seed)), //nolint:gosec // deterministic fault sequence, not security relevant
+	}
+	f.latency, _ = time.ParseDuration(config.Latency)
+	f.jitter, _ = time.ParseDuration(config.Jitter)
+	return f
+}
+
+// next advances the call counter and returns the delay and fault for the call.
+func (f *faultInjector) next() (time.Duration, faultKind) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls++
+	delay := f.latency
+	if f.jitter > 0 {
+		delay += time.Duration(f.rng.Int63n(int64(f.jitter)))
+	}
+
+	// Always draw both numbers so the sequence for one kind of fault does not
+	// shift when the other is reconfigured.
+	dropRoll, errorRoll := f.rng.Float64(), f.rng.Float64()
+	switch {
+	case f.config.DropOnCall == f.calls, dropRoll < f.config.DropRate:
+		return delay, faultDrop
+	case f.calls <= f.config.FailFirst, errorRoll < f.config.ErrorRate:
+		return delay, faultError
+	}
+	return delay, faultNone
+}
+
+// errorMessage returns the configured error message for injected failures.
+func (f *faultInjector) errorMessage() string {
+	if f.config.ErrorMessage != "" {
+		return f.config.ErrorMessage
+	}
+	return defaultFaultErrorMessage
+}
+
+// connectionDropperKey is the context key for the per-request drop function.
+type connectionDropperKey struct{}
+
+// withConnectionDrop lets tool handlers drop the HTTP connection of the
+// request they are serving. It is only effective for transports that answer
+// a tool call on the request's own connection (streamable-http).
+func withConnectionDrop(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		drop := func() bool {
+			hijacker, ok := w.(http.Hijacker)
+			if !ok {
+				return false
+			}
+			conn, _, err := hijacker.Hijack()
+			if err != nil {
+				return false
+			}
+			_ = conn.Close()
+			return true
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), connectionDropperKey{}, drop)))
+	})
+}
+
+// dropConnection closes the connection serving ctx, if the transport allows
+// it, and reports whether it did.
+func dropConnection(ctx context.Context) bool {
+	drop, ok := ctx.Value(connectionDropperKey{}).(func() bool)
+	return ok && drop()
+}
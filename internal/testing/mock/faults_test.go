@@ -0,0 +1,105 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/muster/internal/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaultConfigValidate(t *testing.T) {
+	var nilConfig *FaultConfig
+	assert.NoError(t, nilConfig.Validate())
+	assert.NoError(t, (&FaultConfig{Latency: "100ms", Jitter: "1s", ErrorRate: 0.5, DropRate: 1}).Validate())
+
+	for _, cfg := range []FaultConfig{
+		{Latency: "soon"},
+		{Jitter: "-1s"},
+		{ErrorRate: 1.5},
+		{DropRate: -0.1},
+		{FailFirst: -1},
+		{DropOnCall: -2},
+	} {
+		assert.Error(t, cfg.Validate(), "%+v", cfg)
+	}
+}
+
+func TestFaultInjectorDeterministicFaults(t *testing.T) {
+	f := newFaultInjector(&FaultConfig{FailFirst: 2, DropOnCall: 4})
+
+	var got []faultKind
+	for i := 0; i < 5; i++ {
+		_, fault := f.next()
+		got = append(got, fault)
+	}
+	assert.Equal(t, []faultKind{faultError, faultError, faultNone, faultDrop, faultNone}, got)
+}
+
+func TestFaultInjectorSeedReproducible(t *testing.T) {
+	cfg := &FaultConfig{Jitter: "100ms", ErrorRate: 0.3, DropRate: 0.1, Seed: 42}
+	a, b := newFaultInjector(cfg), newFaultInjector(cfg)
+
+	for i := 0; i < 50; i++ {
+		delayA, faultA := a.next()
+		delayB, faultB := b.next()
+		require.Equal(t, delayA, delayB)
+		require.Equal(t, faultA, faultB)
+		require.Less(t, delayA, 100*time.Millisecond)
+	}
+}
+
+func TestHandleCallContextFaults(t *testing.T) {
+	newHandler := func(faults *FaultConfig) *ToolHandler {
+		return NewToolHandler(ToolConfig{
+			Name:      "flaky",
+			Responses: []ToolResponse{{Response: "ok"}},
+			Faults:    faults,
+		}, template.New(), false)
+	}
+
+	h := newHandler(&FaultConfig{FailFirst: 1, ErrorMessage: "backend unavailable"})
+	_, err := h.HandleCall(nil)
+	assert.EqualError(t, err, "backend unavailable")
+	result, err := h.HandleCall(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", result)
+
+	h = newHandler(&FaultConfig{DropOnCall: 1})
+	_, err = h.HandleCall(nil)
+	assert.True(t, errors.Is(err, ErrConnectionDropped))
+
+	h = newHandler(&FaultConfig{Latency: "10s"})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = h.HandleCallContext(ctx, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestWithConnectionDrop(t *testing.T) {
+	srv := httptest.NewServer(withConnectionDrop(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/drop" && dropConnection(r.Context()) {
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/ok")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = http.Get(srv.URL + "/drop") //nolint:bodyclose // no response is expected
+	assert.Error(t, err)
+
+	assert.False(t, dropConnection(context.Background()))
+}
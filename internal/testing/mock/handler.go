@@ -3,6 +3,7 @@ package mock
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
@@ -17,6 +18,7 @@ import (
 type ToolHandler struct {
 	config         ToolConfig
 	templateEngine *template.Engine
+	faults         *faultInjector
 	debug          bool
 }
 
@@ -25,16 +27,49 @@ func NewToolHandler(config ToolConfig, templateEngine *template.Engine, debug bo
 	return &ToolHandler{
 		config:         config,
 		templateEngine: templateEngine,
+		faults:         newFaultInjector(config.Faults),
 		debug:          debug,
 	}
 }
 
 // HandleCall processes a tool call and returns the configured response
 func (h *ToolHandler) HandleCall(args map[string]interface{}) (interface{}, error) {
+	return h.HandleCallContext(context.Background(), args)
+}
+
+// HandleCallContext processes a tool call, applying any configured fault
+// injection, and returns the configured response. Injected and configured
+// delays end early when ctx is cancelled. It returns ErrConnectionDropped when
+// the call's connection should be dropped instead of answered.
+func (h *ToolHandler) HandleCallContext(ctx context.Context, args map[string]interface{}) (interface{}, error) {
 	if h.debug {
 		fmt.Fprintf(os.Stderr, "🔧 Mock tool '%s' called with args: %v\n", h.config.Name, args)
 	}
 
+	if h.faults != nil {
+		delay, fault := h.faults.next()
+		if delay > 0 {
+			if h.debug {
+				fmt.Fprintf(os.Stderr, "⏳ Injecting latency of %s for tool '%s'\n", delay, h.config.Name)
+			}
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+		switch fault {
+		case faultDrop:
+			if h.debug {
+				fmt.Fprintf(os.Stderr, "🔌 Injecting connection drop for tool '%s'\n", h.config.Name)
+			}
+			return nil, ErrConnectionDropped
+		case faultError:
+			if h.debug {
+				fmt.Fprintf(os.Stderr, "💥 Injecting error for tool '%s'\n", h.config.Name)
+			}
+			return nil, fmt.Errorf("%s", h.faults.errorMessage())
+		}
+	}
+
 	// Merge args with default values from input schema
 	mergedArgs := h.mergeWithDefaults(args)
 
@@ -66,7 +101,9 @@ func (h *ToolHandler) HandleCall(args map[string]interface{}) (interface{}, erro
 			if h.debug {
 				fmt.Fprintf(os.Stderr, "⏳ Simulating delay of %s for tool '%s'\n", selectedResponse.Delay, h.config.Name)
 			}
-			time.Sleep(duration)
+			if err := sleepContext(ctx, duration); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -105,6 +142,18 @@ func (h *ToolHandler) HandleCall(args map[string]interface{}) (interface{}, erro
 	return renderedResponse, nil
 }
 
+// sleepContext waits for d or until ctx is cancelled, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // mergeWithDefaults merges provided args with default values from input schema
 func (h *ToolHandler) mergeWithDefaults(args map[string]interface{}) map[string]interface{} {
 	merged := make(map[string]interface{})
@@ -175,8 +224,11 @@ func (h *ToolHandler) createMCPHandler() func(context.Context, mcp.CallToolReque
 		args := request.GetArguments()
 
 		// Handle the tool call
-		result, err := h.HandleCall(args)
+		result, err := h.HandleCallContext(ctx, args)
 		if err != nil {
+			if errors.Is(err, ErrConnectionDropped) {
+				dropConnection(ctx)
+			}
 			return mcp.NewToolResultError(err.Error()), nil
 		}
 
@@ -75,7 +75,7 @@ func (s *HTTPServer) createHandler() http.Handler {
 	case HTTPTransportStreamableHTTP:
 		fallthrough
 	default:
-		return server.NewStreamableHTTPServer(s.mockServer.mcpServer, server.WithStateful(true))
+		return withConnectionDrop(server.NewStreamableHTTPServer(s.mockServer.mcpServer, server.WithStateful(true)))
 	}
 }
 
@@ -46,20 +46,6 @@ const jwtHeader = "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0"
 // NOT be trusted outside tests.
 const jwtDummySignature = "dGVzdC1zaWduYXR1cmU"
 
-// idTokenClaims represents the claims in an ID token.
-type idTokenClaims struct {
-	Iss           string   `json:"iss"`                      // Issuer
-	Sub           string   `json:"sub"`                      // Subject (user ID)
-	Aud           string   `json:"aud"`                      // Audience (client ID)
-	Exp           int64    `json:"exp"`                      // Expiration time
-	Iat           int64    `json:"iat"`                      // Issued at
-	Nonce         string   `json:"nonce,omitempty"`          // OIDC nonce echoed from the auth request
-	Email         string   `json:"email,omitempty"`          // User email
-	EmailVerified bool     `json:"email_verified,omitempty"` // Whether the email is verified (Dex sets this)
-	Name          string   `json:"name,omitempty"`           // User name
-	Groups        []string `json:"groups,omitempty"`         // User groups for RBAC
-}
-
 // OAuthServerConfig configures the mock OAuth server behavior
 type OAuthServerConfig struct {
 	// Issuer is the OAuth issuer identifier (e.g., "http://localhost:9999")
@@ -109,6 +95,16 @@ type OAuthServerConfig struct {
 	// (e.g. muster's broker) can verify against this server's JWKS. Default false
 	// keeps the legacy alg:none ID tokens and empty JWKS used by most scenarios.
 	SignTokens bool
+
+	// Users are the identities the server logs in. A login selects a user by
+	// login_hint (subject or email); logins without one cycle through the
+	// users in order, so consecutive logins yield distinct users. When empty,
+	// every login is the default test user.
+	Users []MockUser
+
+	// Claims are custom claims added to every ID token and userinfo response.
+	// Per-user claims take precedence.
+	Claims map[string]any
 }
 
 // OAuthErrorSimulation allows simulating error conditions
@@ -149,6 +145,10 @@ type OAuthServer struct {
 	// JWT signing material (populated when config.SignTokens is true)
 	signingKey *ecdsa.PrivateKey
 	signingKID string
+
+	// nextUser is the index of the configured user the next login without a
+	// login_hint selects
+	nextUser int
 }
 
 type authCodeEntry struct {
@@ -440,13 +440,20 @@ func (s *OAuthServer) GenerateAuthCode(clientID, redirectURI, scope, state, code
 }
 
 // GenerateAuthCodeWithSubject generates an authorization code with a custom subject claim.
-// If subject is empty, the default "test-user-123" is used. The nonce, when non-empty,
-// is echoed back in the issued id_token to satisfy OIDC nonce-echo verification.
+// The subject may also be the email of a configured user. If subject is empty, the next
+// configured user is logged in, or the default "test-user-123" without configured users.
+// The nonce, when non-empty, is echoed back in the issued id_token to satisfy OIDC
+// nonce-echo verification.
 func (s *OAuthServer) GenerateAuthCodeWithSubject(clientID, redirectURI, scope, state, codeChallenge, codeChallengeMethod, subject, nonce string) string {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	code := generateOpaqueToken()
+	if subject == "" {
+		subject = s.nextLoginSubject()
+	} else {
+		subject = s.userFor(subject).Subject
+	}
 
 	s.authCodes[code] = &authCodeEntry{
 		ClientID:        clientID,
@@ -493,7 +500,7 @@ func (s *OAuthServer) SimulateCallback(code string) (*TokenResponse, error) {
 
 	sub := entry.Subject
 	if sub == "" {
-		sub = "test-user-123" //nolint:goconst
+		sub = defaultSubject
 	}
 
 	token := &issuedToken{
@@ -632,6 +639,7 @@ func (s *OAuthServer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
 	codeChallengeMethod := r.URL.Query().Get("code_challenge_method")
 	responseType := r.URL.Query().Get("response_type")
 	nonce := r.URL.Query().Get("nonce")
+	loginHint := r.URL.Query().Get("login_hint")
 
 	if s.config.Debug {
 		fmt.Fprintf(os.Stderr, "🔐 Authorization request: client_id=%s, redirect_uri=%s, scope=%s\n",
@@ -656,8 +664,8 @@ func (s *OAuthServer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Generate authorization code
-	code := s.GenerateAuthCode(clientID, redirectURI, scope, state, codeChallenge, codeChallengeMethod, nonce)
+	// Generate authorization code for the hinted user, or the next one
+	code := s.GenerateAuthCodeWithSubject(clientID, redirectURI, scope, state, codeChallenge, codeChallengeMethod, loginHint, nonce)
 
 	if s.config.AutoApprove {
 		// Auto-redirect with code (simulating user approval)
@@ -822,7 +830,7 @@ func (s *OAuthServer) handleAuthCodeExchange(w http.ResponseWriter, r *http.Requ
 
 	sub := entry.Subject
 	if sub == "" {
-		sub = "test-user-123"
+		sub = defaultSubject
 	}
 
 	token := &issuedToken{
@@ -893,7 +901,7 @@ func (s *OAuthServer) handleRefreshToken(w http.ResponseWriter, r *http.Request)
 	// Always use generateIDTokenWithSub to avoid sentinel-value fragility.
 	sub := originalToken.Subject
 	if sub == "" {
-		sub = "test-user-123"
+		sub = defaultSubject
 	}
 	// Nonce is bound to the auth request, not the refresh, so no echo on refresh.
 	newIDToken := s.generateIDTokenWithSub(originalToken.ClientID, originalToken.Scope, sub, "")
@@ -985,7 +993,7 @@ func (s *OAuthServer) handleTokenExchange(w http.ResponseWriter, r *http.Request
 	// Extract user info from the subject token for the new token
 	userID := s.extractSubFromToken(subjectToken)
 	if userID == "" {
-		userID = "test-user-123" // fallback
+		userID = defaultSubject // fallback
 	}
 
 	// Default scope if not provided
@@ -1004,6 +1012,7 @@ func (s *OAuthServer) handleTokenExchange(w http.ResponseWriter, r *http.Request
 		Scope:        scope,
 		ClientID:     s.config.ClientID,
 		ExpiresAt:    s.clock.Now().Add(s.config.TokenLifetime),
+		Subject:      userID,
 	}
 
 	s.mu.Lock()
@@ -1086,31 +1095,7 @@ func (s *OAuthServer) extractSubFromToken(token string) string {
 // When nonce is non-empty, it is echoed in the `nonce` claim so callers can
 // satisfy OIDC nonce-echo verification (RFC 8252, OpenID Connect Core §3.1.2.7).
 func (s *OAuthServer) generateIDTokenWithSub(clientID, scope, subject, nonce string) string {
-	now := s.clock.Now()
-
-	claims := idTokenClaims{
-		Iss:           s.config.Issuer,
-		Sub:           subject,
-		Aud:           clientID,
-		Exp:           now.Add(s.config.TokenLifetime).Unix(),
-		Iat:           now.Unix(),
-		Nonce:         nonce,
-		Email:         "test@example.com",
-		EmailVerified: true,
-		Name:          "Test User",
-	}
-
-	// Include groups claim if the "groups" scope was requested
-	if hasScope(scope, "groups") {
-		claims.Groups = []string{"test-group", "developers"}
-	}
-
-	claimsJSON, err := json.Marshal(claims)
-	if err != nil {
-		panic(fmt.Errorf("failed to marshal ID token claims: %w", err))
-	}
-
-	return s.encodeIDToken(claimsJSON)
+	return s.encodeIDToken(s.idTokenClaimsJSON(clientID, scope, subject, nonce))
 }
 
 func (s *OAuthServer) handleUserInfo(w http.ResponseWriter, r *http.Request) {
@@ -1120,19 +1105,17 @@ func (s *OAuthServer) handleUserInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Use subject from token if available, otherwise default
-	sub := "test-user-123"
+	// Use subject and scope from token if available, otherwise default
+	var sub, scope string
 	s.mu.RLock()
-	if issued, ok := s.issuedTokens[token]; ok && issued.Subject != "" {
-		sub = issued.Subject
+	if issued, ok := s.issuedTokens[token]; ok {
+		sub, scope = issued.Subject, issued.Scope
 	}
 	s.mu.RUnlock()
 
-	userInfo := map[string]interface{}{
-		"sub":   sub,
-		"name":  "Test User",
-		"email": "test@example.com",
-	}
+	user := s.userFor(sub)
+	userInfo := s.userClaims(user, scope)
+	userInfo["sub"] = user.Subject
 
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(userInfo)
@@ -1242,32 +1225,7 @@ func hasScope(scopeString, target string) bool {
 //   - Reject tokens with alg: none
 //   - Verify signatures against the IdP's JWKS
 func (s *OAuthServer) generateIDToken(clientID, scope, nonce string) string {
-	now := s.clock.Now()
-
-	claims := idTokenClaims{
-		Iss:           s.config.Issuer,
-		Sub:           "test-user-123",
-		Aud:           clientID,
-		Exp:           now.Add(s.config.TokenLifetime).Unix(),
-		Iat:           now.Unix(),
-		Nonce:         nonce,
-		Email:         "test@example.com",
-		EmailVerified: true,
-		Name:          "Test User",
-	}
-
-	// Include groups claim if the "groups" scope was requested
-	if hasScope(scope, "groups") {
-		claims.Groups = []string{"test-group", "developers"}
-	}
-
-	claimsJSON, err := json.Marshal(claims)
-	if err != nil {
-		// This should never happen with our fixed struct
-		panic(fmt.Errorf("failed to marshal ID token claims: %w", err))
-	}
-
-	return s.encodeIDToken(claimsJSON)
+	return s.generateIDTokenWithSub(clientID, scope, "", nonce)
 }
 
 // WaitForReady waits for the OAuth server to be ready
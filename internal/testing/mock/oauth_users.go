@@ -0,0 +1,135 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// defaultSubject is the subject of the default test user, logged in when the
+// server has no configured users or a login names an unknown subject.
+const defaultSubject = "test-user-123"
+
+// MockUser is an identity the mock OAuth server can log in. Its profile and
+// claims end up in the ID tokens and userinfo responses issued for it.
+type MockUser struct {
+	// Subject is the sub claim identifying the user
+	Subject string
+
+	// Email is the email claim; email_verified is set when it is non-empty
+	Email string
+
+	// Name is the name claim
+	Name string
+
+	// Groups is the groups claim, included when the "groups" scope is requested
+	Groups []string
+
+	// Claims are additional claims for this user, e.g. a tenant ID. They
+	// take precedence over the server-wide claims.
+	Claims map[string]any
+}
+
+// reservedClaims are the registered claims the server always sets itself;
+// custom claims cannot override them.
+var reservedClaims = map[string]bool{
+	"iss":   true,
+	"sub":   true,
+	"aud":   true,
+	"exp":   true,
+	"iat":   true,
+	"nonce": true,
+}
+
+// IsReservedClaim reports whether name is a registered claim that custom
+// claims cannot set.
+func IsReservedClaim(name string) bool {
+	return reservedClaims[name]
+}
+
+// defaultUser returns the default test user. A non-empty subject replaces the
+// default subject, which keeps ad-hoc per-user logins working without
+// configuring users.
+func defaultUser(subject string) MockUser {
+	if subject == "" {
+		subject = defaultSubject
+	}
+	return MockUser{
+		Subject: subject,
+		Email:   "test@example.com",
+		Name:    "Test User",
+		Groups:  []string{"test-group", "developers"},
+	}
+}
+
+// userFor returns the configured user with the given subject or email, or
+// the default user for any other subject.
+func (s *OAuthServer) userFor(subject string) MockUser {
+	for _, user := range s.config.Users {
+		if user.Subject == subject || (user.Email != "" && user.Email == subject) {
+			return user
+		}
+	}
+	return defaultUser(subject)
+}
+
+// nextLoginSubject returns the subject for a login that does not name a user.
+// With configured users it cycles through them so consecutive logins yield
+// distinct users; otherwise it returns "" for the default user. The caller
+// must hold s.mu.
+func (s *OAuthServer) nextLoginSubject() string {
+	if len(s.config.Users) == 0 {
+		return ""
+	}
+	user := s.config.Users[s.nextUser%len(s.config.Users)]
+	s.nextUser++
+	return user.Subject
+}
+
+// userClaims returns the profile and custom claims for user. Groups are only
+// included when scope requests them, as Dex does.
+func (s *OAuthServer) userClaims(user MockUser, scope string) map[string]any {
+	claims := make(map[string]any, len(s.config.Claims)+len(user.Claims)+4)
+	for name, value := range s.config.Claims {
+		claims[name] = value
+	}
+	for name, value := range user.Claims {
+		claims[name] = value
+	}
+	if user.Email != "" {
+		claims["email"] = user.Email
+		claims["email_verified"] = true
+	}
+	if user.Name != "" {
+		claims["name"] = user.Name
+	}
+	if len(user.Groups) > 0 && hasScope(scope, "groups") {
+		claims["groups"] = user.Groups
+	}
+	for name := range reservedClaims {
+		delete(claims, name)
+	}
+	return claims
+}
+
+// idTokenClaimsJSON serializes the ID token claims for subject.
+func (s *OAuthServer) idTokenClaimsJSON(clientID, scope, subject, nonce string) []byte {
+	user := s.userFor(subject)
+	now := s.clock.Now()
+
+	claims := s.userClaims(user, scope)
+	claims["iss"] = s.config.Issuer
+	claims["sub"] = user.Subject
+	claims["aud"] = clientID
+	claims["exp"] = now.Add(s.config.TokenLifetime).Unix()
+	claims["iat"] = now.Unix()
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		// Claims come from YAML and are always JSON-encodable
+		panic(fmt.Errorf("failed to marshal ID token claims: %w", err))
+	}
+	return claimsJSON
+}
@@ -0,0 +1,125 @@
+package mock
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeIDTokenClaims returns the payload of a compact JWT without verifying it.
+func decodeIDTokenClaims(t *testing.T, token string) map[string]any {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var claims map[string]any
+	require.NoError(t, json.Unmarshal(payload, &claims))
+	return claims
+}
+
+func newUsersServer() *OAuthServer {
+	return NewOAuthServer(OAuthServerConfig{
+		Issuer: "http://idp.example.com",
+		Claims: map[string]any{"tenant": "acme", "sub": "ignored"},
+		Users: []MockUser{
+			{Subject: "alice", Email: "alice@acme.com", Name: "Alice", Groups: []string{"admins"}},
+			{Subject: "bob", Email: "bob@globex.com", Groups: []string{"viewers"}, Claims: map[string]any{"tenant": "globex"}},
+		},
+	})
+}
+
+func loginClaims(t *testing.T, server *OAuthServer, scope, subject string) (map[string]any, *TokenResponse) {
+	t.Helper()
+	code := server.GenerateAuthCodeWithSubject("test-client", "http://localhost/callback", scope, "state", "", "", subject, "n-1")
+	resp, err := server.SimulateCallback(code)
+	require.NoError(t, err)
+	return decodeIDTokenClaims(t, resp.IDToken), resp
+}
+
+func TestOAuthServer_UsersCycleAcrossLogins(t *testing.T) {
+	server := newUsersServer()
+
+	var subjects []string
+	for range 3 {
+		claims, _ := loginClaims(t, server, "openid", "")
+		subjects = append(subjects, claims["sub"].(string))
+	}
+	assert.Equal(t, []string{"alice", "bob", "alice"}, subjects)
+}
+
+func TestOAuthServer_UserClaims(t *testing.T) {
+	server := newUsersServer()
+
+	claims, _ := loginClaims(t, server, "openid email groups", "alice@acme.com")
+	assert.Equal(t, "alice", claims["sub"])
+	assert.Equal(t, "Alice", claims["name"])
+	assert.Equal(t, true, claims["email_verified"])
+	assert.Equal(t, []any{"admins"}, claims["groups"])
+	assert.Equal(t, "acme", claims["tenant"])
+	assert.Equal(t, "n-1", claims["nonce"])
+	assert.Equal(t, "http://idp.example.com", claims["iss"])
+
+	// Per-user claims win over server claims; groups need the groups scope.
+	claims, _ = loginClaims(t, server, "openid", "bob")
+	assert.Equal(t, "globex", claims["tenant"])
+	assert.NotContains(t, claims, "groups")
+	assert.NotContains(t, claims, "name")
+
+	// Unknown subjects get the default profile.
+	claims, _ = loginClaims(t, server, "openid groups", "carol")
+	assert.Equal(t, "carol", claims["sub"])
+	assert.Equal(t, "test@example.com", claims["email"])
+	assert.Equal(t, []any{"test-group", "developers"}, claims["groups"])
+}
+
+func TestOAuthServer_DefaultUserUnchanged(t *testing.T) {
+	server := NewOAuthServer(OAuthServerConfig{Issuer: "http://idp.example.com"})
+
+	claims, _ := loginClaims(t, server, "openid", "")
+	assert.Equal(t, "test-user-123", claims["sub"])
+	assert.Equal(t, "Test User", claims["name"])
+	assert.NotContains(t, claims, "groups")
+	assert.NotContains(t, claims, "tenant")
+}
+
+func TestOAuthServer_UserInfoReturnsUserClaims(t *testing.T) {
+	server := newUsersServer()
+	_, resp := loginClaims(t, server, "openid groups", "bob")
+
+	req := httptest.NewRequest(http.MethodGet, "/userinfo", nil)
+	req.Header.Set("Authorization", "Bearer "+resp.AccessToken)
+	rec := httptest.NewRecorder()
+	server.handleUserInfo(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var info map[string]any
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&info))
+	assert.Equal(t, "bob", info["sub"])
+	assert.Equal(t, "bob@globex.com", info["email"])
+	assert.Equal(t, "globex", info["tenant"])
+	assert.Equal(t, []any{"viewers"}, info["groups"])
+}
+
+func TestOAuthServer_AuthorizeLoginHint(t *testing.T) {
+	server := newUsersServer()
+	server.config.AutoApprove = true
+
+	req := httptest.NewRequest(http.MethodGet, "/authorize?response_type=code&client_id=test-client&redirect_uri=http://localhost/callback&scope=openid&login_hint=bob", nil)
+	rec := httptest.NewRecorder()
+	server.handleAuthorize(rec, req)
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	location, err := url.Parse(rec.Header().Get("Location"))
+	require.NoError(t, err)
+	resp, err := server.SimulateCallback(location.Query().Get("code"))
+	require.NoError(t, err)
+	assert.Equal(t, "bob", decodeIDTokenClaims(t, resp.IDToken)["sub"])
+}
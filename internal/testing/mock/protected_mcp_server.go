@@ -224,7 +224,7 @@ func (s *ProtectedMCPServer) createProtectedHandler() (http.Handler, error) {
 			server.WithMessageEndpoint("/message"),
 		)
 	default:
-		underlyingHandler = server.NewStreamableHTTPServer(mcpServer, server.WithStateful(true))
+		underlyingHandler = withConnectionDrop(server.NewStreamableHTTPServer(mcpServer, server.WithStateful(true)))
 	}
 
 	// Create OAuth protection middleware
@@ -3,6 +3,7 @@ package mock
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -24,7 +25,11 @@ type Server struct {
 	templateEngine *template.Engine
 	mcpServer      *server.MCPServer
 	debug          bool
-	mu             sync.RWMutex
+	// stdio is set once the server serves over stdio; an injected connection
+	// drop then terminates the process, which is how a stdio peer loses its
+	// connection.
+	stdio bool
+	mu    sync.RWMutex
 }
 
 // NewServerFromFile creates a new mock MCP server from a configuration file
@@ -66,6 +71,9 @@ func NewServerFromFile(configPath string, debug bool) (*Server, error) {
 
 	// Initialize tool handlers and register tools
 	for _, toolConfig := range configData.Tools {
+		if err := toolConfig.Faults.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid faults for tool %s in %s: %w", toolConfig.Name, configPath, err)
+		}
 		handler := NewToolHandler(toolConfig, mockServer.templateEngine, debug)
 		mockServer.toolHandlers[toolConfig.Name] = handler
 
@@ -119,7 +127,16 @@ func (s *Server) createToolHandler(toolName string) func(context.Context, mcp.Ca
 		args := request.GetArguments()
 
 		// Handle the tool call
-		result, err := handler.HandleCall(args)
+		result, err := handler.HandleCallContext(ctx, args)
+		if errors.Is(err, ErrConnectionDropped) {
+			s.mu.RLock()
+			stdio := s.stdio
+			s.mu.RUnlock()
+			if !dropConnection(ctx) && stdio {
+				fmt.Fprintf(os.Stderr, "🔌 Mock MCP server '%s' exiting to simulate a dropped connection\n", s.name)
+				os.Exit(1)
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -185,6 +202,10 @@ func (s *Server) Start(ctx context.Context) error {
 		fmt.Fprintf(os.Stderr, "🚀 Starting mock MCP server '%s' on stdio transport\n", s.name)
 	}
 
+	s.mu.Lock()
+	s.stdio = true
+	s.mu.Unlock()
+
 	// Use the proper MCP library to serve stdio
 	// This handles all the protocol details correctly
 	return server.ServeStdio(s.mcpServer)
@@ -14,6 +14,8 @@ type ToolConfig struct {
 	// its decoded claims (sub, act, groups, aud, iss). Used to assert that a
 	// downstream backend accepts a broker-minted token end-to-end.
 	EchoToken bool `yaml:"echo_token,omitempty"`
+	// Faults injects latency, errors, and connection drops into calls
+	Faults *FaultConfig `yaml:"faults,omitempty"`
 }
 
 // ToolResponse defines a conditional response for a mock tool
@@ -111,6 +111,14 @@ func (lc *logCapture) close() {
 	lc.wg.Wait()
 }
 
+// reset discards the logs captured so far
+func (lc *logCapture) reset() {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.stdoutBuf.Reset()
+	lc.stderrBuf.Reset()
+}
+
 // getLogs returns the captured logs
 func (lc *logCapture) getLogs() *InstanceLogs {
 	lc.mu.RLock()
@@ -191,6 +199,14 @@ type musterInstanceManager struct {
 
 	// Protected MCP server tracking (OAuth-protected mock MCP servers)
 	protectedMCPServers map[string]map[string]*mock.ProtectedMCPServer // instanceID -> serverName -> server
+
+	// kube is set in Kubernetes mode: instances then run with kubernetes: true
+	// and discover their MCPServers and Workflows through the API server.
+	kube *kubeTestEnv
+
+	// pool is set when instances are reused across scenarios with the same
+	// pre-configuration (see EnableInstanceReuse)
+	pool *instancePool
 }
 
 // NewMusterInstanceManagerWithLogger creates a new muster instance manager with custom logger
@@ -233,6 +249,14 @@ func (m *musterInstanceManager) CreateInstance(ctx context.Context, scenarioName
 		logger = m.logger
 	}
 
+	// Hand out a warmed-up instance if one with the same configuration is idle
+	poolKey, poolable := m.poolKey(config)
+	if poolable {
+		if instance := m.acquirePooledInstance(poolKey, scenarioName, logger); instance != nil {
+			return instance, nil
+		}
+	}
+
 	// Generate unique instance ID
 	instanceID := fmt.Sprintf("test-%s-%d", sanitizeFileName(scenarioName), time.Now().UnixNano())
 
@@ -279,10 +303,24 @@ func (m *musterInstanceManager) CreateInstance(ctx context.Context, scenarioName
 		return nil, fmt.Errorf("failed to generate config files: %w", err)
 	}
 
+	// In Kubernetes mode, move the generated resources into the instance namespace
+	if m.kube != nil {
+		if err := m.kube.prepareInstance(ctx, instanceID, filepath.Join(configPath, "muster")); err != nil {
+			_ = m.kube.cleanupInstance(ctx, instanceID)
+			m.stopMockHTTPServers(ctx, instanceID, logger)
+			m.releasePort(port, instanceID, logger)
+			_ = os.RemoveAll(configPath)
+			return nil, fmt.Errorf("failed to apply Kubernetes resources: %w", err)
+		}
+	}
+
 	// Start muster serve process with log capture
 	managedProc, err := m.startMusterProcess(ctx, configPath, port, logger)
 	if err != nil {
 		// Clean up on failure: stop mock servers, release port and remove config directory
+		if m.kube != nil {
+			_ = m.kube.cleanupInstance(ctx, instanceID)
+		}
 		m.stopMockHTTPServers(ctx, instanceID, logger)
 		m.releasePort(port, instanceID, logger)
 		_ = os.RemoveAll(configPath)
@@ -326,6 +364,10 @@ func (m *musterInstanceManager) CreateInstance(ctx context.Context, scenarioName
 		logger.Debug("🚀 Started muster instance %s on port %d (PID: %d)\n", instanceID, port, managedProc.cmd.Process.Pid)
 	}
 
+	if poolable {
+		m.trackPooledInstance(poolKey, instance, logger)
+	}
+
 	return instance, nil
 }
 
@@ -337,6 +379,11 @@ func (m *musterInstanceManager) DestroyInstance(ctx context.Context, instance *M
 		logger = m.logger
 	}
 
+	// Keep reusable instances running for the next scenario
+	if m.releaseToPool(ctx, instance, logger) {
+		return nil
+	}
+
 	if m.debug {
 		logger.Debug("🛑 Destroying muster instance %s (PID: %d)\n", instance.ID, instance.Process.Pid)
 	}
@@ -375,6 +422,13 @@ func (m *musterInstanceManager) DestroyInstance(ctx context.Context, instance *M
 	// Stop mock OAuth servers for this instance
 	m.stopMockOAuthServers(ctx, instance.ID, logger)
 
+	// Delete the instance namespace in Kubernetes mode
+	if m.kube != nil {
+		if err := m.kube.cleanupInstance(ctx, instance.ID); err != nil && m.debug {
+			logger.Debug("⚠️  %v\n", err)
+		}
+	}
+
 	// Release the reserved port
 	m.releasePort(instance.Port, instance.ID, logger)
 
@@ -983,6 +1037,13 @@ func (m *musterInstanceManager) startMusterProcess(ctx context.Context, configPa
 	// Configure the process attributes (platform-specific)
 	configureProcAttr(cmd)
 
+	// Point muster serve at the test API server in Kubernetes mode
+	if m.kube != nil {
+		if env := m.kube.environ(); len(env) > 0 {
+			cmd.Env = append(os.Environ(), env...)
+		}
+	}
+
 	if m.debug {
 		logger.Debug("🚀 Starting command: %s %v\n", musterPath, args)
 	}
@@ -1391,6 +1452,12 @@ func (m *musterInstanceManager) generateConfigFilesWithMocks(configPath string,
 		},
 	}
 
+	// In Kubernetes mode, use the CRD backend scoped to the instance namespace
+	if m.kube != nil {
+		mainConfig["kubernetes"] = true
+		mainConfig["namespace"] = kubeNamespaceForInstance(instanceID)
+	}
+
 	// Apply custom main config if provided
 	if config != nil && config.MainConfig != nil {
 		for key, value := range config.MainConfig.Config {
@@ -1792,8 +1859,27 @@ func (m *musterInstanceManager) extractExpectedToolsWithHTTPMocks(config *Muster
 	return expectedTools
 }
 
+// EnableKubernetes switches the manager to Kubernetes mode (see
+// KubernetesModeEnvtest and KubernetesModeCluster). It must be called before
+// any instance is created.
+func (m *musterInstanceManager) EnableKubernetes(mode string) error {
+	kube, err := startKubeTestEnv(mode, m.tempDir, m.logger)
+	if err != nil {
+		return err
+	}
+	m.kube = kube
+	return nil
+}
+
 // Cleanup cleans up all temporary directories created by this manager
 func (m *musterInstanceManager) Cleanup() error {
+	m.drainPool()
+	if m.kube != nil {
+		if err := m.kube.stop(); err != nil {
+			m.logger.Error("⚠️  %v\n", err)
+		}
+		m.kube = nil
+	}
 	if m.tempDir != "" && !m.keepTempConfig {
 		return os.RemoveAll(m.tempDir)
 	}
@@ -70,6 +70,16 @@ func (m *musterInstanceManager) startMockOAuthServers(
 			Debug:          m.debug,
 			UseTLS:         useTLS,
 			SignTokens:     signTokens,
+			Claims:         oauthCfg.Claims,
+		}
+		for _, user := range oauthCfg.Users {
+			serverConfig.Users = append(serverConfig.Users, mock.MockUser{
+				Subject: user.Subject,
+				Email:   user.Email,
+				Name:    user.Name,
+				Groups:  user.Groups,
+				Claims:  user.Claims,
+			})
 		}
 
 		// Use mock clock if configured (enables test_advance_oauth_clock tool)
@@ -533,6 +543,15 @@ func (m *musterInstanceManager) extractToolConfigs(config map[string]interface{}
 		if schema, ok := toolMap["input_schema"].(map[string]interface{}); ok {
 			tool.InputSchema = schema
 		}
+		if faults, ok := toolMap["faults"]; ok {
+			// Round-trip through YAML to reuse FaultConfig's field names.
+			if data, err := yaml.Marshal(faults); err == nil {
+				var fc mock.FaultConfig
+				if yaml.Unmarshal(data, &fc) == nil && fc.Validate() == nil {
+					tool.Faults = &fc
+				}
+			}
+		}
 
 		// Extract responses
 		if responses, ok := toolMap["responses"].([]interface{}); ok {
@@ -0,0 +1,380 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// instanceResetTimeout bounds how long a released instance may take to
+// converge back to its pre-configured state before it is destroyed instead
+// of being reused.
+const instanceResetTimeout = 15 * time.Second
+
+// instanceResourceDirs are the directories, relative to an instance's muster
+// config directory, holding the resources scenarios can create or change.
+var instanceResourceDirs = []string{"mcpservers", "workflows"}
+
+// instancePool keeps warmed-up muster instances for reuse by scenarios with
+// identical pre-configuration. Protected by musterInstanceManager.mu.
+type instancePool struct {
+	maxIdle int
+	// idle holds released instances, oldest first
+	idle []*pooledInstance
+	// leased holds the poolable instances in use, by instance ID
+	leased map[string]*pooledInstance
+}
+
+// pooledInstance is a poolable instance with the state to reset it to.
+type pooledInstance struct {
+	key      string
+	instance *MusterInstance
+	// resources holds the resource files the instance started with, by path
+	// relative to the muster config directory
+	resources map[string][]byte
+	// discard is set when the scenario changed state the reset cannot undo
+	discard bool
+}
+
+// EnableInstanceReuse keeps up to maxIdle released instances running and hands
+// them to later scenarios with the same pre-configuration, after resetting
+// the resources the previous scenario created or changed.
+func (m *musterInstanceManager) EnableInstanceReuse(maxIdle int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pool = &instancePool{maxIdle: maxIdle, leased: make(map[string]*pooledInstance)}
+}
+
+// poolKey identifies instances that can stand in for each other. Instances
+// with mock OAuth servers or muster's token broker are not pooled, since
+// their sessions and tokens cannot be reset; neither are instances in
+// Kubernetes mode.
+func (m *musterInstanceManager) poolKey(config *MusterPreConfiguration) (string, bool) {
+	m.mu.RLock()
+	enabled := m.pool != nil && m.kube == nil
+	m.mu.RUnlock()
+	if !enabled {
+		return "", false
+	}
+	if config != nil && (len(config.MockOAuthServers) > 0 || config.MusterBroker != nil) {
+		return "", false
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), true
+}
+
+// acquirePooledInstance takes an idle instance with the given key out of the
+// pool, or returns nil if there is none.
+func (m *musterInstanceManager) acquirePooledInstance(key, scenarioName string, logger TestLogger) *MusterInstance {
+	m.mu.Lock()
+	var entry *pooledInstance
+	for i := len(m.pool.idle) - 1; i >= 0; i-- {
+		if m.pool.idle[i].key == key {
+			entry = m.pool.idle[i]
+			m.pool.idle = append(m.pool.idle[:i], m.pool.idle[i+1:]...)
+			break
+		}
+	}
+	if entry == nil {
+		m.mu.Unlock()
+		return nil
+	}
+	managedProc := m.processes[entry.instance.ID]
+	if managedProc == nil || processExited(managedProc) {
+		m.mu.Unlock()
+		if m.debug {
+			logger.Debug("⚠️  Pooled muster instance %s has exited, starting a new one\n", entry.instance.ID)
+		}
+		_ = m.DestroyInstance(context.Background(), entry.instance, logger)
+		return nil
+	}
+	m.pool.leased[entry.instance.ID] = entry
+	m.mu.Unlock()
+
+	// Start the logs afresh so each scenario only reports its own
+	if managedProc.logCapture != nil {
+		managedProc.logCapture.reset()
+	}
+	entry.instance.Logs = nil
+
+	if m.debug {
+		logger.Debug("♻️  Reusing muster instance %s for scenario %s\n", entry.instance.ID, scenarioName)
+	}
+	return entry.instance
+}
+
+// trackPooledInstance records a newly created poolable instance, together
+// with the resource files to reset it to.
+func (m *musterInstanceManager) trackPooledInstance(key string, instance *MusterInstance, logger TestLogger) {
+	resources, err := snapshotResourceFiles(filepath.Join(instance.ConfigPath, "muster"))
+	if err != nil {
+		if m.debug {
+			logger.Debug("⚠️  Not pooling muster instance %s: %v\n", instance.ID, err)
+		}
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pool.leased[instance.ID] = &pooledInstance{key: key, instance: instance, resources: resources}
+}
+
+// discardPooledInstance prevents an instance from being reused, because the
+// scenario changed state outside muster's resources, e.g. in mock servers.
+func (m *musterInstanceManager) discardPooledInstance(instanceID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.pool == nil {
+		return
+	}
+	if entry, ok := m.pool.leased[instanceID]; ok {
+		entry.discard = true
+	}
+}
+
+// releaseToPool resets a poolable instance and returns it to the pool. It
+// reports false if the instance must be destroyed instead.
+func (m *musterInstanceManager) releaseToPool(ctx context.Context, instance *MusterInstance, logger TestLogger) bool {
+	m.mu.Lock()
+	if m.pool == nil {
+		m.mu.Unlock()
+		return false
+	}
+	entry, ok := m.pool.leased[instance.ID]
+	delete(m.pool.leased, instance.ID)
+	managedProc := m.processes[instance.ID]
+	m.mu.Unlock()
+	if !ok || entry.discard || managedProc == nil || processExited(managedProc) {
+		return false
+	}
+
+	if err := m.resetInstance(ctx, entry, logger); err != nil {
+		if m.debug {
+			logger.Debug("⚠️  Could not reset muster instance %s for reuse: %v\n", instance.ID, err)
+		}
+		return false
+	}
+	if managedProc.logCapture != nil {
+		instance.Logs = managedProc.logCapture.getLogs()
+	}
+
+	m.mu.Lock()
+	m.pool.idle = append(m.pool.idle, entry)
+	var evicted []*pooledInstance
+	if excess := len(m.pool.idle) - m.pool.maxIdle; excess > 0 {
+		evicted = append(evicted, m.pool.idle[:excess]...)
+		m.pool.idle = m.pool.idle[excess:]
+	}
+	m.mu.Unlock()
+
+	for _, old := range evicted {
+		if err := m.DestroyInstance(ctx, old.instance, logger); err != nil && m.debug {
+			logger.Debug("⚠️  Failed to destroy evicted muster instance %s: %v\n", old.instance.ID, err)
+		}
+	}
+	if m.debug {
+		logger.Debug("♻️  Returned muster instance %s to the pool\n", instance.ID)
+	}
+	return true
+}
+
+// drainPool destroys all idle instances.
+func (m *musterInstanceManager) drainPool() {
+	m.mu.Lock()
+	if m.pool == nil {
+		m.mu.Unlock()
+		return
+	}
+	idle := m.pool.idle
+	m.pool.idle = nil
+	m.mu.Unlock()
+
+	for _, entry := range idle {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		if err := m.DestroyInstance(ctx, entry.instance, m.logger); err != nil && m.debug {
+			m.logger.Debug("⚠️  Failed to destroy pooled muster instance %s: %v\n", entry.instance.ID, err)
+		}
+		cancel()
+	}
+}
+
+// resetInstance restores the resource files an instance started with and
+// waits until muster has reconciled them: exactly the pre-configured
+// workflows and MCP servers exist and the servers are ready again.
+func (m *musterInstanceManager) resetInstance(ctx context.Context, entry *pooledInstance, logger TestLogger) error {
+	musterDir := filepath.Join(entry.instance.ConfigPath, "muster")
+	if err := restoreResourceFiles(musterDir, entry.resources); err != nil {
+		return err
+	}
+
+	resetCtx, cancel := context.WithTimeout(ctx, instanceResetTimeout)
+	defer cancel()
+
+	client := NewMCPTestClientWithLogger(false, logger)
+	if err := client.Connect(resetCtx, entry.instance.Endpoint); err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	wantWorkflows := resourceNames(entry.resources, "workflows")
+	wantServers := resourceNames(entry.resources, "mcpservers")
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		pending, err := m.pendingReset(resetCtx, client, entry.instance, wantWorkflows, wantServers)
+		if err == nil && pending == "" {
+			return nil
+		}
+		select {
+		case <-resetCtx.Done():
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("state did not reset: %s", pending)
+		case <-ticker.C:
+		}
+	}
+}
+
+// pendingReset describes how the instance still differs from its
+// pre-configured state, or returns "" once it no longer does.
+func (m *musterInstanceManager) pendingReset(ctx context.Context, client MCPTestClient, instance *MusterInstance, wantWorkflows, wantServers []string) (string, error) {
+	workflows, err := m.checkWorkflowsAvailability(client, ctx)
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(workflows)
+	if !reflect.DeepEqual(workflows, wantWorkflows) && (len(workflows) > 0 || len(wantWorkflows) > 0) {
+		return fmt.Sprintf("workflows %v, want %v", workflows, wantWorkflows), nil
+	}
+
+	serverStates, err := m.checkMCPServersAvailability(client, ctx)
+	if err != nil {
+		return "", err
+	}
+	for name := range serverStates {
+		if !slices.Contains(wantServers, name) {
+			return fmt.Sprintf("unexpected MCP server %s", name), nil
+		}
+	}
+	if missing := m.findMissingMCPServers(instance.ExpectedMCPServers, serverStates); len(missing) > 0 {
+		return fmt.Sprintf("MCP servers not ready: %s", strings.Join(missing, ", ")), nil
+	}
+	return "", nil
+}
+
+// snapshotResourceFiles reads the resource files below musterDir.
+func snapshotResourceFiles(musterDir string) (map[string][]byte, error) {
+	resources := make(map[string][]byte)
+	for _, dir := range instanceResourceDirs {
+		files, err := resourceFiles(musterDir, dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(filepath.Join(musterDir, file)) //nolint:gosec
+			if err != nil {
+				return nil, fmt.Errorf("failed to read resource file %s: %w", file, err)
+			}
+			resources[file] = data
+		}
+	}
+	return resources, nil
+}
+
+// restoreResourceFiles removes resource files that are not in resources and
+// rewrites those whose spec differs from it. Status changes are left alone,
+// so resources the scenario did not touch are not reconciled again.
+func restoreResourceFiles(musterDir string, resources map[string][]byte) error {
+	for _, dir := range instanceResourceDirs {
+		files, err := resourceFiles(musterDir, dir)
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if _, ok := resources[file]; !ok {
+				if err := os.Remove(filepath.Join(musterDir, file)); err != nil {
+					return fmt.Errorf("failed to remove resource file %s: %w", file, err)
+				}
+			}
+		}
+	}
+	for file, want := range resources {
+		path := filepath.Join(musterDir, file)
+		current, err := os.ReadFile(path) //nolint:gosec
+		if err == nil && sameResourceSpec(current, want) {
+			continue
+		}
+		if err := os.WriteFile(path, want, 0o644); err != nil { //nolint:gosec
+			return fmt.Errorf("failed to restore resource file %s: %w", file, err)
+		}
+	}
+	return nil
+}
+
+// resourceFiles lists the YAML files of a resource directory as paths
+// relative to musterDir.
+func resourceFiles(musterDir, dir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(musterDir, dir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource directory %s: %w", dir, err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && (strings.HasSuffix(entry.Name(), ".yaml") || strings.HasSuffix(entry.Name(), ".yml")) {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+// sameResourceSpec reports whether two resource files have the same spec.
+func sameResourceSpec(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var docA, docB map[string]interface{}
+	if yaml.Unmarshal(a, &docA) != nil || yaml.Unmarshal(b, &docB) != nil {
+		return false
+	}
+	return reflect.DeepEqual(docA["spec"], docB["spec"])
+}
+
+// resourceNames returns the sorted names of the resources of one directory.
+func resourceNames(resources map[string][]byte, dir string) []string {
+	var names []string
+	for file := range resources {
+		if filepath.Dir(file) == dir {
+			names = append(names, strings.TrimSuffix(strings.TrimSuffix(filepath.Base(file), ".yaml"), ".yml"))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// processExited reports whether a managed process has exited.
+func processExited(managedProc *managedProcess) bool {
+	select {
+	case <-managedProc.exited:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeResourceFile(t *testing.T, musterDir, file, content string) {
+	t.Helper()
+	path := filepath.Join(musterDir, file)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestRestoreResourceFiles(t *testing.T) {
+	musterDir := t.TempDir()
+	writeResourceFile(t, musterDir, "workflows/deploy.yaml", "spec:\n  steps: [a]\n")
+	writeResourceFile(t, musterDir, "workflows/removed.yaml", "spec:\n  steps: [b]\n")
+	writeResourceFile(t, musterDir, "mcpservers/mock.yaml", "spec:\n  type: stdio\n")
+
+	resources, err := snapshotResourceFiles(musterDir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deploy", "removed"}, resourceNames(resources, "workflows"))
+	assert.Equal(t, []string{"mock"}, resourceNames(resources, "mcpservers"))
+
+	// The scenario creates, changes, and deletes resources, and muster
+	// records a status on the MCP server
+	writeResourceFile(t, musterDir, "workflows/created.yaml", "spec:\n  steps: [c]\n")
+	writeResourceFile(t, musterDir, "workflows/deploy.yaml", "spec:\n  steps: [changed]\n")
+	require.NoError(t, os.Remove(filepath.Join(musterDir, "workflows/removed.yaml")))
+	status := "spec:\n  type: stdio\nstatus:\n  state: Running\n"
+	writeResourceFile(t, musterDir, "mcpservers/mock.yaml", status)
+
+	require.NoError(t, restoreResourceFiles(musterDir, resources))
+
+	restored, err := snapshotResourceFiles(musterDir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deploy", "removed"}, resourceNames(restored, "workflows"))
+	assert.Equal(t, "spec:\n  steps: [a]\n", string(restored["workflows/deploy.yaml"]))
+	assert.Equal(t, "spec:\n  steps: [b]\n", string(restored["workflows/removed.yaml"]))
+	// Status-only changes are left alone so the server is not reconciled again
+	assert.Equal(t, status, string(restored["mcpservers/mock.yaml"]))
+}
+
+func TestPoolKey(t *testing.T) {
+	m := newPortTestManager(t, 18600)
+	config := &MusterPreConfiguration{Workflows: []WorkflowConfig{{Name: "deploy"}}}
+
+	_, poolable := m.poolKey(config)
+	assert.False(t, poolable, "reuse is disabled by default")
+
+	m.EnableInstanceReuse(2)
+	key, poolable := m.poolKey(config)
+	require.True(t, poolable)
+	same, _ := m.poolKey(&MusterPreConfiguration{Workflows: []WorkflowConfig{{Name: "deploy"}}})
+	assert.Equal(t, key, same)
+	other, _ := m.poolKey(&MusterPreConfiguration{Workflows: []WorkflowConfig{{Name: "other"}}})
+	assert.NotEqual(t, key, other)
+
+	_, poolable = m.poolKey(&MusterPreConfiguration{MockOAuthServers: []MockOAuthServerConfig{{Name: "idp"}}})
+	assert.False(t, poolable, "OAuth sessions cannot be reset")
+}
@@ -0,0 +1,192 @@
+package testing
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario file issue severities.
+const (
+	// IssueSeverityError marks problems that prevent the scenario from loading.
+	IssueSeverityError = "error"
+	// IssueSeverityWarning marks fields the loader silently ignores, which
+	// usually means a typo or an option that does not exist.
+	IssueSeverityWarning = "warning"
+)
+
+// ScenarioFileIssue is a structural problem found in a scenario file, such as
+// invalid YAML, an unknown field, or a missing required field.
+type ScenarioFileIssue struct {
+	File     string `json:"file"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// CheckScenarioFiles strictly decodes every scenario file at path (a file or
+// a directory searched recursively). Unlike LoadScenarios it does not stop at
+// the first bad file and it flags fields that are not part of the scenario
+// format, which the regular loader silently ignores; those are reported as
+// warnings. Suite files are checked against the scenarios found. Scenarios
+// without errors are returned so they can be validated further against the
+// API schema.
+func CheckScenarioFiles(path string) ([]TestScenario, []ScenarioFileIssue, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to stat scenario path: %w", err)
+	}
+
+	loader := &scenarioLoader{logger: NewStdoutLogger(false, false)}
+	var files, suiteFiles []string
+	if info.IsDir() {
+		err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			switch {
+			case d.IsDir() || !(loader.isYAMLFile(p) || isFeatureFile(p)):
+			case IsSuiteFile(p):
+				suiteFiles = append(suiteFiles, p)
+			default:
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to walk directory %s: %w", path, err)
+		}
+	} else {
+		files = []string{path}
+	}
+	sort.Strings(files)
+
+	var scenarios []TestScenario
+	var issues []ScenarioFileIssue
+	names := make(map[string]string)
+	for _, file := range files {
+		var fileScenarios []TestScenario
+		var fileIssues []ScenarioFileIssue
+		if isFeatureFile(file) {
+			fileScenarios, fileIssues = checkFeatureFile(loader, file)
+		} else {
+			scenario, yamlIssues := checkScenarioFile(loader, file)
+			fileScenarios, fileIssues = []TestScenario{scenario}, yamlIssues
+		}
+		if !HasScenarioErrors(fileIssues) {
+			for _, scenario := range fileScenarios {
+				if other, ok := names[scenario.Name]; ok {
+					fileIssues = append(fileIssues, ScenarioFileIssue{
+						File:     file,
+						Severity: IssueSeverityError,
+						Message:  fmt.Sprintf("duplicate scenario name %q (also defined in %s)", scenario.Name, other),
+					})
+				} else {
+					names[scenario.Name] = file
+					scenarios = append(scenarios, scenario)
+				}
+			}
+		}
+		issues = append(issues, fileIssues...)
+	}
+
+	sort.Strings(suiteFiles)
+	for _, file := range suiteFiles {
+		suite, err := loadSuiteFile(file)
+		if err == nil {
+			err = ValidateSuites([]TestSuite{suite}, scenarios)
+		}
+		if err != nil {
+			issues = append(issues, ScenarioFileIssue{File: file, Severity: IssueSeverityError, Message: err.Error()})
+		}
+	}
+
+	return scenarios, issues, nil
+}
+
+// HasScenarioErrors reports whether any issue has error severity.
+func HasScenarioErrors(issues []ScenarioFileIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == IssueSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// checkScenarioFile decodes and validates a single scenario file.
+func checkScenarioFile(loader *scenarioLoader, file string) (TestScenario, []ScenarioFileIssue) {
+	var scenario TestScenario
+	fail := func(msg string) []ScenarioFileIssue {
+		return []ScenarioFileIssue{{File: file, Severity: IssueSeverityError, Message: msg}}
+	}
+
+	content, err := os.ReadFile(file) //nolint:gosec
+	if err != nil {
+		return scenario, fail(err.Error())
+	}
+
+	var issues []ScenarioFileIssue
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	dec.KnownFields(true)
+	if err := dec.Decode(&scenario); err != nil {
+		if errors.Is(err, io.EOF) {
+			return scenario, fail("file is empty")
+		}
+		// yaml.TypeError carries one entry per offending field and still
+		// decodes the rest of the document, so unknown fields can be
+		// reported individually while the remaining checks proceed.
+		var typeErr *yaml.TypeError
+		if !errors.As(err, &typeErr) {
+			return scenario, fail(err.Error())
+		}
+		for _, msg := range typeErr.Errors {
+			severity := IssueSeverityError
+			if strings.Contains(msg, "not found in type") {
+				severity = IssueSeverityWarning
+			}
+			issues = append(issues, ScenarioFileIssue{File: file, Severity: severity, Message: msg})
+		}
+		if HasScenarioErrors(issues) {
+			return scenario, issues
+		}
+	}
+
+	if err := loader.validateScenario(scenario, file); err != nil {
+		issues = append(issues, fail(err.Error())...)
+	}
+	return scenario, issues
+}
+
+// checkFeatureFile parses and validates the scenarios of a Gherkin feature
+// file. The parser rejects every step it does not understand, so there are no
+// warnings.
+func checkFeatureFile(loader *scenarioLoader, file string) ([]TestScenario, []ScenarioFileIssue) {
+	fail := func(msg string) []ScenarioFileIssue {
+		return []ScenarioFileIssue{{File: file, Severity: IssueSeverityError, Message: msg}}
+	}
+
+	content, err := os.ReadFile(file) //nolint:gosec
+	if err != nil {
+		return nil, fail(err.Error())
+	}
+	scenarios, err := parseFeature(content)
+	if err != nil {
+		return nil, fail(err.Error())
+	}
+
+	var issues []ScenarioFileIssue
+	for _, scenario := range scenarios {
+		if err := loader.validateScenario(scenario, file); err != nil {
+			issues = append(issues, fail(fmt.Sprintf("scenario %s: %v", scenario.Name, err))...)
+		}
+	}
+	return scenarios, issues
+}
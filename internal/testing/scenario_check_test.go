@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeScenarioFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+const validScenarioYAML = `name: "valid"
+category: "behavioral"
+concept: "workflow"
+steps:
+  - id: "list"
+    tool: "core_workflow_list"
+    args: {}
+    expected:
+      success: true
+`
+
+func TestCheckScenarioFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeScenarioFile(t, dir, "a-valid.yaml", validScenarioYAML)
+	writeScenarioFile(t, dir, "b-duplicate.yaml", validScenarioYAML)
+	unknown := writeScenarioFile(t, dir, "unknown.yaml", `name: "unknown"
+category: "behavioral"
+concept: "workflow"
+stepz: []
+steps:
+  - id: "list"
+    tool: "core_workflow_list"
+    expected:
+      sucess: true
+`)
+	missing := writeScenarioFile(t, dir, "missing.yaml", `name: "missing"
+category: "behavioral"
+concept: "workflow"
+steps:
+  - id: "no-tool"
+`)
+	writeScenarioFile(t, dir, "notes.txt", "not a scenario")
+
+	scenarios, issues, err := CheckScenarioFiles(dir)
+	require.NoError(t, err)
+
+	// Unknown fields are warnings: the scenario still loads, the fields are ignored.
+	require.Len(t, scenarios, 2)
+	assert.Equal(t, "valid", scenarios[0].Name)
+	assert.Equal(t, "unknown", scenarios[1].Name)
+	assert.True(t, HasScenarioErrors(issues))
+
+	byFile := make(map[string][]ScenarioFileIssue)
+	for _, issue := range issues {
+		byFile[filepath.Base(issue.File)] = append(byFile[filepath.Base(issue.File)], issue)
+	}
+	unknownIssues := byFile[filepath.Base(unknown)]
+	require.Len(t, unknownIssues, 2)
+	assert.Equal(t, IssueSeverityWarning, unknownIssues[0].Severity)
+	assert.Contains(t, unknownIssues[0].Message, "field stepz not found")
+	assert.Contains(t, unknownIssues[1].Message, "field sucess not found")
+	assert.False(t, HasScenarioErrors(unknownIssues))
+
+	require.Len(t, byFile[filepath.Base(missing)], 1)
+	assert.Equal(t, IssueSeverityError, byFile[filepath.Base(missing)][0].Severity)
+	assert.Equal(t, "step 1: step tool is required", byFile[filepath.Base(missing)][0].Message)
+
+	require.Len(t, byFile["b-duplicate.yaml"], 1)
+	assert.Contains(t, byFile["b-duplicate.yaml"][0].Message, `duplicate scenario name "valid"`)
+}
+
+func TestCheckScenarioFilesMissingPath(t *testing.T) {
+	_, _, err := CheckScenarioFiles(filepath.Join(t.TempDir(), "absent"))
+	assert.Error(t, err)
+}
@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/giantswarm/muster/internal/testing/mock"
 )
 
 // scenarioLoader implements the TestScenarioLoader interface
@@ -52,11 +54,16 @@ func (l *scenarioLoader) LoadScenarios(configPath string) ([]TestScenario, error
 	}
 
 	if info.IsDir() {
-		// Load all YAML files from directory
+		// Load all YAML and feature files from directory
 		scenarios, err = l.loadScenariosFromDirectory(configPath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load scenarios from directory: %w", err)
 		}
+	} else if isFeatureFile(configPath) {
+		scenarios, err = l.loadScenariosFromFeatureFile(configPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load scenarios from file: %w", err)
+		}
 	} else {
 		// Load single file
 		scenario, err := l.loadScenarioFromFile(configPath)
@@ -77,7 +84,8 @@ func (l *scenarioLoader) LoadScenarios(configPath string) ([]TestScenario, error
 	return scenarios, nil
 }
 
-// loadScenariosFromDirectory loads all YAML scenario files from a directory
+// loadScenariosFromDirectory loads all YAML scenario files and Gherkin
+// feature files from a directory
 func (l *scenarioLoader) loadScenariosFromDirectory(dirPath string) ([]TestScenario, error) {
 	var scenarios []TestScenario
 
@@ -91,8 +99,20 @@ func (l *scenarioLoader) loadScenariosFromDirectory(dirPath string) ([]TestScena
 			return nil
 		}
 
-		// Only process YAML files
-		if !l.isYAMLFile(path) {
+		if isFeatureFile(path) {
+			if l.debug {
+				l.logger.Debug("📄 Loading feature file: %s\n", path)
+			}
+			featureScenarios, err := l.loadScenariosFromFeatureFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to load scenarios from %s: %w", path, err)
+			}
+			scenarios = append(scenarios, featureScenarios...)
+			return nil
+		}
+
+		// Only process YAML files; suite files are loaded by LoadSuites
+		if !l.isYAMLFile(path) || IsSuiteFile(path) {
 			return nil
 		}
 
@@ -139,6 +159,27 @@ func (l *scenarioLoader) loadScenarioFromFile(filePath string) (TestScenario, er
 	return scenario, nil
 }
 
+// loadScenariosFromFeatureFile loads the scenarios of a Gherkin feature file
+func (l *scenarioLoader) loadScenariosFromFeatureFile(filePath string) ([]TestScenario, error) {
+	content, err := os.ReadFile(filePath) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+
+	scenarios, err := parseFeature(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse feature %s: %w", filePath, err)
+	}
+
+	for _, scenario := range scenarios {
+		if err := l.validateScenario(scenario, filePath); err != nil {
+			return nil, fmt.Errorf("invalid scenario %s in %s: %w", scenario.Name, filePath, err)
+		}
+	}
+
+	return scenarios, nil
+}
+
 // validateScenario validates that a scenario has required fields
 func (l *scenarioLoader) validateScenario(scenario TestScenario, filePath string) error {
 	if scenario.Name == "" {
@@ -157,6 +198,14 @@ func (l *scenarioLoader) validateScenario(scenario TestScenario, filePath string
 		return fmt.Errorf("scenario must have at least one step")
 	}
 
+	if scenario.PreConfiguration != nil {
+		for _, server := range scenario.PreConfiguration.MockOAuthServers {
+			if err := validateMockOAuthServer(server); err != nil {
+				return fmt.Errorf("mock OAuth server %s: %w", server.Name, err)
+			}
+		}
+	}
+
 	// Validate each step
 	for i, step := range scenario.Steps {
 		if err := l.validateStep(step, i); err != nil {
@@ -171,6 +220,10 @@ func (l *scenarioLoader) validateScenario(scenario TestScenario, filePath string
 		}
 	}
 
+	if err := l.validateHooks(scenario.Hooks); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -197,6 +250,52 @@ func (l *scenarioLoader) validateStep(step TestStep, index int) error {
 		}
 	}
 
+	if err := l.validateHookSteps(step.Before); err != nil {
+		return fmt.Errorf("before hook %w", err)
+	}
+	if err := l.validateHookSteps(step.After); err != nil {
+		return fmt.Errorf("after hook %w", err)
+	}
+
+	for i, assertion := range step.Expected.Assertions {
+		if err := assertion.Validate(); err != nil {
+			return fmt.Errorf("assertion %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// validateMockOAuthServer checks the users and custom claims of a mock OAuth
+// server: subjects are set and unique, and custom claims do not override the
+// registered claims the server sets itself.
+func validateMockOAuthServer(server MockOAuthServerConfig) error {
+	if err := validateCustomClaims(server.Claims); err != nil {
+		return err
+	}
+	subjects := make(map[string]bool, len(server.Users))
+	for i, user := range server.Users {
+		if user.Subject == "" {
+			return fmt.Errorf("user %d: subject is required", i+1)
+		}
+		if subjects[user.Subject] {
+			return fmt.Errorf("user %s is defined twice", user.Subject)
+		}
+		subjects[user.Subject] = true
+		if err := validateCustomClaims(user.Claims); err != nil {
+			return fmt.Errorf("user %s: %w", user.Subject, err)
+		}
+	}
+	return nil
+}
+
+// validateCustomClaims rejects custom claims that would override registered claims.
+func validateCustomClaims(claims map[string]interface{}) error {
+	for name := range claims {
+		if mock.IsReservedClaim(name) {
+			return fmt.Errorf("claim %s is set by the server and cannot be customized", name)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,188 @@
+package testing
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ScaffoldScenarioFromWorkflow builds a starting-point scenario for an
+// existing Workflow resource (the YAML stored in the workflows/ directory of a
+// muster configuration). The scenario pre-configures the workflow, adds a mock
+// MCP server stub for every downstream tool the workflow calls, and checks
+// that the workflow is available and can be executed with its required args.
+// The generated expectations are deliberately minimal; authors are expected
+// to refine mock responses and assertions.
+func ScaffoldScenarioFromWorkflow(data []byte) (TestScenario, error) {
+	var resource struct {
+		Kind     string `yaml:"kind"`
+		Metadata struct {
+			Name string `yaml:"name"`
+		} `yaml:"metadata"`
+		Spec map[string]interface{} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal(data, &resource); err != nil {
+		return TestScenario{}, fmt.Errorf("failed to parse workflow: %w", err)
+	}
+	if resource.Kind != "" && resource.Kind != "Workflow" {
+		return TestScenario{}, fmt.Errorf("expected kind Workflow, got %q", resource.Kind)
+	}
+	name := resource.Metadata.Name
+	if name == "" {
+		return TestScenario{}, fmt.Errorf("workflow metadata.name is required")
+	}
+	if resource.Spec == nil {
+		return TestScenario{}, fmt.Errorf("workflow %s has no spec", name)
+	}
+
+	config := make(map[string]interface{}, len(resource.Spec)+1)
+	for k, v := range resource.Spec {
+		config[k] = v
+	}
+	config["name"] = name
+
+	scenario := TestScenario{
+		Name:        "workflow-" + name + "-basic",
+		Category:    CategoryBehavioral,
+		Concept:     ConceptWorkflow,
+		Description: fmt.Sprintf("Scaffolded from workflow %s: verifies the workflow is available and executes with its required args", name),
+		Tags:        []string{"workflow", "scaffolded"},
+		PreConfiguration: &MusterPreConfiguration{
+			MCPServers: scaffoldMockServers(collectWorkflowTools(resource.Spec)),
+			Workflows:  []WorkflowConfig{{Name: name, Config: config}},
+		},
+		Steps: []TestStep{
+			{
+				ID:          "check-workflow-available",
+				Description: "The workflow and all tools it calls are available",
+				Tool:        "core_workflow_available",
+				Args:        map[string]interface{}{"name": name},
+				Expected: TestExpectation{
+					Success:  true,
+					JSONPath: map[string]interface{}{"available": true},
+				},
+			},
+			{
+				ID:          "execute-workflow",
+				Description: "Execute the workflow with placeholder values for its required args",
+				Tool:        "workflow_" + name,
+				Args:        scaffoldWorkflowArgs(resource.Spec["args"]),
+				Expected:    TestExpectation{Success: true},
+			},
+		},
+	}
+	return scenario, nil
+}
+
+// collectWorkflowTools returns the distinct tool names referenced anywhere in
+// a workflow spec, including nested control-flow steps and onFailure steps.
+func collectWorkflowTools(spec map[string]interface{}) []string {
+	seen := make(map[string]bool)
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch val := v.(type) {
+		case map[string]interface{}:
+			for k, child := range val {
+				if tool, ok := child.(string); ok && k == "tool" && tool != "" {
+					seen[tool] = true
+					continue
+				}
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range val {
+				walk(child)
+			}
+		}
+	}
+	walk(spec)
+
+	tools := make([]string, 0, len(seen))
+	for tool := range seen {
+		tools = append(tools, tool)
+	}
+	sort.Strings(tools)
+	return tools
+}
+
+// scaffoldMockServers creates a mock MCP server stub for every x_<server>_<tool>
+// reference. Core and workflow tools are provided by muster itself and tools
+// with a template in their name cannot be resolved statically, so both are
+// skipped.
+func scaffoldMockServers(tools []string) []MCPServerConfig {
+	byServer := make(map[string][]interface{})
+	var servers []string
+	for _, tool := range tools {
+		if strings.Contains(tool, "{{") {
+			continue
+		}
+		name, kind := coverageGroup(tool)
+		if kind != CoverageKindServer {
+			continue
+		}
+		if _, ok := byServer[name]; !ok {
+			servers = append(servers, name)
+		}
+		byServer[name] = append(byServer[name], map[string]interface{}{
+			"name":         strings.TrimPrefix(tool, "x_"+name+"_"),
+			"description":  "Scaffolded stub for " + tool,
+			"input_schema": map[string]interface{}{"type": "object"},
+			"responses": []interface{}{
+				map[string]interface{}{"response": map[string]interface{}{"status": "ok"}},
+			},
+		})
+	}
+	sort.Strings(servers)
+
+	configs := make([]MCPServerConfig, 0, len(servers))
+	for _, server := range servers {
+		configs = append(configs, MCPServerConfig{
+			Name:   server,
+			Config: map[string]interface{}{"tools": byServer[server]},
+		})
+	}
+	return configs
+}
+
+// scaffoldWorkflowArgs returns placeholder values for the workflow's required
+// args. Args with a default are left out since the workflow fills them in.
+func scaffoldWorkflowArgs(raw interface{}) map[string]interface{} {
+	args := make(map[string]interface{})
+	defs, ok := raw.(map[string]interface{})
+	if !ok {
+		return args
+	}
+	for name, rawDef := range defs {
+		def, ok := rawDef.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if required, _ := def["required"].(bool); !required {
+			continue
+		}
+		if _, hasDefault := def["default"]; hasDefault {
+			continue
+		}
+		argType, _ := def["type"].(string)
+		args[name] = placeholderForArgType(argType)
+	}
+	return args
+}
+
+// placeholderForArgType returns an example value for a workflow arg type.
+func placeholderForArgType(argType string) interface{} {
+	switch argType {
+	case "integer", "number":
+		return 1
+	case "boolean":
+		return true
+	case "object":
+		return map[string]interface{}{}
+	case "array":
+		return []interface{}{}
+	default:
+		return "example"
+	}
+}
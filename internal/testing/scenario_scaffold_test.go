@@ -0,0 +1,84 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const scaffoldWorkflowYAML = `apiVersion: muster.giantswarm.io/v1alpha1
+kind: Workflow
+metadata:
+  name: deploy-app
+spec:
+  description: Deploy an app
+  args:
+    app:
+      type: string
+      required: true
+    replicas:
+      type: integer
+      required: true
+    namespace:
+      type: string
+      required: true
+      default: default
+    dryRun:
+      type: boolean
+  steps:
+    - id: render
+      tool: x_helm_template
+      args:
+        name: "{{ .input.app }}"
+    - id: apply
+      tool: x_kubernetes_apply
+    - id: nested
+      tool: workflow_notify
+    - id: status
+      tool: core_service_list
+  onFailure:
+    - id: rollback
+      tool: x_kubernetes_delete
+`
+
+func TestScaffoldScenarioFromWorkflow(t *testing.T) {
+	scenario, err := ScaffoldScenarioFromWorkflow([]byte(scaffoldWorkflowYAML))
+	require.NoError(t, err)
+
+	assert.Equal(t, "workflow-deploy-app-basic", scenario.Name)
+	assert.Equal(t, ConceptWorkflow, scenario.Concept)
+
+	require.NotNil(t, scenario.PreConfiguration)
+	require.Len(t, scenario.PreConfiguration.Workflows, 1)
+	wf := scenario.PreConfiguration.Workflows[0]
+	assert.Equal(t, "deploy-app", wf.Name)
+	assert.Equal(t, "deploy-app", wf.Config["name"])
+	assert.Equal(t, "Deploy an app", wf.Config["description"])
+
+	servers := scenario.PreConfiguration.MCPServers
+	require.Len(t, servers, 2)
+	assert.Equal(t, "helm", servers[0].Name)
+	assert.Equal(t, "kubernetes", servers[1].Name)
+	kubeTools := servers[1].Config["tools"].([]interface{})
+	require.Len(t, kubeTools, 2)
+	assert.Equal(t, "apply", kubeTools[0].(map[string]interface{})["name"])
+	assert.Equal(t, "delete", kubeTools[1].(map[string]interface{})["name"])
+
+	require.Len(t, scenario.Steps, 2)
+	assert.Equal(t, "core_workflow_available", scenario.Steps[0].Tool)
+	assert.Equal(t, "workflow_deploy-app", scenario.Steps[1].Tool)
+	assert.Equal(t, map[string]interface{}{"app": "example", "replicas": 1}, scenario.Steps[1].Args)
+
+	// The scaffold must itself pass the loader's required-field checks.
+	loader := &scenarioLoader{logger: NewStdoutLogger(false, false)}
+	assert.NoError(t, loader.validateScenario(scenario, "scaffold"))
+}
+
+func TestScaffoldScenarioFromWorkflowErrors(t *testing.T) {
+	_, err := ScaffoldScenarioFromWorkflow([]byte("kind: MCPServer\nmetadata:\n  name: x\nspec: {}\n"))
+	assert.ErrorContains(t, err, "expected kind Workflow")
+
+	_, err = ScaffoldScenarioFromWorkflow([]byte("kind: Workflow\nspec:\n  steps: []\n"))
+	assert.ErrorContains(t, err, "metadata.name is required")
+}
@@ -0,0 +1,323 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"gopkg.in/yaml.v3"
+)
+
+// maxRecordedErrorLength bounds the error text turned into an error_contains
+// expectation; longer errors usually embed volatile details.
+const maxRecordedErrorLength = 200
+
+// RecordedToolCall is a single tool call captured by a SessionRecorder.
+type RecordedToolCall struct {
+	Tool   string
+	Args   map[string]interface{}
+	Result *mcp.CallToolResult
+	Err    error
+}
+
+// SessionRecorder captures the tool calls of an interactive session against a
+// real muster instance so they can be turned into a replayable scenario with
+// BuildScenario. It is safe for concurrent use.
+type SessionRecorder struct {
+	mu    sync.Mutex
+	calls []RecordedToolCall
+}
+
+// NewSessionRecorder creates an empty session recorder.
+func NewSessionRecorder() *SessionRecorder {
+	return &SessionRecorder{}
+}
+
+// RecordToolCall records a completed tool call. Test framework tools are
+// ignored since they only exist inside the test runner.
+func (r *SessionRecorder) RecordToolCall(tool string, args map[string]interface{}, result *mcp.CallToolResult, err error) {
+	if IsTestTool(tool) {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, RecordedToolCall{Tool: tool, Args: args, Result: result, Err: err})
+}
+
+// Calls returns a copy of the recorded calls.
+func (r *SessionRecorder) Calls() []RecordedToolCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RecordedToolCall(nil), r.calls...)
+}
+
+// WorkflowTools returns the names of the workflows executed during the
+// session, so their definitions can be fetched for BuildScenario.
+func (r *SessionRecorder) WorkflowTools() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, call := range r.Calls() {
+		name := strings.TrimPrefix(call.Tool, "workflow_")
+		if name == call.Tool || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// BuildScenario turns the recorded session into a scenario.
+//
+// Every call becomes a step that expects the recorded outcome; JSON object
+// responses additionally check that their top-level fields are present. Calls
+// to downstream tools (x_<server>_<tool>) are replaced by mock MCP servers
+// that return the recorded responses, using the input schemas from tools.
+// Since the mock responses are fixed, the steps calling them also check the
+// recorded top-level scalar values. workflows provides the definitions of
+// executed workflows to pre-configure.
+func (r *SessionRecorder) BuildScenario(name string, tools []mcp.Tool, workflows []WorkflowConfig) TestScenario {
+	calls := r.Calls()
+
+	schemas := make(map[string]interface{}, len(tools))
+	descriptions := make(map[string]string, len(tools))
+	for _, tool := range tools {
+		descriptions[tool.Name] = tool.Description
+		if data, err := json.Marshal(tool.InputSchema); err == nil {
+			var schema map[string]interface{}
+			if json.Unmarshal(data, &schema) == nil {
+				schemas[tool.Name] = schema
+			}
+		}
+	}
+
+	scenario := TestScenario{
+		Name:        name,
+		Category:    CategoryBehavioral,
+		Concept:     recordedConcept(calls),
+		Description: fmt.Sprintf("Recorded session with %d tool call(s)", len(calls)),
+		Tags:        []string{"recorded"},
+	}
+
+	stepIDs := make(map[string]int)
+	mocks := newRecordedMocks()
+	for _, call := range calls {
+		id := "call-" + strings.ReplaceAll(call.Tool, "_", "-")
+		stepIDs[id]++
+		if n := stepIDs[id]; n > 1 {
+			id = fmt.Sprintf("%s-%d", id, n)
+		}
+
+		text, isError := recordedResultText(call)
+		_, kind := coverageGroup(call.Tool)
+		// Mocked values can only be asserted if the mock serves this response.
+		mocked := kind == CoverageKindServer &&
+			mocks.add(call, text, isError, schemas[call.Tool], descriptions[call.Tool])
+
+		scenario.Steps = append(scenario.Steps, TestStep{
+			ID:       id,
+			Tool:     call.Tool,
+			Args:     call.Args,
+			Expected: recordedExpectation(text, isError, mocked),
+		})
+	}
+
+	if servers := mocks.servers(); len(servers) > 0 || len(workflows) > 0 {
+		scenario.PreConfiguration = &MusterPreConfiguration{
+			MCPServers: servers,
+			Workflows:  workflows,
+		}
+	}
+	return scenario
+}
+
+// EncodeScenarioYAML encodes a scenario with the two-space indentation used by
+// the checked-in scenarios.
+func EncodeScenarioYAML(w io.Writer, scenario TestScenario) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(scenario); err != nil {
+		return fmt.Errorf("failed to encode scenario: %w", err)
+	}
+	return enc.Close()
+}
+
+// recordedConcept picks the scenario concept from the kind of tools called
+// most often.
+func recordedConcept(calls []RecordedToolCall) TestConcept {
+	counts := make(map[TestConcept]int)
+	for _, call := range calls {
+		group, kind := coverageGroup(call.Tool)
+		switch {
+		case kind == CoverageKindWorkflow || group == "core_workflow":
+			counts[ConceptWorkflow]++
+		case kind == CoverageKindServer || group == "core_mcpserver":
+			counts[ConceptMCPServer]++
+		case group == "core_service":
+			counts[ConceptService]++
+		}
+	}
+	concept, best := ConceptMCPServer, 0
+	for _, c := range []TestConcept{ConceptMCPServer, ConceptWorkflow, ConceptService} {
+		if counts[c] > best {
+			concept, best = c, counts[c]
+		}
+	}
+	return concept
+}
+
+// recordedResultText returns the text of a call's result or error and whether
+// the call failed.
+func recordedResultText(call RecordedToolCall) (string, bool) {
+	if call.Err != nil {
+		return call.Err.Error(), true
+	}
+	if call.Result == nil {
+		return "", false
+	}
+	var parts []string
+	for _, content := range call.Result.Content {
+		if text, ok := mcp.AsTextContent(content); ok {
+			parts = append(parts, text.Text)
+		}
+	}
+	return strings.Join(parts, "\n"), call.Result.IsError
+}
+
+// recordedExpectation derives the step expectation from a recorded result.
+func recordedExpectation(text string, isError, mocked bool) TestExpectation {
+	if isError {
+		expected := TestExpectation{Success: false}
+		if text != "" && len(text) <= maxRecordedErrorLength && !strings.Contains(text, "\n") {
+			expected.ErrorContains = []string{text}
+		}
+		return expected
+	}
+
+	expected := TestExpectation{Success: true}
+	var object map[string]interface{}
+	if json.Unmarshal([]byte(text), &object) != nil {
+		return expected
+	}
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		expected.Contains = append(expected.Contains, fmt.Sprintf("%q", key))
+		if !mocked {
+			continue
+		}
+		switch value := object[key].(type) {
+		case string, bool, float64:
+			if expected.JSONPath == nil {
+				expected.JSONPath = make(map[string]interface{})
+			}
+			expected.JSONPath[key] = value
+		}
+	}
+	return expected
+}
+
+// recordedMocks collects mock MCP server tools from downstream tool calls.
+type recordedMocks struct {
+	order   []string
+	tools   map[string][]string
+	configs map[string]map[string]interface{}
+	seen    map[string]string
+}
+
+func newRecordedMocks() *recordedMocks {
+	return &recordedMocks{
+		tools:   make(map[string][]string),
+		configs: make(map[string]map[string]interface{}),
+		seen:    make(map[string]string),
+	}
+}
+
+// add records the response of a downstream tool call and reports whether the
+// mock will serve it. Calls with args get a condition on those args; repeated
+// calls with the same args keep the first response, since a mock returns the
+// first matching one.
+func (m *recordedMocks) add(call RecordedToolCall, text string, isError bool, schema interface{}, description string) bool {
+	server, _ := coverageGroup(call.Tool)
+	toolName := strings.TrimPrefix(call.Tool, "x_"+server+"_")
+
+	argsKey, _ := json.Marshal(call.Args)
+	key := call.Tool + string(argsKey)
+	if first, ok := m.seen[key]; ok {
+		return first == text
+	}
+	m.seen[key] = text
+
+	tool, ok := m.configs[call.Tool]
+	if !ok {
+		if schema == nil {
+			schema = map[string]interface{}{"type": "object"}
+		}
+		if description == "" {
+			description = "Recorded from " + call.Tool
+		}
+		tool = map[string]interface{}{
+			"name":         toolName,
+			"description":  description,
+			"input_schema": schema,
+			"responses":    []interface{}{},
+		}
+		m.configs[call.Tool] = tool
+		if _, known := m.tools[server]; !known {
+			m.order = append(m.order, server)
+		}
+		m.tools[server] = append(m.tools[server], call.Tool)
+	}
+
+	response := map[string]interface{}{}
+	if len(call.Args) > 0 {
+		response["condition"] = call.Args
+	}
+	if isError {
+		response["error"] = text
+	} else {
+		var value interface{}
+		if json.Unmarshal([]byte(text), &value) == nil {
+			response["response"] = value
+		} else {
+			response["response"] = text
+		}
+	}
+
+	// Conditional responses go first so a fallback does not shadow them.
+	responses := tool["responses"].([]interface{})
+	if len(call.Args) > 0 {
+		i := 0
+		for i < len(responses) && responses[i].(map[string]interface{})["condition"] != nil {
+			i++
+		}
+		responses = append(responses[:i], append([]interface{}{response}, responses[i:]...)...)
+	} else {
+		responses = append(responses, response)
+	}
+	tool["responses"] = responses
+	return true
+}
+
+// servers returns the mock server configurations in order of first use.
+func (m *recordedMocks) servers() []MCPServerConfig {
+	configs := make([]MCPServerConfig, 0, len(m.order))
+	for _, server := range m.order {
+		tools := make([]interface{}, 0, len(m.tools[server]))
+		for _, tool := range m.tools[server] {
+			tools = append(tools, m.configs[tool])
+		}
+		configs = append(configs, MCPServerConfig{
+			Name:   server,
+			Config: map[string]interface{}{"tools": tools},
+		})
+	}
+	return configs
+}
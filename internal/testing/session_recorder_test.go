@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSessionRecorderBuildScenario(t *testing.T) {
+	r := NewSessionRecorder()
+	r.RecordToolCall("core_service_list", nil, mcp.NewToolResultText(`{"services":[],"total":0}`), nil)
+	r.RecordToolCall("x_github_get_issue", map[string]interface{}{"number": float64(1)}, mcp.NewToolResultText(`{"title":"Bug","open":true,"labels":["a"]}`), nil)
+	r.RecordToolCall("x_github_get_issue", map[string]interface{}{"number": float64(2)}, mcp.NewToolResultError("issue not found"), nil)
+	r.RecordToolCall("x_github_get_issue", map[string]interface{}{"number": float64(1)}, mcp.NewToolResultText(`{"title":"Changed"}`), nil)
+	r.RecordToolCall("x_github_list_repos", nil, mcp.NewToolResultText("plain text"), nil)
+	r.RecordToolCall("workflow_deploy", nil, nil, errors.New("connection refused"))
+	r.RecordToolCall("test_create_user", nil, nil, nil)
+
+	assert.Len(t, r.Calls(), 6)
+	assert.Equal(t, []string{"deploy"}, r.WorkflowTools())
+
+	tools := []mcp.Tool{mcp.NewTool("x_github_get_issue", mcp.WithDescription("Get an issue"), mcp.WithNumber("number", mcp.Required()))}
+	workflows := []WorkflowConfig{{Name: "deploy", Config: map[string]interface{}{"steps": []interface{}{}}}}
+	scenario := r.BuildScenario("my-session", tools, workflows)
+
+	assert.Equal(t, "my-session", scenario.Name)
+	assert.Equal(t, ConceptMCPServer, scenario.Concept)
+	require.Len(t, scenario.Steps, 6)
+
+	ids := make([]string, len(scenario.Steps))
+	for i, step := range scenario.Steps {
+		ids[i] = step.ID
+	}
+	assert.Equal(t, []string{
+		"call-core-service-list",
+		"call-x-github-get-issue",
+		"call-x-github-get-issue-2",
+		"call-x-github-get-issue-3",
+		"call-x-github-list-repos",
+		"call-workflow-deploy",
+	}, ids)
+
+	// Core tools only check the response shape, mocked tools also the values.
+	assert.Equal(t, TestExpectation{Success: true, Contains: []string{`"services"`, `"total"`}}, scenario.Steps[0].Expected)
+	assert.Equal(t, map[string]interface{}{"title": "Bug", "open": true}, scenario.Steps[1].Expected.JSONPath)
+	assert.Equal(t, TestExpectation{Success: false, ErrorContains: []string{"issue not found"}}, scenario.Steps[2].Expected)
+	// The mock keeps serving the first response for repeated args.
+	assert.Nil(t, scenario.Steps[3].Expected.JSONPath)
+	assert.Equal(t, TestExpectation{Success: true}, scenario.Steps[4].Expected)
+	assert.Equal(t, TestExpectation{Success: false, ErrorContains: []string{"connection refused"}}, scenario.Steps[5].Expected)
+
+	require.NotNil(t, scenario.PreConfiguration)
+	assert.Equal(t, workflows, scenario.PreConfiguration.Workflows)
+	require.Len(t, scenario.PreConfiguration.MCPServers, 1)
+	server := scenario.PreConfiguration.MCPServers[0]
+	assert.Equal(t, "github", server.Name)
+
+	mockTools := server.Config["tools"].([]interface{})
+	require.Len(t, mockTools, 2)
+	getIssue := mockTools[0].(map[string]interface{})
+	assert.Equal(t, "get_issue", getIssue["name"])
+	assert.Equal(t, "Get an issue", getIssue["description"])
+	assert.Equal(t, "object", getIssue["input_schema"].(map[string]interface{})["type"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"condition": map[string]interface{}{"number": float64(1)},
+			"response":  map[string]interface{}{"title": "Bug", "open": true, "labels": []interface{}{"a"}},
+		},
+		map[string]interface{}{
+			"condition": map[string]interface{}{"number": float64(2)},
+			"error":     "issue not found",
+		},
+	}, getIssue["responses"])
+
+	listRepos := mockTools[1].(map[string]interface{})
+	assert.Equal(t, []interface{}{map[string]interface{}{"response": "plain text"}}, listRepos["responses"])
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeScenarioYAML(&buf, scenario))
+	var decoded TestScenario
+	require.NoError(t, yaml.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, scenario.Name, decoded.Name)
+	assert.Len(t, decoded.Steps, 6)
+}
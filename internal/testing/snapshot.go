@@ -0,0 +1,242 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+)
+
+// Snapshot statuses reported in TestStepResult.Snapshot.
+const (
+	// SnapshotMatched means the response matched the stored snapshot.
+	SnapshotMatched = "matched"
+	// SnapshotRecorded means no snapshot existed and the response was stored.
+	SnapshotRecorded = "recorded"
+	// SnapshotUpdated means a mismatching snapshot was overwritten because
+	// snapshot updates were requested.
+	SnapshotUpdated = "updated"
+)
+
+// snapshotDirName is the directory next to the scenarios that holds the
+// golden files when no snapshot directory is configured.
+const snapshotDirName = "__snapshots__"
+
+// snapshotIgnoredValue replaces the values of ignored fields.
+const snapshotIgnoredValue = "<ignored>"
+
+// SnapshotExpectation compares a step's response against a golden file.
+//
+// On the first run the normalized response is recorded; later runs fail with
+// a diff when it changes. Responses are normalized before comparison: JSON
+// text content is re-indented with sorted keys, timestamps, UUIDs, local
+// ports, and test temp directories are replaced with placeholders, then
+// IgnoreFields and Replace are applied. In YAML, `snapshot: true` enables a
+// snapshot with the default settings.
+type SnapshotExpectation struct {
+	// Name is the golden file name within the scenario's snapshot directory
+	// (default: the step ID)
+	Name string `yaml:"name,omitempty"`
+	// IgnoreFields masks the values of JSON object fields with these names at
+	// any depth, for values that change between runs (e.g. "uptime", "pid")
+	IgnoreFields []string `yaml:"ignore_fields,omitempty"`
+	// Replace applies additional regular expression replacements
+	Replace []SnapshotReplacement `yaml:"replace,omitempty"`
+}
+
+// SnapshotReplacement replaces every match of Pattern with With. With may
+// reference capture groups as $1.
+type SnapshotReplacement struct {
+	Pattern string `yaml:"pattern"`
+	With    string `yaml:"with"`
+}
+
+// UnmarshalYAML accepts `snapshot: true` in addition to the mapping form.
+func (s *SnapshotExpectation) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		var enabled bool
+		if err := node.Decode(&enabled); err != nil || !enabled {
+			return fmt.Errorf("line %d: snapshot must be true or a mapping", node.Line)
+		}
+		*s = SnapshotExpectation{}
+		return nil
+	}
+	type plain SnapshotExpectation
+	return node.Decode((*plain)(s))
+}
+
+// defaultSnapshotNormalizers replace values that differ between runs even
+// when the response format is unchanged.
+var defaultSnapshotNormalizers = []struct {
+	pattern *regexp.Regexp
+	with    string
+}{
+	{regexp.MustCompile(regexp.QuoteMeta(strings.TrimSuffix(os.TempDir(), string(filepath.Separator))) + `/muster-test-[0-9]+`), "<tempdir>"},
+	{regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`), "<timestamp>"},
+	{regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`), "<uuid>"},
+	{regexp.MustCompile(`(localhost|127\.0\.0\.1):\d+`), "$1:<port>"},
+}
+
+// SnapshotDirectory returns the directory golden files are stored in: the
+// configured directory, or __snapshots__ next to the scenario files.
+func SnapshotDirectory(config TestConfiguration) string {
+	if config.SnapshotDir != "" {
+		return config.SnapshotDir
+	}
+	path := GetScenarioPath(config.ConfigPath)
+	if info, err := os.Stat(path); err == nil && !info.IsDir() {
+		path = filepath.Dir(path)
+	}
+	return filepath.Join(path, snapshotDirName)
+}
+
+// snapshotPath returns the golden file of a step.
+func snapshotPath(dir, scenarioName string, step TestStep) string {
+	name := step.ID
+	if step.Expected.Snapshot.Name != "" {
+		name = step.Expected.Snapshot.Name
+	}
+	return filepath.Join(dir, sanitizeFileName(scenarioName), sanitizeFileName(name)+".snap")
+}
+
+// checkSnapshot compares a step response with its golden file, recording it
+// when it does not exist yet or update is set. It returns the snapshot status
+// and, on mismatch, an error containing a unified diff.
+func checkSnapshot(file string, expected *SnapshotExpectation, response interface{}, update bool) (string, error) {
+	actual, err := normalizeSnapshot(response, expected)
+	if err != nil {
+		return "", err
+	}
+
+	stored, err := os.ReadFile(file) //nolint:gosec
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return SnapshotRecorded, writeSnapshot(file, actual)
+	case err != nil:
+		return "", fmt.Errorf("failed to read snapshot: %w", err)
+	case string(stored) == actual:
+		return SnapshotMatched, nil
+	case update:
+		return SnapshotUpdated, writeSnapshot(file, actual)
+	}
+
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(stored)),
+		B:        difflib.SplitLines(actual),
+		FromFile: file,
+		ToFile:   "actual response",
+		Context:  3,
+	})
+	return "", fmt.Errorf("response does not match snapshot (use --update-snapshots to accept the change):\n%s", diff)
+}
+
+// writeSnapshot stores a golden file, creating its directory.
+func writeSnapshot(file, content string) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil { //nolint:gosec
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil { //nolint:gosec
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// normalizeSnapshot renders a response as stable text for comparison.
+func normalizeSnapshot(response interface{}, expected *SnapshotExpectation) (string, error) {
+	ignore := make(map[string]bool, len(expected.IgnoreFields))
+	for _, field := range expected.IgnoreFields {
+		ignore[field] = true
+	}
+
+	var parts []string
+	if result, ok := response.(*mcp.CallToolResult); ok {
+		if result.IsError {
+			parts = append(parts, "isError: true")
+		}
+		for _, content := range result.Content {
+			switch c := content.(type) {
+			case mcp.TextContent:
+				parts = append(parts, normalizeSnapshotText(c.Text, ignore))
+			case mcp.ImageContent:
+				parts = append(parts, fmt.Sprintf("[image %s]", c.MIMEType))
+			case mcp.AudioContent:
+				parts = append(parts, fmt.Sprintf("[audio %s]", c.MIMEType))
+			default:
+				parts = append(parts, fmt.Sprintf("[%T]", content))
+			}
+		}
+	} else {
+		data, err := json.Marshal(response)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode response for snapshot: %w", err)
+		}
+		parts = append(parts, normalizeSnapshotText(string(data), ignore))
+	}
+
+	text := strings.Join(parts, "\n---\n")
+	for _, n := range defaultSnapshotNormalizers {
+		text = n.pattern.ReplaceAllString(text, n.with)
+	}
+	for _, r := range expected.Replace {
+		pattern, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return "", fmt.Errorf("invalid snapshot replace pattern %q: %w", r.Pattern, err)
+		}
+		text = pattern.ReplaceAllString(text, r.With)
+	}
+	return strings.TrimRight(text, "\n") + "\n", nil
+}
+
+// normalizeSnapshotText re-indents JSON text with sorted keys and masks
+// ignored fields. Other text is returned unchanged.
+func normalizeSnapshotText(text string, ignore map[string]bool) string {
+	var value interface{}
+	if err := json.Unmarshal([]byte(text), &value); err != nil {
+		return text
+	}
+	data, err := marshalIndentJSON(maskSnapshotFields(value, ignore))
+	if err != nil {
+		return text
+	}
+	return string(data)
+}
+
+// marshalIndentJSON is json.MarshalIndent without escaping <, > and &, so
+// placeholders such as <ignored> stay readable.
+func marshalIndentJSON(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(value); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// maskSnapshotFields replaces the values of ignored object fields at any depth.
+func maskSnapshotFields(value interface{}, ignore map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if ignore[key] {
+				v[key] = snapshotIgnoredValue
+			} else {
+				v[key] = maskSnapshotFields(child, ignore)
+			}
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = maskSnapshotFields(child, ignore)
+		}
+	}
+	return value
+}
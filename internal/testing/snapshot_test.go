@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSnapshotExpectationUnmarshal(t *testing.T) {
+	var expected TestExpectation
+	require.NoError(t, yaml.Unmarshal([]byte("success: true\nsnapshot: true\n"), &expected))
+	require.NotNil(t, expected.Snapshot)
+	assert.Empty(t, expected.Snapshot.Name)
+
+	require.NoError(t, yaml.Unmarshal([]byte("snapshot:\n  name: list\n  ignore_fields: [uptime]\n"), &expected))
+	assert.Equal(t, "list", expected.Snapshot.Name)
+	assert.Equal(t, []string{"uptime"}, expected.Snapshot.IgnoreFields)
+
+	assert.Error(t, yaml.Unmarshal([]byte("snapshot: false\n"), &expected))
+}
+
+func TestNormalizeSnapshot(t *testing.T) {
+	response := mcp.NewToolResultText(`{"name":"svc","id":"0b6f8a52-4a8e-4d5e-9a6c-0d1f1c2b3a4d","started":"2026-01-02T15:04:05.123Z",` +
+		`"url":"http://localhost:18042/mcp","uptime":"3m2s","items":[{"uptime":"1s","state":"running"}]}`)
+
+	text, err := normalizeSnapshot(response, &SnapshotExpectation{
+		IgnoreFields: []string{"uptime"},
+		Replace:      []SnapshotReplacement{{Pattern: `svc`, With: "service"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "id": "<uuid>",
+  "items": [
+    {
+      "state": "running",
+      "uptime": "<ignored>"
+    }
+  ],
+  "name": "service",
+  "started": "<timestamp>",
+  "uptime": "<ignored>",
+  "url": "http://localhost:<port>/mcp"
+}
+`, text)
+
+	errResult := mcp.NewToolResultError("not found")
+	text, err = normalizeSnapshot(errResult, &SnapshotExpectation{})
+	require.NoError(t, err)
+	assert.Equal(t, "isError: true\n---\nnot found\n", text)
+
+	_, err = normalizeSnapshot(errResult, &SnapshotExpectation{Replace: []SnapshotReplacement{{Pattern: "("}}})
+	assert.ErrorContains(t, err, "invalid snapshot replace pattern")
+}
+
+func TestCheckSnapshot(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "scenario", "step.snap")
+	expected := &SnapshotExpectation{}
+
+	status, err := checkSnapshot(file, expected, mcp.NewToolResultText("first"), false)
+	require.NoError(t, err)
+	assert.Equal(t, SnapshotRecorded, status)
+
+	status, err = checkSnapshot(file, expected, mcp.NewToolResultText("first"), false)
+	require.NoError(t, err)
+	assert.Equal(t, SnapshotMatched, status)
+
+	_, err = checkSnapshot(file, expected, mcp.NewToolResultText("second"), false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "-first")
+	assert.Contains(t, err.Error(), "+second")
+
+	status, err = checkSnapshot(file, expected, mcp.NewToolResultText("second"), true)
+	require.NoError(t, err)
+	assert.Equal(t, SnapshotUpdated, status)
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "second\n", string(content))
+}
+
+func TestSnapshotPath(t *testing.T) {
+	step := TestStep{ID: "list-services", Expected: TestExpectation{Snapshot: &SnapshotExpectation{}}}
+	assert.Equal(t, filepath.Join("snaps", "my-scenario", "list-services.snap"), snapshotPath("snaps", "my-scenario", step))
+
+	step.Expected.Snapshot.Name = "services"
+	assert.Equal(t, filepath.Join("snaps", "my-scenario", "services.snap"), snapshotPath("snaps", "my-scenario", step))
+
+	assert.Equal(t, "custom", SnapshotDirectory(TestConfiguration{SnapshotDir: "custom"}))
+	assert.Equal(t, filepath.Join(GetDefaultScenarioPath(), snapshotDirName), SnapshotDirectory(TestConfiguration{}))
+}
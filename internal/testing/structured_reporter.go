@@ -71,19 +71,10 @@ func (r *structuredReporter) ReportStepResult(stepResult TestStepResult) {
 
 	// Find the scenario this step belongs to and add the result
 	for _, state := range r.scenarioStates {
-		// Check if this step belongs to this scenario
-		for _, step := range state.Scenario.Steps {
-			if step.ID == stepResult.Step.ID {
-				state.StepResults = append(state.StepResults, stepResult)
-				return
-			}
-		}
-		// Check cleanup steps too
-		for _, step := range state.Scenario.Cleanup {
-			if step.ID == stepResult.Step.ID {
-				state.StepResults = append(state.StepResults, stepResult)
-				return
-			}
+		// Check if this step, cleanup step, or hook step belongs to this scenario
+		if state.Scenario.hasStep(stepResult.Step.ID) {
+			state.StepResults = append(state.StepResults, stepResult)
+			return
 		}
 	}
 }
@@ -214,6 +205,12 @@ func (r *structuredReporter) updateSuiteCounters(scenarioResult TestScenarioResu
 	case ResultError:
 		r.suiteResult.ErrorScenarios++
 	}
+	if scenarioResult.Flaky {
+		r.suiteResult.FlakyScenarios++
+	}
+	if scenarioResult.Quarantined {
+		r.suiteResult.QuarantinedScenarios++
+	}
 
 	r.suiteResult.TotalScenarios = len(r.suiteResult.ScenarioResults)
 }
@@ -0,0 +1,299 @@
+package testing
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestSuite groups scenarios that run in order against one shared muster
+// instance, so later scenarios can build on the state earlier ones produce.
+// Suites are defined in *.suite.yaml files next to the scenarios.
+type TestSuite struct {
+	// Name is the unique identifier for the suite
+	Name string `yaml:"name"`
+	// Description provides human-readable suite description
+	Description string `yaml:"description,omitempty"`
+	// PreConfiguration is the shared muster instance setup; the members'
+	// own pre_configuration is merged into it
+	PreConfiguration *MusterPreConfiguration `yaml:"pre_configuration,omitempty"`
+	// Scenarios lists the member scenarios in execution order
+	Scenarios []SuiteScenario `yaml:"scenarios"`
+}
+
+// SuiteScenario is a member of a test suite.
+type SuiteScenario struct {
+	// Name is the name of the member scenario
+	Name string `yaml:"name"`
+	// DependsOn lists earlier members that must pass before this one runs
+	DependsOn []string `yaml:"depends_on,omitempty"`
+}
+
+// dependsOn returns the dependencies of the member with the given name.
+func (s TestSuite) dependsOn(name string) []string {
+	for _, member := range s.Scenarios {
+		if member.Name == name {
+			return member.DependsOn
+		}
+	}
+	return nil
+}
+
+// IsSuiteFile reports whether path is a suite definition file rather than a
+// scenario file.
+func IsSuiteFile(path string) bool {
+	base := strings.ToLower(filepath.Base(path))
+	return strings.HasSuffix(base, ".suite.yaml") || strings.HasSuffix(base, ".suite.yml")
+}
+
+// LoadSuites loads the suite files in configPath, which may be a directory or
+// a single suite file. Other files are ignored.
+func LoadSuites(configPath string) ([]TestSuite, error) {
+	info, err := os.Stat(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat scenario path: %w", err)
+	}
+
+	var files []string
+	if info.IsDir() {
+		err := filepath.WalkDir(configPath, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && IsSuiteFile(path) {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk directory %s: %w", configPath, err)
+		}
+	} else if IsSuiteFile(configPath) {
+		files = []string{configPath}
+	}
+	sort.Strings(files)
+
+	suites := make([]TestSuite, 0, len(files))
+	for _, file := range files {
+		suite, err := loadSuiteFile(file)
+		if err != nil {
+			return nil, err
+		}
+		suites = append(suites, suite)
+	}
+	return suites, nil
+}
+
+// loadSuiteFile decodes and validates a single suite file.
+func loadSuiteFile(file string) (TestSuite, error) {
+	var suite TestSuite
+	content, err := os.ReadFile(file) //nolint:gosec
+	if err != nil {
+		return suite, fmt.Errorf("failed to read suite file %s: %w", file, err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	dec.KnownFields(true)
+	if err := dec.Decode(&suite); err != nil {
+		return suite, fmt.Errorf("failed to parse suite file %s: %w", file, err)
+	}
+	if err := validateSuite(suite); err != nil {
+		return suite, fmt.Errorf("invalid suite in %s: %w", file, err)
+	}
+	return suite, nil
+}
+
+// validateSuite checks a suite on its own: members are unique and only
+// depend on members listed before them.
+func validateSuite(suite TestSuite) error {
+	if suite.Name == "" {
+		return fmt.Errorf("suite name is required")
+	}
+	if len(suite.Scenarios) == 0 {
+		return fmt.Errorf("suite must list at least one scenario")
+	}
+	listed := make(map[string]bool, len(suite.Scenarios))
+	for i, member := range suite.Scenarios {
+		if member.Name == "" {
+			return fmt.Errorf("scenario %d: name is required", i+1)
+		}
+		if listed[member.Name] {
+			return fmt.Errorf("scenario %s is listed twice", member.Name)
+		}
+		for _, dep := range member.DependsOn {
+			if !listed[dep] {
+				return fmt.Errorf("scenario %s depends on %s, which must be listed before it", member.Name, dep)
+			}
+		}
+		listed[member.Name] = true
+	}
+	return nil
+}
+
+// ValidateSuites checks suites against the loaded scenarios: suite names are
+// unique, every member exists, and no scenario belongs to two suites.
+func ValidateSuites(suites []TestSuite, scenarios []TestScenario) error {
+	known := make(map[string]bool, len(scenarios))
+	for _, scenario := range scenarios {
+		known[scenario.Name] = true
+	}
+	suiteNames := make(map[string]bool, len(suites))
+	memberOf := make(map[string]string)
+	for _, suite := range suites {
+		if err := validateSuite(suite); err != nil {
+			return fmt.Errorf("suite %s: %w", suite.Name, err)
+		}
+		if suiteNames[suite.Name] {
+			return fmt.Errorf("duplicate suite name %q", suite.Name)
+		}
+		suiteNames[suite.Name] = true
+		for _, member := range suite.Scenarios {
+			if !known[member.Name] {
+				return fmt.Errorf("suite %s: scenario %s not found", suite.Name, member.Name)
+			}
+			if other, ok := memberOf[member.Name]; ok {
+				return fmt.Errorf("scenario %s belongs to suites %s and %s", member.Name, other, suite.Name)
+			}
+			memberOf[member.Name] = suite.Name
+		}
+	}
+	return nil
+}
+
+// runJob is a unit of scheduled work: a standalone scenario or a suite with
+// the members to run.
+type runJob struct {
+	scenario TestScenario
+	suite    *TestSuite
+	members  []TestScenario
+}
+
+// planRunJobs turns the filtered scenarios into jobs. Scenarios that belong
+// to a suite are run as part of it, together with the members they depend
+// on, even if the filter did not select those. A suite is scheduled where its
+// first selected member appears. It returns the jobs and the number of
+// scenarios they run.
+func planRunJobs(filtered, all []TestScenario, suites []TestSuite) ([]runJob, int) {
+	byName := make(map[string]TestScenario, len(all))
+	for _, scenario := range all {
+		byName[scenario.Name] = scenario
+	}
+	suiteOf := make(map[string]int)
+	for i, suite := range suites {
+		for _, member := range suite.Scenarios {
+			suiteOf[member.Name] = i
+		}
+	}
+	selected := make(map[string]bool, len(filtered))
+	for _, scenario := range filtered {
+		selected[scenario.Name] = true
+	}
+
+	var jobs []runJob
+	total := 0
+	planned := make(map[int]bool)
+	for _, scenario := range filtered {
+		i, inSuite := suiteOf[scenario.Name]
+		if !inSuite {
+			jobs = append(jobs, runJob{scenario: scenario})
+			total++
+			continue
+		}
+		if planned[i] {
+			continue
+		}
+		planned[i] = true
+
+		suite := &suites[i]
+		needed := make(map[string]bool)
+		var need func(name string)
+		need = func(name string) {
+			if needed[name] {
+				return
+			}
+			needed[name] = true
+			for _, dep := range suite.dependsOn(name) {
+				need(dep)
+			}
+		}
+		for _, member := range suite.Scenarios {
+			if selected[member.Name] {
+				need(member.Name)
+			}
+		}
+
+		job := runJob{suite: suite}
+		for _, member := range suite.Scenarios {
+			if scenario, ok := byName[member.Name]; ok && needed[member.Name] {
+				job.members = append(job.members, scenario)
+			}
+		}
+		jobs = append(jobs, job)
+		total += len(job.members)
+	}
+	return jobs, total
+}
+
+// mergePreConfiguration combines a suite's pre-configuration with those of
+// its members. Servers, workflows, services, and mock OAuth servers are
+// merged by name, keeping the first definition; the main config and broker
+// come from the suite or else the first member that sets them.
+func mergePreConfiguration(base *MusterPreConfiguration, members []TestScenario) *MusterPreConfiguration {
+	merged := &MusterPreConfiguration{}
+	configs := []*MusterPreConfiguration{base}
+	for _, member := range members {
+		configs = append(configs, member.PreConfiguration)
+	}
+
+	seen := make(map[string]bool)
+	add := func(kind, name string) bool {
+		key := kind + "/" + name
+		if seen[key] {
+			return false
+		}
+		seen[key] = true
+		return true
+	}
+	empty := true
+	for _, config := range configs {
+		if config == nil {
+			continue
+		}
+		empty = false
+		for _, server := range config.MCPServers {
+			if add("mcpserver", server.Name) {
+				merged.MCPServers = append(merged.MCPServers, server)
+			}
+		}
+		for _, workflow := range config.Workflows {
+			if add("workflow", workflow.Name) {
+				merged.Workflows = append(merged.Workflows, workflow)
+			}
+		}
+		for _, service := range config.Services {
+			if add("service", service.Name) {
+				merged.Services = append(merged.Services, service)
+			}
+		}
+		for _, server := range config.MockOAuthServers {
+			if add("oauth", server.Name) {
+				merged.MockOAuthServers = append(merged.MockOAuthServers, server)
+			}
+		}
+		if merged.MainConfig == nil {
+			merged.MainConfig = config.MainConfig
+		}
+		if merged.MusterBroker == nil {
+			merged.MusterBroker = config.MusterBroker
+		}
+	}
+	if empty {
+		return nil
+	}
+	return merged
+}
@@ -0,0 +1,121 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSuites(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "workflow-basic.yaml"), []byte(`name: create-workflow
+category: behavioral
+concept: workflow
+steps:
+  - id: create
+    tool: core_workflow_create
+    expected:
+      success: true
+`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "lifecycle.suite.yaml"), []byte(`name: workflow-lifecycle
+scenarios:
+  - name: create-workflow
+  - name: run-workflow
+    depends_on: [create-workflow]
+`), 0o600))
+
+	suites, err := LoadSuites(dir)
+	require.NoError(t, err)
+	require.Len(t, suites, 1)
+	assert.Equal(t, "workflow-lifecycle", suites[0].Name)
+	assert.Equal(t, []string{"create-workflow"}, suites[0].dependsOn("run-workflow"))
+
+	// The scenario loader ignores suite files.
+	scenarios, err := NewTestScenarioLoader(false).LoadScenarios(dir)
+	require.NoError(t, err)
+	require.Len(t, scenarios, 1)
+	assert.Equal(t, "create-workflow", scenarios[0].Name)
+
+	// run-workflow does not exist.
+	assert.ErrorContains(t, ValidateSuites(suites, scenarios), "scenario run-workflow not found")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.suite.yml"), []byte(`name: bad
+scenarios:
+  - name: b
+    depends_on: [a]
+  - name: a
+`), 0o600))
+	_, err = LoadSuites(dir)
+	assert.ErrorContains(t, err, "depends on a, which must be listed before it")
+}
+
+func TestValidateSuites(t *testing.T) {
+	scenarios := []TestScenario{{Name: "a"}, {Name: "b"}}
+	suite := func(name string, members ...string) TestSuite {
+		s := TestSuite{Name: name}
+		for _, m := range members {
+			s.Scenarios = append(s.Scenarios, SuiteScenario{Name: m})
+		}
+		return s
+	}
+
+	assert.NoError(t, ValidateSuites([]TestSuite{suite("one", "a"), suite("two", "b")}, scenarios))
+	assert.ErrorContains(t, ValidateSuites([]TestSuite{suite("one", "a"), suite("one", "b")}, scenarios), "duplicate suite name")
+	assert.ErrorContains(t, ValidateSuites([]TestSuite{suite("one", "a"), suite("two", "a")}, scenarios), "belongs to suites one and two")
+	assert.ErrorContains(t, ValidateSuites([]TestSuite{suite("one", "a", "a")}, scenarios), "listed twice")
+	assert.ErrorContains(t, ValidateSuites([]TestSuite{suite("one")}, scenarios), "at least one scenario")
+}
+
+func TestPlanRunJobs(t *testing.T) {
+	all := []TestScenario{{Name: "standalone"}, {Name: "create"}, {Name: "update"}, {Name: "delete"}, {Name: "other"}}
+	suites := []TestSuite{{
+		Name: "lifecycle",
+		Scenarios: []SuiteScenario{
+			{Name: "create"},
+			{Name: "other"},
+			{Name: "update", DependsOn: []string{"create"}},
+			{Name: "delete", DependsOn: []string{"update"}},
+		},
+	}}
+
+	jobs, total := planRunJobs(all, all, suites)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, 5, total)
+	assert.Equal(t, "standalone", jobs[0].scenario.Name)
+	assert.Equal(t, []TestScenario{{Name: "create"}, {Name: "other"}, {Name: "update"}, {Name: "delete"}}, jobs[1].members)
+
+	// Selecting a member pulls in its transitive dependencies only.
+	jobs, total = planRunJobs([]TestScenario{{Name: "delete"}}, all, suites)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, "suite: lifecycle", jobs[0].name())
+	assert.Equal(t, []TestScenario{{Name: "create"}, {Name: "update"}, {Name: "delete"}}, jobs[0].members)
+}
+
+func TestMergePreConfiguration(t *testing.T) {
+	assert.Nil(t, mergePreConfiguration(nil, []TestScenario{{Name: "a"}}))
+
+	base := &MusterPreConfiguration{
+		MCPServers: []MCPServerConfig{{Name: "github", Config: map[string]interface{}{"from": "suite"}}},
+	}
+	members := []TestScenario{
+		{Name: "a", PreConfiguration: &MusterPreConfiguration{
+			MCPServers: []MCPServerConfig{{Name: "github", Config: map[string]interface{}{"from": "a"}}},
+			Workflows:  []WorkflowConfig{{Name: "deploy"}},
+			MainConfig: &MainConfig{},
+		}},
+		{Name: "b", PreConfiguration: &MusterPreConfiguration{
+			Workflows: []WorkflowConfig{{Name: "deploy"}, {Name: "rollback"}},
+		}},
+	}
+
+	merged := mergePreConfiguration(base, members)
+	require.NotNil(t, merged)
+	require.Len(t, merged.MCPServers, 1)
+	assert.Equal(t, "suite", merged.MCPServers[0].Config["from"])
+	assert.Equal(t, []WorkflowConfig{{Name: "deploy"}, {Name: "rollback"}}, merged.Workflows)
+	assert.Same(t, members[0].PreConfiguration.MainConfig, merged.MainConfig)
+}
@@ -146,8 +146,13 @@ func (r *testReporter) ReportScenarioStart(scenario TestScenario) {
 func (r *testReporter) ReportStepResult(stepResult TestStepResult) {
 	if r.verbose {
 		symbol := r.getResultSymbol(stepResult.Result)
-		fmt.Printf("   %s Step: %s (%v)\n",
-			symbol, stepResult.Step.ID, stepResult.Duration)
+		if stepResult.Hook != "" {
+			fmt.Printf("   %s Hook %s: %s (%v)\n",
+				symbol, stepResult.Hook, stepResult.Step.ID, stepResult.Duration)
+		} else {
+			fmt.Printf("   %s Step: %s (%v)\n",
+				symbol, stepResult.Step.ID, stepResult.Duration)
+		}
 
 		// Show step description if available
 		if stepResult.Step.Description != "" {
@@ -229,9 +234,24 @@ func (r *testReporter) ReportScenarioResult(scenarioResult TestScenarioResult) {
 		prefix = GenerateScenarioPrefix(scenarioResult.Scenario.Name) + " "
 	}
 
+	note := r.scenarioNote(scenarioResult)
+
 	if r.verbose {
-		fmt.Printf("%s%s Scenario completed: %s (%v)\n",
-			prefix, symbol, scenarioResult.Scenario.Name, scenarioResult.Duration)
+		fmt.Printf("%s%s Scenario completed: %s (%v)%s\n",
+			prefix, symbol, scenarioResult.Scenario.Name, scenarioResult.Duration, note)
+
+		if scenarioResult.Quarantined {
+			fmt.Printf("\n")
+			return
+		}
+
+		for i, failure := range scenarioResult.FailedAttempts {
+			fmt.Printf("%s   🔁 Attempt %d failed: %s\n", prefix, i+1, failure)
+		}
+
+		for _, event := range scenarioResult.ChaosEvents {
+			fmt.Printf("%s   🌪️  Chaos before %s: %s %s\n", prefix, event.Step, event.Action, event.Target)
+		}
 
 		if scenarioResult.Error != "" {
 			fmt.Printf("%s   ❌ Scenario Error: %s\n", prefix, scenarioResult.Error)
@@ -272,7 +292,11 @@ func (r *testReporter) ReportScenarioResult(scenarioResult TestScenarioResult) {
 			for _, stepResult := range scenarioResult.StepResults {
 				if stepResult.Result == ResultFailed || stepResult.Result == ResultError {
 					stepSymbol := r.getResultSymbol(stepResult.Result)
-					fmt.Printf("%s      %s %s: %s\n", prefix, stepSymbol, stepResult.Step.ID, stepResult.Error)
+					stepName := stepResult.Step.ID
+					if stepResult.Hook != "" {
+						stepName = fmt.Sprintf("%s hook %s", stepResult.Hook, stepName)
+					}
+					fmt.Printf("%s      %s %s: %s\n", prefix, stepSymbol, stepName, stepResult.Error)
 				}
 			}
 		}
@@ -316,18 +340,30 @@ func (r *testReporter) ReportScenarioResult(scenarioResult TestScenarioResult) {
 			r.bufferMutex.Unlock()
 
 			if exists {
-				fmt.Printf("%s%s (%v)\n", bufferedStart, symbol, scenarioResult.Duration)
+				fmt.Printf("%s%s (%v)%s\n", bufferedStart, symbol, scenarioResult.Duration, note)
 			} else {
 				// Fallback if buffer missing (shouldn't happen)
-				fmt.Printf("🎯 %s... %s (%v)\n", scenarioResult.Scenario.Name, symbol, scenarioResult.Duration)
+				fmt.Printf("🎯 %s... %s (%v)%s\n", scenarioResult.Scenario.Name, symbol, scenarioResult.Duration, note)
 			}
 		} else {
 			// Sequential mode - just print the result (start was already printed)
-			fmt.Printf("%s (%v)\n", symbol, scenarioResult.Duration)
+			fmt.Printf("%s (%v)%s\n", symbol, scenarioResult.Duration, note)
 		}
 	}
 }
 
+// scenarioNote annotates quarantined scenarios and scenarios that passed
+// only after a retry
+func (r *testReporter) scenarioNote(scenarioResult TestScenarioResult) string {
+	switch {
+	case scenarioResult.Quarantined:
+		return " 🔒 " + scenarioResult.Error
+	case scenarioResult.Flaky:
+		return fmt.Sprintf(" 🔁 flaky, passed on attempt %d", scenarioResult.Attempts)
+	}
+	return ""
+}
+
 // trimLogs trims logs to a reasonable length for display
 func (r *testReporter) trimLogs(logs string, maxChars int) string {
 	if len(logs) <= maxChars {
@@ -407,6 +443,14 @@ func (r *testReporter) ReportSuiteResult(suiteResult TestSuiteResult) {
 		fmt.Printf("   ⏭️  Skipped: %d\n", suiteResult.SkippedScenarios)
 	}
 
+	if suiteResult.QuarantinedScenarios > 0 {
+		fmt.Printf("   🔒 Quarantined: %d\n", suiteResult.QuarantinedScenarios)
+	}
+
+	if suiteResult.FlakyScenarios > 0 {
+		fmt.Printf("   🔁 Flaky (passed on retry): %d\n", suiteResult.FlakyScenarios)
+	}
+
 	fmt.Printf("   📈 Total: %d\n", suiteResult.TotalScenarios)
 
 	// Calculate success rate
@@ -423,6 +467,10 @@ func (r *testReporter) ReportSuiteResult(suiteResult TestSuiteResult) {
 		fmt.Printf("\n💔 Some tests failed\n")
 	}
 
+	if suiteResult.Coverage != nil {
+		PrintToolCoverage(os.Stdout, suiteResult.Coverage, r.verbose)
+	}
+
 	// Save detailed report if requested
 	if r.reportPath != "" {
 		if err := r.saveDetailedReport(suiteResult); err != nil {
@@ -106,61 +106,77 @@ func (r *testRunner) Run(ctx context.Context, config TestConfiguration, scenario
 		return result, nil
 	}
 
+	if err := ValidateSuites(config.Suites, scenarios); err != nil {
+		return result, err
+	}
+
+	// Scenarios that belong to a suite run together on the suite's instance
+	jobs, total := planRunJobs(filteredScenarios, scenarios, config.Suites)
+	result.TotalScenarios = total
+
 	// Execute scenarios based on parallel configuration
-	// Each scenario now manages its own muster instance
+	// Each scenario or suite manages its own muster instance
 	if config.Parallel <= 1 {
 		// Sequential execution
 		r.reporter.SetParallelMode(false)
-		for _, scenario := range filteredScenarios {
-			scenarioResult := r.runScenario(ctx, scenario, config)
-			result.ScenarioResults = append(result.ScenarioResults, scenarioResult)
+		for _, job := range jobs {
+			stop := false
+			r.runJob(ctx, job, config, func(scenarioResult TestScenarioResult) {
+				result.ScenarioResults = append(result.ScenarioResults, scenarioResult)
 
-			// Update counters
-			r.updateCounters(result, scenarioResult)
+				// Update counters
+				r.updateCounters(result, scenarioResult)
 
-			// Report individual scenario result
-			r.reporter.ReportScenarioResult(scenarioResult)
+				// Report individual scenario result
+				r.reporter.ReportScenarioResult(scenarioResult)
 
-			// Check fail-fast
-			if config.FailFast && scenarioResult.Result == ResultFailed {
+				// Check fail-fast
+				if config.FailFast && scenarioResult.Result == ResultFailed {
+					stop = true
+				}
+			})
+			if stop {
 				break
 			}
 		}
 	} else {
 		// Parallel execution
 		r.reporter.SetParallelMode(true)
-		results := r.runScenariosParallel(ctx, filteredScenarios, config, result)
-		result.ScenarioResults = results
+		result.ScenarioResults = r.runScenariosParallel(ctx, jobs, total, config, result)
 	}
 
 	// Finalize result
 	result.EndTime = time.Now()
 	result.Duration = result.EndTime.Sub(result.StartTime)
 
+	if config.Coverage {
+		result.Coverage = BuildToolCoverage(result.ScenarioResults)
+	}
+
 	// Report final suite result
 	r.reporter.ReportSuiteResult(*result)
 
 	return result, nil
 }
 
-// runScenariosParallel executes scenarios in parallel with a worker pool
-// Each scenario gets its own muster instance
-func (r *testRunner) runScenariosParallel(ctx context.Context, scenarios []TestScenario, config TestConfiguration, suiteResult *TestSuiteResult) []TestScenarioResult {
+// runScenariosParallel executes jobs in parallel with a worker pool
+// Each standalone scenario and each suite gets its own muster instance
+func (r *testRunner) runScenariosParallel(ctx context.Context, jobs []runJob, total int, config TestConfiguration, suiteResult *TestSuiteResult) []TestScenarioResult {
 	// Create channels
-	scenarioChan := make(chan TestScenario, len(scenarios))
-	resultChan := make(chan TestScenarioResult, len(scenarios))
+	jobChan := make(chan runJob, len(jobs))
+	resultChan := make(chan TestScenarioResult, total)
 
-	// Send scenarios to channel
-	for _, scenario := range scenarios {
-		scenarioChan <- scenario
+	// Send jobs to channel
+	for _, job := range jobs {
+		jobChan <- job
 	}
-	close(scenarioChan)
+	close(jobChan)
 
 	// Create worker pool
 	var wg sync.WaitGroup
 	numWorkers := config.Parallel
-	if numWorkers > len(scenarios) {
-		numWorkers = len(scenarios)
+	if numWorkers > len(jobs) {
+		numWorkers = len(jobs)
 	}
 
 	// Start workers
@@ -169,14 +185,15 @@ func (r *testRunner) runScenariosParallel(ctx context.Context, scenarios []TestS
 		go func(workerID int) {
 			defer wg.Done()
 
-			for scenario := range scenarioChan {
+			for job := range jobChan {
 				if r.debug {
-					r.logger.Debug("🔄 Worker %d executing scenario: %s\n", workerID, scenario.Name)
+					r.logger.Debug("🔄 Worker %d executing %s\n", workerID, job.name())
 				}
 
-				// Each worker runs scenario with its own muster instance
-				scenarioResult := r.runScenario(ctx, scenario, config)
-				resultChan <- scenarioResult
+				// Each worker runs the job with its own muster instance
+				r.runJob(ctx, job, config, func(scenarioResult TestScenarioResult) {
+					resultChan <- scenarioResult
+				})
 			}
 		}(i)
 	}
@@ -189,7 +206,7 @@ func (r *testRunner) runScenariosParallel(ctx context.Context, scenarios []TestS
 
 	// Collect results and handle fail-fast in main thread
 	var results []TestScenarioResult
-	expectedResults := len(scenarios)
+	expectedResults := total
 
 	for result := range resultChan {
 		results = append(results, result)
@@ -245,6 +262,173 @@ func (r *testRunner) collectInstanceLogs(instance *MusterInstance, result *TestS
 	}
 }
 
+// name describes the job for log messages
+func (j runJob) name() string {
+	if j.suite != nil {
+		return "suite: " + j.suite.Name
+	}
+	return "scenario: " + j.scenario.Name
+}
+
+// runJob runs a standalone scenario or a suite and passes each scenario
+// result to emit as soon as it is available
+func (r *testRunner) runJob(ctx context.Context, job runJob, config TestConfiguration, emit func(TestScenarioResult)) {
+	if job.suite != nil {
+		r.runSuite(ctx, *job.suite, job.members, config, emit)
+		return
+	}
+	if reason, ok := config.Quarantine[job.scenario.Name]; ok {
+		r.reporter.ReportScenarioStart(job.scenario)
+		emit(quarantinedResult(job.scenario, reason))
+		return
+	}
+	emit(r.runScenarioWithRetries(ctx, job.scenario, config))
+}
+
+// quarantinedResult reports a quarantined scenario as skipped without
+// running it
+func quarantinedResult(scenario TestScenario, reason string) TestScenarioResult {
+	now := time.Now()
+	return TestScenarioResult{
+		Scenario:    scenario,
+		Result:      ResultSkipped,
+		StartTime:   now,
+		EndTime:     now,
+		Error:       strings.TrimSuffix("quarantined: "+reason, ": "),
+		Quarantined: true,
+	}
+}
+
+// runSuite runs the members of a suite in order against one muster instance
+// configured with the merged pre-configuration of the suite and its members.
+// Members whose dependencies did not pass are skipped. Retries do not apply,
+// since a rerun would see the state left behind by the failed attempt.
+func (r *testRunner) runSuite(ctx context.Context, suite TestSuite, members []TestScenario, config TestConfiguration, emit func(TestScenarioResult)) {
+	logger := r.logger
+	if config.Parallel > 1 && (r.debug || logger.IsVerboseEnabled()) {
+		logger = NewPrefixedLogger(r.logger, GenerateScenarioPrefix(suite.Name))
+	}
+
+	if r.debug {
+		logger.Debug("🏗️  Creating shared muster instance for suite: %s (%d scenarios)\n", suite.Name, len(members))
+	}
+
+	// Set up the shared instance; on failure every member reports the error
+	var setupErr string
+	var client MCPTestClient
+	instance, err := r.instanceManager.CreateInstance(ctx, suite.Name, config.Chaos.withChaosDelays(mergePreConfiguration(suite.PreConfiguration, members), suite.Name), logger)
+	if err != nil {
+		setupErr = fmt.Sprintf("failed to create muster instance for suite %s: %v", suite.Name, err)
+	} else {
+		defer func() {
+			cleanupCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			if err := r.instanceManager.DestroyInstance(cleanupCtx, instance, logger); err != nil && r.debug {
+				logger.Debug("⚠️  Failed to destroy muster instance %s: %v\n", instance.ID, err)
+			}
+		}()
+
+		if err := r.instanceManager.WaitForReady(ctx, instance, logger); err != nil {
+			setupErr = fmt.Sprintf("muster instance for suite %s not ready: %v", suite.Name, err)
+		} else if client, err = r.connectInstanceClient(ctx, instance, logger); err != nil {
+			setupErr = fmt.Sprintf("failed to connect to muster instance for suite %s: %v", suite.Name, err)
+		} else {
+			defer r.closeInstanceClient(client, instance, logger)
+		}
+	}
+
+	passed := make(map[string]bool, len(members))
+	for _, scenario := range members {
+		r.reporter.ReportScenarioStart(scenario)
+
+		result := TestScenarioResult{
+			Scenario:    scenario,
+			StartTime:   time.Now(),
+			StepResults: make([]TestStepResult, 0, len(scenario.Steps)),
+			Result:      ResultPassed,
+			Attempts:    1,
+		}
+
+		var failedDep string
+		for _, dep := range suite.dependsOn(scenario.Name) {
+			if !passed[dep] {
+				failedDep = dep
+				break
+			}
+		}
+
+		reason, quarantined := config.Quarantine[scenario.Name]
+		switch {
+		case setupErr != "":
+			result.Result = ResultError
+			result.Error = setupErr
+		case quarantined:
+			result = quarantinedResult(scenario, reason)
+		case scenario.Skip:
+			result.Result = ResultSkipped
+			result.Error = "scenario is marked skip"
+		case failedDep != "":
+			result.Result = ResultSkipped
+			result.Error = fmt.Sprintf("dependency %s did not pass", failedDep)
+		default:
+			scenarioCtx := ctx
+			var cancel context.CancelFunc = func() {}
+			if scenario.Timeout > 0 {
+				scenarioCtx, cancel = context.WithTimeout(ctx, scenario.Timeout)
+			}
+			r.executeScenario(scenarioCtx, scenario, config, instance, client, logger, &result)
+			cancel()
+		}
+
+		result.EndTime = time.Now()
+		result.Duration = result.EndTime.Sub(result.StartTime)
+		if result.Result == ResultPassed {
+			passed[scenario.Name] = true
+		} else if result.Result != ResultSkipped {
+			r.collectInstanceLogs(instance, &result)
+		}
+		emit(result)
+	}
+}
+
+// runScenarioWithRetries executes a scenario and reruns it on failure up to
+// config.Retries times, each time with a fresh muster instance. A scenario
+// that passes on a retry is marked as flaky.
+func (r *testRunner) runScenarioWithRetries(ctx context.Context, scenario TestScenario, config TestConfiguration) TestScenarioResult {
+	// Report scenario start
+	r.reporter.ReportScenarioStart(scenario)
+
+	result := r.runScenario(ctx, scenario, config)
+	attempts := 1
+	var failedAttempts []string
+	for result.Result != ResultPassed && attempts <= config.Retries && ctx.Err() == nil {
+		failedAttempts = append(failedAttempts, scenarioFailure(result))
+		attempts++
+		if r.debug || r.logger.IsVerboseEnabled() {
+			r.logger.Info("🔁 Retrying scenario %s (attempt %d/%d): %s\n", scenario.Name, attempts, config.Retries+1, failedAttempts[len(failedAttempts)-1])
+		}
+		result = r.runScenario(ctx, scenario, config)
+	}
+
+	result.Attempts = attempts
+	result.FailedAttempts = failedAttempts
+	result.Flaky = result.Result == ResultPassed && attempts > 1
+	return result
+}
+
+// scenarioFailure describes why a scenario attempt did not pass.
+func scenarioFailure(result TestScenarioResult) string {
+	if result.Error != "" {
+		return result.Error
+	}
+	for _, step := range result.StepResults {
+		if step.Result == ResultFailed || step.Result == ResultError {
+			return fmt.Sprintf("step %s: %s", step.Step.ID, step.Error)
+		}
+	}
+	return string(result.Result)
+}
+
 // runScenario executes a single test scenario with template variable support
 func (r *testRunner) runScenario(ctx context.Context, scenario TestScenario, config TestConfiguration) TestScenarioResult {
 	result := TestScenarioResult{
@@ -254,9 +438,6 @@ func (r *testRunner) runScenario(ctx context.Context, scenario TestScenario, con
 		Result:      ResultPassed,
 	}
 
-	// Report scenario start
-	r.reporter.ReportScenarioStart(scenario)
-
 	// Create a prefixed logger for this scenario when running in parallel with verbose/debug
 	// This helps distinguish which log messages belong to which scenario
 	logger := r.logger
@@ -265,9 +446,6 @@ func (r *testRunner) runScenario(ctx context.Context, scenario TestScenario, con
 		logger = NewPrefixedLogger(r.logger, prefix)
 	}
 
-	// Create scenario context for template variable support
-	scenarioContext := NewScenarioContext()
-
 	// Apply scenario timeout if specified
 	scenarioCtx := ctx
 	if scenario.Timeout > 0 {
@@ -284,7 +462,7 @@ func (r *testRunner) runScenario(ctx context.Context, scenario TestScenario, con
 		logger.Debug("🏗️  Creating muster instance for scenario: %s\n", scenario.Name)
 	}
 
-	instance, err = r.instanceManager.CreateInstance(scenarioCtx, scenario.Name, scenario.PreConfiguration, logger)
+	instance, err = r.instanceManager.CreateInstance(scenarioCtx, scenario.Name, config.Chaos.withChaosDelays(scenario.PreConfiguration, scenario.Name), logger)
 	if err != nil {
 		result.Result = ResultError
 		result.Error = fmt.Sprintf("failed to create muster instance: %v", err)
@@ -334,21 +512,9 @@ func (r *testRunner) runScenario(ctx context.Context, scenario TestScenario, con
 		return result
 	}
 
-	// Create isolated MCP client for this scenario
+	// Create an isolated MCP client connected to this specific instance
 	// This ensures each parallel scenario has its own client and context
-	scenarioClient := NewMCPTestClientWithLogger(r.debug, logger)
-
-	// Connect the isolated MCP client to this specific instance
-	// Use authenticated connection if muster's OAuth server is enabled
-	var connectErr error
-	if instance.MusterOAuthAccessToken != "" {
-		if r.debug {
-			logger.Debug("🔐 Connecting with OAuth token (muster OAuth server enabled)\n")
-		}
-		connectErr = scenarioClient.ConnectWithAuth(scenarioCtx, instance.Endpoint, instance.MusterOAuthAccessToken)
-	} else {
-		connectErr = scenarioClient.Connect(scenarioCtx, instance.Endpoint)
-	}
+	scenarioClient, connectErr := r.connectInstanceClient(scenarioCtx, instance, logger)
 	if connectErr != nil {
 		result.Result = ResultError
 		result.Error = fmt.Sprintf("failed to connect to muster instance: %v", connectErr)
@@ -361,38 +527,91 @@ func (r *testRunner) runScenario(ctx context.Context, scenario TestScenario, con
 	}
 
 	// Ensure isolated MCP client is closed properly
-	defer func() {
+	defer r.closeInstanceClient(scenarioClient, instance, logger)
+
+	if r.debug {
+		logger.Debug("✅ Connected isolated MCP client to muster instance %s at %s\n", instance.ID, instance.Endpoint)
+	}
+
+	r.executeScenario(scenarioCtx, scenario, config, instance, scenarioClient, logger, &result)
+
+	// Finalize result - collect instance logs before ending
+	result.EndTime = time.Now()
+	result.Duration = result.EndTime.Sub(result.StartTime)
+
+	// Collect instance logs by triggering the destroy process early
+	// The defer cleanup will handle the actual cleanup, but we need logs now
+	r.collectInstanceLogs(instance, &result)
+
+	return result
+}
+
+// connectInstanceClient creates an MCP client connected to the instance,
+// authenticating when muster's OAuth server is enabled
+func (r *testRunner) connectInstanceClient(ctx context.Context, instance *MusterInstance, logger TestLogger) (MCPTestClient, error) {
+	client := NewMCPTestClientWithLogger(r.debug, logger)
+
+	// Use authenticated connection if muster's OAuth server is enabled
+	var connectErr error
+	if instance.MusterOAuthAccessToken != "" {
 		if r.debug {
-			logger.Debug("🔌 Closing isolated MCP client connection to %s\n", instance.Endpoint)
+			logger.Debug("🔐 Connecting with OAuth token (muster OAuth server enabled)\n")
 		}
+		connectErr = client.ConnectWithAuth(ctx, instance.Endpoint, instance.MusterOAuthAccessToken)
+	} else {
+		connectErr = client.Connect(ctx, instance.Endpoint)
+	}
+	if connectErr != nil {
+		return nil, connectErr
+	}
+	return client, nil
+}
 
-		// Close with timeout to avoid hanging
-		closeCtx, closeCancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer closeCancel()
+// closeInstanceClient closes an instance client, giving up after a timeout
+func (r *testRunner) closeInstanceClient(client MCPTestClient, instance *MusterInstance, logger TestLogger) {
+	if r.debug {
+		logger.Debug("🔌 Closing isolated MCP client connection to %s\n", instance.Endpoint)
+	}
 
-		done := make(chan struct{})
-		go func() {
-			_ = scenarioClient.Close()
-			close(done)
-		}()
+	// Close with timeout to avoid hanging
+	closeCtx, closeCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer closeCancel()
 
-		// Waiting on done is the actual synchronization: scenarioClient.Close()
-		// closes the connection synchronously, so once done is signaled the
-		// teardown is complete. No blind delay is needed afterwards.
-		select {
-		case <-done:
-			if r.debug {
-				logger.Debug("✅ Isolated MCP client closed successfully\n")
-			}
-		case <-closeCtx.Done():
-			if r.debug {
-				logger.Debug("⏰ Isolated MCP client close timeout - connection may have been reset\n")
-			}
-		}
+	done := make(chan struct{})
+	go func() {
+		_ = client.Close()
+		close(done)
 	}()
 
-	if r.debug {
-		logger.Debug("✅ Connected isolated MCP client to muster instance %s at %s\n", instance.ID, instance.Endpoint)
+	// Waiting on done is the actual synchronization: client.Close()
+	// closes the connection synchronously, so once done is signaled the
+	// teardown is complete. No blind delay is needed afterwards.
+	select {
+	case <-done:
+		if r.debug {
+			logger.Debug("✅ Isolated MCP client closed successfully\n")
+		}
+	case <-closeCtx.Done():
+		if r.debug {
+			logger.Debug("⏰ Isolated MCP client close timeout - connection may have been reset\n")
+		}
+	}
+}
+
+// executeScenario runs the scenario's steps and cleanup steps against a
+// ready instance and records them in result
+func (r *testRunner) executeScenario(scenarioCtx context.Context, scenario TestScenario, config TestConfiguration, instance *MusterInstance, scenarioClient MCPTestClient, logger TestLogger, result *TestScenarioResult) {
+	// Create scenario context for template variable support
+	scenarioContext := NewScenarioContext()
+
+	// Record the tools available before any step runs so coverage can
+	// report tools that no step exercised
+	if config.Coverage {
+		if listResult, err := scenarioClient.CallToolDirect(scenarioCtx, "list_tools", nil); err == nil {
+			result.AvailableTools, _ = extractToolNamesFromResult(listResult)
+		} else if r.debug {
+			logger.Debug("⚠️  Failed to list tools for coverage: %v\n", err)
+		}
 	}
 
 	// Create test tools handler for this scenario
@@ -408,49 +627,61 @@ func (r *testRunner) runScenario(ctx context.Context, scenario TestScenario, con
 		}
 	}()
 
-	// Execute steps using the isolated client
-	for _, step := range scenario.Steps {
-		stepResult := r.runStepWithTestTools(scenarioCtx, step, config, scenarioClient, scenarioContext, testToolsHandler, logger)
-		result.StepResults = append(result.StepResults, stepResult)
-
-		// Report step result
-		r.reporter.ReportStepResult(stepResult)
-
-		// Check if step failed
-		if stepResult.Result == ResultFailed || stepResult.Result == ResultError {
-			result.Result = stepResult.Result
-			result.Error = stepResult.Error
-			break
-		}
+	exec := &scenarioExecution{
+		runner:          r,
+		ctx:             scenarioCtx,
+		scenario:        scenario,
+		config:          config,
+		client:          scenarioClient,
+		scenarioContext: scenarioContext,
+		testTools:       testToolsHandler,
+		chaos:           newChaosMonkey(config.Chaos, scenario, r.instanceManager, instance, logger),
+		logger:          logger,
+		result:          result,
+	}
+	var hooks ScenarioHooks
+	if scenario.Hooks != nil {
+		hooks = *scenario.Hooks
 	}
 
-	// Execute cleanup steps regardless of main scenario outcome using the isolated client
-	if len(scenario.Cleanup) > 0 {
-		for _, cleanupStep := range scenario.Cleanup {
-			stepResult := r.runStepWithTestTools(scenarioCtx, cleanupStep, config, scenarioClient, scenarioContext, testToolsHandler, logger)
-			result.StepResults = append(result.StepResults, stepResult)
-			r.reporter.ReportStepResult(stepResult)
-
-			// Cleanup step failures should also fail the scenario
-			if stepResult.Result == ResultFailed || stepResult.Result == ResultError {
-				// Only update if the scenario hasn't already failed
-				if result.Result == ResultPassed {
-					result.Result = stepResult.Result
-					result.Error = stepResult.Error
-				}
+	// Execute steps using the isolated client, unless a before_scenario hook failed
+	if exec.runHooks(hooks.BeforeScenario, hookBeforeScenario, true) {
+		for _, step := range scenario.Steps {
+			if !exec.runStep(step, hooks) {
+				break
 			}
 		}
 	}
 
-	// Finalize result - collect instance logs before ending
-	result.EndTime = time.Now()
-	result.Duration = result.EndTime.Sub(result.StartTime)
+	// Execute cleanup steps regardless of main scenario outcome using the isolated client.
+	// Cleanup step failures also fail the scenario; per-step hooks only apply to
+	// the scenario steps.
+	for _, cleanupStep := range scenario.Cleanup {
+		exec.runStep(cleanupStep, ScenarioHooks{})
+	}
 
-	// Collect instance logs by triggering the destroy process early
-	// The defer cleanup will handle the actual cleanup, but we need logs now
-	r.collectInstanceLogs(instance, &result)
+	exec.runHooks(hooks.AfterScenario, hookAfterScenario, false)
+}
 
-	return result
+// checkStepSnapshot compares a passed step's response with its snapshot, if
+// the step has a snapshot expectation, and fails the step on mismatch.
+func (r *testRunner) checkStepSnapshot(stepResult *TestStepResult, scenarioName string, config TestConfiguration, logger TestLogger) {
+	expected := stepResult.Step.Expected.Snapshot
+	if expected == nil || stepResult.Result != ResultPassed {
+		return
+	}
+
+	file := snapshotPath(SnapshotDirectory(config), scenarioName, stepResult.Step)
+	status, err := checkSnapshot(file, expected, stepResult.Response, config.UpdateSnapshots)
+	if err != nil {
+		stepResult.Result = ResultFailed
+		stepResult.Error = fmt.Sprintf("snapshot %s: %v", file, err)
+		return
+	}
+	stepResult.Snapshot = status
+	if status != SnapshotMatched {
+		logger.Info("📸 Snapshot %s: %s\n", status, file)
+	}
 }
 
 // runStep executes a single test step using the specified MCP client with template variable support
@@ -865,19 +1096,7 @@ func (r *testRunner) validateExpectations(expected TestExpectation, response int
 
 	// Check response content expectations
 	if response != nil {
-		var responseStr string
-		if mcpResult, ok := response.(*mcp.CallToolResult); ok {
-			// Extract text content from MCP result for text matching
-			var textParts []string
-			for _, content := range mcpResult.Content {
-				if textContent, ok := mcp.AsTextContent(content); ok {
-					textParts = append(textParts, textContent.Text)
-				}
-			}
-			responseStr = strings.Join(textParts, " ")
-		} else {
-			responseStr = fmt.Sprintf("%v", response)
-		}
+		responseStr := responseText(response)
 
 		// Check contains expectations
 		for _, expectedText := range expected.Contains {
@@ -944,6 +1163,28 @@ func (r *testRunner) validateExpectations(expected TestExpectation, response int
 		}
 	}
 
+	// Check assertions against the decoded response, or the error text if the
+	// call returned no response
+	if len(expected.Assertions) > 0 {
+		var doc interface{}
+		if response != nil {
+			doc = r.extractStorableResult(response, logger)
+		} else if err != nil {
+			doc = err.Error()
+		}
+		for _, assertion := range expected.Assertions {
+			if assertErr := assertion.Evaluate(doc); assertErr != nil {
+				if r.debug {
+					logger.Debug("❌ Assertion failed: %v\n", assertErr)
+				}
+				return false
+			}
+		}
+		if r.debug {
+			logger.Debug("✅ All %d assertion(s) passed\n", len(expected.Assertions))
+		}
+	}
+
 	if r.debug {
 		logger.Debug("✅ All expectations met for step\n")
 	}
@@ -1040,6 +1281,28 @@ func (r *testRunner) updateCounters(suiteResult *TestSuiteResult, scenarioResult
 	case ResultError:
 		suiteResult.ErrorScenarios++
 	}
+	if scenarioResult.Flaky {
+		suiteResult.FlakyScenarios++
+	}
+	if scenarioResult.Quarantined {
+		suiteResult.QuarantinedScenarios++
+	}
+}
+
+// responseText returns the text that contains and not_contains expectations
+// are matched against: the text content of an MCP result, or the formatted
+// response otherwise.
+func responseText(response interface{}) string {
+	if mcpResult, ok := response.(*mcp.CallToolResult); ok {
+		var textParts []string
+		for _, content := range mcpResult.Content {
+			if textContent, ok := mcp.AsTextContent(content); ok {
+				textParts = append(textParts, textContent.Text)
+			}
+		}
+		return strings.Join(textParts, " ")
+	}
+	return fmt.Sprintf("%v", response)
 }
 
 // extractStorableResult extracts a storable result from a response
@@ -197,6 +197,16 @@ func (h *TestToolsHandler) HandleTestTool(ctx context.Context, toolName string,
 		h.logger.Debug("🧪 Handling test tool: %s with args: %v\n", toolName, args)
 	}
 
+	// Test tools change mock server and session state that resetting a
+	// pooled instance cannot undo, so the instance is not reused afterwards
+	switch toolName {
+	case TestToolGetOAuthServerInfo, TestToolReadAuthStatus, TestToolGetCurrentUser, TestToolListToolsForUser:
+	default:
+		if h.instanceManager != nil && h.currentInstance != nil {
+			h.instanceManager.discardPooledInstance(h.currentInstance.ID)
+		}
+	}
+
 	switch toolName {
 	case TestToolSimulateOAuthCallback:
 		return h.handleSimulateOAuthCallback(ctx, args)
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/giantswarm/muster/internal/testing/mock"
 )
 
 // TestCategory represents the category of tests to execute
@@ -95,6 +97,22 @@ type TestConfiguration struct {
 	BasePort int `yaml:"base_port,omitempty"`
 	// KeepTempConfig keeps temporary config directory after test execution
 	KeepTempConfig bool `yaml:"keep_temp_config,omitempty"`
+	// Coverage enables tool coverage tracking and reporting
+	Coverage bool `yaml:"coverage,omitempty"`
+	// SnapshotDir is where snapshot golden files are stored (default:
+	// __snapshots__ next to the scenario files)
+	SnapshotDir string `yaml:"snapshot_dir,omitempty"`
+	// UpdateSnapshots overwrites snapshots that do not match the response
+	UpdateSnapshots bool `yaml:"update_snapshots,omitempty"`
+	// Retries is how often a failed scenario is rerun; a scenario that passes
+	// on a retry is reported as flaky
+	Retries int `yaml:"retries,omitempty"`
+	// Quarantine maps the names of scenarios to skip to the reason
+	Quarantine map[string]string `yaml:"quarantine,omitempty"`
+	// Chaos enables chaos mode; nil disables it
+	Chaos *ChaosConfig `yaml:"chaos,omitempty"`
+	// Suites group scenarios that share one muster instance
+	Suites []TestSuite `yaml:"-" json:"-"`
 }
 
 // TestScenario defines a single test scenario
@@ -121,6 +139,8 @@ type TestScenario struct {
 	Skip bool `yaml:"skip,omitempty"`
 	// PreConfiguration defines muster instance setup
 	PreConfiguration *MusterPreConfiguration `yaml:"pre_configuration,omitempty"`
+	// Hooks define tool calls run around the scenario and each of its steps
+	Hooks *ScenarioHooks `yaml:"hooks,omitempty"`
 }
 
 // MusterPreConfiguration defines how to pre-configure an muster serve instance
@@ -315,6 +335,34 @@ type MockOAuthServerConfig struct {
 	// JWKS, so muster's broker can validate its tokens as a trusted issuer.
 	// Automatically enabled (with UseTLS) when referenced by muster_broker.
 	SignTokens bool `yaml:"sign_tokens,omitempty"`
+
+	// Users are the identities this server logs in, for authorization-policy
+	// and multi-tenant tests. A login selects a user by subject or email
+	// (e.g. the subject argument of test_muster_auth_login); logins that name
+	// no user cycle through the list, so consecutive logins get distinct users.
+	Users []MockOAuthUserConfig `yaml:"users,omitempty"`
+
+	// Claims are custom claims added to every ID token this server issues.
+	// Per-user claims take precedence.
+	Claims map[string]interface{} `yaml:"claims,omitempty"`
+}
+
+// MockOAuthUserConfig defines an identity a mock OAuth server can log in
+type MockOAuthUserConfig struct {
+	// Subject is the user's sub claim
+	Subject string `yaml:"subject"`
+
+	// Email is the user's email claim
+	Email string `yaml:"email,omitempty"`
+
+	// Name is the user's name claim
+	Name string `yaml:"name,omitempty"`
+
+	// Groups is the user's groups claim, issued when the groups scope is requested
+	Groups []string `yaml:"groups,omitempty"`
+
+	// Claims are custom claims for this user, e.g. a tenant ID
+	Claims map[string]interface{} `yaml:"claims,omitempty"`
 }
 
 // TrustedIssuerConfig defines a trusted issuer for RFC 8693 token exchange
@@ -392,6 +440,9 @@ type MusterInstanceManager interface {
 	// WaitForReady waits for an instance to be ready to accept connections.
 	// The logger parameter allows scenario-specific logging with prefixes for parallel execution.
 	WaitForReady(ctx context.Context, instance *MusterInstance, logger TestLogger) error
+	// GetMockOAuthServer returns the named mock OAuth server of an instance,
+	// or nil if the instance has none by that name.
+	GetMockOAuthServer(instanceID, serverName string) *mock.OAuthServer
 }
 
 // TestStep defines a single step within a test scenario
@@ -413,6 +464,10 @@ type TestStep struct {
 	// AsUser specifies which user session to execute this step as.
 	// For multi-user testing scenarios. If not set, uses the current user.
 	AsUser string `yaml:"as_user,omitempty"`
+	// Before lists steps run before this step; if one fails, the step is skipped
+	Before []TestStep `yaml:"before,omitempty"`
+	// After lists steps run after this step, even if it failed
+	After []TestStep `yaml:"after,omitempty"`
 }
 
 // TestExpectation defines what result is expected from a test step
@@ -427,10 +482,15 @@ type TestExpectation struct {
 	NotContains []string `yaml:"not_contains,omitempty"`
 	// JSONPath allows checking specific JSON response fields
 	JSONPath map[string]interface{} `yaml:"json_path,omitempty"`
+	// Assertions are JSONPath-based checks with regex, numeric, and negated
+	// matching
+	Assertions []Assertion `yaml:"assertions,omitempty"`
 	// StatusCode for HTTP-based expectations
 	StatusCode int `yaml:"status_code,omitempty"`
 	// WaitForState enables polling for state changes with timeout
 	WaitForState time.Duration `yaml:"wait_for_state,omitempty"`
+	// Snapshot compares the normalized response against a golden file
+	Snapshot *SnapshotExpectation `yaml:"snapshot,omitempty"`
 }
 
 // RetryConfig defines retry behavior for test steps
@@ -461,10 +521,16 @@ type TestSuiteResult struct {
 	SkippedScenarios int `json:"skipped_scenarios"`
 	// ErrorScenarios is the number of scenarios that had errors
 	ErrorScenarios int `json:"error_scenarios"`
+	// FlakyScenarios is the number of passed scenarios that needed a retry
+	FlakyScenarios int `json:"flaky_scenarios,omitempty"`
+	// QuarantinedScenarios is the number of skipped quarantined scenarios
+	QuarantinedScenarios int `json:"quarantined_scenarios,omitempty"`
 	// ScenarioResults contains individual scenario results
 	ScenarioResults []TestScenarioResult `json:"scenario_results"`
 	// Configuration used for this test run
 	Configuration TestConfiguration `json:"configuration"`
+	// Coverage reports which aggregator tools were exercised, if enabled
+	Coverage *ToolCoverageReport `json:"coverage,omitempty"`
 }
 
 // TestScenarioResult represents the result of a single test scenario
@@ -487,6 +553,19 @@ type TestScenarioResult struct {
 	Output string `json:"output,omitempty"`
 	// InstanceLogs contains logs from the muster serve instance
 	InstanceLogs *InstanceLogs `json:"instance_logs,omitempty"`
+	// AvailableTools lists the aggregator tools exposed when the scenario
+	// started; only collected when coverage is enabled
+	AvailableTools []string `json:"available_tools,omitempty"`
+	// Attempts is the number of times the scenario was run
+	Attempts int `json:"attempts,omitempty"`
+	// Flaky indicates the scenario passed only after a retry
+	Flaky bool `json:"flaky,omitempty"`
+	// FailedAttempts holds the errors of the attempts before the last one
+	FailedAttempts []string `json:"failed_attempts,omitempty"`
+	// Quarantined indicates the scenario was skipped by the quarantine list
+	Quarantined bool `json:"quarantined,omitempty"`
+	// ChaosEvents lists the chaos actions taken during the scenario
+	ChaosEvents []ChaosEvent `json:"chaos_events,omitempty"`
 }
 
 // TestStepResult represents the result of a single test step
@@ -507,6 +586,12 @@ type TestStepResult struct {
 	Error string `json:"error,omitempty"`
 	// RetryCount is the number of retries attempted
 	RetryCount int `json:"retry_count"`
+	// Snapshot is the snapshot status (matched, recorded, updated), if the
+	// step has a snapshot expectation
+	Snapshot string `json:"snapshot,omitempty"`
+	// Hook is the hook phase that ran this step, e.g. "after_each_step (create)",
+	// or empty for scenario and cleanup steps
+	Hook string `json:"hook,omitempty"`
 }
 
 // TestRunner interface defines the test execution engine
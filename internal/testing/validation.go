@@ -101,10 +101,8 @@ func validateScenario(scenario TestScenario, toolSchemas map[string]interface{},
 		StepResults:  make([]StepValidationResult, 0),
 	}
 
-	// Validate all steps in the scenario
-	allSteps := append(scenario.Steps, scenario.Cleanup...)
-
-	for _, step := range allSteps {
+	// Validate all steps in the scenario, including cleanup and hook steps
+	for _, step := range scenario.allSteps() {
 		stepResult := validateStep(step, toolSchemas, verbose, debug)
 		result.StepResults = append(result.StepResults, stepResult)
 
@@ -134,6 +132,11 @@ func validateStep(step TestStep, toolSchemas map[string]interface{}, verbose, de
 		Errors: make([]ValidationError, 0),
 	}
 
+	// Test framework tools are handled by the runner, not muster serve
+	if IsTestTool(step.Tool) {
+		return result
+	}
+
 	// Check tool prefix to determine validation approach
 	if strings.HasPrefix(step.Tool, "core_") {
 		// Core tools can be validated against the schema
@@ -195,6 +198,11 @@ func validateArguments(args map[string]interface{}, properties map[string]interf
 	return errors
 }
 
+// SchemaToolCount returns the number of tools described by an API schema.
+func SchemaToolCount(schema map[string]interface{}) int {
+	return len(extractToolSchemas(schema))
+}
+
 // extractToolSchemas extracts tool schemas from the main schema
 func extractToolSchemas(schema map[string]interface{}) map[string]interface{} {
 	if properties, ok := schema["properties"].(map[string]interface{}); ok {
@@ -3,8 +3,9 @@ package workflow
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"sort"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -13,12 +14,14 @@ import (
 
 	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/internal/client"
+	"github.com/giantswarm/muster/internal/config"
 	"github.com/giantswarm/muster/internal/events"
 	"github.com/giantswarm/muster/pkg/logging"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"gopkg.in/yaml.v3"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -26,6 +29,10 @@ import (
 // the workflow-level output template.
 const fieldOutput = "output"
 
+// fieldConcurrency is the argument/field name of the concurrency limits of a
+// workflow.
+const fieldConcurrency = "concurrency"
+
 // Retention defaults bound how long execution records are kept and cap the
 // total count as a burst safety net. The GC runs on a fixed interval.
 //
@@ -44,6 +51,17 @@ type Adapter struct {
 	executor         *WorkflowExecutor
 	executionTracker *ExecutionTracker
 	toolChecker      ToolAvailabilityChecker
+	queue            *executionQueue
+	artifacts        artifactStore
+
+	// createdAt tells the executions of this run of muster from the ones a
+	// previous run left behind. A warm standby moves it to its takeover.
+	createdAt time.Time
+
+	// takeover is closed when a warm standby takes over, and nil unless the
+	// adapter stands by (see StandBy).
+	takeover     chan struct{}
+	takeoverOnce sync.Once
 
 	// Prevent circular dependency during tool generation
 	generatingTools bool
@@ -63,8 +81,10 @@ type ToolAvailabilityChecker interface {
 	MissingToolsForSession(ctx context.Context, toolNames []string) []string
 }
 
-// NewAdapterWithClient creates a new workflow adapter with a pre-configured client
-func NewAdapterWithClient(musterClient client.MusterClient, namespace string, toolCaller ToolCaller, toolChecker ToolAvailabilityChecker, configPath string) *Adapter {
+// NewAdapterWithClient creates a new workflow adapter with a pre-configured client.
+// Outside Kubernetes mode, workflow executions are kept in executionStore, or
+// as files in configPath if it is nil.
+func NewAdapterWithClient(musterClient client.MusterClient, namespace string, toolCaller ToolCaller, toolChecker ToolAvailabilityChecker, configPath string, executionStore config.EntityStorage) *Adapter {
 
 	if namespace == "" {
 		namespace = "default"
@@ -73,11 +93,15 @@ func NewAdapterWithClient(musterClient client.MusterClient, namespace string, to
 	adapter := &Adapter{
 		client:           musterClient,
 		namespace:        namespace,
-		executionTracker: NewExecutionTracker(newExecutionStorage(musterClient, namespace, configPath)),
+		executionTracker: NewExecutionTracker(newExecutionStorage(musterClient, namespace, configPath, executionStore)),
 		toolChecker:      toolChecker,
+		queue:            newExecutionQueue(defaultQueueWorkers, defaultQueueMaxPerWorkflow),
+		artifacts:        newArtifactStore(musterClient, configPath, executionStore),
+		createdAt:        time.Now().UTC(),
 	}
 
 	adapter.executor = NewWorkflowExecutor(toolCaller, adapter)
+	adapter.executor.artifacts = adapter.artifacts
 
 	// Start the background retention GC so execution records stay bounded in
 	// both backends without manual cleanup.
@@ -113,17 +137,32 @@ func (a *Adapter) runRetentionGC(ctx context.Context) {
 			if deleted > 0 {
 				logging.Info("WorkflowAdapter", "Execution retention GC pruned %d record(s)", deleted)
 			}
+
+			// Artifacts outlive their execution by at most the maximum age,
+			// as executions pruned for the count leave theirs behind.
+			deleted, err = a.artifacts.prune(time.Now().Add(-policy.MaxAge))
+			if err != nil {
+				logging.Warn("WorkflowAdapter", "Artifact retention GC failed: %v", err)
+				continue
+			}
+			if deleted > 0 {
+				logging.Info("WorkflowAdapter", "Artifact retention GC pruned %d artifact(s)", deleted)
+			}
 		}
 	}
 }
 
 // newExecutionStorage selects the execution-storage backend by deployment mode:
-// the durable Kubernetes CRD backend when running against a cluster, and the
-// filesystem backend for standalone `muster serve` (a writable config dir).
-func newExecutionStorage(musterClient client.MusterClient, namespace, configPath string) ExecutionStorage {
+// the durable Kubernetes CRD backend when running against a cluster, and for
+// standalone `muster serve` the configured store, if any, or else the
+// filesystem backend (a writable config dir).
+func newExecutionStorage(musterClient client.MusterClient, namespace, configPath string, store config.EntityStorage) ExecutionStorage {
 	if musterClient != nil && musterClient.IsKubernetesMode() {
 		return newK8sExecutionStorage(musterClient, namespace)
 	}
+	if store != nil {
+		return NewExecutionStorageWithStorage(store)
+	}
 	return NewExecutionStorage(configPath)
 }
 
@@ -133,17 +172,167 @@ func (a *Adapter) Register() {
 	logging.Debug("WorkflowAdapter", "Registered workflow adapter with API layer")
 }
 
-// ExecuteWorkflow executes a workflow and returns MCP result
+// ExecuteWorkflow executes a workflow and returns MCP result. The execution
+// waits in the execution queue, in the pending state, until a worker is free
+// to run it.
 func (a *Adapter) ExecuteWorkflow(ctx context.Context, workflowName string, args map[string]interface{}) (*api.CallToolResult, error) {
 	logging.Debug("WorkflowAdapter", "Executing workflow: %s", workflowName)
 
+	workflow, err := a.prepareExecution(ctx, workflowName)
+	if err != nil {
+		var unavailable *api.UnavailableError
+		if errors.As(err, &unavailable) {
+			return api.NewErrorResult(fmt.Sprintf("workflow %s is not available (missing required tools)", workflowName), err), nil
+		}
+		return api.HandleError(err), nil
+	}
+
+	concurrency, err := a.executor.executionConcurrency(workflow, args)
+	if err != nil {
+		return api.HandleError(err), nil
+	}
+
+	// Nested workflows run in the worker slot of the workflow that calls
+	// them, within their own limits and the mutexes their callers do not
+	// hold already. They fail rather than wait, which could deadlock
+	if isWithinExecution(ctx) {
+		if nested := nestedConcurrency(ctx, workflowName, concurrency); nested.limited() {
+			release, err := a.queue.acquireNested(workflowName, nested)
+			if err != nil {
+				return api.HandleError(err), nil
+			}
+			defer release()
+		}
+		return a.runExecution(withinExecution(ctx, workflowName, concurrency), workflow, newExecution(workflowName, args))
+	}
+
+	execution := a.executionTracker.Enqueue(ctx, workflowName, args)
+	release, err := a.queue.acquire(ctx, workflowName, concurrency)
+	if err != nil {
+		err = fmt.Errorf("execution %s of workflow %s cancelled while pending: %w", execution.ExecutionID, workflowName, err)
+		a.executionTracker.Fail(context.WithoutCancel(ctx), execution, err)
+		return api.HandleError(err), nil
+	}
+	defer release()
+
+	return a.runExecution(withinExecution(ctx, workflowName, concurrency), workflow, execution)
+}
+
+// SetExecutionLimits sets the number of workflow executions that run at the
+// same time, in total and per workflow. Non-positive workers keep the
+// default of 10; a maxPerWorkflow of 0 sets no limit per workflow.
+func (a *Adapter) SetExecutionLimits(workers, maxPerWorkflow int) {
+	a.queue.setLimits(workers, maxPerWorkflow)
+}
+
+// StandBy makes the adapter of a warm standby leave the execution storage to
+// the serving instance: ResumePendingExecutions waits for TakeOver. It must
+// be called before ResumePendingExecutions.
+func (a *Adapter) StandBy() {
+	a.takeover = make(chan struct{})
+}
+
+// TakeOver makes the executions in the storage those of this instance, once
+// the standby holds the handover lock. The executions started until now,
+// including the ones the previous instance started after this adapter was
+// created, are resumed or failed by ResumePendingExecutions.
+func (a *Adapter) TakeOver() {
+	if a.takeover == nil {
+		return
+	}
+	a.takeoverOnce.Do(func() {
+		a.createdAt = time.Now().UTC()
+		close(a.takeover)
+	})
+}
+
+// ResumePendingExecutions runs the executions a previous run of muster left
+// pending in the execution storage, oldest first, and marks the ones it left
+// in progress as failed, as they were interrupted. It returns once all
+// pending executions are queued; they run without the session of the caller
+// that started them. It assumes a single muster instance per storage, so a
+// standby waits until it takes over, or ctx is done.
+func (a *Adapter) ResumePendingExecutions(ctx context.Context) {
+	if a.takeover != nil {
+		select {
+		case <-a.takeover:
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	interrupted, err := a.executionTracker.ListByStatus(ctx, api.WorkflowExecutionInProgress)
+	if err != nil {
+		logging.Warn("WorkflowAdapter", "Failed to list interrupted executions: %v", err)
+	}
+	for _, execution := range interrupted {
+		// Executions of this run started after the adapter was created
+		if !execution.StartedAt.Before(a.createdAt) {
+			continue
+		}
+		logging.Info("WorkflowAdapter", "Marking execution %s of workflow %s as failed, it was interrupted by a restart", execution.ExecutionID, execution.WorkflowName)
+		a.executionTracker.Fail(ctx, execution, errors.New("execution interrupted by a restart of muster"))
+	}
+
+	pending, err := a.executionTracker.ListByStatus(ctx, api.WorkflowExecutionPending)
+	if err != nil {
+		logging.Warn("WorkflowAdapter", "Failed to list pending executions: %v", err)
+		return
+	}
+	for _, execution := range pending {
+		if !execution.StartedAt.Before(a.createdAt) {
+			continue
+		}
+
+		concurrency, err := a.resumedExecutionConcurrency(ctx, execution)
+		if err != nil {
+			// Keep the execution pending for the next start if ctx is done
+			if ctx.Err() != nil {
+				return
+			}
+			logging.Warn("WorkflowAdapter", "Failed to resume pending execution %s: %v", execution.ExecutionID, err)
+			a.executionTracker.Fail(ctx, execution, err)
+			continue
+		}
+
+		// Keep the execution pending for the next start if ctx is done first
+		release, err := a.queue.acquire(ctx, execution.WorkflowName, concurrency)
+		if err != nil {
+			return
+		}
+		logging.Info("WorkflowAdapter", "Resuming pending execution %s of workflow %s", execution.ExecutionID, execution.WorkflowName)
+		go func() {
+			defer release()
+
+			workflow, err := a.prepareResumedExecution(ctx, execution.WorkflowName)
+			if err != nil {
+				if ctx.Err() == nil {
+					a.executionTracker.Fail(ctx, execution, err)
+				}
+				return
+			}
+			_, _ = a.runExecution(withinExecution(ctx, execution.WorkflowName, concurrency), workflow, execution)
+		}()
+	}
+}
+
+// resumedExecutionConcurrency returns the concurrency of a resumed pending
+// execution, so it waits for the limits and mutexes of its workflow.
+func (a *Adapter) resumedExecutionConcurrency(ctx context.Context, execution *api.WorkflowExecution) (executionConcurrency, error) {
+	workflowCRD, err := a.client.GetWorkflow(ctx, execution.WorkflowName, a.namespace)
+	if err != nil {
+		return executionConcurrency{}, fmt.Errorf("failed to get workflow %s: %w", execution.WorkflowName, err)
+	}
+	return a.executor.executionConcurrency(a.convertCRDToWorkflow(workflowCRD), execution.Input)
+}
+
+// prepareExecution returns the workflow workflowName if all the tools it
+// needs are available for the calling session.
+func (a *Adapter) prepareExecution(ctx context.Context, workflowName string) (*api.Workflow, error) {
 	// Get the workflow CRD
 	workflowCRD, err := a.client.GetWorkflow(ctx, workflowName, a.namespace)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("workflow %s not found", workflowName)},
-			IsError: true,
-		}, nil
+		return nil, api.NewWorkflowNotFoundError(workflowName)
 	}
 
 	// Convert CRD to internal workflow format
@@ -152,31 +341,55 @@ func (a *Adapter) ExecuteWorkflow(ctx context.Context, workflowName string, args
 	// Check if workflow is available before execution
 	if missingTools := a.findMissingTools(ctx, workflow); len(missingTools) > 0 {
 		// Generate workflow unavailable event with missing tools
-		a.generateCRDEvent(workflowName, events.ReasonWorkflowUnavailable, events.EventData{
+		a.generateCRDEvent(ctx, workflowName, events.ReasonWorkflowUnavailable, events.EventData{
 			Operation: opExecute,
 			ToolNames: missingTools,
 		})
 
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("workflow %s is not available (missing required tools)", workflowName)},
-			IsError: true,
-		}, nil
+		return nil, api.NewUnavailableError("workflow "+workflowName, fmt.Errorf("missing tools %v", missingTools))
+	}
+	return workflow, nil
+}
+
+// prepareResumedExecution is prepareExecution for a resumed execution. As the
+// MCP servers are still connecting after a restart, it waits up to
+// resumeAvailabilityTimeout for the tools of the workflow to be available.
+func (a *Adapter) prepareResumedExecution(ctx context.Context, workflowName string) (*api.Workflow, error) {
+	deadline := time.Now().Add(resumeAvailabilityTimeout)
+	for {
+		workflow, err := a.prepareExecution(ctx, workflowName)
+		var unavailable *api.UnavailableError
+		if err == nil || !errors.As(err, &unavailable) || time.Now().After(deadline) {
+			return workflow, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(resumeRetryInterval):
+		}
 	}
+}
+
+// runExecution runs execution of workflow with tracking.
+func (a *Adapter) runExecution(ctx context.Context, workflow *api.Workflow, execution *api.WorkflowExecution) (*api.CallToolResult, error) {
+	workflowName := workflow.Name
+	args := execution.Input
 
 	// Generate execution started event
-	a.generateCRDEvent(workflowName, events.ReasonWorkflowExecutionStarted, events.EventData{
+	a.generateCRDEvent(ctx, workflowName, events.ReasonWorkflowExecutionStarted, events.EventData{
 		Operation: opExecute,
 		StepCount: len(workflow.Steps),
 	})
 
 	// Execute workflow with automatic tracking
-	result, execution, err := a.executionTracker.TrackExecution(ctx, workflowName, args, func() (*mcp.CallToolResult, error) {
-		return a.executor.ExecuteWorkflow(ctx, workflow, args)
+	result, execution, err := a.executionTracker.RunExecution(ctx, execution, func() (*mcp.CallToolResult, error) {
+		return a.executor.ExecuteWorkflow(withExecutionID(ctx, execution.ExecutionID), workflow, args)
 	})
 
 	// Generate execution tracked event
 	if execution != nil {
-		a.generateCRDEvent(workflowName, events.ReasonWorkflowExecutionTracked, events.EventData{
+		a.generateCRDEvent(ctx, workflowName, events.ReasonWorkflowExecutionTracked, events.EventData{
 			Operation:   opExecute,
 			ExecutionID: execution.ExecutionID,
 		})
@@ -204,7 +417,7 @@ func (a *Adapter) ExecuteWorkflow(ctx context.Context, workflowName string, args
 		if execution != nil {
 			eventData.ExecutionID = execution.ExecutionID
 		}
-		a.generateCRDEvent(workflowName, events.ReasonWorkflowExecutionFailed, eventData)
+		a.generateCRDEvent(ctx, workflowName, events.ReasonWorkflowExecutionFailed, eventData)
 
 		// Convert mcp result to api result
 		if result != nil {
@@ -222,10 +435,7 @@ func (a *Adapter) ExecuteWorkflow(ctx context.Context, workflowName string, args
 			}, nil
 		}
 
-		return &api.CallToolResult{
-			Content: []interface{}{err.Error()},
-			IsError: true,
-		}, nil
+		return api.HandleError(err), nil
 	}
 
 	// Generate execution completed event
@@ -239,7 +449,7 @@ func (a *Adapter) ExecuteWorkflow(ctx context.Context, workflowName string, args
 			eventData.Duration = time.Duration(execution.DurationMs) * time.Millisecond
 		}
 	}
-	a.generateCRDEvent(workflowName, events.ReasonWorkflowExecutionCompleted, eventData)
+	a.generateCRDEvent(ctx, workflowName, events.ReasonWorkflowExecutionCompleted, eventData)
 
 	// Convert mcp.CallToolResult to api.CallToolResult
 	var content []interface{}
@@ -269,26 +479,39 @@ func (a *Adapter) GetWorkflows() []api.Workflow {
 // getWorkflows returns all workflows with availability evaluated for the
 // calling session carried by ctx.
 func (a *Adapter) getWorkflows(ctx context.Context) []api.Workflow {
-	workflowCRDs, err := a.client.ListWorkflows(ctx, a.namespace)
+	workflows := a.listWorkflowDefinitions(ctx, a.namespace)
+	a.setWorkflowAvailability(ctx, workflows)
+	return workflows
+}
+
+// listWorkflowDefinitions returns all workflows of namespace without
+// evaluating their availability.
+func (a *Adapter) listWorkflowDefinitions(ctx context.Context, namespace string) []api.Workflow {
+	workflowCRDs, err := a.client.ListWorkflows(ctx, namespace)
 	if err != nil {
 		logging.Error("WorkflowAdapter", err, "Failed to list workflows")
 		return []api.Workflow{}
 	}
 
+	workflows := make([]api.Workflow, 0, len(workflowCRDs))
+	for _, workflowCRD := range workflowCRDs {
+		workflows = append(workflows, *a.convertCRDToWorkflow(&workflowCRD))
+	}
+	return workflows
+}
+
+// setWorkflowAvailability evaluates the availability of workflows for the
+// calling session carried by ctx.
+func (a *Adapter) setWorkflowAvailability(ctx context.Context, workflows []api.Workflow) {
 	// Every workflow's availability check resolves the same session tool set.
 	// Install a request-scoped memo so that expensive resolution happens once
 	// for the whole list instead of once per workflow (the O(workflows)
 	// rebuild that made this endpoint take ~30 s for ~280 workflows).
 	ctx = api.WithSessionToolMemo(ctx)
 
-	workflows := make([]api.Workflow, 0, len(workflowCRDs))
-	for _, workflowCRD := range workflowCRDs {
-		workflow := a.convertCRDToWorkflow(&workflowCRD)
-		workflow.Available = a.isWorkflowAvailable(ctx, workflow)
-		workflows = append(workflows, *workflow)
+	for i := range workflows {
+		workflows[i].Available = a.isWorkflowAvailable(ctx, &workflows[i])
 	}
-
-	return workflows
 }
 
 // GetWorkflow returns a specific workflow definition.
@@ -297,19 +520,25 @@ func (a *Adapter) getWorkflows(ctx context.Context) []api.Workflow {
 // context). Session-aware availability is computed in the tool-dispatch path
 // (handleGet / handleWorkflowAvailable) via getWorkflow.
 func (a *Adapter) GetWorkflow(name string) (*api.Workflow, error) {
-	return a.getWorkflow(context.Background(), name)
+	return a.getWorkflow(context.Background(), name, a.namespace)
 }
 
-// getWorkflow returns a specific workflow with availability evaluated for the
-// calling session carried by ctx.
-func (a *Adapter) getWorkflow(ctx context.Context, name string) (*api.Workflow, error) {
-	workflowCRD, err := a.client.GetWorkflow(ctx, name, a.namespace)
+// getWorkflow returns a workflow of namespace with availability evaluated for
+// the calling session carried by ctx.
+func (a *Adapter) getWorkflow(ctx context.Context, name, namespace string) (*api.Workflow, error) {
+	workflowCRD, err := a.client.GetWorkflow(ctx, name, namespace)
 	if err != nil {
 		return nil, api.NewWorkflowNotFoundError(name)
 	}
 
 	workflow := a.convertCRDToWorkflow(workflowCRD)
 	workflow.Available = a.isWorkflowAvailable(ctx, workflow)
+
+	// The reconciler gets a workflow whenever its definition changes, so its
+	// templates are parsed here rather than on its first execution
+	if err := a.executor.compileTemplates(workflow); err != nil {
+		logging.Warn("WorkflowAdapter", "Workflow %s has an invalid template: %v", name, err)
+	}
 	return workflow, nil
 }
 
@@ -317,7 +546,7 @@ func (a *Adapter) getWorkflow(ctx context.Context, name string) (*api.Workflow,
 func (a *Adapter) CreateWorkflowFromStructured(args map[string]interface{}) error {
 	// Validate the workflow before creating it
 	if err := a.ValidateWorkflowFromStructured(args); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+		return &api.ValidationFailedError{Message: "validation failed", Err: err}
 	}
 
 	// Convert structured arguments to api.Workflow
@@ -328,6 +557,7 @@ func (a *Adapter) CreateWorkflowFromStructured(args map[string]interface{}) erro
 
 	// Build the CRD from the internal workflow representation.
 	workflowCRD := a.convertWorkflowToCRD(&wf)
+	workflowCRD.Namespace = api.NamespaceFrom(args, a.namespace)
 	workflowCRD.TypeMeta = metav1.TypeMeta{
 		APIVersion: "muster.giantswarm.io/v1alpha1",
 		Kind:       "Workflow",
@@ -337,15 +567,17 @@ func (a *Adapter) CreateWorkflowFromStructured(args map[string]interface{}) erro
 	ctx := context.Background()
 	if err := a.client.CreateWorkflow(ctx, workflowCRD); err != nil {
 		// Generate failure event
-		a.generateCRDEvent(wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
+		a.generateCRDEvent(ctx, wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
+			Namespace: workflowCRD.Namespace,
 			Error:     err.Error(),
 			Operation: "create",
 		})
-		return fmt.Errorf("failed to create workflow: %w", err)
+		return workflowClientError("create", wf.Name, err)
 	}
 
 	// Generate success event for CRD creation
-	a.generateCRDEvent(wf.Name, events.ReasonWorkflowCreated, events.EventData{
+	a.generateCRDEvent(ctx, wf.Name, events.ReasonWorkflowCreated, events.EventData{
+		Namespace: workflowCRD.Namespace,
 		Operation: "create",
 		StepCount: len(wf.Steps),
 	})
@@ -357,7 +589,7 @@ func (a *Adapter) CreateWorkflowFromStructured(args map[string]interface{}) erro
 func (a *Adapter) UpdateWorkflowFromStructured(name string, args map[string]interface{}) error {
 	// Validate the workflow before updating it
 	if err := a.ValidateWorkflowFromStructured(args); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+		return &api.ValidationFailedError{Message: "validation failed", Err: err}
 	}
 
 	// Convert structured arguments to api.Workflow
@@ -371,20 +603,23 @@ func (a *Adapter) UpdateWorkflowFromStructured(name string, args map[string]inte
 
 	// Convert to CRD
 	workflowCRD := a.convertWorkflowToCRD(&wf)
+	workflowCRD.Namespace = api.NamespaceFrom(args, a.namespace)
 
 	// Update the CRD
 	ctx := context.Background()
 	if err := a.client.UpdateWorkflow(ctx, workflowCRD); err != nil {
 		// Generate failure event
-		a.generateCRDEvent(wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
+		a.generateCRDEvent(ctx, wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
+			Namespace: workflowCRD.Namespace,
 			Error:     err.Error(),
 			Operation: "update",
 		})
-		return fmt.Errorf("failed to update workflow: %w", err)
+		return workflowClientError("update", name, err)
 	}
 
 	// Generate success event for CRD update
-	a.generateCRDEvent(wf.Name, events.ReasonWorkflowUpdated, events.EventData{
+	a.generateCRDEvent(ctx, wf.Name, events.ReasonWorkflowUpdated, events.EventData{
+		Namespace: workflowCRD.Namespace,
 		Operation: "update",
 		StepCount: len(wf.Steps),
 	})
@@ -398,7 +633,7 @@ func (a *Adapter) ValidateWorkflowFromStructured(args map[string]interface{}) er
 	wf, err := convertToWorkflow(args)
 	if err != nil {
 		// Generate validation failure event
-		a.generateCRDEvent(wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
+		a.generateCRDEvent(context.Background(), wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
 			Error:     err.Error(),
 			Operation: opValidate,
 		})
@@ -408,7 +643,7 @@ func (a *Adapter) ValidateWorkflowFromStructured(args map[string]interface{}) er
 	// Basic validation
 	if wf.Name == "" {
 		err := fmt.Errorf("workflow name is required")
-		a.generateCRDEvent("", events.ReasonWorkflowValidationFailed, events.EventData{
+		a.generateCRDEvent(context.Background(), "", events.ReasonWorkflowValidationFailed, events.EventData{
 			Error:     err.Error(),
 			Operation: opValidate,
 		})
@@ -416,7 +651,7 @@ func (a *Adapter) ValidateWorkflowFromStructured(args map[string]interface{}) er
 	}
 	if len(wf.Steps) == 0 {
 		err := fmt.Errorf("workflow must have at least one step")
-		a.generateCRDEvent(wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
+		a.generateCRDEvent(context.Background(), wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
 			Error:     err.Error(),
 			Operation: opValidate,
 		})
@@ -425,13 +660,18 @@ func (a *Adapter) ValidateWorkflowFromStructured(args map[string]interface{}) er
 
 	// fail records a validation failure event and returns the error.
 	fail := func(err error) error {
-		a.generateCRDEvent(wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
+		a.generateCRDEvent(context.Background(), wf.Name, events.ReasonWorkflowValidationFailed, events.EventData{
 			Error:     err.Error(),
 			Operation: opValidate,
 		})
 		return err
 	}
 
+	// Template syntax errors surface now rather than when the workflow runs
+	if err := a.executor.template.Compile(args); err != nil {
+		return fail(err)
+	}
+
 	// Step validation
 	stepIDs := make(map[string]bool)
 	for i, step := range wf.Steps {
@@ -443,13 +683,18 @@ func (a *Adapter) ValidateWorkflowFromStructured(args map[string]interface{}) er
 		}
 		stepIDs[step.ID] = true
 
-		// A step must be exactly one of: tool call, forEach loop, or parallel group.
+		// A step must be exactly one of: tool call, prompt, forEach loop, or
+		// parallel group.
 		composite := step.ForEach != nil || len(step.Parallel) > 0
 		switch {
-		case step.Tool == "" && !composite:
-			return fail(fmt.Errorf("step %d (%s): one of tool, forEach, or parallel is required", i, step.ID))
+		case step.Tool == "" && step.Prompt == "" && !composite:
+			return fail(fmt.Errorf("step %d (%s): one of tool, prompt, forEach, or parallel is required", i, step.ID))
+		case step.Tool != "" && step.Prompt != "":
+			return fail(fmt.Errorf("step %d (%s): tool and prompt are mutually exclusive", i, step.ID))
 		case step.Tool != "" && composite:
 			return fail(fmt.Errorf("step %d (%s): tool is mutually exclusive with forEach/parallel", i, step.ID))
+		case step.Prompt != "" && composite:
+			return fail(fmt.Errorf("step %d (%s): prompt is mutually exclusive with forEach/parallel", i, step.ID))
 		case step.ForEach != nil && len(step.Parallel) > 0:
 			return fail(fmt.Errorf("step %d (%s): forEach and parallel are mutually exclusive", i, step.ID))
 		}
@@ -481,10 +726,14 @@ func (a *Adapter) ValidateWorkflowFromStructured(args map[string]interface{}) er
 		return fail(err)
 	}
 
+	if err := validateWorkflowConcurrency(wf.Concurrency); err != nil {
+		return fail(err)
+	}
+
 	logAuthoringWarnings(&wf)
 
 	// Generate validation success event
-	a.generateCRDEvent(wf.Name, events.ReasonWorkflowValidationSucceeded, events.EventData{
+	a.generateCRDEvent(context.Background(), wf.Name, events.ReasonWorkflowValidationSucceeded, events.EventData{
 		Operation: opValidate,
 		StepCount: len(wf.Steps),
 	})
@@ -535,6 +784,29 @@ func validateWorkflowCondition(c *api.WorkflowCondition) error {
 	return nil
 }
 
+// validateWorkflowConcurrency checks that the concurrency limits of a
+// workflow are usable: a non-negative maxConcurrent and non-empty, distinct
+// mutex names.
+func validateWorkflowConcurrency(c *api.WorkflowConcurrency) error {
+	if c == nil {
+		return nil
+	}
+	if c.MaxConcurrent < 0 {
+		return fmt.Errorf("concurrency.maxConcurrent must not be negative")
+	}
+	seen := make(map[string]bool, len(c.Mutexes))
+	for i, mutex := range c.Mutexes {
+		if strings.TrimSpace(mutex) == "" {
+			return fmt.Errorf("concurrency.mutexes[%d] cannot be empty", i)
+		}
+		if seen[mutex] {
+			return fmt.Errorf("concurrency.mutexes: duplicate mutex '%s'", mutex)
+		}
+		seen[mutex] = true
+	}
+	return nil
+}
+
 // validateWorkflowSubSteps validates the sub-steps used inside forEach bodies,
 // parallel groups, and onFailure handlers. Sub-step IDs must be present and
 // unique within the group, every sub-step must name a tool, and any condition
@@ -561,24 +833,45 @@ func validateWorkflowSubSteps(label string, subs []api.WorkflowSubStep) error {
 
 // DeleteWorkflow deletes a workflow
 func (a *Adapter) DeleteWorkflow(name string) error {
+	return a.deleteWorkflow(name, a.namespace)
+}
+
+// deleteWorkflow deletes a workflow of namespace.
+func (a *Adapter) deleteWorkflow(name, namespace string) error {
 	ctx := context.Background()
-	if err := a.client.DeleteWorkflow(ctx, name, a.namespace); err != nil {
+	if err := a.client.DeleteWorkflow(ctx, name, namespace); err != nil {
 		// Generate failure event
-		a.generateCRDEvent(name, events.ReasonWorkflowValidationFailed, events.EventData{
+		a.generateCRDEvent(ctx, name, events.ReasonWorkflowValidationFailed, events.EventData{
+			Namespace: namespace,
 			Error:     err.Error(),
 			Operation: "delete",
 		})
-		return fmt.Errorf("failed to delete workflow: %w", err)
+		return workflowClientError("delete", name, err)
 	}
 
 	// Generate success event for CRD deletion
-	a.generateCRDEvent(name, events.ReasonWorkflowDeleted, events.EventData{
+	a.generateCRDEvent(ctx, name, events.ReasonWorkflowDeleted, events.EventData{
+		Namespace: namespace,
 		Operation: "delete",
 	})
 
 	return nil
 }
 
+// workflowClientError wraps an error of the muster client for the workflow
+// name, giving missing and already existing workflows their API error code.
+func workflowClientError(action, name string, err error) error {
+	message := fmt.Sprintf("failed to %s workflow: %v", action, err)
+	switch {
+	case apierrors.IsNotFound(err):
+		return &api.NotFoundError{ResourceType: "workflow", ResourceName: name, Message: message}
+	case apierrors.IsAlreadyExists(err), apierrors.IsConflict(err):
+		return &api.ConflictError{ResourceType: "workflow", ResourceName: name, Message: message}
+	default:
+		return fmt.Errorf("failed to %s workflow: %w", action, err)
+	}
+}
+
 // ListWorkflowExecutions returns paginated list of workflow executions with optional filtering
 func (a *Adapter) ListWorkflowExecutions(ctx context.Context, req *api.ListWorkflowExecutionsRequest) (*api.ListWorkflowExecutionsResponse, error) {
 	return a.executionTracker.ListExecutions(ctx, req)
@@ -589,6 +882,11 @@ func (a *Adapter) GetWorkflowExecution(ctx context.Context, req *api.GetWorkflow
 	return a.executionTracker.GetExecution(ctx, req)
 }
 
+// GetStepArtifact returns the artifact a step of an execution stored.
+func (a *Adapter) GetStepArtifact(ctx context.Context, executionID, stepID string) (*api.StepArtifact, error) {
+	return a.artifacts.get(executionID, stepID)
+}
+
 // CallToolInternal calls a tool internally - required by ToolCaller interface
 func (a *Adapter) CallToolInternal(ctx context.Context, toolName string, args map[string]interface{}) (*mcp.CallToolResult, error) {
 	if a.executor == nil {
@@ -624,6 +922,7 @@ func (a *Adapter) convertCRDToWorkflow(workflowCRD *musterv1alpha1.Workflow) *ap
 		Args:         a.convertArgDefinitions(workflowCRD.Spec.Args),
 		Steps:        a.convertWorkflowSteps(workflowCRD.Spec.Steps),
 		OnFailure:    a.convertSubSteps(workflowCRD.Spec.OnFailure),
+		Concurrency:  convertConcurrency(workflowCRD.Spec.Concurrency),
 		CreatedAt:    workflowCRD.CreationTimestamp.Time,
 		LastModified: workflowCRD.CreationTimestamp.Time,
 	}
@@ -657,10 +956,33 @@ func (a *Adapter) convertWorkflowToCRD(workflow *api.Workflow) *musterv1alpha1.W
 			Steps:       a.convertWorkflowStepsToCRD(workflow.Steps),
 			OnFailure:   a.convertSubStepsToCRD(workflow.OnFailure),
 			Output:      a.workflowOutputToCRD(workflow.Output),
+			Concurrency: convertConcurrencyToCRD(workflow.Concurrency),
 		},
 	}
 }
 
+// convertConcurrency converts CRD concurrency limits to internal format.
+func convertConcurrency(c *musterv1alpha1.WorkflowConcurrency) *api.WorkflowConcurrency {
+	if c == nil {
+		return nil
+	}
+	return &api.WorkflowConcurrency{
+		MaxConcurrent: c.MaxConcurrent,
+		Mutexes:       slices.Clone(c.Mutexes),
+	}
+}
+
+// convertConcurrencyToCRD converts internal concurrency limits to CRD format.
+func convertConcurrencyToCRD(c *api.WorkflowConcurrency) *musterv1alpha1.WorkflowConcurrency {
+	if c == nil {
+		return nil
+	}
+	return &musterv1alpha1.WorkflowConcurrency{
+		MaxConcurrent: c.MaxConcurrent,
+		Mutexes:       slices.Clone(c.Mutexes),
+	}
+}
+
 // workflowOutputToCRD converts an internal output template to CRD raw-JSON
 // form, returning nil when no output template is declared.
 func (a *Adapter) workflowOutputToCRD(output map[string]interface{}) map[string]apiextensionsv1.JSON {
@@ -705,9 +1027,11 @@ func (a *Adapter) convertWorkflowSteps(crdSteps []musterv1alpha1.WorkflowStep) [
 		step := api.WorkflowStep{
 			ID:           crdStep.ID,
 			Tool:         crdStep.Tool,
+			Prompt:       crdStep.Prompt,
 			Args:         a.convertRawExtensionMap(crdStep.Args),
 			Output:       crdStep.Output,
 			Store:        crdStep.Store,
+			Artifact:     crdStep.Artifact,
 			AllowFailure: crdStep.AllowFailure,
 			Parallel:     a.convertSubSteps(crdStep.Parallel),
 			Description:  crdStep.Description,
@@ -737,9 +1061,11 @@ func (a *Adapter) convertWorkflowStepsToCRD(steps []api.WorkflowStep) []musterv1
 		crdStep := musterv1alpha1.WorkflowStep{
 			ID:           step.ID,
 			Tool:         step.Tool,
+			Prompt:       step.Prompt,
 			Args:         a.convertToRawExtensionMap(step.Args),
 			Output:       step.Output,
 			Store:        step.Store,
+			Artifact:     step.Artifact,
 			AllowFailure: step.AllowFailure,
 			Parallel:     a.convertSubStepsToCRD(step.Parallel),
 			Description:  step.Description,
@@ -967,6 +1293,7 @@ var workflowManagementTools = map[string]struct{}{
 	"workflow_update":         {},
 	"workflow_delete":         {},
 	"workflow_validate":       {},
+	"workflow_batch":          {},
 	"workflow_available":      {},
 	"workflow_execution_list": {},
 	"workflow_execution_get":  {},
@@ -1171,7 +1498,7 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 		{
 			Name:        "workflow_list",
 			Description: "List all workflows",
-			Args: []api.ArgMetadata{
+			Args: append([]api.ArgMetadata{
 				{
 					Name:        "include_system",
 					Type:        api.ArgTypeBoolean,
@@ -1179,7 +1506,8 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Description: "Include system-defined workflows",
 					Default:     true,
 				},
-			},
+				api.NamespaceArgMetadata(),
+			}, api.ListArgs("Workflows carry the labels of their definition.")...),
 		},
 		{
 			Name:        "workflow_get",
@@ -1191,6 +1519,7 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Required:    true,
 					Description: "Name of the workflow",
 				},
+				api.NamespaceArgMetadata(),
 			},
 		},
 		{
@@ -1203,6 +1532,7 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Required:    true,
 					Description: "Name of the workflow",
 				},
+				api.NamespaceArgMetadata(),
 				{
 					Name:        "description",
 					Type:        api.ArgTypeString,
@@ -1237,6 +1567,13 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Description: "Optional output template that shapes the returned document",
 					Schema:      getWorkflowOutputSchema(),
 				},
+				{
+					Name:        fieldConcurrency,
+					Type:        api.ArgTypeObject,
+					Required:    false,
+					Description: "Limits of the executions that run at the same time",
+					Schema:      getWorkflowConcurrencySchema(),
+				},
 			},
 		},
 		{
@@ -1249,6 +1586,7 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Required:    true,
 					Description: "Name of the workflow to update",
 				},
+				api.NamespaceArgMetadata(),
 				{
 					Name:        "description",
 					Type:        api.ArgTypeString,
@@ -1283,6 +1621,13 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Description: "Optional output template that shapes the returned document",
 					Schema:      getWorkflowOutputSchema(),
 				},
+				{
+					Name:        fieldConcurrency,
+					Type:        api.ArgTypeObject,
+					Required:    false,
+					Description: "Limits of the executions that run at the same time",
+					Schema:      getWorkflowConcurrencySchema(),
+				},
 			},
 		},
 		{
@@ -1295,6 +1640,7 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Required:    true,
 					Description: "Name of the workflow to delete",
 				},
+				api.NamespaceArgMetadata(),
 			},
 		},
 		{
@@ -1307,6 +1653,7 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Required:    true,
 					Description: "Name of the workflow",
 				},
+				api.NamespaceArgMetadata(),
 				{
 					Name:        "description",
 					Type:        api.ArgTypeString,
@@ -1341,8 +1688,20 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Description: "Optional output template that shapes the returned document",
 					Schema:      getWorkflowOutputSchema(),
 				},
+				{
+					Name:        fieldConcurrency,
+					Type:        api.ArgTypeObject,
+					Required:    false,
+					Description: "Limits of the executions that run at the same time",
+					Schema:      getWorkflowConcurrencySchema(),
+				},
 			},
 		},
+		{
+			Name:        "workflow_batch",
+			Description: "Create, update and delete several workflow definitions in one call, optionally atomically",
+			Args:        api.BatchArgs("workflow"),
+		},
 		{
 			Name:        "workflow_available",
 			Description: "Check if a workflow is available",
@@ -1369,7 +1728,7 @@ func (a *Adapter) GetTools() []api.ToolMetadata {
 					Name:        "status",
 					Type:        api.ArgTypeString,
 					Required:    false,
-					Description: "Filter by execution status",
+					Description: "Filter by execution status: pending, inprogress, completed, or failed",
 				},
 				{
 					Name:        "limit",
@@ -1443,6 +1802,8 @@ func (a *Adapter) ExecuteTool(ctx context.Context, toolName string, args map[str
 		return a.handleDelete(args)
 	case toolName == "workflow_validate":
 		return a.handleValidate(args)
+	case toolName == "workflow_batch":
+		return a.handleBatch(ctx, args)
 	case toolName == "workflow_available":
 		return a.handleWorkflowAvailable(ctx, args)
 	case toolName == "workflow_execution_list":
@@ -1486,10 +1847,20 @@ func (a *Adapter) convertWorkflowArgs(workflowName string) []api.ArgMetadata {
 
 // Helper methods for handling management operations
 func (a *Adapter) handleList(ctx context.Context, args map[string]interface{}) (*api.CallToolResult, error) {
-	workflows := a.getWorkflows(ctx)
+	opts, err := api.ParseListOptions(args)
+	if err != nil {
+		return api.HandleError(err), nil
+	}
 
-	var result []map[string]interface{}
-	for _, wf := range workflows {
+	// Filter and paginate before evaluating availability, which is the
+	// expensive part of listing, so that only the returned page pays for it
+	page := api.PaginateList(a.listWorkflowDefinitions(ctx, api.NamespaceFrom(args, a.namespace)), opts, func(wf api.Workflow) (string, map[string]string) {
+		return wf.Name, wf.Labels
+	})
+	a.setWorkflowAvailability(ctx, page.Items)
+
+	result := make([]map[string]interface{}, 0, len(page.Items))
+	for _, wf := range page.Items {
 		workflowInfo := map[string]interface{}{
 			api.FieldName: wf.Name,
 			"available":   wf.Available,
@@ -1503,14 +1874,14 @@ func (a *Adapter) handleList(ctx context.Context, args map[string]interface{}) (
 		result = append(result, workflowInfo)
 	}
 
-	// Sort workflows by name for consistent ordering
-	sort.Slice(result, func(i, j int) bool {
-		return result[i]["name"].(string) < result[j]["name"].(string)
-	})
-
-	// Wrap the result in a "workflows" field to match expected format
+	// Wrap the result in a "workflows" field to match expected format.
+	// PaginateList already sorted the workflows by name.
 	response := map[string]interface{}{
 		"workflows": result,
+		"total":     page.Total,
+	}
+	if page.NextCursor != "" {
+		response[api.ListKeyNextCursor] = page.NextCursor
 	}
 
 	return &api.CallToolResult{
@@ -1522,13 +1893,10 @@ func (a *Adapter) handleList(ctx context.Context, args map[string]interface{}) (
 func (a *Adapter) handleGet(ctx context.Context, args map[string]interface{}) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok {
-		return &api.CallToolResult{
-			Content: []interface{}{"name is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("name is required")), nil
 	}
 
-	workflow, err := a.getWorkflow(ctx, name)
+	workflow, err := a.getWorkflow(ctx, name, api.NamespaceFrom(args, a.namespace))
 	if err != nil {
 		return api.HandleErrorWithPrefix(err, "Failed to get workflow"), nil
 	}
@@ -1536,10 +1904,7 @@ func (a *Adapter) handleGet(ctx context.Context, args map[string]interface{}) (*
 	// Convert to YAML for easier viewing
 	yamlData, err := yaml.Marshal(workflow)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to marshal workflow: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to marshal workflow"), nil
 	}
 
 	result := map[string]interface{}{
@@ -1556,10 +1921,7 @@ func (a *Adapter) handleGet(ctx context.Context, args map[string]interface{}) (*
 func (a *Adapter) handleCreate(args map[string]interface{}) (*api.CallToolResult, error) {
 	var req api.WorkflowCreateRequest
 	if err := api.ParseRequest(args, &req); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{err.Error()},
-			IsError: true,
-		}, nil
+		return api.HandleError(err), nil
 	}
 
 	// Convert structured arguments to api.Workflow
@@ -1579,12 +1941,12 @@ func (a *Adapter) handleCreate(args map[string]interface{}) (*api.CallToolResult
 		aggregator.UpdateCapabilities()
 
 		// Generate tool registration event
-		a.generateCRDEvent(wf.Name, events.ReasonWorkflowToolRegistered, events.EventData{
+		a.generateCRDEvent(context.Background(), wf.Name, events.ReasonWorkflowToolRegistered, events.EventData{
 			Operation: "register",
 		})
 
 		// Generate capabilities refresh event
-		a.generateCRDEvent(wf.Name, events.ReasonWorkflowCapabilitiesRefreshed, events.EventData{
+		a.generateCRDEvent(context.Background(), wf.Name, events.ReasonWorkflowCapabilitiesRefreshed, events.EventData{
 			Operation: "create",
 		})
 	}
@@ -1597,10 +1959,7 @@ func (a *Adapter) handleCreate(args map[string]interface{}) (*api.CallToolResult
 func (a *Adapter) handleUpdate(args map[string]interface{}) (*api.CallToolResult, error) {
 	var req api.WorkflowUpdateRequest
 	if err := api.ParseRequest(args, &req); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{err.Error()},
-			IsError: true,
-		}, nil
+		return api.HandleError(err), nil
 	}
 
 	if err := a.UpdateWorkflowFromStructured(req.Name, args); err != nil {
@@ -1616,13 +1975,10 @@ func (a *Adapter) handleUpdate(args map[string]interface{}) (*api.CallToolResult
 func (a *Adapter) handleDelete(args map[string]interface{}) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok {
-		return &api.CallToolResult{
-			Content: []interface{}{"name is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("name is required")), nil
 	}
 
-	if err := a.DeleteWorkflow(name); err != nil {
+	if err := a.deleteWorkflow(name, api.NamespaceFrom(args, a.namespace)); err != nil {
 		return api.HandleErrorWithPrefix(err, "Failed to delete workflow"), nil
 	}
 
@@ -1635,17 +1991,11 @@ func (a *Adapter) handleDelete(args map[string]interface{}) (*api.CallToolResult
 func (a *Adapter) handleValidate(args map[string]interface{}) (*api.CallToolResult, error) {
 	var req api.WorkflowValidateRequest
 	if err := api.ParseRequest(args, &req); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{err.Error()},
-			IsError: true,
-		}, nil
+		return api.HandleError(err), nil
 	}
 
 	if err := a.ValidateWorkflowFromStructured(args); err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Validation failed: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(&api.ValidationFailedError{Err: err}, "Validation failed"), nil
 	}
 
 	return &api.CallToolResult{
@@ -1657,18 +2007,12 @@ func (a *Adapter) handleValidate(args map[string]interface{}) (*api.CallToolResu
 func (a *Adapter) handleWorkflowAvailable(ctx context.Context, args map[string]interface{}) (*api.CallToolResult, error) {
 	name, ok := args["name"].(string)
 	if !ok {
-		return &api.CallToolResult{
-			Content: []interface{}{"name argument is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("name argument is required")), nil
 	}
 
-	workflow, err := a.getWorkflow(ctx, name)
+	workflow, err := a.getWorkflow(ctx, name, a.namespace)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{err.Error()},
-			IsError: true,
-		}, nil
+		return api.HandleError(err), nil
 	}
 	available := a.isWorkflowAvailable(ctx, workflow)
 
@@ -1696,16 +2040,10 @@ func (a *Adapter) handleExecutionList(ctx context.Context, args map[string]inter
 	if status, ok := args["status"].(string); ok {
 		// Empty status is invalid when explicitly provided
 		if status == "" {
-			return &api.CallToolResult{
-				Content: []interface{}{"status must be one of the enum values: inprogress, completed, failed"},
-				IsError: true,
-			}, nil
+			return api.HandleError(api.NewValidationFailedError("status must be one of the enum values: pending, inprogress, completed, failed")), nil
 		}
-		if status != "inprogress" && status != "completed" && status != "failed" { //nolint:goconst
-			return &api.CallToolResult{
-				Content: []interface{}{"status must be one of the enum values: inprogress, completed, failed"},
-				IsError: true,
-			}, nil
+		if status != "pending" && status != "inprogress" && status != "completed" && status != "failed" { //nolint:goconst
+			return api.HandleError(api.NewValidationFailedError("status must be one of the enum values: pending, inprogress, completed, failed")), nil
 		}
 		req.Status = api.WorkflowExecutionStatus(status)
 	}
@@ -1722,23 +2060,14 @@ func (a *Adapter) handleExecutionList(ctx context.Context, args map[string]inter
 		case int64:
 			limitInt = int(v)
 		default:
-			return &api.CallToolResult{
-				Content: []interface{}{"limit must be a number"},
-				IsError: true,
-			}, nil
+			return api.HandleError(api.NewValidationFailedError("limit must be a number")), nil
 		}
 
 		if limitInt < 1 {
-			return &api.CallToolResult{
-				Content: []interface{}{"limit must be at least 1 (minimum value)"},
-				IsError: true,
-			}, nil
+			return api.HandleError(api.NewValidationFailedError("limit must be at least 1 (minimum value)")), nil
 		}
 		if limitInt > 1000 {
-			return &api.CallToolResult{
-				Content: []interface{}{"limit must be at most 1000 (maximum value)"},
-				IsError: true,
-			}, nil
+			return api.HandleError(api.NewValidationFailedError("limit must be at most 1000 (maximum value)")), nil
 		}
 		req.Limit = limitInt
 	}
@@ -1758,17 +2087,11 @@ func (a *Adapter) handleExecutionList(ctx context.Context, args map[string]inter
 		case int64:
 			offsetInt = int(v)
 		default:
-			return &api.CallToolResult{
-				Content: []interface{}{"offset must be a number"},
-				IsError: true,
-			}, nil
+			return api.HandleError(api.NewValidationFailedError("offset must be a number")), nil
 		}
 
 		if offsetInt < 0 {
-			return &api.CallToolResult{
-				Content: []interface{}{"offset must be at least 0 (minimum value)"},
-				IsError: true,
-			}, nil
+			return api.HandleError(api.NewValidationFailedError("offset must be at least 0 (minimum value)")), nil
 		}
 		req.Offset = offsetInt
 	}
@@ -1779,10 +2102,7 @@ func (a *Adapter) handleExecutionList(ctx context.Context, args map[string]inter
 	// Call the execution tracking functionality
 	response, err := a.ListWorkflowExecutions(ctx, req)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to list executions: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to list executions"), nil
 	}
 
 	return &api.CallToolResult{
@@ -1800,10 +2120,7 @@ func (a *Adapter) handleExecutionGet(ctx context.Context, args map[string]interf
 
 	executionID, ok := args[api.FieldExecutionID].(string)
 	if !ok || executionID == "" {
-		return &api.CallToolResult{
-			Content: []interface{}{"execution_id is required"},
-			IsError: true,
-		}, nil
+		return api.HandleError(api.NewValidationFailedError("execution_id is required")), nil
 	}
 	req.ExecutionID = executionID
 
@@ -1812,28 +2129,19 @@ func (a *Adapter) handleExecutionGet(ctx context.Context, args map[string]interf
 		if includeSteps, ok := includeStepsVal.(bool); ok {
 			req.IncludeSteps = includeSteps
 		} else {
-			return &api.CallToolResult{
-				Content: []interface{}{"include_steps must be a boolean value"},
-				IsError: true,
-			}, nil
+			return api.HandleError(api.NewValidationFailedError("include_steps must be a boolean value")), nil
 		}
 	}
 
 	// Validate step_id arg - check for empty string and null
 	if stepIDVal, exists := args["step_id"]; exists {
 		if stepIDVal == nil {
-			return &api.CallToolResult{
-				Content: []interface{}{"step_id cannot be null"},
-				IsError: true,
-			}, nil
+			return api.HandleError(api.NewValidationFailedError("step_id cannot be null")), nil
 		}
 		if stepIDStr, ok := stepIDVal.(string); ok {
 			if stepIDStr == "" {
 				// Empty step_id is explicitly invalid per BDD requirements
-				return &api.CallToolResult{
-					Content: []interface{}{"step_id is invalid: cannot be empty"},
-					IsError: true,
-				}, nil
+				return api.HandleError(api.NewValidationFailedError("step_id is invalid: cannot be empty")), nil
 			}
 			req.StepID = stepIDStr
 		}
@@ -1842,10 +2150,7 @@ func (a *Adapter) handleExecutionGet(ctx context.Context, args map[string]interf
 	// Call the execution tracking functionality
 	execution, err := a.GetWorkflowExecution(ctx, req)
 	if err != nil {
-		return &api.CallToolResult{
-			Content: []interface{}{fmt.Sprintf("Failed to get execution: %v", err)},
-			IsError: true,
-		}, nil
+		return api.HandleErrorWithPrefix(err, "Failed to get execution"), nil
 	}
 
 	// For summary mode, create a custom response that completely omits the "steps" field
@@ -1968,6 +2273,15 @@ func convertToWorkflow(args map[string]interface{}) (api.Workflow, error) {
 		wf.Output = outputParam
 	}
 
+	// Convert concurrency limits (optional)
+	if concurrencyParam, ok := args[fieldConcurrency].(map[string]interface{}); ok {
+		concurrency, err := convertConcurrencyArg(concurrencyParam)
+		if err != nil {
+			return wf, fmt.Errorf("validation failed: concurrency: %v", err)
+		}
+		wf.Concurrency = concurrency
+	}
+
 	// Set timestamps
 	wf.CreatedAt = time.Now()
 	wf.LastModified = time.Now()
@@ -1975,6 +2289,42 @@ func convertToWorkflow(args map[string]interface{}) (api.Workflow, error) {
 	return wf, nil
 }
 
+// convertConcurrencyArg converts the concurrency argument of the workflow
+// management tools to api.WorkflowConcurrency.
+func convertConcurrencyArg(param map[string]interface{}) (*api.WorkflowConcurrency, error) {
+	concurrency := &api.WorkflowConcurrency{}
+	if value, ok := param["maxConcurrent"]; ok {
+		// Handle both int and float64 types (JSON may parse as either)
+		switch n := value.(type) {
+		case float64:
+			if n != float64(int(n)) {
+				return nil, fmt.Errorf("maxConcurrent must be an integer")
+			}
+			concurrency.MaxConcurrent = int(n)
+		case int:
+			concurrency.MaxConcurrent = n
+		case int64:
+			concurrency.MaxConcurrent = int(n)
+		default:
+			return nil, fmt.Errorf("maxConcurrent must be an integer")
+		}
+	}
+	if value, ok := param["mutexes"]; ok {
+		mutexes, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("mutexes must be an array of strings")
+		}
+		for _, m := range mutexes {
+			mutex, ok := m.(string)
+			if !ok {
+				return nil, fmt.Errorf("mutexes must be an array of strings")
+			}
+			concurrency.Mutexes = append(concurrency.Mutexes, mutex)
+		}
+	}
+	return concurrency, nil
+}
+
 // convertArgsDefinition converts a map[string]interface{} to map[string]api.ArgDefinition
 func convertArgsDefinition(argsParam map[string]interface{}) (map[string]api.ArgDefinition, error) {
 	argsDefinition := make(map[string]api.ArgDefinition)
@@ -2055,19 +2405,33 @@ func convertWorkflowSteps(stepsParam []interface{}) ([]api.WorkflowStep, error)
 			step.Parallel = subSteps
 		}
 
-		// Tool (optional when forEach or parallel is provided)
+		// Prompt (optional, mutually exclusive with tool/forEach/parallel)
+		if prompt, ok := stepMap["prompt"].(string); ok {
+			if prompt == "" {
+				return nil, fmt.Errorf("step %d (%s): prompt cannot be empty", i, step.ID)
+			}
+			step.Prompt = prompt
+		}
+
+		// Tool (optional when prompt, forEach, or parallel is provided)
 		composite := step.ForEach != nil || len(step.Parallel) > 0
 		if tool, ok := stepMap["tool"].(string); ok {
 			if tool == "" {
 				return nil, fmt.Errorf("step %d (%s): tool cannot be empty", i, step.ID)
 			}
 			step.Tool = tool
-		} else if !composite {
-			return nil, fmt.Errorf("step %d (%s): one of tool, forEach, or parallel is required", i, step.ID)
+		} else if step.Prompt == "" && !composite {
+			return nil, fmt.Errorf("step %d (%s): one of tool, prompt, forEach, or parallel is required", i, step.ID)
+		}
+		if step.Tool != "" && step.Prompt != "" {
+			return nil, fmt.Errorf("step %d (%s): tool and prompt are mutually exclusive", i, step.ID)
 		}
 		if step.Tool != "" && composite {
 			return nil, fmt.Errorf("step %d (%s): tool is mutually exclusive with forEach/parallel", i, step.ID)
 		}
+		if step.Prompt != "" && composite {
+			return nil, fmt.Errorf("step %d (%s): prompt is mutually exclusive with forEach/parallel", i, step.ID)
+		}
 		if step.ForEach != nil && len(step.Parallel) > 0 {
 			return nil, fmt.Errorf("step %d (%s): forEach and parallel are mutually exclusive", i, step.ID)
 		}
@@ -2096,6 +2460,14 @@ func convertWorkflowSteps(stepsParam []interface{}) ([]api.WorkflowStep, error)
 			step.Store = store
 		}
 
+		// Artifact (optional) — store the result as an artifact of the execution.
+		if artifact, ok := stepMap["artifact"].(bool); ok {
+			if artifact && composite {
+				return nil, fmt.Errorf("step %d (%s): artifact requires a tool or prompt step", i, step.ID)
+			}
+			step.Artifact = artifact
+		}
+
 		// Description (optional)
 		if description, ok := stepMap["description"].(string); ok {
 			step.Description = description
@@ -2434,7 +2806,7 @@ func getWorkflowSubStepSchema() map[string]interface{} {
 func getWorkflowStepsSchema() map[string]interface{} {
 	return map[string]interface{}{
 		api.SchemaKeyType:        string(api.ArgTypeArray),
-		api.SchemaKeyDescription: "Workflow steps defining the sequence of operations. Each step is exactly one of: a tool call, a forEach loop, or a parallel group.",
+		api.SchemaKeyDescription: "Workflow steps defining the sequence of operations. Each step is exactly one of: a tool call, a prompt, a forEach loop, or a parallel group.",
 		api.SchemaKeyItems: map[string]interface{}{
 			api.SchemaKeyType:                 string(api.ArgTypeObject),
 			api.SchemaKeyDescription:          "Individual workflow step configuration",
@@ -2446,11 +2818,15 @@ func getWorkflowStepsSchema() map[string]interface{} {
 				},
 				"tool": map[string]interface{}{
 					api.SchemaKeyType:        string(api.ArgTypeString),
-					api.SchemaKeyDescription: "Name of the tool to execute for this step (mutually exclusive with forEach/parallel)",
+					api.SchemaKeyDescription: "Name of the tool to execute for this step (mutually exclusive with prompt/forEach/parallel)",
+				},
+				"prompt": map[string]interface{}{
+					api.SchemaKeyType:        string(api.ArgTypeString),
+					api.SchemaKeyDescription: "Name of an MCP prompt to render for this step, as get_prompt does (mutually exclusive with tool/forEach/parallel). The args are passed to the prompt as strings; the step result holds the rendered messages under \"messages\" and their text under \"text\".",
 				},
 				"args": map[string]interface{}{
 					api.SchemaKeyType:        string(api.ArgTypeObject),
-					api.SchemaKeyDescription: "Arguments to pass to the tool, supporting templating with {{.input.argName}} for workflow args and {{.results.stepId.field}} for stored step results",
+					api.SchemaKeyDescription: "Arguments to pass to the tool or prompt, supporting templating with {{.input.argName}} for workflow args and {{.results.stepId.field}} for stored step results",
 				},
 				"condition": getWorkflowConditionSchema(),
 				"forEach": map[string]interface{}{
@@ -2477,7 +2853,7 @@ func getWorkflowStepsSchema() map[string]interface{} {
 				},
 				"parallel": map[string]interface{}{
 					api.SchemaKeyType:        string(api.ArgTypeArray),
-					api.SchemaKeyDescription: "Sub-steps to execute concurrently (mutually exclusive with tool/forEach)",
+					api.SchemaKeyDescription: "Sub-steps to execute concurrently (mutually exclusive with tool/prompt/forEach)",
 					api.SchemaKeyItems:       getWorkflowSubStepSchema(),
 					"minItems":               1,
 				},
@@ -2493,6 +2869,10 @@ func getWorkflowStepsSchema() map[string]interface{} {
 					api.SchemaKeyType:        string(api.ArgTypeBoolean),
 					api.SchemaKeyDescription: "Deprecated alias for output; kept for backwards compatibility",
 				},
+				"artifact": map[string]interface{}{
+					api.SchemaKeyType:        string(api.ArgTypeBoolean),
+					api.SchemaKeyDescription: "Store this tool or prompt step's result as an artifact, served as the MCP resource execution://<execution_id>/<step_id>/output, instead of inlining it into the returned document. The result stays referenceable by later steps.",
+				},
 				api.SchemaKeyDescription: map[string]interface{}{
 					api.SchemaKeyType:        string(api.ArgTypeString),
 					api.SchemaKeyDescription: "Human-readable documentation for this step's purpose",
@@ -2524,32 +2904,64 @@ func getWorkflowOutputSchema() map[string]interface{} {
 	}
 }
 
-// generateCRDEvent creates a Kubernetes event for Workflow CRD operations.
+// getWorkflowConcurrencySchema returns the schema for the concurrency limits
+// of a workflow.
+func getWorkflowConcurrencySchema() map[string]interface{} {
+	return map[string]interface{}{
+		api.SchemaKeyType:        string(api.ArgTypeObject),
+		api.SchemaKeyDescription: "Limits of the executions of this workflow that run at the same time. Executions beyond the limits wait, pending, until the conflicting executions finished.",
+		api.SchemaKeyProperties: map[string]interface{}{
+			"maxConcurrent": map[string]interface{}{
+				api.SchemaKeyType:        string(api.ArgTypeInteger),
+				api.SchemaKeyDescription: "Number of executions of this workflow that run at the same time; 1 runs them one after the other, 0 sets no limit",
+				"minimum":                0,
+			},
+			"mutexes": map[string]interface{}{
+				api.SchemaKeyType:        string(api.ArgTypeArray),
+				api.SchemaKeyDescription: "Mutexes an execution holds while it runs: of all the executions of any workflow naming the same mutex, only one runs at a time. Names are templates against the arguments, e.g. \"deploy-{{ .input.cluster }}\" to only exclude executions against the same cluster.",
+				api.SchemaKeyItems: map[string]interface{}{
+					api.SchemaKeyType: string(api.ArgTypeString),
+				},
+			},
+		},
+	}
+}
+
+// generateCRDEvent creates a Kubernetes event for Workflow CRD operations and
+// publishes it as a workflow lifecycle event on the API event bus.
 // The message and eventType are determined by the event generator's template engine based on the reason.
-func (a *Adapter) generateCRDEvent(name string, reason events.EventReason, data events.EventData) {
+func (a *Adapter) generateCRDEvent(ctx context.Context, name string, reason events.EventReason, data events.EventData) {
+	api.PublishWorkflowEvent(api.WorkflowLifecycleEvent{
+		Workflow:    name,
+		Reason:      string(reason),
+		ExecutionID: data.ExecutionID,
+		StepID:      data.StepID,
+		Error:       data.Error,
+	})
+
 	eventManager := api.GetEventManager()
 	if eventManager == nil {
 		// Event manager not available, skip event generation
 		return
 	}
 
-	// Create an object reference for the Workflow CRD
-	objectRef := api.ObjectReference{
-		Kind:      "Workflow",
-		Name:      name,
-		Namespace: a.namespace,
-	}
-
 	// Populate event data
 	data.Name = name
 	if data.Namespace == "" {
 		data.Namespace = a.namespace
 	}
 
+	// Create an object reference for the Workflow CRD
+	objectRef := api.ObjectReference{
+		Kind:      "Workflow",
+		Name:      name,
+		Namespace: data.Namespace,
+	}
+
 	// The message and event type are determined by the generator's template
 	// engine from the reason; the structured data is threaded through so the
 	// rendered message includes contextual detail (step counts, errors, ...).
-	err := eventManager.CreateEventWithData(context.Background(), objectRef, string(reason), data.ToAPI())
+	err := eventManager.CreateEventWithData(ctx, objectRef, string(reason), data.ToAPI())
 	if err != nil {
 		// Log error but don't fail the operation
 		logging.Debug("WorkflowAdapter", "Failed to generate event %s for Workflow %s: %v", string(reason), name, err)
@@ -2559,7 +2971,7 @@ func (a *Adapter) generateCRDEvent(name string, reason events.EventReason, data
 }
 
 // GenerateStepEvent implements the EventCallback interface for step-level events
-func (a *Adapter) GenerateStepEvent(workflowName string, stepID string, eventType string, data map[string]interface{}) {
+func (a *Adapter) GenerateStepEvent(ctx context.Context, workflowName string, stepID string, eventType string, data map[string]interface{}) {
 	var reason events.EventReason
 	var eventData events.EventData
 
@@ -2605,7 +3017,7 @@ func (a *Adapter) GenerateStepEvent(workflowName string, stepID string, eventTyp
 	}
 
 	// Generate the event
-	a.generateCRDEvent(workflowName, reason, eventData)
+	a.generateCRDEvent(ctx, workflowName, reason, eventData)
 }
 
 // Helper functions to extract values from map
@@ -1,7 +1,13 @@
 package workflow
 
 import (
+	"context"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/giantswarm/muster/internal/api"
 )
@@ -121,6 +127,30 @@ func TestValidateWorkflowSubSteps(t *testing.T) {
 	}
 }
 
+// TestValidateWorkflowConcurrency covers the rules for the concurrency
+// limits and mutexes of a workflow.
+func TestValidateWorkflowConcurrency(t *testing.T) {
+	tests := []struct {
+		name        string
+		concurrency *api.WorkflowConcurrency
+		wantErr     bool
+	}{
+		{name: "none", concurrency: nil, wantErr: false},
+		{name: "valid", concurrency: &api.WorkflowConcurrency{MaxConcurrent: 1, Mutexes: []string{"deploy-{{ .input.cluster }}"}}, wantErr: false},
+		{name: "negative maxConcurrent", concurrency: &api.WorkflowConcurrency{MaxConcurrent: -1}, wantErr: true},
+		{name: "empty mutex", concurrency: &api.WorkflowConcurrency{Mutexes: []string{" "}}, wantErr: true},
+		{name: "duplicate mutex", concurrency: &api.WorkflowConcurrency{Mutexes: []string{"a", "a"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateWorkflowConcurrency(tt.concurrency); (err != nil) != tt.wantErr {
+				t.Fatalf("validateWorkflowConcurrency() err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 // TestConvertWorkflowStepsFieldCasing verifies the structured create path
 // accepts the canonical camelCase field names (matching the CRD and the docs)
 // as well as the previously released snake_case aliases. A workflow authored
@@ -177,3 +207,83 @@ func TestConvertWorkflowStepsFieldCasing(t *testing.T) {
 		})
 	}
 }
+
+// TestConvertWorkflowStepsPrompt verifies that a step may render a prompt
+// instead of calling a tool, but not both.
+func TestConvertWorkflowStepsPrompt(t *testing.T) {
+	steps, err := convertWorkflowSteps([]interface{}{
+		map[string]interface{}{"id": "prompt", "prompt": "x_review_code", "args": map[string]interface{}{"file": "main.go"}},
+	})
+	if err != nil {
+		t.Fatalf("convertWorkflowSteps() unexpected error: %v", err)
+	}
+	if steps[0].Prompt != "x_review_code" || steps[0].Tool != "" {
+		t.Errorf("prompt = %q, tool = %q, want a prompt step", steps[0].Prompt, steps[0].Tool)
+	}
+
+	_, err = convertWorkflowSteps([]interface{}{
+		map[string]interface{}{"id": "both", "prompt": "x_review_code", "tool": "x_echo"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "tool and prompt are mutually exclusive") {
+		t.Errorf("convertWorkflowSteps() error = %v, want tool and prompt to be mutually exclusive", err)
+	}
+}
+
+// storeExecution stores an execution of workflowName with status, started a
+// minute ago.
+func storeExecution(t *testing.T, storage ExecutionStorage, workflowName string, status api.WorkflowExecutionStatus) *api.WorkflowExecution {
+	t.Helper()
+	execution := newExecution(workflowName, nil)
+	execution.Status = status
+	execution.StartedAt = execution.StartedAt.Add(-time.Minute)
+	require.NoError(t, storage.Store(context.Background(), execution))
+	return execution
+}
+
+// executionStatus returns the stored status of execution.
+func executionStatus(t *testing.T, storage ExecutionStorage, execution *api.WorkflowExecution) api.WorkflowExecutionStatus {
+	t.Helper()
+	stored, err := storage.Get(context.Background(), execution.ExecutionID)
+	require.NoError(t, err)
+	return stored.Status
+}
+
+func TestAdapter_StandbyLeavesExecutionsAlone(t *testing.T) {
+	storage := NewExecutionStorage(t.TempDir())
+	running := storeExecution(t, storage, "deploy", api.WorkflowExecutionInProgress)
+	pending := storeExecution(t, storage, "deploy", api.WorkflowExecutionPending)
+
+	// The executions belong to the serving instance, so a standby that stops
+	// before it takes over leaves them alone
+	standby := &Adapter{executionTracker: NewExecutionTracker(storage), queue: newExecutionQueue(1, 0), createdAt: time.Now().UTC()}
+	standby.StandBy()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		standby.ResumePendingExecutions(ctx)
+	}()
+	cancel()
+	<-done
+	assert.Equal(t, api.WorkflowExecutionInProgress, executionStatus(t, storage, running))
+	assert.Equal(t, api.WorkflowExecutionPending, executionStatus(t, storage, pending))
+}
+
+func TestAdapter_StandbyTakeOver(t *testing.T) {
+	storage := NewExecutionStorage(t.TempDir())
+	standby := &Adapter{executionTracker: NewExecutionTracker(storage), queue: newExecutionQueue(1, 0), createdAt: time.Now().UTC().Add(-time.Hour)}
+	standby.StandBy()
+
+	// The execution was started by the serving instance after the standby was
+	// created, and interrupted by its failure
+	interrupted := storeExecution(t, storage, "deploy", api.WorkflowExecutionInProgress)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		standby.ResumePendingExecutions(context.Background())
+	}()
+	standby.TakeOver()
+	<-done
+	assert.Equal(t, api.WorkflowExecutionFailed, executionStatus(t, storage, interrupted))
+}
@@ -0,0 +1,250 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/client"
+	"github.com/giantswarm/muster/internal/config"
+	"github.com/giantswarm/muster/pkg/logging"
+)
+
+const (
+	// artifactEntityType is the entity type step artifacts are stored under.
+	artifactEntityType = "workflow_artifacts"
+
+	// maxArtifactBytes bounds a single step artifact. A larger result is not
+	// stored; the step reports an artifact_error instead.
+	maxArtifactBytes = 4 << 20
+
+	// maxMemoryArtifactBytes bounds the artifacts kept in memory in total;
+	// the oldest ones are evicted beyond it.
+	maxMemoryArtifactBytes = 64 << 20
+)
+
+// artifactStore keeps the artifacts of workflow steps.
+type artifactStore interface {
+	// put stores an artifact, replacing the one of the same step, if any
+	put(artifact *api.StepArtifact) error
+
+	// get returns the artifact of a step, or a NotFoundError
+	get(executionID, stepID string) (*api.StepArtifact, error)
+
+	// prune deletes the artifacts created before cutoff and returns their number
+	prune(cutoff time.Time) (int, error)
+}
+
+// newArtifactStore selects the artifact backend like newExecutionStorage
+// selects the execution one. In Kubernetes mode the artifacts are kept in
+// memory, as WorkflowExecution records are bounded by the etcd object size,
+// so they do not survive a restart and are only served by the replica that
+// ran the execution.
+func newArtifactStore(musterClient client.MusterClient, configPath string, store config.EntityStorage) artifactStore {
+	if musterClient != nil && musterClient.IsKubernetesMode() {
+		return newMemoryArtifactStore(maxMemoryArtifactBytes)
+	}
+	if store != nil {
+		return &entityArtifactStore{storage: store}
+	}
+	return &entityArtifactStore{storage: config.NewStorageWithPath(configPath)}
+}
+
+// artifactName is the name of the artifact of a step. Execution IDs are
+// UUIDs, which contain no underscore, so the name is unambiguous.
+func artifactName(executionID, stepID string) string {
+	return executionID + "_" + stepID
+}
+
+// entityArtifactStore keeps each artifact as a JSON record of a
+// config.EntityStorage, next to the execution records.
+type entityArtifactStore struct {
+	storage config.EntityStorage
+}
+
+func (s *entityArtifactStore) put(artifact *api.StepArtifact) error {
+	data, err := json.Marshal(artifact)
+	if err != nil {
+		return fmt.Errorf("failed to marshal artifact: %w", err)
+	}
+	if err := s.storage.Save(artifactEntityType, artifactName(artifact.ExecutionID, artifact.StepID), data); err != nil {
+		return fmt.Errorf("failed to save artifact: %w", err)
+	}
+	return nil
+}
+
+func (s *entityArtifactStore) get(executionID, stepID string) (*api.StepArtifact, error) {
+	name := artifactName(executionID, stepID)
+	data, err := s.storage.Load(artifactEntityType, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, api.NewNotFoundError("artifact", api.ExecutionArtifactURI(executionID, stepID))
+		}
+		return nil, fmt.Errorf("failed to load artifact %s: %w", name, err)
+	}
+	var artifact api.StepArtifact
+	if err := json.Unmarshal(data, &artifact); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal artifact %s: %w", name, err)
+	}
+	return &artifact, nil
+}
+
+func (s *entityArtifactStore) prune(cutoff time.Time) (int, error) {
+	names, err := s.storage.List(artifactEntityType)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+	deleted := 0
+	for _, name := range names {
+		data, err := s.storage.Load(artifactEntityType, name)
+		if err != nil {
+			continue
+		}
+		var artifact api.StepArtifact
+		if err := json.Unmarshal(data, &artifact); err != nil || !artifact.CreatedAt.Before(cutoff) {
+			continue
+		}
+		if err := s.storage.Delete(artifactEntityType, name); err != nil {
+			logging.Warn("WorkflowArtifacts", "Failed to delete artifact %s: %v", name, err)
+			continue
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// memoryArtifactStore keeps the artifacts in memory, at most maxBytes of
+// them in total.
+type memoryArtifactStore struct {
+	mu        sync.Mutex
+	maxBytes  int
+	size      int
+	artifacts map[string]*api.StepArtifact
+}
+
+func newMemoryArtifactStore(maxBytes int) *memoryArtifactStore {
+	return &memoryArtifactStore{
+		maxBytes:  maxBytes,
+		artifacts: make(map[string]*api.StepArtifact),
+	}
+}
+
+func (s *memoryArtifactStore) put(artifact *api.StepArtifact) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := artifactName(artifact.ExecutionID, artifact.StepID)
+	if previous, ok := s.artifacts[name]; ok {
+		s.size -= len(previous.Data)
+	}
+	s.artifacts[name] = artifact
+	s.size += len(artifact.Data)
+
+	if s.size > s.maxBytes {
+		s.evictLocked(name)
+	}
+	return nil
+}
+
+// evictLocked deletes the oldest artifacts, except keep, until the store is
+// within its size.
+func (s *memoryArtifactStore) evictLocked(keep string) {
+	names := make([]string, 0, len(s.artifacts))
+	for name := range s.artifacts {
+		if name != keep {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return s.artifacts[names[i]].CreatedAt.Before(s.artifacts[names[j]].CreatedAt)
+	})
+	for _, name := range names {
+		if s.size <= s.maxBytes {
+			return
+		}
+		s.size -= len(s.artifacts[name].Data)
+		delete(s.artifacts, name)
+	}
+}
+
+func (s *memoryArtifactStore) get(executionID, stepID string) (*api.StepArtifact, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	artifact, ok := s.artifacts[artifactName(executionID, stepID)]
+	if !ok {
+		return nil, api.NewNotFoundError("artifact", api.ExecutionArtifactURI(executionID, stepID))
+	}
+	return artifact, nil
+}
+
+func (s *memoryArtifactStore) prune(cutoff time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := 0
+	for name, artifact := range s.artifacts {
+		if artifact.CreatedAt.Before(cutoff) {
+			s.size -= len(artifact.Data)
+			delete(s.artifacts, name)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// storeArtifact stores the text result of step stepID of the running
+// execution as an artifact and returns its URI.
+func (we *WorkflowExecutor) storeArtifact(ctx context.Context, stepID string, result *mcp.CallToolResult) (string, error) {
+	executionID := executionIDFromContext(ctx)
+	if we.artifacts == nil || executionID == "" {
+		return "", fmt.Errorf("artifacts are not available for this execution")
+	}
+
+	var text strings.Builder
+	for _, content := range result.Content {
+		if textContent, ok := content.(mcp.TextContent); ok {
+			text.WriteString(textContent.Text)
+		}
+	}
+	if text.Len() > maxArtifactBytes {
+		return "", fmt.Errorf("result of %d bytes exceeds the artifact limit of %d bytes", text.Len(), maxArtifactBytes)
+	}
+
+	artifact := &api.StepArtifact{
+		ExecutionID: executionID,
+		StepID:      stepID,
+		MIMEType:    "text/plain",
+		Data:        text.String(),
+		CreatedAt:   time.Now().UTC(),
+	}
+	if json.Valid([]byte(artifact.Data)) {
+		artifact.MIMEType = "application/json"
+	}
+	if err := we.artifacts.put(artifact); err != nil {
+		return "", err
+	}
+	return api.ExecutionArtifactURI(executionID, stepID), nil
+}
+
+// executionIDContextKey carries the ID of the running execution to its steps.
+type executionIDContextKey struct{}
+
+// withExecutionID returns ctx carrying the ID of the running execution.
+func withExecutionID(ctx context.Context, executionID string) context.Context {
+	return context.WithValue(ctx, executionIDContextKey{}, executionID)
+}
+
+// executionIDFromContext returns the ID of the running execution, or "" if
+// ctx does not belong to a tracked execution.
+func executionIDFromContext(ctx context.Context) string {
+	executionID, _ := ctx.Value(executionIDContextKey{}).(string)
+	return executionID
+}
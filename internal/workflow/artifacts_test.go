@@ -0,0 +1,120 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/api"
+	"github.com/giantswarm/muster/internal/config"
+)
+
+func TestWorkflowExecutor_Artifacts(t *testing.T) {
+	caller := &scriptedToolCaller{responder: func(toolName string, args map[string]interface{}) (*mcp.CallToolResult, error) {
+		text := `{"status": "success"}`
+		switch toolName {
+		case "dump":
+			text = `{"items": ["a", "b", "c"]}`
+		case "huge":
+			text = strings.Repeat("x", maxArtifactBytes+1)
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.NewTextContent(text)}}, nil
+	}}
+	executor := NewWorkflowExecutor(caller, nil)
+	executor.artifacts = newMemoryArtifactStore(maxMemoryArtifactBytes)
+
+	workflow := &api.Workflow{
+		Name: "report",
+		Steps: []api.WorkflowStep{
+			{ID: "dump", Tool: "dump", Artifact: true},
+			{ID: "huge", Tool: "huge", Artifact: true},
+			{ID: "count", Tool: "echo", Args: map[string]interface{}{"n": "{{ len .results.dump.items }}"}, Artifact: true},
+		},
+	}
+
+	ctx := withExecutionID(context.Background(), "exec-1")
+	result, err := executor.ExecuteWorkflow(ctx, workflow, map[string]interface{}{debugArgKey: true})
+	require.NoError(t, err)
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &resp))
+	steps := resp[api.FieldSteps].([]interface{})
+	require.Len(t, steps, 3)
+
+	dump := steps[0].(map[string]interface{})
+	assert.Equal(t, "execution://exec-1/dump/output", dump["artifact"])
+	assert.NotContains(t, dump, "result", "an artifact is not inlined, not even in debug mode")
+
+	huge := steps[1].(map[string]interface{})
+	assert.Contains(t, huge["artifact_error"], "exceeds the artifact limit")
+	assert.NotContains(t, huge, "result")
+
+	// The result of an artifact step stays referenceable by later steps
+	assert.Equal(t, "3", caller.calls[2].args["n"])
+	assert.NotContains(t, resp, "items", "the last step's artifact is not merged into the response")
+
+	artifact, err := executor.artifacts.get("exec-1", "dump")
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", artifact.MIMEType)
+	assert.JSONEq(t, `{"items": ["a", "b", "c"]}`, artifact.Data)
+
+	_, err = executor.artifacts.get("exec-1", "huge")
+	assert.True(t, api.IsNotFound(err))
+}
+
+func TestWorkflowExecutor_ArtifactsWithoutExecution(t *testing.T) {
+	executor := NewWorkflowExecutor(&scriptedToolCaller{}, nil)
+	executor.artifacts = newMemoryArtifactStore(maxMemoryArtifactBytes)
+
+	workflow := &api.Workflow{
+		Name:  "untracked",
+		Steps: []api.WorkflowStep{{ID: "step", Tool: "echo", Artifact: true}},
+	}
+	result, err := executor.ExecuteWorkflow(context.Background(), workflow, map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "artifacts are not available for this execution")
+}
+
+func TestEntityArtifactStore(t *testing.T) {
+	store := &entityArtifactStore{storage: config.NewStorageWithPath(t.TempDir())}
+	old := time.Now().Add(-48 * time.Hour)
+
+	require.NoError(t, store.put(&api.StepArtifact{ExecutionID: "exec-1", StepID: "a", MIMEType: "text/plain", Data: "old", CreatedAt: old}))
+	require.NoError(t, store.put(&api.StepArtifact{ExecutionID: "exec-2", StepID: "a", MIMEType: "text/plain", Data: "new", CreatedAt: time.Now()}))
+
+	artifact, err := store.get("exec-2", "a")
+	require.NoError(t, err)
+	assert.Equal(t, "new", artifact.Data)
+
+	_, err = store.get("exec-2", "b")
+	assert.True(t, api.IsNotFound(err))
+
+	deleted, err := store.prune(time.Now().Add(-24 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	_, err = store.get("exec-1", "a")
+	assert.True(t, api.IsNotFound(err))
+}
+
+func TestMemoryArtifactStore_Evicts(t *testing.T) {
+	store := newMemoryArtifactStore(10)
+	base := time.Now()
+
+	require.NoError(t, store.put(&api.StepArtifact{ExecutionID: "exec-1", StepID: "a", Data: "12345", CreatedAt: base}))
+	require.NoError(t, store.put(&api.StepArtifact{ExecutionID: "exec-2", StepID: "a", Data: "12345", CreatedAt: base.Add(time.Second)}))
+	require.NoError(t, store.put(&api.StepArtifact{ExecutionID: "exec-3", StepID: "a", Data: "123", CreatedAt: base.Add(2 * time.Second)}))
+
+	_, err := store.get("exec-1", "a")
+	assert.True(t, api.IsNotFound(err), "the oldest artifact is evicted")
+	_, err = store.get("exec-2", "a")
+	assert.NoError(t, err)
+	_, err = store.get("exec-3", "a")
+	assert.NoError(t, err)
+	assert.Equal(t, 8, store.size)
+}
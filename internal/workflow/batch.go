@@ -0,0 +1,124 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/muster/internal/api"
+	musterv1alpha1 "github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+var _ api.BatchTarget = (*Adapter)(nil)
+
+// handleBatch applies several create, update and delete operations in one
+// call.
+func (a *Adapter) handleBatch(ctx context.Context, args map[string]interface{}) (*api.CallToolResult, error) {
+	req, err := api.ParseBatchRequest(args)
+	if err != nil {
+		return api.HandleError(err), nil
+	}
+	result := api.ExecuteBatch(ctx, req, a)
+
+	// Rollbacks bypass the handlers, so refresh the capabilities to drop the
+	// tools of workflows whose creation was reverted
+	if aggregator := api.GetAggregator(); aggregator != nil && result.Atomic && result.Failed > 0 {
+		aggregator.UpdateCapabilities()
+	}
+	return api.BatchToolResult(result), nil
+}
+
+// ValidateBatchItem checks the arguments and definitions of creates and
+// updates. Conflicts with the current state, such as creating a workflow that
+// exists, are detected when the item is applied, as earlier items of the
+// batch may resolve them.
+func (a *Adapter) ValidateBatchItem(ctx context.Context, item api.BatchItem) error {
+	switch item.Operation {
+	case api.BatchOperationCreate:
+		var req api.WorkflowCreateRequest
+		if err := api.ParseRequest(item.Args, &req); err != nil {
+			return err
+		}
+	case api.BatchOperationUpdate:
+		var req api.WorkflowUpdateRequest
+		if err := api.ParseRequest(item.Args, &req); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+	if err := a.ValidateWorkflowFromStructured(item.Args); err != nil {
+		return &api.ValidationFailedError{Message: "validation failed", Err: err}
+	}
+	return nil
+}
+
+// ApplyBatchItem applies an item through the handler of the matching
+// single-resource tool. The returned function restores the workflow as it
+// was before the item was applied.
+func (a *Adapter) ApplyBatchItem(ctx context.Context, item api.BatchItem) (func(context.Context) error, error) {
+	name := item.Name()
+	namespace := api.NamespaceFrom(item.Args, a.namespace)
+
+	var previous *musterv1alpha1.Workflow
+	if item.Operation != api.BatchOperationCreate {
+		existing, err := a.client.GetWorkflow(ctx, name, namespace)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, api.NewWorkflowNotFoundError(name)
+			}
+			return nil, fmt.Errorf("failed to get workflow %s: %w", name, err)
+		}
+		previous = existing.DeepCopy()
+	}
+
+	var result *api.CallToolResult
+	var err error
+	switch item.Operation {
+	case api.BatchOperationCreate:
+		result, err = a.handleCreate(item.Args)
+	case api.BatchOperationUpdate:
+		result, err = a.handleUpdate(item.Args)
+	case api.BatchOperationDelete:
+		result, err = a.handleDelete(item.Args)
+	default:
+		return nil, api.NewValidationFailedError("unsupported operation %q", item.Operation)
+	}
+	if err == nil {
+		err = api.ErrorFromResult(result)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch item.Operation {
+	case api.BatchOperationCreate:
+		return func(ctx context.Context) error {
+			return a.client.DeleteWorkflow(ctx, name, namespace)
+		}, nil
+	case api.BatchOperationUpdate:
+		return func(ctx context.Context) error {
+			current, err := a.client.GetWorkflow(ctx, name, namespace)
+			if err != nil {
+				return fmt.Errorf("failed to get workflow %s: %w", name, err)
+			}
+			current.Spec = previous.Spec
+			return a.client.UpdateWorkflow(ctx, current)
+		}, nil
+	default:
+		return func(ctx context.Context) error {
+			return a.client.CreateWorkflow(ctx, &musterv1alpha1.Workflow{
+				TypeMeta: previous.TypeMeta,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        previous.Name,
+					Namespace:   previous.Namespace,
+					Labels:      previous.Labels,
+					Annotations: previous.Annotations,
+				},
+				Spec: previous.Spec,
+			})
+		}, nil
+	}
+}
@@ -44,7 +44,8 @@
 // # Workflow Execution
 //
 // Workflows are executed step by step in the defined order. Each step:
-//   - Calls the specified tool with the provided arguments
+//   - Calls the specified tool with the provided arguments, or renders the
+//     specified MCP prompt (prompt steps)
 //   - Can reference outputs from previous steps using {{stepId.field}} syntax
 //   - Can reference input args using {{.argumentName}} syntax
 //   - Has access to the workflow's execution context
@@ -39,13 +39,14 @@ type ExecutionStorage interface {
 	Prune(ctx context.Context, policy RetentionPolicy) (int, error)
 }
 
-// ExecutionStorageImpl implements ExecutionStorage using the existing config.Storage
-// patterns for consistent file management and path resolution.
+// ExecutionStorageImpl implements ExecutionStorage on a config.EntityStorage:
+// by default config.Storage for consistent file management and path
+// resolution, or a database selected by the storage section of config.yaml.
 //
-// This implementation stores each execution as a separate JSON file for optimal
-// performance with concurrent access and efficient individual file operations.
+// Each execution is stored as a separate JSON record for optimal performance
+// with concurrent access and efficient individual record operations.
 type ExecutionStorageImpl struct {
-	storage *config.Storage
+	storage config.EntityStorage
 	mu      sync.RWMutex
 	cache   map[string]*api.WorkflowExecutionSummary // Cache for efficient listing
 
@@ -62,8 +63,12 @@ func NewExecutionStorage(configPath string) ExecutionStorage {
 	if configPath == "" {
 		panic("Logic error: empty execution storage configPath")
 	}
-	storage := config.NewStorageWithPath(configPath)
+	return NewExecutionStorageWithStorage(config.NewStorageWithPath(configPath))
+}
 
+// NewExecutionStorageWithStorage creates an execution storage instance that
+// keeps the executions in the given entity storage, e.g. a config.SQLStorage.
+func NewExecutionStorageWithStorage(storage config.EntityStorage) ExecutionStorage {
 	return &ExecutionStorageImpl{
 		storage: storage,
 		cache:   make(map[string]*api.WorkflowExecutionSummary),
@@ -107,7 +112,7 @@ func (es *ExecutionStorageImpl) Get(ctx context.Context, executionID string) (*a
 	data, err := es.storage.Load("workflow_executions", executionID)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
-			return nil, fmt.Errorf("execution %s not found", executionID)
+			return nil, api.NewNotFoundError("execution", executionID)
 		}
 		return nil, fmt.Errorf("failed to load execution %s: %w", executionID, err)
 	}
@@ -207,7 +212,7 @@ func (es *ExecutionStorageImpl) Delete(ctx context.Context, executionID string)
 	// Delete from storage using existing patterns
 	if err := es.storage.Delete("workflow_executions", executionID); err != nil {
 		if strings.Contains(err.Error(), "not found") {
-			return fmt.Errorf("execution %s not found", executionID)
+			return api.NewNotFoundError("execution", executionID)
 		}
 		return fmt.Errorf("failed to delete execution %s: %w", executionID, err)
 	}
@@ -304,6 +304,7 @@ func stepsToCRD(steps []api.WorkflowExecutionStep) []musterv1alpha1.WorkflowExec
 		record := musterv1alpha1.WorkflowExecutionStepRecord{
 			StepID:     step.StepID,
 			Tool:       step.Tool,
+			Prompt:     step.Prompt,
 			Status:     string(step.Status),
 			StartedAt:  metav1.NewTime(step.StartedAt),
 			DurationMs: step.DurationMs,
@@ -311,6 +312,7 @@ func stepsToCRD(steps []api.WorkflowExecutionStep) []musterv1alpha1.WorkflowExec
 			Result:     toJSON(step.Result),
 			Error:      step.Error,
 			StoredAs:   step.StoredAs,
+			Artifact:   step.Artifact,
 		}
 		if step.CompletedAt != nil {
 			completed := metav1.NewTime(*step.CompletedAt)
@@ -331,6 +333,7 @@ func stepsFromCRD(records []musterv1alpha1.WorkflowExecutionStepRecord) []api.Wo
 		step := api.WorkflowExecutionStep{
 			StepID:     record.StepID,
 			Tool:       record.Tool,
+			Prompt:     record.Prompt,
 			Status:     api.WorkflowExecutionStatus(record.Status),
 			StartedAt:  record.StartedAt.Time,
 			DurationMs: record.DurationMs,
@@ -338,6 +341,7 @@ func stepsFromCRD(records []musterv1alpha1.WorkflowExecutionStepRecord) []api.Wo
 			Result:     fromJSON(record.Result),
 			Error:      record.Error,
 			StoredAs:   record.StoredAs,
+			Artifact:   record.Artifact,
 		}
 		if record.CompletedAt != nil {
 			t := record.CompletedAt.Time
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -52,25 +53,90 @@ func NewExecutionTracker(storage ExecutionStorage) *ExecutionTracker {
 //   - *api.WorkflowExecution: Complete execution record for reference
 //   - error: Error if execution or tracking fails
 func (et *ExecutionTracker) TrackExecution(ctx context.Context, workflowName string, args map[string]interface{}, executeFn func() (*mcp.CallToolResult, error)) (*mcp.CallToolResult, *api.WorkflowExecution, error) {
-	// Generate unique execution ID
-	executionID := uuid.New().String()
-	startTime := time.Now().UTC()
-
-	logging.Debug("ExecutionTracker", "Starting execution tracking for workflow %s (execution: %s)", workflowName, executionID)
+	return et.RunExecution(ctx, newExecution(workflowName, args), executeFn)
+}
 
-	// Create initial execution record
-	execution := &api.WorkflowExecution{
-		ExecutionID:  executionID,
+// newExecution creates the record of a new execution of workflowName.
+func newExecution(workflowName string, args map[string]interface{}) *api.WorkflowExecution {
+	return &api.WorkflowExecution{
+		ExecutionID:  uuid.New().String(),
 		WorkflowName: workflowName,
-		Status:       api.WorkflowExecutionInProgress,
-		StartedAt:    startTime,
-		CompletedAt:  nil,
-		DurationMs:   0,
+		Status:       api.WorkflowExecutionPending,
+		StartedAt:    time.Now().UTC(),
 		Input:        args,
-		Result:       nil,
-		Error:        nil,
 		Steps:        []api.WorkflowExecutionStep{},
 	}
+}
+
+// Enqueue creates and stores the record of an execution of workflowName
+// waiting in the execution queue, in the pending state. Pending executions
+// are resumed when muster restarts before they ran.
+func (et *ExecutionTracker) Enqueue(ctx context.Context, workflowName string, args map[string]interface{}) *api.WorkflowExecution {
+	execution := newExecution(workflowName, args)
+	if err := et.storage.Store(ctx, execution); err != nil {
+		logging.Warn("ExecutionTracker", "Failed to store pending execution record %s: %v", execution.ExecutionID, err)
+	}
+	return execution
+}
+
+// Fail marks an execution that could not run, such as a pending execution
+// whose caller gave up, as failed with err.
+func (et *ExecutionTracker) Fail(ctx context.Context, execution *api.WorkflowExecution, err error) {
+	endTime := time.Now().UTC()
+	execution.Status = api.WorkflowExecutionFailed
+	execution.CompletedAt = &endTime
+	errorStr := err.Error()
+	execution.Error = &errorStr
+
+	if storageErr := et.storage.Store(ctx, execution); storageErr != nil {
+		logging.Error("ExecutionTracker", storageErr, "Failed to store failed execution record %s for workflow %s", execution.ExecutionID, execution.WorkflowName)
+		et.metrics.recordStoreError(ctx, execution.WorkflowName)
+	}
+	et.metrics.recordExecution(ctx, execution.WorkflowName, execution.Status, 0)
+}
+
+// ListByStatus returns the complete records of all executions with status,
+// oldest first.
+func (et *ExecutionTracker) ListByStatus(ctx context.Context, status api.WorkflowExecutionStatus) ([]*api.WorkflowExecution, error) {
+	var summaries []api.WorkflowExecutionSummary
+	for offset := 0; ; {
+		page, err := et.storage.List(ctx, &api.ListWorkflowExecutionsRequest{Status: status, Limit: 1000, Offset: offset})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s executions: %w", status, err)
+		}
+		summaries = append(summaries, page.Executions...)
+		offset += len(page.Executions)
+		if !page.HasMore || len(page.Executions) == 0 {
+			break
+		}
+	}
+
+	executions := make([]*api.WorkflowExecution, 0, len(summaries))
+	for _, summary := range summaries {
+		execution, err := et.storage.Get(ctx, summary.ExecutionID)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, execution)
+	}
+	sort.SliceStable(executions, func(i, j int) bool {
+		return executions[i].StartedAt.Before(executions[j].StartedAt)
+	})
+	return executions, nil
+}
+
+// RunExecution runs an execution created by Enqueue, or TrackExecution,
+// with tracking: it marks the execution as in progress, runs executeFn, and
+// stores the final record with the result or error of executeFn.
+func (et *ExecutionTracker) RunExecution(ctx context.Context, execution *api.WorkflowExecution, executeFn func() (*mcp.CallToolResult, error)) (*mcp.CallToolResult, *api.WorkflowExecution, error) {
+	executionID := execution.ExecutionID
+	workflowName := execution.WorkflowName
+	startTime := time.Now().UTC()
+
+	logging.Debug("ExecutionTracker", "Starting execution tracking for workflow %s (execution: %s)", workflowName, executionID)
+
+	execution.Status = api.WorkflowExecutionInProgress
+	execution.StartedAt = startTime
 
 	// Store initial execution record
 	if err := et.storage.Store(ctx, execution); err != nil {
@@ -199,6 +265,7 @@ func (et *ExecutionTracker) extractStepsFromNewStructure(execution *api.Workflow
 
 		stepID, _ := stepData["id"].(string)
 		tool, _ := stepData["tool"].(string)
+		prompt, _ := stepData["prompt"].(string)
 		stepStatusRaw, _ := stepData["status"].(string)
 
 		// Extract condition information if present
@@ -234,6 +301,9 @@ func (et *ExecutionTracker) extractStepsFromNewStructure(execution *api.Workflow
 			stepResult = resultRaw
 		}
 
+		// Get the URI of the step artifact if the result was stored as one
+		artifact, _ := stepData["artifact"].(string)
+
 		// Get step error if available
 		var stepError *string
 		if errorRaw, hasError := stepData["error"]; hasError {
@@ -295,6 +365,7 @@ func (et *ExecutionTracker) extractStepsFromNewStructure(execution *api.Workflow
 		step := api.WorkflowExecutionStep{
 			StepID:      stepID,
 			Tool:        tool,
+			Prompt:      prompt,
 			Status:      stepStatus,
 			StartedAt:   execution.StartedAt, // Approximate timing
 			CompletedAt: execution.CompletedAt,
@@ -303,6 +374,7 @@ func (et *ExecutionTracker) extractStepsFromNewStructure(execution *api.Workflow
 			Result:      enhancedStepResult,
 			Error:       stepError,
 			StoredAs:    stepID, // In new structure, step ID is used as storage key
+			Artifact:    artifact,
 		}
 
 		logging.Debug("ExecutionTracker", "Created step: stepID='%s', status='%s', hasError=%t, hasCondition=%t",
@@ -424,7 +496,7 @@ func (et *ExecutionTracker) GetExecution(ctx context.Context, req *api.GetWorkfl
 		}
 
 		if targetStep == nil {
-			return nil, fmt.Errorf("step %s not found in execution %s", req.StepID, req.ExecutionID)
+			return nil, &api.NotFoundError{ResourceType: "step", ResourceName: req.StepID, Message: fmt.Sprintf("step %s not found in execution %s", req.StepID, req.ExecutionID)}
 		}
 
 		// Return a minimal execution record containing only the requested step
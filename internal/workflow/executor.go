@@ -21,13 +21,13 @@ type ToolCaller interface {
 // EventCallback interface for generating workflow step events
 type EventCallback interface {
 	// GenerateStepEvent generates an event for a workflow step operation
-	GenerateStepEvent(workflowName string, stepID string, eventType string, data map[string]interface{})
+	GenerateStepEvent(ctx context.Context, workflowName string, stepID string, eventType string, data map[string]interface{})
 }
 
 // NoOpEventCallback provides a no-operation implementation of EventCallback
 type NoOpEventCallback struct{}
 
-func (n *NoOpEventCallback) GenerateStepEvent(workflowName string, stepID string, eventType string, data map[string]interface{}) {
+func (n *NoOpEventCallback) GenerateStepEvent(ctx context.Context, workflowName string, stepID string, eventType string, data map[string]interface{}) {
 	// No operation - events are disabled
 }
 
@@ -70,12 +70,15 @@ func extractDebugFlag(args map[string]interface{}) bool {
 type stepMetadata struct {
 	ID                  string      // Original step ID from workflow definition
 	Tool                string      // Tool name used in the step
+	Prompt              string      // Prompt name used in the step (empty for tool steps)
 	Output              bool        // Whether the step result is included in the returned document
 	Status              string      // Step execution status: "completed", "skipped", "failed"
 	AllowFailure        bool        // Whether this step is allowed to fail without failing the workflow
 	ConditionEvaluation *bool       // Boolean result of condition evaluation (nil if no condition)
 	ConditionResult     interface{} // Actual result from condition tool call (nil if no condition)
 	ConditionTool       string      // Tool used for condition evaluation (empty if no condition)
+	Artifact            string      // URI of the artifact holding the step result (empty if none)
+	ArtifactError       string      // Why the result of an artifact step was not stored (empty if it was)
 }
 
 // executionContext holds the state during workflow execution.
@@ -92,6 +95,10 @@ type WorkflowExecutor struct {
 	toolCaller    ToolCaller
 	template      *template.Engine
 	eventCallback EventCallback
+
+	// artifacts keeps the results of steps that declare artifact: true; nil
+	// if artifacts are not available.
+	artifacts artifactStore
 }
 
 // NewWorkflowExecutor creates a new workflow executor
@@ -115,8 +122,8 @@ func (we *WorkflowExecutor) ExecuteWorkflow(ctx context.Context, workflow *api.W
 			requiredArgs = append(requiredArgs, name)
 		}
 	}
-	logging.Debug("WorkflowExecutor", "ExecuteWorkflow called with workflow=%s, args=%+v, required=%+v", workflow.Name, args, requiredArgs)
-	logging.Debug("WorkflowExecutor", "Executing workflow %s with %d steps", workflow.Name, len(workflow.Steps))
+	logging.DebugCtx(ctx, "WorkflowExecutor", "ExecuteWorkflow called with workflow=%s, args=%+v, required=%+v", workflow.Name, args, requiredArgs)
+	logging.DebugCtx(ctx, "WorkflowExecutor", "Executing workflow %s with %d steps", workflow.Name, len(workflow.Steps))
 
 	// Pull the reserved debug toggle out of args before validation/execution so
 	// it neither collides with workflow args nor reaches step tools (#877).
@@ -124,7 +131,7 @@ func (we *WorkflowExecutor) ExecuteWorkflow(ctx context.Context, workflow *api.W
 
 	// Validate inputs against args definition (this applies default values to args)
 	if err := we.validateInputs(workflow.Args, args); err != nil {
-		logging.Error("WorkflowExecutor", err, "Input validation failed for workflow %s", workflow.Name)
+		logging.ErrorCtx(ctx, "WorkflowExecutor", err, "Input validation failed for workflow %s", workflow.Name)
 		return nil, fmt.Errorf("input validation failed: %w", err)
 	}
 
@@ -136,14 +143,15 @@ func (we *WorkflowExecutor) ExecuteWorkflow(ctx context.Context, workflow *api.W
 		templateVars: make([]string, 0),
 		stepMetadata: make([]stepMetadata, 0),
 	}
-	logging.Debug("WorkflowExecutor", "Initial execution context: input=%+v, results=%+v", execCtx.input, execCtx.results)
+	logging.DebugCtx(ctx, "WorkflowExecutor", "Initial execution context: input=%+v, results=%+v", execCtx.input, execCtx.results)
 
 	// Execute each step
 	var lastStepResult *mcp.CallToolResult
 	for i, step := range workflow.Steps {
-		logging.Debug("WorkflowExecutor", "Executing step %d/%d: %s, tool: %s", i+1, len(workflow.Steps), step.ID, step.Tool)
+		logging.DebugCtx(ctx, "WorkflowExecutor", "Executing step %d/%d: %s, tool: %s", i+1, len(workflow.Steps), step.ID, step.Tool)
 
-		// Dispatch by step kind: forEach loop, parallel group, or plain tool call.
+		// Dispatch by step kind: forEach loop, parallel group, or plain tool
+		// call or prompt.
 		var outcome stepOutcome
 		var err error
 		switch {
@@ -182,7 +190,7 @@ func (we *WorkflowExecutor) ExecuteWorkflow(ctx context.Context, workflow *api.W
 			// already succeeded. Return the full debug response (every recorded
 			// result) alongside the error so the underlying data stays
 			// recoverable for debugging the output template (#877).
-			logging.Error("WorkflowExecutor", err, "Failed to render output template")
+			logging.ErrorCtx(ctx, "WorkflowExecutor", err, "Failed to render output template")
 			wrapped := fmt.Errorf("failed to render output template: %w", err)
 			resp := we.buildResponse(workflow, execCtx, statusCompleted, true, map[string]interface{}{
 				"output_error": wrapped.Error(),
@@ -202,7 +210,7 @@ func (we *WorkflowExecutor) ExecuteWorkflow(ctx context.Context, workflow *api.W
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal rendered output: %w", err)
 		}
-		logging.Debug("WorkflowExecutor", "Rendered output JSON: %s", string(outputJSON))
+		logging.DebugCtx(ctx, "WorkflowExecutor", "Rendered output JSON: %s", string(outputJSON))
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{mcp.NewTextContent(string(outputJSON))},
 		}, nil
@@ -226,8 +234,8 @@ func (we *WorkflowExecutor) ExecuteWorkflow(ctx context.Context, workflow *api.W
 	// result to merge.
 	if lastStepResult != nil && len(workflow.Steps) > 0 {
 		lastStep := workflow.Steps[len(workflow.Steps)-1]
-		if !api.OutputEnabled(lastStep.Output, lastStep.Store) && lastStep.Tool != "" {
-			logging.Debug("WorkflowExecutor", "Last step %s is not an output step, merging result into top level", lastStep.ID)
+		if !api.OutputEnabled(lastStep.Output, lastStep.Store) && !lastStep.Artifact && lastStep.Tool != "" {
+			logging.DebugCtx(ctx, "WorkflowExecutor", "Last step %s is not an output step, merging result into top level", lastStep.ID)
 			// Parse the last step's result and merge it
 			if len(lastStepResult.Content) > 0 {
 				if textContent, ok := lastStepResult.Content[0].(mcp.TextContent); ok {
@@ -238,7 +246,7 @@ func (we *WorkflowExecutor) ExecuteWorkflow(ctx context.Context, workflow *api.W
 							for k, v := range lastResultMap {
 								finalResult[k] = v
 							}
-							logging.Debug("WorkflowExecutor", "Merged last step result into final result: %+v", lastResultMap)
+							logging.DebugCtx(ctx, "WorkflowExecutor", "Merged last step result into final result: %+v", lastResultMap)
 						}
 					}
 				}
@@ -246,14 +254,14 @@ func (we *WorkflowExecutor) ExecuteWorkflow(ctx context.Context, workflow *api.W
 		}
 	}
 
-	logging.Debug("WorkflowExecutor", "Final result before JSON marshal: %+v", finalResult)
+	logging.DebugCtx(ctx, "WorkflowExecutor", "Final result before JSON marshal: %+v", finalResult)
 
 	resultJSON, err := json.Marshal(finalResult)
 	if err != nil {
-		logging.Error("WorkflowExecutor", err, "Failed to marshal final result")
+		logging.ErrorCtx(ctx, "WorkflowExecutor", err, "Failed to marshal final result")
 		return nil, fmt.Errorf("failed to marshal result: %w", err)
 	}
-	logging.Debug("WorkflowExecutor", "Final result JSON: %s", string(resultJSON))
+	logging.DebugCtx(ctx, "WorkflowExecutor", "Final result JSON: %s", string(resultJSON))
 
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
@@ -275,6 +283,10 @@ func (we *WorkflowExecutor) buildStepsArray(stepMetadata []stepMetadata, results
 			"tool":          stepMeta.Tool,
 			api.FieldStatus: stepMeta.Status,
 		}
+		if stepMeta.Prompt != "" {
+			delete(step, "tool")
+			step["prompt"] = stepMeta.Prompt
+		}
 
 		// Add condition information if present
 		if stepMeta.ConditionEvaluation != nil {
@@ -295,7 +307,14 @@ func (we *WorkflowExecutor) buildStepsArray(stepMetadata []stepMetadata, results
 		// Add result for output steps (the returned document). Results are
 		// always recorded for referencing, but only surfaced here when
 		// requested -- or for every step in debug mode (includeAllResults).
-		if (stepMeta.Output || includeAllResults) && results[stepMeta.ID] != nil {
+		// Artifact steps reference their stored result instead, so a large
+		// result is never inlined, not even in debug mode.
+		switch {
+		case stepMeta.Artifact != "":
+			step["artifact"] = stepMeta.Artifact
+		case stepMeta.ArtifactError != "":
+			step["artifact_error"] = stepMeta.ArtifactError
+		case (stepMeta.Output || includeAllResults) && results[stepMeta.ID] != nil:
 			step["result"] = results[stepMeta.ID]
 		}
 
@@ -348,12 +367,15 @@ func marshalResponse(resp map[string]interface{}, isError bool) *mcp.CallToolRes
 
 // subStepView is the common shape of an executable tool step, shared by
 // top-level plain steps, forEach/parallel sub-steps, and onFailure handlers.
+// A top-level plain step renders a prompt instead when Prompt is set.
 type subStepView struct {
 	ID           string
 	Tool         string
+	Prompt       string
 	Args         map[string]interface{}
 	Condition    *api.WorkflowCondition
 	Output       bool
+	Artifact     bool
 	AllowFailure bool
 }
 
@@ -361,9 +383,11 @@ func plainStepView(step api.WorkflowStep) subStepView {
 	return subStepView{
 		ID:           step.ID,
 		Tool:         step.Tool,
+		Prompt:       step.Prompt,
 		Args:         step.Args,
 		Condition:    step.Condition,
 		Output:       api.OutputEnabled(step.Output, step.Store),
+		Artifact:     step.Artifact,
 		AllowFailure: step.AllowFailure,
 	}
 }
@@ -379,6 +403,19 @@ func subStepViewFrom(ss api.WorkflowSubStep) subStepView {
 	}
 }
 
+// eventData returns the data of a step event: the tool (or prompt) of the
+// step, plus extra.
+func (s subStepView) eventData(extra map[string]interface{}) map[string]interface{} {
+	data := map[string]interface{}{"tool": s.Tool}
+	if s.Prompt != "" {
+		data = map[string]interface{}{"prompt": s.Prompt}
+	}
+	for k, v := range extra {
+		data[k] = v
+	}
+	return data
+}
+
 // stepOutcome reports how a step (or composite step) affected the workflow.
 type stepOutcome struct {
 	// stop indicates the workflow must stop because a non-allowFailure step failed.
@@ -431,20 +468,19 @@ func (we *WorkflowExecutor) runStep(ctx context.Context, workflowName string, s
 		}
 		conditionEvaluation, conditionResult, conditionTool = eval, condResult, condTool
 
-		we.eventCallback.GenerateStepEvent(workflowName, s.ID, "condition_evaluated", map[string]interface{}{
-			"tool":             s.Tool,
+		we.eventCallback.GenerateStepEvent(ctx, workflowName, s.ID, "condition_evaluated", s.eventData(map[string]interface{}{
 			"condition_result": fmt.Sprintf("%t", passed),
-		})
+		}))
 
 		if !passed {
-			logging.Debug("WorkflowExecutor", "Step %s condition failed, skipping step", s.ID)
-			we.eventCallback.GenerateStepEvent(workflowName, s.ID, "step_skipped", map[string]interface{}{
-				"tool":             s.Tool,
+			logging.DebugCtx(ctx, "WorkflowExecutor", "Step %s condition failed, skipping step", s.ID)
+			we.eventCallback.GenerateStepEvent(ctx, workflowName, s.ID, "step_skipped", s.eventData(map[string]interface{}{
 				"condition_result": "false",
-			})
+			}))
 			execCtx.stepMetadata = append(execCtx.stepMetadata, stepMetadata{
 				ID:                  s.ID,
 				Tool:                s.Tool,
+				Prompt:              s.Prompt,
 				Output:              s.Output,
 				Status:              statusSkipped,
 				AllowFailure:        s.AllowFailure,
@@ -460,24 +496,29 @@ func (we *WorkflowExecutor) runStep(ctx context.Context, workflowName string, s
 	if err != nil {
 		return stepOutcome{}, fmt.Errorf("failed to resolve arguments for step %s: %w", s.ID, err)
 	}
-	logging.Debug("WorkflowExecutor", "Step %s resolved args: %+v", s.ID, resolvedArgs)
+	logging.DebugCtx(ctx, "WorkflowExecutor", "Step %s resolved args: %+v", s.ID, resolvedArgs)
 
-	we.eventCallback.GenerateStepEvent(workflowName, s.ID, "step_started", map[string]interface{}{"tool": s.Tool})
+	we.eventCallback.GenerateStepEvent(ctx, workflowName, s.ID, "step_started", s.eventData(nil))
 
 	stepCtx, endStepSpan := startStepSpan(ctx, workflowName, s.ID, s.Tool)
-	result, err := we.toolCaller.CallToolInternal(stepCtx, s.Tool, resolvedArgs)
+	var result *mcp.CallToolResult
+	if s.Prompt != "" {
+		result, err = we.getPrompt(stepCtx, s.Prompt, resolvedArgs)
+	} else {
+		result, err = we.toolCaller.CallToolInternal(stepCtx, s.Tool, resolvedArgs)
+	}
 	endStepSpan(result != nil && result.IsError, err)
 
 	if err != nil {
-		logging.Error("WorkflowExecutor", err, "Step %s failed", s.ID)
-		we.eventCallback.GenerateStepEvent(workflowName, s.ID, "step_failed", map[string]interface{}{
-			"tool":          s.Tool,
+		logging.ErrorCtx(ctx, "WorkflowExecutor", err, "Step %s failed", s.ID)
+		we.eventCallback.GenerateStepEvent(ctx, workflowName, s.ID, "step_failed", s.eventData(map[string]interface{}{
 			api.FieldError:  err.Error(),
 			"allow_failure": s.AllowFailure,
-		})
+		}))
 		execCtx.stepMetadata = append(execCtx.stepMetadata, stepMetadata{
 			ID:                  s.ID,
 			Tool:                s.Tool,
+			Prompt:              s.Prompt,
 			Output:              s.Output,
 			Status:              statusFailed,
 			AllowFailure:        s.AllowFailure,
@@ -493,7 +534,7 @@ func (we *WorkflowExecutor) runStep(ctx context.Context, workflowName string, s
 			"isError":        true,
 		}
 		if s.AllowFailure {
-			logging.Debug("WorkflowExecutor", "Step %s failed but allow_failure is true, continuing", s.ID)
+			logging.DebugCtx(ctx, "WorkflowExecutor", "Step %s failed but allow_failure is true, continuing", s.ID)
 			return stepOutcome{}, nil
 		}
 		return stepOutcome{stop: true, fatalErr: err, failedStepID: s.ID, errorMessage: err.Error()}, nil
@@ -511,28 +552,37 @@ func (we *WorkflowExecutor) runStep(ctx context.Context, workflowName string, s
 		}
 	}
 	execCtx.results[s.ID] = resultData
-	logging.Debug("WorkflowExecutor", "Recorded result from step %s: %+v", s.ID, resultData)
+	logging.DebugCtx(ctx, "WorkflowExecutor", "Recorded result from step %s: %+v", s.ID, resultData)
 
-	we.eventCallback.GenerateStepEvent(workflowName, s.ID, "step_completed", map[string]interface{}{"tool": s.Tool})
+	we.eventCallback.GenerateStepEvent(ctx, workflowName, s.ID, "step_completed", s.eventData(nil))
 
-	execCtx.stepMetadata = append(execCtx.stepMetadata, stepMetadata{
+	meta := stepMetadata{
 		ID:                  s.ID,
 		Tool:                s.Tool,
+		Prompt:              s.Prompt,
 		Output:              s.Output,
 		Status:              statusCompleted,
 		AllowFailure:        s.AllowFailure,
 		ConditionEvaluation: conditionEvaluation,
 		ConditionResult:     conditionResult,
 		ConditionTool:       conditionTool,
-	})
+	}
+	if s.Artifact && !result.IsError {
+		if uri, err := we.storeArtifact(ctx, s.ID, result); err != nil {
+			logging.WarnCtx(ctx, "WorkflowExecutor", "Result of step %s not stored as an artifact: %v", s.ID, err)
+			meta.ArtifactError = err.Error()
+		} else {
+			meta.Artifact = uri
+		}
+	}
+	execCtx.stepMetadata = append(execCtx.stepMetadata, meta)
 
 	if result.IsError {
-		logging.Error("WorkflowExecutor", fmt.Errorf("step returned error"), "Step %s returned error result", s.ID)
-		we.eventCallback.GenerateStepEvent(workflowName, s.ID, "step_failed", map[string]interface{}{
-			"tool":          s.Tool,
+		logging.ErrorCtx(ctx, "WorkflowExecutor", fmt.Errorf("step returned error"), "Step %s returned error result", s.ID)
+		we.eventCallback.GenerateStepEvent(ctx, workflowName, s.ID, "step_failed", s.eventData(map[string]interface{}{
 			api.FieldError:  "step returned error result",
 			"allow_failure": s.AllowFailure,
-		})
+		}))
 		if s.AllowFailure {
 			if len(execCtx.stepMetadata) > 0 {
 				execCtx.stepMetadata[len(execCtx.stepMetadata)-1].Status = statusFailed
@@ -562,7 +612,7 @@ func (we *WorkflowExecutor) runStep(ctx context.Context, workflowName string, s
 // inline condition tool call, each validated against Expect/ExpectNot.
 func (we *WorkflowExecutor) evaluateStepCondition(ctx context.Context, workflowName string, s subStepView, execCtx *executionContext) (bool, *bool, interface{}, string, error) {
 	cond := s.Condition
-	logging.Debug("WorkflowExecutor", "Step %s has condition, evaluating...", s.ID)
+	logging.DebugCtx(ctx, "WorkflowExecutor", "Step %s has condition, evaluating...", s.ID)
 
 	// Boolean Go-template gate.
 	if cond.Template != "" {
@@ -571,7 +621,7 @@ func (we *WorkflowExecutor) evaluateStepCondition(ctx context.Context, workflowN
 			return false, nil, nil, "template", fmt.Errorf("failed to evaluate condition template for step %s: %w", s.ID, err)
 		}
 		passed := isConditionTrue(rendered)
-		logging.Debug("WorkflowExecutor", "Step %s template condition %q -> %v", s.ID, cond.Template, passed)
+		logging.DebugCtx(ctx, "WorkflowExecutor", "Step %s template condition %q -> %v", s.ID, cond.Template, passed)
 		return passed, &passed, rendered, "template", nil
 	}
 
@@ -581,7 +631,7 @@ func (we *WorkflowExecutor) evaluateStepCondition(ctx context.Context, workflowN
 	var conditionTool string
 
 	if cond.FromStep != "" {
-		logging.Debug("WorkflowExecutor", "Step %s condition references previous step: %s", s.ID, cond.FromStep)
+		logging.DebugCtx(ctx, "WorkflowExecutor", "Step %s condition references previous step: %s", s.ID, cond.FromStep)
 
 		var referencedStepResult interface{}
 		found := false
@@ -621,7 +671,7 @@ func (we *WorkflowExecutor) evaluateStepCondition(ctx context.Context, workflowN
 		}
 
 		if !found {
-			logging.Error("WorkflowExecutor", fmt.Errorf("referenced step not found"), "Step %s condition references non-existent step result: %s", s.ID, cond.FromStep)
+			logging.ErrorCtx(ctx, "WorkflowExecutor", fmt.Errorf("referenced step not found"), "Step %s condition references non-existent step result: %s", s.ID, cond.FromStep)
 			return false, nil, nil, "", fmt.Errorf("step %s condition references non-existent step result: %s", s.ID, cond.FromStep)
 		}
 
@@ -655,7 +705,7 @@ func (we *WorkflowExecutor) evaluateStepCondition(ctx context.Context, workflowN
 		conditionToolResult, conditionError = we.toolCaller.CallToolInternal(condCtx, cond.Tool, resolvedConditionArgs)
 		endCondSpan(conditionToolResult != nil && conditionToolResult.IsError, conditionError)
 		if conditionError != nil {
-			logging.Debug("WorkflowExecutor", "Step %s condition tool failed: %v", s.ID, conditionError)
+			logging.DebugCtx(ctx, "WorkflowExecutor", "Step %s condition tool failed: %v", s.ID, conditionError)
 			conditionResult = false
 		} else if len(conditionToolResult.Content) > 0 {
 			if textContent, ok := conditionToolResult.Content[0].(mcp.TextContent); ok {
@@ -759,7 +809,7 @@ func (we *WorkflowExecutor) runForEach(ctx context.Context, workflowName string,
 			}
 			if outcome.stop {
 				if step.AllowFailure {
-					logging.Debug("WorkflowExecutor", "forEach step %s sub-step failed but allow_failure is true", step.ID)
+					logging.DebugCtx(ctx, "WorkflowExecutor", "forEach step %s sub-step failed but allow_failure is true", step.ID)
 					return stepOutcome{}, nil
 				}
 				return outcome, nil
@@ -844,7 +894,7 @@ func (we *WorkflowExecutor) evaluateCompositeCondition(ctx context.Context, work
 	if passed {
 		return false, stepOutcome{}, nil
 	}
-	we.eventCallback.GenerateStepEvent(workflowName, step.ID, "step_skipped", map[string]interface{}{"tool": ""})
+	we.eventCallback.GenerateStepEvent(ctx, workflowName, step.ID, "step_skipped", map[string]interface{}{"tool": ""})
 	execCtx.stepMetadata = append(execCtx.stepMetadata, stepMetadata{
 		ID:                  step.ID,
 		Status:              statusSkipped,
@@ -879,12 +929,12 @@ func (we *WorkflowExecutor) runOnFailure(ctx context.Context, workflow *api.Work
 	if len(workflow.OnFailure) == 0 {
 		return
 	}
-	logging.Debug("WorkflowExecutor", "Running %d onFailure step(s) for workflow %s", len(workflow.OnFailure), workflow.Name)
+	logging.DebugCtx(ctx, "WorkflowExecutor", "Running %d onFailure step(s) for workflow %s", len(workflow.OnFailure), workflow.Name)
 	for _, ss := range workflow.OnFailure {
 		view := subStepViewFrom(ss)
 		view.AllowFailure = true
 		if _, err := we.runStep(ctx, workflow.Name, view, execCtx); err != nil {
-			logging.Error("WorkflowExecutor", err, "onFailure step %s errored", ss.ID)
+			logging.ErrorCtx(ctx, "WorkflowExecutor", err, "onFailure step %s errored", ss.ID)
 		}
 	}
 }
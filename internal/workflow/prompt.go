@@ -0,0 +1,87 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// PromptGetter is implemented by ToolCallers that can also render MCP
+// prompts, which prompt steps require.
+type PromptGetter interface {
+	GetPrompt(ctx context.Context, name string, args map[string]string) (*mcp.GetPromptResult, error)
+}
+
+// getPrompt renders the prompt of a prompt step and returns it as a tool
+// result, so that prompt steps are recorded, referenced, and stored as
+// artifacts like tool steps. The result is a JSON object holding the prompt's
+// description, its messages as {role, content}, where the content of a text
+// message is its text, and the text of all messages joined by blank lines.
+func (we *WorkflowExecutor) getPrompt(ctx context.Context, name string, args map[string]interface{}) (*mcp.CallToolResult, error) {
+	getter, ok := we.toolCaller.(PromptGetter)
+	if !ok {
+		return nil, fmt.Errorf("prompts are not available to workflows")
+	}
+
+	promptArgs, err := promptArguments(args)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := getter.GetPrompt(ctx, name, promptArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]map[string]interface{}, 0, len(result.Messages))
+	var texts []string
+	for _, message := range result.Messages {
+		entry := map[string]interface{}{
+			"role":    string(message.Role),
+			"content": message.Content,
+		}
+		if textContent, ok := message.Content.(mcp.TextContent); ok {
+			entry["content"] = textContent.Text
+			texts = append(texts, textContent.Text)
+		}
+		messages = append(messages, entry)
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"prompt":      name,
+		"description": result.Description,
+		"messages":    messages,
+		"text":        strings.Join(texts, "\n\n"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal prompt %s: %w", name, err)
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{mcp.NewTextContent(string(data))},
+	}, nil
+}
+
+// promptArguments converts the resolved args of a prompt step to the string
+// arguments of MCP prompts. Strings are passed as they are, other values as
+// JSON, so 3 becomes "3" and a list becomes its JSON array.
+func promptArguments(args map[string]interface{}) (map[string]string, error) {
+	promptArgs := make(map[string]string, len(args))
+	for key, value := range args {
+		switch v := value.(type) {
+		case string:
+			promptArgs[key] = v
+		case nil:
+			promptArgs[key] = ""
+		default:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert prompt argument %s to a string: %w", key, err)
+			}
+			promptArgs[key] = string(data)
+		}
+	}
+	return promptArgs, nil
+}
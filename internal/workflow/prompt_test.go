@@ -0,0 +1,89 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// promptToolCaller is a scriptedToolCaller that also renders prompts.
+type promptToolCaller struct {
+	scriptedToolCaller
+	promptArgs map[string]string
+}
+
+func (m *promptToolCaller) GetPrompt(ctx context.Context, name string, args map[string]string) (*mcp.GetPromptResult, error) {
+	if name != "x_review_code" {
+		return nil, fmt.Errorf("prompt %s not found", name)
+	}
+	m.promptArgs = args
+	return &mcp.GetPromptResult{
+		Description: "Review code",
+		Messages: []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleAssistant, mcp.NewTextContent("You review code.")),
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent("Review "+args["file"]+" at depth "+args["depth"])),
+		},
+	}, nil
+}
+
+func TestWorkflowExecutor_PromptStep(t *testing.T) {
+	caller := &promptToolCaller{}
+	executor := NewWorkflowExecutor(caller, nil)
+
+	workflow := &api.Workflow{
+		Name: "review",
+		Steps: []api.WorkflowStep{
+			{ID: "prompt", Prompt: "x_review_code", Args: map[string]interface{}{"file": "{{ .input.file }}", "depth": 2}},
+			{ID: "ask", Tool: "llm_complete", Args: map[string]interface{}{"prompt": "{{ .results.prompt.text }}"}},
+		},
+	}
+
+	result, err := executor.ExecuteWorkflow(context.Background(), workflow, map[string]interface{}{"file": "main.go", debugArgKey: true})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"file": "main.go", "depth": "2"}, caller.promptArgs)
+
+	require.Len(t, caller.calls, 1, "a prompt step calls no tool")
+	assert.Equal(t, "You review code.\n\nReview main.go at depth 2", caller.calls[0].args["prompt"])
+
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &resp))
+	steps := resp[api.FieldSteps].([]interface{})
+	require.Len(t, steps, 2)
+
+	prompt := steps[0].(map[string]interface{})
+	assert.Equal(t, "x_review_code", prompt["prompt"])
+	assert.NotContains(t, prompt, "tool")
+	assert.Equal(t, statusCompleted, prompt[api.FieldStatus])
+	rendered := prompt["result"].(map[string]interface{})
+	assert.Equal(t, "Review code", rendered["description"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"role": "assistant", "content": "You review code."},
+		map[string]interface{}{"role": "user", "content": "Review main.go at depth 2"},
+	}, rendered["messages"])
+}
+
+func TestWorkflowExecutor_PromptStepFailure(t *testing.T) {
+	workflow := &api.Workflow{
+		Name:  "review",
+		Steps: []api.WorkflowStep{{ID: "prompt", Prompt: "x_missing"}},
+	}
+
+	result, err := NewWorkflowExecutor(&promptToolCaller{}, nil).ExecuteWorkflow(context.Background(), workflow, map[string]interface{}{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "prompt x_missing not found")
+	assert.True(t, result.IsError)
+
+	// Without prompt support, a prompt step fails instead of calling a tool
+	caller := &scriptedToolCaller{}
+	_, err = NewWorkflowExecutor(caller, nil).ExecuteWorkflow(context.Background(), workflow, map[string]interface{}{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "prompts are not available to workflows")
+	assert.Empty(t, caller.calls)
+}
@@ -0,0 +1,322 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// Defaults of the execution queue, used when the workflows section of
+// config.yaml does not set them.
+const (
+	defaultQueueWorkers        = 10
+	defaultQueueMaxPerWorkflow = 0 // no limit besides the number of workers
+)
+
+// How long a pending execution resumed after a restart waits for the tools
+// of its workflow to be available, as the MCP servers are still connecting.
+const (
+	resumeAvailabilityTimeout = 2 * time.Minute
+	resumeRetryInterval       = 5 * time.Second
+)
+
+// executionQueue bounds the workflow executions that run at the same time:
+// at most workers in total and, if maxPerWorkflow is positive, at most
+// maxPerWorkflow of each workflow. On top, each execution brings the
+// concurrency its workflow declares: a lower limit for the executions of its
+// workflow, and mutexes no other running execution may hold. Executions
+// beyond the limits wait in FIFO order; a waiting execution that is held back
+// does not hold up the executions queued after it that may run. Nested
+// executions, of the workflows a running workflow calls, run in the worker of
+// their caller and do not wait: they fail if the concurrency of their own
+// workflow does not let them run right away.
+//
+// Waiting executions do not take a goroutine of their own: the caller waits
+// in acquire, so the number of running executions is bounded by the workers.
+type executionQueue struct {
+	mu             sync.Mutex
+	workers        int
+	maxPerWorkflow int
+	running        int
+	perWorkflow    map[string]int
+	mutexes        map[string]bool // mutexes held by running executions
+	waiting        []*queueSlot
+}
+
+// executionConcurrency is the concurrency an execution's workflow declares:
+// the number of executions of the workflow that run at the same time, 0 for
+// no limit, and the rendered names of the mutexes the execution holds.
+type executionConcurrency struct {
+	maxConcurrent int
+	mutexes       []string
+}
+
+// limited reports whether c limits the execution at all.
+func (c executionConcurrency) limited() bool {
+	return c.maxConcurrent > 0 || len(c.mutexes) > 0
+}
+
+// queueSlot is an execution waiting in the queue. ready is closed when the
+// execution may run. A nested execution runs in the worker slot of the
+// execution that calls it, and only waits for its workflow's concurrency.
+type queueSlot struct {
+	workflow    string
+	concurrency executionConcurrency
+	nested      bool
+	ready       chan struct{}
+}
+
+// newExecutionQueue creates a queue with the given limits. Non-positive
+// workers fall back to the default.
+func newExecutionQueue(workers, maxPerWorkflow int) *executionQueue {
+	q := &executionQueue{perWorkflow: make(map[string]int), mutexes: make(map[string]bool)}
+	q.setLimits(workers, maxPerWorkflow)
+	return q
+}
+
+// setLimits changes the limits of the queue. Lowering them does not stop
+// running executions; new ones wait until the running ones are within the
+// limits.
+func (q *executionQueue) setLimits(workers, maxPerWorkflow int) {
+	if workers <= 0 {
+		workers = defaultQueueWorkers
+	}
+	if maxPerWorkflow < 0 {
+		maxPerWorkflow = defaultQueueMaxPerWorkflow
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.workers = workers
+	q.maxPerWorkflow = maxPerWorkflow
+	q.dispatchLocked()
+}
+
+// acquire waits until an execution of workflow with the given concurrency
+// may run and returns the function that frees its slot and mutexes when it
+// has finished. If ctx is done first, the execution leaves the queue and
+// ctx's error is returned.
+func (q *executionQueue) acquire(ctx context.Context, workflow string, concurrency executionConcurrency) (func(), error) {
+	return q.wait(ctx, &queueSlot{workflow: workflow, concurrency: concurrency, ready: make(chan struct{})})
+}
+
+// acquireNested is acquire for a nested execution, which takes no worker
+// slot and is not subject to the per-workflow limit of the queue. It does not
+// wait: its caller holds a slot and mutexes already, and waiting for another
+// execution that waits for them in turn would deadlock. If the concurrency
+// of workflow does not allow the execution to run now, a ConflictError is
+// returned.
+func (q *executionQueue) acquireNested(workflow string, concurrency executionConcurrency) (func(), error) {
+	slot := &queueSlot{workflow: workflow, concurrency: concurrency, nested: true, ready: make(chan struct{})}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.mayRunLocked(slot) {
+		return nil, &api.ConflictError{Message: fmt.Sprintf(
+			"nested execution of workflow %s conflicts with running executions: its concurrency limit is reached or another execution holds one of its mutexes", workflow)}
+	}
+	q.takeLocked(slot)
+	return q.releaseFunc(slot), nil
+}
+
+// wait queues slot and waits until it is dispatched or ctx is done.
+func (q *executionQueue) wait(ctx context.Context, slot *queueSlot) (func(), error) {
+	q.mu.Lock()
+	q.waiting = append(q.waiting, slot)
+	q.dispatchLocked()
+	q.mu.Unlock()
+
+	select {
+	case <-slot.ready:
+	case <-ctx.Done():
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		select {
+		case <-slot.ready:
+			// Dispatched while the context was cancelled
+			q.releaseLocked(slot)
+		default:
+			q.removeLocked(slot)
+		}
+		return nil, ctx.Err()
+	}
+	return q.releaseFunc(slot), nil
+}
+
+// releaseFunc returns the function that releases the running execution of
+// slot, once.
+func (q *executionQueue) releaseFunc(slot *queueSlot) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			q.mu.Lock()
+			defer q.mu.Unlock()
+			q.releaseLocked(slot)
+		})
+	}
+}
+
+// stats returns the number of running and waiting executions.
+func (q *executionQueue) stats() (running, waiting int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.running, len(q.waiting)
+}
+
+// dispatchLocked starts the waiting executions the limits allow, oldest
+// first.
+func (q *executionQueue) dispatchLocked() {
+	for i := 0; i < len(q.waiting); {
+		slot := q.waiting[i]
+		if !q.mayRunLocked(slot) {
+			i++
+			continue
+		}
+		q.waiting = append(q.waiting[:i], q.waiting[i+1:]...)
+		q.takeLocked(slot)
+	}
+}
+
+// takeLocked starts the execution of slot: it takes its worker, counts it
+// for its workflow, and holds its mutexes.
+func (q *executionQueue) takeLocked(slot *queueSlot) {
+	if !slot.nested {
+		q.running++
+	}
+	q.perWorkflow[slot.workflow]++
+	for _, mutex := range slot.concurrency.mutexes {
+		q.mutexes[mutex] = true
+	}
+	close(slot.ready)
+}
+
+// mayRunLocked reports whether the waiting execution of slot has a worker,
+// is within the limits of its workflow and none of its mutexes is held.
+func (q *executionQueue) mayRunLocked(slot *queueSlot) bool {
+	running := q.perWorkflow[slot.workflow]
+	if !slot.nested && (q.running >= q.workers || q.maxPerWorkflow > 0 && running >= q.maxPerWorkflow) {
+		return false
+	}
+	if slot.concurrency.maxConcurrent > 0 && running >= slot.concurrency.maxConcurrent {
+		return false
+	}
+	for _, mutex := range slot.concurrency.mutexes {
+		if q.mutexes[mutex] {
+			return false
+		}
+	}
+	return true
+}
+
+// releaseLocked frees the slot and the mutexes of a finished execution.
+func (q *executionQueue) releaseLocked(slot *queueSlot) {
+	if !slot.nested {
+		q.running--
+	}
+	if q.perWorkflow[slot.workflow]--; q.perWorkflow[slot.workflow] <= 0 {
+		delete(q.perWorkflow, slot.workflow)
+	}
+	for _, mutex := range slot.concurrency.mutexes {
+		delete(q.mutexes, mutex)
+	}
+	q.dispatchLocked()
+}
+
+// removeLocked removes a waiting execution from the queue.
+func (q *executionQueue) removeLocked(slot *queueSlot) {
+	for i, s := range q.waiting {
+		if s == slot {
+			q.waiting = append(q.waiting[:i], q.waiting[i+1:]...)
+			return
+		}
+	}
+}
+
+// executionContextKey marks the contexts of running workflow executions.
+type executionContextKey struct{}
+
+// executionScope is what a running execution and the executions that
+// called it, if it is nested, hold: their workflows and mutexes.
+type executionScope struct {
+	workflows []string
+	mutexes   []string
+}
+
+// withinExecution returns ctx marked as the context of a running execution
+// of workflow, holding the mutexes of concurrency.
+func withinExecution(ctx context.Context, workflow string, concurrency executionConcurrency) context.Context {
+	scope := &executionScope{workflows: []string{workflow}, mutexes: concurrency.mutexes}
+	if parent, ok := ctx.Value(executionContextKey{}).(*executionScope); ok {
+		scope.workflows = slices.Concat(parent.workflows, scope.workflows)
+		scope.mutexes = slices.Concat(parent.mutexes, scope.mutexes)
+	}
+	return context.WithValue(ctx, executionContextKey{}, scope)
+}
+
+// isWithinExecution reports whether ctx belongs to a running execution, as
+// for the nested workflows a workflow calls. These run in the slot of their
+// parent, as waiting for a slot of their own could deadlock a full queue.
+func isWithinExecution(ctx context.Context) bool {
+	_, ok := ctx.Value(executionContextKey{}).(*executionScope)
+	return ok
+}
+
+// nestedConcurrency returns the part of concurrency a nested execution of
+// workflow in ctx still has to wait for. The mutexes the calling executions
+// hold are held already, and a workflow calling itself, directly or through
+// others, is not held back by its own maxConcurrent.
+func nestedConcurrency(ctx context.Context, workflow string, concurrency executionConcurrency) executionConcurrency {
+	scope, ok := ctx.Value(executionContextKey{}).(*executionScope)
+	if !ok {
+		return concurrency
+	}
+	nested := executionConcurrency{maxConcurrent: concurrency.maxConcurrent}
+	if slices.Contains(scope.workflows, workflow) {
+		nested.maxConcurrent = 0
+	}
+	for _, mutex := range concurrency.mutexes {
+		if !slices.Contains(scope.mutexes, mutex) {
+			nested.mutexes = append(nested.mutexes, mutex)
+		}
+	}
+	return nested
+}
+
+// executionConcurrency returns the concurrency of an execution of workflow
+// with args. The mutex names are rendered against the args, with the
+// defaults of the workflow applied, as .input, so a mutex such as
+// "deploy-{{ .input.cluster }}" only serialises the executions against the
+// same cluster. Names that render empty are dropped.
+func (we *WorkflowExecutor) executionConcurrency(workflow *api.Workflow, args map[string]interface{}) (executionConcurrency, error) {
+	if workflow.Concurrency == nil {
+		return executionConcurrency{}, nil
+	}
+	concurrency := executionConcurrency{maxConcurrent: workflow.Concurrency.MaxConcurrent}
+	if len(workflow.Concurrency.Mutexes) == 0 {
+		return concurrency, nil
+	}
+
+	input := make(map[string]interface{}, len(args))
+	maps.Copy(input, args)
+	for name, arg := range workflow.Args {
+		if _, exists := input[name]; !exists && arg.Default != nil {
+			input[name] = arg.Default
+		}
+	}
+	for _, mutex := range workflow.Concurrency.Mutexes {
+		rendered, err := we.template.RenderGoTemplate(mutex, map[string]interface{}{api.FieldInput: input})
+		if err != nil {
+			return executionConcurrency{}, fmt.Errorf("failed to render mutex %q of workflow %s: %w", mutex, workflow.Name, err)
+		}
+		if name := strings.TrimSpace(fmt.Sprint(rendered)); name != "" {
+			concurrency.mutexes = append(concurrency.mutexes, name)
+		}
+	}
+	return concurrency, nil
+}
@@ -0,0 +1,350 @@
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/giantswarm/muster/internal/api"
+)
+
+// acquireAsync acquires a slot of q in a goroutine and returns the channel
+// its release function is sent on.
+func acquireAsync(ctx context.Context, q *executionQueue, workflow string, concurrency executionConcurrency) <-chan func() {
+	acquired := make(chan func(), 1)
+	go func() {
+		release, err := q.acquire(ctx, workflow, concurrency)
+		if err == nil {
+			acquired <- release
+		}
+	}()
+	return acquired
+}
+
+// waitForWaiting waits until n executions wait in q.
+func waitForWaiting(t *testing.T, q *executionQueue, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		_, waiting := q.stats()
+		return waiting == n
+	}, time.Second, time.Millisecond)
+}
+
+func TestExecutionQueue_Workers(t *testing.T) {
+	q := newExecutionQueue(2, 0)
+	ctx := context.Background()
+
+	release1, err := q.acquire(ctx, "a", executionConcurrency{})
+	require.NoError(t, err)
+	release2, err := q.acquire(ctx, "b", executionConcurrency{})
+	require.NoError(t, err)
+
+	third := acquireAsync(ctx, q, "c", executionConcurrency{})
+	waitForWaiting(t, q, 1)
+
+	release1()
+	release1() // releasing twice frees one slot only
+	select {
+	case release3 := <-third:
+		running, waiting := q.stats()
+		assert.Equal(t, 2, running)
+		assert.Equal(t, 0, waiting)
+		release3()
+	case <-time.After(time.Second):
+		t.Fatal("the waiting execution did not run when a worker was freed")
+	}
+	release2()
+
+	running, _ := q.stats()
+	assert.Equal(t, 0, running)
+}
+
+func TestExecutionQueue_MaxPerWorkflow(t *testing.T) {
+	q := newExecutionQueue(3, 1)
+	ctx := context.Background()
+
+	releaseA, err := q.acquire(ctx, "a", executionConcurrency{})
+	require.NoError(t, err)
+
+	secondA := acquireAsync(ctx, q, "a", executionConcurrency{})
+	waitForWaiting(t, q, 1)
+
+	// An execution of another workflow is not held up by the waiting one
+	releaseB, err := q.acquire(ctx, "b", executionConcurrency{})
+	require.NoError(t, err)
+	releaseB()
+
+	select {
+	case <-secondA:
+		t.Fatal("a second execution of a ran beyond the per-workflow limit")
+	default:
+	}
+
+	releaseA()
+	select {
+	case release := <-secondA:
+		release()
+	case <-time.After(time.Second):
+		t.Fatal("the waiting execution of a did not run when the first one finished")
+	}
+}
+
+func TestExecutionQueue_Cancel(t *testing.T) {
+	q := newExecutionQueue(1, 0)
+
+	release, err := q.acquire(context.Background(), "a", executionConcurrency{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := q.acquire(ctx, "b", executionConcurrency{})
+		errs <- err
+	}()
+	waitForWaiting(t, q, 1)
+
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+	_, waiting := q.stats()
+	assert.Equal(t, 0, waiting, "a cancelled execution leaves the queue")
+
+	release()
+	running, _ := q.stats()
+	assert.Equal(t, 0, running)
+}
+
+func TestExecutionQueue_SetLimits(t *testing.T) {
+	q := newExecutionQueue(1, 0)
+	ctx := context.Background()
+
+	release1, err := q.acquire(ctx, "a", executionConcurrency{})
+	require.NoError(t, err)
+	second := acquireAsync(ctx, q, "a", executionConcurrency{})
+	waitForWaiting(t, q, 1)
+
+	// Raising the limits runs the waiting execution
+	q.setLimits(2, 0)
+	select {
+	case release2 := <-second:
+		release2()
+	case <-time.After(time.Second):
+		t.Fatal("the waiting execution did not run when the limits were raised")
+	}
+	release1()
+
+	q.setLimits(0, -1)
+	assert.Equal(t, defaultQueueWorkers, q.workers)
+	assert.Equal(t, defaultQueueMaxPerWorkflow, q.maxPerWorkflow)
+}
+
+func TestExecutionQueue_MaxConcurrent(t *testing.T) {
+	q := newExecutionQueue(3, 0)
+	ctx := context.Background()
+	serial := executionConcurrency{maxConcurrent: 1}
+
+	releaseA, err := q.acquire(ctx, "a", serial)
+	require.NoError(t, err)
+	secondA := acquireAsync(ctx, q, "a", serial)
+	waitForWaiting(t, q, 1)
+
+	// The limit of a does not apply to b
+	releaseB, err := q.acquire(ctx, "b", executionConcurrency{})
+	require.NoError(t, err)
+	releaseB()
+
+	releaseA()
+	select {
+	case release := <-secondA:
+		release()
+	case <-time.After(time.Second):
+		t.Fatal("the waiting execution of a did not run when the first one finished")
+	}
+}
+
+func TestExecutionQueue_Mutexes(t *testing.T) {
+	q := newExecutionQueue(3, 0)
+	ctx := context.Background()
+
+	releaseA, err := q.acquire(ctx, "deploy-app", executionConcurrency{mutexes: []string{"cluster-prod"}})
+	require.NoError(t, err)
+
+	// Another workflow sharing the mutex waits
+	rollback := acquireAsync(ctx, q, "rollback-app", executionConcurrency{mutexes: []string{"cluster-dev", "cluster-prod"}})
+	waitForWaiting(t, q, 1)
+
+	// A different mutex does not
+	releaseStaging, err := q.acquire(ctx, "deploy-app", executionConcurrency{mutexes: []string{"cluster-staging"}})
+	require.NoError(t, err)
+	releaseStaging()
+
+	releaseA()
+	select {
+	case release := <-rollback:
+		// The waiting execution holds all of its mutexes
+		assert.Equal(t, map[string]bool{"cluster-dev": true, "cluster-prod": true}, q.mutexes)
+		release()
+	case <-time.After(time.Second):
+		t.Fatal("the waiting execution did not run when the mutex was released")
+	}
+	assert.Empty(t, q.mutexes)
+}
+
+func TestWorkflowExecutor_ExecutionConcurrency(t *testing.T) {
+	we := NewWorkflowExecutor(nil, nil)
+	workflow := &api.Workflow{
+		Name: "deploy",
+		Args: map[string]api.ArgDefinition{
+			"cluster":   {Type: "string", Required: true},
+			"namespace": {Type: "string", Default: "default"},
+		},
+	}
+
+	concurrency, err := we.executionConcurrency(workflow, map[string]interface{}{"cluster": "prod"})
+	require.NoError(t, err)
+	assert.Equal(t, executionConcurrency{}, concurrency)
+
+	workflow.Concurrency = &api.WorkflowConcurrency{
+		MaxConcurrent: 2,
+		Mutexes:       []string{"deploy-{{ .input.cluster }}-{{ .input.namespace }}", "releases", "{{ if false }}x{{ end }}"},
+	}
+	args := map[string]interface{}{"cluster": "prod"}
+	concurrency, err = we.executionConcurrency(workflow, args)
+	require.NoError(t, err)
+	assert.Equal(t, executionConcurrency{maxConcurrent: 2, mutexes: []string{"deploy-prod-default", "releases"}}, concurrency)
+	assert.NotContains(t, args, "namespace", "the args are not modified")
+
+	workflow.Concurrency.Mutexes = []string{"{{ .input.cluster"}
+	_, err = we.executionConcurrency(workflow, args)
+	assert.Error(t, err)
+}
+
+func TestExecutionQueue_Nested(t *testing.T) {
+	q := newExecutionQueue(2, 0)
+	ctx := context.Background()
+	prod := executionConcurrency{mutexes: []string{"cluster-prod"}}
+
+	releaseDeploy, err := q.acquire(ctx, "deploy", prod)
+	require.NoError(t, err)
+	releaseParent, err := q.acquire(ctx, "release", executionConcurrency{})
+	require.NoError(t, err)
+
+	// A nested execution runs without a worker of its own
+	releaseNested, err := q.acquireNested("deploy", executionConcurrency{mutexes: []string{"cluster-dev"}})
+	require.NoError(t, err)
+	running, _ := q.stats()
+	assert.Equal(t, 2, running, "nested executions take no worker")
+	releaseNested()
+
+	// but fails rather than wait for the mutexes held by other executions
+	_, err = q.acquireNested("deploy", prod)
+	assert.True(t, api.IsConflict(err), "expected a conflict, got %v", err)
+
+	releaseDeploy()
+	releaseNested, err = q.acquireNested("deploy", prod)
+	require.NoError(t, err)
+	releaseNested()
+	releaseParent()
+	assert.Empty(t, q.mutexes)
+	assert.Empty(t, q.perWorkflow)
+}
+
+func TestExecutionQueue_NestedCrossing(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b executionConcurrency
+	}{
+		{
+			name: "mutexes",
+			a:    executionConcurrency{mutexes: []string{"m"}},
+			b:    executionConcurrency{mutexes: []string{"n"}},
+		},
+		{
+			name: "max concurrent",
+			a:    executionConcurrency{maxConcurrent: 1},
+			b:    executionConcurrency{maxConcurrent: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newExecutionQueue(2, 0)
+			ctx := context.Background()
+
+			// a holds what b's nested execution needs, and the other way
+			// round: both fail instead of waiting for each other forever
+			releaseA, err := q.acquire(ctx, "a", tt.a)
+			require.NoError(t, err)
+			releaseB, err := q.acquire(ctx, "b", tt.b)
+			require.NoError(t, err)
+
+			_, err = q.acquireNested("b", nestedConcurrency(withinExecution(ctx, "a", tt.a), "b", tt.b))
+			assert.True(t, api.IsConflict(err), "a nesting into b: expected a conflict, got %v", err)
+			_, err = q.acquireNested("a", nestedConcurrency(withinExecution(ctx, "b", tt.b), "a", tt.a))
+			assert.True(t, api.IsConflict(err), "b nesting into a: expected a conflict, got %v", err)
+
+			releaseA()
+			releaseB()
+			running, waiting := q.stats()
+			assert.Zero(t, running)
+			assert.Zero(t, waiting)
+		})
+	}
+}
+
+func TestNestedConcurrency(t *testing.T) {
+	concurrency := executionConcurrency{maxConcurrent: 1, mutexes: []string{"cluster-prod", "deploy-app", "rollback-app"}}
+	assert.Equal(t, concurrency, nestedConcurrency(context.Background(), "rollback", concurrency))
+
+	ctx := withinExecution(context.Background(), "release", executionConcurrency{mutexes: []string{"cluster-prod"}})
+	ctx = withinExecution(ctx, "deploy", executionConcurrency{mutexes: []string{"deploy-app"}})
+
+	// The mutexes of the calling executions are held already
+	assert.Equal(t, executionConcurrency{maxConcurrent: 1, mutexes: []string{"rollback-app"}},
+		nestedConcurrency(ctx, "rollback", concurrency))
+
+	// A workflow calling itself is not held back by its own limit
+	assert.False(t, nestedConcurrency(ctx, "release", executionConcurrency{maxConcurrent: 1, mutexes: []string{"cluster-prod"}}).limited())
+}
+
+func TestWithinExecution(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, isWithinExecution(ctx))
+	assert.True(t, isWithinExecution(withinExecution(ctx, "deploy", executionConcurrency{})))
+}
+
+func TestExecutionTracker_Pending(t *testing.T) {
+	tracker := NewExecutionTracker(NewExecutionStorage(t.TempDir()))
+	ctx := context.Background()
+
+	first := tracker.Enqueue(ctx, "deploy", map[string]interface{}{"env": "prod"})
+	second := tracker.Enqueue(ctx, "deploy", nil)
+	assert.Equal(t, api.WorkflowExecutionPending, first.Status)
+
+	pending, err := tracker.ListByStatus(ctx, api.WorkflowExecutionPending)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, first.ExecutionID, pending[0].ExecutionID, "oldest first")
+	assert.Equal(t, "prod", pending[0].Input["env"])
+
+	_, execution, err := tracker.RunExecution(ctx, first, func() (*mcp.CallToolResult, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.NewTextContent("done")}}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, first.ExecutionID, execution.ExecutionID)
+	assert.Equal(t, api.WorkflowExecutionCompleted, execution.Status)
+
+	tracker.Fail(ctx, second, context.Canceled)
+
+	pending, err = tracker.ListByStatus(ctx, api.WorkflowExecutionPending)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	failed, err := tracker.ListByStatus(ctx, api.WorkflowExecutionFailed)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, second.ExecutionID, failed[0].ExecutionID)
+}
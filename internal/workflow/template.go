@@ -1,11 +1,13 @@
 package workflow
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"slices"
 	"strings"
 
+	"github.com/giantswarm/muster/internal/api"
 	"github.com/giantswarm/muster/pkg/logging"
 )
 
@@ -16,6 +18,20 @@ import (
 // instead of being stringified by text/template.
 var purePathPattern = regexp.MustCompile(`^\{\{\s*\.([A-Za-z0-9_][A-Za-z0-9_.\[\]-]*)\s*\}\}$`)
 
+// compileTemplates parses the templates of wf ahead of its executions, which
+// then use the parsed templates cached by the template engine.
+func (we *WorkflowExecutor) compileTemplates(wf *api.Workflow) error {
+	data, err := json.Marshal(wf)
+	if err != nil {
+		return fmt.Errorf("failed to encode workflow %s: %w", wf.Name, err)
+	}
+	var definition map[string]interface{}
+	if err := json.Unmarshal(data, &definition); err != nil {
+		return fmt.Errorf("failed to decode workflow %s: %w", wf.Name, err)
+	}
+	return we.template.Compile(definition)
+}
+
 // resolveArguments resolves template variables in step arguments.
 func (we *WorkflowExecutor) resolveArguments(args map[string]interface{}, ctx *executionContext) (map[string]interface{}, error) {
 	resolved := make(map[string]interface{})
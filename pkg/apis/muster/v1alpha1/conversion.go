@@ -0,0 +1,10 @@
+package v1alpha1
+
+// v1alpha1 is the storage version and the conversion hub of MCPServer and
+// Workflow: the other served versions convert to and from it.
+
+// Hub marks MCPServer as a conversion hub.
+func (*MCPServer) Hub() {}
+
+// Hub marks Workflow as a conversion hub.
+func (*Workflow) Hub() {}
@@ -366,6 +366,45 @@ const (
 	MCPServerStateFailed MCPServerStateValue = "Failed"
 )
 
+// MCPServerHealthValue represents the health of an MCPServer's service, as
+// reported by its health checks.
+type MCPServerHealthValue string
+
+const (
+	// MCPServerHealthHealthy indicates the server's health checks pass.
+	MCPServerHealthHealthy MCPServerHealthValue = "Healthy"
+
+	// MCPServerHealthDegraded indicates the server works with impaired functionality.
+	MCPServerHealthDegraded MCPServerHealthValue = "Degraded"
+
+	// MCPServerHealthUnhealthy indicates the server's health checks fail.
+	MCPServerHealthUnhealthy MCPServerHealthValue = "Unhealthy"
+
+	// MCPServerHealthUnknown indicates the server's health has not been checked yet.
+	MCPServerHealthUnknown MCPServerHealthValue = "Unknown"
+)
+
+// MCPServerAuthValue represents how muster authenticates to an MCPServer.
+type MCPServerAuthValue string
+
+const (
+	// MCPServerAuthNone indicates the server requires no authentication.
+	MCPServerAuthNone MCPServerAuthValue = "None"
+
+	// MCPServerAuthOAuth indicates each user authenticates to the server with
+	// OAuth, either as configured in spec.auth or because the server returned
+	// 401 Unauthorized.
+	MCPServerAuthOAuth MCPServerAuthValue = "OAuth"
+
+	// MCPServerAuthForwardToken indicates muster forwards the session's ID token
+	// to the server (SSO).
+	MCPServerAuthForwardToken MCPServerAuthValue = "ForwardToken"
+
+	// MCPServerAuthTokenExchange indicates muster exchanges the session's token
+	// for one of the server's identity provider (RFC 8693).
+	MCPServerAuthTokenExchange MCPServerAuthValue = "TokenExchange"
+)
+
 // MCPServerStatus defines the observed state of MCPServer.
 //
 // This status reflects server-side observable state including auth requirements.
@@ -391,6 +430,17 @@ type MCPServerStatus struct {
 	// +kubebuilder:validation:Enum=Running;Starting;Stopped;Connected;Auth Required;Connecting;Disconnected;Failed
 	State MCPServerStateValue `json:"state,omitempty" yaml:"state,omitempty"`
 
+	// Health is the health of the server's service: Healthy, Degraded,
+	// Unhealthy, or Unknown before its first health check.
+	// +kubebuilder:validation:Enum=Healthy;Degraded;Unhealthy;Unknown
+	Health MCPServerHealthValue `json:"health,omitempty" yaml:"health,omitempty"`
+
+	// Auth is how muster authenticates to the server: None, OAuth,
+	// ForwardToken, or TokenExchange. It reflects spec.auth, and is OAuth for a
+	// server without spec.auth that requires authentication.
+	// +kubebuilder:validation:Enum=None;OAuth;ForwardToken;TokenExchange
+	Auth MCPServerAuthValue `json:"auth,omitempty" yaml:"auth,omitempty"`
+
 	// LastError contains any error message from the most recent server operation.
 	// Note: Per-user authentication errors are tracked in the Session Registry,
 	// not here. This field only contains infrastructure-level errors.
@@ -423,11 +473,15 @@ type MCPServerStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=mcps
+// +kubebuilder:storageversion
+// +kubebuilder:resource:shortName=mcps,categories=muster
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
-// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.url"
-// +kubebuilder:printcolumn:name="AutoStart",type="boolean",JSONPath=".spec.autoStart"
-// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Health",type="string",JSONPath=".status.health"
+// +kubebuilder:printcolumn:name="Auth",type="string",JSONPath=".status.auth"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.url",priority=1
+// +kubebuilder:printcolumn:name="AutoStart",type="boolean",JSONPath=".spec.autoStart",priority=1
+// +kubebuilder:printcolumn:name="Last Error",type="string",JSONPath=".status.lastError",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:validation:XValidation:rule="self.spec.type != 'stdio' || has(self.spec.command)",message="command is required when type is stdio"
 // +kubebuilder:validation:XValidation:rule="self.spec.type == 'stdio' || has(self.spec.url)",message="url is required when type is streamable-http or sse"
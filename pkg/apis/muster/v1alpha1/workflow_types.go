@@ -61,12 +61,37 @@ type WorkflowSpec struct {
 	// returned unchanged.
 	// +kubebuilder:validation:XPreserveUnknownFields
 	Output map[string]apiextensionsv1.JSON `json:"output,omitempty" yaml:"output,omitempty"`
+
+	// Concurrency limits the executions of this workflow that run at the same
+	// time, so conflicting executions, such as two deployments to the same
+	// cluster, wait for each other instead of running concurrently.
+	Concurrency *WorkflowConcurrency `json:"concurrency,omitempty" yaml:"concurrency,omitempty"`
+}
+
+// WorkflowConcurrency limits the executions of a workflow that run at the
+// same time. Executions beyond the limits wait in the execution queue,
+// pending, until the conflicting executions finished.
+type WorkflowConcurrency struct {
+	// MaxConcurrent is the number of executions of this workflow that run at
+	// the same time; 1 runs them one after the other. 0 sets no limit besides
+	// the limits of the execution queue.
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrent int `json:"maxConcurrent,omitempty" yaml:"maxConcurrent,omitempty"`
+
+	// Mutexes names mutexes an execution holds while it runs. Of all the
+	// executions, of any workflow, that name the same mutex, only one runs at
+	// a time. The names support templating against the execution arguments,
+	// e.g. "deploy-{{ .input.cluster }}", to only exclude executions against
+	// the same target.
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MinLength=1
+	Mutexes []string `json:"mutexes,omitempty" yaml:"mutexes,omitempty"`
 }
 
 // WorkflowStep defines a single step in the workflow execution.
-// A step is exactly one of: a tool call (tool), a sequential loop (forEach),
-// or a concurrent group (parallel).
-// +kubebuilder:validation:XValidation:rule="(has(self.tool) ? 1 : 0) + (has(self.forEach) ? 1 : 0) + (has(self.parallel) ? 1 : 0) == 1",message="exactly one of tool, forEach, or parallel must be set"
+// A step is exactly one of: a tool call (tool), a prompt rendering (prompt),
+// a sequential loop (forEach), or a concurrent group (parallel).
+// +kubebuilder:validation:XValidation:rule="(has(self.tool) ? 1 : 0) + (has(self.prompt) ? 1 : 0) + (has(self.forEach) ? 1 : 0) + (has(self.parallel) ? 1 : 0) == 1",message="exactly one of tool, prompt, forEach, or parallel must be set"
 type WorkflowStep struct {
 	// ID is the unique identifier for this step within the workflow.
 	// +kubebuilder:validation:Required
@@ -75,10 +100,16 @@ type WorkflowStep struct {
 	ID string `json:"id" yaml:"id"`
 
 	// Tool specifies the name of the tool to execute for this step.
-	// Mutually exclusive with forEach and parallel.
+	// Mutually exclusive with prompt, forEach, and parallel.
 	Tool string `json:"tool,omitempty" yaml:"tool,omitempty"`
 
-	// Args provides arguments for the tool execution (supports templating).
+	// Prompt specifies the name of an MCP prompt to render for this step, as
+	// the get_prompt meta-tool does. The args are passed to the prompt as
+	// strings, and the step result holds the rendered messages. Mutually
+	// exclusive with tool, forEach, and parallel.
+	Prompt string `json:"prompt,omitempty" yaml:"prompt,omitempty"`
+
+	// Args provides arguments for the tool execution or prompt (supports templating).
 	// Values may be any JSON type (string, integer, boolean, number, object, array)
 	// because the schema uses x-kubernetes-preserve-unknown-fields. Templated
 	// strings such as "{{.input.namespace}}" are resolved server-side at
@@ -89,13 +120,13 @@ type WorkflowStep struct {
 	Condition *WorkflowCondition `json:"condition,omitempty" yaml:"condition,omitempty"`
 
 	// ForEach executes a body of sub-steps once per item of a list. Mutually
-	// exclusive with tool and parallel.
+	// exclusive with tool, prompt, and parallel.
 	ForEach *WorkflowForEach `json:"forEach,omitempty" yaml:"forEach,omitempty"`
 
 	// Parallel executes a group of sub-steps concurrently. Each sub-step
 	// resolves its arguments from the workflow state as it was before the
 	// group started; siblings cannot reference each other's results. Mutually
-	// exclusive with tool and forEach.
+	// exclusive with tool, prompt, and forEach.
 	// +kubebuilder:validation:MinItems=1
 	Parallel []WorkflowSubStep `json:"parallel,omitempty" yaml:"parallel,omitempty"`
 
@@ -113,6 +144,12 @@ type WorkflowStep struct {
 	// +kubebuilder:default=false
 	Store bool `json:"store,omitempty" yaml:"store,omitempty"`
 
+	// Artifact stores the result of a tool step as an artifact of the
+	// execution, served as the MCP resource execution://<id>/<step>/output,
+	// instead of inlining it into the returned document. The result stays
+	// referenceable by later steps and the output template.
+	Artifact bool `json:"artifact,omitempty" yaml:"artifact,omitempty"`
+
 	// AllowFailure defines if in case of an error the next step is executed or not.
 	// +kubebuilder:default=false
 	AllowFailure bool `json:"allowFailure,omitempty" yaml:"allowFailure,omitempty"`
@@ -247,7 +284,8 @@ type WorkflowStatus struct {
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
-//+kubebuilder:resource:shortName=wf
+//+kubebuilder:storageversion
+//+kubebuilder:resource:shortName=wf,categories=muster
 //+kubebuilder:printcolumn:name="Valid",type="boolean",JSONPath=".status.valid"
 //+kubebuilder:printcolumn:name="Steps",type="integer",JSONPath=".status.stepCount"
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
@@ -18,7 +18,7 @@ type WorkflowExecutionSpec struct {
 	WorkflowName string `json:"workflowName" yaml:"workflowName"`
 
 	// Status indicates the final (or current) state of the execution.
-	// +kubebuilder:validation:Enum=inprogress;completed;failed
+	// +kubebuilder:validation:Enum=pending;inprogress;completed;failed
 	Status string `json:"status" yaml:"status"`
 
 	// StartedAt is the timestamp when the execution began.
@@ -62,6 +62,10 @@ type WorkflowExecutionStepRecord struct {
 	// Tool is the name of the tool that was executed for this step.
 	Tool string `json:"tool,omitempty" yaml:"tool,omitempty"`
 
+	// Prompt is the name of the prompt that was rendered for this step, if it
+	// is a prompt step.
+	Prompt string `json:"prompt,omitempty" yaml:"prompt,omitempty"`
+
 	// Status indicates the final (or current) state of the step execution.
 	// +kubebuilder:validation:Enum=inprogress;completed;failed
 	Status string `json:"status" yaml:"status"`
@@ -89,10 +93,14 @@ type WorkflowExecutionStepRecord struct {
 
 	// StoredAs is the variable name where the step result was stored.
 	StoredAs string `json:"storedAs,omitempty" yaml:"storedAs,omitempty"`
+
+	// Artifact is the URI of the artifact holding the step result, if the
+	// step stored its result as an artifact.
+	Artifact string `json:"artifact,omitempty" yaml:"artifact,omitempty"`
 }
 
 //+kubebuilder:object:root=true
-//+kubebuilder:resource:shortName=wfe
+//+kubebuilder:resource:shortName=wfe,categories=muster
 //+kubebuilder:printcolumn:name="Workflow",type="string",JSONPath=".spec.workflowName"
 //+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".spec.status"
 //+kubebuilder:printcolumn:name="Duration(ms)",type="integer",JSONPath=".spec.durationMs"
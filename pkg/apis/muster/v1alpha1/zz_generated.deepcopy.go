@@ -289,6 +289,26 @@ func (in *Workflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowConcurrency) DeepCopyInto(out *WorkflowConcurrency) {
+	*out = *in
+	if in.Mutexes != nil {
+		in, out := &in.Mutexes, &out.Mutexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowConcurrency.
+func (in *WorkflowConcurrency) DeepCopy() *WorkflowConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowCondition) DeepCopyInto(out *WorkflowCondition) {
 	*out = *in
@@ -568,6 +588,11 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(WorkflowConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
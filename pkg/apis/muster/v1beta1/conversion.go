@@ -0,0 +1,440 @@
+package v1beta1
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// The v1beta1 types convert to and from the v1alpha1 hub field by field. A
+// field added to either version must be converted here, or dropped on purpose;
+// the round-trip tests fail until it is. TypeMeta is never converted: the
+// caller sets the apiVersion and kind of the destination.
+
+// ConvertTo converts this MCPServer to the v1alpha1 hub version.
+func (src *MCPServer) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.MCPServer)
+	if !ok {
+		return fmt.Errorf("cannot convert MCPServer to %T", dstRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	convertMCPServerSpecToHub(&src.Spec, &dst.Spec)
+	convertMCPServerStatusToHub(&src.Status, &dst.Status)
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to this MCPServer.
+func (dst *MCPServer) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.MCPServer)
+	if !ok {
+		return fmt.Errorf("cannot convert %T to MCPServer", srcRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	convertMCPServerSpecFromHub(&src.Spec, &dst.Spec)
+	convertMCPServerStatusFromHub(&src.Status, &dst.Status)
+	return nil
+}
+
+// ConvertTo converts this Workflow to the v1alpha1 hub version.
+func (src *Workflow) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.Workflow)
+	if !ok {
+		return fmt.Errorf("cannot convert Workflow to %T", dstRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	convertWorkflowSpecToHub(&src.Spec, &dst.Spec)
+	convertWorkflowStatusToHub(&src.Status, &dst.Status)
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to this Workflow.
+func (dst *Workflow) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.Workflow)
+	if !ok {
+		return fmt.Errorf("cannot convert %T to Workflow", srcRaw)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	convertWorkflowSpecFromHub(&src.Spec, &dst.Spec)
+	convertWorkflowStatusFromHub(&src.Status, &dst.Status)
+	return nil
+}
+
+func convertMCPServerSpecToHub(in *MCPServerSpec, out *v1alpha1.MCPServerSpec) {
+	out.Type = in.Type
+	out.ToolPrefix = in.ToolPrefix
+	out.Family = nil
+	if in.Family != nil {
+		out.Family = &v1alpha1.MCPServerFamily{Name: in.Family.Name, InstanceArg: in.Family.InstanceArg}
+	}
+	out.Description = in.Description
+	out.AutoStart = in.AutoStart
+	out.Command = in.Command
+	out.Args = slices.Clone(in.Args)
+	out.URL = in.URL
+	out.Env = maps.Clone(in.Env)
+	out.Headers = maps.Clone(in.Headers)
+	out.Auth = nil
+	if in.Auth != nil {
+		out.Auth = &v1alpha1.MCPServerAuth{}
+		convertMCPServerAuthToHub(in.Auth, out.Auth)
+	}
+	out.Timeout = in.Timeout
+}
+
+func convertMCPServerSpecFromHub(in *v1alpha1.MCPServerSpec, out *MCPServerSpec) {
+	out.Type = in.Type
+	out.ToolPrefix = in.ToolPrefix
+	out.Family = nil
+	if in.Family != nil {
+		out.Family = &MCPServerFamily{Name: in.Family.Name, InstanceArg: in.Family.InstanceArg}
+	}
+	out.Description = in.Description
+	out.AutoStart = in.AutoStart
+	out.Command = in.Command
+	out.Args = slices.Clone(in.Args)
+	out.URL = in.URL
+	out.Env = maps.Clone(in.Env)
+	out.Headers = maps.Clone(in.Headers)
+	out.Auth = nil
+	if in.Auth != nil {
+		out.Auth = &MCPServerAuth{}
+		convertMCPServerAuthFromHub(in.Auth, out.Auth)
+	}
+	out.Timeout = in.Timeout
+}
+
+func convertMCPServerAuthToHub(in *MCPServerAuth, out *v1alpha1.MCPServerAuth) {
+	out.Type = in.Type
+	out.ForwardToken = in.ForwardToken
+	out.RequiredAudiences = slices.Clone(in.RequiredAudiences)
+	out.TokenExchange = nil
+	if in.TokenExchange != nil {
+		out.TokenExchange = &v1alpha1.TokenExchangeConfig{
+			Enabled:          in.TokenExchange.Enabled,
+			DexTokenEndpoint: in.TokenExchange.DexTokenEndpoint,
+			ExpectedIssuer:   in.TokenExchange.ExpectedIssuer,
+			ConnectorID:      in.TokenExchange.ConnectorID,
+			Scopes:           in.TokenExchange.Scopes,
+		}
+		if ref := in.TokenExchange.ClientCredentialsSecretRef; ref != nil {
+			out.TokenExchange.ClientCredentialsSecretRef = &v1alpha1.ClientCredentialsSecretRef{
+				Name:            ref.Name,
+				Namespace:       ref.Namespace,
+				ClientIDKey:     ref.ClientIDKey,
+				ClientSecretKey: ref.ClientSecretKey,
+			}
+		}
+	}
+	out.AuthorizationServer = nil
+	if in.AuthorizationServer != nil {
+		out.AuthorizationServer = &v1alpha1.MCPServerAuthAuthorizationServer{
+			Issuer: v1alpha1.IssuerURL(in.AuthorizationServer.Issuer),
+			Scopes: in.AuthorizationServer.Scopes,
+		}
+	}
+}
+
+func convertMCPServerAuthFromHub(in *v1alpha1.MCPServerAuth, out *MCPServerAuth) {
+	out.Type = in.Type
+	out.ForwardToken = in.ForwardToken
+	out.RequiredAudiences = slices.Clone(in.RequiredAudiences)
+	out.TokenExchange = nil
+	if in.TokenExchange != nil {
+		out.TokenExchange = &TokenExchangeConfig{
+			Enabled:          in.TokenExchange.Enabled,
+			DexTokenEndpoint: in.TokenExchange.DexTokenEndpoint,
+			ExpectedIssuer:   in.TokenExchange.ExpectedIssuer,
+			ConnectorID:      in.TokenExchange.ConnectorID,
+			Scopes:           in.TokenExchange.Scopes,
+		}
+		if ref := in.TokenExchange.ClientCredentialsSecretRef; ref != nil {
+			out.TokenExchange.ClientCredentialsSecretRef = &ClientCredentialsSecretRef{
+				Name:            ref.Name,
+				Namespace:       ref.Namespace,
+				ClientIDKey:     ref.ClientIDKey,
+				ClientSecretKey: ref.ClientSecretKey,
+			}
+		}
+	}
+	out.AuthorizationServer = nil
+	if in.AuthorizationServer != nil {
+		out.AuthorizationServer = &MCPServerAuthAuthorizationServer{
+			Issuer: IssuerURL(in.AuthorizationServer.Issuer),
+			Scopes: in.AuthorizationServer.Scopes,
+		}
+	}
+}
+
+func convertMCPServerStatusToHub(in *MCPServerStatus, out *v1alpha1.MCPServerStatus) {
+	out.State = v1alpha1.MCPServerStateValue(in.State)
+	out.Health = v1alpha1.MCPServerHealthValue(in.Health)
+	out.Auth = v1alpha1.MCPServerAuthValue(in.Auth)
+	out.LastError = in.LastError
+	out.LastConnected = in.LastConnected.DeepCopy()
+	out.RestartCount = in.RestartCount
+	out.ConsecutiveFailures = in.ConsecutiveFailures
+	out.LastAttempt = in.LastAttempt.DeepCopy()
+	out.NextRetryAfter = in.NextRetryAfter.DeepCopy()
+	out.Conditions = copyConditions(in.Conditions)
+}
+
+func convertMCPServerStatusFromHub(in *v1alpha1.MCPServerStatus, out *MCPServerStatus) {
+	out.State = MCPServerStateValue(in.State)
+	out.Health = MCPServerHealthValue(in.Health)
+	out.Auth = MCPServerAuthValue(in.Auth)
+	out.LastError = in.LastError
+	out.LastConnected = in.LastConnected.DeepCopy()
+	out.RestartCount = in.RestartCount
+	out.ConsecutiveFailures = in.ConsecutiveFailures
+	out.LastAttempt = in.LastAttempt.DeepCopy()
+	out.NextRetryAfter = in.NextRetryAfter.DeepCopy()
+	out.Conditions = copyConditions(in.Conditions)
+}
+
+func convertWorkflowSpecToHub(in *WorkflowSpec, out *v1alpha1.WorkflowSpec) {
+	out.Description = in.Description
+	out.Args = nil
+	if in.Args != nil {
+		out.Args = make(map[string]v1alpha1.ArgDefinition, len(in.Args))
+		for name, arg := range in.Args {
+			out.Args[name] = v1alpha1.ArgDefinition{
+				Type:        arg.Type,
+				Required:    arg.Required,
+				Default:     arg.Default.DeepCopy(),
+				Description: arg.Description,
+			}
+		}
+	}
+	out.Steps = nil
+	if in.Steps != nil {
+		out.Steps = make([]v1alpha1.WorkflowStep, len(in.Steps))
+		for i := range in.Steps {
+			convertWorkflowStepToHub(&in.Steps[i], &out.Steps[i])
+		}
+	}
+	out.OnFailure = convertWorkflowSubStepsToHub(in.OnFailure)
+	out.Output = copyJSONMap(in.Output)
+	out.Concurrency = nil
+	if in.Concurrency != nil {
+		out.Concurrency = &v1alpha1.WorkflowConcurrency{
+			MaxConcurrent: in.Concurrency.MaxConcurrent,
+			Mutexes:       slices.Clone(in.Concurrency.Mutexes),
+		}
+	}
+}
+
+func convertWorkflowSpecFromHub(in *v1alpha1.WorkflowSpec, out *WorkflowSpec) {
+	out.Description = in.Description
+	out.Args = nil
+	if in.Args != nil {
+		out.Args = make(map[string]ArgDefinition, len(in.Args))
+		for name, arg := range in.Args {
+			out.Args[name] = ArgDefinition{
+				Type:        arg.Type,
+				Required:    arg.Required,
+				Default:     arg.Default.DeepCopy(),
+				Description: arg.Description,
+			}
+		}
+	}
+	out.Steps = nil
+	if in.Steps != nil {
+		out.Steps = make([]WorkflowStep, len(in.Steps))
+		for i := range in.Steps {
+			convertWorkflowStepFromHub(&in.Steps[i], &out.Steps[i])
+		}
+	}
+	out.OnFailure = convertWorkflowSubStepsFromHub(in.OnFailure)
+	out.Output = copyJSONMap(in.Output)
+	out.Concurrency = nil
+	if in.Concurrency != nil {
+		out.Concurrency = &WorkflowConcurrency{
+			MaxConcurrent: in.Concurrency.MaxConcurrent,
+			Mutexes:       slices.Clone(in.Concurrency.Mutexes),
+		}
+	}
+}
+
+func convertWorkflowStepToHub(in *WorkflowStep, out *v1alpha1.WorkflowStep) {
+	out.ID = in.ID
+	out.Tool = in.Tool
+	out.Prompt = in.Prompt
+	out.Args = copyJSONMap(in.Args)
+	out.Condition = convertWorkflowConditionToHub(in.Condition)
+	out.ForEach = nil
+	if in.ForEach != nil {
+		out.ForEach = &v1alpha1.WorkflowForEach{
+			Items: in.ForEach.Items,
+			As:    in.ForEach.As,
+			Steps: convertWorkflowSubStepsToHub(in.ForEach.Steps),
+		}
+	}
+	out.Parallel = convertWorkflowSubStepsToHub(in.Parallel)
+	out.Output = copyBool(in.Output)
+	out.Store = in.Store
+	out.Artifact = in.Artifact
+	out.AllowFailure = in.AllowFailure
+	out.Description = in.Description
+}
+
+func convertWorkflowStepFromHub(in *v1alpha1.WorkflowStep, out *WorkflowStep) {
+	out.ID = in.ID
+	out.Tool = in.Tool
+	out.Prompt = in.Prompt
+	out.Args = copyJSONMap(in.Args)
+	out.Condition = convertWorkflowConditionFromHub(in.Condition)
+	out.ForEach = nil
+	if in.ForEach != nil {
+		out.ForEach = &WorkflowForEach{
+			Items: in.ForEach.Items,
+			As:    in.ForEach.As,
+			Steps: convertWorkflowSubStepsFromHub(in.ForEach.Steps),
+		}
+	}
+	out.Parallel = convertWorkflowSubStepsFromHub(in.Parallel)
+	out.Output = copyBool(in.Output)
+	out.Store = in.Store
+	out.Artifact = in.Artifact
+	out.AllowFailure = in.AllowFailure
+	out.Description = in.Description
+}
+
+func convertWorkflowSubStepsToHub(in []WorkflowSubStep) []v1alpha1.WorkflowSubStep {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha1.WorkflowSubStep, len(in))
+	for i, step := range in {
+		out[i] = v1alpha1.WorkflowSubStep{
+			ID:           step.ID,
+			Tool:         step.Tool,
+			Args:         copyJSONMap(step.Args),
+			Condition:    convertWorkflowConditionToHub(step.Condition),
+			Output:       copyBool(step.Output),
+			Store:        step.Store,
+			AllowFailure: step.AllowFailure,
+			Description:  step.Description,
+		}
+	}
+	return out
+}
+
+func convertWorkflowSubStepsFromHub(in []v1alpha1.WorkflowSubStep) []WorkflowSubStep {
+	if in == nil {
+		return nil
+	}
+	out := make([]WorkflowSubStep, len(in))
+	for i, step := range in {
+		out[i] = WorkflowSubStep{
+			ID:           step.ID,
+			Tool:         step.Tool,
+			Args:         copyJSONMap(step.Args),
+			Condition:    convertWorkflowConditionFromHub(step.Condition),
+			Output:       copyBool(step.Output),
+			Store:        step.Store,
+			AllowFailure: step.AllowFailure,
+			Description:  step.Description,
+		}
+	}
+	return out
+}
+
+func convertWorkflowConditionToHub(in *WorkflowCondition) *v1alpha1.WorkflowCondition {
+	if in == nil {
+		return nil
+	}
+	return &v1alpha1.WorkflowCondition{
+		Template:  in.Template,
+		Tool:      in.Tool,
+		Args:      copyJSONMap(in.Args),
+		FromStep:  in.FromStep,
+		Expect:    convertExpectationToHub(in.Expect),
+		ExpectNot: convertExpectationToHub(in.ExpectNot),
+	}
+}
+
+func convertWorkflowConditionFromHub(in *v1alpha1.WorkflowCondition) *WorkflowCondition {
+	if in == nil {
+		return nil
+	}
+	return &WorkflowCondition{
+		Template:  in.Template,
+		Tool:      in.Tool,
+		Args:      copyJSONMap(in.Args),
+		FromStep:  in.FromStep,
+		Expect:    convertExpectationFromHub(in.Expect),
+		ExpectNot: convertExpectationFromHub(in.ExpectNot),
+	}
+}
+
+func convertExpectationToHub(in *WorkflowConditionExpectation) *v1alpha1.WorkflowConditionExpectation {
+	if in == nil {
+		return nil
+	}
+	return &v1alpha1.WorkflowConditionExpectation{
+		Success:  copyBool(in.Success),
+		JsonPath: copyJSONMap(in.JsonPath),
+	}
+}
+
+func convertExpectationFromHub(in *v1alpha1.WorkflowConditionExpectation) *WorkflowConditionExpectation {
+	if in == nil {
+		return nil
+	}
+	return &WorkflowConditionExpectation{
+		Success:  copyBool(in.Success),
+		JsonPath: copyJSONMap(in.JsonPath),
+	}
+}
+
+func convertWorkflowStatusToHub(in *WorkflowStatus, out *v1alpha1.WorkflowStatus) {
+	out.Valid = in.Valid
+	out.ValidationErrors = slices.Clone(in.ValidationErrors)
+	out.ReferencedTools = slices.Clone(in.ReferencedTools)
+	out.StepCount = in.StepCount
+	out.Conditions = copyConditions(in.Conditions)
+}
+
+func convertWorkflowStatusFromHub(in *v1alpha1.WorkflowStatus, out *WorkflowStatus) {
+	out.Valid = in.Valid
+	out.ValidationErrors = slices.Clone(in.ValidationErrors)
+	out.ReferencedTools = slices.Clone(in.ReferencedTools)
+	out.StepCount = in.StepCount
+	out.Conditions = copyConditions(in.Conditions)
+}
+
+func copyJSONMap(in map[string]apiextensionsv1.JSON) map[string]apiextensionsv1.JSON {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]apiextensionsv1.JSON, len(in))
+	for key, value := range in {
+		out[key] = *value.DeepCopy()
+	}
+	return out
+}
+
+func copyConditions(in []metav1.Condition) []metav1.Condition {
+	if in == nil {
+		return nil
+	}
+	out := make([]metav1.Condition, len(in))
+	for i := range in {
+		in[i].DeepCopyInto(&out[i])
+	}
+	return out
+}
+
+func copyBool(in *bool) *bool {
+	if in == nil {
+		return nil
+	}
+	out := *in
+	return &out
+}
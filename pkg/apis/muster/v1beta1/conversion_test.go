@@ -0,0 +1,130 @@
+package v1beta1
+
+import (
+	"testing"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/randfill"
+
+	"github.com/giantswarm/muster/pkg/apis/muster/v1alpha1"
+)
+
+// roundTrips is the number of randomly filled objects each round-trip test
+// converts.
+const roundTrips = 200
+
+// newFiller returns a filler with a fixed seed, so failures reproduce.
+func newFiller() *randfill.Filler {
+	return randfill.NewWithSeed(1).NilChance(0.2).NumElements(0, 3).MaxDepth(8).Funcs(
+		// TypeMeta is set by the caller of a conversion, never converted.
+		func(tm *metav1.TypeMeta, c randfill.Continue) {},
+	)
+}
+
+func TestMCPServerConversion_SpokeRoundTrip(t *testing.T) {
+	filler := newFiller()
+	for i := 0; i < roundTrips; i++ {
+		original := &MCPServer{}
+		filler.Fill(original)
+
+		hub := &v1alpha1.MCPServer{}
+		if err := original.DeepCopy().ConvertTo(hub); err != nil {
+			t.Fatalf("ConvertTo failed: %v", err)
+		}
+		converted := &MCPServer{}
+		if err := converted.ConvertFrom(hub); err != nil {
+			t.Fatalf("ConvertFrom failed: %v", err)
+		}
+
+		if !apiequality.Semantic.DeepEqual(original, converted) {
+			t.Fatalf("v1beta1 -> v1alpha1 -> v1beta1 changed the MCPServer:\n%#v\nbecame\n%#v", original, converted)
+		}
+	}
+}
+
+func TestMCPServerConversion_HubRoundTrip(t *testing.T) {
+	filler := newFiller()
+	for i := 0; i < roundTrips; i++ {
+		original := &v1alpha1.MCPServer{}
+		filler.Fill(original)
+
+		spoke := &MCPServer{}
+		if err := spoke.ConvertFrom(original.DeepCopy()); err != nil {
+			t.Fatalf("ConvertFrom failed: %v", err)
+		}
+		converted := &v1alpha1.MCPServer{}
+		if err := spoke.ConvertTo(converted); err != nil {
+			t.Fatalf("ConvertTo failed: %v", err)
+		}
+
+		if !apiequality.Semantic.DeepEqual(original, converted) {
+			t.Fatalf("v1alpha1 -> v1beta1 -> v1alpha1 changed the MCPServer:\n%#v\nbecame\n%#v", original, converted)
+		}
+	}
+}
+
+func TestWorkflowConversion_SpokeRoundTrip(t *testing.T) {
+	filler := newFiller()
+	for i := 0; i < roundTrips; i++ {
+		original := &Workflow{}
+		filler.Fill(original)
+
+		hub := &v1alpha1.Workflow{}
+		if err := original.DeepCopy().ConvertTo(hub); err != nil {
+			t.Fatalf("ConvertTo failed: %v", err)
+		}
+		converted := &Workflow{}
+		if err := converted.ConvertFrom(hub); err != nil {
+			t.Fatalf("ConvertFrom failed: %v", err)
+		}
+
+		if !apiequality.Semantic.DeepEqual(original, converted) {
+			t.Fatalf("v1beta1 -> v1alpha1 -> v1beta1 changed the Workflow:\n%#v\nbecame\n%#v", original, converted)
+		}
+	}
+}
+
+func TestWorkflowConversion_HubRoundTrip(t *testing.T) {
+	filler := newFiller()
+	for i := 0; i < roundTrips; i++ {
+		original := &v1alpha1.Workflow{}
+		filler.Fill(original)
+
+		spoke := &Workflow{}
+		if err := spoke.ConvertFrom(original.DeepCopy()); err != nil {
+			t.Fatalf("ConvertFrom failed: %v", err)
+		}
+		converted := &v1alpha1.Workflow{}
+		if err := spoke.ConvertTo(converted); err != nil {
+			t.Fatalf("ConvertTo failed: %v", err)
+		}
+
+		if !apiequality.Semantic.DeepEqual(original, converted) {
+			t.Fatalf("v1alpha1 -> v1beta1 -> v1alpha1 changed the Workflow:\n%#v\nbecame\n%#v", original, converted)
+		}
+	}
+}
+
+// TestConversion_DoesNotAlias asserts that converted objects share no memory
+// with their source, so mutating one never changes the other.
+func TestConversion_DoesNotAlias(t *testing.T) {
+	output := true
+	src := &Workflow{
+		Spec: WorkflowSpec{
+			Steps: []WorkflowStep{{ID: "a", Tool: "t", Output: &output}},
+		},
+		Status: WorkflowStatus{ReferencedTools: []string{"t"}},
+	}
+
+	hub := &v1alpha1.Workflow{}
+	if err := src.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+	*hub.Spec.Steps[0].Output = false
+	hub.Status.ReferencedTools[0] = "changed"
+
+	if !*src.Spec.Steps[0].Output || src.Status.ReferencedTools[0] != "t" {
+		t.Errorf("mutating the converted Workflow changed its source: %#v", src)
+	}
+}
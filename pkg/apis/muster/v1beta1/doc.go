@@ -0,0 +1,31 @@
+// Package v1beta1 contains API Schema definitions for the muster v1beta1 API group.
+//
+// v1beta1 is served next to v1alpha1 so the MCPServer and Workflow schemas can
+// evolve without breaking existing v1alpha1 resources. v1alpha1 remains the
+// storage version and the conversion hub: every v1beta1 type implements
+// conversion.Convertible and converts to and from its v1alpha1 counterpart,
+// and the API server converts between the versions through muster's
+// conversion webhook (see internal/conversion).
+//
+// WorkflowExecution is only served as v1alpha1.
+//
+// # API Group: muster.giantswarm.io/v1beta1
+//
+// Example:
+//
+//	apiVersion: muster.giantswarm.io/v1beta1
+//	kind: MCPServer
+//	metadata:
+//	  name: git-tools
+//	  namespace: default
+//	spec:
+//	  type: stdio
+//	  autoStart: true
+//	  toolPrefix: git
+//	  command: npx
+//	  args: ["@modelcontextprotocol/server-git"]
+//	  description: "Git tools MCP server for repository operations"
+//
+// +kubebuilder:object:generate=true
+// +groupName=muster.giantswarm.io
+package v1beta1
@@ -0,0 +1,93 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// attrsHandler nests the attributes of each record under "attrs", keeping
+// the subsystem, error, and correlation ID at the top level, so JSON log entries have a fixed
+// set of top-level fields that log aggregators can index:
+//
+//	{"time":"...","level":"ERROR","msg":"...","subsystem":"Aggregator","error":"...","attrs":{"server":"github"}}
+type attrsHandler struct {
+	next slog.Handler
+
+	// attrs are the attributes added by WithAttrs, already nested in their
+	// groups.
+	attrs []slog.Attr
+
+	// groups are the groups opened by WithGroup, outermost first.
+	groups []string
+}
+
+func newAttrsHandler(next slog.Handler) slog.Handler {
+	return &attrsHandler{next: next}
+}
+
+// isTopLevelAttr reports whether attr stays at the top level of the record.
+func isTopLevelAttr(attr slog.Attr) bool {
+	return attr.Key == "subsystem" || attr.Key == "error" || attr.Key == CorrelationIDKey
+}
+
+func (h *attrsHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *attrsHandler) Handle(ctx context.Context, r slog.Record) error {
+	record := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	var recordAttrs []slog.Attr
+	r.Attrs(func(attr slog.Attr) bool {
+		if len(h.groups) == 0 && isTopLevelAttr(attr) {
+			record.AddAttrs(attr)
+		} else {
+			recordAttrs = append(recordAttrs, attr)
+		}
+		return true
+	})
+
+	attrs := append(append([]slog.Attr{}, h.attrs...), inGroups(recordAttrs, h.groups)...)
+	if len(attrs) > 0 {
+		record.AddAttrs(slog.Attr{Key: "attrs", Value: slog.GroupValue(attrs...)})
+	}
+	return h.next.Handle(ctx, record)
+}
+
+func (h *attrsHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	next := h.next
+	var nested []slog.Attr
+	for _, attr := range attrs {
+		if len(h.groups) == 0 && isTopLevelAttr(attr) {
+			next = next.WithAttrs([]slog.Attr{attr})
+		} else {
+			nested = append(nested, attr)
+		}
+	}
+	return &attrsHandler{
+		next:   next,
+		attrs:  append(append([]slog.Attr{}, h.attrs...), inGroups(nested, h.groups)...),
+		groups: h.groups,
+	}
+}
+
+func (h *attrsHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &attrsHandler{
+		next:   h.next,
+		attrs:  h.attrs,
+		groups: append(append([]string{}, h.groups...), name),
+	}
+}
+
+// inGroups nests attrs in groups, outermost first.
+func inGroups(attrs []slog.Attr, groups []string) []slog.Attr {
+	if len(attrs) == 0 {
+		return nil
+	}
+	for i := len(groups) - 1; i >= 0; i-- {
+		attrs = []slog.Attr{{Key: groups[i], Value: slog.GroupValue(attrs...)}}
+	}
+	return attrs
+}
@@ -0,0 +1,70 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// CorrelationIDKey is the attribute key of the correlation ID in log
+// entries.
+const CorrelationIDKey = "correlationID"
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying the correlation ID id.
+// Log entries written with the context, through the *Ctx functions or
+// slog's *Context methods, include it as the correlationID attribute.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the correlation ID of ctx, or "" if it
+// has none.
+func CorrelationIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// EnsureCorrelationID returns ctx if it carries a correlation ID, and
+// otherwise a copy carrying a new one. It is called where requests enter
+// muster, so all work done for a request shares one ID.
+func EnsureCorrelationID(ctx context.Context) context.Context {
+	if CorrelationIDFromContext(ctx) != "" {
+		return ctx
+	}
+	return WithCorrelationID(ctx, uuid.NewString())
+}
+
+// correlationHandler adds the correlation ID of the context to each record.
+type correlationHandler struct {
+	next slog.Handler
+}
+
+func newCorrelationHandler(next slog.Handler) slog.Handler {
+	return &correlationHandler{next: next}
+}
+
+func (h *correlationHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *correlationHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := CorrelationIDFromContext(ctx); id != "" {
+		r = r.Clone()
+		r.AddAttrs(slog.String(CorrelationIDKey, id))
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *correlationHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &correlationHandler{next: h.next.WithAttrs(attrs)}
+}
+
+func (h *correlationHandler) WithGroup(name string) slog.Handler {
+	return &correlationHandler{next: h.next.WithGroup(name)}
+}
@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEnsureCorrelationID(t *testing.T) {
+	ctx := EnsureCorrelationID(context.Background())
+	id := CorrelationIDFromContext(ctx)
+	if id == "" {
+		t.Fatal("expected a correlation ID")
+	}
+	if got := CorrelationIDFromContext(EnsureCorrelationID(ctx)); got != id {
+		t.Errorf("EnsureCorrelationID replaced %q with %q", id, got)
+	}
+	if CorrelationIDFromContext(context.Background()) != "" {
+		t.Error("expected no correlation ID on a plain context")
+	}
+}
+
+func TestCorrelationID_InLogEntries(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_LOGS_EXPORTER", "")
+
+	var buf bytes.Buffer
+	if _, err := Init(context.Background(), LevelInfo, FormatJSON, &buf, "muster-test", "0.0.0-test"); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	t.Cleanup(func() { defaultLogger = nil })
+
+	ctx := WithCorrelationID(context.Background(), "req-1")
+	InfoCtx(ctx, "Aggregator", "Calling tool %s", "x_github_list")
+	Info("Aggregator", "Without context")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 JSON lines, got %q", buf.String())
+	}
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("line is not JSON: %q", lines[0])
+	}
+	if entry[CorrelationIDKey] != "req-1" {
+		t.Errorf("expected a top-level correlationID, got %v", entry)
+	}
+	if strings.Contains(lines[1], CorrelationIDKey) {
+		t.Errorf("expected no correlationID without a context, got %q", lines[1])
+	}
+}
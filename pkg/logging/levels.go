@@ -0,0 +1,179 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// String returns the name of the level, as accepted by ParseLevel.
+func (l LogLevel) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return "info"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, so levels appear by name in
+// JSON.
+func (l LogLevel) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// ParseLevel parses a level name: debug, info, warn (or warning), or error.
+func ParseLevel(name string) (LogLevel, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	default:
+		return LevelInfo, fmt.Errorf("unknown log level %q (supported: debug, info, warn, error)", name)
+	}
+}
+
+// Levels are the minimum levels of logged entries.
+type Levels struct {
+	// Level applies to subsystems without a level of their own.
+	Level LogLevel `json:"level"`
+
+	// Subsystems are the levels of individual subsystems, by lower-case
+	// subsystem name.
+	Subsystems map[string]LogLevel `json:"subsystems"`
+}
+
+// levelTable holds the minimum levels of logged entries. It is consulted for
+// every entry, so levels can change at runtime without re-initializing the
+// handlers.
+type levelTable struct {
+	mu         sync.RWMutex
+	level      LogLevel
+	subsystems map[string]LogLevel
+}
+
+var levels = &levelTable{level: LevelInfo, subsystems: map[string]LogLevel{}}
+
+// enabled reports whether entries of subsystem at level are logged.
+func (t *levelTable) enabled(subsystem string, level slog.Level) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	minimum := t.level
+	if len(t.subsystems) > 0 && subsystem != "" {
+		if subsystemLevel, ok := t.subsystems[strings.ToLower(subsystem)]; ok {
+			minimum = subsystemLevel
+		}
+	}
+	return level >= minimum.SlogLevel()
+}
+
+// minimum returns the lowest level logged for any subsystem.
+func (t *levelTable) minimum() slog.Level {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	minimum := t.level
+	for _, level := range t.subsystems {
+		minimum = min(minimum, level)
+	}
+	return minimum.SlogLevel()
+}
+
+// SetLevel sets the minimum level of entries logged by subsystems without a
+// level of their own, and by loggers without a subsystem.
+func SetLevel(level LogLevel) {
+	levels.mu.Lock()
+	defer levels.mu.Unlock()
+	levels.level = level
+}
+
+// SetSubsystemLevel sets the minimum level of entries logged by subsystem,
+// e.g. LevelDebug for "Aggregator" alone. Subsystem names are matched
+// case-insensitively.
+func SetSubsystemLevel(subsystem string, level LogLevel) {
+	levels.mu.Lock()
+	defer levels.mu.Unlock()
+	levels.subsystems[strings.ToLower(subsystem)] = level
+}
+
+// ResetSubsystemLevel removes the level of subsystem set by
+// SetSubsystemLevel, so the global level applies to it again.
+func ResetSubsystemLevel(subsystem string) {
+	levels.mu.Lock()
+	defer levels.mu.Unlock()
+	delete(levels.subsystems, strings.ToLower(subsystem))
+}
+
+// GetLevels returns the current global and subsystem levels.
+func GetLevels() Levels {
+	levels.mu.RLock()
+	defer levels.mu.RUnlock()
+	subsystems := make(map[string]LogLevel, len(levels.subsystems))
+	for subsystem, level := range levels.subsystems {
+		subsystems[subsystem] = level
+	}
+	return Levels{Level: levels.level, Subsystems: subsystems}
+}
+
+// levelHandler drops the records below the level of their subsystem, taken
+// from the "subsystem" attribute of the record or of the logger, counts the
+// remaining records in records, if set, and drops those suppressed by the
+// sampling rules.
+type levelHandler struct {
+	next      slog.Handler
+	subsystem string
+	records   metric.Int64Counter
+}
+
+func newLevelHandler(next slog.Handler, records metric.Int64Counter) slog.Handler {
+	return &levelHandler{next: next, records: records}
+}
+
+func (h *levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= levels.minimum() && h.next.Enabled(ctx, level)
+}
+
+func (h *levelHandler) Handle(ctx context.Context, r slog.Record) error {
+	subsystem := h.subsystem
+	r.Attrs(func(attr slog.Attr) bool {
+		if attr.Key == "subsystem" {
+			subsystem = attr.Value.String()
+			return false
+		}
+		return true
+	})
+	if !levels.enabled(subsystem, r.Level) {
+		return nil
+	}
+	countRecord(ctx, h.records, r.Level, subsystem)
+	if !sampling.allow(subsystem, r, h.next) {
+		return nil
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	subsystem := h.subsystem
+	for _, attr := range attrs {
+		if attr.Key == "subsystem" {
+			subsystem = attr.Value.String()
+		}
+	}
+	return &levelHandler{next: h.next.WithAttrs(attrs), subsystem: subsystem, records: h.records}
+}
+
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{next: h.next.WithGroup(name), subsystem: h.subsystem, records: h.records}
+}
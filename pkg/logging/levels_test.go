@@ -0,0 +1,75 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSetSubsystemLevel(t *testing.T) {
+	var buf bytes.Buffer
+	InitForCLI(LevelInfo, &buf)
+	SetSubsystemLevel("Aggregator", LevelDebug)
+	SetSubsystemLevel("events", LevelError)
+	t.Cleanup(func() {
+		ResetSubsystemLevel("Aggregator")
+		ResetSubsystemLevel("events")
+		defaultLogger = nil
+	})
+
+	Debug("Aggregator", "aggregator debug")
+	Debug("Workflow", "workflow debug")
+	Info("Workflow", "workflow info")
+	Warn("events", "events warning")
+	Error("events", nil, "events error")
+	defaultLogger.With("subsystem", "aggregator").Debug("attrs debug")
+
+	output := buf.String()
+	for _, want := range []string{"aggregator debug", "workflow info", "events error", "attrs debug"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output:\n%s", want, output)
+		}
+	}
+	for _, unwanted := range []string{"workflow debug", "events warning"} {
+		if strings.Contains(output, unwanted) {
+			t.Errorf("expected no %q in output:\n%s", unwanted, output)
+		}
+	}
+
+	buf.Reset()
+	ResetSubsystemLevel("AGGREGATOR")
+	Debug("Aggregator", "aggregator debug")
+	if buf.Len() != 0 {
+		t.Errorf("expected the global level after reset, got %q", buf.String())
+	}
+}
+
+func TestGetLevels(t *testing.T) {
+	SetLevel(LevelWarn)
+	SetSubsystemLevel("Aggregator", LevelDebug)
+	t.Cleanup(func() {
+		SetLevel(LevelInfo)
+		ResetSubsystemLevel("Aggregator")
+	})
+
+	data, err := json.Marshal(GetLevels())
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(data) != `{"level":"warn","subsystems":{"aggregator":"debug"}}` {
+		t.Errorf("GetLevels() = %s", data)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for name, want := range map[string]LogLevel{"debug": LevelDebug, "INFO": LevelInfo, "warning": LevelWarn, "error": LevelError} {
+		got, err := ParseLevel(name)
+		if err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v; want %v", name, got, err, want)
+		}
+	}
+	if _, err := ParseLevel("trace"); err == nil {
+		t.Error("expected an error for an unknown level")
+	}
+}
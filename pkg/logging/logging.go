@@ -42,6 +42,34 @@ func (l LogLevel) SlogLevel() slog.Level {
 	}
 }
 
+// Format selects the log output format.
+type Format string
+
+const (
+	// FormatAuto writes JSON inside a Kubernetes pod (KUBERNETES_SERVICE_HOST
+	// set) and text otherwise.
+	FormatAuto Format = ""
+
+	// FormatText writes one logfmt-style line per entry.
+	FormatText Format = "text"
+
+	// FormatJSON writes one JSON object per entry, with the subsystem, level,
+	// message, and error at the top level and all other attributes nested
+	// under "attrs".
+	FormatJSON Format = "json"
+)
+
+// ParseFormat parses a log format name, "text" or "json". The empty string
+// is FormatAuto.
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(name)); format {
+	case FormatAuto, FormatText, FormatJSON:
+		return format, nil
+	default:
+		return FormatAuto, fmt.Errorf("unknown log format %q (supported: text, json)", name)
+	}
+}
+
 var defaultLogger *slog.Logger
 
 // initControllerRuntimeLogger initializes the controller-runtime logger using the provided slog handler.
@@ -78,9 +106,11 @@ func InitForCLI(filterLevel LogLevel, output io.Writer) {
 	// is a no-op closure and the error is always nil.
 	logger, _, _ := mcptoolkitlogging.Init(context.Background(),
 		mcptoolkitlogging.WithFormat(mcptoolkitlogging.FormatText),
-		mcptoolkitlogging.WithLevel(filterLevel.SlogLevel()),
+		mcptoolkitlogging.WithLevel(slog.LevelDebug),
 		mcptoolkitlogging.WithOutput(output),
 	)
+	SetLevel(filterLevel)
+	logger = slog.New(newLevelHandler(newCorrelationHandler(logger.Handler()), nil))
 	defaultLogger = logger
 	slog.SetDefault(logger)
 	initControllerRuntimeLogger(logger.Handler())
@@ -101,17 +131,45 @@ func InitForCLI(filterLevel LogLevel, output io.Writer) {
 // semconv.ServiceVersion on the OTel LoggerProvider's Resource;
 // standard env overrides (OTEL_SERVICE_NAME, OTEL_RESOURCE_ATTRIBUTES)
 // take precedence.
-func Init(ctx context.Context, filterLevel LogLevel, output io.Writer, serviceName, serviceVersion string) (Shutdown, error) {
-	logger, shutdown, err := mcptoolkitlogging.Init(ctx,
-		mcptoolkitlogging.WithLevel(filterLevel.SlogLevel()),
+//
+// In OTLP mode the entries are still written to output, in the format of
+// the non-OTLP handler, unless output is io.Discard, so the console and a
+// log file keep working alongside the collector.
+//
+// filterLevel is the global level; SetLevel and SetSubsystemLevel change
+// the levels at runtime. format overrides the text/JSON choice of the
+// non-OTLP handler; FormatJSON also nests the attributes of each record
+// under "attrs". Every written entry is counted in the LogRecordsMetric
+// counter of the global MeterProvider.
+func Init(ctx context.Context, filterLevel LogLevel, format Format, output io.Writer, serviceName, serviceVersion string) (Shutdown, error) {
+	// The handlers pass all levels; levelHandler filters by subsystem
+	opts := []mcptoolkitlogging.Option{
+		mcptoolkitlogging.WithLevel(slog.LevelDebug),
 		mcptoolkitlogging.WithOutput(output),
 		mcptoolkitlogging.WithLoggerName("github.com/giantswarm/muster"),
 		mcptoolkitlogging.WithServiceName(serviceName),
 		mcptoolkitlogging.WithServiceVersion(serviceVersion),
-	)
+	}
+	switch format {
+	case FormatText:
+		opts = append(opts, mcptoolkitlogging.WithFormat(mcptoolkitlogging.FormatText))
+	case FormatJSON:
+		opts = append(opts, mcptoolkitlogging.WithFormat(mcptoolkitlogging.FormatJSON))
+	}
+	otlp := OTLPEnabled()
+	if otlp && output != io.Discard {
+		opts = append(opts, mcptoolkitlogging.WithExtraHandlers(consoleHandler(format, output)))
+	}
+	logger, shutdown, err := mcptoolkitlogging.Init(ctx, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("init toolkit logging: %w", err)
 	}
+	handler := logger.Handler()
+	if format == FormatJSON && !otlp {
+		handler = newAttrsHandler(handler)
+	}
+	SetLevel(filterLevel)
+	logger = slog.New(newLevelHandler(newCorrelationHandler(handler), newLogRecordsCounter()))
 	defaultLogger = logger
 	slog.SetDefault(logger)
 	initControllerRuntimeLogger(logger.Handler())
@@ -119,8 +177,9 @@ func Init(ctx context.Context, filterLevel LogLevel, output io.Writer, serviceNa
 }
 
 func logInternal(ctx context.Context, level LogLevel, subsystem string, err error, messageFmt string, args ...interface{}) {
-	// Check if the level is enabled by the configured handler before proceeding.
-	if defaultLogger == nil || !defaultLogger.Enabled(ctx, level.SlogLevel()) {
+	// Check if the level is enabled for the subsystem and by the configured
+	// handler before proceeding.
+	if defaultLogger == nil || !levels.enabled(subsystem, level.SlogLevel()) || !defaultLogger.Enabled(ctx, level.SlogLevel()) {
 		return
 	}
 
@@ -211,7 +270,7 @@ func WarnWithAttrsCtx(ctx context.Context, subsystem string, msg string, attrs .
 }
 
 func logWithAttrs(ctx context.Context, level slog.Level, subsystem string, msg string, attrs ...slog.Attr) {
-	if defaultLogger == nil || !defaultLogger.Enabled(ctx, level) {
+	if defaultLogger == nil || !levels.enabled(subsystem, level) || !defaultLogger.Enabled(ctx, level) {
 		return
 	}
 	allAttrs := make([]slog.Attr, 0, len(attrs)+1)
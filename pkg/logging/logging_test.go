@@ -3,6 +3,8 @@ package logging
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"log/slog"
 	"strings"
 	"testing"
@@ -123,7 +125,7 @@ func TestInit_NoOTLPEnv_NoOpShutdown(t *testing.T) {
 	t.Setenv("OTEL_LOGS_EXPORTER", "")
 
 	var buf bytes.Buffer
-	shutdown, err := Init(context.Background(), LevelInfo, &buf, "muster-test", "0.0.0-test")
+	shutdown, err := Init(context.Background(), LevelInfo, FormatAuto, &buf, "muster-test", "0.0.0-test")
 	if err != nil {
 		t.Fatalf("Init: %v", err)
 	}
@@ -139,6 +141,69 @@ func TestInit_NoOTLPEnv_NoOpShutdown(t *testing.T) {
 	}
 }
 
+func TestInit_JSONFormat(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_LOGS_EXPORTER", "")
+
+	var buf bytes.Buffer
+	if _, err := Init(context.Background(), LevelInfo, FormatJSON, &buf, "muster-test", "0.0.0-test"); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	t.Cleanup(func() { defaultLogger = nil })
+
+	Error("Aggregator", errors.New("connection refused"), "Failed to connect")
+	InfoWithAttrs("Aggregator", "Connected", slog.String("server", "github"), slog.Int("tools", 12))
+	defaultLogger.With("session", "abc").WithGroup("request").Info("Handled", "status", 200)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 JSON lines, got %q", buf.String())
+	}
+	var entries []map[string]interface{}
+	for _, line := range lines {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("line is not JSON: %q", line)
+		}
+		entries = append(entries, entry)
+	}
+
+	if entries[0]["level"] != "ERROR" || entries[0]["subsystem"] != "Aggregator" ||
+		entries[0]["error"] != "connection refused" || entries[0]["msg"] != "Failed to connect" {
+		t.Errorf("error entry = %v", entries[0])
+	}
+	if _, ok := entries[0]["attrs"]; ok {
+		t.Errorf("expected no attrs without attributes, got %v", entries[0])
+	}
+
+	attrs, _ := entries[1]["attrs"].(map[string]interface{})
+	if entries[1]["subsystem"] != "Aggregator" || attrs["server"] != "github" || attrs["tools"] != float64(12) {
+		t.Errorf("attrs entry = %v", entries[1])
+	}
+	if _, ok := entries[1]["server"]; ok {
+		t.Errorf("expected server only under attrs, got %v", entries[1])
+	}
+
+	attrs, _ = entries[2]["attrs"].(map[string]interface{})
+	request, _ := attrs["request"].(map[string]interface{})
+	if attrs["session"] != "abc" || request["status"] != float64(200) {
+		t.Errorf("grouped entry = %v", entries[2])
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, want := range map[string]Format{"": FormatAuto, "text": FormatText, "JSON": FormatJSON} {
+		got, err := ParseFormat(name)
+		if err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", name, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("yaml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
 func TestInfoCtx_PassesContextThroughToHandler(t *testing.T) {
 	type ctxKey string
 	const probeKey ctxKey = "probe-key"
@@ -0,0 +1,69 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/giantswarm/muster/pkg/observability"
+)
+
+// LogRecordsMetric is the name of the counter of written log entries, with
+// "level" and "subsystem" attributes. Its rate at level "error" is the error
+// rate of a subsystem.
+const LogRecordsMetric = "muster.log.records"
+
+// OTLPEnabled reports whether Init exports log records with OTLP, i.e.
+// whether any of OTEL_EXPORTER_OTLP_LOGS_ENDPOINT,
+// OTEL_EXPORTER_OTLP_ENDPOINT, or OTEL_LOGS_EXPORTER is set.
+func OTLPEnabled() bool {
+	return os.Getenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_LOGS_EXPORTER") != ""
+}
+
+// consoleHandler returns the handler that writes entries to output next to
+// the OTLP handler, in the format the toolkit would use without OTLP: JSON
+// inside a Kubernetes pod and text otherwise, unless format says otherwise.
+func consoleHandler(format Format, output io.Writer) slog.Handler {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	switch {
+	case format == FormatJSON:
+		return newAttrsHandler(slog.NewJSONHandler(output, opts))
+	case format == FormatAuto && os.Getenv("KUBERNETES_SERVICE_HOST") != "":
+		return slog.NewJSONHandler(output, opts)
+	default:
+		return slog.NewTextHandler(output, opts)
+	}
+}
+
+// newLogRecordsCounter creates the LogRecordsMetric counter on the global
+// MeterProvider. The counter follows the provider installed later by the
+// metrics initialization; it is nil if it cannot be created.
+func newLogRecordsCounter() metric.Int64Counter {
+	counter, err := otel.Meter(observability.TracerName).Int64Counter(LogRecordsMetric,
+		metric.WithDescription("Number of log entries written by muster."),
+		metric.WithUnit("{record}"),
+	)
+	if err != nil {
+		return nil
+	}
+	return counter
+}
+
+// countRecord adds a record of subsystem at level to counter, if set.
+func countRecord(ctx context.Context, counter metric.Int64Counter, level slog.Level, subsystem string) {
+	if counter == nil {
+		return
+	}
+	counter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("level", strings.ToLower(level.String())),
+		attribute.String("subsystem", subsystem),
+	))
+}
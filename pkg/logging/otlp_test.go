@@ -0,0 +1,87 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestInit_CountsLogRecords(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_LOGS_EXPORTER", "")
+
+	reader := sdkmetric.NewManualReader()
+	prev := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(prev) })
+
+	if _, err := Init(context.Background(), LevelInfo, FormatText, io.Discard, "muster-test", "0.0.0-test"); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	t.Cleanup(func() { defaultLogger = nil })
+
+	Error("Aggregator", nil, "first failure")
+	Error("Aggregator", nil, "second failure")
+	Info("Workflow", "started")
+	Debug("Workflow", "filtered out")
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	counts := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != LogRecordsMetric {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				level, _ := dp.Attributes.Value(attribute.Key("level"))
+				subsystem, _ := dp.Attributes.Value(attribute.Key("subsystem"))
+				counts[level.AsString()+"/"+subsystem.AsString()] = dp.Value
+			}
+		}
+	}
+	want := map[string]int64{"error/Aggregator": 2, "info/Workflow": 1}
+	if len(counts) != len(want) {
+		t.Fatalf("counts = %v, want %v", counts, want)
+	}
+	for key, value := range want {
+		if counts[key] != value {
+			t.Errorf("counts[%s] = %d, want %d", key, counts[key], value)
+		}
+	}
+}
+
+func TestInit_OTLPKeepsConsoleOutput(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_LOGS_EXPORTER", "none")
+
+	var buf bytes.Buffer
+	shutdown, err := Init(context.Background(), LevelInfo, FormatJSON, &buf, "muster-test", "0.0.0-test")
+	if err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = shutdown(context.Background())
+		defaultLogger = nil
+	})
+	if !OTLPEnabled() {
+		t.Fatal("expected OTLP mode")
+	}
+
+	InfoWithAttrs("Aggregator", "Connected", slog.String("server", "github"))
+	if !strings.Contains(buf.String(), `"msg":"Connected"`) || !strings.Contains(buf.String(), `"attrs":{"server":"github"}`) {
+		t.Errorf("expected the entry on the console in JSON, got %q", buf.String())
+	}
+}
@@ -0,0 +1,201 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxFileSize is the size at which a log file is rotated when
+	// RotateOptions.MaxSize is not set.
+	DefaultMaxFileSize = 100 * 1024 * 1024
+
+	// DefaultMaxBackups is the number of rotated log files kept when
+	// RotateOptions.MaxBackups is not set.
+	DefaultMaxBackups = 5
+
+	// backupTimeFormat is the timestamp inserted into the names of rotated
+	// files, e.g. muster-2026-10-16T09-00-00.000.log. It sorts
+	// chronologically.
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+)
+
+// RotateOptions configures when a RotatingFile is rotated and how many
+// rotated files are kept.
+type RotateOptions struct {
+	// MaxSize is the size in bytes a file may grow to before it is rotated
+	// (default: DefaultMaxFileSize).
+	MaxSize int64
+
+	// Interval rotates the file once it has been written to for this long,
+	// e.g. 24h for daily files. Zero rotates by size only.
+	Interval time.Duration
+
+	// MaxBackups is the number of rotated files kept (default:
+	// DefaultMaxBackups). Negative keeps all of them.
+	MaxBackups int
+
+	// MaxAge deletes rotated files older than this. Zero keeps them
+	// regardless of age.
+	MaxAge time.Duration
+}
+
+// RotatingFile is an io.WriteCloser that appends to a log file and rotates
+// it by size and time. A rotated file is renamed with the time of rotation
+// inserted before the extension, and the oldest rotated files are deleted
+// beyond MaxBackups and MaxAge, so a long-running muster serve needs no
+// external logrotate.
+type RotatingFile struct {
+	path string
+	opts RotateOptions
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+
+	// now returns the current time; replaced in tests.
+	now func() time.Time
+}
+
+// OpenRotatingFile opens the log file at path for appending, creating it and
+// its directory if needed.
+func OpenRotatingFile(path string, opts RotateOptions) (*RotatingFile, error) {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultMaxFileSize
+	}
+	if opts.MaxBackups == 0 {
+		opts.MaxBackups = DefaultMaxBackups
+	}
+	f := &RotatingFile{path: path, opts: opts, now: time.Now}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// open opens the log file and records its size. Caller must hold mu, or
+// have exclusive access.
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+	f.file = file
+	f.size = info.Size()
+	f.openedAt = f.now()
+	return nil
+}
+
+// Write implements io.Writer, rotating the file first if p would make it
+// exceed MaxSize or it was opened more than Interval ago. Writes are never
+// split across files.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if f.size > 0 && (f.size+int64(len(p)) > f.opts.MaxSize ||
+		(f.opts.Interval > 0 && f.now().Sub(f.openedAt) >= f.opts.Interval)) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Close implements io.Closer.
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+// rotate renames the current file to a backup, opens a new file, and prunes
+// the backups. Caller must hold mu.
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file: %w", err)
+	}
+	f.file = nil
+
+	ext := filepath.Ext(f.path)
+	backup := strings.TrimSuffix(f.path, ext) + "-" + f.now().Format(backupTimeFormat) + ext
+	if err := os.Rename(f.path, backup); err != nil {
+		return fmt.Errorf("failed to rotate log file: %w", err)
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	f.prune()
+	return nil
+}
+
+// prune deletes the backups beyond MaxBackups and older than MaxAge.
+// Failures are ignored, since logging them would write to this file.
+func (f *RotatingFile) prune() {
+	backups := f.backups()
+	// Newest first
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+
+	cutoff := time.Time{}
+	if f.opts.MaxAge > 0 {
+		cutoff = f.now().Add(-f.opts.MaxAge)
+	}
+	for i, backup := range backups {
+		expired := false
+		if !cutoff.IsZero() {
+			if info, err := os.Stat(backup); err == nil && info.ModTime().Before(cutoff) {
+				expired = true
+			}
+		}
+		if expired || (f.opts.MaxBackups > 0 && i >= f.opts.MaxBackups) {
+			_ = os.Remove(backup)
+		}
+	}
+}
+
+// backups returns the paths of the rotated files of the log file.
+func (f *RotatingFile) backups() []string {
+	ext := filepath.Ext(f.path)
+	prefix := filepath.Base(strings.TrimSuffix(f.path, ext)) + "-"
+	entries, err := os.ReadDir(filepath.Dir(f.path))
+	if err != nil {
+		return nil
+	}
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		stamp := strings.TrimSuffix(strings.TrimPrefix(name, prefix), ext)
+		if _, err := time.Parse(backupTimeFormat, stamp); err == nil {
+			backups = append(backups, filepath.Join(filepath.Dir(f.path), name))
+		}
+	}
+	return backups
+}
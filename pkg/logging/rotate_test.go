@@ -0,0 +1,114 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRotatingFile_RotatesBySize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "muster.log")
+	f, err := OpenRotatingFile(path, RotateOptions{MaxSize: 10, MaxBackups: 2})
+	if err != nil {
+		t.Fatalf("OpenRotatingFile: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	f.now = func() time.Time { return now }
+	for _, line := range []string{"line 1\n", "line 2\n", "line 3\n", "line 4\n"} {
+		now = now.Add(time.Second)
+		if _, err := f.Write([]byte(line)); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if string(data) != "line 4\n" {
+		t.Errorf("current file = %q, want the last line", data)
+	}
+
+	// Three rotations, of which the two newest backups are kept
+	backups := f.backups()
+	if len(backups) != 2 {
+		t.Fatalf("backups = %v, want 2", backups)
+	}
+	for i, want := range []string{"muster-2026-10-16T09-00-03.000.log", "muster-2026-10-16T09-00-04.000.log"} {
+		if filepath.Base(backups[i]) != want {
+			t.Errorf("backup %d = %s, want %s", i, filepath.Base(backups[i]), want)
+		}
+	}
+	data, _ = os.ReadFile(backups[1])
+	if string(data) != "line 3\n" {
+		t.Errorf("newest backup = %q", data)
+	}
+}
+
+func TestRotatingFile_RotatesByInterval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "muster.log")
+	f, err := OpenRotatingFile(path, RotateOptions{Interval: 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("OpenRotatingFile: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	f.now = func() time.Time { return now }
+	f.openedAt = now
+
+	_, _ = f.Write([]byte("day 1\n"))
+	now = now.Add(23 * time.Hour)
+	_, _ = f.Write([]byte("day 1 again\n"))
+	if len(f.backups()) != 0 {
+		t.Fatal("rotated before the interval elapsed")
+	}
+	now = now.Add(time.Hour)
+	_, _ = f.Write([]byte("day 2\n"))
+	if len(f.backups()) != 1 {
+		t.Fatalf("backups = %v, want 1", f.backups())
+	}
+}
+
+func TestRotatingFile_PrunesByAge(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "muster.log")
+	old := filepath.Join(dir, "muster-2026-09-01T00-00-00.000.log")
+	if err := os.WriteFile(old, []byte("old\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	oldTime := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	if err := os.Chtimes(old, oldTime, oldTime); err != nil {
+		t.Fatal(err)
+	}
+	unrelated := filepath.Join(dir, "muster-notes.log")
+	if err := os.WriteFile(unrelated, []byte("keep\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenRotatingFile(path, RotateOptions{MaxSize: 4, MaxAge: 7 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("OpenRotatingFile: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+	f.now = func() time.Time { return time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC) }
+
+	_, _ = f.Write([]byte("one\n"))
+	_, _ = f.Write([]byte("two\n"))
+
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Error("expected the expired backup to be deleted")
+	}
+	if _, err := os.Stat(unrelated); err != nil {
+		t.Error("expected files that are not backups to be kept")
+	}
+	backups := f.backups()
+	if len(backups) != 1 || !strings.HasSuffix(backups[0], "muster-2026-10-16T09-00-00.000.log") {
+		t.Errorf("backups = %v", backups)
+	}
+}
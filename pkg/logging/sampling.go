@@ -0,0 +1,211 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSamplingInterval is the window of a SamplingRule without an
+// Interval.
+const DefaultSamplingInterval = time.Minute
+
+// SamplingRule collapses bursts of repeated log entries. Of the entries it
+// matches, the first Burst in each Interval are written; the rest are
+// suppressed and reported by one summary entry at the end of the interval.
+type SamplingRule struct {
+	// Subsystem is the subsystem of the matched entries, matched
+	// case-insensitively. Empty matches all subsystems.
+	Subsystem string `json:"subsystem,omitempty"`
+
+	// Message matches the entries whose message contains it, e.g. "ID token
+	// forwarding failed". Empty matches all entries of Subsystem.
+	Message string `json:"message,omitempty"`
+
+	// Interval is the window in which at most Burst entries are written
+	// (default: DefaultSamplingInterval).
+	Interval time.Duration `json:"interval,omitempty"`
+
+	// Burst is the number of entries written per Interval (default: 1).
+	Burst int `json:"burst,omitempty"`
+}
+
+// DefaultSamplingRules are the sampling rules of muster serve when none are
+// configured. They collapse the warnings that auth retry loops log for every
+// attempt.
+var DefaultSamplingRules = []SamplingRule{
+	{Subsystem: "Connection", Message: "ID token forwarding failed"},
+	{Subsystem: "Connection", Message: "Token exchange failed"},
+	{Subsystem: "Connection", Message: "Token re-exchange failed"},
+}
+
+// matches reports whether the rule applies to an entry of subsystem with
+// message.
+func (r SamplingRule) matches(subsystem, message string) bool {
+	if r.Subsystem != "" && !strings.EqualFold(r.Subsystem, subsystem) {
+		return false
+	}
+	return r.Message == "" || strings.Contains(message, r.Message)
+}
+
+// sampleWindow is the state of a sampling rule for one subsystem.
+type sampleWindow struct {
+	start   time.Time
+	written int
+
+	// suppressed counts the entries suppressed in the window; last is the
+	// latest of them, and handler the handler it was suppressed in.
+	suppressed int
+	last       slog.Record
+	handler    slog.Handler
+	timer      sampleTimer
+}
+
+// sampleTimer is the timer writing the summary of a window, a *time.Timer.
+type sampleTimer interface {
+	Stop() bool
+}
+
+// samplingTable holds the sampling rules and the windows of the entries
+// they matched. Like levelTable, it is consulted for every entry.
+type samplingTable struct {
+	mu      sync.Mutex
+	rules   []SamplingRule
+	windows map[string]*sampleWindow
+
+	// now returns the current time, and afterFunc calls f in its own
+	// goroutine after d; replaced in tests.
+	now       func() time.Time
+	afterFunc func(d time.Duration, f func()) sampleTimer
+}
+
+var sampling = &samplingTable{windows: map[string]*sampleWindow{}, now: time.Now, afterFunc: afterFunc}
+
+// afterFunc is time.AfterFunc.
+func afterFunc(d time.Duration, f func()) sampleTimer {
+	return time.AfterFunc(d, f)
+}
+
+// SetSamplingRules replaces the sampling rules. Entries are matched against
+// the rules in order and sampled by the first matching rule. Pending
+// summaries of the previous rules are dropped.
+func SetSamplingRules(rules []SamplingRule) {
+	sampling.mu.Lock()
+	defer sampling.mu.Unlock()
+	for _, window := range sampling.windows {
+		if window.timer != nil {
+			window.timer.Stop()
+		}
+	}
+	sampling.rules = append([]SamplingRule(nil), rules...)
+	sampling.windows = map[string]*sampleWindow{}
+}
+
+// GetSamplingRules returns the current sampling rules.
+func GetSamplingRules() []SamplingRule {
+	sampling.mu.Lock()
+	defer sampling.mu.Unlock()
+	return append([]SamplingRule(nil), sampling.rules...)
+}
+
+// allow reports whether the entry r of subsystem is written. A suppressed
+// entry is remembered for the summary, which is written to handler at the
+// end of the window.
+func (t *samplingTable) allow(subsystem string, r slog.Record, handler slog.Handler) bool {
+	t.mu.Lock()
+	if len(t.rules) == 0 {
+		t.mu.Unlock()
+		return true
+	}
+	index := -1
+	for i, rule := range t.rules {
+		if rule.matches(subsystem, r.Message) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		t.mu.Unlock()
+		return true
+	}
+	rule := t.rules[index]
+	interval := rule.Interval
+	if interval <= 0 {
+		interval = DefaultSamplingInterval
+	}
+	burst := max(rule.Burst, 1)
+
+	key := fmt.Sprintf("%d/%s", index, strings.ToLower(subsystem))
+	now := t.now()
+	window := t.windows[key]
+	var summary func()
+	if window == nil || !now.Before(window.start.Add(interval)) {
+		if window != nil {
+			summary = t.takeSummary(window, interval)
+		}
+		window = &sampleWindow{start: now}
+		t.windows[key] = window
+	}
+
+	allowed := window.written < burst
+	if allowed {
+		window.written++
+	} else {
+		window.suppressed++
+		window.last = r.Clone()
+		window.handler = handler
+		if window.timer == nil {
+			window.timer = t.afterFunc(window.start.Add(interval).Sub(now), func() {
+				t.mu.Lock()
+				flush := t.takeSummary(window, interval)
+				t.mu.Unlock()
+				if flush != nil {
+					flush()
+				}
+			})
+		}
+	}
+	t.mu.Unlock()
+
+	// Written outside the lock, so a slow writer doesn't hold up the
+	// entries of other subsystems
+	if summary != nil {
+		summary()
+	}
+	return allowed
+}
+
+// takeSummary resets the suppressed entries of window and returns a function
+// writing their summary, or nil if none were suppressed. Caller must hold
+// mu.
+func (t *samplingTable) takeSummary(window *sampleWindow, interval time.Duration) func() {
+	if window.timer != nil {
+		window.timer.Stop()
+		window.timer = nil
+	}
+	if window.suppressed == 0 {
+		return nil
+	}
+	last, handler, suppressed := window.last, window.handler, window.suppressed
+	window.suppressed = 0
+	window.handler = nil
+
+	record := slog.NewRecord(t.now(), last.Level, "Suppressed repeated log entries", 0)
+	last.Attrs(func(attr slog.Attr) bool {
+		if attr.Key == "subsystem" {
+			record.AddAttrs(attr)
+		}
+		return true
+	})
+	record.AddAttrs(
+		slog.Int("suppressed", suppressed),
+		slog.String("interval", interval.String()),
+		slog.String("lastMessage", last.Message),
+	)
+	return func() {
+		_ = handler.Handle(context.Background(), record)
+	}
+}
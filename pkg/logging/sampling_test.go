@@ -0,0 +1,104 @@
+package logging
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSampling_CollapsesBursts(t *testing.T) {
+	var buf bytes.Buffer
+	InitForCLI(LevelInfo, &buf)
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	sampling.now = func() time.Time { return now }
+	SetSamplingRules([]SamplingRule{{Subsystem: "connection", Message: "ID token forwarding failed", Burst: 2}})
+	t.Cleanup(func() {
+		SetSamplingRules(nil)
+		sampling.now = time.Now
+		defaultLogger = nil
+	})
+
+	for i := 0; i < 5; i++ {
+		Warn("Connection", "ID token forwarding failed for user alice to server github: attempt %d", i)
+	}
+	Warn("Connection", "Failed to store capabilities")
+	Warn("Aggregator", "ID token forwarding failed elsewhere")
+
+	output := buf.String()
+	if got := strings.Count(output, "ID token forwarding failed for user"); got != 2 {
+		t.Errorf("expected the burst of 2 entries, got %d:\n%s", got, output)
+	}
+	for _, want := range []string{"Failed to store capabilities", "ID token forwarding failed elsewhere"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected unmatched entry %q in output:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "Suppressed") {
+		t.Errorf("expected no summary within the interval:\n%s", output)
+	}
+
+	// The next entry after the interval writes the summary first
+	buf.Reset()
+	now = now.Add(DefaultSamplingInterval)
+	Warn("Connection", "ID token forwarding failed for user alice to server github: attempt 5")
+	output = buf.String()
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected a summary and the entry, got:\n%s", output)
+	}
+	for _, want := range []string{"Suppressed repeated log entries", "subsystem=Connection", "suppressed=3", "interval=1m0s", "attempt 4"} {
+		if !strings.Contains(lines[0], want) {
+			t.Errorf("expected %q in summary %q", want, lines[0])
+		}
+	}
+	if !strings.Contains(lines[1], "attempt 5") {
+		t.Errorf("expected the new entry after the summary, got %q", lines[1])
+	}
+}
+
+// fakeTimer is a sampleTimer fired by the test.
+type fakeTimer struct {
+	d time.Duration
+	f func()
+}
+
+func (t *fakeTimer) Stop() bool { return true }
+
+func TestSampling_SummaryAtEndOfInterval(t *testing.T) {
+	var buf bytes.Buffer
+	InitForCLI(LevelInfo, &buf)
+	var timers []*fakeTimer
+	sampling.afterFunc = func(d time.Duration, f func()) sampleTimer {
+		timer := &fakeTimer{d: d, f: f}
+		timers = append(timers, timer)
+		return timer
+	}
+	SetSamplingRules([]SamplingRule{{Message: "retrying", Interval: 20 * time.Millisecond}})
+	t.Cleanup(func() {
+		SetSamplingRules(nil)
+		sampling.afterFunc = afterFunc
+		defaultLogger = nil
+	})
+
+	for i := 0; i < 3; i++ {
+		Warn("OAuth", "retrying refresh")
+	}
+	if len(timers) != 1 {
+		t.Fatalf("expected a summary timer for the suppressed entries, got %d", len(timers))
+	}
+	if timers[0].d <= 0 || timers[0].d > 20*time.Millisecond {
+		t.Errorf("expected the summary by the end of the interval, got it in %s", timers[0].d)
+	}
+	if strings.Contains(buf.String(), "Suppressed") {
+		t.Errorf("expected no summary before the end of the interval:\n%s", buf.String())
+	}
+
+	timers[0].f()
+	if !strings.Contains(buf.String(), "suppressed=2") {
+		t.Errorf("expected a summary of 2 suppressed entries, got:\n%s", buf.String())
+	}
+	if got := strings.Count(buf.String(), "msg=\"retrying refresh\""); got != 1 {
+		t.Errorf("expected 1 written entry, got %d:\n%s", got, buf.String())
+	}
+}
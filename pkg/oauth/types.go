@@ -335,6 +335,68 @@ const (
 	SessionServerStatusReauthRequired SessionServerStatus = "reauth_required"
 )
 
+// AuthFailureCategory classifies why a user cannot use an MCP server, in the
+// FailureCategory field of ServerAuthStatus.
+type AuthFailureCategory string
+
+const (
+	// AuthFailureNotAuthenticated indicates the user has not authenticated to
+	// the server yet.
+	AuthFailureNotAuthenticated AuthFailureCategory = "not_authenticated"
+
+	// AuthFailureLoginFailed indicates the last login, token forwarding, or
+	// token exchange for the server failed.
+	AuthFailureLoginFailed AuthFailureCategory = "login_failed"
+
+	// AuthFailureTokenExpired indicates the server rejected the user's token
+	// as expired or invalid.
+	AuthFailureTokenExpired AuthFailureCategory = "token_expired"
+
+	// AuthFailureScopeMismatch indicates the server rejected the scopes of
+	// the user's token.
+	AuthFailureScopeMismatch AuthFailureCategory = "scope_mismatch"
+
+	// AuthFailureSSOFailed indicates the server rejected the token muster
+	// forwarded or exchanged for SSO, so its trust configuration must be
+	// fixed.
+	AuthFailureSSOFailed AuthFailureCategory = "sso_failed"
+
+	// AuthFailureUnreachable indicates the server cannot be reached, or is
+	// not connected.
+	AuthFailureUnreachable AuthFailureCategory = "unreachable"
+
+	// AuthFailureMisconfigured indicates the server failed, or requires
+	// authentication without announcing an OAuth issuer, so neither logging
+	// in nor waiting helps.
+	AuthFailureMisconfigured AuthFailureCategory = "misconfigured"
+
+	// AuthFailureAggregatorAuth indicates the user is not authenticated to
+	// the aggregator itself. Clients report it when auth://status is rejected.
+	AuthFailureAggregatorAuth AuthFailureCategory = "aggregator_auth"
+
+	// AuthFailureAggregatorUnreachable indicates the aggregator cannot be
+	// reached. Clients report it when auth://status cannot be read.
+	AuthFailureAggregatorUnreachable AuthFailureCategory = "aggregator_unreachable"
+)
+
+// RemediationAction is the machine-readable action that restores access to an
+// MCP server, in the Remediation field of ServerAuthStatus.
+type RemediationAction string
+
+const (
+	// RemediationLogin means the user must log in to the server, e.g. with
+	// 'muster auth login --server <name>' or the AuthTool.
+	RemediationLogin RemediationAction = "login"
+
+	// RemediationUnreachable means the server or the network must be fixed;
+	// logging in does not help until the server is reachable.
+	RemediationUnreachable RemediationAction = "unreachable"
+
+	// RemediationMisconfigured means the server configuration must be fixed
+	// by an administrator.
+	RemediationMisconfigured RemediationAction = "misconfigured"
+)
+
 // Display constants for user-facing output.
 // These are formatted strings suitable for CLI prompts and status displays.
 const (
@@ -384,6 +446,27 @@ type ServerAuthStatus struct {
 	// When true, the status will be "auth_required" and users should check
 	// server trust configuration.
 	SSOAttemptFailed bool `json:"sso_attempt_failed,omitempty"`
+
+	// RequiredScopes are the OAuth scopes the server requires, Scope split
+	// into its scopes.
+	RequiredScopes []string `json:"required_scopes,omitempty"`
+
+	// FailureCategory classifies why the server is not usable. It is empty
+	// while the server is connected or SSO is in progress.
+	FailureCategory AuthFailureCategory `json:"failure_category,omitempty"`
+
+	// LastAttempt is when the user last tried to authenticate to the server,
+	// by logging in, token forwarding, or token exchange, or when the server
+	// last rejected the user's token. It is unset without a session.
+	LastAttempt *time.Time `json:"last_attempt,omitempty"`
+
+	// Remediation is the action that makes the server usable again. It is
+	// empty when no action is needed.
+	Remediation RemediationAction `json:"remediation,omitempty"`
+
+	// RemediationHint describes Remediation for humans, e.g. the command to
+	// run.
+	RemediationHint string `json:"remediation_hint,omitempty"`
 }
 
 // AuthRequiredInfo contains information about a server requiring authentication.
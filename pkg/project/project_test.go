@@ -40,3 +40,27 @@ func TestAccessors(t *testing.T) {
 		t.Error("BuildTimestamp must not be empty")
 	}
 }
+
+func TestVersionSkew(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want Skew
+	}{
+		{"v1.2.3", "v1.2.3", SkewNone},
+		{"v1.2.3", "1.2.3", SkewNone},
+		{"v1.2.3", "v1.4.0", SkewCompatible},
+		{"v1.2.3", "v2.0.0", SkewIncompatible},
+		{"v0.5.1", "v0.5.4", SkewCompatible},
+		{"v0.5.1", "v0.6.0", SkewIncompatible},
+		{"v1.3.0-rc.1", "v1.3.0", SkewNone},
+		{"dev", "v1.2.3", SkewUnknown},
+		{"v1.2.3", "abc1234", SkewUnknown},
+		{"v0.0.0-20240101120000-abcdef123456", "v0.1.0", SkewUnknown},
+		{"v1.2.4-0.20240101120000-abcdef123456", "v1.2.3", SkewUnknown},
+	}
+	for _, tc := range tests {
+		if got := VersionSkew(tc.a, tc.b); got != tc.want {
+			t.Errorf("VersionSkew(%q, %q) = %q, want %q", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
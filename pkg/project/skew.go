@@ -0,0 +1,86 @@
+package project
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Skew classifies the difference between the versions of two muster
+// binaries, such as the CLI and the server it connects to.
+type Skew string
+
+const (
+	// SkewNone means both binaries run the same release.
+	SkewNone Skew = "none"
+
+	// SkewUnknown means at least one version is not a release, e.g. "dev",
+	// a commit SHA, or a Go pseudo-version, so skew cannot be determined.
+	SkewUnknown Skew = "unknown"
+
+	// SkewCompatible means the releases differ but are expected to work
+	// together: the same major version, and for 0.x the same minor version.
+	SkewCompatible Skew = "compatible"
+
+	// SkewIncompatible means the releases differ in their major version, or
+	// for 0.x in their minor version, which may break the protocol between
+	// them.
+	SkewIncompatible Skew = "incompatible"
+)
+
+// pseudoVersionTimestamp matches the commit timestamp of a Go pseudo-version,
+// e.g. v0.0.0-20240101120000-abcdef123456.
+var pseudoVersionTimestamp = regexp.MustCompile(`(^|[.-])\d{14}-`)
+
+// VersionSkew compares the versions of two muster binaries, e.g. the CLI's
+// Version and the server version reported in the MCP handshake.
+func VersionSkew(a, b string) Skew {
+	va, okA := parseRelease(a)
+	vb, okB := parseRelease(b)
+	if !okA || !okB {
+		return SkewUnknown
+	}
+	switch {
+	case va == vb:
+		return SkewNone
+	case va[0] != vb[0], va[0] == 0 && va[1] != vb[1]:
+		return SkewIncompatible
+	default:
+		return SkewCompatible
+	}
+}
+
+// IsRelease reports whether v is a release version rather than, e.g., "dev",
+// a commit SHA, or a Go pseudo-version.
+func IsRelease(v string) bool {
+	_, ok := parseRelease(v)
+	return ok
+}
+
+// parseRelease parses a release version, vMAJOR.MINOR.PATCH with an optional
+// pre-release and build suffix. It reports false for anything else.
+func parseRelease(v string) ([3]int, bool) {
+	var parsed [3]int
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		v = v[:i]
+	}
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		if pseudoVersionTimestamp.MatchString(v[i:]) {
+			return parsed, false
+		}
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return parsed, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return parsed, false
+		}
+		parsed[i] = n
+	}
+	return parsed, true
+}